@@ -84,8 +84,15 @@ func main() {
 	g.GenerateModelAs("api_chain", "ChainM", gen.FieldIgnore("placeholder"))
 	g.GenerateModelAs("api_minerset", "MinerSetM", gen.FieldIgnore("placeholder"))
 	g.GenerateModelAs("api_miner", "MinerM", gen.FieldIgnore("placeholder"))
-	g.GenerateModelAs("uc_user", "UserM", gen.FieldIgnore("placeholder"))
+	g.GenerateModelAs("uc_user", "UserM", gen.FieldIgnore("placeholder"),
+		gen.FieldType("mfa_required", "bool"),
+		gen.FieldType("totp_enabled", "bool"),
+	)
 	g.GenerateModelAs("uc_secret", "SecretM", gen.FieldIgnore("placeholder"))
+	g.GenerateModelAs("uc_role", "RoleM", gen.FieldIgnore("placeholder"))
+	g.GenerateModelAs("uc_user_identity", "UserIdentityM", gen.FieldIgnore("placeholder"))
+	g.GenerateModelAs("uc_signing_key", "SigningKeyM", gen.FieldIgnore("placeholder"))
+	g.GenerateModelAs("uc_session", "SessionM", gen.FieldIgnore("placeholder"))
 	// g.ApplyInterface(func(Querier) {}, model.MinerModel{})
 
 	// execute the action of code generation
@@ -23,6 +23,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/superproj/onex/cmd/onex-apiserver/app"
+	"github.com/superproj/onex/internal/apiserver/admission/plugin/minerclass"
 	"github.com/superproj/onex/internal/apiserver/admission/plugin/minerset"
 	"github.com/superproj/onex/internal/controlplane/admission/initializer"
 	"github.com/superproj/onex/internal/pkg/config/minerprofile"
@@ -44,6 +45,7 @@ func main() {
 		app.WithAlternateDNS("onex.io"),
 		// Add custom admission plugins.
 		app.WithAdmissionPlugin(minerset.PluginName, minerset.Register),
+		app.WithAdmissionPlugin(minerclass.PluginName, minerclass.Register),
 		// Add custom admission plugins initializer.
 		app.WithAdmissionInitializers(func(c *genericapiserver.RecommendedConfig) ([]admission.PluginInitializer, error) {
 			client, err := versioned.NewForConfig(c.LoopbackClientConfig)
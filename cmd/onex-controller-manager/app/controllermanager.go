@@ -548,6 +548,7 @@ func NewControllerDescriptors() map[string]*ControllerDescriptor {
 	register(newNamespacedResourcesDeleterControllerDescriptor())
 	register(newChainControllerDescriptor())
 	register(newChainSyncControllerDescriptor())
+	register(newChargeRequestControllerDescriptor())
 	register(newMinerSetSyncControllerDescriptor())
 	register(newMinerSyncControllerDescriptor())
 
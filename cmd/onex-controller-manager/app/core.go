@@ -11,6 +11,7 @@ import (
 
 	"github.com/superproj/onex/cmd/onex-controller-manager/names"
 	chaincontroller "github.com/superproj/onex/internal/controller/chain"
+	chargerequestcontroller "github.com/superproj/onex/internal/controller/chargerequest"
 	namespacecontroller "github.com/superproj/onex/internal/controller/namespace"
 	resourcecleancontroller "github.com/superproj/onex/internal/controller/resourceclean"
 	synccontroller "github.com/superproj/onex/internal/controller/sync"
@@ -48,6 +49,14 @@ func newChainSyncControllerDescriptor() *ControllerDescriptor {
 	}
 }
 
+func newChargeRequestControllerDescriptor() *ControllerDescriptor {
+	return &ControllerDescriptor{
+		name:    names.ChargeRequestController,
+		aliases: []string{"chargerequest"},
+		addFunc: addChargeRequestController,
+	}
+}
+
 func newMinerSetSyncControllerDescriptor() *ControllerDescriptor {
 	return &ControllerDescriptor{
 		name:    names.ChainController,
@@ -91,6 +100,12 @@ func addChainSyncController(ctx context.Context, mgr ctrl.Manager, cctx Controll
 	}).SetupWithManager(ctx, mgr, cctx.ControllerManagerOptions)
 }
 
+func addChargeRequestController(ctx context.Context, mgr ctrl.Manager, cctx ControllerContext) (bool, error) {
+	return true, (&chargerequestcontroller.Reconciler{
+		WatchFilterValue: cctx.Config.ComponentConfig.Generic.WatchFilterValue,
+	}).SetupWithManager(ctx, mgr, cctx.ControllerManagerOptions)
+}
+
 func addMinerSetSyncController(ctx context.Context, mgr ctrl.Manager, cctx ControllerContext) (bool, error) {
 	return true, (&synccontroller.MinerSetSyncReconciler{
 		Store: cctx.Store,
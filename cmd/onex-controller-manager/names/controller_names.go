@@ -46,6 +46,7 @@ const (
 	NamespacedResourcesDeleterController = "namespaced-resource-deleter"
 	ChainController                      = "chain-controller"
 	ChainSyncController                  = "chain-sync-controller"
+	ChargeRequestController              = "chargerequest-controller"
 	MinerSetSyncController               = "minerset-sync-controller"
 	MinerSyncController                  = "miner-sync-controller"
 	ResourceCleanController              = "resource-clean-controller"
@@ -13,6 +13,8 @@ import (
 	cliflag "k8s.io/component-base/cli/flag"
 
 	"github.com/superproj/onex/internal/gateway"
+	"github.com/superproj/onex/internal/gateway/quota"
+	"github.com/superproj/onex/internal/pkg/audit"
 	"github.com/superproj/onex/internal/pkg/client"
 	"github.com/superproj/onex/internal/pkg/client/usercenter"
 	"github.com/superproj/onex/internal/pkg/feature"
@@ -32,17 +34,20 @@ var _ app.CliOptions = (*Options)(nil)
 // Options contains state for master/api server.
 type Options struct {
 	// GenericOptions *genericoptions.Options       `json:"server"   mapstructure:"server"`
-	GRPCOptions       *genericoptions.GRPCOptions    `json:"grpc" mapstructure:"grpc"`
-	HTTPOptions       *genericoptions.HTTPOptions    `json:"http" mapstructure:"http"`
-	TLSOptions        *genericoptions.TLSOptions     `json:"tls" mapstructure:"tls"`
-	MySQLOptions      *genericoptions.MySQLOptions   `json:"mysql" mapstructure:"mysql"`
-	RedisOptions      *genericoptions.RedisOptions   `json:"redis" mapstructure:"redis"`
-	EtcdOptions       *genericoptions.EtcdOptions    `json:"etcd" mapstructure:"etcd"`
-	JaegerOptions     *genericoptions.JaegerOptions  `json:"jaeger" mapstructure:"jaeger"`
-	ConsulOptions     *genericoptions.ConsulOptions  `json:"consul" mapstructure:"consul"`
-	UserCenterOptions *usercenter.UserCenterOptions  `json:"usercenter" mapstructure:"usercenter"`
-	Metrics           *genericoptions.MetricsOptions `json:"metrics" mapstructure:"metrics"`
-	EnableTLS         bool                           `json:"enable-tls" mapstructure:"enable-tls"`
+	GRPCOptions       *genericoptions.GRPCOptions      `json:"grpc" mapstructure:"grpc"`
+	HTTPOptions       *genericoptions.HTTPOptions      `json:"http" mapstructure:"http"`
+	TLSOptions        *genericoptions.TLSOptions       `json:"tls" mapstructure:"tls"`
+	MySQLOptions      *genericoptions.MySQLOptions     `json:"mysql" mapstructure:"mysql"`
+	RedisOptions      *genericoptions.RedisOptions     `json:"redis" mapstructure:"redis"`
+	EtcdOptions       *genericoptions.EtcdOptions      `json:"etcd" mapstructure:"etcd"`
+	JaegerOptions     *genericoptions.JaegerOptions    `json:"jaeger" mapstructure:"jaeger"`
+	ConsulOptions     *genericoptions.ConsulOptions    `json:"consul" mapstructure:"consul"`
+	UserCenterOptions *usercenter.UserCenterOptions    `json:"usercenter" mapstructure:"usercenter"`
+	Metrics           *genericoptions.MetricsOptions   `json:"metrics" mapstructure:"metrics"`
+	RateLimitOptions  *genericoptions.RateLimitOptions `json:"ratelimit" mapstructure:"ratelimit"`
+	QuotaOptions      *quota.QuotaOptions              `json:"quota" mapstructure:"quota"`
+	AuditOptions      *audit.AuditOptions              `json:"audit" mapstructure:"audit"`
+	EnableTLS         bool                             `json:"enable-tls" mapstructure:"enable-tls"`
 	// Path to kubeconfig file with authorization and master location information.
 	Kubeconfig   string          `json:"kubeconfig" mapstructure:"kubeconfig"`
 	FeatureGates map[string]bool `json:"feature-gates"`
@@ -64,6 +69,9 @@ func NewOptions() *Options {
 		ConsulOptions:     genericoptions.NewConsulOptions(),
 		UserCenterOptions: usercenter.NewUserCenterOptions(),
 		Metrics:           genericoptions.NewMetricsOptions(),
+		RateLimitOptions:  genericoptions.NewRateLimitOptions(),
+		QuotaOptions:      quota.NewQuotaOptions(),
+		AuditOptions:      audit.NewAuditOptions(),
 		Log:               log.NewOptions(),
 	}
 
@@ -82,6 +90,9 @@ func (o *Options) Flags() (fss cliflag.NamedFlagSets) {
 	o.ConsulOptions.AddFlags(fss.FlagSet("consul"))
 	o.UserCenterOptions.AddFlags(fss.FlagSet("usercenter"))
 	o.Metrics.AddFlags(fss.FlagSet("metrics"))
+	o.RateLimitOptions.AddFlags(fss.FlagSet("ratelimit"))
+	o.QuotaOptions.AddFlags(fss.FlagSet("quota"))
+	o.AuditOptions.AddFlags(fss.FlagSet("audit"))
 	o.Log.AddFlags(fss.FlagSet("log"))
 
 	// Note: the weird ""+ in below lines seems to be the only way to get gofmt to
@@ -117,6 +128,9 @@ func (o *Options) Validate() error {
 	errs = append(errs, o.ConsulOptions.Validate()...)
 	errs = append(errs, o.UserCenterOptions.Validate()...)
 	errs = append(errs, o.Metrics.Validate()...)
+	errs = append(errs, o.RateLimitOptions.Validate()...)
+	errs = append(errs, o.QuotaOptions.Validate()...)
+	errs = append(errs, o.AuditOptions.Validate()...)
 	errs = append(errs, o.Log.Validate()...)
 
 	return utilerrors.NewAggregate(errs)
@@ -133,6 +147,9 @@ func (o *Options) ApplyTo(c *gateway.Config) error {
 	c.EtcdOptions = o.EtcdOptions
 	c.JaegerOptions = o.JaegerOptions
 	c.ConsulOptions = o.ConsulOptions
+	c.RateLimitOptions = o.RateLimitOptions
+	c.QuotaOptions = o.QuotaOptions
+	c.AuditOptions = o.AuditOptions
 	return nil
 }
 
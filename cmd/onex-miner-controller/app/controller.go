@@ -36,6 +36,7 @@ import (
 	"github.com/superproj/onex/cmd/onex-miner-controller/app/config"
 	"github.com/superproj/onex/cmd/onex-miner-controller/app/options"
 	minercontroller "github.com/superproj/onex/internal/controller/miner"
+	minersetautoscalercontroller "github.com/superproj/onex/internal/controller/minersetautoscaler"
 	"github.com/superproj/onex/internal/pkg/util/ratelimiter"
 	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
 	"github.com/superproj/onex/pkg/apis/apps/v1beta1/index"
@@ -220,6 +221,19 @@ func Run(ctx context.Context, c *config.CompletedConfig) error {
 		return err
 	}
 
+	if err = (&minersetautoscalercontroller.Reconciler{
+		ProviderClient:   c.ProviderClient,
+		Quota:            &c.ComponentConfig.Quota,
+		WatchFilterValue: c.ComponentConfig.WatchFilterValue,
+	}).SetupWithManager(ctx, mgr, controller.Options{
+		MaxConcurrentReconciles: int(c.ComponentConfig.Parallelism),
+		RecoverPanic:            ptr.To(true),
+		RateLimiter:             ratelimiter.DefaultControllerRateLimiter(),
+	}); err != nil {
+		klog.ErrorS(err, "Unable to create controller", "controller", "minersetautoscaler")
+		return err
+	}
+
 	// add handlers
 	if err := mgr.AddReadyzCheck("healthz", healthz.Ping); err != nil {
 		klog.ErrorS(err, "Unable to set up health check")
@@ -9,6 +9,7 @@ package options
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -16,7 +17,9 @@ import (
 
 	"github.com/superproj/onex/internal/pkg/zflag"
 	"github.com/superproj/onex/internal/toyblc"
+	"github.com/superproj/onex/internal/toyblc/blc"
 	"github.com/superproj/onex/internal/toyblc/defaults"
+	"github.com/superproj/onex/internal/toyblc/ws"
 	"github.com/superproj/onex/pkg/app"
 	"github.com/superproj/onex/pkg/log"
 	genericoptions "github.com/superproj/onex/pkg/options"
@@ -35,8 +38,13 @@ type Options struct {
 	MinMineInterval  time.Duration               `json:"min-mine-interval" mapstructure:"min-mine-interval"`
 	MiningDifficulty int                         `json:"mining-difficulty" mapstructure:"mining-difficulty"`
 	Address          string                      `json:"address" mapstructure:"address"`
+	DataDir          string                      `json:"data-dir" mapstructure:"data-dir"`
 	Accounts         map[string]string           `json:"accounts" mapstructure:"-"`
 	P2PAddr          string                      `json:"p2p-addr" mapstructure:"p2p-addr"`
+	ExternalP2PAddr  string                      `json:"external-p2p-addr" mapstructure:"external-p2p-addr"`
+	ChainID          string                      `json:"chain-id" mapstructure:"chain-id"`
+	MaxPeers         int                         `json:"max-peers" mapstructure:"max-peers"`
+	Heartbeat        time.Duration               `json:"heartbeat-interval" mapstructure:"heartbeat-interval"`
 	Peers            []string                    `json:"peers" mapstructure:"peers"`
 	HTTPOptions      *genericoptions.HTTPOptions `json:"http" mapstructure:"http"`
 	TLSOptions       *genericoptions.TLSOptions  `json:"tls" mapstructure:"tls"`
@@ -51,6 +59,9 @@ func NewOptions() *Options {
 		Address:          defaults.GenesisAddress,
 		Accounts:         defaults.Accounts,
 		P2PAddr:          "0.0.0.0:6001",
+		ChainID:          ws.DefaultChainID,
+		MaxPeers:         ws.DefaultMaxPeers,
+		Heartbeat:        ws.DefaultHeartbeatInterval,
 		Peers:            []string{"ws://localhost:6001"},
 		HTTPOptions:      genericoptions.NewHTTPOptions(),
 		TLSOptions:       genericoptions.NewTLSOptions(),
@@ -71,9 +82,16 @@ func (o *Options) Flags() (fss cliflag.NamedFlagSets) {
 	fs := fss.FlagSet("misc")
 	fs.BoolVar(&o.Miner, "miner", o.Miner, "Turn on mining mode.")
 	fs.DurationVar(&o.MinMineInterval, "min-mine-interval", o.MinMineInterval, "Specify the minimum mining interval.")
-	fs.IntVar(&o.MiningDifficulty, "mining-difficulty", o.MiningDifficulty, "Specify the mining difficulty.")
+	fs.IntVar(&o.MiningDifficulty, "mining-difficulty", o.MiningDifficulty, "Specify the minimum number of leading zero bits of the mined block hash.")
 	fs.StringVar(&o.Address, "address", o.Address, "Wallet account to receive the block rewards.")
+	fs.StringVar(&o.DataDir, "data-dir", o.DataDir, "Directory to persist the blockchain. If empty, blocks are kept in memory only.")
 	fs.StringVar(&o.P2PAddr, "p2p-addr", o.P2PAddr, "The p2p server address.")
+	fs.StringVar(&o.ExternalP2PAddr, "external-p2p-addr", o.ExternalP2PAddr, ""+
+		"The websocket address advertised to peers, e.g. ws://10.0.0.1:6001. "+
+		"If empty, the node is not advertised by peer exchange.")
+	fs.StringVar(&o.ChainID, "chain-id", o.ChainID, "Identifier of the chain, peers on another chain are disconnected.")
+	fs.IntVar(&o.MaxPeers, "max-peers", o.MaxPeers, "The maximum number of connected peers.")
+	fs.DurationVar(&o.Heartbeat, "heartbeat-interval", o.Heartbeat, "Interval to ping peers, peers silent for three intervals are evicted.")
 	zflag.MapVar(&o.Accounts, "accounts", o.Accounts, "Authentication username and password set for API interface.", fs)
 	fs.StringSliceVar(&o.Peers, "peers", o.Peers, "The initial peers.")
 
@@ -89,14 +107,30 @@ func (o *Options) Complete() error {
 func (o *Options) Validate() error {
 	errs := []error{}
 
-	if o.MiningDifficulty < 0 {
-		errs = append(errs, fmt.Errorf("`--mining-difficulty` must be non-negative"))
+	if o.MiningDifficulty < 0 || o.MiningDifficulty > blc.MaxDifficulty {
+		errs = append(errs, fmt.Errorf("`--mining-difficulty` must be between 0 and %d", blc.MaxDifficulty))
 	}
 
 	if err := genericoptions.ValidateAddress(o.P2PAddr); err != nil {
 		errs = append(errs, err)
 	}
 
+	if o.ExternalP2PAddr != "" && !strings.HasPrefix(o.ExternalP2PAddr, "ws://") && !strings.HasPrefix(o.ExternalP2PAddr, "wss://") {
+		errs = append(errs, fmt.Errorf("`--external-p2p-addr` must be a ws:// or wss:// url"))
+	}
+
+	if o.ChainID == "" {
+		errs = append(errs, fmt.Errorf("`--chain-id` can not be empty"))
+	}
+
+	if o.MaxPeers <= 0 {
+		errs = append(errs, fmt.Errorf("`--max-peers` must be greater than 0"))
+	}
+
+	if o.Heartbeat <= 0 {
+		errs = append(errs, fmt.Errorf("`--heartbeat-interval` must be greater than 0"))
+	}
+
 	if len(o.Accounts) == 0 {
 		errs = append(errs, fmt.Errorf("empty list of authorized credentials"))
 	} else {
@@ -118,12 +152,20 @@ func (o *Options) Validate() error {
 func (o *Options) ApplyTo(c *toyblc.Config) error {
 	c.Miner = o.Miner
 	c.MinMineInterval = o.MinMineInterval
+	c.MiningDifficulty = o.MiningDifficulty
 	c.Address = o.Address
+	c.DataDir = o.DataDir
 	c.Accounts = o.Accounts
 	c.HTTPOptions = o.HTTPOptions
 	c.TLSOptions = o.TLSOptions
 	c.P2PAddr = o.P2PAddr
 	c.Peers = o.Peers
+	c.P2P = ws.Config{
+		ChainID:           o.ChainID,
+		ListenAddr:        o.ExternalP2PAddr,
+		MaxPeers:          o.MaxPeers,
+		HeartbeatInterval: o.Heartbeat,
+	}
 
 	return nil
 }
@@ -11,10 +11,12 @@ import (
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	cliflag "k8s.io/component-base/cli/flag"
 
+	"github.com/superproj/onex/internal/pkg/audit"
 	"github.com/superproj/onex/internal/pkg/client"
 	"github.com/superproj/onex/internal/pkg/feature"
 	known "github.com/superproj/onex/internal/pkg/known/usercenter"
 	"github.com/superproj/onex/internal/usercenter"
+	"github.com/superproj/onex/internal/usercenter/auth"
 	"github.com/superproj/onex/pkg/app"
 	"github.com/superproj/onex/pkg/log"
 	genericoptions "github.com/superproj/onex/pkg/options"
@@ -50,6 +52,16 @@ type Options struct {
 	ConsulOptions *genericoptions.ConsulOptions `json:"consul" mapstructure:"consul"`
 	// JWT options for configuring JWT related options.
 	JWTOptions *genericoptions.JWTOptions `json:"jwt" mapstructure:"jwt"`
+	// Authn options for configuring how access tokens are signed.
+	AuthnOptions *auth.AuthnOptions `json:"authn" mapstructure:"authn"`
+	// Authz options for configuring authorization related options.
+	AuthzOptions *auth.AuthzOptions `json:"authz" mapstructure:"authz"`
+	// OIDC options for configuring login with an OpenID provider.
+	OIDCOptions *auth.OIDCOptions `json:"oidc" mapstructure:"oidc"`
+	// Password options for configuring the password policy and the login lockout.
+	PasswordOptions *auth.PasswordOptions `json:"password" mapstructure:"password"`
+	// Audit options for configuring the audit events of the mutating operations.
+	AuditOptions *audit.AuditOptions `json:"audit" mapstructure:"audit"`
 	// Metrics options for configuring metric related options.
 	Metrics *genericoptions.MetricsOptions `json:"metrics" mapstructure:"metrics"`
 	// TODO: add `mapstructure` tag for FeatureGates
@@ -63,18 +75,23 @@ type Options struct {
 func NewOptions() *Options {
 	o := &Options{
 		// GenericOptions: genericoptions.NewOptions(),
-		GRPCOptions:   genericoptions.NewGRPCOptions(),
-		HTTPOptions:   genericoptions.NewHTTPOptions(),
-		TLSOptions:    genericoptions.NewTLSOptions(),
-		MySQLOptions:  genericoptions.NewMySQLOptions(),
-		RedisOptions:  genericoptions.NewRedisOptions(),
-		EtcdOptions:   genericoptions.NewEtcdOptions(),
-		KafkaOptions:  genericoptions.NewKafkaOptions(),
-		JaegerOptions: genericoptions.NewJaegerOptions(),
-		ConsulOptions: genericoptions.NewConsulOptions(),
-		JWTOptions:    genericoptions.NewJWTOptions(),
-		Metrics:       genericoptions.NewMetricsOptions(),
-		Log:           log.NewOptions(),
+		GRPCOptions:     genericoptions.NewGRPCOptions(),
+		HTTPOptions:     genericoptions.NewHTTPOptions(),
+		TLSOptions:      genericoptions.NewTLSOptions(),
+		MySQLOptions:    genericoptions.NewMySQLOptions(),
+		RedisOptions:    genericoptions.NewRedisOptions(),
+		EtcdOptions:     genericoptions.NewEtcdOptions(),
+		KafkaOptions:    genericoptions.NewKafkaOptions(),
+		JaegerOptions:   genericoptions.NewJaegerOptions(),
+		ConsulOptions:   genericoptions.NewConsulOptions(),
+		JWTOptions:      genericoptions.NewJWTOptions(),
+		AuthnOptions:    auth.NewAuthnOptions(),
+		AuthzOptions:    auth.NewAuthzOptions(),
+		OIDCOptions:     auth.NewOIDCOptions(),
+		PasswordOptions: auth.NewPasswordOptions(),
+		AuditOptions:    audit.NewAuditOptions(),
+		Metrics:         genericoptions.NewMetricsOptions(),
+		Log:             log.NewOptions(),
 	}
 
 	return o
@@ -92,6 +109,11 @@ func (o *Options) Flags() (fss cliflag.NamedFlagSets) {
 	o.JaegerOptions.AddFlags(fss.FlagSet("jaeger"))
 	o.ConsulOptions.AddFlags(fss.FlagSet("consul"))
 	o.JWTOptions.AddFlags(fss.FlagSet("jwt"))
+	o.AuthnOptions.AddFlags(fss.FlagSet("authn"))
+	o.AuthzOptions.AddFlags(fss.FlagSet("authz"))
+	o.OIDCOptions.AddFlags(fss.FlagSet("oidc"))
+	o.PasswordOptions.AddFlags(fss.FlagSet("password"))
+	o.AuditOptions.AddFlags(fss.FlagSet("audit"))
 	o.Metrics.AddFlags(fss.FlagSet("metrics"))
 	o.Log.AddFlags(fss.FlagSet("log"))
 
@@ -128,6 +150,11 @@ func (o *Options) Validate() error {
 	errs = append(errs, o.JaegerOptions.Validate()...)
 	errs = append(errs, o.ConsulOptions.Validate()...)
 	errs = append(errs, o.JWTOptions.Validate()...)
+	errs = append(errs, o.AuthnOptions.Validate()...)
+	errs = append(errs, o.AuthzOptions.Validate()...)
+	errs = append(errs, o.OIDCOptions.Validate()...)
+	errs = append(errs, o.PasswordOptions.Validate()...)
+	errs = append(errs, o.AuditOptions.Validate()...)
 	errs = append(errs, o.Metrics.Validate()...)
 	errs = append(errs, o.Log.Validate()...)
 
@@ -146,6 +173,11 @@ func (o *Options) ApplyTo(c *usercenter.Config) error {
 	c.KafkaOptions = o.KafkaOptions
 	c.JaegerOptions = o.JaegerOptions
 	c.ConsulOptions = o.ConsulOptions
+	c.AuthnOptions = o.AuthnOptions
+	c.AuthzOptions = o.AuthzOptions
+	c.OIDCOptions = o.OIDCOptions
+	c.PasswordOptions = o.PasswordOptions
+	c.AuditOptions = o.AuditOptions
 	return nil
 }
 
@@ -15,6 +15,13 @@ CREATE TABLE `uc_user` (
   `password` varchar(64) NOT NULL DEFAULT '' COMMENT '用户加密后的密码',
   `email` varchar(253) NOT NULL DEFAULT '' COMMENT '用户电子邮箱',
   `phone` varchar(16) NOT NULL DEFAULT '' COMMENT '用户手机号',
+  `mfa_required` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否强制开启多因素认证',
+  `totp_enabled` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否已启用 TOTP 认证',
+  `totp_secret` varchar(64) NOT NULL DEFAULT '' COMMENT 'TOTP 密钥',
+  `totp_counter` bigint(20) NOT NULL DEFAULT 0 COMMENT '最后一次通过校验的 TOTP 时间步',
+  `recovery_codes` text COMMENT '加密后的恢复码列表',
+  `password_history` text COMMENT '加密后的历史密码列表',
+  `password_updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '最后修改密码时间',
   `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
   `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
   PRIMARY KEY (`id`),
@@ -40,6 +47,63 @@ CREATE TABLE `uc_secret` (
   KEY `idx_user_id` (`user_id`)
 ) ENGINE=InnoDB AUTO_INCREMENT=0 DEFAULT CHARSET=utf8mb4 COMMENT='密钥表';
 
+-- uc_role
+
+CREATE TABLE `uc_role` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '主键 ID',
+  `name` varchar(100) NOT NULL DEFAULT '' COMMENT '角色名称',
+  `description` varchar(255) NOT NULL DEFAULT '' COMMENT '角色描述',
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_name` (`name`)
+) ENGINE=InnoDB AUTO_INCREMENT=0 DEFAULT CHARSET=utf8mb4 COMMENT='角色表';
+
+-- uc_user_identity
+
+CREATE TABLE `uc_user_identity` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '主键 ID',
+  `user_id` varchar(253) NOT NULL DEFAULT '' COMMENT '用户 ID',
+  `issuer` varchar(253) NOT NULL DEFAULT '' COMMENT '身份提供方标识',
+  `subject` varchar(253) NOT NULL DEFAULT '' COMMENT '用户在身份提供方中的唯一标识',
+  `email` varchar(253) NOT NULL DEFAULT '' COMMENT '用户在身份提供方中的电子邮箱',
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_issuer_subject` (`issuer`,`subject`),
+  KEY `idx_user_id` (`user_id`)
+) ENGINE=InnoDB AUTO_INCREMENT=0 DEFAULT CHARSET=utf8mb4 COMMENT='用户外部身份表';
+
+-- uc_signing_key
+
+CREATE TABLE `uc_signing_key` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '主键 ID',
+  `kid` varchar(64) NOT NULL DEFAULT '' COMMENT '密钥 ID',
+  `algorithm` varchar(16) NOT NULL DEFAULT '' COMMENT '签名算法',
+  `private_key` text NOT NULL COMMENT 'PEM 格式的私钥',
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_kid` (`kid`)
+) ENGINE=InnoDB AUTO_INCREMENT=0 DEFAULT CHARSET=utf8mb4 COMMENT='令牌签名密钥表';
+
+-- uc_session
+
+CREATE TABLE `uc_session` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '主键 ID',
+  `session_id` varchar(64) NOT NULL DEFAULT '' COMMENT '会话 ID，即令牌的 jti 字段',
+  `user_id` varchar(253) NOT NULL DEFAULT '' COMMENT '用户 ID',
+  `device` varchar(253) NOT NULL DEFAULT '' COMMENT '登录设备',
+  `ip` varchar(64) NOT NULL DEFAULT '' COMMENT '登录 IP',
+  `user_agent` varchar(512) NOT NULL DEFAULT '' COMMENT '登录客户端的 User-Agent',
+  `issued_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '最近一次签发令牌的时间',
+  `expires_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '会话过期时间',
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_session_id` (`session_id`),
+  KEY `idx_user_id` (`user_id`)
+) ENGINE=InnoDB AUTO_INCREMENT=0 DEFAULT CHARSET=utf8mb4 COMMENT='用户登录会话表';
+
 -- api_chain
 
 CREATE TABLE `api_chain` (
@@ -65,6 +129,8 @@ CREATE TABLE `api_minerset` (
   `name` varchar(253) NOT NULL DEFAULT '' COMMENT '矿机池名',
   `replicas` int(8) NOT NULL DEFAULT 0 COMMENT '矿机副本数',
   `display_name` varchar(253) NOT NULL DEFAULT '' COMMENT '矿机池展示名',
+  `chain_name` varchar(253) NOT NULL DEFAULT '' COMMENT '矿机所属的区块链名',
+  `miner_type` varchar(16) NOT NULL DEFAULT '' COMMENT '矿机机型',
   `delete_policy` varchar(32) NOT NULL DEFAULT '' COMMENT '矿机池缩容策略',
   `min_ready_seconds` int(8) NOT NULL DEFAULT 0 COMMENT '矿机 Ready 最小等待时间',
   `fully_labeled_replicas` int(8) NOT NULL DEFAULT 0 COMMENT '所有标签匹配的副本数',
@@ -72,11 +138,16 @@ CREATE TABLE `api_minerset` (
   `available_replicas` int(8) NOT NULL DEFAULT 0 COMMENT '可用副本数',
   `failure_reason` longtext DEFAULT NULL COMMENT '失败原因',
   `failure_message` longtext DEFAULT NULL COMMENT '失败信息',
+  `labels` longtext DEFAULT NULL COMMENT '矿机池标签',
   `conditions` longtext DEFAULT NULL COMMENT '矿机池状态',
   `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
   `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
   PRIMARY KEY (`id`),
-  UNIQUE KEY `uniq_namespace_name` (`namespace`,`name`)
+  UNIQUE KEY `uniq_namespace_name` (`namespace`,`name`),
+  KEY `idx_namespace_chain_name` (`namespace`,`chain_name`),
+  KEY `idx_namespace_miner_type` (`namespace`,`miner_type`),
+  KEY `idx_namespace_created_at` (`namespace`,`created_at`),
+  KEY `idx_namespace_updated_at` (`namespace`,`updated_at`)
 ) ENGINE=InnoDB AUTO_INCREMENT=0 DEFAULT CHARSET=utf8mb4 COMMENT='矿机池表';
 
 -- api_miner
@@ -91,13 +162,42 @@ CREATE TABLE `api_miner` (
   `chain_name` varchar(253) NOT NULL DEFAULT '' COMMENT '矿机所属的区块链名',
   `cpu` int(8) NOT NULL DEFAULT 0 COMMENT '矿机 CPU 规格',
   `memory` int(8) NOT NULL DEFAULT 0 COMMENT '矿机内存规格',
+  `labels` longtext DEFAULT NULL COMMENT '矿机标签',
   `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
   `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
   PRIMARY KEY (`id`),
   UNIQUE KEY `uniq_namespace_name` (`namespace`,`name`),
-  KEY `idx_chain_name` (`chain_name`)
+  KEY `idx_chain_name` (`chain_name`),
+  KEY `idx_namespace_chain_name` (`namespace`,`chain_name`),
+  KEY `idx_namespace_phase` (`namespace`,`phase`),
+  KEY `idx_namespace_miner_type` (`namespace`,`miner_type`),
+  KEY `idx_namespace_created_at` (`namespace`,`created_at`),
+  KEY `idx_namespace_updated_at` (`namespace`,`updated_at`)
 ) ENGINE=InnoDB AUTO_INCREMENT=0 DEFAULT CHARSET=utf8mb4 COMMENT='矿机表';
 
+-- audit_event
+
+CREATE TABLE `audit_event` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '主键 ID',
+  `event_id` varchar(36) NOT NULL DEFAULT '' COMMENT '审计事件 ID',
+  `time` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '操作时间',
+  `user_id` varchar(253) NOT NULL DEFAULT '' COMMENT '操作用户 ID',
+  `secret_id` varchar(36) NOT NULL DEFAULT '' COMMENT '请求签名使用的密钥 ID',
+  `operation` varchar(253) NOT NULL DEFAULT '' COMMENT '操作名',
+  `resource` varchar(64) NOT NULL DEFAULT '' COMMENT '资源类型',
+  `name` varchar(253) NOT NULL DEFAULT '' COMMENT '资源名',
+  `request` longtext DEFAULT NULL COMMENT '脱敏后的请求',
+  `diff` longtext DEFAULT NULL COMMENT '资源变更的 JSON Merge Patch',
+  `code` int(8) NOT NULL DEFAULT 0 COMMENT '操作结果的 HTTP 状态码',
+  `reason` varchar(253) NOT NULL DEFAULT '' COMMENT '操作失败的原因',
+  `message` varchar(1024) NOT NULL DEFAULT '' COMMENT '操作失败的信息',
+  `trace_id` varchar(32) NOT NULL DEFAULT '' COMMENT '链路追踪 ID',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_event_id` (`event_id`),
+  KEY `idx_user_id_time` (`user_id`,`time`),
+  KEY `idx_resource_name` (`resource`,`name`)
+) ENGINE=InnoDB AUTO_INCREMENT=0 DEFAULT CHARSET=utf8mb4 COMMENT='审计事件表';
+
 -- fs_order
 
 CREATE TABLE `fs_order` (
@@ -274,7 +274,7 @@ require (
 	golang.org/x/crypto v0.21.0
 	golang.org/x/mod v0.15.0 // indirect
 	golang.org/x/net v0.23.0
-	golang.org/x/oauth2 v0.12.0 // indirect
+	golang.org/x/oauth2 v0.12.0
 	golang.org/x/sync v0.7.0
 	golang.org/x/sys v0.18.0 // indirect
 	golang.org/x/term v0.18.0 // indirect
@@ -0,0 +1,112 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+// Package minerclass contains an admission controller that validates the miner types
+// of the Chains, MinerSets and Miners refer to existing MinerClasses.
+package minerclass
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/admission"
+
+	"github.com/superproj/onex/pkg/apis/apps"
+	"github.com/superproj/onex/pkg/generated/informers"
+	appslisters "github.com/superproj/onex/pkg/generated/listers/apps/v1beta1"
+)
+
+// PluginName indicates name of admission plugin.
+const PluginName = "MinerClass"
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return NewPlugin(), nil
+	})
+}
+
+// Plugin is an implementation of admission.Interface.
+// It rejects the chains, minersets and miners whose miner type is not a MinerClass.
+type Plugin struct {
+	*admission.Handler
+	lister appslisters.MinerClassLister
+}
+
+var _ admission.ValidationInterface = &Plugin{}
+
+// Validate makes sure the miner type of the object refers to an existing MinerClass.
+// Updates are only checked when the miner type changes, so that the objects created
+// before a MinerClass is deleted can still be updated.
+func (p *Plugin) Validate(ctx context.Context, attributes admission.Attributes, o admission.ObjectInterfaces) error {
+	if len(attributes.GetSubresource()) != 0 {
+		return nil
+	}
+
+	minerType, fldPath, ok := getMinerType(attributes.GetObject())
+	if !ok {
+		return nil
+	}
+	if attributes.GetOperation() == admission.Update {
+		if oldMinerType, _, _ := getMinerType(attributes.GetOldObject()); oldMinerType == minerType {
+			return nil
+		}
+	}
+
+	if !p.WaitForReady() {
+		return admission.NewForbidden(attributes, fmt.Errorf("not yet ready to handle request"))
+	}
+
+	if _, err := p.lister.Get(minerType); err != nil {
+		if apierrors.IsNotFound(err) {
+			return apierrors.NewInvalid(attributes.GetKind().GroupKind(), attributes.GetName(), field.ErrorList{
+				field.NotFound(fldPath, minerType),
+			})
+		}
+		return apierrors.NewInternalError(fmt.Errorf("can not get minerclass: %s", minerType))
+	}
+
+	return nil
+}
+
+// getMinerType returns the miner type of the given object and its field path.
+func getMinerType(obj any) (string, *field.Path, bool) {
+	switch o := obj.(type) {
+	case *apps.Chain:
+		return o.Spec.MinerType, field.NewPath("spec", "minerType"), true
+	case *apps.MinerSet:
+		return o.Spec.Template.Spec.MinerType, field.NewPath("spec", "template", "spec", "minerType"), true
+	case *apps.Miner:
+		return o.Spec.MinerType, field.NewPath("spec", "minerType"), true
+	}
+
+	return "", nil, false
+}
+
+// SetInternalInformerFactory gets Lister from SharedInformerFactory.
+// The lister knows how to lists MinerClasses.
+func (p *Plugin) SetInternalInformerFactory(f informers.SharedInformerFactory) {
+	p.lister = f.Apps().V1beta1().MinerClasses().Lister()
+	p.SetReadyFunc(f.Apps().V1beta1().MinerClasses().Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (p *Plugin) ValidateInitialization() error {
+	if p.lister == nil {
+		return fmt.Errorf("%s requires a minerclass lister", PluginName)
+	}
+	return nil
+}
+
+// NewPlugin creates a new minerclass admission control handler.
+func NewPlugin() *Plugin {
+	return &Plugin{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+//nolint:gocritic
+package chargerequest
+
+import (
+	"context"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"k8s.io/kubernetes/pkg/api/legacyscheme"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+
+	chargerequestutil "github.com/superproj/onex/internal/pkg/util/chargerequest"
+	"github.com/superproj/onex/pkg/apis/apps"
+	"github.com/superproj/onex/pkg/apis/apps/validation"
+)
+
+// chargeRequestStrategy implements behavior for ChargeRequest objects.
+type chargeRequestStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating ChargeRequest
+// objects via the REST API.
+var Strategy = chargeRequestStrategy{legacyscheme.Scheme, names.SimpleNameGenerator}
+
+var (
+	// Make sure we correctly implement the interface.
+	_ = rest.GarbageCollectionDeleteStrategy(Strategy)
+	// Strategy should implement rest.RESTCreateStrategy.
+	_ rest.RESTCreateStrategy = Strategy
+	// Strategy should implement rest.RESTUpdateStrategy.
+	_ rest.RESTUpdateStrategy = Strategy
+)
+
+// DefaultGarbageCollectionPolicy returns DeleteDependents for all currently served versions.
+func (chargeRequestStrategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.GarbageCollectionPolicy {
+	return rest.DeleteDependents
+}
+
+// NamespaceScoped is true for chargerequests.
+func (chargeRequestStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// GetResetFields returns the set of fields that get reset by the strategy
+// and should not be modified by the user.
+func (chargeRequestStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	fields := map[fieldpath.APIVersion]*fieldpath.Set{
+		"apps.onex.io/v1beta1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("status"),
+		),
+	}
+
+	return fields
+}
+
+// PrepareForCreate clears fields that are not allowed to be set by end users on creation.
+func (chargeRequestStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	chargeRequest := obj.(*apps.ChargeRequest)
+	chargeRequest.Status = apps.ChargeRequestStatus{}
+	chargeRequest.Generation = 1
+
+	dropChargeRequestDisabledFields(chargeRequest, nil)
+
+	// Be explicit that users cannot create pre-provisioned chargerequests.
+	chargeRequest.Status.Conditions = []apps.Condition{}
+
+	// The password is never stored in plaintext. If it can not be sealed, it is
+	// left as is and rejected by Validate.
+	if sealed, err := chargerequestutil.SealPassword(chargeRequest.Spec.Password); err == nil {
+		chargeRequest.Spec.Password = sealed
+	}
+}
+
+// Validate validates a new chargerequest.
+func (chargeRequestStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	chargeRequest := obj.(*apps.ChargeRequest)
+	allErrs := validation.ValidateChargeRequest(chargeRequest)
+	allErrs = append(allErrs, validatePasswordSealed(chargeRequest)...)
+	return allErrs
+}
+
+// WarningsOnCreate returns warnings for the creation of the given object.
+func (chargeRequestStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+// Canonicalize normalizes the object after validation.
+func (chargeRequestStrategy) Canonicalize(obj runtime.Object) {
+}
+
+// AllowCreateOnUpdate is false for chargerequests.
+func (chargeRequestStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// PrepareForUpdate clears fields that are not allowed to be set by end users on update.
+func (chargeRequestStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newChargeRequest := obj.(*apps.ChargeRequest)
+	oldChargeRequest := old.(*apps.ChargeRequest)
+	// Update is not allowed to set status
+	newChargeRequest.Status = oldChargeRequest.Status
+
+	dropChargeRequestDisabledFields(newChargeRequest, oldChargeRequest)
+
+	// Any changes to the spec increment the generation number, any changes to the
+	// status should reflect the generation number of the corresponding object.
+	// See metav1.ObjectMeta description for more information on Generation.
+	if !apiequality.Semantic.DeepEqual(oldChargeRequest.Spec, newChargeRequest.Spec) {
+		newChargeRequest.Generation = oldChargeRequest.Generation + 1
+	}
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (chargeRequestStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	chargeRequest := obj.(*apps.ChargeRequest)
+	allErrs := validation.ValidateChargeRequestUpdate(chargeRequest, old.(*apps.ChargeRequest))
+	allErrs = append(allErrs, validatePasswordSealed(chargeRequest)...)
+	return allErrs
+}
+
+// WarningsOnUpdate returns warnings for the given update.
+func (chargeRequestStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// If AllowUnconditionalUpdate() is true and the object specified by
+// the user does not have a resource version, then generic Update()
+// populates it with the latest version. Else, it checks that the
+// version specified by the user matches the version of latest etcd
+// object.
+func (chargeRequestStrategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+// Storage strategy for the Status subresource.
+type chargeRequestStatusStrategy struct {
+	chargeRequestStrategy
+}
+
+// StatusStrategy is the default logic invoked when updating object status.
+var StatusStrategy = chargeRequestStatusStrategy{Strategy}
+
+// GetResetFields returns the set of fields that get reset by the strategy
+// and should not be modified by the user.
+func (chargeRequestStatusStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return map[fieldpath.APIVersion]*fieldpath.Set{
+		"apps.onex.io/v1beta1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("spec"),
+			fieldpath.MakePathOrDie("status", "conditions"),
+		),
+	}
+}
+
+// PrepareForUpdate clears fields that are not allowed to be set by end users on update of status.
+func (chargeRequestStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newChargeRequest := obj.(*apps.ChargeRequest)
+	oldChargeRequest := old.(*apps.ChargeRequest)
+
+	// Updating /status should not modify spec
+	newChargeRequest.Spec = oldChargeRequest.Spec
+	newChargeRequest.DeletionTimestamp = nil
+
+	// don't allow the chargerequests/status endpoint to touch owner references since old kubelets corrupt them in a way
+	// that breaks garbage collection
+	newChargeRequest.OwnerReferences = oldChargeRequest.OwnerReferences
+}
+
+// ValidateUpdate is the default update validation for an end user updating status.
+func (chargeRequestStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateChargeRequestStatusUpdate(obj.(*apps.ChargeRequest), old.(*apps.ChargeRequest))
+}
+
+// WarningsOnUpdate returns warnings for the given update.
+func (chargeRequestStatusStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// Canonicalize normalizes the object after validation.
+func (chargeRequestStatusStrategy) Canonicalize(obj runtime.Object) {
+}
+
+// ToSelectableFields returns a field set that can be used for filter selection.
+func ToSelectableFields(obj *apps.ChargeRequest) fields.Set {
+	return generic.ObjectMetaFieldsSet(&obj.ObjectMeta, true)
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	c, ok := obj.(*apps.ChargeRequest)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a chargerequest")
+	}
+	return labels.Set(c.Labels), ToSelectableFields(c), nil
+}
+
+// Matcher is the filter used by the generic etcd backend to watch events
+// from etcd to clients of the apiserver only interested in specific labels/fields.
+func Matcher(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:       label,
+		Field:       field,
+		GetAttrs:    GetAttrs,
+		IndexFields: []string{"metadata.name"},
+	}
+}
+
+// NameTriggerFunc returns value metadata.namespace of given object.
+func NameTriggerFunc(obj runtime.Object) string {
+	return obj.(*apps.ChargeRequest).ObjectMeta.Name
+}
+
+// validatePasswordSealed rejects the chargerequests whose password could not be sealed.
+func validatePasswordSealed(chargeRequest *apps.ChargeRequest) field.ErrorList {
+	password := chargeRequest.Spec.Password
+	if password == "" || chargerequestutil.IsSealed(password) {
+		return nil
+	}
+
+	_, err := chargerequestutil.SealPassword(password)
+	return field.ErrorList{field.InternalError(field.NewPath("spec", "password"), fmt.Errorf("failed to seal password: %w", err))}
+}
+
+func dropChargeRequestDisabledFields(chargeRequest *apps.ChargeRequest, oldChargeRequest *apps.ChargeRequest) {
+}
@@ -0,0 +1,41 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package minerclass
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+)
+
+// BootstrapMinerClasses returns the minerclasses created by onex-apiserver on startup, they
+// are the miner types used by default for the genesis miners and the other miners.
+func BootstrapMinerClasses() []*v1beta1.MinerClass {
+	return []*v1beta1.MinerClass{
+		newMinerClass("S1.SMALL1", "50m", "128Mi", 7),
+		newMinerClass("S1.SMALL2", "100m", "256Mi", 5),
+		newMinerClass("M1.MEDIUM1", "150m", "512Mi", 3),
+		newMinerClass("M1.MEDIUM2", "250m", "1024Mi", 1),
+	}
+}
+
+func newMinerClass(name string, cpu, memory string, difficulty int32) *v1beta1.MinerClass {
+	return &v1beta1.MinerClass{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1beta1.MinerClassSpec{
+			Resources: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(cpu),
+					corev1.ResourceMemory: resource.MustParse(memory),
+				},
+			},
+			MiningDifficulty: difficulty,
+		},
+	}
+}
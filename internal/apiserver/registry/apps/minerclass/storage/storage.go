@@ -0,0 +1,64 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package storage
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/kubernetes/pkg/printers"
+	printerstorage "k8s.io/kubernetes/pkg/printers/storage"
+
+	"github.com/superproj/onex/internal/apiserver/registry/apps/minerclass"
+	printersinternal "github.com/superproj/onex/internal/pkg/printers/internalversion"
+	"github.com/superproj/onex/pkg/apis/apps"
+)
+
+// REST implements a RESTStorage for minerclasses.
+type REST struct {
+	*genericregistry.Store
+}
+
+// NewREST returns a RESTStorage object that will work against minerclasses.
+func NewREST(optsGetter generic.RESTOptionsGetter) (*REST, error) {
+	store := &genericregistry.Store{
+		NewFunc:       func() runtime.Object { return &apps.MinerClass{} },
+		NewListFunc:   func() runtime.Object { return &apps.MinerClassList{} },
+		PredicateFunc: minerclass.Matcher,
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return obj.(*apps.MinerClass).Name, nil
+		},
+		DefaultQualifiedResource:  apps.Resource("minerclasses"),
+		SingularQualifiedResource: apps.Resource("minerclass"),
+
+		CreateStrategy: minerclass.Strategy,
+		UpdateStrategy: minerclass.Strategy,
+		DeleteStrategy: minerclass.Strategy,
+
+		TableConvertor: printerstorage.TableConvertor{TableGenerator: printers.NewTableGenerator().With(printersinternal.AddHandlers)},
+	}
+	options := &generic.StoreOptions{
+		RESTOptions: optsGetter,
+		AttrFunc:    minerclass.GetAttrs,
+		TriggerFunc: map[string]storage.IndexerFunc{"metadata.name": minerclass.NameTriggerFunc},
+	}
+	if err := store.CompleteWithOptions(options); err != nil {
+		return nil, err
+	}
+
+	return &REST{store}, nil
+}
+
+// Implement ShortNamesProvider.
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"mc"}
+}
@@ -0,0 +1,131 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+//nolint:gocritic
+package minerclass
+
+import (
+	"context"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"k8s.io/kubernetes/pkg/api/legacyscheme"
+
+	"github.com/superproj/onex/pkg/apis/apps"
+	"github.com/superproj/onex/pkg/apis/apps/validation"
+)
+
+// minerClassStrategy implements behavior for MinerClass objects.
+type minerClassStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating MinerClass
+// objects via the REST API.
+var Strategy = minerClassStrategy{legacyscheme.Scheme, names.SimpleNameGenerator}
+
+var (
+	// Strategy should implement rest.RESTCreateStrategy.
+	_ rest.RESTCreateStrategy = Strategy
+	// Strategy should implement rest.RESTUpdateStrategy.
+	_ rest.RESTUpdateStrategy = Strategy
+)
+
+// NamespaceScoped is false for minerclasses, they are shared by all the miners.
+func (minerClassStrategy) NamespaceScoped() bool {
+	return false
+}
+
+// PrepareForCreate clears fields that are not allowed to be set by end users on creation.
+func (minerClassStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	mc := obj.(*apps.MinerClass)
+	mc.Generation = 1
+}
+
+// Validate validates a new minerclass.
+func (minerClassStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return validation.ValidateMinerClass(obj.(*apps.MinerClass))
+}
+
+// WarningsOnCreate returns warnings for the creation of the given object.
+func (minerClassStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+// Canonicalize normalizes the object after validation.
+func (minerClassStrategy) Canonicalize(obj runtime.Object) {
+}
+
+// AllowCreateOnUpdate is false for minerclasses.
+func (minerClassStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// PrepareForUpdate clears fields that are not allowed to be set by end users on update.
+func (minerClassStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newMinerClass := obj.(*apps.MinerClass)
+	oldMinerClass := old.(*apps.MinerClass)
+
+	// Any changes to the spec increment the generation number.
+	// See metav1.ObjectMeta description for more information on Generation.
+	if !apiequality.Semantic.DeepEqual(oldMinerClass.Spec, newMinerClass.Spec) {
+		newMinerClass.Generation = oldMinerClass.Generation + 1
+	}
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (minerClassStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateMinerClassUpdate(obj.(*apps.MinerClass), old.(*apps.MinerClass))
+}
+
+// WarningsOnUpdate returns warnings for the given update.
+func (minerClassStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// AllowUnconditionalUpdate is the default update policy for minerclass objects.
+func (minerClassStrategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+// ToSelectableFields returns a field set that can be used for filter selection.
+func ToSelectableFields(obj *apps.MinerClass) fields.Set {
+	return generic.ObjectMetaFieldsSet(&obj.ObjectMeta, false)
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	mc, ok := obj.(*apps.MinerClass)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a minerclass")
+	}
+	return labels.Set(mc.Labels), ToSelectableFields(mc), nil
+}
+
+// Matcher is the filter used by the generic etcd backend to watch events
+// from etcd to clients of the apiserver only interested in specific labels/fields.
+func Matcher(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:       label,
+		Field:       field,
+		GetAttrs:    GetAttrs,
+		IndexFields: []string{"metadata.name"},
+	}
+}
+
+// NameTriggerFunc returns value metadata.name of given object.
+func NameTriggerFunc(obj runtime.Object) string {
+	return obj.(*apps.MinerClass).ObjectMeta.Name
+}
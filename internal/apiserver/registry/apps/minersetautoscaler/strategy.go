@@ -0,0 +1,217 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+//nolint:gocritic
+package minersetautoscaler
+
+import (
+	"context"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"k8s.io/kubernetes/pkg/api/legacyscheme"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+
+	"github.com/superproj/onex/pkg/apis/apps"
+	"github.com/superproj/onex/pkg/apis/apps/validation"
+)
+
+// minerSetAutoscalerStrategy implements behavior for MinerSetAutoscaler objects.
+type minerSetAutoscalerStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating MinerSetAutoscaler
+// objects via the REST API.
+var Strategy = minerSetAutoscalerStrategy{legacyscheme.Scheme, names.SimpleNameGenerator}
+
+var (
+	// Make sure we correctly implement the interface.
+	_ = rest.GarbageCollectionDeleteStrategy(Strategy)
+	// Strategy should implement rest.RESTCreateStrategy.
+	_ rest.RESTCreateStrategy = Strategy
+	// Strategy should implement rest.RESTUpdateStrategy.
+	_ rest.RESTUpdateStrategy = Strategy
+)
+
+// DefaultGarbageCollectionPolicy returns DeleteDependents for all currently served versions.
+func (minerSetAutoscalerStrategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.GarbageCollectionPolicy {
+	return rest.DeleteDependents
+}
+
+// NamespaceScoped is true for minersetautoscalers.
+func (minerSetAutoscalerStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// GetResetFields returns the set of fields that get reset by the strategy
+// and should not be modified by the user.
+func (minerSetAutoscalerStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	fields := map[fieldpath.APIVersion]*fieldpath.Set{
+		"apps.onex.io/v1beta1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("status"),
+		),
+	}
+
+	return fields
+}
+
+// PrepareForCreate clears fields that are not allowed to be set by end users on creation.
+func (minerSetAutoscalerStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	autoscaler := obj.(*apps.MinerSetAutoscaler)
+	autoscaler.Status = apps.MinerSetAutoscalerStatus{}
+	autoscaler.Generation = 1
+
+	dropMinerSetAutoscalerDisabledFields(autoscaler, nil)
+
+	// Be explicit that users cannot create pre-provisioned minersetautoscalers.
+	autoscaler.Status.Conditions = []apps.Condition{}
+}
+
+// Validate validates a new minersetautoscaler.
+func (minerSetAutoscalerStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	autoscaler := obj.(*apps.MinerSetAutoscaler)
+	return validation.ValidateMinerSetAutoscaler(autoscaler)
+}
+
+// WarningsOnCreate returns warnings for the creation of the given object.
+func (minerSetAutoscalerStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+// Canonicalize normalizes the object after validation.
+func (minerSetAutoscalerStrategy) Canonicalize(obj runtime.Object) {
+}
+
+// AllowCreateOnUpdate is false for minersetautoscalers.
+func (minerSetAutoscalerStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// PrepareForUpdate clears fields that are not allowed to be set by end users on update.
+func (minerSetAutoscalerStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newAutoscaler := obj.(*apps.MinerSetAutoscaler)
+	oldAutoscaler := old.(*apps.MinerSetAutoscaler)
+	// Update is not allowed to set status
+	newAutoscaler.Status = oldAutoscaler.Status
+
+	dropMinerSetAutoscalerDisabledFields(newAutoscaler, oldAutoscaler)
+
+	// Any changes to the spec increment the generation number, any changes to the
+	// status should reflect the generation number of the corresponding object.
+	// See metav1.ObjectMeta description for more information on Generation.
+	if !apiequality.Semantic.DeepEqual(oldAutoscaler.Spec, newAutoscaler.Spec) {
+		newAutoscaler.Generation = oldAutoscaler.Generation + 1
+	}
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (minerSetAutoscalerStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateMinerSetAutoscalerUpdate(obj.(*apps.MinerSetAutoscaler), old.(*apps.MinerSetAutoscaler))
+}
+
+// WarningsOnUpdate returns warnings for the given update.
+func (minerSetAutoscalerStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// If AllowUnconditionalUpdate() is true and the object specified by
+// the user does not have a resource version, then generic Update()
+// populates it with the latest version. Else, it checks that the
+// version specified by the user matches the version of latest etcd
+// object.
+func (minerSetAutoscalerStrategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+// Storage strategy for the Status subresource.
+type minerSetAutoscalerStatusStrategy struct {
+	minerSetAutoscalerStrategy
+}
+
+// StatusStrategy is the default logic invoked when updating object status.
+var StatusStrategy = minerSetAutoscalerStatusStrategy{Strategy}
+
+// GetResetFields returns the set of fields that get reset by the strategy
+// and should not be modified by the user.
+func (minerSetAutoscalerStatusStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return map[fieldpath.APIVersion]*fieldpath.Set{
+		"apps.onex.io/v1beta1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("spec"),
+			fieldpath.MakePathOrDie("status", "conditions"),
+		),
+	}
+}
+
+// PrepareForUpdate clears fields that are not allowed to be set by end users on update of status.
+func (minerSetAutoscalerStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newAutoscaler := obj.(*apps.MinerSetAutoscaler)
+	oldAutoscaler := old.(*apps.MinerSetAutoscaler)
+
+	// Updating /status should not modify spec
+	newAutoscaler.Spec = oldAutoscaler.Spec
+	newAutoscaler.DeletionTimestamp = nil
+
+	// don't allow the minersetautoscalers/status endpoint to touch owner references since old kubelets corrupt them in a way
+	// that breaks garbage collection
+	newAutoscaler.OwnerReferences = oldAutoscaler.OwnerReferences
+}
+
+// ValidateUpdate is the default update validation for an end user updating status.
+func (minerSetAutoscalerStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateMinerSetAutoscalerStatusUpdate(obj.(*apps.MinerSetAutoscaler), old.(*apps.MinerSetAutoscaler))
+}
+
+// WarningsOnUpdate returns warnings for the given update.
+func (minerSetAutoscalerStatusStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// Canonicalize normalizes the object after validation.
+func (minerSetAutoscalerStatusStrategy) Canonicalize(obj runtime.Object) {
+}
+
+// ToSelectableFields returns a field set that can be used for filter selection.
+func ToSelectableFields(obj *apps.MinerSetAutoscaler) fields.Set {
+	return generic.ObjectMetaFieldsSet(&obj.ObjectMeta, true)
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	c, ok := obj.(*apps.MinerSetAutoscaler)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a minersetautoscaler")
+	}
+	return labels.Set(c.Labels), ToSelectableFields(c), nil
+}
+
+// Matcher is the filter used by the generic etcd backend to watch events
+// from etcd to clients of the apiserver only interested in specific labels/fields.
+func Matcher(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:       label,
+		Field:       field,
+		GetAttrs:    GetAttrs,
+		IndexFields: []string{"metadata.name"},
+	}
+}
+
+// NameTriggerFunc returns value metadata.namespace of given object.
+func NameTriggerFunc(obj runtime.Object) string {
+	return obj.(*apps.MinerSetAutoscaler).ObjectMeta.Name
+}
+
+func dropMinerSetAutoscalerDisabledFields(autoscaler *apps.MinerSetAutoscaler, oldAutoscaler *apps.MinerSetAutoscaler) {
+}
@@ -7,27 +7,45 @@
 package rest
 
 import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apiserver/pkg/registry/generic"
 	"k8s.io/apiserver/pkg/registry/rest"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	serverstorage "k8s.io/apiserver/pkg/server/storage"
+	"k8s.io/klog/v2"
 	"k8s.io/kubernetes/pkg/api/legacyscheme"
 
 	"github.com/superproj/onex/internal/controlplane/storage"
 	serializerutil "github.com/superproj/onex/internal/pkg/util/serializer"
 	chainstore "github.com/superproj/onex/internal/apiserver/registry/apps/chain/storage"
+	chargerequeststore "github.com/superproj/onex/internal/apiserver/registry/apps/chargerequest/storage"
 	minerstore "github.com/superproj/onex/internal/apiserver/registry/apps/miner/storage"
+	"github.com/superproj/onex/internal/apiserver/registry/apps/minerclass"
+	minerclassstore "github.com/superproj/onex/internal/apiserver/registry/apps/minerclass/storage"
 	minersetstore "github.com/superproj/onex/internal/apiserver/registry/apps/minerset/storage"
+	minersetautoscalerstore "github.com/superproj/onex/internal/apiserver/registry/apps/minersetautoscaler/storage"
 	"github.com/superproj/onex/pkg/apis/apps"
 	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+	"github.com/superproj/onex/pkg/generated/clientset/versioned"
 )
 
+// PostStartHookName is the name of the post-start-hook provided by apps storage.
+const PostStartHookName = "apps-bootstrap-minerclasses"
+
 // RESTStorageProvider is a struct for apps REST storage.
 type RESTStorageProvider struct{}
 
 // Implement RESTStorageProvider.
 var _ storage.RESTStorageProvider = &RESTStorageProvider{}
 
+var _ genericapiserver.PostStartHookProvider = RESTStorageProvider{}
+
 // NewRESTStorage returns APIGroupInfo object.
 func (p RESTStorageProvider) NewRESTStorage(
 	apiResourceConfigSource serverstorage.APIResourceConfigSource,
@@ -66,6 +84,17 @@ func (p RESTStorageProvider) v1beta1Storage(
 		storage[resource+"/status"] = chainStorage.Status
 	}
 
+	// chargerequests
+	if resource := "chargerequests"; apiResourceConfigSource.ResourceEnabled(v1beta1.SchemeGroupVersion.WithResource(resource)) {
+		chargeRequestStorage, err := chargerequeststore.NewStorage(restOptionsGetter)
+		if err != nil {
+			return storage, err
+		}
+
+		storage[resource] = chargeRequestStorage.ChargeRequest
+		storage[resource+"/status"] = chargeRequestStorage.Status
+	}
+
 	// miners
 	if resource := "miners"; apiResourceConfigSource.ResourceEnabled(v1beta1.SchemeGroupVersion.WithResource(resource)) {
 		minerStorage, err := minerstore.NewStorage(restOptionsGetter)
@@ -77,6 +106,16 @@ func (p RESTStorageProvider) v1beta1Storage(
 		storage[resource+"/status"] = minerStorage.Status
 	}
 
+	// minerclasses
+	if resource := "minerclasses"; apiResourceConfigSource.ResourceEnabled(v1beta1.SchemeGroupVersion.WithResource(resource)) {
+		minerClassStorage, err := minerclassstore.NewREST(restOptionsGetter)
+		if err != nil {
+			return storage, err
+		}
+
+		storage[resource] = minerClassStorage
+	}
+
 	// minersets
 	if resource := "minersets"; apiResourceConfigSource.ResourceEnabled(v1beta1.SchemeGroupVersion.WithResource(resource)) {
 		minerSetStorage, err := minersetstore.NewStorage(restOptionsGetter)
@@ -89,6 +128,17 @@ func (p RESTStorageProvider) v1beta1Storage(
 		storage[resource+"/scale"] = minerSetStorage.Scale
 	}
 
+	// minersetautoscalers
+	if resource := "minersetautoscalers"; apiResourceConfigSource.ResourceEnabled(v1beta1.SchemeGroupVersion.WithResource(resource)) {
+		minerSetAutoscalerStorage, err := minersetautoscalerstore.NewStorage(restOptionsGetter)
+		if err != nil {
+			return storage, err
+		}
+
+		storage[resource] = minerSetAutoscalerStorage.MinerSetAutoscaler
+		storage[resource+"/status"] = minerSetAutoscalerStorage.Status
+	}
+
 	return storage, nil
 }
 
@@ -96,3 +146,40 @@ func (p RESTStorageProvider) v1beta1Storage(
 func (p RESTStorageProvider) GroupName() string {
 	return apps.GroupName
 }
+
+// PostStartHook returns the hook func that creates the bootstrap minerclasses.
+func (p RESTStorageProvider) PostStartHook() (string, genericapiserver.PostStartHookFunc, error) {
+	return PostStartHookName, addBootstrapMinerClasses, nil
+}
+
+// addBootstrapMinerClasses creates the bootstrap minerclasses which do not exist. The existing
+// minerclasses are never updated, so that they can be customized.
+func addBootstrapMinerClasses(hookContext genericapiserver.PostStartHookContext) error {
+	client, err := versioned.NewForConfig(hookContext.LoopbackClientConfig)
+	if err != nil {
+		return fmt.Errorf("unable to initialize client: %w", err)
+	}
+
+	return wait.PollUntilContextTimeout(context.Background(), 1*time.Second, 30*time.Second, true,
+		func(ctx context.Context) (bool, error) {
+			for _, mc := range minerclass.BootstrapMinerClasses() {
+				_, err := client.AppsV1beta1().MinerClasses().Get(ctx, mc.Name, metav1.GetOptions{})
+				if err == nil {
+					continue
+				}
+				if !apierrors.IsNotFound(err) {
+					klog.ErrorS(err, "Unable to get minerclass", "minerclass", mc.Name)
+					return false, nil
+				}
+
+				if _, err := client.AppsV1beta1().MinerClasses().Create(ctx, mc, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+					klog.ErrorS(err, "Unable to create minerclass", "minerclass", mc.Name)
+					return false, nil
+				}
+				klog.InfoS("Created minerclass", "minerclass", mc.Name)
+			}
+
+			klog.InfoS("All bootstrap minerclasses are created successfully")
+			return true, nil
+		})
+}
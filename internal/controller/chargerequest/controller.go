@@ -0,0 +1,342 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package chargerequest
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/superproj/onex/internal/pkg/util/annotations"
+	chargerequestutil "github.com/superproj/onex/internal/pkg/util/chargerequest"
+	"github.com/superproj/onex/internal/pkg/util/conditions"
+	coreutil "github.com/superproj/onex/internal/pkg/util/core"
+	logutil "github.com/superproj/onex/internal/pkg/util/log"
+	minerutil "github.com/superproj/onex/internal/pkg/util/miner"
+	"github.com/superproj/onex/internal/pkg/util/patch"
+	"github.com/superproj/onex/internal/pkg/util/predicates"
+	"github.com/superproj/onex/internal/toyblc/blc"
+	"github.com/superproj/onex/internal/toyblc/defaults"
+	v1 "github.com/superproj/onex/pkg/api/toyblc/v1"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+	"github.com/superproj/onex/pkg/record"
+)
+
+const controllerName = "controller-manager.chargerequest"
+
+// pollPeriod is the interval at which the chain is polled for the confirmations of
+// the submitted transactions.
+var pollPeriod = 10 * time.Second
+
+// Reconciler reconciles a ChargeRequest object.
+type Reconciler struct {
+	client client.Client
+
+	// WatchFilterValue is the label value used to filter events prior to reconciliation.
+	WatchFilterValue string
+
+	// chainURL returns the url of the http api of the genesis miner of the chain.
+	chainURL func(ch *v1beta1.Chain) string
+
+	// senders serializes the submissions of the charges paid from the same account, so
+	// that concurrent reconciliations do not sign different transactions with the same nonce.
+	senders sync.Map
+	// submitted keeps the transaction submitted for a charge request until it is recorded
+	// in the status, so that it is not signed again with the next nonce when the status
+	// update is lost.
+	submitted sync.Map
+}
+
+func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	builder := ctrl.NewControllerManagedBy(mgr).
+		For(&v1beta1.ChargeRequest{}).
+		WithOptions(options).
+		Named(controllerName).
+		WithEventFilter(predicates.ResourceNotPausedAndHasFilterLabel(ctrl.LoggerFrom(ctx), r.WatchFilterValue))
+
+	r.client = mgr.GetClient()
+	if r.chainURL == nil {
+		r.chainURL = genesisMinerURL
+	}
+
+	return builder.Complete(r)
+}
+
+// Reconcile submits the charge of a ChargeRequest object to its chain, and tracks the
+// confirmations of the transaction until the charge is settled.
+func (r *Reconciler) Reconcile(ctx context.Context, rq ctrl.Request) (_ ctrl.Result, reterr error) {
+	// Fetch the ChargeRequest instance
+	cr := &v1beta1.ChargeRequest{}
+	if err := r.client.Get(ctx, rq.NamespacedName, cr); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	ctx, log := logutil.AddOwners(ctx, cr)
+	log.V(4).Info("Reconcile chargerequest")
+
+	// Return early if the object is paused.
+	if annotations.IsPaused(cr) {
+		log.Info("Reconciliation is paused for this object")
+		return ctrl.Result{}, nil
+	}
+
+	// Nothing to do once the charge is settled or failed, and nothing to clean up on deletion.
+	if !cr.DeletionTimestamp.IsZero() || isFinished(cr) {
+		return ctrl.Result{}, nil
+	}
+
+	// Initialize the patch helper
+	helper, err := patch.NewHelper(cr, r.client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	defer func() {
+		// Always attempt to patch the object and status after each reconciliation.
+		if err := helper.Patch(ctx, cr, patch.WithOwnedConditions{Conditions: []v1beta1.ConditionType{
+			v1beta1.ChargeApproved,
+			v1beta1.ChargeSucceeded,
+			v1beta1.ChargeFailed,
+		}}); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	return r.reconcile(ctx, cr)
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, cr *v1beta1.ChargeRequest) (ctrl.Result, error) {
+	ch, err := coreutil.GetChainByName(ctx, r.client, metav1.NamespaceSystem, cr.Spec.ChainName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		conditions.MarkFalse(cr, v1beta1.ChargeSucceeded, v1beta1.WaitingForChainReason, v1beta1.ConditionSeverityInfo,
+			"Chain %s does not exist", cr.Spec.ChainName)
+		return ctrl.Result{RequeueAfter: pollPeriod}, nil
+	}
+	if ch.Status.MinerRef == nil {
+		conditions.MarkFalse(cr, v1beta1.ChargeSucceeded, v1beta1.WaitingForChainReason, v1beta1.ConditionSeverityInfo,
+			"Chain %s has no genesis miner yet", cr.Spec.ChainName)
+		return ctrl.Result{RequeueAfter: pollPeriod}, nil
+	}
+
+	cli := newToyblcClient(r.chainURL(ch))
+	if cr.Status.TransactionID == "" {
+		return r.submit(ctx, cr, ch, cli)
+	}
+	return r.track(ctx, cr, cli)
+}
+
+// submit signs the charge with the key derived from the password and salt, and submits it to the chain.
+func (r *Reconciler) submit(ctx context.Context, cr *v1beta1.ChargeRequest, ch *v1beta1.Chain, cli *toyblcClient) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	password, err := chargerequestutil.OpenPassword(cr.Spec.Password)
+	if errors.Is(err, chargerequestutil.ErrNoKey) {
+		// The controller is misconfigured, retry once it is fixed.
+		return ctrl.Result{}, err
+	}
+	if err != nil {
+		return r.fail(cr, v1beta1.InvalidPasswordReason, "Failed to open the password: %v", err), nil
+	}
+
+	salt, err := hex.DecodeString(cr.Spec.Salt)
+	if err != nil {
+		return r.fail(cr, v1beta1.InvalidPasswordReason, "Failed to decode the salt: %v", err), nil
+	}
+	key, err := blc.KeyFromPassword(password, salt)
+	if err != nil {
+		return r.fail(cr, v1beta1.InvalidPasswordReason, "Failed to derive the signing key: %v", err), nil
+	}
+	if address := blc.AddressFromPublicKey(key.Public().(ed25519.PublicKey)); address != cr.Spec.From {
+		conditions.MarkFalse(cr, v1beta1.ChargeApproved, v1beta1.InvalidPasswordReason, v1beta1.ConditionSeverityError,
+			"The password does not own account %s", cr.Spec.From)
+		return r.fail(cr, v1beta1.InvalidPasswordReason, "The password does not own account %s", cr.Spec.From), nil
+	}
+	conditions.MarkTrue(cr, v1beta1.ChargeApproved)
+
+	mu, _ := r.senders.LoadOrStore(cr.Spec.From, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	defer mu.(*sync.Mutex).Unlock()
+
+	stats, err := cli.Stats(ctx)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get stats of chain %s: %w", ch.Name, err)
+	}
+	to := ptr.Deref(ch.Spec.BootstrapAccount, defaults.GenesisAddress)
+	tx, err := r.submitTransaction(ctx, cr, cli, key, to)
+	if err != nil {
+		if v1.IsTransactionInvalid(err) {
+			return r.fail(cr, v1beta1.TransactionRejectedReason, "The chain rejected the transaction: %v", err), nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Submitted charge transaction", "transaction", tx.ID, "nonce", tx.Nonce)
+	record.Eventf(cr, "SuccessfulSubmit", "Submitted transaction %s paying %d to %s", tx.ID, cr.Spec.Amount, to)
+
+	// The password is not needed anymore.
+	cr.Spec.Password = ""
+	cr.Status.TransactionID = tx.ID
+	cr.Status.SubmittedHeight = stats.Height
+	conditions.MarkFalse(cr, v1beta1.ChargeSucceeded, v1beta1.WaitingForConfirmationsReason, v1beta1.ConditionSeverityInfo,
+		"Transaction %s is pending", tx.ID)
+
+	return ctrl.Result{RequeueAfter: pollPeriod}, nil
+}
+
+// submitTransaction signs the transaction of the charge with the next nonce of the account and
+// submits it. The transaction submitted by a previous reconciliation whose status update was
+// lost is returned instead, so that the charge is not paid twice.
+func (r *Reconciler) submitTransaction(
+	ctx context.Context,
+	cr *v1beta1.ChargeRequest,
+	cli *toyblcClient,
+	key ed25519.PrivateKey,
+	to string,
+) (*blc.Transaction, error) {
+	if tx, ok := r.submitted.Load(cr.UID); ok {
+		return tx.(*blc.Transaction), nil
+	}
+
+	nonce, err := nextNonce(ctx, cli, cr.Spec.From)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := blc.NewTransaction(key, to, uint64(cr.Spec.Amount), nonce)
+	if err := cli.SubmitTransaction(ctx, tx); err != nil {
+		if v1.IsTransactionInvalid(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to submit transaction: %w", err)
+	}
+
+	r.submitted.Store(cr.UID, tx)
+	return tx, nil
+}
+
+// nextNonce returns the nonce of the next transaction of the account, which follows both the
+// transactions included in the chain and the ones still pending in the mempool.
+func nextNonce(ctx context.Context, cli *toyblcClient, address string) (uint64, error) {
+	// The mempool is read before the account, so that a transaction mined in between is
+	// counted in the nonce of the account.
+	pending, err := cli.Pending(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list pending transactions: %w", err)
+	}
+	account, err := cli.Account(ctx, address)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get account %s: %w", address, err)
+	}
+
+	nonce := account.Nonce
+	for _, tx := range pending {
+		if tx.From == address && tx.Nonce > nonce {
+			nonce = tx.Nonce
+		}
+	}
+
+	return nonce + 1, nil
+}
+
+// track updates the confirmations of the submitted transaction, and settles the charge
+// once it is confirmed by enough blocks.
+func (r *Reconciler) track(ctx context.Context, cr *v1beta1.ChargeRequest, cli *toyblcClient) (ctrl.Result, error) {
+	txID := cr.Status.TransactionID
+	// The transaction is recorded in the status, it is never submitted again.
+	r.submitted.Delete(cr.UID)
+
+	// The mempool is read before the blocks, so that a transaction mined in between is
+	// found in the blocks.
+	txs, err := cli.Pending(ctx)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list pending transactions: %w", err)
+	}
+	pending := slices.ContainsFunc(txs, func(tx *blc.Transaction) bool { return tx.ID == txID })
+	stats, err := cli.Stats(ctx)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get stats of chain %s: %w", cr.Spec.ChainName, err)
+	}
+	block, err := cli.FindTransaction(ctx, txID, cr.Status.SubmittedHeight)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to look for transaction %s: %w", txID, err)
+	}
+
+	if block == nil {
+		cr.Status.BlockHeight, cr.Status.Confirmations = 0, 0
+		if !pending {
+			return r.fail(cr, v1beta1.TransactionDroppedReason, "Transaction %s was dropped by the chain", txID), nil
+		}
+		conditions.MarkFalse(cr, v1beta1.ChargeSucceeded, v1beta1.WaitingForConfirmationsReason, v1beta1.ConditionSeverityInfo,
+			"Transaction %s is pending", txID)
+		return ctrl.Result{RequeueAfter: pollPeriod}, nil
+	}
+
+	required := ptr.Deref(cr.Spec.Confirmations, 3)
+	cr.Status.BlockHeight = block.Index
+	cr.Status.Confirmations = confirmations(stats.Height, block.Index)
+	if cr.Status.Confirmations < required {
+		conditions.MarkFalse(cr, v1beta1.ChargeSucceeded, v1beta1.WaitingForConfirmationsReason, v1beta1.ConditionSeverityInfo,
+			"Transaction %s has %d/%d confirmations", txID, cr.Status.Confirmations, required)
+		return ctrl.Result{RequeueAfter: pollPeriod}, nil
+	}
+
+	ctrl.LoggerFrom(ctx).Info("Charge settled", "transaction", txID, "block", block.Index)
+	record.Eventf(cr, "SuccessfulSettle", "Transaction %s was confirmed by %d blocks", txID, cr.Status.Confirmations)
+	conditions.MarkTrue(cr, v1beta1.ChargeSucceeded)
+
+	return ctrl.Result{}, nil
+}
+
+// fail marks the charge request as failed for good. The password is cleared since the
+// charge is never retried.
+func (r *Reconciler) fail(cr *v1beta1.ChargeRequest, reason, messageFormat string, messageArgs ...any) ctrl.Result {
+	cr.Spec.Password = ""
+	conditions.Set(cr, &v1beta1.Condition{
+		Type:    v1beta1.ChargeFailed,
+		Status:  corev1.ConditionTrue,
+		Reason:  reason,
+		Message: fmt.Sprintf(messageFormat, messageArgs...),
+	})
+	record.Warnf(cr, "FailedCharge", messageFormat, messageArgs...)
+	return ctrl.Result{}
+}
+
+// isFinished reports whether the charge request succeeded or failed.
+func isFinished(cr *v1beta1.ChargeRequest) bool {
+	return conditions.IsTrue(cr, v1beta1.ChargeSucceeded) || conditions.IsTrue(cr, v1beta1.ChargeFailed)
+}
+
+// confirmations returns the number of blocks, including the block at the given index,
+// from that block to the tip of the chain.
+func confirmations(height, index int64) int32 {
+	if index > height {
+		return 0
+	}
+	return int32(height - index + 1)
+}
+
+// genesisMinerURL returns the url of the http api of the genesis miner of the chain.
+func genesisMinerURL(ch *v1beta1.Chain) string {
+	return fmt.Sprintf("http://%s:8080", minerutil.GenesisDNSServiceNameFromMiner(ch.Status.MinerRef.Name))
+}
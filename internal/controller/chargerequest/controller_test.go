@@ -0,0 +1,269 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package chargerequest
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	chargerequestutil "github.com/superproj/onex/internal/pkg/util/chargerequest"
+	"github.com/superproj/onex/internal/pkg/util/conditions"
+	"github.com/superproj/onex/internal/toyblc/blc"
+	"github.com/superproj/onex/internal/toyblc/defaults"
+	v1 "github.com/superproj/onex/pkg/api/toyblc/v1"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+)
+
+const (
+	testPassword = "onex(#)666"
+	testSalt     = "6f6e65782d746573742d73616c742131"
+)
+
+// testAddress returns the address of the account derived from the test password and salt.
+func testAddress(t *testing.T) string {
+	t.Helper()
+
+	salt, _ := hex.DecodeString(testSalt)
+	address, err := blc.AddressFromPassword(testPassword, salt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return address
+}
+
+// fakeChain serves the subset of the toyblc http api used by the controller.
+type fakeChain struct {
+	mu      sync.Mutex
+	nonces  map[string]uint64
+	pending []*blc.Transaction
+	blocks  []*blc.Block
+}
+
+func newFakeChain() *fakeChain {
+	return &fakeChain{nonces: map[string]uint64{}, blocks: []*blc.Block{{Index: 0}}}
+}
+
+// mine adds a block containing the pending transactions.
+func (c *fakeChain) mine() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, tx := range c.pending {
+		c.nonces[tx.From]++
+	}
+	c.blocks = append(c.blocks, &blc.Block{Index: int64(len(c.blocks)), Transactions: c.pending})
+	c.pending = nil
+}
+
+func (c *fakeChain) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var resp any
+	switch {
+	case req.URL.Path == "/v1/blocks/stats":
+		resp = map[string]int64{"height": int64(len(c.blocks) - 1)}
+	case req.URL.Path == "/v1/blocks":
+		offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+		blocks := []*blc.Block{}
+		for i := len(c.blocks) - 1 - offset; i >= 0; i-- {
+			blocks = append(blocks, c.blocks[i])
+		}
+		resp = listBlocksResponse{TotalCount: int64(len(c.blocks)), Blocks: blocks}
+	case req.URL.Path == "/v1/transactions" && req.Method == http.MethodGet:
+		resp = c.pending
+	case req.URL.Path == "/v1/transactions":
+		var r v1.CreateTransactionRequest
+		_ = json.NewDecoder(req.Body).Decode(&r)
+		// The nonce follows the confirmed and the pending transactions of the sender.
+		nonce := c.nonces[r.From]
+		for _, tx := range c.pending {
+			if tx.From == r.From {
+				nonce++
+			}
+		}
+		if r.Nonce != nonce+1 {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(v1.ErrorTransactionInvalid("invalid nonce"))
+			return
+		}
+		tx := &blc.Transaction{From: r.From, To: r.To, Amount: r.Amount, Nonce: r.Nonce, PublicKey: r.PublicKey, Signature: r.Signature}
+		tx.ID = tx.CalID()
+		c.pending = append(c.pending, tx)
+		resp = tx
+	default:
+		address := req.URL.Path[len("/v1/accounts/"):]
+		resp = blc.Account{Address: address, Nonce: c.nonces[address]}
+	}
+
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func setupTest(t *testing.T, chain *fakeChain, from, password string) (*Reconciler, client.Client) {
+	t.Helper()
+	t.Setenv("ONEX_CHARGE_REQUEST_KEY", "secret")
+
+	server := httptest.NewServer(chain)
+	t.Cleanup(server.Close)
+
+	sealed, err := chargerequestutil.SealPassword(password)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scheme := runtime.NewScheme()
+	_ = v1beta1.AddToScheme(scheme)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&v1beta1.ChargeRequest{}).
+		WithObjects(
+			&v1beta1.Chain{
+				ObjectMeta: metav1.ObjectMeta{Name: "chain", Namespace: metav1.NamespaceSystem},
+				Status:     v1beta1.ChainStatus{MinerRef: &v1beta1.LocalObjectReference{Name: "genesis"}},
+			},
+			&v1beta1.ChargeRequest{
+				ObjectMeta: metav1.ObjectMeta{Name: "charge", Namespace: metav1.NamespaceDefault},
+				Spec: v1beta1.ChargeRequestSpec{
+					From:          from,
+					Password:      sealed,
+					Salt:          testSalt,
+					ChainName:     "chain",
+					Amount:        10,
+					Confirmations: ptr.To[int32](2),
+				},
+			},
+		).Build()
+
+	r := &Reconciler{client: c, chainURL: func(*v1beta1.Chain) string { return server.URL }}
+	return r, c
+}
+
+func reconcileChargeRequest(g *WithT, r *Reconciler, c client.Client) *v1beta1.ChargeRequest {
+	key := client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "charge"}
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	cr := &v1beta1.ChargeRequest{}
+	g.Expect(c.Get(context.Background(), key, cr)).To(Succeed())
+	return cr
+}
+
+func TestReconcile(t *testing.T) {
+	g := NewWithT(t)
+	chain := newFakeChain()
+	r, c := setupTest(t, chain, testAddress(t), testPassword)
+
+	// The charge is submitted and the password cleared.
+	cr := reconcileChargeRequest(g, r, c)
+	g.Expect(cr.Status.TransactionID).NotTo(BeEmpty())
+	g.Expect(cr.Spec.Password).To(BeEmpty())
+	g.Expect(conditions.IsTrue(cr, v1beta1.ChargeApproved)).To(BeTrue())
+	g.Expect(conditions.GetReason(cr, v1beta1.ChargeSucceeded)).To(Equal(v1beta1.WaitingForConfirmationsReason))
+	g.Expect(chain.pending).To(HaveLen(1))
+	g.Expect(chain.pending[0].To).To(Equal(defaults.GenesisAddress))
+
+	// The transaction is included in a block, but not confirmed enough.
+	chain.mine()
+	cr = reconcileChargeRequest(g, r, c)
+	g.Expect(cr.Status.BlockHeight).To(Equal(int64(1)))
+	g.Expect(cr.Status.Confirmations).To(Equal(int32(1)))
+	g.Expect(conditions.IsTrue(cr, v1beta1.ChargeSucceeded)).To(BeFalse())
+
+	chain.mine()
+	cr = reconcileChargeRequest(g, r, c)
+	g.Expect(cr.Status.Confirmations).To(Equal(int32(2)))
+	g.Expect(conditions.IsTrue(cr, v1beta1.ChargeSucceeded)).To(BeTrue())
+	g.Expect(conditions.IsTrue(cr, v1beta1.ChargeFailed)).To(BeFalse())
+}
+
+func TestReconcileWrongPassword(t *testing.T) {
+	g := NewWithT(t)
+	chain := newFakeChain()
+	r, c := setupTest(t, chain, testAddress(t), "wrong")
+
+	cr := reconcileChargeRequest(g, r, c)
+	g.Expect(cr.Status.TransactionID).To(BeEmpty())
+	g.Expect(cr.Spec.Password).To(BeEmpty())
+	g.Expect(conditions.IsFalse(cr, v1beta1.ChargeApproved)).To(BeTrue())
+	g.Expect(conditions.IsTrue(cr, v1beta1.ChargeFailed)).To(BeTrue())
+	g.Expect(conditions.GetReason(cr, v1beta1.ChargeFailed)).To(Equal(v1beta1.InvalidPasswordReason))
+	g.Expect(chain.pending).To(BeEmpty())
+}
+
+func TestReconcileDroppedTransaction(t *testing.T) {
+	g := NewWithT(t)
+	chain := newFakeChain()
+	r, c := setupTest(t, chain, testAddress(t), testPassword)
+
+	cr := reconcileChargeRequest(g, r, c)
+	g.Expect(cr.Status.TransactionID).NotTo(BeEmpty())
+
+	// The transaction leaves the mempool without being mined.
+	chain.pending = nil
+	cr = reconcileChargeRequest(g, r, c)
+	g.Expect(conditions.IsTrue(cr, v1beta1.ChargeFailed)).To(BeTrue())
+	g.Expect(conditions.GetReason(cr, v1beta1.ChargeFailed)).To(Equal(v1beta1.TransactionDroppedReason))
+}
+
+func TestReconcilePendingNonce(t *testing.T) {
+	g := NewWithT(t)
+	chain := newFakeChain()
+	from := testAddress(t)
+	r, c := setupTest(t, chain, from, testPassword)
+
+	// Another transaction of the account is waiting in the mempool.
+	chain.pending = append(chain.pending, &blc.Transaction{ID: "other", From: from, Nonce: 1})
+
+	cr := reconcileChargeRequest(g, r, c)
+	g.Expect(conditions.IsTrue(cr, v1beta1.ChargeFailed)).To(BeFalse())
+	g.Expect(chain.pending).To(HaveLen(2))
+	g.Expect(chain.pending[1].ID).To(Equal(cr.Status.TransactionID))
+	g.Expect(chain.pending[1].Nonce).To(Equal(uint64(2)))
+}
+
+func TestReconcileLostStatusUpdate(t *testing.T) {
+	g := NewWithT(t)
+	chain := newFakeChain()
+	r, c := setupTest(t, chain, testAddress(t), testPassword)
+
+	cr := reconcileChargeRequest(g, r, c)
+	txID := cr.Status.TransactionID
+
+	// The status update of the submission is lost, the same transaction is tracked
+	// rather than another one paying the charge again.
+	cr.Status.TransactionID = ""
+	cr.Spec.Password, _ = chargerequestutil.SealPassword(testPassword)
+	g.Expect(c.Update(context.Background(), cr)).To(Succeed())
+	g.Expect(c.Status().Update(context.Background(), cr)).To(Succeed())
+
+	cr = reconcileChargeRequest(g, r, c)
+	g.Expect(cr.Status.TransactionID).To(Equal(txID))
+	g.Expect(chain.pending).To(HaveLen(1))
+}
+
+func TestConfirmations(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(confirmations(10, 10)).To(Equal(int32(1)))
+	g.Expect(confirmations(10, 8)).To(Equal(int32(3)))
+	g.Expect(confirmations(10, 11)).To(Equal(int32(0)))
+}
@@ -0,0 +1,133 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package chargerequest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-resty/resty/v2"
+
+	onexclient "github.com/superproj/onex/internal/pkg/client"
+	"github.com/superproj/onex/internal/toyblc/blc"
+	"github.com/superproj/onex/internal/toyblc/defaults"
+	v1 "github.com/superproj/onex/pkg/api/toyblc/v1"
+)
+
+// blocksPageSize is the number of blocks read at once when looking for a transaction.
+const blocksPageSize = 100
+
+// chainStats is the subset of the toyblc block statistics used by the controller.
+type chainStats struct {
+	Height int64 `json:"height"`
+}
+
+// listBlocksResponse is a page of blocks returned by toyblc.
+type listBlocksResponse struct {
+	TotalCount int64        `json:"totalCount"`
+	Blocks     []*blc.Block `json:"blocks"`
+}
+
+// toyblcClient talks to the http api of a toyblc miner.
+type toyblcClient struct {
+	url string
+}
+
+func newToyblcClient(url string) *toyblcClient {
+	return &toyblcClient{url: url}
+}
+
+func (c *toyblcClient) request(ctx context.Context) *resty.Request {
+	return onexclient.NewRequest(c.url).
+		SetContext(ctx).
+		SetBasicAuth("onex", defaults.Accounts["onex"])
+}
+
+// do sends the request and decodes the response into result. Errors returned by
+// toyblc are converted back to kratos errors, so that they can be checked with the
+// helpers of the toyblc api.
+func (c *toyblcClient) do(req *resty.Request, method, path string, result any) error {
+	resp, err := req.Execute(method, path)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		e := &kerrors.Error{}
+		if err := json.Unmarshal(resp.Body(), e); err != nil || e.Reason == "" {
+			return fmt.Errorf("%s %s: %s", method, path, resp.Status())
+		}
+		return e
+	}
+
+	return json.Unmarshal(resp.Body(), result)
+}
+
+// Stats returns the statistics of the chain.
+func (c *toyblcClient) Stats(ctx context.Context) (*chainStats, error) {
+	stats := &chainStats{}
+	return stats, c.do(c.request(ctx), http.MethodGet, "/v1/blocks/stats", stats)
+}
+
+// Account returns the state of the account at the tip of the chain.
+func (c *toyblcClient) Account(ctx context.Context, address string) (*blc.Account, error) {
+	account := &blc.Account{}
+	return account, c.do(c.request(ctx), http.MethodGet, "/v1/accounts/"+address, account)
+}
+
+// SubmitTransaction adds the transaction to the mempool of the miner.
+func (c *toyblcClient) SubmitTransaction(ctx context.Context, tx *blc.Transaction) error {
+	req := c.request(ctx).SetBody(&v1.CreateTransactionRequest{
+		From:      tx.From,
+		To:        tx.To,
+		Amount:    tx.Amount,
+		Nonce:     tx.Nonce,
+		PublicKey: tx.PublicKey,
+		Signature: tx.Signature,
+	})
+	return c.do(req, http.MethodPost, "/v1/transactions", &blc.Transaction{})
+}
+
+// Pending returns the transactions waiting in the mempool of the miner.
+func (c *toyblcClient) Pending(ctx context.Context) ([]*blc.Transaction, error) {
+	var txs []*blc.Transaction
+	return txs, c.do(c.request(ctx), http.MethodGet, "/v1/transactions", &txs)
+}
+
+// FindTransaction looks for the block containing the transaction, from the tip of the chain
+// down to the block following the given height. It returns nil if the transaction is not found.
+func (c *toyblcClient) FindTransaction(ctx context.Context, id string, after int64) (*blc.Block, error) {
+	for offset := int64(0); ; offset += blocksPageSize {
+		resp := &listBlocksResponse{}
+		req := c.request(ctx).SetQueryParams(map[string]string{
+			"offset": fmt.Sprint(offset),
+			"limit":  fmt.Sprint(blocksPageSize),
+			"desc":   "true",
+		})
+		if err := c.do(req, http.MethodGet, "/v1/blocks", resp); err != nil {
+			return nil, err
+		}
+
+		for _, block := range resp.Blocks {
+			if block.Index <= after {
+				return nil, nil
+			}
+			for _, tx := range block.Transactions {
+				if tx.ID == id {
+					return block, nil
+				}
+			}
+		}
+
+		if len(resp.Blocks) < blocksPageSize {
+			return nil, nil
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package scheme
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/superproj/onex/internal/controller/miner/apis/config"
+)
+
+func TestCodecsDecodeDeprecatedTypes(t *testing.T) {
+	data := []byte(`
+apiVersion: minercontroller.config.onex.io/v1beta1
+kind: MinerControllerConfiguration
+types:
+  S1.SMALL1:
+    cpu: 50m
+    memory: 1024Mi
+    miningDifficulty: 1
+  X1.CUSTOM:
+    cpu: "2"
+    memory: 4Gi
+    miningDifficulty: 9
+`)
+
+	// The strict decoder must still accept the deprecated field, and convert it to the internal type.
+	obj, _, err := Codecs.UniversalDecoder().Decode(data, nil, nil)
+	assert.Nil(t, err)
+
+	cfg, ok := obj.(*config.MinerControllerConfiguration)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]config.MinerProfile{
+		"S1.SMALL1": {CPU: resource.MustParse("50m"), Memory: resource.MustParse("1024Mi"), MiningDifficulty: 1},
+		"X1.CUSTOM": {CPU: resource.MustParse("2"), Memory: resource.MustParse("4Gi"), MiningDifficulty: 9},
+	}, cfg.Types)
+}
+
+func TestCodecsDecodeWithoutTypes(t *testing.T) {
+	data := []byte(`
+apiVersion: minercontroller.config.onex.io/v1beta1
+kind: MinerControllerConfiguration
+`)
+
+	obj, _, err := Codecs.UniversalDecoder().Decode(data, nil, nil)
+	assert.Nil(t, err)
+
+	// No miner types are defaulted any more, they are minerclasses.
+	assert.Empty(t, obj.(*config.MinerControllerConfiguration).Types)
+}
@@ -63,8 +63,15 @@ type MinerControllerConfiguration struct {
 	HealthzBindAddress string
 
 	// Types specifies the configuration of the cloud mining machine.
+	//
+	// Deprecated: Use MinerClass objects instead. The miner controller creates a MinerClass
+	// for every type which has none yet on startup, and this field will be removed.
 	Types map[string]MinerProfile
 
+	// Quota is the miner quota of the users. The autoscalers do not scale the minersets of a user
+	// beyond it. It should be the same as the quota of onex-gateway.
+	Quota MinerQuotaConfiguration
+
 	// Redis defines the configuration of redis client.
 	Redis genericconfig.RedisConfiguration
 
@@ -74,6 +81,18 @@ type MinerControllerConfiguration struct {
 	// Cloud *cloud.CloudOptions `json:"cloud,omitempty"`
 }
 
+// MinerQuotaConfiguration defines the miner quota of the users. A quota of 0 is unlimited.
+type MinerQuotaConfiguration struct {
+	// MaxMiners is the number of the miners a user can have, including the replicas of the minersets.
+	MaxMiners int32
+
+	// Users overrides the quotas of some users, keyed by the user ID.
+	Users map[string]int32
+}
+
+// MinerProfile is the machine configuration of a miner type.
+//
+// Deprecated: Use MinerClass objects instead.
 type MinerProfile struct {
 	CPU              resource.Quantity `json:"cpu,omitempty"`
 	Memory           resource.Quantity `json:"memory,omitempty"`
@@ -10,7 +10,6 @@ import (
 	"fmt"
 	"time"
 
-	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/clientcmd"
@@ -59,30 +58,5 @@ func SetDefaults_MinerControllerConfiguration(obj *MinerControllerConfiguration)
 		obj.Parallelism = 10
 	}
 
-	if len(obj.Types) == 0 {
-		obj.Types = map[string]MinerProfile{
-			"S1.SMALL1": {
-				CPU:              resource.MustParse("50m"),
-				Memory:           resource.MustParse("128Mi"),
-				MiningDifficulty: 7,
-			},
-			"S1.SMALL2": {
-				CPU:              resource.MustParse("100m"),
-				Memory:           resource.MustParse("256Mi"),
-				MiningDifficulty: 5,
-			},
-			"M1.MEDIUM1": {
-				CPU:              resource.MustParse("150m"),
-				Memory:           resource.MustParse("512Mi"),
-				MiningDifficulty: 3,
-			},
-			"M1.MEDIUM2": {
-				CPU:              resource.MustParse("250m"),
-				Memory:           resource.MustParse("1024Mi"),
-				MiningDifficulty: 1,
-			},
-		}
-	}
-
 	genericconfigv1beta1.RecommendedDefaultRedisConfiguration(&obj.Redis)
 }
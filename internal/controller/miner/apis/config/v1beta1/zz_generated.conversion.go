@@ -47,6 +47,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MinerQuotaConfiguration)(nil), (*config.MinerQuotaConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MinerQuotaConfiguration_To_config_MinerQuotaConfiguration(a.(*MinerQuotaConfiguration), b.(*config.MinerQuotaConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MinerQuotaConfiguration)(nil), (*MinerQuotaConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MinerQuotaConfiguration_To_v1beta1_MinerQuotaConfiguration(a.(*config.MinerQuotaConfiguration), b.(*MinerQuotaConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -65,6 +75,9 @@ func autoConvert_v1beta1_MinerControllerConfiguration_To_config_MinerControllerC
 	out.MetricsBindAddress = in.MetricsBindAddress
 	out.HealthzBindAddress = in.HealthzBindAddress
 	out.Types = *(*map[string]config.MinerProfile)(unsafe.Pointer(&in.Types))
+	if err := Convert_v1beta1_MinerQuotaConfiguration_To_config_MinerQuotaConfiguration(&in.Quota, &out.Quota, s); err != nil {
+		return err
+	}
 	if err := configv1beta1.Convert_v1beta1_RedisConfiguration_To_config_RedisConfiguration(&in.Redis, &out.Redis, s); err != nil {
 		return err
 	}
@@ -91,6 +104,9 @@ func autoConvert_config_MinerControllerConfiguration_To_v1beta1_MinerControllerC
 	out.MetricsBindAddress = in.MetricsBindAddress
 	out.HealthzBindAddress = in.HealthzBindAddress
 	out.Types = *(*map[string]MinerProfile)(unsafe.Pointer(&in.Types))
+	if err := Convert_config_MinerQuotaConfiguration_To_v1beta1_MinerQuotaConfiguration(&in.Quota, &out.Quota, s); err != nil {
+		return err
+	}
 	if err := configv1beta1.Convert_config_RedisConfiguration_To_v1beta1_RedisConfiguration(&in.Redis, &out.Redis, s); err != nil {
 		return err
 	}
@@ -125,3 +141,25 @@ func autoConvert_config_MinerProfile_To_v1beta1_MinerProfile(in *config.MinerPro
 func Convert_config_MinerProfile_To_v1beta1_MinerProfile(in *config.MinerProfile, out *MinerProfile, s conversion.Scope) error {
 	return autoConvert_config_MinerProfile_To_v1beta1_MinerProfile(in, out, s)
 }
+
+func autoConvert_v1beta1_MinerQuotaConfiguration_To_config_MinerQuotaConfiguration(in *MinerQuotaConfiguration, out *config.MinerQuotaConfiguration, s conversion.Scope) error {
+	out.MaxMiners = in.MaxMiners
+	out.Users = *(*map[string]int32)(unsafe.Pointer(&in.Users))
+	return nil
+}
+
+// Convert_v1beta1_MinerQuotaConfiguration_To_config_MinerQuotaConfiguration is an autogenerated conversion function.
+func Convert_v1beta1_MinerQuotaConfiguration_To_config_MinerQuotaConfiguration(in *MinerQuotaConfiguration, out *config.MinerQuotaConfiguration, s conversion.Scope) error {
+	return autoConvert_v1beta1_MinerQuotaConfiguration_To_config_MinerQuotaConfiguration(in, out, s)
+}
+
+func autoConvert_config_MinerQuotaConfiguration_To_v1beta1_MinerQuotaConfiguration(in *config.MinerQuotaConfiguration, out *MinerQuotaConfiguration, s conversion.Scope) error {
+	out.MaxMiners = in.MaxMiners
+	out.Users = *(*map[string]int32)(unsafe.Pointer(&in.Users))
+	return nil
+}
+
+// Convert_config_MinerQuotaConfiguration_To_v1beta1_MinerQuotaConfiguration is an autogenerated conversion function.
+func Convert_config_MinerQuotaConfiguration_To_v1beta1_MinerQuotaConfiguration(in *config.MinerQuotaConfiguration, out *MinerQuotaConfiguration, s conversion.Scope) error {
+	return autoConvert_config_MinerQuotaConfiguration_To_v1beta1_MinerQuotaConfiguration(in, out, s)
+}
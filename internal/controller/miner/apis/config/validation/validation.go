@@ -34,5 +34,15 @@ func Validate(cc *config.MinerControllerConfiguration) field.ErrorList {
 		allErrs = append(allErrs, validation.ValidateHostPort(cc.MetricsBindAddress, newPath.Child("metricsBindAddress"))...)
 	}
 
+	quotaPath := newPath.Child("quota")
+	if cc.Quota.MaxMiners < 0 {
+		allErrs = append(allErrs, field.Invalid(quotaPath.Child("maxMiners"), cc.Quota.MaxMiners, "must not be negative"))
+	}
+	for userID, maxMiners := range cc.Quota.Users {
+		if maxMiners < 0 {
+			allErrs = append(allErrs, field.Invalid(quotaPath.Child("users").Key(userID), maxMiners, "must not be negative"))
+		}
+	}
+
 	return allErrs
 }
@@ -34,6 +34,7 @@ func (in *MinerControllerConfiguration) DeepCopyInto(out *MinerControllerConfigu
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	in.Quota.DeepCopyInto(&out.Quota)
 	out.Redis = in.Redis
 	return
 }
@@ -73,3 +74,26 @@ func (in *MinerProfile) DeepCopy() *MinerProfile {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinerQuotaConfiguration) DeepCopyInto(out *MinerQuotaConfiguration) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinerQuotaConfiguration.
+func (in *MinerQuotaConfiguration) DeepCopy() *MinerQuotaConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(MinerQuotaConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -25,6 +25,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/superproj/onex/internal/controller/miner/apis/config"
@@ -98,6 +99,12 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 	r.client = mgr.GetClient()
 	r.ssaCache = ssa.NewCache()
 
+	if r.ComponentConfig != nil && len(r.ComponentConfig.Types) != 0 {
+		if err := mgr.Add(manager.RunnableFunc(r.migrateMinerTypes)); err != nil {
+			return fmt.Errorf("failed adding the miner types migration: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,65 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package miner
+
+import (
+	"context"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/superproj/onex/internal/controller/miner/apis/config"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+)
+
+// migrateMinerTypes creates a minerclass for every miner type of the deprecated Types
+// configuration which has none yet. The existing minerclasses are never updated, so
+// that they can be customized, the configured type is ignored for them instead.
+func (r *Reconciler) migrateMinerTypes(ctx context.Context) error {
+	klog.InfoS("The types configuration is deprecated, use minerclasses instead")
+
+	for _, mc := range minerClassesFromTypes(r.ComponentConfig.Types) {
+		err := r.client.Create(ctx, mc)
+		if apierrors.IsAlreadyExists(err) {
+			klog.InfoS("Minerclass already exists, ignoring the configured miner type", "minerclass", mc.Name)
+			continue
+		}
+		if err != nil {
+			klog.ErrorS(err, "Unable to create minerclass for the configured miner type", "minerclass", mc.Name)
+			return err
+		}
+		klog.InfoS("Created minerclass for the configured miner type", "minerclass", mc.Name)
+	}
+
+	return nil
+}
+
+// minerClassesFromTypes converts the miner types of the deprecated Types configuration
+// into minerclasses, sorted by name.
+func minerClassesFromTypes(types map[string]config.MinerProfile) []*v1beta1.MinerClass {
+	mcs := make([]*v1beta1.MinerClass, 0, len(types))
+	for name, profile := range types {
+		mcs = append(mcs, &v1beta1.MinerClass{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v1beta1.MinerClassSpec{
+				Resources: corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{
+						corev1.ResourceCPU:    profile.CPU.DeepCopy(),
+						corev1.ResourceMemory: profile.Memory.DeepCopy(),
+					},
+				},
+				MiningDifficulty: int32(profile.MiningDifficulty),
+			},
+		})
+	}
+
+	sort.Slice(mcs, func(i, j int) bool { return mcs[i].Name < mcs[j].Name })
+	return mcs
+}
@@ -16,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/superproj/onex/internal/pkg/known"
 	"github.com/superproj/onex/internal/pkg/util/conditions"
@@ -73,11 +74,17 @@ func (r *Reconciler) reconcileAnnotations(ctx context.Context, m *v1beta1.Miner)
 		return ctrl.Result{}, nil
 	}
 
+	mc := &v1beta1.MinerClass{}
+	if err := r.client.Get(ctx, client.ObjectKey{Name: m.Spec.MinerType}, mc); err != nil {
+		// An unknown miner type is reported when the pod is created.
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
 	if m.Annotations == nil {
 		m.Annotations = make(map[string]string)
 	}
-	cpu := r.ComponentConfig.Types[m.Spec.MinerType].CPU
-	memory := r.ComponentConfig.Types[m.Spec.MinerType].Memory
+	cpu := mc.Spec.Resources.Limits[corev1.ResourceCPU]
+	memory := mc.Spec.Resources.Limits[corev1.ResourceMemory]
 	m.Annotations[known.CPUAnnotation] = cpu.String()
 	m.Annotations[known.MemoryAnnotation] = memory.String()
 
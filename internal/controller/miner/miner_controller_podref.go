@@ -10,6 +10,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 	"time"
 
@@ -17,7 +19,6 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
@@ -117,8 +118,12 @@ func (r *Reconciler) createMinerPod(ctx context.Context, m *v1beta1.Miner) (ctrl
 
 	// service name is the same as miner name
 	bootstrapIP := minerutil.GenesisDNSServiceNameFromMiner(ch.Status.MinerRef.Name)
-	minerType, ok := r.ComponentConfig.Types[m.Spec.MinerType]
-	if !ok {
+	mc := &v1beta1.MinerClass{}
+	if err := r.client.Get(ctx, client.ObjectKey{Name: m.Spec.MinerType}, mc); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+
 		errMessage := fmt.Sprintf("Miner's miner type %s is unsupported", m.Spec.MinerType)
 		log.Error(fmt.Errorf("Miner's miner type is unsupported"), errMessage)
 		m.Status.FailureReason = cmerrors.MinerStatusErrorPtr(cmerrors.InvalidConfigurationMinerError)
@@ -138,7 +143,7 @@ func (r *Reconciler) createMinerPod(ctx context.Context, m *v1beta1.Miner) (ctrl
 			"--miner",
 			"--address="+m.Namespace,
 			"--min-mine-interval="+(time.Duration(ch.Spec.MinMineIntervalSeconds)*time.Second).String(),
-			"--mining-difficulty="+strconv.Itoa(minerType.MiningDifficulty),
+			"--mining-difficulty="+strconv.Itoa(int(mc.Spec.MiningDifficulty)),
 		)
 	}
 
@@ -154,19 +159,20 @@ func (r *Reconciler) createMinerPod(ctx context.Context, m *v1beta1.Miner) (ctrl
 				{
 					ImagePullPolicy: corev1.PullIfNotPresent,
 					Name:            "toyblc",
-					Image:           ptr.Deref(GetMinerEnv().Image, ch.Spec.Image),
+					Image:           ptr.Deref(GetMinerEnv().Image, minerImage(ch, mc)),
 					Args:            args,
-					Resources: corev1.ResourceRequirements{
-						Limits: map[corev1.ResourceName]resource.Quantity{
-							corev1.ResourceCPU:    minerType.CPU,
-							corev1.ResourceMemory: minerType.Memory,
-						},
-					},
+					Resources:       *mc.Spec.Resources.DeepCopy(),
 				},
 			},
+			// Copied, since the overrides are merged into them.
+			NodeSelector: maps.Clone(mc.Spec.NodeSelector),
+			Tolerations:  slices.Clone(mc.Spec.Tolerations),
+			Affinity:     mc.Spec.Affinity.DeepCopy(),
 		},
 	}
 
+	applyPodOverrides(pod, &m.Spec)
+
 	// The above still follows the process of creating pods, because we want dryrun to go through more logic.
 	if r.DryRun {
 		pod = createDryRunPod(m)
@@ -178,6 +184,136 @@ func (r *Reconciler) createMinerPod(ctx context.Context, m *v1beta1.Miner) (ctrl
 	return ctrl.Result{}, nil
 }
 
+// applyPodOverrides merges the pod metadata and the pod overrides of the miner into the
+// generated pod. The values generated by the controller take precedence.
+func applyPodOverrides(pod *corev1.Pod, spec *v1beta1.MinerSpec) {
+	for k, v := range spec.Labels {
+		if _, ok := pod.Labels[k]; !ok {
+			if pod.Labels == nil {
+				pod.Labels = make(map[string]string, len(spec.Labels))
+			}
+			pod.Labels[k] = v
+		}
+	}
+	for k, v := range spec.Annotations {
+		if _, ok := pod.Annotations[k]; !ok {
+			if pod.Annotations == nil {
+				pod.Annotations = make(map[string]string, len(spec.Annotations))
+			}
+			pod.Annotations[k] = v
+		}
+	}
+
+	overrides := spec.PodOverrides
+	if overrides == nil {
+		return
+	}
+
+	// Placement from the miner class is refined, not discarded, by the overrides:
+	// the selector keys of the class win, and the affinity terms are merged.
+	for k, v := range overrides.NodeSelector {
+		if _, ok := pod.Spec.NodeSelector[k]; !ok {
+			if pod.Spec.NodeSelector == nil {
+				pod.Spec.NodeSelector = make(map[string]string, len(overrides.NodeSelector))
+			}
+			pod.Spec.NodeSelector[k] = v
+		}
+	}
+	pod.Spec.Tolerations = append(pod.Spec.Tolerations, overrides.Tolerations...)
+	pod.Spec.Affinity = mergeAffinity(pod.Spec.Affinity, overrides.Affinity)
+	pod.Spec.TopologySpreadConstraints = append(pod.Spec.TopologySpreadConstraints, overrides.TopologySpreadConstraints...)
+	pod.Spec.PriorityClassName = overrides.PriorityClassName
+	for i := range pod.Spec.Containers {
+		pod.Spec.Containers[i].Env = append(pod.Spec.Containers[i].Env, overrides.Env...)
+	}
+}
+
+// mergeAffinity returns the affinity of the miner class refined by the affinity of the overrides.
+// Every required node selector term of the class is ANDed with every required term of the
+// overrides, so that a node must satisfy both. The other terms are appended.
+func mergeAffinity(class, overrides *corev1.Affinity) *corev1.Affinity {
+	if overrides == nil {
+		return class
+	}
+	if class == nil {
+		return overrides.DeepCopy()
+	}
+
+	merged := class.DeepCopy()
+	if na := overrides.NodeAffinity; na != nil {
+		if merged.NodeAffinity == nil {
+			merged.NodeAffinity = &corev1.NodeAffinity{}
+		}
+		merged.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = mergeNodeSelector(
+			merged.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+			na.RequiredDuringSchedulingIgnoredDuringExecution,
+		)
+		merged.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(
+			merged.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+			na.PreferredDuringSchedulingIgnoredDuringExecution...,
+		)
+	}
+	if pa := overrides.PodAffinity; pa != nil {
+		if merged.PodAffinity == nil {
+			merged.PodAffinity = &corev1.PodAffinity{}
+		}
+		merged.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(
+			merged.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+			pa.RequiredDuringSchedulingIgnoredDuringExecution...,
+		)
+		merged.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(
+			merged.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+			pa.PreferredDuringSchedulingIgnoredDuringExecution...,
+		)
+	}
+	if paa := overrides.PodAntiAffinity; paa != nil {
+		if merged.PodAntiAffinity == nil {
+			merged.PodAntiAffinity = &corev1.PodAntiAffinity{}
+		}
+		merged.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(
+			merged.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+			paa.RequiredDuringSchedulingIgnoredDuringExecution...,
+		)
+		merged.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(
+			merged.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+			paa.PreferredDuringSchedulingIgnoredDuringExecution...,
+		)
+	}
+
+	return merged
+}
+
+// mergeNodeSelector returns a node selector matching the nodes which match both selectors.
+// The terms of a node selector are ORed, so the result has a term for every pair of terms.
+func mergeNodeSelector(class, overrides *corev1.NodeSelector) *corev1.NodeSelector {
+	if overrides == nil || len(overrides.NodeSelectorTerms) == 0 {
+		return class
+	}
+	if class == nil || len(class.NodeSelectorTerms) == 0 {
+		return overrides.DeepCopy()
+	}
+
+	terms := make([]corev1.NodeSelectorTerm, 0, len(class.NodeSelectorTerms)*len(overrides.NodeSelectorTerms))
+	for _, ct := range class.NodeSelectorTerms {
+		for _, ot := range overrides.NodeSelectorTerms {
+			term := ct.DeepCopy()
+			term.MatchExpressions = append(term.MatchExpressions, ot.MatchExpressions...)
+			term.MatchFields = append(term.MatchFields, ot.MatchFields...)
+			terms = append(terms, *term)
+		}
+	}
+
+	return &corev1.NodeSelector{NodeSelectorTerms: terms}
+}
+
+// minerImage returns the image of the miner class if set, otherwise the image of the chain.
+func minerImage(ch *v1beta1.Chain, mc *v1beta1.MinerClass) string {
+	if mc.Spec.Image != "" {
+		return mc.Spec.Image
+	}
+	return ch.Spec.Image
+}
+
 func createDryRunPod(m *v1beta1.Miner) *corev1.Pod {
 	dryRunPod := &corev1.Pod{
 		TypeMeta: metav1.TypeMeta{
@@ -10,7 +10,12 @@ import (
 	"testing"
 
 	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/superproj/onex/internal/controller/miner/apis/config"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
 )
 
 func TestHasMatchingLabels(t *testing.T) {
@@ -79,3 +84,171 @@ func TestHasMatchingLabels(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyPodOverrides(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{v1beta1.MinerAnnotation: "miner"},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "toyblc"}},
+		},
+	}
+	spec := &v1beta1.MinerSpec{
+		ObjectMeta: v1beta1.ObjectMeta{
+			Labels:      map[string]string{"app": "miner"},
+			Annotations: map[string]string{v1beta1.MinerAnnotation: "other", "team": "onex"},
+		},
+		PodOverrides: &v1beta1.MinerPodOverrides{
+			NodeSelector: map[string]string{"disktype": "ssd"},
+			Tolerations:  []corev1.Toleration{{Key: "miner", Operator: corev1.TolerationOpExists}},
+			TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+				MaxSkew:           1,
+				TopologyKey:       corev1.LabelTopologyZone,
+				WhenUnsatisfiable: corev1.ScheduleAnyway,
+				LabelSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "miner"}},
+			}},
+			PriorityClassName: "miner-high",
+			Env:               []corev1.EnvVar{{Name: "LOG_LEVEL", Value: "debug"}},
+		},
+	}
+
+	applyPodOverrides(pod, spec)
+	g.Expect(pod.Labels).To(gomega.Equal(map[string]string{"app": "miner"}))
+	g.Expect(pod.Annotations).To(gomega.Equal(map[string]string{v1beta1.MinerAnnotation: "miner", "team": "onex"}))
+	g.Expect(pod.Spec.NodeSelector).To(gomega.Equal(spec.PodOverrides.NodeSelector))
+	g.Expect(pod.Spec.Tolerations).To(gomega.Equal(spec.PodOverrides.Tolerations))
+	g.Expect(pod.Spec.TopologySpreadConstraints).To(gomega.Equal(spec.PodOverrides.TopologySpreadConstraints))
+	g.Expect(pod.Spec.PriorityClassName).To(gomega.Equal("miner-high"))
+	g.Expect(pod.Spec.Containers[0].Env).To(gomega.Equal(spec.PodOverrides.Env))
+}
+
+func TestApplyPodOverridesMergesClassPlacement(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	classAffinity := &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{}}
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers:   []corev1.Container{{Name: "toyblc"}},
+			NodeSelector: map[string]string{"disktype": "hdd", "pool": "miners"},
+			Tolerations:  []corev1.Toleration{{Key: "class", Operator: corev1.TolerationOpExists}},
+			Affinity:     classAffinity,
+		},
+	}
+	spec := &v1beta1.MinerSpec{
+		PodOverrides: &v1beta1.MinerPodOverrides{
+			NodeSelector: map[string]string{"disktype": "ssd"},
+			Tolerations:  []corev1.Toleration{{Key: "miner", Operator: corev1.TolerationOpExists}},
+		},
+	}
+
+	applyPodOverrides(pod, spec)
+	// The selector keys of the class can not be overridden.
+	g.Expect(pod.Spec.NodeSelector).To(gomega.Equal(map[string]string{"disktype": "hdd", "pool": "miners"}))
+	g.Expect(pod.Spec.Tolerations).To(gomega.Equal([]corev1.Toleration{
+		{Key: "class", Operator: corev1.TolerationOpExists},
+		{Key: "miner", Operator: corev1.TolerationOpExists},
+	}))
+	g.Expect(pod.Spec.Affinity).To(gomega.BeIdenticalTo(classAffinity))
+}
+
+func TestApplyPodOverridesMergesClassAffinity(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	requirement := func(key string, values ...string) corev1.NodeSelectorRequirement {
+		return corev1.NodeSelectorRequirement{Key: key, Operator: corev1.NodeSelectorOpIn, Values: values}
+	}
+	podTerm := func(app string) corev1.PodAffinityTerm {
+		return corev1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}},
+			TopologyKey:   corev1.LabelHostname,
+		}
+	}
+	spread := func(key string) corev1.TopologySpreadConstraint {
+		return corev1.TopologySpreadConstraint{MaxSkew: 1, TopologyKey: key, WhenUnsatisfiable: corev1.ScheduleAnyway}
+	}
+
+	classAffinity := &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{
+				{MatchExpressions: []corev1.NodeSelectorRequirement{requirement("pool", "miners")}},
+				{MatchExpressions: []corev1.NodeSelectorRequirement{requirement("pool", "spare")}},
+			}},
+			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.PreferredSchedulingTerm{
+				{Weight: 10, Preference: corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{requirement("gpu", "true")}}},
+			},
+		},
+		PodAntiAffinity: &corev1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{podTerm("class")},
+		},
+	}
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers:                []corev1.Container{{Name: "toyblc"}},
+			Affinity:                  classAffinity,
+			TopologySpreadConstraints: []corev1.TopologySpreadConstraint{spread(corev1.LabelHostname)},
+		},
+	}
+	spec := &v1beta1.MinerSpec{
+		PodOverrides: &v1beta1.MinerPodOverrides{
+			Affinity: &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{
+						{MatchExpressions: []corev1.NodeSelectorRequirement{requirement(corev1.LabelTopologyZone, "zone-a")}},
+					}},
+					PreferredDuringSchedulingIgnoredDuringExecution: []corev1.PreferredSchedulingTerm{
+						{Weight: 20, Preference: corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{requirement("disktype", "ssd")}}},
+					},
+				},
+				PodAffinity: &corev1.PodAffinity{
+					PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{Weight: 5, PodAffinityTerm: podTerm("cache")}},
+				},
+				PodAntiAffinity: &corev1.PodAntiAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{podTerm("miner")},
+				},
+			},
+			TopologySpreadConstraints: []corev1.TopologySpreadConstraint{spread(corev1.LabelTopologyZone)},
+		},
+	}
+
+	applyPodOverrides(pod, spec)
+	affinity := pod.Spec.Affinity
+
+	// Every required term of the class must also match the zone of the overrides.
+	g.Expect(affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(gomega.Equal([]corev1.NodeSelectorTerm{
+		{MatchExpressions: []corev1.NodeSelectorRequirement{requirement("pool", "miners"), requirement(corev1.LabelTopologyZone, "zone-a")}},
+		{MatchExpressions: []corev1.NodeSelectorRequirement{requirement("pool", "spare"), requirement(corev1.LabelTopologyZone, "zone-a")}},
+	}))
+	g.Expect(affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution).To(gomega.HaveLen(2))
+	g.Expect(affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution).To(gomega.Equal(
+		spec.PodOverrides.Affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+	))
+	g.Expect(affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution).To(gomega.Equal(
+		[]corev1.PodAffinityTerm{podTerm("class"), podTerm("miner")},
+	))
+	g.Expect(pod.Spec.TopologySpreadConstraints).To(gomega.Equal(
+		[]corev1.TopologySpreadConstraint{spread(corev1.LabelHostname), spread(corev1.LabelTopologyZone)},
+	))
+
+	// The class itself is left untouched.
+	g.Expect(classAffinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions).To(gomega.HaveLen(1))
+}
+
+func TestMinerClassesFromTypes(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	mcs := minerClassesFromTypes(map[string]config.MinerProfile{
+		"X1.CUSTOM": {CPU: resource.MustParse("2"), Memory: resource.MustParse("4Gi"), MiningDifficulty: 9},
+		"S1.SMALL1": {CPU: resource.MustParse("50m"), Memory: resource.MustParse("1024Mi"), MiningDifficulty: 1},
+	})
+	g.Expect(mcs).To(gomega.HaveLen(2))
+	g.Expect(mcs[0].Name).To(gomega.Equal("S1.SMALL1"))
+	g.Expect(mcs[1].Name).To(gomega.Equal("X1.CUSTOM"))
+
+	limits := mcs[1].Spec.Resources.Limits
+	g.Expect(limits.Cpu().String()).To(gomega.Equal("2"))
+	g.Expect(limits.Memory().String()).To(gomega.Equal("4Gi"))
+	g.Expect(mcs[1].Spec.MiningDifficulty).To(gomega.Equal(int32(9)))
+}
@@ -154,6 +154,7 @@ func patchMinerSet(ctx context.Context, helper *patch.Helper, ms *v1beta1.MinerS
 			v1beta1.MinersCreatedCondition,
 			v1beta1.ResizedCondition,
 			v1beta1.MinersReadyCondition,
+			v1beta1.MinersUpToDateCondition,
 		}},
 	)
 	return helper.Patch(ctx, ms, options...)
@@ -225,17 +226,22 @@ func (r *Reconciler) reconcile(ctx context.Context, chain *v1beta1.Chain, ms *v1
 	}
 	result = coreutil.LowestNonZeroResult(result, unHealthyResult)
 
+	// Record the revision of the miner template, the miners created from the other
+	// revisions are replaced by the rollout.
+	rev := r.syncRevision(ctx, ms)
+
 	if err := r.syncMiners(ctx, ms, filteredMiners); err != nil {
 		return ctrl.Result{}, err
 	}
 
-	syncResult, syncErr := r.syncReplicas(ctx, ms, filteredMiners)
+	syncResult, syncErr := r.syncRollout(ctx, ms, rev, filteredMiners)
 	result = coreutil.LowestNonZeroResult(result, syncResult)
 
 	// Always updates status as miners come up or die.
-	if err := r.updateStatus(ctx, ms, filteredMiners); err != nil {
+	if err := r.updateStatus(ctx, ms, rev, filteredMiners); err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to update MinerSet's Status, err: %w", kerrors.NewAggregate([]error{err, syncErr}))
 	}
+	result = coreutil.LowestNonZeroResult(result, updateRolloutCondition(ms, rev, filteredMiners))
 
 	if syncErr != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to sync MinerSet replicas, err: %w", syncErr)
@@ -271,8 +277,8 @@ func (r *Reconciler) reconcile(ctx context.Context, chain *v1beta1.Chain, ms *v1
 	return result, nil
 }
 
-// syncReplicas scales Miner resources up or down.
-func (r *Reconciler) syncReplicas(ctx context.Context, ms *v1beta1.MinerSet, miners []*v1beta1.Miner) (ctrl.Result, error) {
+// syncReplicas scales Miner resources up or down. The new miners are created from the revision rev.
+func (r *Reconciler) syncReplicas(ctx context.Context, ms *v1beta1.MinerSet, rev *revision, miners []*v1beta1.Miner) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
 
 	if ms.Spec.Replicas == nil {
@@ -290,7 +296,7 @@ func (r *Reconciler) syncReplicas(ctx context.Context, ms *v1beta1.MinerSet, min
 			}
 		}
 
-		minerList, err := r.createMiners(ctx, ms, concurrencyNum(diff))
+		minerList, err := r.createMiners(ctx, ms, rev, concurrencyNum(diff))
 		if err != nil {
 			return ctrl.Result{}, err
 		}
@@ -352,7 +358,7 @@ func (r *Reconciler) syncMiners(ctx context.Context, ms *v1beta1.MinerSet, miner
 		}
 
 		// Update Miner to propagate in-place mutable fields from the MinerSet.
-		updatedMiner := r.computeDesiredMiner(ms, m)
+		updatedMiner := r.computeDesiredMiner(ms, nil, m)
 		err := ssa.Patch(ctx, r.client, controllerName, updatedMiner, ssa.WithCachingProxy{Cache: r.ssaCache, Original: m})
 		if err != nil {
 			log.Error(err, "failed to update Miner", "Miner", klog.KObj(updatedMiner))
@@ -409,7 +415,9 @@ func (r *Reconciler) reconcileUnhealthyMiners(ctx context.Context, filteredMiner
 // There are small differences in how we calculate the Miner depending on if it
 // is a create or update. Example: for a new Miner we have to calculate a new name,
 // while for an existing Miner we have to use the name of the existing Miner.
-func (r *Reconciler) computeDesiredMiner(ms *v1beta1.MinerSet, existingMiner *v1beta1.Miner) *v1beta1.Miner {
+// A new Miner is created from the revision rev, while an existing Miner keeps the spec
+// of its revision until it is replaced by the rollout.
+func (r *Reconciler) computeDesiredMiner(ms *v1beta1.MinerSet, rev *revision, existingMiner *v1beta1.Miner) *v1beta1.Miner {
 	gv := v1beta1.SchemeGroupVersion
 	desiredMiner := &v1beta1.Miner{
 		TypeMeta: metav1.TypeMeta{
@@ -425,25 +433,30 @@ func (r *Reconciler) computeDesiredMiner(ms *v1beta1.MinerSet, existingMiner *v1
 			Annotations:     ms.Spec.Template.Annotations,
 			Finalizers:      []string{v1beta1.MinerFinalizer},
 		},
-		Spec: *ms.Spec.Template.Spec.DeepCopy(),
 	}
 
-	// If we are updating an existing Miner reuse the name and uid
+	// Set Labels
+	desiredMiner.Labels = minerLabelsFromMinerSet(ms)
+
+	// If we are updating an existing Miner reuse the name, uid, spec and revision
 	// from the existingMiner.
 	// Note: we use UID to force SSA to update the existing Miner and to not accidentally create a new Miner.
 	// infrastructureRef and bootstrap.configRef remain the same for an existing Miner.
 	if existingMiner != nil {
 		desiredMiner.SetName(existingMiner.Name)
 		desiredMiner.SetUID(existingMiner.UID)
+		desiredMiner.Spec = *existingMiner.Spec.DeepCopy()
+		desiredMiner.Labels[v1beta1.MinerSetTemplateHashLabel] = templateHashOf(existingMiner)
+	} else {
+		desiredMiner.Spec = *rev.spec.DeepCopy()
+		desiredMiner.Labels[v1beta1.MinerSetTemplateHashLabel] = rev.hash
 	}
 
-	// Set Labels
-	desiredMiner.Labels = minerLabelsFromMinerSet(ms)
-
 	// Set Annotations
 	desiredMiner.Annotations = minerAnnotationsFromMinerSet(ms)
 
 	// Set all other in-place mutable fields.
+	desiredMiner.Spec.DisplayName = ms.Spec.Template.Spec.DisplayName
 	desiredMiner.Spec.PodDeletionTimeout = ms.Spec.Template.Spec.PodDeletionTimeout
 
 	return desiredMiner
@@ -643,7 +656,7 @@ func (r *Reconciler) shouldAdopt(ms *v1beta1.MinerSet) bool {
 
 // updateStatus updates the Status field for the MinerSet
 // It checks for the current state of the replicas and updates the Status of the MinerSet.
-func (r *Reconciler) updateStatus(ctx context.Context, ms *v1beta1.MinerSet, filteredMiners []*v1beta1.Miner) error {
+func (r *Reconciler) updateStatus(ctx context.Context, ms *v1beta1.MinerSet, rev *revision, filteredMiners []*v1beta1.Miner) error {
 	log := ctrl.LoggerFrom(ctx)
 
 	newStatus := ms.Status.DeepCopy()
@@ -695,18 +708,22 @@ func (r *Reconciler) updateStatus(ctx context.Context, ms *v1beta1.MinerSet, fil
 	newStatus.FullyLabeledReplicas = int32(fullyLabeledReplicasCount)
 	newStatus.ReadyReplicas = int32(readyReplicasCount)
 	newStatus.AvailableReplicas = int32(availableReplicasCount)
+	newMiners, _ := splitMiners(filteredMiners, rev.hash)
+	newStatus.UpdatedReplicas = int32(len(newMiners))
 
 	// Copy the newly calculated status into the minerset
 	if ms.Status.Replicas != newStatus.Replicas ||
 		ms.Status.FullyLabeledReplicas != newStatus.FullyLabeledReplicas ||
 		ms.Status.ReadyReplicas != newStatus.ReadyReplicas ||
 		ms.Status.AvailableReplicas != newStatus.AvailableReplicas ||
+		ms.Status.UpdatedReplicas != newStatus.UpdatedReplicas ||
 		ms.Generation != ms.Status.ObservedGeneration {
 		log.V(4).Info("Updating status: " +
 			fmt.Sprintf("replicas %d->%d (need %d), ", ms.Status.Replicas, newStatus.Replicas, desiredReplicas) +
 			fmt.Sprintf("fullyLabeledReplicas %d->%d, ", ms.Status.FullyLabeledReplicas, newStatus.FullyLabeledReplicas) +
 			fmt.Sprintf("readyReplicas %d->%d, ", ms.Status.ReadyReplicas, newStatus.ReadyReplicas) +
 			fmt.Sprintf("availableReplicas %d->%d, ", ms.Status.AvailableReplicas, newStatus.AvailableReplicas) +
+			fmt.Sprintf("updatedReplicas %d->%d, ", ms.Status.UpdatedReplicas, newStatus.UpdatedReplicas) +
 			fmt.Sprintf("sequence No: %v->%v", ms.Status.ObservedGeneration, newStatus.ObservedGeneration))
 
 		// Save the generation number we acted on, otherwise we might wrongfully indicate
@@ -762,7 +779,7 @@ func (r *Reconciler) updateStatus(ctx context.Context, ms *v1beta1.MinerSet, fil
 	return nil
 }
 
-func (r *Reconciler) createMiners(ctx context.Context, ms *v1beta1.MinerSet, concurrent int) ([]*v1beta1.Miner, error) {
+func (r *Reconciler) createMiners(ctx context.Context, ms *v1beta1.MinerSet, rev *revision, concurrent int) ([]*v1beta1.Miner, error) {
 	log := ctrl.LoggerFrom(ctx)
 
 	var (
@@ -777,7 +794,7 @@ func (r *Reconciler) createMiners(ctx context.Context, ms *v1beta1.MinerSet, con
 			case <-ctx.Done():
 				return ctx.Err()
 			default:
-				miner := r.computeDesiredMiner(ms, nil)
+				miner := r.computeDesiredMiner(ms, rev, nil)
 
 				// Create the Miner.
 				if err := ssa.Patch(ctx, r.client, controllerName, miner); err != nil {
@@ -0,0 +1,375 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package minerset
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/rand"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/superproj/onex/internal/pkg/util/conditions"
+	minerutil "github.com/superproj/onex/internal/pkg/util/miner"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+	"github.com/superproj/onex/pkg/record"
+)
+
+// rolloutResyncPeriod is the amount of time between two steps of a rollout when
+// no miner event triggers the reconciliation.
+var rolloutResyncPeriod = 15 * time.Second
+
+// revision is the revision of the miner template the new miners are created from.
+type revision struct {
+	number int64
+	hash   string
+	spec   *v1beta1.MinerSpec
+}
+
+// computeTemplateHash returns the hash of the miner spec of a template. The in-place
+// mutable fields are ignored, their changes are propagated to the existing miners
+// instead of rolling them out.
+func computeTemplateHash(spec *v1beta1.MinerSpec) string {
+	s := spec.DeepCopy()
+	s.DisplayName = ""
+	s.PodDeletionTimeout = nil
+
+	return hashObject(s)
+}
+
+// hashObject returns the hash of the JSON encoding of the object. The empty fields are
+// omitted from the encoding, so a field added to the API does not change the hashes of
+// the existing templates, which would roll out all the miners on upgrade.
+func hashObject(obj any) string {
+	// The encoding of the API types does not fail.
+	data, _ := json.Marshal(obj)
+
+	hasher := fnv.New32a()
+	_, _ = hasher.Write(data)
+	return rand.SafeEncodeString(fmt.Sprint(hasher.Sum32()))
+}
+
+// templateHashOf returns the hash of the revision the miner is created from. The miners
+// created before the MinerSet recorded its revisions have no label, their spec is hashed.
+func templateHashOf(miner *v1beta1.Miner) string {
+	if hash, ok := miner.Labels[v1beta1.MinerSetTemplateHashLabel]; ok {
+		return hash
+	}
+
+	return computeTemplateHash(&miner.Spec)
+}
+
+// syncRevision rolls back the miner template if requested, and records the revision of
+// the miner template in the status when it changes. It returns the revision the new miners
+// are created from, which is the last rolled out revision when the rollout is paused.
+func (r *Reconciler) syncRevision(ctx context.Context, ms *v1beta1.MinerSet) *revision {
+	log := ctrl.LoggerFrom(ctx)
+
+	if ms.Spec.RollbackTo != nil {
+		rollback(ms)
+	}
+
+	hash := computeTemplateHash(&ms.Spec.Template.Spec)
+	if hash == ms.Status.TemplateHash {
+		return &revision{number: ms.Status.Revision, hash: hash, spec: &ms.Spec.Template.Spec}
+	}
+
+	if ms.Spec.Paused && ms.Status.TemplateHash != "" {
+		if current := findRevision(ms.Status.History, ms.Status.Revision); current != nil {
+			log.V(4).Info("Rollout is paused, ignoring the changes of the miner template", "revision", current.Revision)
+			return &revision{number: current.Revision, hash: current.TemplateHash, spec: &current.Spec}
+		}
+	}
+
+	number := ms.Status.Revision
+	history := make([]v1beta1.MinerSetRevision, 0, len(ms.Status.History)+1)
+	for _, rev := range ms.Status.History {
+		number = max(number, rev.Revision)
+		// A template rolled out again, e.g. by a rollback, moves to the new revision.
+		if rev.TemplateHash != hash {
+			history = append(history, rev)
+		}
+	}
+	number++
+
+	history = append(history, v1beta1.MinerSetRevision{
+		Revision:          number,
+		TemplateHash:      hash,
+		Spec:              *ms.Spec.Template.Spec.DeepCopy(),
+		CreationTimestamp: metav1.Now(),
+	})
+
+	limit := 10
+	if ms.Spec.RevisionHistoryLimit != nil {
+		limit = int(*ms.Spec.RevisionHistoryLimit)
+	}
+	// Keep the current revision and at most limit old revisions.
+	if len(history) > limit+1 {
+		history = history[len(history)-limit-1:]
+	}
+
+	ms.Status.History = history
+	ms.Status.Revision = number
+	ms.Status.TemplateHash = hash
+
+	log.V(2).Info("Rolling out new revision of the miner template", "revision", number, "templateHash", hash)
+	record.Eventf(ms, "NewRevision", "Rolling out revision %d of the miner template", number)
+
+	return &revision{number: number, hash: hash, spec: &ms.Spec.Template.Spec}
+}
+
+// rollback copies the miner spec of the revision to rollback to into the template, and
+// clears the rollback request.
+func rollback(ms *v1beta1.MinerSet) {
+	number := ms.Spec.RollbackTo.Revision
+	ms.Spec.RollbackTo = nil
+
+	if number == 0 {
+		number = previousRevision(ms.Status.History, ms.Status.Revision)
+	}
+
+	rev := findRevision(ms.Status.History, number)
+	if rev == nil {
+		record.Warnf(ms, "RollbackRevisionNotFound", "Unable to find revision %d to rollback to", number)
+		return
+	}
+
+	spec := rev.Spec.DeepCopy()
+	// The in-place mutable fields are not part of the revisions.
+	spec.DisplayName = ms.Spec.Template.Spec.DisplayName
+	spec.PodDeletionTimeout = ms.Spec.Template.Spec.PodDeletionTimeout
+	ms.Spec.Template.Spec = *spec
+
+	record.Eventf(ms, "RollbackDone", "Rolled back the miner template to revision %d", number)
+}
+
+// findRevision returns the revision numbered number of the history, or nil if there is none.
+func findRevision(history []v1beta1.MinerSetRevision, number int64) *v1beta1.MinerSetRevision {
+	for i := range history {
+		if history[i].Revision == number {
+			return &history[i]
+		}
+	}
+
+	return nil
+}
+
+// previousRevision returns the newest revision of the history older than current, or 0 if there is none.
+func previousRevision(history []v1beta1.MinerSetRevision, current int64) int64 {
+	var previous int64
+	for _, rev := range history {
+		if rev.Revision < current && rev.Revision > previous {
+			previous = rev.Revision
+		}
+	}
+
+	return previous
+}
+
+// syncRollout replaces the miners created from the old revisions of the miner template
+// according to the strategy of the MinerSet. Once they are all replaced, or if the rollout
+// is paused, it scales the miners.
+func (r *Reconciler) syncRollout(ctx context.Context, ms *v1beta1.MinerSet, rev *revision, miners []*v1beta1.Miner) (ctrl.Result, error) {
+	newMiners, oldMiners := splitMiners(miners, rev.hash)
+	if len(oldMiners) == 0 || ms.Spec.Paused {
+		return r.syncReplicas(ctx, ms, rev, miners)
+	}
+
+	if ms.Spec.Strategy.Type == v1beta1.RecreateMinerSetStrategyType {
+		return r.rolloutRecreate(ctx, ms, oldMiners)
+	}
+
+	return r.rolloutRolling(ctx, ms, rev, miners, newMiners, oldMiners)
+}
+
+// rolloutRecreate deletes all the old miners. The new miners are created by syncReplicas
+// once the old miners are gone.
+func (r *Reconciler) rolloutRecreate(ctx context.Context, ms *v1beta1.MinerSet, oldMiners []*v1beta1.Miner) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	log.V(2).Info(fmt.Sprintf("MinerSet is recreating miners by deleting %d old miners", len(oldMiners)))
+	minersToDelete := oldMiners[0:concurrencyNum(len(oldMiners))]
+	if err := r.deleteMiners(ctx, ms, minersToDelete); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: rolloutResyncPeriod}, r.waitForMinerDeletion(ctx, minersToDelete)
+}
+
+// rolloutRolling creates the new miners as long as there are at most replicas+maxSurge miners,
+// and deletes the old miners as long as there are at least replicas-maxUnavailable available miners.
+func (r *Reconciler) rolloutRolling(
+	ctx context.Context,
+	ms *v1beta1.MinerSet,
+	rev *revision,
+	miners, newMiners, oldMiners []*v1beta1.Miner,
+) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	desired := *ms.Spec.Replicas
+	maxSurge, maxUnavailable, err := resolveFenceposts(ms.Spec.Strategy.RollingUpdate, desired)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Miners being deleted are counted, they still run until their pods are gone.
+	if scaleUp := min(int(desired+maxSurge)-len(miners), int(desired)-len(newMiners)); scaleUp > 0 {
+		log.V(2).Info(fmt.Sprintf("MinerSet is rolling out revision %d by creating %d miners", rev.number, scaleUp))
+		minerList, err := r.createMiners(ctx, ms, rev, concurrencyNum(scaleUp))
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{RequeueAfter: rolloutResyncPeriod}, r.waitForMinerCreation(ctx, minerList)
+	}
+
+	// The unavailable old miners can always be deleted, the available ones as long as
+	// the minimum number of available miners is kept.
+	now := metav1.Now()
+	available := 0
+	for _, miner := range miners {
+		if miner.DeletionTimestamp.IsZero() && minerutil.IsMinerAvailable(miner, ms.Spec.MinReadySeconds, now) {
+			available++
+		}
+	}
+
+	var minersToDelete, availableOldMiners []*v1beta1.Miner
+	for _, miner := range oldMiners {
+		if minerutil.IsMinerAvailable(miner, ms.Spec.MinReadySeconds, now) {
+			availableOldMiners = append(availableOldMiners, miner)
+			continue
+		}
+		minersToDelete = append(minersToDelete, miner)
+	}
+
+	if scaleDown := available - int(desired-maxUnavailable); scaleDown > 0 {
+		deletePriorityFunc, err := getDeletePriorityFunc(ms)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		minersToDelete = append(minersToDelete, getMinersToDeletePrioritized(availableOldMiners, scaleDown, deletePriorityFunc)...)
+	}
+
+	if len(minersToDelete) == 0 {
+		log.V(4).Info("Waiting for new miners to become available", "revision", rev.number)
+		return ctrl.Result{RequeueAfter: rolloutResyncPeriod}, nil
+	}
+
+	log.V(2).Info(fmt.Sprintf("MinerSet is rolling out revision %d by deleting %d old miners", rev.number, len(minersToDelete)))
+	minersToDelete = minersToDelete[0:concurrencyNum(len(minersToDelete))]
+	if err := r.deleteMiners(ctx, ms, minersToDelete); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: rolloutResyncPeriod}, r.waitForMinerDeletion(ctx, minersToDelete)
+}
+
+// splitMiners returns the miners which are not being deleted, created from the revision
+// whose hash is hash, and from the old revisions.
+func splitMiners(miners []*v1beta1.Miner, hash string) (newMiners, oldMiners []*v1beta1.Miner) {
+	for _, miner := range miners {
+		if !miner.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		if templateHashOf(miner) == hash {
+			newMiners = append(newMiners, miner)
+			continue
+		}
+		oldMiners = append(oldMiners, miner)
+	}
+
+	// Replace the oldest miners first.
+	sort.SliceStable(oldMiners, func(i, j int) bool {
+		return oldMiners[i].CreationTimestamp.Before(&oldMiners[j].CreationTimestamp)
+	})
+
+	return newMiners, oldMiners
+}
+
+// resolveFenceposts resolves both maxSurge and maxUnavailable. It ensures that both are not
+// zero at the same time, otherwise the rollout would never make progress.
+func resolveFenceposts(rollingUpdate *v1beta1.RollingUpdateMinerSet, desired int32) (int32, int32, error) {
+	zero := intstr.FromInt32(0)
+	if rollingUpdate == nil {
+		rollingUpdate = &v1beta1.RollingUpdateMinerSet{}
+	}
+
+	surge, err := intstr.GetScaledValueFromIntOrPercent(intstr.ValueOrDefault(rollingUpdate.MaxSurge, zero), int(desired), true)
+	if err != nil {
+		return 0, 0, err
+	}
+	unavailable, err := intstr.GetScaledValueFromIntOrPercent(intstr.ValueOrDefault(rollingUpdate.MaxUnavailable, zero), int(desired), false)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if surge == 0 && unavailable == 0 {
+		// Validation should never allow the user to explicitly use zero values for both maxSurge
+		// maxUnavailable. Due to rounding down maxUnavailable though, it may resolve to zero.
+		// If both fenceposts resolve to zero, then we should set maxUnavailable to 1 on the
+		// theory that surge might not work due to quota.
+		unavailable = 1
+	}
+
+	return int32(surge), int32(unavailable), nil
+}
+
+// updateRolloutCondition sets the MinersUpToDate condition of the MinerSet. The rollout is
+// considered stuck when the number of updated and available miners hasn't changed for
+// ProgressDeadlineSeconds. It returns when the deadline has to be checked again.
+func updateRolloutCondition(ms *v1beta1.MinerSet, rev *revision, miners []*v1beta1.Miner) ctrl.Result {
+	newMiners, oldMiners := splitMiners(miners, rev.hash)
+	if len(oldMiners) == 0 {
+		conditions.MarkTrue(ms, v1beta1.MinersUpToDateCondition)
+		return ctrl.Result{}
+	}
+
+	available := 0
+	for _, miner := range newMiners {
+		if minerutil.IsMinerAvailable(miner, ms.Spec.MinReadySeconds, metav1.Now()) {
+			available++
+		}
+	}
+	progress := fmt.Sprintf("%d of %d miners updated, %d available", len(newMiners), *ms.Spec.Replicas, available)
+
+	if ms.Spec.Paused {
+		conditions.MarkFalse(ms, v1beta1.MinersUpToDateCondition, v1beta1.RolloutPausedReason, v1beta1.ConditionSeverityInfo,
+			"Rollout of revision %d is paused: %s", rev.number, progress)
+		return ctrl.Result{}
+	}
+
+	// Progress is not estimated while the deadline is exceeded, until the rollout moves on.
+	cond := conditions.Get(ms, v1beta1.MinersUpToDateCondition)
+	exceeded := fmt.Sprintf("Revision %d exceeded its progress deadline: %s", rev.number, progress)
+	if cond != nil && cond.Reason == v1beta1.ProgressDeadlineExceededReason && cond.Message == exceeded {
+		return ctrl.Result{}
+	}
+
+	conditions.MarkFalse(ms, v1beta1.MinersUpToDateCondition, v1beta1.RollingUpdateInProgressReason, v1beta1.ConditionSeverityInfo,
+		"Rolling out revision %d: %s", rev.number, progress)
+	if ms.Spec.ProgressDeadlineSeconds == nil {
+		return ctrl.Result{}
+	}
+
+	// The last transition time of the condition is the last time the rollout made progress.
+	deadline := time.Duration(*ms.Spec.ProgressDeadlineSeconds) * time.Second
+	elapsed := time.Since(conditions.GetLastTransitionTime(ms, v1beta1.MinersUpToDateCondition).Time)
+	if elapsed < deadline {
+		return ctrl.Result{RequeueAfter: deadline - elapsed}
+	}
+
+	conditions.MarkFalse(ms, v1beta1.MinersUpToDateCondition, v1beta1.ProgressDeadlineExceededReason, v1beta1.ConditionSeverityError, "%s", exceeded)
+	record.Warnf(ms, v1beta1.ProgressDeadlineExceededReason, "Rollout of revision %d has not made progress for %s", rev.number, deadline)
+	return ctrl.Result{}
+}
@@ -0,0 +1,214 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package minerset
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+)
+
+func TestComputeTemplateHash(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	spec := &v1beta1.MinerSpec{MinerType: "M1.SMALL1", ChainName: "genesis"}
+	hash := computeTemplateHash(spec)
+
+	inPlace := spec.DeepCopy()
+	inPlace.DisplayName = "miner"
+	inPlace.PodDeletionTimeout = &metav1.Duration{Duration: time.Minute}
+	g.Expect(computeTemplateHash(inPlace)).To(gomega.Equal(hash))
+
+	changed := spec.DeepCopy()
+	changed.MinerType = "M1.MEDIUM2"
+	g.Expect(computeTemplateHash(changed)).NotTo(gomega.Equal(hash))
+
+	// Empty fields and empty metadata do not change the hash either.
+	empty := spec.DeepCopy()
+	empty.Labels = map[string]string{}
+	empty.PodOverrides = nil
+	g.Expect(computeTemplateHash(empty)).To(gomega.Equal(hash))
+}
+
+func TestComputeTemplateHashIgnoresAddedFields(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	// oldMinerSpec is the MinerSpec before PodOverrides was added to it.
+	type oldMinerSpec struct {
+		v1beta1.ObjectMeta `json:"metadata,omitempty"`
+		DisplayName        string               `json:"displayName,omitempty"`
+		MinerType          string               `json:"minerType,omitempty"`
+		ChainName          string               `json:"chainName,omitempty"`
+		RestartPolicy      corev1.RestartPolicy `json:"restartPolicy,omitempty"`
+		PodDeletionTimeout *metav1.Duration     `json:"podDeletionTimeout,omitempty"`
+	}
+
+	old := oldMinerSpec{
+		ObjectMeta:    v1beta1.ObjectMeta{Labels: map[string]string{"app": "miner"}},
+		MinerType:     "M1.SMALL1",
+		ChainName:     "genesis",
+		RestartPolicy: corev1.RestartPolicyAlways,
+	}
+	spec := &v1beta1.MinerSpec{
+		ObjectMeta:    old.ObjectMeta,
+		MinerType:     old.MinerType,
+		ChainName:     old.ChainName,
+		RestartPolicy: old.RestartPolicy,
+	}
+
+	// The templates hashed before the upgrade keep their hash, so they are not rolled out.
+	g.Expect(computeTemplateHash(spec)).To(gomega.Equal(hashObject(old)))
+}
+
+func TestSyncRevision(t *testing.T) {
+	g := gomega.NewWithT(t)
+	r := &Reconciler{}
+
+	ms := &v1beta1.MinerSet{
+		Spec: v1beta1.MinerSetSpec{
+			RevisionHistoryLimit: ptr.To[int32](1),
+			Template:             v1beta1.MinerTemplateSpec{Spec: v1beta1.MinerSpec{MinerType: "small"}},
+		},
+	}
+
+	rev := r.syncRevision(context.TODO(), ms)
+	g.Expect(rev.number).To(gomega.Equal(int64(1)))
+	g.Expect(ms.Status.TemplateHash).To(gomega.Equal(rev.hash))
+	small := rev.hash
+
+	// An unchanged template keeps the revision.
+	g.Expect(r.syncRevision(context.TODO(), ms).number).To(gomega.Equal(int64(1)))
+
+	ms.Spec.Template.Spec.MinerType = "medium"
+	rev = r.syncRevision(context.TODO(), ms)
+	g.Expect(rev.number).To(gomega.Equal(int64(2)))
+	g.Expect(ms.Status.History).To(gomega.HaveLen(2))
+
+	// The changes are ignored while the rollout is paused.
+	ms.Spec.Paused = true
+	ms.Spec.Template.Spec.MinerType = "large"
+	rev = r.syncRevision(context.TODO(), ms)
+	g.Expect(rev.number).To(gomega.Equal(int64(2)))
+	g.Expect(rev.spec.MinerType).To(gomega.Equal("medium"))
+
+	// The history is limited to the current revision and RevisionHistoryLimit old ones.
+	ms.Spec.Paused = false
+	rev = r.syncRevision(context.TODO(), ms)
+	g.Expect(rev.number).To(gomega.Equal(int64(3)))
+	g.Expect(ms.Status.History).To(gomega.HaveLen(2))
+	g.Expect(ms.Status.History[0].Revision).To(gomega.Equal(int64(2)))
+
+	// Rollback to the previous revision moves it to a new revision.
+	ms.Spec.RollbackTo = &v1beta1.MinerSetRollback{}
+	rev = r.syncRevision(context.TODO(), ms)
+	g.Expect(ms.Spec.RollbackTo).To(gomega.BeNil())
+	g.Expect(ms.Spec.Template.Spec.MinerType).To(gomega.Equal("medium"))
+	g.Expect(rev.number).To(gomega.Equal(int64(4)))
+	g.Expect(ms.Status.History).To(gomega.HaveLen(2))
+	g.Expect(ms.Status.History[0].Revision).To(gomega.Equal(int64(3)))
+
+	// Unknown revisions are ignored.
+	ms.Spec.RollbackTo = &v1beta1.MinerSetRollback{Revision: 1}
+	rev = r.syncRevision(context.TODO(), ms)
+	g.Expect(ms.Spec.RollbackTo).To(gomega.BeNil())
+	g.Expect(rev.number).To(gomega.Equal(int64(4)))
+	g.Expect(rev.hash).NotTo(gomega.Equal(small))
+}
+
+func TestResolveFenceposts(t *testing.T) {
+	testCases := []struct {
+		name                string
+		maxSurge            intstr.IntOrString
+		maxUnavailable      intstr.IntOrString
+		desired             int32
+		expectedSurge       int32
+		expectedUnavailable int32
+	}{
+		{
+			name:                "percentages round surge up and unavailable down",
+			maxSurge:            intstr.FromString("25%"),
+			maxUnavailable:      intstr.FromString("25%"),
+			desired:             10,
+			expectedSurge:       3,
+			expectedUnavailable: 2,
+		},
+		{
+			name:                "absolute values",
+			maxSurge:            intstr.FromInt32(2),
+			maxUnavailable:      intstr.FromInt32(0),
+			desired:             3,
+			expectedSurge:       2,
+			expectedUnavailable: 0,
+		},
+		{
+			name:                "both zero make one unavailable",
+			maxSurge:            intstr.FromString("0%"),
+			maxUnavailable:      intstr.FromString("25%"),
+			desired:             1,
+			expectedSurge:       0,
+			expectedUnavailable: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			surge, unavailable, err := resolveFenceposts(&v1beta1.RollingUpdateMinerSet{
+				MaxSurge:       &tc.maxSurge,
+				MaxUnavailable: &tc.maxUnavailable,
+			}, tc.desired)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(surge).To(gomega.Equal(tc.expectedSurge))
+			g.Expect(unavailable).To(gomega.Equal(tc.expectedUnavailable))
+		})
+	}
+}
+
+func TestSplitMiners(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	spec := v1beta1.MinerSpec{MinerType: "small"}
+	hash := computeTemplateHash(&spec)
+	now := metav1.Now()
+	miner := func(name string, labels map[string]string, created time.Duration, deleted bool) *v1beta1.Miner {
+		m := &v1beta1.Miner{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Labels:            labels,
+				CreationTimestamp: metav1.NewTime(now.Add(created)),
+			},
+			Spec: spec,
+		}
+		if deleted {
+			m.DeletionTimestamp = &now
+		}
+		return m
+	}
+
+	miners := []*v1beta1.Miner{
+		miner("new", map[string]string{v1beta1.MinerSetTemplateHashLabel: hash}, 0, false),
+		miner("unlabeled", nil, 0, false),
+		miner("old-2", map[string]string{v1beta1.MinerSetTemplateHashLabel: "old"}, -time.Minute, false),
+		miner("old-1", map[string]string{v1beta1.MinerSetTemplateHashLabel: "old"}, -time.Hour, false),
+		miner("deleting", map[string]string{v1beta1.MinerSetTemplateHashLabel: "old"}, 0, true),
+	}
+
+	newMiners, oldMiners := splitMiners(miners, hash)
+	g.Expect(newMiners).To(gomega.HaveLen(2))
+	g.Expect(oldMiners).To(gomega.HaveLen(2))
+	g.Expect(oldMiners[0].Name).To(gomega.Equal("old-1"))
+	g.Expect(oldMiners[1].Name).To(gomega.Equal("old-2"))
+}
@@ -0,0 +1,300 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package minersetautoscaler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	"github.com/superproj/onex/internal/controller/miner/apis/config"
+	"github.com/superproj/onex/internal/pkg/util/annotations"
+	"github.com/superproj/onex/internal/pkg/util/conditions"
+	logutil "github.com/superproj/onex/internal/pkg/util/log"
+	minerutil "github.com/superproj/onex/internal/pkg/util/miner"
+	"github.com/superproj/onex/internal/pkg/util/patch"
+	"github.com/superproj/onex/internal/pkg/util/predicates"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+	"github.com/superproj/onex/pkg/record"
+)
+
+const controllerName = "minersetautoscaler-controller"
+
+// resyncPeriod is the interval at which the metrics are read again and the
+// replica count of the MinerSet recalculated.
+var resyncPeriod = 30 * time.Second
+
+// Reconciler reconciles a MinerSetAutoscaler object.
+type Reconciler struct {
+	client    client.Client
+	APIReader client.Reader
+
+	// ProviderClient is used to read the CPU usage of the miner pods.
+	ProviderClient kubernetes.Interface
+
+	// Quota is the miner quota of the users, which the MinerSets are not scaled up beyond.
+	Quota *config.MinerQuotaConfiguration
+
+	// WatchFilterValue is the label value used to filter events prior to reconciliation.
+	WatchFilterValue string
+
+	// recommendations holds the replica counts recommended for each autoscaler during
+	// the last stabilization window.
+	recommendations *recommendationHistory
+}
+
+func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	builder := ctrl.NewControllerManagedBy(mgr).
+		For(&v1beta1.MinerSetAutoscaler{}).
+		Watches(
+			&v1beta1.MinerSet{},
+			handler.EnqueueRequestsFromMapFunc(r.MinerSetToMinerSetAutoscalers)).
+		WithOptions(options).
+		Named(controllerName).
+		WithEventFilter(predicates.ResourceNotPausedAndHasFilterLabel(ctrl.LoggerFrom(ctx), r.WatchFilterValue))
+
+	r.client = mgr.GetClient()
+	r.APIReader = mgr.GetAPIReader()
+	r.recommendations = newRecommendationHistory()
+
+	return builder.Complete(r)
+}
+
+// Reconcile reads the metrics of a MinerSetAutoscaler object and scales the target
+// MinerSet accordingly.
+func (r *Reconciler) Reconcile(ctx context.Context, rq ctrl.Request) (_ ctrl.Result, reterr error) {
+	// Fetch the MinerSetAutoscaler object
+	a := &v1beta1.MinerSetAutoscaler{}
+	if err := r.client.Get(ctx, rq.NamespacedName, a); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			r.recommendations.delete(rq.NamespacedName)
+		}
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	ctx, log := logutil.AddOwners(ctx, a)
+	log = log.WithValues("MinerSet", klog.KRef(a.Namespace, a.Spec.ScaleTargetRef.Name))
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	log.V(4).Info("Reconcile minersetautoscaler")
+	// Return early if the object is paused.
+	if annotations.IsPaused(a) {
+		log.V(2).Info("Reconciliation is paused for this object")
+		return ctrl.Result{}, nil
+	}
+
+	// Initialize the patch helper
+	helper, err := patch.NewHelper(a, r.client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	defer func() {
+		// Always attempt to patch the object and status after each reconciliation.
+		a.Status.ObservedGeneration = a.Generation
+		if err := helper.Patch(ctx, a, patch.WithOwnedConditions{Conditions: []v1beta1.ConditionType{
+			v1beta1.ScalingActiveCondition,
+			v1beta1.AbleToScaleCondition,
+		}}); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	if !a.DeletionTimestamp.IsZero() {
+		r.recommendations.delete(rq.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+
+	return r.reconcile(ctx, a)
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, a *v1beta1.MinerSetAutoscaler) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	ms := &v1beta1.MinerSet{}
+	key := client.ObjectKey{Namespace: a.Namespace, Name: a.Spec.ScaleTargetRef.Name}
+	if err := r.client.Get(ctx, key, ms); err != nil {
+		conditions.MarkFalse(a, v1beta1.AbleToScaleCondition, v1beta1.FailedGetScaleTargetReason,
+			v1beta1.ConditionSeverityError, "Failed to get MinerSet %s: %v", key.Name, err)
+		record.Warnf(a, "FailedGetScaleTarget", "Failed to get MinerSet %s: %v", key.Name, err)
+		return ctrl.Result{}, err
+	}
+
+	currentReplicas := ptr.Deref(ms.Spec.Replicas, 1)
+	a.Status.CurrentReplicas = currentReplicas
+
+	metricStatuses, proposal, errs := r.computeReplicasForMetrics(ctx, a, ms, currentReplicas)
+	for _, err := range errs {
+		record.Warnf(a, "FailedGetMetrics", "%v", err)
+	}
+	if len(errs) != 0 && len(errs) == len(a.Spec.Metrics) {
+		// None of the metrics could be read, keep the current replica count.
+		conditions.MarkFalse(a, v1beta1.ScalingActiveCondition, v1beta1.FailedGetMetricsReason,
+			v1beta1.ConditionSeverityWarning, "The autoscaler was unable to read the metrics: %v", kerrors.NewAggregate(errs))
+		a.Status.CurrentMetrics = metricStatuses
+		a.Status.DesiredReplicas = currentReplicas
+		return ctrl.Result{RequeueAfter: resyncPeriod}, nil
+	}
+	conditions.MarkTrue(a, v1beta1.ScalingActiveCondition)
+	a.Status.CurrentMetrics = metricStatuses
+
+	recommendation := recommendReplicas(proposal, ptr.Deref(a.Spec.MinReplicas, 1), a.Spec.MaxReplicas, currentReplicas, len(errs) != 0)
+	if recommendation > currentReplicas {
+		remaining, err := r.remainingQuota(ctx, a.Namespace)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		// The MinerSet is not scaled up beyond the miner quota of the user.
+		if remaining != nil && recommendation > currentReplicas+*remaining {
+			limited := currentReplicas + max(*remaining, 0)
+			log.V(2).Info("Recommendation is limited by the miner quota", "recommendation", recommendation, "limited", limited)
+			record.Eventf(a, "ScaleLimitedByQuota", "Recommended size %d exceeds the miner quota, limited to %d", recommendation, limited)
+			recommendation = limited
+		}
+	}
+
+	upWindow, downWindow := stabilizationWindows(a.Spec.Behavior)
+	desiredReplicas := r.recommendations.stabilize(types.NamespacedName{Namespace: a.Namespace, Name: a.Name},
+		recommendation, currentReplicas, upWindow, downWindow, time.Now())
+	a.Status.DesiredReplicas = desiredReplicas
+
+	switch {
+	case desiredReplicas < recommendation:
+		conditions.MarkFalse(a, v1beta1.AbleToScaleCondition, v1beta1.ScaleUpStabilizedReason, v1beta1.ConditionSeverityInfo,
+			"Recent recommendations were lower than the current one, applying the lowest recent recommendation")
+	case desiredReplicas > recommendation:
+		conditions.MarkFalse(a, v1beta1.AbleToScaleCondition, v1beta1.ScaleDownStabilizedReason, v1beta1.ConditionSeverityInfo,
+			"Recent recommendations were higher than the current one, applying the highest recent recommendation")
+	default:
+		conditions.MarkTrue(a, v1beta1.AbleToScaleCondition)
+	}
+
+	if desiredReplicas == currentReplicas {
+		return ctrl.Result{RequeueAfter: resyncPeriod}, nil
+	}
+
+	patchBase := client.MergeFrom(ms.DeepCopy())
+	ms.Spec.Replicas = ptr.To(desiredReplicas)
+	if err := r.client.Patch(ctx, ms, patchBase); err != nil {
+		conditions.MarkFalse(a, v1beta1.AbleToScaleCondition, v1beta1.FailedUpdateScaleReason,
+			v1beta1.ConditionSeverityWarning, "The autoscaler was unable to update the MinerSet replicas: %v", err)
+		record.Warnf(a, "FailedRescale", "New size: %d; reason: %s; error: %v", desiredReplicas, rescaleReason(metricStatuses), err)
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Successfully rescaled MinerSet", "currentReplicas", currentReplicas, "desiredReplicas", desiredReplicas)
+	record.Eventf(a, "SuccessfulRescale", "New size: %d; reason: %s", desiredReplicas, rescaleReason(metricStatuses))
+	a.Status.CurrentReplicas = desiredReplicas
+	a.Status.LastScaleTime = ptr.To(metav1.Now())
+
+	return ctrl.Result{RequeueAfter: resyncPeriod}, nil
+}
+
+// recommendReplicas bounds the replica count proposed by the metrics to the limits of the
+// autoscaler. metricsFailed is set when some of the metrics could not be read.
+func recommendReplicas(proposal *int32, minReplicas, maxReplicas, currentReplicas int32, metricsFailed bool) int32 {
+	// When no metric recommends a replica count, e.g. all the schedules are inactive,
+	// the MinerSet is scaled to the lower limit.
+	recommendation := ptr.Deref(proposal, minReplicas)
+	recommendation = max(min(recommendation, maxReplicas), minReplicas)
+
+	// The metrics which could not be read may recommend more replicas than the others, so
+	// the MinerSet is only scaled up until they are read again, like the HorizontalPodAutoscaler.
+	if metricsFailed {
+		recommendation = max(recommendation, currentReplicas)
+	}
+
+	return recommendation
+}
+
+// remainingQuota returns the number of miners which can still be added to the namespace,
+// whose name is the user ID. It returns nil if the user has no miner quota.
+func (r *Reconciler) remainingQuota(ctx context.Context, namespace string) (*int32, error) {
+	if r.Quota == nil {
+		return nil, nil
+	}
+	limit, ok := r.Quota.Users[namespace]
+	if !ok {
+		limit = r.Quota.MaxMiners
+	}
+	if limit == 0 {
+		return nil, nil
+	}
+
+	miners := &v1beta1.MinerList{}
+	if err := r.client.List(ctx, miners, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	minerSets := &v1beta1.MinerSetList{}
+	if err := r.client.List(ctx, minerSets, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	ms := make([]*v1beta1.Miner, 0, len(miners.Items))
+	for i := range miners.Items {
+		ms = append(ms, &miners.Items[i])
+	}
+	mss := make([]*v1beta1.MinerSet, 0, len(minerSets.Items))
+	for i := range minerSets.Items {
+		mss = append(mss, &minerSets.Items[i])
+	}
+
+	return ptr.To(limit - int32(minerutil.CountQuotaMiners(ms, mss))), nil
+}
+
+// MinerSetToMinerSetAutoscalers is a handler.ToRequestsFunc to be used to enqueue requests for
+// the autoscalers targeting a MinerSet.
+func (r *Reconciler) MinerSetToMinerSetAutoscalers(ctx context.Context, o client.Object) []ctrl.Request {
+	ms, ok := o.(*v1beta1.MinerSet)
+	if !ok {
+		panic(fmt.Sprintf("Expected a MinerSet but got a %T", o))
+	}
+
+	autoscalers := &v1beta1.MinerSetAutoscalerList{}
+	if err := r.client.List(ctx, autoscalers, client.InNamespace(ms.Namespace)); err != nil {
+		return nil
+	}
+
+	var result []ctrl.Request
+	for _, a := range autoscalers.Items {
+		if a.Spec.ScaleTargetRef.Name == ms.Name {
+			result = append(result, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&a)})
+		}
+	}
+	return result
+}
+
+// rescaleReason describes the metrics which recommended the largest replica count.
+func rescaleReason(statuses []v1beta1.MinerSetMetricStatus) string {
+	var highest int32 = -1
+	var reasons []string
+	for _, status := range statuses {
+		if status.DesiredReplicas == nil || *status.DesiredReplicas < highest {
+			continue
+		}
+		if *status.DesiredReplicas > highest {
+			highest, reasons = *status.DesiredReplicas, nil
+		}
+		reasons = append(reasons, fmt.Sprintf("%s metric value %s", status.Type, status.Current.String()))
+	}
+	if len(reasons) == 0 {
+		return "no metric recommended a replica count"
+	}
+	return strings.Join(reasons, ", ")
+}
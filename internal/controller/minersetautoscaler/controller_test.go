@@ -0,0 +1,89 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package minersetautoscaler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/superproj/onex/internal/controller/miner/apis/config"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+)
+
+func TestRecommendReplicas(t *testing.T) {
+	testCases := []struct {
+		name          string
+		proposal      *int32
+		current       int32
+		metricsFailed bool
+		expected      int32
+	}{
+		{name: "scale up", proposal: ptr.To[int32](6), current: 3, expected: 6},
+		{name: "scale down", proposal: ptr.To[int32](2), current: 3, expected: 2},
+		{name: "bounded by max replicas", proposal: ptr.To[int32](20), current: 3, expected: 10},
+		{name: "no proposal scales to min replicas", current: 3, expected: 1},
+		{name: "scale up with failed metrics", proposal: ptr.To[int32](6), current: 3, metricsFailed: true, expected: 6},
+		{name: "no scale down with failed metrics", proposal: ptr.To[int32](2), current: 3, metricsFailed: true, expected: 3},
+		{name: "no proposal with failed metrics", current: 3, metricsFailed: true, expected: 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(recommendReplicas(tc.proposal, 1, 10, tc.current, tc.metricsFailed)).To(gomega.Equal(tc.expected))
+		})
+	}
+}
+
+func TestRemainingQuota(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	_ = v1beta1.AddToScheme(scheme)
+	owned := &v1beta1.Miner{ObjectMeta: metav1.ObjectMeta{
+		Namespace: "user-colin",
+		Name:      "owned",
+		OwnerReferences: []metav1.OwnerReference{{
+			APIVersion: v1beta1.SchemeGroupVersion.String(), Kind: "MinerSet", Name: "ms", UID: "uid", Controller: ptr.To(true),
+		}},
+	}}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&v1beta1.Miner{ObjectMeta: metav1.ObjectMeta{Namespace: "user-colin", Name: "standalone"}},
+			owned,
+			&v1beta1.MinerSet{ObjectMeta: metav1.ObjectMeta{Namespace: "user-colin", Name: "ms"}, Spec: v1beta1.MinerSetSpec{Replicas: ptr.To[int32](3)}},
+		).
+		Build()
+
+	r := &Reconciler{client: c}
+	remaining, err := r.remainingQuota(context.TODO(), "user-colin")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(remaining).To(gomega.BeNil())
+
+	// The miners of the minerset are counted by its replicas.
+	r.Quota = &config.MinerQuotaConfiguration{MaxMiners: 10}
+	remaining, err = r.remainingQuota(context.TODO(), "user-colin")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(remaining).To(gomega.Equal(ptr.To[int32](6)))
+
+	r.Quota.Users = map[string]int32{"user-colin": 2}
+	remaining, err = r.remainingQuota(context.TODO(), "user-colin")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(remaining).To(gomega.Equal(ptr.To[int32](-2)))
+
+	r.Quota.Users = map[string]int32{"user-colin": 0}
+	remaining, err = r.remainingQuota(context.TODO(), "user-colin")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(remaining).To(gomega.BeNil())
+}
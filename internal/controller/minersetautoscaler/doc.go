@@ -0,0 +1,8 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+// Package minersetautoscaler implements minersetautoscaler controller.
+package minersetautoscaler // import "github.com/superproj/onex/internal/controller/minersetautoscaler"
@@ -0,0 +1,321 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package minersetautoscaler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	onexclient "github.com/superproj/onex/internal/pkg/client"
+	"github.com/superproj/onex/internal/pkg/util/conditions"
+	coreutil "github.com/superproj/onex/internal/pkg/util/core"
+	minerutil "github.com/superproj/onex/internal/pkg/util/miner"
+	"github.com/superproj/onex/internal/toyblc/defaults"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+)
+
+// tolerance is how far from 1.0 the ratio between the current and the target value of
+// a metric must be before the MinerSet is scaled.
+const tolerance = 0.1
+
+// podMetricsList is the subset of the metrics.k8s.io PodMetricsList used by the autoscaler.
+type podMetricsList struct {
+	Items []struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Containers        []struct {
+			Name  string              `json:"name"`
+			Usage corev1.ResourceList `json:"usage"`
+		} `json:"containers"`
+	} `json:"items"`
+}
+
+// blockIntervalWindow is the number of the latest block intervals the block interval
+// of a chain is averaged over, so that it follows the current mining power of the chain.
+const blockIntervalWindow = 10
+
+// blockList is the subset of the toyblc block listing used by the autoscaler.
+type blockList struct {
+	Blocks []struct {
+		Index     int64 `json:"index"`
+		Timestamp int64 `json:"timestamp"`
+	} `json:"blocks"`
+}
+
+// computeReplicasForMetrics computes the replica count recommended by each metric of the
+// autoscaler, and returns the largest one. The returned replica count is nil if no metric
+// recommends any, and the errors contain one entry for every metric which failed.
+func (r *Reconciler) computeReplicasForMetrics(
+	ctx context.Context,
+	a *v1beta1.MinerSetAutoscaler,
+	ms *v1beta1.MinerSet,
+	currentReplicas int32,
+) ([]v1beta1.MinerSetMetricStatus, *int32, []error) {
+	var proposal *int32
+	var errs []error
+	statuses := make([]v1beta1.MinerSetMetricStatus, 0, len(a.Spec.Metrics))
+	for i := range a.Spec.Metrics {
+		status, err := r.computeReplicasForMetric(ctx, &a.Spec.Metrics[i], a.Namespace, ms, currentReplicas)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to get %s metric: %w", a.Spec.Metrics[i].Type, err))
+			continue
+		}
+		statuses = append(statuses, status)
+		if status.DesiredReplicas != nil && (proposal == nil || *status.DesiredReplicas > *proposal) {
+			proposal = status.DesiredReplicas
+		}
+	}
+
+	return statuses, proposal, errs
+}
+
+func (r *Reconciler) computeReplicasForMetric(
+	ctx context.Context,
+	metric *v1beta1.MinerSetMetricSpec,
+	namespace string,
+	ms *v1beta1.MinerSet,
+	currentReplicas int32,
+) (v1beta1.MinerSetMetricStatus, error) {
+	status := v1beta1.MinerSetMetricStatus{Type: metric.Type}
+
+	switch {
+	case metric.Type == v1beta1.PodCPUMetricSourceType && metric.PodCPU != nil:
+		utilization, readyPods, err := r.getPodCPUUtilization(ctx, ms)
+		if err != nil {
+			return status, err
+		}
+		status.Current = *resource.NewQuantity(utilization, resource.DecimalSI)
+		status.DesiredReplicas = ptr.To(replicasForRatio(
+			float64(utilization)/float64(metric.PodCPU.TargetAverageUtilization), readyPods, currentReplicas))
+	case metric.Type == v1beta1.ChainBlockIntervalMetricSourceType && metric.ChainBlockInterval != nil:
+		interval, err := r.getBlockInterval(ctx, ms.Spec.Template.Spec.ChainName)
+		if err != nil {
+			return status, err
+		}
+		status.Current = *resource.NewMilliQuantity(int64(interval*1000), resource.DecimalSI)
+		// The block interval is not meaningful until blocks are mined.
+		if interval > 0 {
+			status.DesiredReplicas = ptr.To(replicasForRatio(
+				interval/float64(metric.ChainBlockInterval.TargetSeconds), currentReplicas, currentReplicas))
+		}
+	case metric.Type == v1beta1.PendingChargeRequestsMetricSourceType && metric.PendingChargeRequests != nil:
+		pending, err := r.countPendingChargeRequests(ctx, namespace)
+		if err != nil {
+			return status, err
+		}
+		status.Current = *resource.NewQuantity(int64(pending), resource.DecimalSI)
+		status.DesiredReplicas = ptr.To(int32(math.Ceil(float64(pending) / float64(metric.PendingChargeRequests.TargetPerReplica))))
+	case metric.Type == v1beta1.ScheduleMetricSourceType && metric.Schedule != nil:
+		active, err := isScheduleActive(metric.Schedule, time.Now())
+		if err != nil {
+			return status, err
+		}
+		status.Current = *resource.NewQuantity(0, resource.DecimalSI)
+		if active {
+			status.Current = *resource.NewQuantity(1, resource.DecimalSI)
+			status.DesiredReplicas = ptr.To(metric.Schedule.Replicas)
+		}
+	default:
+		return status, fmt.Errorf("unsupported metric source type %q", metric.Type)
+	}
+
+	return status, nil
+}
+
+// replicasForRatio returns the replica count needed to bring the ratio between the current
+// and the target value of a metric to 1, from the replica count the metric was read on.
+// The current replica count is kept if the ratio is within the tolerance.
+func replicasForRatio(ratio float64, measuredReplicas, currentReplicas int32) int32 {
+	if math.Abs(1.0-ratio) <= tolerance {
+		return currentReplicas
+	}
+	return int32(math.Ceil(ratio * float64(max(measuredReplicas, 1))))
+}
+
+// getPodCPUUtilization returns the average CPU utilization of the ready miner pods of
+// the MinerSet, as a percentage of their CPU requests, and the number of pods measured.
+func (r *Reconciler) getPodCPUUtilization(ctx context.Context, ms *v1beta1.MinerSet) (int64, int32, error) {
+	if r.ProviderClient == nil || r.ProviderClient.Discovery().RESTClient() == nil {
+		return 0, 0, fmt.Errorf("no provider cluster client configured")
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&ms.Spec.Selector)
+	if err != nil {
+		return 0, 0, err
+	}
+	miners := &v1beta1.MinerList{}
+	if err := r.client.List(ctx, miners, client.InNamespace(ms.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return 0, 0, err
+	}
+
+	// The pods are named after their miners.
+	pods, err := r.ProviderClient.CoreV1().Pods(ms.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return 0, 0, err
+	}
+	requests := make(map[string]int64, len(pods.Items))
+	for _, pod := range pods.Items {
+		if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		requests[pod.Name] = podCPURequest(&pod)
+	}
+
+	raw, err := r.ProviderClient.Discovery().RESTClient().Get().
+		AbsPath("/apis/metrics.k8s.io/v1beta1/namespaces", ms.Namespace, "pods").
+		DoRaw(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	metrics := &podMetricsList{}
+	if err := json.Unmarshal(raw, metrics); err != nil {
+		return 0, 0, err
+	}
+	usages := make(map[string]int64, len(metrics.Items))
+	for _, item := range metrics.Items {
+		for _, c := range item.Containers {
+			usages[item.Name] += c.Usage.Cpu().MilliValue()
+		}
+	}
+
+	var totalUsage, totalRequests int64
+	var measured int32
+	for _, m := range miners.Items {
+		usage, hasUsage := usages[m.Name]
+		request, hasRequest := requests[m.Name]
+		if !hasUsage || !hasRequest || request == 0 {
+			continue
+		}
+		totalUsage += usage
+		totalRequests += request
+		measured++
+	}
+	if measured == 0 {
+		return 0, 0, fmt.Errorf("no CPU metrics returned for the miner pods of MinerSet %s", ms.Name)
+	}
+
+	return totalUsage * 100 / totalRequests, measured, nil
+}
+
+// podCPURequest returns the CPU requests of the pod in millicores, using the limits of the
+// containers which do not request any CPU.
+func podCPURequest(pod *corev1.Pod) int64 {
+	var total int64
+	for _, c := range pod.Spec.Containers {
+		if request, ok := c.Resources.Requests[corev1.ResourceCPU]; ok {
+			total += request.MilliValue()
+		} else if limit, ok := c.Resources.Limits[corev1.ResourceCPU]; ok {
+			total += limit.MilliValue()
+		}
+	}
+	return total
+}
+
+// getBlockInterval returns the average time in seconds between the latest blocks of the
+// chain, read from its genesis miner. It returns 0 if not enough blocks have been mined.
+func (r *Reconciler) getBlockInterval(ctx context.Context, chainName string) (float64, error) {
+	ch, err := coreutil.GetChainByName(ctx, r.client, metav1.NamespaceSystem, chainName)
+	if err != nil {
+		return 0, err
+	}
+	if ch.Status.MinerRef == nil {
+		return 0, fmt.Errorf("chain %s has no genesis miner yet", chainName)
+	}
+
+	// The http port of the genesis miner service.
+	url := fmt.Sprintf("http://%s:8080", minerutil.GenesisDNSServiceNameFromMiner(ch.Status.MinerRef.Name))
+	list := &blockList{}
+	resp, err := onexclient.NewRequest(url).
+		SetContext(ctx).
+		SetBasicAuth("onex", defaults.Accounts["onex"]).
+		SetQueryParam("limit", strconv.Itoa(blockIntervalWindow+1)).
+		SetQueryParam("desc", "true").
+		SetResult(list).
+		Get("/v1/blocks")
+	if err != nil {
+		return 0, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return 0, fmt.Errorf("failed to get blocks of chain %s: %s", chainName, resp.Status())
+	}
+
+	return averageBlockInterval(list), nil
+}
+
+// averageBlockInterval returns the average time in seconds between the blocks, which are
+// listed from the tip of the chain. The genesis block has a made-up timestamp, so it is
+// left out. It returns 0 if there are less than two other blocks.
+func averageBlockInterval(list *blockList) float64 {
+	blocks := list.Blocks
+	if n := len(blocks); n > 0 && blocks[n-1].Index == 0 {
+		blocks = blocks[:n-1]
+	}
+	if len(blocks) < 2 {
+		return 0
+	}
+
+	newest, oldest := blocks[0], blocks[len(blocks)-1]
+	return float64(newest.Timestamp-oldest.Timestamp) / float64(len(blocks)-1)
+}
+
+// countPendingChargeRequests returns the number of ChargeRequests in the namespace which
+// neither succeeded nor failed yet.
+func (r *Reconciler) countPendingChargeRequests(ctx context.Context, namespace string) (int, error) {
+	// ChargeRequests are read as unstructured objects, so that the autoscaler keeps working
+	// against an apiserver which does not serve them.
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(v1beta1.SchemeGroupVersion.WithKind("ChargeRequestList"))
+	if err := r.APIReader.List(ctx, list, client.InNamespace(namespace)); err != nil {
+		if meta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	pending := 0
+	for _, item := range list.Items {
+		cr := &v1beta1.ChargeRequest{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, cr); err != nil {
+			return 0, err
+		}
+		if !cr.DeletionTimestamp.IsZero() ||
+			conditions.IsTrue(cr, v1beta1.ChargeSucceeded) || conditions.IsTrue(cr, v1beta1.ChargeFailed) {
+			continue
+		}
+		pending++
+	}
+
+	return pending, nil
+}
+
+// isScheduleActive reports whether the time window of the schedule contains the given time,
+// that is whether the schedule fired during the last duration.
+func isScheduleActive(src *v1beta1.ScheduleMetricSource, now time.Time) (bool, error) {
+	schedule, err := cron.ParseStandard(src.Schedule)
+	if err != nil {
+		return false, err
+	}
+
+	// The first activation after the beginning of the window, if any, is in the window.
+	next := schedule.Next(now.Add(-src.Duration.Duration))
+	return !next.After(now), nil
+}
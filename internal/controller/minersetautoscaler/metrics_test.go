@@ -0,0 +1,111 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package minersetautoscaler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+)
+
+func TestReplicasForRatio(t *testing.T) {
+	testCases := []struct {
+		name             string
+		ratio            float64
+		measuredReplicas int32
+		currentReplicas  int32
+		expected         int32
+	}{
+		{name: "within tolerance keeps the current replicas", ratio: 1.05, measuredReplicas: 3, currentReplicas: 4, expected: 4},
+		{name: "scale up rounds up", ratio: 1.5, measuredReplicas: 3, currentReplicas: 3, expected: 5},
+		{name: "scale down", ratio: 0.5, measuredReplicas: 4, currentReplicas: 4, expected: 2},
+		{name: "scale from zero", ratio: 2, measuredReplicas: 0, currentReplicas: 0, expected: 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(replicasForRatio(tc.ratio, tc.measuredReplicas, tc.currentReplicas)).To(gomega.Equal(tc.expected))
+		})
+	}
+}
+
+func TestIsScheduleActive(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	src := &v1beta1.ScheduleMetricSource{
+		Schedule: "CRON_TZ=UTC 0 8 * * *",
+		Duration: metav1.Duration{Duration: 2 * time.Hour},
+		Replicas: 3,
+	}
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 1, 1, hour, minute, 0, 0, time.UTC)
+	}
+
+	for now, expected := range map[time.Time]bool{
+		at(7, 59): false,
+		at(8, 0):  true,
+		at(9, 30): true,
+		at(10, 0): false,
+		at(23, 0): false,
+		at(0, 0):  false,
+		at(8, 1):  true,
+		at(10, 1): false,
+		at(9, 59): true,
+		at(12, 0): false,
+	} {
+		active, err := isScheduleActive(src, now)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(active).To(gomega.Equal(expected), "at %s", now)
+	}
+
+	_, err := isScheduleActive(&v1beta1.ScheduleMetricSource{Schedule: "invalid"}, at(8, 0))
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestRescaleReason(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	g.Expect(rescaleReason(nil)).To(gomega.Equal("no metric recommended a replica count"))
+
+	statuses := []v1beta1.MinerSetMetricStatus{
+		{Type: v1beta1.ScheduleMetricSourceType},
+		{Type: v1beta1.PendingChargeRequestsMetricSourceType, DesiredReplicas: ptr.To[int32](2)},
+		{Type: v1beta1.PodCPUMetricSourceType, DesiredReplicas: ptr.To[int32](5)},
+	}
+	statuses[2].Current.Set(120)
+	g.Expect(rescaleReason(statuses)).To(gomega.Equal("PodCPU metric value 120"))
+}
+
+func TestAverageBlockInterval(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	newList := func(timestamps ...int64) *blockList {
+		list := &blockList{}
+		for i, ts := range timestamps {
+			list.Blocks = append(list.Blocks, struct {
+				Index     int64 `json:"index"`
+				Timestamp int64 `json:"timestamp"`
+			}{Index: int64(len(timestamps) - 1 - i), Timestamp: ts})
+		}
+		return list
+	}
+
+	// Listed from the tip, the genesis block with its made-up timestamp is left out.
+	g.Expect(averageBlockInterval(newList(160, 150, 130, 1))).To(gomega.Equal(15.0))
+	// Only the listed window counts, not the blocks mined before it.
+	list := newList(1000, 990, 980)
+	list.Blocks[2].Index = 500
+	g.Expect(averageBlockInterval(list)).To(gomega.Equal(10.0))
+	g.Expect(averageBlockInterval(newList(100, 1))).To(gomega.Equal(0.0))
+	g.Expect(averageBlockInterval(&blockList{})).To(gomega.Equal(0.0))
+}
@@ -0,0 +1,101 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package minersetautoscaler
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+)
+
+// Default stabilization windows, used when the behavior of the autoscaler is not set.
+const (
+	defaultScaleUpStabilizationWindow   = 0
+	defaultScaleDownStabilizationWindow = 300 * time.Second
+)
+
+// timestampedRecommendation is a replica count recommended at a given time.
+type timestampedRecommendation struct {
+	replicas  int32
+	timestamp time.Time
+}
+
+// recommendationHistory keeps the recent recommendations of every autoscaler, so that
+// scaling decisions can be stabilized over a time window.
+type recommendationHistory struct {
+	mu    sync.Mutex
+	items map[types.NamespacedName][]timestampedRecommendation
+}
+
+func newRecommendationHistory() *recommendationHistory {
+	return &recommendationHistory{items: make(map[types.NamespacedName][]timestampedRecommendation)}
+}
+
+// stabilize records the recommendation and returns the replica count to scale to: the
+// lowest recommendation of the scale up window when scaling up, and the highest
+// recommendation of the scale down window when scaling down.
+func (h *recommendationHistory) stabilize(key types.NamespacedName, recommendation, currentReplicas int32,
+	upWindow, downWindow time.Duration, now time.Time,
+) int32 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// Drop the recommendations older than the longest window.
+	cutoff := now.Add(-max(upWindow, downWindow))
+	recommendations := []timestampedRecommendation{{replicas: recommendation, timestamp: now}}
+	for _, rec := range h.items[key] {
+		if rec.timestamp.After(cutoff) {
+			recommendations = append(recommendations, rec)
+		}
+	}
+	h.items[key] = recommendations
+
+	upRecommendation, downRecommendation := recommendation, recommendation
+	for _, rec := range recommendations {
+		if !rec.timestamp.Before(now.Add(-upWindow)) {
+			upRecommendation = min(upRecommendation, rec.replicas)
+		}
+		if !rec.timestamp.Before(now.Add(-downWindow)) {
+			downRecommendation = max(downRecommendation, rec.replicas)
+		}
+	}
+
+	desired := currentReplicas
+	if desired < upRecommendation {
+		desired = upRecommendation
+	}
+	if desired > downRecommendation {
+		desired = downRecommendation
+	}
+	return desired
+}
+
+// delete forgets the recommendations of an autoscaler.
+func (h *recommendationHistory) delete(key types.NamespacedName) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.items, key)
+}
+
+// stabilizationWindows returns the scale up and scale down stabilization windows of the behavior.
+func stabilizationWindows(behavior *v1beta1.MinerSetAutoscalerBehavior) (time.Duration, time.Duration) {
+	up, down := time.Duration(defaultScaleUpStabilizationWindow), defaultScaleDownStabilizationWindow
+	if behavior == nil {
+		return up, down
+	}
+	if behavior.ScaleUp != nil && behavior.ScaleUp.StabilizationWindowSeconds != nil {
+		up = time.Duration(*behavior.ScaleUp.StabilizationWindowSeconds) * time.Second
+	}
+	if behavior.ScaleDown != nil && behavior.ScaleDown.StabilizationWindowSeconds != nil {
+		down = time.Duration(*behavior.ScaleDown.StabilizationWindowSeconds) * time.Second
+	}
+	return up, down
+}
@@ -0,0 +1,57 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package minersetautoscaler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+)
+
+func TestStabilize(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	h := newRecommendationHistory()
+	key := types.NamespacedName{Namespace: "default", Name: "autoscaler"}
+	up, down := time.Minute, 5*time.Minute
+	start := time.Now()
+
+	// The first recommendation is applied immediately.
+	g.Expect(h.stabilize(key, 4, 2, up, down, start)).To(gomega.Equal(int32(4)))
+
+	// A lower recommendation is held back by the scale down window.
+	g.Expect(h.stabilize(key, 1, 4, up, down, start.Add(time.Minute))).To(gomega.Equal(int32(4)))
+
+	// A higher recommendation scales up to the lowest recommendation of the scale up window.
+	g.Expect(h.stabilize(key, 6, 4, up, down, start.Add(90*time.Second))).To(gomega.Equal(int32(4)))
+	g.Expect(h.stabilize(key, 6, 4, up, down, start.Add(3*time.Minute))).To(gomega.Equal(int32(6)))
+
+	// Once the higher recommendations leave the scale down window, the MinerSet is scaled down.
+	g.Expect(h.stabilize(key, 1, 6, up, down, start.Add(9*time.Minute))).To(gomega.Equal(int32(1)))
+
+	h.delete(key)
+	g.Expect(h.items).NotTo(gomega.HaveKey(key))
+}
+
+func TestStabilizationWindows(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	up, down := stabilizationWindows(nil)
+	g.Expect(up).To(gomega.Equal(time.Duration(0)))
+	g.Expect(down).To(gomega.Equal(5 * time.Minute))
+
+	up, down = stabilizationWindows(&v1beta1.MinerSetAutoscalerBehavior{
+		ScaleUp: &v1beta1.MinerSetScalingRules{StabilizationWindowSeconds: ptr.To[int32](30)},
+	})
+	g.Expect(up).To(gomega.Equal(30 * time.Second))
+	g.Expect(down).To(gomega.Equal(5 * time.Minute))
+}
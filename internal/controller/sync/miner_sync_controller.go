@@ -96,6 +96,7 @@ func applyToMiner(mr *gwmodel.MinerM, m *v1beta1.Miner) *gwmodel.MinerM {
 	mr.Phase = m.Status.Phase
 	mr.MinerType = m.Spec.MinerType
 	mr.ChainName = m.Spec.ChainName
+	mr.Labels = labelsToString(m.Labels)
 
 	if mr.CPU == 0 || mr.Memory == 0 {
 		mr.CPU, mr.Memory = GetMinerConfig(m.Annotations)
@@ -124,3 +125,14 @@ func GetMinerConfig(annotations map[string]string) (cpu int32, mem int32) {
 
 	return cpu, mem
 }
+
+// labelsToString encodes the labels as a json object, which is queried by the label selectors of the gateway.
+func labelsToString(labels map[string]string) string {
+	if len(labels) == 0 {
+		return "{}"
+	}
+
+	//nolint:errchkjson
+	data, _ := json.Marshal(labels)
+	return string(data)
+}
@@ -92,6 +92,9 @@ func applyToMinerSet(msr *gwmodel.MinerSetM, ms *v1beta1.MinerSet) *gwmodel.Mine
 	msr.Name = ms.Name
 	msr.Replicas = *ms.Spec.Replicas
 	msr.DisplayName = ms.Spec.DisplayName
+	msr.ChainName = ms.Spec.Template.Spec.ChainName
+	msr.MinerType = ms.Spec.Template.Spec.MinerType
+	msr.Labels = labelsToString(ms.Labels)
 	msr.DeletePolicy = ms.Spec.DeletePolicy
 	msr.MinReadySeconds = ms.Spec.MinReadySeconds
 	msr.FullyLabeledReplicas = ms.Status.FullyLabeledReplicas
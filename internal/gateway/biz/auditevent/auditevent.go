@@ -0,0 +1,94 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package auditevent
+
+//go:generate mockgen -self_package github.com/superproj/onex/internal/gateway/biz/auditevent -destination mock_auditevent.go -package auditevent github.com/superproj/onex/internal/gateway/biz/auditevent AuditEventBiz
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/superproj/onex/internal/gateway/store"
+	"github.com/superproj/onex/internal/pkg/meta"
+	"github.com/superproj/onex/internal/pkg/onexx"
+	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+	"github.com/superproj/onex/pkg/log"
+)
+
+// AuditEventBiz defines functions used to handle audit event rquest.
+// The events are written by the mysql sink of the auditor, a user can only list its own events.
+type AuditEventBiz interface {
+	List(ctx context.Context, rq *v1.ListAuditEventsRequest) (*v1.ListAuditEventsResponse, error)
+}
+
+type auditEventBiz struct {
+	ds store.IStore
+}
+
+var _ AuditEventBiz = (*auditEventBiz)(nil)
+
+func New(ds store.IStore) *auditEventBiz {
+	return &auditEventBiz{ds}
+}
+
+func (b *auditEventBiz) List(ctx context.Context, rq *v1.ListAuditEventsRequest) (*v1.ListAuditEventsResponse, error) {
+	filters := map[string]any{}
+	if rq.Operation != "" {
+		filters["operation"] = rq.Operation
+	}
+	if rq.Resource != "" {
+		filters["resource"] = rq.Resource
+	}
+	if rq.Name != "" {
+		filters["name"] = rq.Name
+	}
+
+	var since, until time.Time
+	if rq.Since != nil {
+		since = rq.Since.AsTime()
+	}
+	if rq.Until != nil {
+		until = rq.Until.AsTime()
+	}
+
+	total, list, err := b.ds.AuditEvents().List(
+		ctx,
+		onexx.FromUserID(ctx),
+		since,
+		until,
+		meta.WithFilter(filters),
+		meta.WithOffset(rq.Offset),
+		meta.WithLimit(rq.Limit),
+	)
+	if err != nil {
+		log.C(ctx).Errorw(err, "Failed to list audit events")
+		return nil, err
+	}
+
+	events := make([]*v1.AuditEvent, 0, len(list))
+	for _, item := range list {
+		events = append(events, &v1.AuditEvent{
+			EventID:   item.EventID,
+			Time:      timestamppb.New(item.Time),
+			UserID:    item.UserID,
+			SecretID:  item.SecretID,
+			Operation: item.Operation,
+			Resource:  item.Resource,
+			Name:      item.Name,
+			Request:   item.Request,
+			Diff:      item.Diff,
+			Code:      item.Code,
+			Reason:    item.Reason,
+			Message:   item.Message,
+			TraceID:   item.TraceID,
+		})
+	}
+
+	return &v1.ListAuditEventsResponse{TotalCount: total, Events: events}, nil
+}
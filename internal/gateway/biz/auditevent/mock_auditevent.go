@@ -0,0 +1,57 @@
+// Copyright 2024 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/superproj/onex/internal/gateway/biz/auditevent (interfaces: AuditEventBiz)
+
+// Package auditevent is a generated GoMock package.
+package auditevent
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+)
+
+// MockAuditEventBiz is a mock of AuditEventBiz interface.
+type MockAuditEventBiz struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditEventBizMockRecorder
+}
+
+// MockAuditEventBizMockRecorder is the mock recorder for MockAuditEventBiz.
+type MockAuditEventBizMockRecorder struct {
+	mock *MockAuditEventBiz
+}
+
+// NewMockAuditEventBiz creates a new mock instance.
+func NewMockAuditEventBiz(ctrl *gomock.Controller) *MockAuditEventBiz {
+	mock := &MockAuditEventBiz{ctrl: ctrl}
+	mock.recorder = &MockAuditEventBizMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditEventBiz) EXPECT() *MockAuditEventBizMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockAuditEventBiz) List(arg0 context.Context, arg1 *v1.ListAuditEventsRequest) (*v1.ListAuditEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ListAuditEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAuditEventBizMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAuditEventBiz)(nil).List), arg0, arg1)
+}
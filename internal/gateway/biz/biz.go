@@ -10,10 +10,19 @@ package biz
 
 import (
 	"github.com/google/wire"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/superproj/onex/internal/gateway/biz/auditevent"
+	"github.com/superproj/onex/internal/gateway/biz/chain"
+	"github.com/superproj/onex/internal/gateway/biz/chargerequest"
 	"github.com/superproj/onex/internal/gateway/biz/miner"
+	"github.com/superproj/onex/internal/gateway/biz/minerclass"
 	"github.com/superproj/onex/internal/gateway/biz/minerset"
+	"github.com/superproj/onex/internal/gateway/quota"
 	"github.com/superproj/onex/internal/gateway/store"
+	"github.com/superproj/onex/internal/gateway/watcher"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
 	clientset "github.com/superproj/onex/pkg/generated/clientset/versioned"
 	"github.com/superproj/onex/pkg/generated/informers"
 )
@@ -23,25 +32,63 @@ var ProviderSet = wire.NewSet(NewBiz, wire.Bind(new(IBiz), new(*biz)))
 
 // IBiz defines functions used to return resource interface.
 type IBiz interface {
+	Chains() chain.ChainBiz
 	Miners() miner.MinerBiz
 	MinerSets() minerset.MinerSetBiz
+	AuditEvents() auditevent.AuditEventBiz
+	ChargeRequests() chargerequest.ChargeRequestBiz
+	MinerClasses() minerclass.MinerClassBiz
 }
 
 type biz struct {
 	ds store.IStore
 	cl clientset.Interface
 	f  informers.SharedInformerFactory
+	qc *quota.Checker
+	// The broadcasters live as long as the gateway, so that the watches can be resumed.
+	msbc *watcher.Broadcaster
+	mbc  *watcher.Broadcaster
 }
 
 // NewBiz returns IBiz interface.
-func NewBiz(ds store.IStore, cl clientset.Interface, f informers.SharedInformerFactory) *biz {
-	return &biz{ds, cl, f}
+func NewBiz(ds store.IStore, cl clientset.Interface, f informers.SharedInformerFactory, qc *quota.Checker) (*biz, error) {
+	msbc, err := watcher.New(f.Apps().V1beta1().MinerSets().Informer(), func(rv string) runtime.Object {
+		return &v1beta1.MinerSet{ObjectMeta: metav1.ObjectMeta{ResourceVersion: rv}}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mbc, err := watcher.New(f.Apps().V1beta1().Miners().Informer(), func(rv string) runtime.Object {
+		return &v1beta1.Miner{ObjectMeta: metav1.ObjectMeta{ResourceVersion: rv}}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &biz{ds: ds, cl: cl, f: f, qc: qc, msbc: msbc, mbc: mbc}, nil
+}
+
+func (b *biz) Chains() chain.ChainBiz {
+	return chain.New(b.ds, b.cl)
 }
 
 func (b *biz) MinerSets() minerset.MinerSetBiz {
-	return minerset.New(b.ds, b.cl, b.f)
+	return minerset.New(b.ds, b.cl, b.f, b.qc, b.msbc)
 }
 
 func (b *biz) Miners() miner.MinerBiz {
-	return miner.New(b.ds, b.cl, b.f)
+	return miner.New(b.ds, b.cl, b.f, b.qc, b.mbc)
+}
+
+func (b *biz) AuditEvents() auditevent.AuditEventBiz {
+	return auditevent.New(b.ds)
+}
+
+func (b *biz) ChargeRequests() chargerequest.ChargeRequestBiz {
+	return chargerequest.New(b.cl)
+}
+
+func (b *biz) MinerClasses() minerclass.MinerClassBiz {
+	return minerclass.New(b.f)
 }
@@ -0,0 +1,160 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package chain
+
+//go:generate mockgen -self_package github.com/superproj/onex/internal/gateway/biz/chain -destination mock_chain.go -package chain github.com/superproj/onex/internal/gateway/biz/chain ChainBiz
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jinzhu/copier"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/superproj/onex/internal/gateway/store"
+	"github.com/superproj/onex/internal/pkg/meta"
+	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+	"github.com/superproj/onex/pkg/api/zerrors"
+	"github.com/superproj/onex/pkg/apis/apps"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+	"github.com/superproj/onex/pkg/apis/apps/validation"
+	clientset "github.com/superproj/onex/pkg/generated/clientset/versioned"
+	"github.com/superproj/onex/pkg/log"
+)
+
+// ChainBiz defines functions used to handle chain rquest.
+// Chains are shared by all the users, and are all stored in the `kube-system` namespace.
+type ChainBiz interface {
+	Create(ctx context.Context, ch *v1beta1.Chain) error
+	List(ctx context.Context, rq *v1.ListChainRequest) (*v1.ListChainResponse, error)
+	Get(ctx context.Context, name string) (*v1.Chain, error)
+	Update(ctx context.Context, ch *v1beta1.Chain) error
+	Delete(ctx context.Context, name string) error
+}
+
+type chainBiz struct {
+	ds     store.IStore
+	client clientset.Interface
+}
+
+var _ ChainBiz = (*chainBiz)(nil)
+
+func New(ds store.IStore, client clientset.Interface) *chainBiz {
+	return &chainBiz{ds, client}
+}
+
+func (b *chainBiz) Create(ctx context.Context, ch *v1beta1.Chain) error {
+	ch.Namespace = metav1.NamespaceSystem
+	if _, err := b.client.AppsV1beta1().Chains(ch.Namespace).Create(ctx, ch, metav1.CreateOptions{}); err != nil {
+		log.C(ctx).Errorw(err, "Failed to create chain", "chain", klog.KObj(ch))
+		return convertError(err, ch.Name)
+	}
+
+	return nil
+}
+
+// List reads the chains from the table synced by the ChainSyncReconciler.
+func (b *chainBiz) List(ctx context.Context, rq *v1.ListChainRequest) (*v1.ListChainResponse, error) {
+	total, list, err := b.ds.Chains().List(ctx, metav1.NamespaceSystem, meta.WithOffset(rq.Offset), meta.WithLimit(rq.Limit))
+	if err != nil {
+		log.C(ctx).Errorw(err, "Failed to list chain")
+		return nil, err
+	}
+
+	chains := make([]*v1.Chain, 0, len(list))
+	for _, item := range list {
+		var ch v1.Chain
+		_ = copier.Copy(&ch, &item)
+		ch.CreatedAt = timestamppb.New(item.CreatedAt)
+		ch.UpdatedAt = timestamppb.New(item.UpdatedAt)
+		chains = append(chains, &ch)
+	}
+
+	return &v1.ListChainResponse{TotalCount: total, Chains: chains}, nil
+}
+
+// Get reads the chain from the table synced by the ChainSyncReconciler.
+func (b *chainBiz) Get(ctx context.Context, name string) (*v1.Chain, error) {
+	item, err := b.ds.Chains().Get(ctx, map[string]any{"namespace": metav1.NamespaceSystem, "name": name})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorChainNotFound("chain %s not found", name)
+		}
+
+		log.C(ctx).Errorw(err, "Failed to retrieve chain", "chain", klog.KRef(metav1.NamespaceSystem, name))
+		return nil, err
+	}
+
+	var ch v1.Chain
+	_ = copier.Copy(&ch, item)
+	ch.CreatedAt = timestamppb.New(item.CreatedAt)
+	ch.UpdatedAt = timestamppb.New(item.UpdatedAt)
+
+	return &ch, nil
+}
+
+// Update updates the spec of the chain. The fields generated by OneX, e.g. the
+// bootstrap account, are kept.
+func (b *chainBiz) Update(ctx context.Context, ch *v1beta1.Chain) error {
+	old, err := b.client.AppsV1beta1().Chains(metav1.NamespaceSystem).Get(ctx, ch.Name, metav1.GetOptions{})
+	if err != nil {
+		return convertError(err, ch.Name)
+	}
+
+	update := old.DeepCopy()
+	if ch.Labels != nil {
+		update.Labels = ch.Labels
+	}
+	if ch.Annotations != nil {
+		update.Annotations = ch.Annotations
+	}
+	update.Spec.DisplayName = ch.Spec.DisplayName
+	update.Spec.MinerType = ch.Spec.MinerType
+	update.Spec.Image = ch.Spec.Image
+	update.Spec.MinMineIntervalSeconds = ch.Spec.MinMineIntervalSeconds
+
+	var newObj, oldObj apps.Chain
+	_ = v1beta1.Convert_v1beta1_Chain_To_apps_Chain(update, &newObj, nil)
+	_ = v1beta1.Convert_v1beta1_Chain_To_apps_Chain(old, &oldObj, nil)
+	if errs := validation.ValidateChainUpdate(&newObj, &oldObj); len(errs) != 0 {
+		return zerrors.ErrorInvalidParameter(errs.ToAggregate().Error())
+	}
+
+	if _, err := b.client.AppsV1beta1().Chains(metav1.NamespaceSystem).Update(ctx, update, metav1.UpdateOptions{}); err != nil {
+		log.C(ctx).Errorw(err, "Failed to update chain", "chain", klog.KObj(update))
+		return convertError(err, ch.Name)
+	}
+
+	return nil
+}
+
+func (b *chainBiz) Delete(ctx context.Context, name string) error {
+	if err := b.client.AppsV1beta1().Chains(metav1.NamespaceSystem).Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
+		log.C(ctx).Errorw(err, "Failed to delete chain", "chain", klog.KRef(metav1.NamespaceSystem, name))
+		return convertError(err, name)
+	}
+
+	return nil
+}
+
+// convertError converts the errors returned by onex-apiserver to the gateway errors.
+func convertError(err error, name string) error {
+	switch {
+	case apierrors.IsNotFound(err):
+		return v1.ErrorChainNotFound("chain %s not found", name)
+	case apierrors.IsAlreadyExists(err):
+		return v1.ErrorChainAlreadyExists("chain %s already exists", name)
+	case apierrors.IsInvalid(err):
+		return zerrors.ErrorInvalidParameter(err.Error())
+	}
+
+	return err
+}
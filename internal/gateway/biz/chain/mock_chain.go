@@ -0,0 +1,115 @@
+// Copyright 2024 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/superproj/onex/internal/gateway/biz/chain (interfaces: ChainBiz)
+
+// Package chain is a generated GoMock package.
+package chain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+	v1beta1 "github.com/superproj/onex/pkg/apis/apps/v1beta1"
+)
+
+// MockChainBiz is a mock of ChainBiz interface.
+type MockChainBiz struct {
+	ctrl     *gomock.Controller
+	recorder *MockChainBizMockRecorder
+}
+
+// MockChainBizMockRecorder is the mock recorder for MockChainBiz.
+type MockChainBizMockRecorder struct {
+	mock *MockChainBiz
+}
+
+// NewMockChainBiz creates a new mock instance.
+func NewMockChainBiz(ctrl *gomock.Controller) *MockChainBiz {
+	mock := &MockChainBiz{ctrl: ctrl}
+	mock.recorder = &MockChainBizMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChainBiz) EXPECT() *MockChainBizMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockChainBiz) Create(arg0 context.Context, arg1 *v1beta1.Chain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockChainBizMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockChainBiz)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockChainBiz) Delete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockChainBizMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockChainBiz)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockChainBiz) Get(arg0 context.Context, arg1 string) (*v1.Chain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*v1.Chain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockChainBizMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockChainBiz)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockChainBiz) List(arg0 context.Context, arg1 *v1.ListChainRequest) (*v1.ListChainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ListChainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockChainBizMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockChainBiz)(nil).List), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockChainBiz) Update(arg0 context.Context, arg1 *v1beta1.Chain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockChainBizMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockChainBiz)(nil).Update), arg0, arg1)
+}
@@ -0,0 +1,101 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package chargerequest
+
+//go:generate mockgen -self_package github.com/superproj/onex/internal/gateway/biz/chargerequest -destination mock_chargerequest.go -package chargerequest github.com/superproj/onex/internal/gateway/biz/chargerequest ChargeRequestBiz
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+	"github.com/superproj/onex/pkg/api/zerrors"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+	clientset "github.com/superproj/onex/pkg/generated/clientset/versioned"
+	"github.com/superproj/onex/pkg/log"
+)
+
+// ChargeRequestBiz defines functions used to handle chargerequest rquest.
+// ChargeRequests are read from onex-apiserver, and their passwords are never returned.
+type ChargeRequestBiz interface {
+	Create(ctx context.Context, namespace string, cr *v1beta1.ChargeRequest) error
+	List(ctx context.Context, namespace string, rq *v1.ListChargeRequestRequest) (*v1.ListChargeRequestResponse, error)
+	Get(ctx context.Context, namespace, name string) (*v1beta1.ChargeRequest, error)
+}
+
+type chargeRequestBiz struct {
+	client clientset.Interface
+}
+
+var _ ChargeRequestBiz = (*chargeRequestBiz)(nil)
+
+func New(client clientset.Interface) *chargeRequestBiz {
+	return &chargeRequestBiz{client}
+}
+
+func (b *chargeRequestBiz) Create(ctx context.Context, namespace string, cr *v1beta1.ChargeRequest) error {
+	cr.Namespace = namespace
+	// The status is owned by the chargerequest controller.
+	cr.Status = v1beta1.ChargeRequestStatus{}
+	if _, err := b.client.AppsV1beta1().ChargeRequests(namespace).Create(ctx, cr, metav1.CreateOptions{}); err != nil {
+		log.C(ctx).Errorw(err, "Failed to create chargerequest", "chargerequest", klog.KObj(cr))
+		return convertError(err, cr.Name)
+	}
+
+	return nil
+}
+
+func (b *chargeRequestBiz) List(ctx context.Context, namespace string, rq *v1.ListChargeRequestRequest) (*v1.ListChargeRequestResponse, error) {
+	list, err := b.client.AppsV1beta1().ChargeRequests(namespace).List(ctx, metav1.ListOptions{
+		Limit:    rq.Limit,
+		Continue: rq.Continue,
+	})
+	if err != nil {
+		log.C(ctx).Errorw(err, "Failed to list chargerequest")
+		return nil, convertError(err, "")
+	}
+
+	crs := make([]*v1beta1.ChargeRequest, 0, len(list.Items))
+	for i := range list.Items {
+		crs = append(crs, withoutPassword(&list.Items[i]))
+	}
+
+	return &v1.ListChargeRequestResponse{ChargeRequests: crs, Continue: list.Continue}, nil
+}
+
+func (b *chargeRequestBiz) Get(ctx context.Context, namespace, name string) (*v1beta1.ChargeRequest, error) {
+	cr, err := b.client.AppsV1beta1().ChargeRequests(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.C(ctx).Errorw(err, "Failed to retrieve chargerequest", "chargerequest", klog.KRef(namespace, name))
+		return nil, convertError(err, name)
+	}
+
+	return withoutPassword(cr), nil
+}
+
+// withoutPassword clears the sealed password, which is of no use to the users.
+func withoutPassword(cr *v1beta1.ChargeRequest) *v1beta1.ChargeRequest {
+	cr.Spec.Password = ""
+	return cr
+}
+
+// convertError converts the errors returned by onex-apiserver to the gateway errors.
+func convertError(err error, name string) error {
+	switch {
+	case apierrors.IsNotFound(err):
+		return v1.ErrorChargeRequestNotFound("chargerequest %s not found", name)
+	case apierrors.IsAlreadyExists(err):
+		return v1.ErrorChargeRequestAlreadyExists("chargerequest %s already exists", name)
+	case apierrors.IsInvalid(err):
+		return zerrors.ErrorInvalidParameter(err.Error())
+	}
+
+	return err
+}
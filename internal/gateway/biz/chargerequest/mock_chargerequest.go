@@ -0,0 +1,87 @@
+// Copyright 2024 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/superproj/onex/internal/gateway/biz/chargerequest (interfaces: ChargeRequestBiz)
+
+// Package chargerequest is a generated GoMock package.
+package chargerequest
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+	v1beta1 "github.com/superproj/onex/pkg/apis/apps/v1beta1"
+)
+
+// MockChargeRequestBiz is a mock of ChargeRequestBiz interface.
+type MockChargeRequestBiz struct {
+	ctrl     *gomock.Controller
+	recorder *MockChargeRequestBizMockRecorder
+}
+
+// MockChargeRequestBizMockRecorder is the mock recorder for MockChargeRequestBiz.
+type MockChargeRequestBizMockRecorder struct {
+	mock *MockChargeRequestBiz
+}
+
+// NewMockChargeRequestBiz creates a new mock instance.
+func NewMockChargeRequestBiz(ctrl *gomock.Controller) *MockChargeRequestBiz {
+	mock := &MockChargeRequestBiz{ctrl: ctrl}
+	mock.recorder = &MockChargeRequestBizMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChargeRequestBiz) EXPECT() *MockChargeRequestBizMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockChargeRequestBiz) Create(arg0 context.Context, arg1 string, arg2 *v1beta1.ChargeRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockChargeRequestBizMockRecorder) Create(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockChargeRequestBiz)(nil).Create), arg0, arg1, arg2)
+}
+
+// Get mocks base method.
+func (m *MockChargeRequestBiz) Get(arg0 context.Context, arg1, arg2 string) (*v1beta1.ChargeRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1beta1.ChargeRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockChargeRequestBizMockRecorder) Get(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockChargeRequestBiz)(nil).Get), arg0, arg1, arg2)
+}
+
+// List mocks base method.
+func (m *MockChargeRequestBiz) List(arg0 context.Context, arg1 string, arg2 *v1.ListChargeRequestRequest) (*v1.ListChargeRequestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.ListChargeRequestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockChargeRequestBizMockRecorder) List(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockChargeRequestBiz)(nil).List), arg0, arg1, arg2)
+}
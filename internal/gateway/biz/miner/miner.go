@@ -10,15 +10,19 @@ package miner
 
 import (
 	"context"
+	"errors"
 
 	"github.com/jinzhu/copier"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/superproj/onex/internal/gateway/quota"
 	"github.com/superproj/onex/internal/gateway/store"
-	"github.com/superproj/onex/internal/pkg/meta"
+	"github.com/superproj/onex/internal/gateway/watcher"
 	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+	"github.com/superproj/onex/pkg/api/zerrors"
 	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
 	clientset "github.com/superproj/onex/pkg/generated/clientset/versioned"
 	"github.com/superproj/onex/pkg/generated/informers"
@@ -33,21 +37,28 @@ type MinerBiz interface {
 	Get(ctx context.Context, namespace, name string) (*v1beta1.Miner, error)
 	Update(ctx context.Context, namespace string, m *v1beta1.Miner) error
 	Delete(ctx context.Context, namespace, name string) error
+	Watch(ctx context.Context, namespace, resourceVersion string) (watch.Interface, error)
 }
 
 type minerBiz struct {
 	ds     store.IStore
 	client clientset.Interface
 	lister listers.MinerLister
+	qc     *quota.Checker
+	bc     *watcher.Broadcaster
 }
 
 var _ MinerBiz = (*minerBiz)(nil)
 
-func New(ds store.IStore, client clientset.Interface, f informers.SharedInformerFactory) *minerBiz {
-	return &minerBiz{ds, client, f.Apps().V1beta1().Miners().Lister()}
+func New(ds store.IStore, client clientset.Interface, f informers.SharedInformerFactory, qc *quota.Checker, bc *watcher.Broadcaster) *minerBiz {
+	return &minerBiz{ds, client, f.Apps().V1beta1().Miners().Lister(), qc, bc}
 }
 
 func (b *minerBiz) Create(ctx context.Context, namespace string, m *v1beta1.Miner) error {
+	if err := b.qc.CheckMiners(namespace, 1); err != nil {
+		return err
+	}
+
 	_, err := b.client.AppsV1beta1().Miners(namespace).Create(ctx, m, metav1.CreateOptions{})
 	if err != nil {
 		return err
@@ -57,8 +68,18 @@ func (b *minerBiz) Create(ctx context.Context, namespace string, m *v1beta1.Mine
 }
 
 func (b *minerBiz) List(ctx context.Context, namespace string, rq *v1.ListMinerRequest) (*v1.ListMinerResponse, error) {
-	total, list, err := b.ds.Miners().List(ctx, namespace, meta.WithOffset(rq.Offset), meta.WithLimit(rq.Limit))
+	total, list, next, err := b.ds.Miners().Query(ctx, namespace, &store.ListQuery{
+		LabelSelector: rq.LabelSelector,
+		FieldSelector: rq.FieldSelector,
+		OrderBy:       rq.OrderBy,
+		Continue:      rq.Continue,
+		Offset:        rq.Offset,
+		Limit:         rq.Limit,
+	})
 	if err != nil {
+		if errors.Is(err, store.ErrInvalidQuery) {
+			return nil, zerrors.ErrorInvalidParameter(err.Error())
+		}
 		log.Errorw(err, "Failed to list miner")
 		return nil, err
 	}
@@ -72,7 +93,7 @@ func (b *minerBiz) List(ctx context.Context, namespace string, rq *v1.ListMinerR
 		miners = append(miners, &m)
 	}
 
-	return &v1.ListMinerResponse{TotalCount: total, Miners: miners}, nil
+	return &v1.ListMinerResponse{TotalCount: total, Miners: miners, Continue: next}, nil
 }
 
 func (b *minerBiz) Get(ctx context.Context, namespace, name string) (*v1beta1.Miner, error) {
@@ -100,3 +121,17 @@ func (b *minerBiz) Delete(ctx context.Context, namespace, name string) error {
 
 	return nil
 }
+
+// Watch watches the events of the miners in the namespace after resourceVersion.
+func (b *minerBiz) Watch(ctx context.Context, namespace, resourceVersion string) (watch.Interface, error) {
+	w, err := b.bc.Watch(namespace, resourceVersion)
+	if err != nil {
+		if errors.Is(err, watcher.ErrExpired) {
+			return nil, v1.ErrorResourceVersionExpired("resource version %s is too old", resourceVersion)
+		}
+		log.C(ctx).Errorw(err, "Failed to watch miner")
+		return nil, err
+	}
+
+	return w, nil
+}
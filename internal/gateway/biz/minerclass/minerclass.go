@@ -0,0 +1,55 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package minerclass
+
+//go:generate mockgen -self_package github.com/superproj/onex/internal/gateway/biz/minerclass -destination mock_minerclass.go -package minerclass github.com/superproj/onex/internal/gateway/biz/minerclass MinerClassBiz
+
+import (
+	"context"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+	"github.com/superproj/onex/pkg/generated/informers"
+	listers "github.com/superproj/onex/pkg/generated/listers/apps/v1beta1"
+	"github.com/superproj/onex/pkg/log"
+)
+
+// MinerClassBiz defines functions used to handle minerclass rquest.
+// MinerClasses are cluster-scoped and read-only to the users.
+type MinerClassBiz interface {
+	List(ctx context.Context, rq *v1.ListMinerClassRequest) (*v1.ListMinerClassResponse, error)
+}
+
+type minerClassBiz struct {
+	lister listers.MinerClassLister
+}
+
+var _ MinerClassBiz = (*minerClassBiz)(nil)
+
+func New(f informers.SharedInformerFactory) *minerClassBiz {
+	return &minerClassBiz{f.Apps().V1beta1().MinerClasses().Lister()}
+}
+
+func (b *minerClassBiz) List(ctx context.Context, rq *v1.ListMinerClassRequest) (*v1.ListMinerClassResponse, error) {
+	list, err := b.lister.List(labels.Everything())
+	if err != nil {
+		log.C(ctx).Errorw(err, "Failed to list minerclass")
+		return nil, err
+	}
+
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	mcs := make([]*v1beta1.MinerClass, 0, len(list))
+	for _, mc := range list {
+		// The objects of the lister are shared with the informer cache.
+		mcs = append(mcs, mc.DeepCopy())
+	}
+
+	return &v1.ListMinerClassResponse{TotalCount: int64(len(mcs)), MinerClasses: mcs}, nil
+}
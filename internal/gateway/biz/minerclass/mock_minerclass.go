@@ -0,0 +1,57 @@
+// Copyright 2024 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/superproj/onex/internal/gateway/biz/minerclass (interfaces: MinerClassBiz)
+
+// Package minerclass is a generated GoMock package.
+package minerclass
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+)
+
+// MockMinerClassBiz is a mock of MinerClassBiz interface.
+type MockMinerClassBiz struct {
+	ctrl     *gomock.Controller
+	recorder *MockMinerClassBizMockRecorder
+}
+
+// MockMinerClassBizMockRecorder is the mock recorder for MockMinerClassBiz.
+type MockMinerClassBizMockRecorder struct {
+	mock *MockMinerClassBiz
+}
+
+// NewMockMinerClassBiz creates a new mock instance.
+func NewMockMinerClassBiz(ctrl *gomock.Controller) *MockMinerClassBiz {
+	mock := &MockMinerClassBiz{ctrl: ctrl}
+	mock.recorder = &MockMinerClassBizMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMinerClassBiz) EXPECT() *MockMinerClassBizMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockMinerClassBiz) List(arg0 context.Context, arg1 *v1.ListMinerClassRequest) (*v1.ListMinerClassResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ListMinerClassResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockMinerClassBizMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockMinerClassBiz)(nil).List), arg0, arg1)
+}
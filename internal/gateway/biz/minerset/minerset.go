@@ -10,15 +10,19 @@ package minerset
 
 import (
 	"context"
+	"errors"
 
 	"github.com/jinzhu/copier"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/klog/v2"
 
+	"github.com/superproj/onex/internal/gateway/quota"
 	"github.com/superproj/onex/internal/gateway/store"
-	"github.com/superproj/onex/internal/pkg/meta"
+	"github.com/superproj/onex/internal/gateway/watcher"
 	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+	"github.com/superproj/onex/pkg/api/zerrors"
 	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
 	clientset "github.com/superproj/onex/pkg/generated/clientset/versioned"
 	"github.com/superproj/onex/pkg/generated/informers"
@@ -34,21 +38,31 @@ type MinerSetBiz interface {
 	Update(ctx context.Context, namespace string, ms *v1beta1.MinerSet) error
 	Delete(ctx context.Context, namespace, name string) error
 	Scale(ctx context.Context, namespace, name string, replicas int32) error
+	Watch(ctx context.Context, namespace, resourceVersion string) (watch.Interface, error)
 }
 
 type minerSetBiz struct {
 	ds     store.IStore
 	client clientset.Interface
 	lister listers.MinerSetLister
+	qc     *quota.Checker
+	bc     *watcher.Broadcaster
 }
 
 var _ MinerSetBiz = (*minerSetBiz)(nil)
 
-func New(ds store.IStore, client clientset.Interface, f informers.SharedInformerFactory) *minerSetBiz {
-	return &minerSetBiz{ds, client, f.Apps().V1beta1().MinerSets().Lister()}
+func New(ds store.IStore, client clientset.Interface, f informers.SharedInformerFactory, qc *quota.Checker, bc *watcher.Broadcaster) *minerSetBiz {
+	return &minerSetBiz{ds, client, f.Apps().V1beta1().MinerSets().Lister(), qc, bc}
 }
 
 func (b *minerSetBiz) Create(ctx context.Context, namespace string, ms *v1beta1.MinerSet) error {
+	if err := b.qc.CheckMinerSets(namespace); err != nil {
+		return err
+	}
+	if err := b.qc.CheckMiners(namespace, quota.Replicas(ms)); err != nil {
+		return err
+	}
+
 	_, err := b.client.AppsV1beta1().MinerSets(namespace).Create(ctx, ms, metav1.CreateOptions{})
 	if err != nil {
 		return err
@@ -58,8 +72,18 @@ func (b *minerSetBiz) Create(ctx context.Context, namespace string, ms *v1beta1.
 }
 
 func (b *minerSetBiz) List(ctx context.Context, namespace string, rq *v1.ListMinerSetRequest) (*v1.ListMinerSetResponse, error) {
-	total, list, err := b.ds.MinerSets().List(ctx, namespace, meta.WithOffset(rq.Offset), meta.WithLimit(rq.Limit))
+	total, list, next, err := b.ds.MinerSets().Query(ctx, namespace, &store.ListQuery{
+		LabelSelector: rq.LabelSelector,
+		FieldSelector: rq.FieldSelector,
+		OrderBy:       rq.OrderBy,
+		Continue:      rq.Continue,
+		Offset:        rq.Offset,
+		Limit:         rq.Limit,
+	})
 	if err != nil {
+		if errors.Is(err, store.ErrInvalidQuery) {
+			return nil, zerrors.ErrorInvalidParameter(err.Error())
+		}
 		log.C(ctx).Errorw(err, "Failed to list minerset")
 		return nil, err
 	}
@@ -74,7 +98,7 @@ func (b *minerSetBiz) List(ctx context.Context, namespace string, rq *v1.ListMin
 		mss = append(mss, &ms)
 	}
 
-	return &v1.ListMinerSetResponse{TotalCount: total, MinerSets: mss}, nil
+	return &v1.ListMinerSetResponse{TotalCount: total, MinerSets: mss, Continue: next}, nil
 }
 
 func (b *minerSetBiz) Get(ctx context.Context, namespace, name string) (*v1beta1.MinerSet, error) {
@@ -88,6 +112,10 @@ func (b *minerSetBiz) Get(ctx context.Context, namespace, name string) (*v1beta1
 }
 
 func (b *minerSetBiz) Update(ctx context.Context, namespace string, ms *v1beta1.MinerSet) error {
+	if err := b.checkScale(namespace, ms.Name, quota.Replicas(ms)); err != nil {
+		return err
+	}
+
 	if _, err := b.client.AppsV1beta1().MinerSets(namespace).Update(ctx, ms, metav1.UpdateOptions{}); err != nil {
 		log.Errorw(err, "Failed to update minerset", "minerset", klog.KRef(namespace, ms.Name))
 	}
@@ -104,6 +132,10 @@ func (b *minerSetBiz) Delete(ctx context.Context, namespace, name string) error
 }
 
 func (b *minerSetBiz) Scale(ctx context.Context, namespace, name string, replicas int32) error {
+	if err := b.checkScale(namespace, name, int(replicas)); err != nil {
+		return err
+	}
+
 	scale, err := b.client.AppsV1beta1().MinerSets(namespace).GetScale(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		log.Errorw(err, "Failed to get scale", "minerset", klog.KRef(namespace, name))
@@ -118,3 +150,27 @@ func (b *minerSetBiz) Scale(ctx context.Context, namespace, name string, replica
 
 	return nil
 }
+
+// checkScale checks the miner quota of the namespace before the minerset is scaled to replicas.
+func (b *minerSetBiz) checkScale(namespace, name string, replicas int) error {
+	delta := replicas
+	if old, err := b.lister.MinerSets(namespace).Get(name); err == nil {
+		delta -= quota.Replicas(old)
+	}
+
+	return b.qc.CheckMiners(namespace, delta)
+}
+
+// Watch watches the events of the minersets in the namespace after resourceVersion.
+func (b *minerSetBiz) Watch(ctx context.Context, namespace, resourceVersion string) (watch.Interface, error) {
+	w, err := b.bc.Watch(namespace, resourceVersion)
+	if err != nil {
+		if errors.Is(err, watcher.ErrExpired) {
+			return nil, v1.ErrorResourceVersionExpired("resource version %s is too old", resourceVersion)
+		}
+		log.C(ctx).Errorw(err, "Failed to watch minerset")
+		return nil, err
+	}
+
+	return w, nil
+}
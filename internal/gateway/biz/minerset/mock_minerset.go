@@ -17,6 +17,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
 	v1beta1 "github.com/superproj/onex/pkg/apis/apps/v1beta1"
+	watch "k8s.io/apimachinery/pkg/watch"
 )
 
 // MockMinerSetBiz is a mock of MinerSetBiz interface.
@@ -127,3 +128,18 @@ func (mr *MockMinerSetBizMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMinerSetBiz)(nil).Update), arg0, arg1, arg2)
 }
+
+// Watch mocks base method.
+func (m *MockMinerSetBiz) Watch(arg0 context.Context, arg1, arg2 string) (watch.Interface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", arg0, arg1, arg2)
+	ret0, _ := ret[0].(watch.Interface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockMinerSetBizMockRecorder) Watch(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockMinerSetBiz)(nil).Watch), arg0, arg1, arg2)
+}
@@ -14,7 +14,11 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	auditevent "github.com/superproj/onex/internal/gateway/biz/auditevent"
+	chain "github.com/superproj/onex/internal/gateway/biz/chain"
+	chargerequest "github.com/superproj/onex/internal/gateway/biz/chargerequest"
 	miner "github.com/superproj/onex/internal/gateway/biz/miner"
+	minerclass "github.com/superproj/onex/internal/gateway/biz/minerclass"
 	minerset "github.com/superproj/onex/internal/gateway/biz/minerset"
 )
 
@@ -41,6 +45,62 @@ func (m *MockIBiz) EXPECT() *MockIBizMockRecorder {
 	return m.recorder
 }
 
+// AuditEvents mocks base method.
+func (m *MockIBiz) AuditEvents() auditevent.AuditEventBiz {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditEvents")
+	ret0, _ := ret[0].(auditevent.AuditEventBiz)
+	return ret0
+}
+
+// AuditEvents indicates an expected call of AuditEvents.
+func (mr *MockIBizMockRecorder) AuditEvents() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditEvents", reflect.TypeOf((*MockIBiz)(nil).AuditEvents))
+}
+
+// Chains mocks base method.
+func (m *MockIBiz) Chains() chain.ChainBiz {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Chains")
+	ret0, _ := ret[0].(chain.ChainBiz)
+	return ret0
+}
+
+// Chains indicates an expected call of Chains.
+func (mr *MockIBizMockRecorder) Chains() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Chains", reflect.TypeOf((*MockIBiz)(nil).Chains))
+}
+
+// ChargeRequests mocks base method.
+func (m *MockIBiz) ChargeRequests() chargerequest.ChargeRequestBiz {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChargeRequests")
+	ret0, _ := ret[0].(chargerequest.ChargeRequestBiz)
+	return ret0
+}
+
+// ChargeRequests indicates an expected call of ChargeRequests.
+func (mr *MockIBizMockRecorder) ChargeRequests() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChargeRequests", reflect.TypeOf((*MockIBiz)(nil).ChargeRequests))
+}
+
+// MinerClasses mocks base method.
+func (m *MockIBiz) MinerClasses() minerclass.MinerClassBiz {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MinerClasses")
+	ret0, _ := ret[0].(minerclass.MinerClassBiz)
+	return ret0
+}
+
+// MinerClasses indicates an expected call of MinerClasses.
+func (mr *MockIBizMockRecorder) MinerClasses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MinerClasses", reflect.TypeOf((*MockIBiz)(nil).MinerClasses))
+}
+
 // MinerSets mocks base method.
 func (m *MockIBiz) MinerSets() minerset.MinerSetBiz {
 	m.ctrl.T.Helper()
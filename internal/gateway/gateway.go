@@ -13,7 +13,9 @@ import (
 	"github.com/jinzhu/copier"
 	"k8s.io/client-go/rest"
 
+	"github.com/superproj/onex/internal/gateway/quota"
 	"github.com/superproj/onex/internal/gateway/server"
+	"github.com/superproj/onex/internal/pkg/audit"
 	"github.com/superproj/onex/internal/pkg/bootstrap"
 	"github.com/superproj/onex/internal/pkg/client/usercenter"
 	"github.com/superproj/onex/pkg/db"
@@ -42,6 +44,9 @@ type Config struct {
 	EtcdOptions       *genericoptions.EtcdOptions
 	JaegerOptions     *genericoptions.JaegerOptions
 	ConsulOptions     *genericoptions.ConsulOptions
+	RateLimitOptions  *genericoptions.RateLimitOptions
+	QuotaOptions      *quota.QuotaOptions
+	AuditOptions      *audit.AuditOptions
 
 	// the rest config for the onex-apiserver
 	Kubeconfig *rest.Config
@@ -84,7 +89,7 @@ func (c completedConfig) New(stopCh <-chan struct{}) (*Server, error) {
 	_ = copier.Copy(&mysqlOptions, c.MySQLOptions)
 	_ = copier.Copy(&redisOptions, c.RedisOptions)
 
-	app, cleanup, err := wireApp(stopCh, appInfo, conf, client, &mysqlOptions, &redisOptions, c.UserCenterOptions, c.RedisOptions, c.EtcdOptions)
+	app, cleanup, err := wireApp(stopCh, appInfo, conf, client, &mysqlOptions, &redisOptions, c.UserCenterOptions, c.RedisOptions, c.EtcdOptions, c.RateLimitOptions, c.QuotaOptions, c.AuditOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -21,9 +21,10 @@ func createInformers(stopCh <-chan struct{}, client clientset.Interface) (inform
 	f := informers.NewSharedInformerFactory(client, time.Minute)
 	msinfor := f.Apps().V1beta1().MinerSets().Informer()
 	minfor := f.Apps().V1beta1().Miners().Informer()
+	mcinfor := f.Apps().V1beta1().MinerClasses().Informer()
 
 	f.Start(stopCh)
-	if !cache.WaitForCacheSync(stopCh, msinfor.HasSynced, minfor.HasSynced) {
+	if !cache.WaitForCacheSync(stopCh, msinfor.HasSynced, minfor.HasSynced, mcinfor.HasSynced) {
 		log.Errorf("Failed to wait for caches to populate")
 		return nil, fmt.Errorf("failed to wait caches to populate")
 	}
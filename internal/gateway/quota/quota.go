@@ -0,0 +1,168 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+// Package quota enforces the resource quotas of the users of the gateway.
+package quota
+
+import (
+	"fmt"
+
+	"github.com/google/wire"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/labels"
+
+	minerutil "github.com/superproj/onex/internal/pkg/util/miner"
+	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+	"github.com/superproj/onex/pkg/generated/informers"
+	listers "github.com/superproj/onex/pkg/generated/listers/apps/v1beta1"
+)
+
+// ProviderSet is quota providers.
+var ProviderSet = wire.NewSet(NewChecker)
+
+// QuotaOptions defines the resource quotas of the users. A quota of 0 is unlimited.
+type QuotaOptions struct {
+	// MaxMiners is the number of the miners a user can have, including the replicas of the minersets.
+	MaxMiners int `json:"max-miners" mapstructure:"max-miners"`
+	// MaxMinerSets is the number of the minersets a user can have.
+	MaxMinerSets int `json:"max-minersets" mapstructure:"max-minersets"`
+	// Users overrides the quotas of some users.
+	Users []UserQuota `json:"users" mapstructure:"users"`
+}
+
+// UserQuota is the resource quota of a user.
+type UserQuota struct {
+	UserID       string `json:"user-id" mapstructure:"user-id"`
+	MaxMiners    int    `json:"max-miners" mapstructure:"max-miners"`
+	MaxMinerSets int    `json:"max-minersets" mapstructure:"max-minersets"`
+}
+
+// NewQuotaOptions returns initialized QuotaOptions.
+func NewQuotaOptions() *QuotaOptions {
+	return &QuotaOptions{
+		MaxMiners:    50,
+		MaxMinerSets: 10,
+	}
+}
+
+// Validate verifies flags passed to QuotaOptions.
+func (o *QuotaOptions) Validate() []error {
+	errs := []error{}
+
+	if o.MaxMiners < 0 || o.MaxMinerSets < 0 {
+		errs = append(errs, fmt.Errorf("--quota.max-miners and --quota.max-minersets can not be negative"))
+	}
+
+	for _, u := range o.Users {
+		if u.UserID == "" || u.MaxMiners < 0 || u.MaxMinerSets < 0 {
+			errs = append(errs, fmt.Errorf("quota of user %q must have a user id and non-negative quotas", u.UserID))
+		}
+	}
+
+	return errs
+}
+
+// AddFlags adds flags related to quotas to the specified FlagSet.
+// The quotas of the users can only be set in the config file.
+func (o *QuotaOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.IntVar(&o.MaxMiners, "quota.max-miners", o.MaxMiners, ""+
+		"Number of the miners a user can have, including the replicas of the minersets. 0 means unlimited.")
+	fs.IntVar(&o.MaxMinerSets, "quota.max-minersets", o.MaxMinerSets, "Number of the minersets a user can have. 0 means unlimited.")
+}
+
+// Checker checks the usage of the users against their quotas. The namespace of a user is
+// named by its user ID. The usage is read from the informer cache, so the concurrent
+// requests of a user may exceed the quota slightly.
+type Checker struct {
+	opts     *QuotaOptions
+	users    map[string]UserQuota
+	mlister  listers.MinerLister
+	mslister listers.MinerSetLister
+}
+
+// NewChecker creates a quota checker.
+func NewChecker(opts *QuotaOptions, f informers.SharedInformerFactory) *Checker {
+	users := make(map[string]UserQuota, len(opts.Users))
+	for _, u := range opts.Users {
+		users[u.UserID] = u
+	}
+
+	return &Checker{
+		opts:     opts,
+		users:    users,
+		mlister:  f.Apps().V1beta1().Miners().Lister(),
+		mslister: f.Apps().V1beta1().MinerSets().Lister(),
+	}
+}
+
+// CheckMiners returns an error if adding delta miners to the namespace exceeds its quota.
+func (c *Checker) CheckMiners(namespace string, delta int) error {
+	limit := c.quotaOf(namespace).MaxMiners
+	if limit == 0 || delta <= 0 {
+		return nil
+	}
+
+	used, err := c.minersOf(namespace)
+	if err != nil {
+		return err
+	}
+	if used+delta > limit {
+		return v1.ErrorQuotaExceeded("miner quota exceeded: used %d, requested %d, limited %d", used, delta, limit)
+	}
+
+	return nil
+}
+
+// CheckMinerSets returns an error if adding a minerset to the namespace exceeds its quota.
+func (c *Checker) CheckMinerSets(namespace string) error {
+	limit := c.quotaOf(namespace).MaxMinerSets
+	if limit == 0 {
+		return nil
+	}
+
+	mss, err := c.mslister.MinerSets(namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	if len(mss)+1 > limit {
+		return v1.ErrorQuotaExceeded("minerset quota exceeded: used %d, limited %d", len(mss), limit)
+	}
+
+	return nil
+}
+
+func (c *Checker) quotaOf(namespace string) UserQuota {
+	if q, ok := c.users[namespace]; ok {
+		return q
+	}
+
+	return UserQuota{MaxMiners: c.opts.MaxMiners, MaxMinerSets: c.opts.MaxMinerSets}
+}
+
+// minersOf returns the number of the miners in the namespace. The miners owned by the
+// minersets are counted by the replicas of the minersets, which may not be created yet.
+func (c *Checker) minersOf(namespace string) (int, error) {
+	miners, err := c.mlister.Miners(namespace).List(labels.Everything())
+	if err != nil {
+		return 0, err
+	}
+	mss, err := c.mslister.MinerSets(namespace).List(labels.Everything())
+	if err != nil {
+		return 0, err
+	}
+
+	return minerutil.CountQuotaMiners(miners, mss), nil
+}
+
+// Replicas returns the desired replicas of the minerset, which default to 1.
+func Replicas(ms *v1beta1.MinerSet) int {
+	if ms.Spec.Replicas == nil {
+		return 1
+	}
+
+	return int(*ms.Spec.Replicas)
+}
@@ -0,0 +1,37 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package server
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	auditmw "github.com/superproj/onex/internal/pkg/middleware/audit"
+	"github.com/superproj/onex/internal/pkg/onexx"
+	clientset "github.com/superproj/onex/pkg/generated/clientset/versioned"
+)
+
+// newSnapshotFunc returns the function reading the updated resources from onex-apiserver
+// for the audit events. The informer cache is not used, it may not have seen the update yet.
+func newSnapshotFunc(cl clientset.Interface) auditmw.SnapshotFunc {
+	return func(ctx context.Context, resource, name string) (any, error) {
+		namespace := onexx.FromUserID(ctx)
+		switch resource {
+		case "MinerSet":
+			return cl.AppsV1beta1().MinerSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		case "Miner":
+			return cl.AppsV1beta1().Miners(namespace).Get(ctx, name, metav1.GetOptions{})
+		case "Chain":
+			return cl.AppsV1beta1().Chains(metav1.NamespaceSystem).Get(ctx, name, metav1.GetOptions{})
+		case "ChargeRequest":
+			return cl.AppsV1beta1().ChargeRequests(namespace).Get(ctx, name, metav1.GetOptions{})
+		}
+
+		return nil, nil
+	}
+}
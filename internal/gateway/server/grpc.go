@@ -7,8 +7,11 @@
 package server
 
 import (
+	"context"
+
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	ggrpc "google.golang.org/grpc"
 
 	"github.com/superproj/onex/internal/gateway/service"
 	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
@@ -21,6 +24,8 @@ func NewGRPCServer(c *Config, gw *service.GatewayService, middlewares []middlewa
 		// grpc.WithEndpoint("discovery:///matrix.creation.service.grpc"),
 		// Define the middleware chain with variable options.
 		grpc.Middleware(middlewares...),
+		// The kratos middlewares only run on the unary rpcs, e.g. the watches need them too.
+		grpc.StreamInterceptor(streamMiddleware(middlewares...)),
 	}
 	if c.GRPC.Network != "" {
 		opts = append(opts, grpc.Network(c.GRPC.Network))
@@ -40,3 +45,41 @@ func NewGRPCServer(c *Config, gw *service.GatewayService, middlewares []middlewa
 	v1.RegisterGatewayServer(srv, gw)
 	return srv
 }
+
+// streamMiddleware runs the middlewares on the request of the server-streaming rpcs when
+// it is received. The stream is served with the context returned by the middlewares, which
+// carries e.g. the user ID.
+func streamMiddleware(m ...middleware.Middleware) ggrpc.StreamServerInterceptor {
+	return func(srv any, ss ggrpc.ServerStream, info *ggrpc.StreamServerInfo, handler ggrpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss, chain: middleware.Chain(m...)})
+	}
+}
+
+// serverStream is a server stream whose first request runs through the middlewares.
+type serverStream struct {
+	ggrpc.ServerStream
+	chain middleware.Middleware
+	ctx   context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return s.ServerStream.Context()
+}
+
+func (s *serverStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.ctx != nil {
+		return nil
+	}
+
+	_, err := s.chain(func(ctx context.Context, rq any) (any, error) {
+		s.ctx = ctx
+		return nil, nil
+	})(s.ServerStream.Context(), m)
+	return err
+}
@@ -12,14 +12,25 @@ import (
 	"github.com/go-kratos/swagger-api/openapiv2"
 	"github.com/gorilla/handlers"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
 
 	"github.com/superproj/onex/internal/gateway/service"
+	"github.com/superproj/onex/internal/pkg/middleware/authn/hmac"
+	ratelimitmw "github.com/superproj/onex/internal/pkg/middleware/ratelimit"
 	"github.com/superproj/onex/internal/pkg/pprof"
 	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+	hmacauthn "github.com/superproj/onex/pkg/authn/hmac"
 )
 
 // NewHTTPServer creates a new HTTP server with middleware and handler chain.
-func NewHTTPServer(c *Config, gw *service.GatewayService, middlewares []middleware.Middleware) *http.Server {
+// Requests signed with a secret are verified by v, and their nonces are kept in rdb.
+func NewHTTPServer(
+	c *Config,
+	gw *service.GatewayService,
+	v hmac.Verifier,
+	rdb redis.UniversalClient,
+	middlewares []middleware.Middleware,
+) *http.Server {
 	opts := []http.ServerOption{
 		// http.WithDiscovery(nil),
 		// http.WithEndpoint("discovery:///matrix.creation.service.grpc"),
@@ -32,10 +43,21 @@ func NewHTTPServer(c *Config, gw *service.GatewayService, middlewares []middlewa
 				"Content-Type",
 				"Authorization",
 				"X-Idempotent-ID",
+				"X-Tenant-ID",
+				hmacauthn.TimestampHeader,
+				hmacauthn.NonceHeader,
 			}),
 			handlers.AllowedMethods([]string{"GET", "POST", "PUT", "HEAD", "OPTIONS"}),
 			handlers.AllowedOrigins([]string{"*"}),
+			handlers.ExposedHeaders([]string{
+				ratelimitmw.LimitHeader,
+				ratelimitmw.RemainingHeader,
+				ratelimitmw.ResetHeader,
+				ratelimitmw.RetryAfterHeader,
+			}),
 		)),
+		// Authenticate the requests signed with a secret, before their body is consumed.
+		http.Filter(hmac.Server(v, rdb)),
 	}
 	if c.HTTP.Network != "" {
 		opts = append(opts, http.Network(c.HTTP.Network))
@@ -57,6 +79,12 @@ func NewHTTPServer(c *Config, gw *service.GatewayService, middlewares []middlewa
 	srv.Handle("/metrics", promhttp.Handler())
 	srv.Handle("", pprof.NewHandler())
 
+	// The http generator skips the streaming rpcs, the watches are served as server-sent events.
+	// They are registered first, so that they are not routed to e.g. `GET /v1/miners/{name}`.
+	r := srv.Route("/")
+	r.GET("/v1/minersets/watch", gw.WatchMinerSetHTTP)
+	r.GET("/v1/miners/watch", gw.WatchMinerHTTP)
+
 	v1.RegisterGatewayHTTPServer(srv, gw)
 	return srv
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
 
 	"github.com/superproj/onex/internal/gateway/locales"
 	"github.com/superproj/onex/internal/pkg/middleware/auth"
@@ -21,13 +22,19 @@ import (
 	"github.com/superproj/onex/pkg/log"
 )
 
+// TenantHeader is the request header carrying the domain (tenant) the request is authorized in.
+// The user must be bound to a role in the domain. Without it the domains are derived from the user.
+const TenantHeader = "X-Tenant-ID"
+
 // Auth is a authentication and authorization middleware.
 func Auth(a auth.AuthProvider) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, rq any) (reply any, err error) {
 			accessToken := jwtutil.TokenFromServerContext(ctx)
 			if tr, ok := transport.FromServerContext(ctx); ok {
-				userID, allowed, err := a.Auth(ctx, accessToken, "*", tr.Operation())
+				dom := tr.RequestHeader().Get(TenantHeader)
+				obj, act := resource(tr, rq)
+				userID, allowed, err := authenticate(ctx, a, accessToken, dom, obj, act)
 				if err != nil {
 					log.Errorw(err, "Authorization failure occurs", "operation", tr.Operation())
 					return nil, err
@@ -44,3 +51,31 @@ func Auth(a auth.AuthProvider) middleware.Middleware {
 		}
 	}
 }
+
+// authenticate authenticates the access token and authorizes the request. Requests signed
+// with a secret have already been authenticated by the HMAC filter, and are only authorized.
+func authenticate(ctx context.Context, a auth.AuthProvider, accessToken string, dom, obj, act string) (string, bool, error) {
+	if userID := onexx.FromUserID(ctx); userID != "" {
+		allowed, err := a.Authorize(ctx, userID, dom, obj, act)
+		return userID, allowed, err
+	}
+
+	return a.Auth(ctx, accessToken, dom, obj, act)
+}
+
+// resource returns the object and the action to authorize. They are the path and the
+// method of HTTP requests, so policies can match routes like /v1/minersets/*, and the
+// path and the method of the http binding of the operation for the other transports, so
+// that the same policies apply to them. Operations without http binding are authorized
+// as the operation with the "*" action.
+func resource(tr transport.Transporter, rq any) (string, string) {
+	if ht, ok := tr.(khttp.Transporter); ok {
+		return ht.Request().URL.Path, ht.Request().Method
+	}
+
+	if obj, act, ok := auth.Resource(tr.Operation(), rq); ok {
+		return obj, act
+	}
+
+	return tr.Operation(), "*"
+}
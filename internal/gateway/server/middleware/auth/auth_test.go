@@ -0,0 +1,105 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+
+	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+)
+
+type headerCarrier map[string]string
+
+func (hc headerCarrier) Get(key string) string      { return hc[key] }
+func (hc headerCarrier) Set(key string, val string) { hc[key] = val }
+func (hc headerCarrier) Add(key string, val string) { hc[key] = val }
+func (hc headerCarrier) Keys() []string             { return nil }
+func (hc headerCarrier) Values(key string) []string { return []string{hc[key]} }
+
+// grpcTransport is a gRPC server transport of an operation.
+type grpcTransport struct {
+	operation string
+	header    headerCarrier
+}
+
+func (tr *grpcTransport) Kind() transport.Kind            { return transport.KindGRPC }
+func (tr *grpcTransport) Endpoint() string                { return "" }
+func (tr *grpcTransport) Operation() string               { return tr.operation }
+func (tr *grpcTransport) RequestHeader() transport.Header { return tr.header }
+func (tr *grpcTransport) ReplyHeader() transport.Header   { return headerCarrier{} }
+func (tr *grpcTransport) String() string                  { return tr.operation }
+func (tr *grpcTransport) withContext(ctx context.Context) context.Context {
+	return transport.NewServerContext(ctx, tr)
+}
+
+// denyProvider denies the requests on obj and act, and records the last authorized request.
+type denyProvider struct {
+	obj, act string
+	got      [3]string
+}
+
+func (p *denyProvider) Auth(ctx context.Context, token string, dom, obj, act string) (string, bool, error) {
+	p.got = [3]string{dom, obj, act}
+	return "user-colin", !(obj == p.obj && act == p.act), nil
+}
+
+func (p *denyProvider) Authorize(ctx context.Context, sub, dom, obj, act string) (bool, error) {
+	_, allowed, err := p.Auth(ctx, "", dom, obj, act)
+	return allowed, err
+}
+
+func TestAuth(t *testing.T) {
+	p := &denyProvider{obj: "/v1/chains", act: "POST"}
+	handler := Auth(p)(func(ctx context.Context, rq any) (any, error) { return "ok", nil })
+
+	tests := []struct {
+		name      string
+		operation string
+		rq        any
+		tenant    string
+		want      [3]string
+		forbidden bool
+	}{
+		{
+			name:      "grpc request is authorized as its http binding",
+			operation: v1.OperationGatewayDeleteMinerSet,
+			rq:        &v1.DeleteMinerSetRequest{Name: "foo"},
+			want:      [3]string{"", "/v1/minersets/foo", "DELETE"},
+		},
+		{
+			name:      "denied grpc request is forbidden",
+			operation: v1.OperationGatewayCreateChain,
+			rq:        &v1beta1.Chain{},
+			want:      [3]string{"", "/v1/chains", "POST"},
+			forbidden: true,
+		},
+		{
+			name:      "tenant header",
+			operation: v1.OperationGatewayGetMinerSet,
+			rq:        &v1.GetMinerSetRequest{Name: "foo"},
+			tenant:    "tenant-a",
+			want:      [3]string{"tenant-a", "/v1/minersets/foo", "GET"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := &grpcTransport{operation: tt.operation, header: headerCarrier{TenantHeader: tt.tenant}}
+			_, err := handler(tr.withContext(context.Background()), tt.rq)
+			if p.got != tt.want {
+				t.Errorf("authorized %v, want %v", p.got, tt.want)
+			}
+			if forbidden := errors.Code(err) == 403; forbidden != tt.forbidden {
+				t.Errorf("forbidden = %v, want %v, err = %v", forbidden, tt.forbidden, err)
+			}
+		})
+	}
+}
@@ -21,20 +21,27 @@ import (
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/google/wire"
+	"github.com/redis/go-redis/v9"
 	"golang.org/x/text/language"
 
 	"github.com/superproj/onex/internal/gateway/locales"
 	authmw "github.com/superproj/onex/internal/gateway/server/middleware/auth"
+	"github.com/superproj/onex/internal/pkg/audit"
 	"github.com/superproj/onex/internal/pkg/idempotent"
 	onexmetrics "github.com/superproj/onex/internal/pkg/metrics"
+	auditmw "github.com/superproj/onex/internal/pkg/middleware/audit"
 	"github.com/superproj/onex/internal/pkg/middleware/auth"
 	i18nmw "github.com/superproj/onex/internal/pkg/middleware/i18n"
 	idempotentmw "github.com/superproj/onex/internal/pkg/middleware/idempotent"
 	"github.com/superproj/onex/internal/pkg/middleware/logging"
+	ratelimitmw "github.com/superproj/onex/internal/pkg/middleware/ratelimit"
 	"github.com/superproj/onex/internal/pkg/middleware/tracing"
 	"github.com/superproj/onex/internal/pkg/middleware/validate"
+	clientset "github.com/superproj/onex/pkg/generated/clientset/versioned"
 	"github.com/superproj/onex/pkg/i18n"
 	"github.com/superproj/onex/pkg/log"
+	genericoptions "github.com/superproj/onex/pkg/options"
+	pkgratelimit "github.com/superproj/onex/pkg/ratelimit"
 )
 
 // ProviderSet defines a wire provider set.
@@ -57,7 +64,16 @@ func NewWhiteListMatcher() selector.MatchFunc {
 	}
 }
 
-func NewMiddlewares(logger krtlog.Logger, idt *idempotent.Idempotent, a auth.AuthProvider, v validate.IValidator) []middleware.Middleware {
+func NewMiddlewares(
+	logger krtlog.Logger,
+	idt *idempotent.Idempotent,
+	a auth.AuthProvider,
+	v validate.IValidator,
+	rdb redis.UniversalClient,
+	rlopts *genericoptions.RateLimitOptions,
+	ad *audit.Auditor,
+	cl clientset.Interface,
+) []middleware.Middleware {
 	return []middleware.Middleware{
 		recovery.Recovery(
 			recovery.WithHandler(func(ctx context.Context, rq, err any) error {
@@ -72,11 +88,17 @@ func NewMiddlewares(logger krtlog.Logger, idt *idempotent.Idempotent, a auth.Aut
 		),
 		i18nmw.Translator(i18n.WithLanguage(language.English), i18n.WithFS(locales.Locales)),
 		// circuitbreaker.Client(),
-		idempotentmw.Idempotent(idt),
+		// Protects the process from overload, regardless of the callers.
 		ratelimit.Server(),
 		tracing.Server(),
 		selector.Server(authmw.Auth(a)).Match(NewWhiteListMatcher()).Build(),
+		// Runs after the authentication, because the limits are per caller.
+		ratelimitmw.Server(pkgratelimit.New(pkgratelimit.WithRedis(rdb)), rlopts),
+		// Runs before the validation, so that the rejected requests are audited too.
+		auditmw.Server(ad, auditmw.WithSnapshot(newSnapshotFunc(cl))),
 		validate.Validator(v),
+		// Runs after the authentication, because the idempotency keys are scoped by user.
+		idempotentmw.Idempotent(idt),
 		logging.Server(logger),
 	}
 }
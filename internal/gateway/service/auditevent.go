@@ -0,0 +1,22 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package service
+
+import (
+	"context"
+
+	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+)
+
+func (s *GatewayService) ListAuditEvents(ctx context.Context, rq *v1.ListAuditEventsRequest) (*v1.ListAuditEventsResponse, error) {
+	events, err := s.biz.AuditEvents().List(ctx, rq)
+	if err != nil {
+		return &v1.ListAuditEventsResponse{}, err
+	}
+
+	return events, nil
+}
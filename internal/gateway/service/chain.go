@@ -0,0 +1,58 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package service
+
+import (
+	"context"
+
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+
+	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+)
+
+func (s *GatewayService) CreateChain(ctx context.Context, ch *v1beta1.Chain) (*emptypb.Empty, error) {
+	if err := s.biz.Chains().Create(ctx, ch); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *GatewayService) ListChain(ctx context.Context, rq *v1.ListChainRequest) (*v1.ListChainResponse, error) {
+	chains, err := s.biz.Chains().List(ctx, rq)
+	if err != nil {
+		return &v1.ListChainResponse{}, err
+	}
+
+	return chains, nil
+}
+
+func (s *GatewayService) GetChain(ctx context.Context, rq *v1.GetChainRequest) (*v1.Chain, error) {
+	ch, err := s.biz.Chains().Get(ctx, rq.Name)
+	if err != nil {
+		return &v1.Chain{}, err
+	}
+
+	return ch, nil
+}
+
+func (s *GatewayService) UpdateChain(ctx context.Context, ch *v1beta1.Chain) (*emptypb.Empty, error) {
+	if err := s.biz.Chains().Update(ctx, ch); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *GatewayService) DeleteChain(ctx context.Context, rq *v1.DeleteChainRequest) (*emptypb.Empty, error) {
+	if err := s.biz.Chains().Delete(ctx, rq.Name); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
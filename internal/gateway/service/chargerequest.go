@@ -0,0 +1,43 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package service
+
+import (
+	"context"
+
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/superproj/onex/internal/pkg/onexx"
+	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+)
+
+func (s *GatewayService) CreateChargeRequest(ctx context.Context, cr *v1beta1.ChargeRequest) (*emptypb.Empty, error) {
+	if err := s.biz.ChargeRequests().Create(ctx, onexx.FromUserID(ctx), cr); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *GatewayService) ListChargeRequest(ctx context.Context, rq *v1.ListChargeRequestRequest) (*v1.ListChargeRequestResponse, error) {
+	crs, err := s.biz.ChargeRequests().List(ctx, onexx.FromUserID(ctx), rq)
+	if err != nil {
+		return &v1.ListChargeRequestResponse{}, err
+	}
+
+	return crs, nil
+}
+
+func (s *GatewayService) GetChargeRequest(ctx context.Context, rq *v1.GetChargeRequestRequest) (*v1beta1.ChargeRequest, error) {
+	cr, err := s.biz.ChargeRequests().Get(ctx, onexx.FromUserID(ctx), rq.Name)
+	if err != nil {
+		return &v1beta1.ChargeRequest{}, err
+	}
+
+	return cr, nil
+}
@@ -9,7 +9,9 @@ package service
 import (
 	"context"
 
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	"k8s.io/apimachinery/pkg/watch"
 
 	"github.com/superproj/onex/internal/pkg/onexx"
 	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
@@ -57,3 +59,36 @@ func (s *GatewayService) DeleteMiner(ctx context.Context, rq *v1.DeleteMinerRequ
 
 	return &emptypb.Empty{}, nil
 }
+
+// WatchMiner streams the add, update and delete events of the miners of the user.
+func (s *GatewayService) WatchMiner(rq *v1.WatchMinerRequest, stream v1.Gateway_WatchMinerServer) error {
+	return s.watchMiner(stream.Context(), rq, func(e watch.Event) error {
+		return stream.Send(&v1.MinerEvent{Type: string(e.Type), Object: e.Object.(*v1beta1.Miner)})
+	}, nil)
+}
+
+// WatchMinerHTTP serves WatchMiner as server-sent events at `GET /v1/miners/watch`.
+func (s *GatewayService) WatchMinerHTTP(ctx khttp.Context) error {
+	var rq v1.WatchMinerRequest
+	if err := ctx.BindQuery(&rq); err != nil {
+		return err
+	}
+	if rq.ResourceVersion == "" {
+		rq.ResourceVersion = lastEventID(ctx)
+	}
+
+	return serveSSE(ctx, v1.Gateway_WatchMiner_FullMethodName, &rq, func(ctx context.Context, sw *sseWriter) error {
+		return s.watchMiner(ctx, &rq, func(e watch.Event) error {
+			return sw.send(e, &v1.MinerEvent{Type: string(e.Type), Object: e.Object.(*v1beta1.Miner)})
+		}, sw.heartbeat)
+	})
+}
+
+func (s *GatewayService) watchMiner(ctx context.Context, rq *v1.WatchMinerRequest, send func(watch.Event) error, heartbeat func() error) error {
+	w, err := s.biz.Miners().Watch(ctx, onexx.FromUserID(ctx), rq.ResourceVersion)
+	if err != nil {
+		return err
+	}
+
+	return serveWatch(ctx, w, send, heartbeat)
+}
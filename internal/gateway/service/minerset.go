@@ -9,7 +9,9 @@ package service
 import (
 	"context"
 
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	"k8s.io/apimachinery/pkg/watch"
 
 	"github.com/superproj/onex/internal/pkg/onexx"
 	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
@@ -65,3 +67,36 @@ func (s *GatewayService) ScaleMinerSet(ctx context.Context, rq *v1.ScaleMinerSet
 
 	return &emptypb.Empty{}, nil
 }
+
+// WatchMinerSet streams the add, update and delete events of the minersets of the user.
+func (s *GatewayService) WatchMinerSet(rq *v1.WatchMinerSetRequest, stream v1.Gateway_WatchMinerSetServer) error {
+	return s.watchMinerSet(stream.Context(), rq, func(e watch.Event) error {
+		return stream.Send(&v1.MinerSetEvent{Type: string(e.Type), Object: e.Object.(*v1beta1.MinerSet)})
+	}, nil)
+}
+
+// WatchMinerSetHTTP serves WatchMinerSet as server-sent events at `GET /v1/minersets/watch`.
+func (s *GatewayService) WatchMinerSetHTTP(ctx khttp.Context) error {
+	var rq v1.WatchMinerSetRequest
+	if err := ctx.BindQuery(&rq); err != nil {
+		return err
+	}
+	if rq.ResourceVersion == "" {
+		rq.ResourceVersion = lastEventID(ctx)
+	}
+
+	return serveSSE(ctx, v1.Gateway_WatchMinerSet_FullMethodName, &rq, func(ctx context.Context, sw *sseWriter) error {
+		return s.watchMinerSet(ctx, &rq, func(e watch.Event) error {
+			return sw.send(e, &v1.MinerSetEvent{Type: string(e.Type), Object: e.Object.(*v1beta1.MinerSet)})
+		}, sw.heartbeat)
+	})
+}
+
+func (s *GatewayService) watchMinerSet(ctx context.Context, rq *v1.WatchMinerSetRequest, send func(watch.Event) error, heartbeat func() error) error {
+	w, err := s.biz.MinerSets().Watch(ctx, onexx.FromUserID(ctx), rq.ResourceVersion)
+	if err != nil {
+		return err
+	}
+
+	return serveWatch(ctx, w, send, heartbeat)
+}
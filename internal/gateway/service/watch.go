@@ -0,0 +1,145 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/encoding"
+	"github.com/go-kratos/kratos/v2/encoding/json"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/superproj/onex/pkg/log"
+)
+
+// heartbeatInterval is the interval of the comments which keep the server-sent event
+// streams alive. They also detect the clients which have disconnected.
+const heartbeatInterval = 15 * time.Second
+
+// serveWatch sends the events of the watcher until ctx is done, the watcher is stopped,
+// or send fails. heartbeat is called periodically if it is not nil.
+func serveWatch(ctx context.Context, w watch.Interface, send func(watch.Event) error, heartbeat func() error) error {
+	defer w.Stop()
+
+	var tick <-chan time.Time
+	if heartbeat != nil {
+		ticker := time.NewTicker(heartbeatInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-tick:
+			if err := heartbeat(); err != nil {
+				return err
+			}
+		case e, ok := <-w.ResultChan():
+			if !ok {
+				// The watcher fell behind, the client resumes from its last event.
+				return nil
+			}
+			if err := send(e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// serveSSE serves a watch operation as server-sent events. The request runs through the
+// middlewares of the server, e.g. the authentication, before serve is called.
+//
+// The id of an event is the resource version of its object, so the browsers resume the
+// stream by the Last-Event-ID header when they reconnect.
+func serveSSE(ctx khttp.Context, operation string, rq any, serve func(context.Context, *sseWriter) error) error {
+	khttp.SetOperation(ctx, operation)
+
+	sw := &sseWriter{w: ctx.Response()}
+	h := ctx.Middleware(func(c context.Context, rq any) (any, error) {
+		// The request context is canceled after the timeout of the server, the stream
+		// ends when the client disconnects instead, which is detected by the heartbeats.
+		return nil, serve(context.WithoutCancel(c), sw)
+	})
+	if _, err := h(ctx, rq); err != nil {
+		if sw.started {
+			// The response has been sent, the error can't be returned to the client.
+			log.C(ctx).Debugw("Server-sent event stream closed", "operation", operation, "err", err)
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// sseWriter writes the events of a watch as server-sent events. The headers are written
+// with the first event, so that the errors before it are returned as usual.
+type sseWriter struct {
+	w       http.ResponseWriter
+	started bool
+}
+
+func (sw *sseWriter) start() {
+	if sw.started {
+		return
+	}
+	sw.started = true
+
+	h := sw.w.Header()
+	h.Set("Content-Type", "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	h.Set("Connection", "keep-alive")
+	// Disable the buffering of the reverse proxies, e.g. nginx.
+	h.Set("X-Accel-Buffering", "no")
+	sw.w.WriteHeader(http.StatusOK)
+}
+
+// send writes the event, which is encoded as the other responses of the server.
+func (sw *sseWriter) send(e watch.Event, v any) error {
+	data, err := encoding.GetCodec(json.Name).Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	sw.start()
+	if accessor, err := meta.Accessor(e.Object); err == nil {
+		if _, err := fmt.Fprintf(sw.w, "id: %s\n", accessor.GetResourceVersion()); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(sw.w, "data: %s\n\n", data); err != nil {
+		return err
+	}
+
+	return sw.flush()
+}
+
+// heartbeat writes a comment, which is ignored by the clients.
+func (sw *sseWriter) heartbeat() error {
+	sw.start()
+	if _, err := fmt.Fprint(sw.w, ": heartbeat\n\n"); err != nil {
+		return err
+	}
+
+	return sw.flush()
+}
+
+func (sw *sseWriter) flush() error {
+	return http.NewResponseController(sw.w).Flush()
+}
+
+// lastEventID returns the resource version the browser resumes the stream from.
+func lastEventID(ctx khttp.Context) string {
+	return ctx.Header().Get("Last-Event-ID")
+}
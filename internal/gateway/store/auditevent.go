@@ -0,0 +1,63 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package store
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/superproj/onex/internal/pkg/audit"
+	"github.com/superproj/onex/internal/pkg/meta"
+)
+
+// AuditEventStore defines the audit event storage interface.
+type AuditEventStore interface {
+	List(ctx context.Context, userID string, since, until time.Time, opts ...meta.ListOption) (int64, []*audit.Event, error)
+}
+
+// auditEventStore is a structure which implements the AuditEventStore interface.
+type auditEventStore struct {
+	ds *datastore
+}
+
+// newAuditEventStore creates a new auditEventStore instance with provided datastore.
+func newAuditEventStore(ds *datastore) *auditEventStore {
+	return &auditEventStore{ds}
+}
+
+// db is an alias for d.ds.Core(ctx context.Context), a convenience method to get the core database instance.
+func (d *auditEventStore) db(ctx context.Context) *gorm.DB {
+	return d.ds.Core(ctx)
+}
+
+// List returns the audit events of the user in the time range [since, until), from the
+// newest to the oldest. A zero since or until leaves the range open.
+func (d *auditEventStore) List(ctx context.Context, userID string, since, until time.Time, opts ...meta.ListOption) (count int64, ret []*audit.Event, err error) {
+	los := meta.NewListOptions(opts...)
+	los.Filters["user_id"] = userID
+
+	db := d.db(ctx).Where(los.Filters)
+	if !since.IsZero() {
+		db = db.Where("time >= ?", since)
+	}
+	if !until.IsZero() {
+		db = db.Where("time < ?", until)
+	}
+
+	ans := db.
+		Offset(los.Offset).
+		Limit(los.Limit).
+		Order("id desc").
+		Find(&ret).
+		Offset(-1).
+		Limit(-1).
+		Count(&count)
+
+	return count, ret, ans.Error
+}
@@ -23,6 +23,7 @@ type MinerStore interface {
 	Update(ctx context.Context, miner *model.MinerM) error
 	Get(ctx context.Context, filters map[string]any) (*model.MinerM, error)
 	List(ctx context.Context, namespace string, opts ...meta.ListOption) (int64, []*model.MinerM, error)
+	Query(ctx context.Context, namespace string, q *ListQuery) (int64, []*model.MinerM, string, error)
 }
 
 type minerStore struct {
@@ -87,3 +88,25 @@ func (d *minerStore) List(ctx context.Context, namespace string, opts ...meta.Li
 
 	return count, ret, ans.Error
 }
+
+// minerQuerySpec maps the list queries to the columns of the miner table.
+var minerQuerySpec = querySpec[model.MinerM]{
+	fields: map[string]string{
+		"metadata.name":      "name",
+		"metadata.namespace": "namespace",
+		"spec.chainName":     "chain_name",
+		"spec.minerType":     "miner_type",
+		"status.phase":       "phase",
+	},
+	sorts: map[string]sortKey[model.MinerM]{
+		"name":      {column: "name", value: func(m *model.MinerM) any { return m.Name }},
+		"createdAt": {column: "created_at", value: func(m *model.MinerM) any { return m.CreatedAt }, time: true},
+		"updatedAt": {column: "updated_at", value: func(m *model.MinerM) any { return m.UpdatedAt }, time: true},
+	},
+	id: func(m *model.MinerM) int64 { return m.ID },
+}
+
+// Query returns the miner records selected by the query, and the continue token of the next page.
+func (d *minerStore) Query(ctx context.Context, namespace string, q *ListQuery) (int64, []*model.MinerM, string, error) {
+	return query(d.db(ctx), namespace, q, &minerQuerySpec)
+}
@@ -23,6 +23,7 @@ type MinerSetStore interface {
 	Update(ctx context.Context, ms *model.MinerSetM) error
 	Get(ctx context.Context, filters map[string]any) (*model.MinerSetM, error)
 	List(ctx context.Context, namespace string, opts ...meta.ListOption) (int64, []*model.MinerSetM, error)
+	Query(ctx context.Context, namespace string, q *ListQuery) (int64, []*model.MinerSetM, string, error)
 }
 
 // minerSetStore is a structure which implements the MinerSetStore interface.
@@ -88,3 +89,25 @@ func (d *minerSetStore) List(ctx context.Context, namespace string, opts ...meta
 
 	return count, ret, ans.Error
 }
+
+// minerSetQuerySpec maps the list queries to the columns of the minerset table.
+var minerSetQuerySpec = querySpec[model.MinerSetM]{
+	fields: map[string]string{
+		"metadata.name":                "name",
+		"metadata.namespace":           "namespace",
+		"spec.template.spec.chainName": "chain_name",
+		"spec.template.spec.minerType": "miner_type",
+	},
+	sorts: map[string]sortKey[model.MinerSetM]{
+		"name":      {column: "name", value: func(m *model.MinerSetM) any { return m.Name }},
+		"createdAt": {column: "created_at", value: func(m *model.MinerSetM) any { return m.CreatedAt }, time: true},
+		"updatedAt": {column: "updated_at", value: func(m *model.MinerSetM) any { return m.UpdatedAt }, time: true},
+		"replicas":  {column: "replicas", value: func(m *model.MinerSetM) any { return m.Replicas }},
+	},
+	id: func(m *model.MinerSetM) int64 { return m.ID },
+}
+
+// Query returns the minerset records selected by the query, and the continue token of the next page.
+func (d *minerSetStore) Query(ctx context.Context, namespace string, q *ListQuery) (int64, []*model.MinerSetM, string, error) {
+	return query(d.db(ctx), namespace, q, &minerSetQuerySpec)
+}
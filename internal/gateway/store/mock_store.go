@@ -5,7 +5,7 @@
 //
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/superproj/onex/internal/gateway/store (interfaces: IStore,ChainStore,MinerStore,MinerSetStore)
+// Source: github.com/superproj/onex/internal/gateway/store (interfaces: IStore,ChainStore,MinerStore,MinerSetStore,AuditEventStore)
 
 // Package store is a generated GoMock package.
 package store
@@ -13,9 +13,11 @@ package store
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/superproj/onex/internal/gateway/model"
+	audit "github.com/superproj/onex/internal/pkg/audit"
 	meta "github.com/superproj/onex/internal/pkg/meta"
 )
 
@@ -42,6 +44,20 @@ func (m *MockIStore) EXPECT() *MockIStoreMockRecorder {
 	return m.recorder
 }
 
+// AuditEvents mocks base method.
+func (m *MockIStore) AuditEvents() AuditEventStore {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditEvents")
+	ret0, _ := ret[0].(AuditEventStore)
+	return ret0
+}
+
+// AuditEvents indicates an expected call of AuditEvents.
+func (mr *MockIStoreMockRecorder) AuditEvents() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditEvents", reflect.TypeOf((*MockIStore)(nil).AuditEvents))
+}
+
 // Chains mocks base method.
 func (m *MockIStore) Chains() ChainStore {
 	m.ctrl.T.Helper()
@@ -286,6 +302,23 @@ func (mr *MockMinerStoreMockRecorder) List(arg0, arg1 any, arg2 ...any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockMinerStore)(nil).List), varargs...)
 }
 
+// Query mocks base method.
+func (m *MockMinerStore) Query(arg0 context.Context, arg1 string, arg2 *ListQuery) (int64, []*model.MinerM, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Query", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].([]*model.MinerM)
+	ret2, _ := ret[2].(string)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockMinerStoreMockRecorder) Query(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockMinerStore)(nil).Query), arg0, arg1, arg2)
+}
+
 // Update mocks base method.
 func (m *MockMinerStore) Update(arg0 context.Context, arg1 *model.MinerM) error {
 	m.ctrl.T.Helper()
@@ -387,6 +420,23 @@ func (mr *MockMinerSetStoreMockRecorder) List(arg0, arg1 any, arg2 ...any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockMinerSetStore)(nil).List), varargs...)
 }
 
+// Query mocks base method.
+func (m *MockMinerSetStore) Query(arg0 context.Context, arg1 string, arg2 *ListQuery) (int64, []*model.MinerSetM, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Query", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].([]*model.MinerSetM)
+	ret2, _ := ret[2].(string)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockMinerSetStoreMockRecorder) Query(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockMinerSetStore)(nil).Query), arg0, arg1, arg2)
+}
+
 // Update mocks base method.
 func (m *MockMinerSetStore) Update(arg0 context.Context, arg1 *model.MinerSetM) error {
 	m.ctrl.T.Helper()
@@ -400,3 +450,47 @@ func (mr *MockMinerSetStoreMockRecorder) Update(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMinerSetStore)(nil).Update), arg0, arg1)
 }
+
+// MockAuditEventStore is a mock of AuditEventStore interface.
+type MockAuditEventStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditEventStoreMockRecorder
+}
+
+// MockAuditEventStoreMockRecorder is the mock recorder for MockAuditEventStore.
+type MockAuditEventStoreMockRecorder struct {
+	mock *MockAuditEventStore
+}
+
+// NewMockAuditEventStore creates a new mock instance.
+func NewMockAuditEventStore(ctrl *gomock.Controller) *MockAuditEventStore {
+	mock := &MockAuditEventStore{ctrl: ctrl}
+	mock.recorder = &MockAuditEventStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditEventStore) EXPECT() *MockAuditEventStoreMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockAuditEventStore) List(arg0 context.Context, arg1 string, arg2, arg3 time.Time, arg4 ...meta.ListOption) (int64, []*audit.Event, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "List", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].([]*audit.Event)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockAuditEventStoreMockRecorder) List(arg0, arg1, arg2, arg3 any, arg4 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAuditEventStore)(nil).List), varargs...)
+}
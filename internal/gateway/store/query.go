@@ -0,0 +1,285 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package store
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// defaultQueryLimit is the page size used when the limit of a query is not set.
+const defaultQueryLimit = 1000
+
+// ErrInvalidQuery is returned when the selectors, the sort key or the continue token of a query are invalid.
+var ErrInvalidQuery = errors.New("invalid list query")
+
+// labelsExpr is the json object of the labels of a record. The labels of the
+// records synced before the labels column existed are NULL.
+const labelsExpr = "COALESCE(labels, '{}')"
+
+// ListQuery selects, sorts and pages the records returned by a list request.
+type ListQuery struct {
+	// LabelSelector selects the records by their labels, in the kubernetes label selector syntax, e.g. `app=foo,env!=prod`.
+	LabelSelector string
+	// FieldSelector selects the records by their fields, in the kubernetes field selector syntax, e.g. `status.phase=Failed`.
+	FieldSelector string
+	// OrderBy is the sort key of the records, prefixed with `-` for the descending order, e.g. `-createdAt`.
+	// The records are returned from the newest to the oldest by default.
+	OrderBy string
+	// Continue is the token returned by the previous page. Offset is ignored if it is set.
+	Continue string
+	Offset   int64
+	Limit    int64
+}
+
+// sortKey is a key the records can be sorted by.
+type sortKey[T any] struct {
+	column string
+	// value returns the value of the key of the record, which is saved in the continue token.
+	value func(*T) any
+	// time is true if the values are times, which are decoded from the continue token as time.Time.
+	time bool
+}
+
+// querySpec describes how a list query maps to the columns of a table.
+type querySpec[T any] struct {
+	// fields maps the field selector keys to the columns.
+	fields map[string]string
+	// sorts maps the sort keys to the columns.
+	sorts map[string]sortKey[T]
+	// id returns the primary key of the record, which breaks the ties of the sort key.
+	id func(*T) int64
+}
+
+// continueToken is the position of the last record of a page. It is opaque to the clients.
+type continueToken struct {
+	// Query is the digest of the selectors and the sort key, a token can't be used by other queries.
+	Query string          `json:"q"`
+	Value json.RawMessage `json:"v,omitempty"`
+	ID    int64           `json:"i"`
+}
+
+// query returns the total count of the records selected by q, a page of them and the
+// continue token of the next page, which is empty if this is the last page.
+//
+// The pages are read by keyset pagination on the sort key and the primary key, so that
+// the records created or deleted between two pages don't shift the next page.
+func query[T any](db *gorm.DB, namespace string, q *ListQuery, spec *querySpec[T]) (count int64, ret []*T, next string, err error) {
+	db = db.Model(new(T))
+	if namespace != "" {
+		db = db.Where("namespace = ?", namespace)
+	}
+
+	if db, err = selectLabels(db, q.LabelSelector); err != nil {
+		return 0, nil, "", err
+	}
+	if db, err = selectFields(db, q.FieldSelector, spec.fields); err != nil {
+		return 0, nil, "", err
+	}
+
+	if err := db.Session(&gorm.Session{}).Count(&count).Error; err != nil {
+		return 0, nil, "", err
+	}
+
+	key, desc, err := parseOrderBy(q.OrderBy, spec.sorts)
+	if err != nil {
+		return 0, nil, "", err
+	}
+
+	digest := queryDigest(namespace, q)
+	page := db.Session(&gorm.Session{})
+	if q.Continue != "" {
+		if page, err = seek(page, q.Continue, digest, key, desc); err != nil {
+			return 0, nil, "", err
+		}
+	} else if q.Offset > 0 {
+		page = page.Offset(int(q.Offset))
+	}
+
+	dir := "ASC"
+	if desc {
+		dir = "DESC"
+	}
+	if key.column != "id" {
+		page = page.Order(key.column + " " + dir)
+	}
+
+	limit := int(q.Limit)
+	if limit <= 0 {
+		limit = defaultQueryLimit
+	}
+
+	// Read one more record to know whether there is a next page.
+	if err := page.Order("id " + dir).Limit(limit + 1).Find(&ret).Error; err != nil {
+		return 0, nil, "", err
+	}
+	if len(ret) <= limit {
+		return count, ret, "", nil
+	}
+
+	ret = ret[:limit]
+	last := ret[limit-1]
+	token := continueToken{Query: digest, ID: spec.id(last)}
+	if key.value != nil {
+		//nolint: errchkjson
+		token.Value, _ = json.Marshal(key.value(last))
+	}
+	//nolint: errchkjson
+	data, _ := json.Marshal(token)
+
+	return count, ret, base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// selectLabels translates a label selector to the conditions on the json labels column.
+func selectLabels(db *gorm.DB, selector string) (*gorm.DB, error) {
+	if selector == "" {
+		return db, nil
+	}
+
+	sel, err := labels.Parse(selector)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidQuery, err)
+	}
+
+	reqs, _ := sel.Requirements()
+	for _, r := range reqs {
+		// The label keys are validated by the parser, they can't break out of the quotes.
+		path := fmt.Sprintf(`$."%s"`, r.Key())
+		has := "JSON_CONTAINS_PATH(" + labelsExpr + ", 'one', ?)"
+		value := "JSON_UNQUOTE(JSON_EXTRACT(" + labelsExpr + ", ?))"
+
+		switch r.Operator() {
+		case selection.Equals, selection.DoubleEquals, selection.In:
+			db = db.Where(value+" IN ?", path, r.Values().List())
+		case selection.NotEquals, selection.NotIn:
+			// The records without the label are selected too, as kubernetes does.
+			db = db.Where("(NOT "+has+" OR "+value+" NOT IN ?)", path, path, r.Values().List())
+		case selection.Exists:
+			db = db.Where(has, path)
+		case selection.DoesNotExist:
+			db = db.Where("NOT "+has, path)
+		case selection.GreaterThan, selection.LessThan:
+			n, err := strconv.ParseInt(r.Values().List()[0], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidQuery, err)
+			}
+
+			op := ">"
+			if r.Operator() == selection.LessThan {
+				op = "<"
+			}
+			db = db.Where("CAST("+value+" AS SIGNED) "+op+" ?", path, n)
+		default:
+			return nil, fmt.Errorf("%w: unsupported label selector operator %q", ErrInvalidQuery, r.Operator())
+		}
+	}
+
+	return db, nil
+}
+
+// selectFields translates a field selector to the conditions on the columns.
+func selectFields(db *gorm.DB, selector string, columns map[string]string) (*gorm.DB, error) {
+	if selector == "" {
+		return db, nil
+	}
+
+	sel, err := fields.ParseSelector(selector)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidQuery, err)
+	}
+
+	for _, r := range sel.Requirements() {
+		column, ok := columns[r.Field]
+		if !ok {
+			return nil, fmt.Errorf("%w: field %q is not supported by the field selector", ErrInvalidQuery, r.Field)
+		}
+
+		switch r.Operator {
+		case selection.Equals, selection.DoubleEquals:
+			db = db.Where(column+" = ?", r.Value)
+		case selection.NotEquals:
+			db = db.Where(column+" <> ?", r.Value)
+		default:
+			return nil, fmt.Errorf("%w: unsupported field selector operator %q", ErrInvalidQuery, r.Operator)
+		}
+	}
+
+	return db, nil
+}
+
+// parseOrderBy returns the sort key and the direction of the query. The records are
+// sorted by the primary key in the descending order by default, i.e. the newest first.
+func parseOrderBy[T any](orderBy string, sorts map[string]sortKey[T]) (sortKey[T], bool, error) {
+	if orderBy == "" {
+		return sortKey[T]{column: "id"}, true, nil
+	}
+
+	name, desc := strings.CutPrefix(orderBy, "-")
+	key, ok := sorts[name]
+	if !ok {
+		return key, false, fmt.Errorf("%w: records can't be sorted by %q", ErrInvalidQuery, name)
+	}
+
+	return key, desc, nil
+}
+
+// seek skips the records up to the position saved in the continue token.
+func seek[T any](db *gorm.DB, token string, digest string, key sortKey[T], desc bool) (*gorm.DB, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed continue token", ErrInvalidQuery)
+	}
+
+	var ct continueToken
+	if err := json.Unmarshal(data, &ct); err != nil {
+		return nil, fmt.Errorf("%w: malformed continue token", ErrInvalidQuery)
+	}
+	if ct.Query != digest {
+		return nil, fmt.Errorf("%w: continue token was issued for a different query", ErrInvalidQuery)
+	}
+
+	op := ">"
+	if desc {
+		op = "<"
+	}
+	if key.column == "id" {
+		return db.Where("id "+op+" ?", ct.ID), nil
+	}
+
+	var value any
+	if key.time {
+		var t time.Time
+		err = json.Unmarshal(ct.Value, &t)
+		value = t
+	} else {
+		err = json.Unmarshal(ct.Value, &value)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed continue token", ErrInvalidQuery)
+	}
+
+	cond := fmt.Sprintf("(%s %s ? OR (%s = ? AND id %s ?))", key.column, op, key.column, op)
+	return db.Where(cond, value, value, ct.ID), nil
+}
+
+// queryDigest identifies the records selected by a query and their order.
+func queryDigest(namespace string, q *ListQuery) string {
+	h := sha256.Sum256([]byte(strings.Join([]string{namespace, q.LabelSelector, q.FieldSelector, q.OrderBy}, "\n")))
+	return hex.EncodeToString(h[:8])
+}
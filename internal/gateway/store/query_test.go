@@ -0,0 +1,116 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package store
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	"github.com/superproj/onex/internal/gateway/model"
+)
+
+// dryRunDB returns a gorm.DB which only builds the statements.
+func dryRunDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(mysql.New(mysql.Config{SkipInitializeWithVersion: true}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	assert.Nil(t, err)
+	return db
+}
+
+func toSQL(t *testing.T, db *gorm.DB, q *ListQuery) (string, error) {
+	var err error
+	sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Model(&model.MinerM{})
+		if tx, err = selectLabels(tx, q.LabelSelector); err == nil {
+			tx, err = selectFields(tx, q.FieldSelector, minerQuerySpec.fields)
+		}
+		if err != nil {
+			return db
+		}
+		return tx.Find(&[]*model.MinerM{})
+	})
+
+	return sql, err
+}
+
+func TestSelectors(t *testing.T) {
+	db := dryRunDB(t)
+
+	tests := []struct {
+		name  string
+		query ListQuery
+		want  string
+		err   bool
+	}{
+		{
+			name:  "label equals",
+			query: ListQuery{LabelSelector: "env=prod"},
+			want:  "JSON_UNQUOTE(JSON_EXTRACT(COALESCE(labels, '{}'), '$.\"env\"')) IN ('prod')",
+		},
+		{
+			name:  "label not in",
+			query: ListQuery{LabelSelector: "tier notin (a,b)"},
+			want:  "(NOT JSON_CONTAINS_PATH(COALESCE(labels, '{}'), 'one', '$.\"tier\"') OR JSON_UNQUOTE(JSON_EXTRACT(COALESCE(labels, '{}'), '$.\"tier\"')) NOT IN ('a','b'))",
+		},
+		{
+			name:  "label exists",
+			query: ListQuery{LabelSelector: "!env"},
+			want:  "NOT JSON_CONTAINS_PATH(COALESCE(labels, '{}'), 'one', '$.\"env\"')",
+		},
+		{
+			name:  "fields",
+			query: ListQuery{FieldSelector: "spec.chainName=x,status.phase!=Failed"},
+			want:  "chain_name = 'x' AND phase <> 'Failed'",
+		},
+		{name: "malformed label selector", query: ListQuery{LabelSelector: "env in ("}, err: true},
+		{name: "unsupported field", query: ListQuery{FieldSelector: "spec.image=x"}, err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, err := toSQL(t, db, &tt.query)
+			if tt.err {
+				assert.True(t, errors.Is(err, ErrInvalidQuery))
+				return
+			}
+			assert.Nil(t, err)
+			assert.Contains(t, sql, tt.want)
+		})
+	}
+}
+
+func TestSeek(t *testing.T) {
+	db := dryRunDB(t)
+	q := &ListQuery{OrderBy: "-createdAt"}
+	digest := queryDigest("user-1", q)
+	key, desc, err := parseOrderBy(q.OrderBy, minerQuerySpec.sorts)
+	assert.Nil(t, err)
+	assert.True(t, desc)
+
+	value, _ := json.Marshal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	data, _ := json.Marshal(continueToken{Query: digest, Value: value, ID: 7})
+	token := base64.RawURLEncoding.EncodeToString(data)
+
+	sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		tx, err = seek(tx.Model(&model.MinerM{}), token, digest, key, desc)
+		return tx.Find(&[]*model.MinerM{})
+	})
+	assert.Nil(t, err)
+	assert.Contains(t, sql, "(created_at < '2024-01-02 03:04:05' OR (created_at = '2024-01-02 03:04:05' AND id < 7))")
+
+	// The token can't be used by another query.
+	_, err = seek(db, token, queryDigest("user-1", &ListQuery{OrderBy: "name"}), key, desc)
+	assert.True(t, errors.Is(err, ErrInvalidQuery))
+
+	_, _, err = parseOrderBy("cpu", minerQuerySpec.sorts)
+	assert.True(t, errors.Is(err, ErrInvalidQuery))
+}
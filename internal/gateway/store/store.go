@@ -6,7 +6,7 @@
 
 package store
 
-//go:generate mockgen -self_package github.com/superproj/onex/internal/gateway/store -destination mock_store.go -package store github.com/superproj/onex/internal/gateway/store IStore,ChainStore,MinerStore,MinerSetStore
+//go:generate mockgen -self_package github.com/superproj/onex/internal/gateway/store -destination mock_store.go -package store github.com/superproj/onex/internal/gateway/store IStore,ChainStore,MinerStore,MinerSetStore,AuditEventStore
 
 import (
 	"context"
@@ -32,6 +32,7 @@ type IStore interface {
 	Chains() ChainStore
 	Miners() MinerStore
 	MinerSets() MinerSetStore
+	AuditEvents() AuditEventStore
 }
 
 // datastore is a concrete implementation of IStore interface.
@@ -93,3 +94,8 @@ func (ds *datastore) MinerSets() MinerSetStore {
 func (ds *datastore) Miners() MinerStore {
 	return newMinerStore(ds)
 }
+
+// AuditEvents returns an AuditEventStore that interacts with datastore.
+func (ds *datastore) AuditEvents() AuditEventStore {
+	return newAuditEventStore(ds)
+}
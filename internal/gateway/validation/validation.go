@@ -10,9 +10,15 @@ import (
 	"context"
 
 	"github.com/google/wire"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/superproj/onex/internal/gateway/store"
+	"github.com/superproj/onex/internal/pkg/onexx"
 	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+	"github.com/superproj/onex/pkg/apis/apps"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+	"github.com/superproj/onex/pkg/apis/apps/validation"
 )
 
 // ProviderSet is a set of validator providers, used for dependency injection.
@@ -36,3 +42,43 @@ func New(ds store.IStore) (*validator, error) {
 func (vd *validator) ValidateListMinerSetRequest(ctx context.Context, rq *v1.ListMinerSetRequest) error {
 	return nil
 }
+
+// ValidateChain validates the chain of the CreateChain and UpdateChain requests
+// with the same rules as onex-apiserver, so that invalid chains are rejected early.
+// The namespace can be omitted, because chains are always stored in `kube-system`.
+func (vd *validator) ValidateChain(ctx context.Context, rq *v1beta1.Chain) error {
+	ch := rq.DeepCopy()
+	if ch.Namespace == "" {
+		ch.Namespace = metav1.NamespaceSystem
+	}
+
+	var obj apps.Chain
+	if err := v1beta1.Convert_v1beta1_Chain_To_apps_Chain(ch, &obj, nil); err != nil {
+		return err
+	}
+
+	allErrs := validation.ValidateChain(&obj)
+	allErrs = append(allErrs, validation.ValidateChainSpec(&obj.Spec, field.NewPath("spec"))...)
+	return allErrs.ToAggregate()
+}
+
+// ValidateChargeRequest validates the chargerequest of the CreateChargeRequest request
+// with the same rules as onex-apiserver. The password is required by the gateway, because
+// it is the only way for the users to prove that they own the charged account.
+func (vd *validator) ValidateChargeRequest(ctx context.Context, rq *v1beta1.ChargeRequest) error {
+	cr := rq.DeepCopy()
+	if cr.Namespace == "" {
+		cr.Namespace = onexx.FromUserID(ctx)
+	}
+
+	var obj apps.ChargeRequest
+	if err := v1beta1.Convert_v1beta1_ChargeRequest_To_apps_ChargeRequest(cr, &obj, nil); err != nil {
+		return err
+	}
+
+	allErrs := validation.ValidateChargeRequest(&obj)
+	if obj.Spec.Password == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "password"), ""))
+	}
+	return allErrs.ToAggregate()
+}
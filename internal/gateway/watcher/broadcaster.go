@@ -0,0 +1,228 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+// Package watcher fans the events of a shared informer out to the watchers of the gateway.
+package watcher
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// defaultHistorySize is the number of the recent events kept to resume the watches.
+	defaultHistorySize = 1000
+	// bufferSize is the number of the events buffered for a watcher. A watcher which
+	// falls further behind is stopped, and should be resumed by the client.
+	bufferSize = 100
+)
+
+// ErrExpired is returned when the events after the requested resource version are
+// no longer kept. The client should watch again without a resource version.
+var ErrExpired = errors.New("resource version is too old")
+
+// event is an event of the informer and the resource version it happened at.
+type event struct {
+	watch.Event
+	namespace string
+	rv        uint64
+}
+
+// Broadcaster keeps the recent events of an informer and sends them to the watchers.
+type Broadcaster struct {
+	mu       sync.Mutex
+	indexer  cache.Indexer
+	bookmark func(rv string) runtime.Object
+	// history is a ring of the recent events, next is the index of the oldest one when it is full.
+	history []event
+	next    int
+	size    int
+	// floor is the resource version after which all the events are in history.
+	floor    uint64
+	latest   uint64
+	watchers map[*Watcher]struct{}
+}
+
+// New creates a broadcaster of the events of the informer, which must have synced.
+// bookmark returns an empty object of the informer type at the given resource version.
+func New(informer cache.SharedIndexInformer, bookmark func(rv string) runtime.Object) (*Broadcaster, error) {
+	b := &Broadcaster{
+		indexer:  informer.GetIndexer(),
+		bookmark: bookmark,
+		size:     defaultHistorySize,
+		watchers: make(map[*Watcher]struct{}),
+	}
+
+	reg, err := informer.AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj any, isInInitialList bool) {
+			// The objects in the cache are listed by the watches without resource version.
+			if !isInInitialList {
+				b.dispatch(watch.Added, obj)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj any) {
+			// Skip the periodic resyncs, which don't change the objects.
+			if resourceVersion(oldObj) != resourceVersion(newObj) {
+				b.dispatch(watch.Modified, newObj)
+			}
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			b.dispatch(watch.Deleted, obj)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The events before the handler is registered are lost, they are covered by the
+	// resource version the informer has synced to.
+	cache.WaitForCacheSync(wait.NeverStop, reg.HasSynced)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.floor = max(b.floor, parseResourceVersion(informer.LastSyncResourceVersion()))
+	b.latest = max(b.latest, b.floor)
+
+	return b, nil
+}
+
+// Watch watches the events of the objects in the namespace after resourceVersion. If
+// resourceVersion is empty or "0", the objects in the cache are sent as ADDED events,
+// followed by a BOOKMARK event with the resource version to resume from.
+func (b *Broadcaster) Watch(namespace string, resourceVersion string) (*Watcher, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var events []watch.Event
+	if resourceVersion == "" || resourceVersion == "0" {
+		objs, err := b.indexer.ByIndex(cache.NamespaceIndex, namespace)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objs {
+			events = append(events, watch.Event{Type: watch.Added, Object: obj.(runtime.Object)})
+		}
+		events = append(events, watch.Event{Type: watch.Bookmark, Object: b.bookmark(strconv.FormatUint(b.latest, 10))})
+	} else {
+		rv, err := strconv.ParseUint(resourceVersion, 10, 64)
+		if err != nil || rv < b.floor {
+			return nil, ErrExpired
+		}
+		b.each(func(e *event) {
+			if e.rv > rv && e.namespace == namespace {
+				events = append(events, e.Event)
+			}
+		})
+	}
+
+	w := &Watcher{b: b, namespace: namespace, ch: make(chan watch.Event, len(events)+bufferSize)}
+	for _, e := range events {
+		w.ch <- e
+	}
+	b.watchers[w] = struct{}{}
+
+	return w, nil
+}
+
+// dispatch records the event and sends it to the watchers of its namespace.
+func (b *Broadcaster) dispatch(typ watch.EventType, obj any) {
+	robj, ok := obj.(runtime.Object)
+	if !ok {
+		return
+	}
+	accessor, err := meta.Accessor(robj)
+	if err != nil {
+		return
+	}
+
+	e := event{
+		Event:     watch.Event{Type: typ, Object: robj},
+		namespace: accessor.GetNamespace(),
+		rv:        parseResourceVersion(accessor.GetResourceVersion()),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.history) < b.size {
+		b.history = append(b.history, e)
+	} else {
+		b.floor = max(b.floor, b.history[b.next].rv)
+		b.history[b.next] = e
+		b.next = (b.next + 1) % b.size
+	}
+	b.latest = max(b.latest, e.rv)
+
+	for w := range b.watchers {
+		if w.namespace != e.namespace {
+			continue
+		}
+		select {
+		case w.ch <- e.Event:
+		default:
+			b.stop(w)
+		}
+	}
+}
+
+// each calls fn on the events in history from the oldest to the newest.
+func (b *Broadcaster) each(fn func(e *event)) {
+	for i := range b.history {
+		fn(&b.history[(b.next+i)%len(b.history)])
+	}
+}
+
+// stop closes the channel of the watcher. The caller must hold the lock.
+func (b *Broadcaster) stop(w *Watcher) {
+	if _, ok := b.watchers[w]; ok {
+		delete(b.watchers, w)
+		close(w.ch)
+	}
+}
+
+// Watcher receives the events of the objects in a namespace. It implements watch.Interface.
+type Watcher struct {
+	b         *Broadcaster
+	namespace string
+	ch        chan watch.Event
+}
+
+var _ watch.Interface = (*Watcher)(nil)
+
+// ResultChan returns the channel of the events. It is closed when the watcher is stopped,
+// or when it falls behind, in which case the client should resume from the last event.
+func (w *Watcher) ResultChan() <-chan watch.Event {
+	return w.ch
+}
+
+// Stop stops the watcher.
+func (w *Watcher) Stop() {
+	w.b.mu.Lock()
+	defer w.b.mu.Unlock()
+	w.b.stop(w)
+}
+
+func resourceVersion(obj any) string {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+	return accessor.GetResourceVersion()
+}
+
+func parseResourceVersion(rv string) uint64 {
+	n, _ := strconv.ParseUint(rv, 10, 64)
+	return n
+}
@@ -0,0 +1,95 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package watcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+)
+
+func miner(namespace, name, rv string) *v1beta1.Miner {
+	return &v1beta1.Miner{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, ResourceVersion: rv}}
+}
+
+func receive(t *testing.T, w *Watcher) watch.Event {
+	select {
+	case e := <-w.ResultChan():
+		return e
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the event")
+	}
+	return watch.Event{}
+}
+
+func TestBroadcaster(t *testing.T) {
+	fw := watch.NewFake()
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			return &v1beta1.MinerList{
+				ListMeta: metav1.ListMeta{ResourceVersion: "2"},
+				Items:    []v1beta1.Miner{*miner("a", "m1", "1"), *miner("b", "m2", "2")},
+			}, nil
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			return fw, nil
+		},
+	}
+	informer := cache.NewSharedIndexInformer(lw, &v1beta1.Miner{}, 0, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go informer.Run(ctx.Done())
+	assert.True(t, cache.WaitForCacheSync(ctx.Done(), informer.HasSynced))
+
+	b, err := New(informer, func(rv string) runtime.Object { return miner("", "", rv) })
+	assert.Nil(t, err)
+
+	// The objects in the namespace are listed first.
+	w, err := b.Watch("a", "")
+	assert.Nil(t, err)
+	defer w.Stop()
+	e := receive(t, w)
+	assert.Equal(t, watch.Added, e.Type)
+	assert.Equal(t, "m1", e.Object.(*v1beta1.Miner).Name)
+	e = receive(t, w)
+	assert.Equal(t, watch.Bookmark, e.Type)
+	assert.Equal(t, "2", e.Object.(*v1beta1.Miner).ResourceVersion)
+
+	// The events of the other namespaces are not sent.
+	fw.Modify(miner("b", "m2", "3"))
+	fw.Modify(miner("a", "m1", "4"))
+	fw.Delete(miner("a", "m1", "5"))
+	e = receive(t, w)
+	assert.Equal(t, watch.Modified, e.Type)
+	assert.Equal(t, "4", e.Object.(*v1beta1.Miner).ResourceVersion)
+	e = receive(t, w)
+	assert.Equal(t, watch.Deleted, e.Type)
+
+	// The watch is resumed after the resource version.
+	resumed, err := b.Watch("a", "4")
+	assert.Nil(t, err)
+	defer resumed.Stop()
+	e = receive(t, resumed)
+	assert.Equal(t, watch.Deleted, e.Type)
+	assert.Equal(t, "5", e.Object.(*v1beta1.Miner).ResourceVersion)
+
+	_, err = b.Watch("a", "1")
+	assert.ErrorIs(t, err, ErrExpired)
+
+	w.Stop()
+	_, ok := <-w.ResultChan()
+	assert.False(t, ok)
+}
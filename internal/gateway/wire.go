@@ -18,10 +18,12 @@ import (
 	"github.com/google/wire"
 
 	"github.com/superproj/onex/internal/gateway/biz"
+	"github.com/superproj/onex/internal/gateway/quota"
 	"github.com/superproj/onex/internal/gateway/server"
 	"github.com/superproj/onex/internal/gateway/service"
 	"github.com/superproj/onex/internal/gateway/store"
 	customvalidation "github.com/superproj/onex/internal/gateway/validation"
+	"github.com/superproj/onex/internal/pkg/audit"
 	"github.com/superproj/onex/internal/pkg/bootstrap"
 	"github.com/superproj/onex/internal/pkg/client/usercenter"
 	"github.com/superproj/onex/internal/pkg/idempotent"
@@ -42,6 +44,9 @@ func wireApp(
 	*usercenter.UserCenterOptions,
 	*genericoptions.RedisOptions,
 	*genericoptions.EtcdOptions,
+	*genericoptions.RateLimitOptions,
+	*quota.QuotaOptions,
+	*audit.AuditOptions,
 ) (*kratos.App, func(), error) {
 	wire.Build(
 		bootstrap.ProviderSet,
@@ -55,6 +60,8 @@ func wireApp(
 		validation.ProviderSet,
 		idempotent.ProviderSet,
 		customvalidation.ProviderSet,
+		quota.ProviderSet,
+		audit.ProviderSet,
 		createInformers,
 	)
 
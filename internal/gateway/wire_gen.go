@@ -15,10 +15,12 @@ package gateway
 import (
 	"github.com/go-kratos/kratos/v2"
 	"github.com/superproj/onex/internal/gateway/biz"
+	"github.com/superproj/onex/internal/gateway/quota"
 	"github.com/superproj/onex/internal/gateway/server"
 	"github.com/superproj/onex/internal/gateway/service"
 	"github.com/superproj/onex/internal/gateway/store"
 	"github.com/superproj/onex/internal/gateway/validation"
+	"github.com/superproj/onex/internal/pkg/audit"
 	"github.com/superproj/onex/internal/pkg/bootstrap"
 	"github.com/superproj/onex/internal/pkg/client/usercenter"
 	"github.com/superproj/onex/internal/pkg/idempotent"
@@ -31,7 +33,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(arg <-chan struct{}, appInfo bootstrap.AppInfo, config *server.Config, versionedInterface versioned.Interface, mySQLOptions *db.MySQLOptions, redisOptions *db.RedisOptions, userCenterOptions *usercenter.UserCenterOptions, optionsRedisOptions *options.RedisOptions, etcdOptions *options.EtcdOptions) (*kratos.App, func(), error) {
+func wireApp(arg <-chan struct{}, appInfo bootstrap.AppInfo, config *server.Config, versionedInterface versioned.Interface, mySQLOptions *db.MySQLOptions, redisOptions *db.RedisOptions, userCenterOptions *usercenter.UserCenterOptions, optionsRedisOptions *options.RedisOptions, etcdOptions *options.EtcdOptions, rateLimitOptions *options.RateLimitOptions, quotaOptions *quota.QuotaOptions, auditOptions *audit.AuditOptions) (*kratos.App, func(), error) {
 	logger := bootstrap.NewLogger(appInfo)
 	registrar := bootstrap.NewEtcdRegistrar(etcdOptions)
 	appConfig := bootstrap.AppConfig{
@@ -48,7 +50,11 @@ func wireApp(arg <-chan struct{}, appInfo bootstrap.AppInfo, config *server.Conf
 	if err != nil {
 		return nil, nil, err
 	}
-	bizBiz := biz.NewBiz(datastore, versionedInterface, sharedInformerFactory)
+	checker := quota.NewChecker(quotaOptions, sharedInformerFactory)
+	bizBiz, err := biz.NewBiz(datastore, versionedInterface, sharedInformerFactory, checker)
+	if err != nil {
+		return nil, nil, err
+	}
 	client, err := db.NewRedis(redisOptions)
 	if err != nil {
 		return nil, nil, err
@@ -64,11 +70,16 @@ func wireApp(arg <-chan struct{}, appInfo bootstrap.AppInfo, config *server.Conf
 		return nil, nil, err
 	}
 	validationValidator := validation2.New(validator)
-	v := server.NewMiddlewares(logger, idempotentIdempotent, impl, validationValidator)
-	httpServer := server.NewHTTPServer(config, gatewayService, v)
+	auditor, cleanup, err := audit.NewAuditor(auditOptions, gormDB)
+	if err != nil {
+		return nil, nil, err
+	}
+	v := server.NewMiddlewares(logger, idempotentIdempotent, impl, validationValidator, client, rateLimitOptions, auditor, versionedInterface)
+	httpServer := server.NewHTTPServer(config, gatewayService, impl, client, v)
 	grpcServer := server.NewGRPCServer(config, gatewayService, v)
 	v2 := server.NewServers(httpServer, grpcServer)
 	app := bootstrap.NewApp(appConfig, v2...)
 	return app, func() {
+		cleanup()
 	}, nil
 }
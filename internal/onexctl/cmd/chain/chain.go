@@ -0,0 +1,56 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+// Package chain provides functions to manage chains on onex platform.
+package chain
+
+import (
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	cmdutil "github.com/superproj/onex/internal/onexctl/cmd/util"
+	"github.com/superproj/onex/internal/onexctl/util/templates"
+	"github.com/superproj/onex/pkg/cli/genericclioptions"
+)
+
+var chainLong = templates.LongDesc(`
+	Chain management commands.
+
+	This commands allow you to manage the chains on onex platform.`)
+
+// NewCmdChain returns new initialized instance of 'chain' sub command.
+func NewCmdChain(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "chain SUBCOMMAND",
+		DisableFlagsInUseLine: true,
+		Short:                 "Manage chains on onex platform",
+		Long:                  chainLong,
+		Run:                   cmdutil.DefaultSubCommandRun(ioStreams.ErrOut),
+	}
+
+	cmd.AddCommand(NewCmdCreate(f, ioStreams))
+	cmd.AddCommand(NewCmdGet(f, ioStreams))
+	cmd.AddCommand(NewCmdList(f, ioStreams))
+	cmd.AddCommand(NewCmdUpdate(f, ioStreams))
+	cmd.AddCommand(NewCmdDelete(f, ioStreams))
+
+	return cmd
+}
+
+// setHeader set headers for chain commands.
+func setHeader(table *tablewriter.Table) *tablewriter.Table {
+	table.SetHeader([]string{"Name", "DisplayName", "MinerType", "Image", "MinMineInterval", "Created"})
+	table.SetHeaderColor(
+		tablewriter.Colors{tablewriter.FgGreenColor},
+		tablewriter.Colors{tablewriter.FgRedColor},
+		tablewriter.Colors{tablewriter.FgWhiteColor},
+		tablewriter.Colors{tablewriter.FgCyanColor},
+		tablewriter.Colors{tablewriter.FgMagentaColor},
+		tablewriter.Colors{tablewriter.FgGreenColor},
+	)
+
+	return table
+}
@@ -0,0 +1,132 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmdutil "github.com/superproj/onex/internal/onexctl/cmd/util"
+	"github.com/superproj/onex/internal/onexctl/util/templates"
+	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+	"github.com/superproj/onex/pkg/cli/genericclioptions"
+)
+
+const (
+	createUsageStr = "create CHAIN_NAME"
+)
+
+// CreateOptions is an options struct to support create subcommands.
+type CreateOptions struct {
+	DisplayName            string
+	MinerType              string
+	Image                  string
+	MinMineIntervalSeconds int32
+
+	Chain  *v1beta1.Chain
+	client v1.GatewayHTTPClient
+
+	genericclioptions.IOStreams
+}
+
+var (
+	createLong = templates.LongDesc(`Create chain resource.
+
+The genesis miner of the chain is created by onex-controller-manager.`)
+
+	createExample = templates.Examples(`
+		# Create a chain with the default miner type
+		onexctl chain create foo
+
+		# Create a chain with a specified miner type and image
+		onexctl chain create foo --miner-type=S1.SMALL2 --image=ccr.ccs.tencentyun.com/superproj/onex-toyblc-amd64:v1.0.0`)
+
+	createUsageErrStr = fmt.Sprintf(
+		"expected '%s'.\nCHAIN_NAME is required arguments for the create command",
+		createUsageStr,
+	)
+)
+
+// NewCreateOptions returns an initialized CreateOptions instance.
+func NewCreateOptions(ioStreams genericclioptions.IOStreams) *CreateOptions {
+	return &CreateOptions{
+		IOStreams: ioStreams,
+	}
+}
+
+// NewCmdCreate returns new initialized instance of create sub command.
+func NewCmdCreate(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	o := NewCreateOptions(ioStreams)
+
+	cmd := &cobra.Command{
+		Use:                   createUsageStr,
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{},
+		Short:                 "Create chain resource",
+		TraverseChildren:      true,
+		Long:                  createLong,
+		Example:               createExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(f, cmd, args))
+			cmdutil.CheckErr(o.Validate(cmd, args))
+			cmdutil.CheckErr(o.Run(f, args))
+		},
+		SuggestFor: []string{},
+	}
+
+	cmd.Flags().StringVar(&o.DisplayName, "display-name", o.DisplayName, "The display name of the chain.")
+	cmd.Flags().StringVar(&o.MinerType, "miner-type", o.MinerType, "The miner type of the genesis miner.")
+	cmd.Flags().StringVar(&o.Image, "image", o.Image, "The image of the blockchain nodes.")
+	cmd.Flags().Int32Var(&o.MinMineIntervalSeconds, "min-mine-interval-seconds", o.MinMineIntervalSeconds,
+		"Minimum number of seconds for the miners to mine a block.")
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *CreateOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return cmdutil.UsageErrorf(cmd, createUsageErrStr)
+	}
+
+	o.Chain = &v1beta1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: args[0]},
+		Spec: v1beta1.ChainSpec{
+			DisplayName:            o.DisplayName,
+			MinerType:              o.MinerType,
+			Image:                  o.Image,
+			MinMineIntervalSeconds: o.MinMineIntervalSeconds,
+		},
+	}
+
+	o.client = f.GatewayClient()
+	return nil
+}
+
+// Validate makes sure there is no discrepency in command options.
+func (o *CreateOptions) Validate(cmd *cobra.Command, args []string) error {
+	if o.MinMineIntervalSeconds < 0 {
+		return fmt.Errorf("--min-mine-interval-seconds must not be negative")
+	}
+
+	return nil
+}
+
+// Run executes a create subcommand using the specified options.
+func (o *CreateOptions) Run(f cmdutil.Factory, args []string) error {
+	if _, err := o.client.CreateChain(context.Background(), o.Chain); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "chain/%s created\n", o.Chain.Name)
+
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	cmdutil "github.com/superproj/onex/internal/onexctl/cmd/util"
+	"github.com/superproj/onex/internal/onexctl/util/templates"
+	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+	"github.com/superproj/onex/pkg/cli/genericclioptions"
+)
+
+const (
+	deleteUsageStr = "delete CHAIN_NAME"
+)
+
+// DeleteOptions is an options struct to support delete subcommands.
+type DeleteOptions struct {
+	DeleteChainRequest *v1.DeleteChainRequest
+	client             v1.GatewayHTTPClient
+
+	genericclioptions.IOStreams
+}
+
+var (
+	deleteExample = templates.Examples(`
+		# Delete chain foo
+		onexctl chain delete foo`)
+
+	deleteUsageErrStr = fmt.Sprintf(
+		"expected '%s'.\nCHAIN_NAME is required arguments for the delete command",
+		deleteUsageStr,
+	)
+)
+
+// NewDeleteOptions returns an initialized DeleteOptions instance.
+func NewDeleteOptions(ioStreams genericclioptions.IOStreams) *DeleteOptions {
+	return &DeleteOptions{
+		IOStreams: ioStreams,
+	}
+}
+
+// NewCmdDelete returns new initialized instance of delete sub command.
+func NewCmdDelete(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	o := NewDeleteOptions(ioStreams)
+
+	cmd := &cobra.Command{
+		Use:                   deleteUsageStr,
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{},
+		Short:                 "Delete a chain resource",
+		TraverseChildren:      true,
+		Long:                  "Delete a chain resource.",
+		Example:               deleteExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(f, cmd, args))
+			cmdutil.CheckErr(o.Validate(cmd, args))
+			cmdutil.CheckErr(o.Run(f))
+		},
+		SuggestFor: []string{},
+	}
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *DeleteOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return cmdutil.UsageErrorf(cmd, deleteUsageErrStr)
+	}
+
+	o.DeleteChainRequest = &v1.DeleteChainRequest{
+		Name: args[0],
+	}
+
+	o.client = f.GatewayClient()
+
+	return nil
+}
+
+// Validate makes sure there is no discrepency in command options.
+func (o *DeleteOptions) Validate(cmd *cobra.Command, args []string) error {
+	return o.DeleteChainRequest.Validate()
+}
+
+// Run executes a delete subcommand using the specified options.
+func (o *DeleteOptions) Run(f cmdutil.Factory) error {
+	if _, err := o.client.DeleteChain(context.Background(), o.DeleteChainRequest); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "chain/%s deleted\n", o.DeleteChainRequest.Name)
+
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	cmdutil "github.com/superproj/onex/internal/onexctl/cmd/util"
+	"github.com/superproj/onex/internal/onexctl/util/templates"
+	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+	"github.com/superproj/onex/pkg/cli/genericclioptions"
+)
+
+const (
+	getUsageStr = "get CHAIN_NAME"
+)
+
+// GetOptions is an options struct to support get subcommands.
+type GetOptions struct {
+	GetChainRequest *v1.GetChainRequest
+	client          v1.GatewayHTTPClient
+
+	genericclioptions.IOStreams
+}
+
+var (
+	getExample = templates.Examples(`
+		# Get a specified chain information
+		onexctl chain get foo`)
+
+	getUsageErrStr = fmt.Sprintf("expected '%s'.\nCHAIN_NAME is required arguments for the get command", getUsageStr)
+)
+
+// NewGetOptions returns an initialized GetOptions instance.
+func NewGetOptions(ioStreams genericclioptions.IOStreams) *GetOptions {
+	return &GetOptions{
+		IOStreams: ioStreams,
+	}
+}
+
+// NewCmdGet returns new initialized instance of get sub command.
+func NewCmdGet(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	o := NewGetOptions(ioStreams)
+
+	cmd := &cobra.Command{
+		Use:                   getUsageStr,
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{},
+		Short:                 "Display a chain resource",
+		TraverseChildren:      true,
+		Long:                  "Display a chain resource.",
+		Example:               getExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(f, cmd, args))
+			cmdutil.CheckErr(o.Validate(cmd, args))
+			cmdutil.CheckErr(o.Run(f, args))
+		},
+		SuggestFor: []string{},
+	}
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *GetOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return cmdutil.UsageErrorf(cmd, getUsageErrStr)
+	}
+
+	o.GetChainRequest = &v1.GetChainRequest{Name: args[0]}
+	o.client = f.GatewayClient()
+
+	return nil
+}
+
+// Validate makes sure there is no discrepency in command options.
+func (o *GetOptions) Validate(cmd *cobra.Command, args []string) error {
+	return o.GetChainRequest.Validate()
+}
+
+// Run executes a get subcommand using the specified options.
+func (o *GetOptions) Run(f cmdutil.Factory, args []string) error {
+	chain, err := o.client.GetChain(context.Background(), o.GetChainRequest)
+	if err != nil {
+		return err
+	}
+
+	table := tablewriter.NewWriter(o.Out)
+	table = setHeader(table)
+	table = cmdutil.TableWriterDefaultConfig(table)
+	table.AppendBulk([][]string{row(chain)})
+	table.Render()
+
+	return nil
+}
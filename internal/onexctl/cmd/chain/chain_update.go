@@ -0,0 +1,149 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmdutil "github.com/superproj/onex/internal/onexctl/cmd/util"
+	"github.com/superproj/onex/internal/onexctl/util/templates"
+	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+	"github.com/superproj/onex/pkg/cli/genericclioptions"
+)
+
+const (
+	updateUsageStr = "update CHAIN_NAME"
+)
+
+// UpdateOptions is an options struct to support update subcommands.
+type UpdateOptions struct {
+	Name                   string
+	DisplayName            string
+	MinerType              string
+	Image                  string
+	MinMineIntervalSeconds int32
+
+	cmd    *cobra.Command
+	client v1.GatewayHTTPClient
+
+	genericclioptions.IOStreams
+}
+
+var (
+	updateExample = templates.Examples(`
+		# Update the display name and the image of a chain resource
+		onexctl chain update foo --display-name=bar --image=ccr.ccs.tencentyun.com/superproj/onex-toyblc-amd64:v1.0.0`)
+
+	updateUsageErrStr = fmt.Sprintf(
+		"expected '%s'.\nCHAIN_NAME is required arguments for the update command",
+		updateUsageStr,
+	)
+)
+
+// NewUpdateOptions returns an initialized UpdateOptions instance.
+func NewUpdateOptions(ioStreams genericclioptions.IOStreams) *UpdateOptions {
+	return &UpdateOptions{
+		IOStreams: ioStreams,
+	}
+}
+
+// NewCmdUpdate returns new initialized instance of update sub command.
+func NewCmdUpdate(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	o := NewUpdateOptions(ioStreams)
+
+	cmd := &cobra.Command{
+		Use:                   updateUsageStr,
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{},
+		Short:                 "Update a chain resource",
+		TraverseChildren:      true,
+		Long:                  "Update a chain resource. The fields whose flags are not given are kept.",
+		Example:               updateExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(f, cmd, args))
+			cmdutil.CheckErr(o.Validate(cmd, args))
+			cmdutil.CheckErr(o.Run(f, args))
+		},
+		SuggestFor: []string{},
+	}
+
+	cmd.Flags().StringVar(&o.DisplayName, "display-name", o.DisplayName, "The display name of the chain.")
+	cmd.Flags().StringVar(&o.MinerType, "miner-type", o.MinerType, "The miner type of the genesis miner.")
+	cmd.Flags().StringVar(&o.Image, "image", o.Image, "The image of the blockchain nodes.")
+	cmd.Flags().Int32Var(&o.MinMineIntervalSeconds, "min-mine-interval-seconds", o.MinMineIntervalSeconds,
+		"Minimum number of seconds for the miners to mine a block.")
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *UpdateOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return cmdutil.UsageErrorf(cmd, updateUsageErrStr)
+	}
+
+	o.Name = args[0]
+	o.cmd = cmd
+	o.client = f.GatewayClient()
+
+	return nil
+}
+
+// Validate makes sure there is no discrepency in command options.
+func (o *UpdateOptions) Validate(cmd *cobra.Command, args []string) error {
+	if o.MinMineIntervalSeconds < 0 {
+		return fmt.Errorf("--min-mine-interval-seconds must not be negative")
+	}
+
+	return nil
+}
+
+// Run executes a update subcommand using the specified options.
+func (o *UpdateOptions) Run(f cmdutil.Factory, args []string) error {
+	ctx := context.Background()
+
+	// UpdateChain replaces the whole spec, so start from the current one.
+	current, err := o.client.GetChain(ctx, &v1.GetChainRequest{Name: o.Name})
+	if err != nil {
+		return err
+	}
+
+	ch := &v1beta1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: o.Name},
+		Spec: v1beta1.ChainSpec{
+			DisplayName:            current.DisplayName,
+			MinerType:              current.MinerType,
+			Image:                  current.Image,
+			MinMineIntervalSeconds: current.MinMineIntervalSeconds,
+		},
+	}
+	if o.cmd.Flags().Changed("display-name") {
+		ch.Spec.DisplayName = o.DisplayName
+	}
+	if o.cmd.Flags().Changed("miner-type") {
+		ch.Spec.MinerType = o.MinerType
+	}
+	if o.cmd.Flags().Changed("image") {
+		ch.Spec.Image = o.Image
+	}
+	if o.cmd.Flags().Changed("min-mine-interval-seconds") {
+		ch.Spec.MinMineIntervalSeconds = o.MinMineIntervalSeconds
+	}
+
+	if _, err := o.client.UpdateChain(ctx, ch); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "chain/%s updated\n", o.Name)
+
+	return nil
+}
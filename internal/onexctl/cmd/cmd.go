@@ -19,12 +19,16 @@ import (
 	cliflag "k8s.io/component-base/cli/flag"
 	"k8s.io/klog/v2"
 
+	"github.com/superproj/onex/internal/onexctl/cmd/chain"
 	"github.com/superproj/onex/internal/onexctl/cmd/color"
 	"github.com/superproj/onex/internal/onexctl/cmd/completion"
 	"github.com/superproj/onex/internal/onexctl/cmd/info"
 	"github.com/superproj/onex/internal/onexctl/cmd/jwt"
 	"github.com/superproj/onex/internal/onexctl/cmd/new"
 	"github.com/superproj/onex/internal/onexctl/cmd/options"
+	"github.com/superproj/onex/internal/onexctl/cmd/policy"
+	"github.com/superproj/onex/internal/onexctl/cmd/role"
+	"github.com/superproj/onex/internal/onexctl/cmd/rolebinding"
 	cmdutil "github.com/superproj/onex/internal/onexctl/cmd/util"
 	"github.com/superproj/onex/internal/onexctl/cmd/validate"
 	"github.com/superproj/onex/internal/onexctl/cmd/version"
@@ -132,12 +136,17 @@ func NewOneXCtlCommand(in io.Reader, out, err io.Writer) *cobra.Command {
 			},
 		},
 		{
-			Message:  "UserCenter Commands:",
-			Commands: []*cobra.Command{},
+			Message: "UserCenter Commands:",
+			Commands: []*cobra.Command{
+				role.NewCmdRole(f, ioStreams),
+				rolebinding.NewCmdRoleBinding(f, ioStreams),
+				policy.NewCmdPolicy(f, ioStreams),
+			},
 		},
 		{
 			Message: "Gateway Commands:",
 			Commands: []*cobra.Command{
+				chain.NewCmdChain(f, ioStreams),
 				minerset.NewCmdMinerSet(f, ioStreams),
 			},
 		},
@@ -8,6 +8,7 @@ package minerset
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -26,8 +27,12 @@ const (
 
 // ListOptions is an options struct to support list subcommands.
 type ListOptions struct {
-	Offset int64
-	Limit  int64
+	Offset        int64
+	Limit         int64
+	LabelSelector string
+	FieldSelector string
+	SortBy        string
+	Continue      string
 
 	ListMinerSetRequest *v1.ListMinerSetRequest
 	client              v1.GatewayHTTPClient
@@ -39,7 +44,13 @@ var listExample = templates.Examples(`
 		onexctl minerset list
 
 		# List minersets with limit and offset 
-		onexctl minerset list --offset=0 --limit=5`)
+		onexctl minerset list --offset=0 --limit=5
+
+		# List the minersets of chain foo labeled env=prod, newest first
+		onexctl minerset list --selector=env=prod --field-selector=spec.template.spec.chainName=foo --sort-by=-createdAt
+
+		# List the next page of minersets
+		onexctl minerset list --limit=5 --continue=<token printed by the previous page>`)
 
 // NewListOptions returns an initialized ListOptions instance.
 func NewListOptions(ioStreams genericclioptions.IOStreams) *ListOptions {
@@ -72,6 +83,10 @@ func NewCmdList(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra
 
 	cmd.Flags().Int64VarP(&o.Offset, "offset", "o", o.Offset, "Specify the offset of the first row to be returned.")
 	cmd.Flags().Int64VarP(&o.Limit, "limit", "l", o.Limit, "Specify the amount records to be returned.")
+	cmd.Flags().StringVar(&o.LabelSelector, "selector", o.LabelSelector, "Selector (label query) to filter on, supports '=', '==', '!=', 'in', 'notin'.")
+	cmd.Flags().StringVar(&o.FieldSelector, "field-selector", o.FieldSelector, "Selector (field query) to filter on, supports '=', '==', and '!='.")
+	cmd.Flags().StringVar(&o.SortBy, "sort-by", o.SortBy, "Sort key, one of name, createdAt, updatedAt and replicas, prefixed with '-' for the descending order.")
+	cmd.Flags().StringVar(&o.Continue, "continue", o.Continue, "The continue token printed by the previous page.")
 
 	return cmd
 }
@@ -79,8 +94,12 @@ func NewCmdList(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra
 // Complete completes all the required options.
 func (o *ListOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args []string) error {
 	o.ListMinerSetRequest = &v1.ListMinerSetRequest{
-		Limit:  o.Limit,
-		Offset: o.Offset,
+		Limit:         o.Limit,
+		Offset:        o.Offset,
+		LabelSelector: o.LabelSelector,
+		FieldSelector: o.FieldSelector,
+		OrderBy:       o.SortBy,
+		Continue:      o.Continue,
 	}
 	o.client = f.GatewayClient()
 
@@ -116,5 +135,9 @@ func (o *ListOptions) Run(f cmdutil.Factory, args []string) error {
 	table.AppendBulk(data)
 	table.Render()
 
+	if minersets.Continue != "" {
+		fmt.Fprintf(o.Out, "\nMore minersets can be listed with --continue=%s\n", minersets.Continue)
+	}
+
 	return nil
 }
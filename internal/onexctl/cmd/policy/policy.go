@@ -0,0 +1,54 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+// Package policy provides functions to manage authorization policies on onex platform.
+package policy
+
+import (
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	cmdutil "github.com/superproj/onex/internal/onexctl/cmd/util"
+	"github.com/superproj/onex/internal/onexctl/util/templates"
+	"github.com/superproj/onex/pkg/cli/genericclioptions"
+)
+
+var policyLong = templates.LongDesc(`
+	Policy management commands.
+
+	This commands allow you to manage the authorization policies on onex platform.
+	A policy allows or denies a subject, which is a role or a user id, to perform
+	an action on an object in a domain (tenant). Objects are path patterns such as
+	/v1/minersets/*, and the "*" action and domain match every action and domain.`)
+
+// NewCmdPolicy returns new initialized instance of 'policy' sub command.
+func NewCmdPolicy(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "policy SUBCOMMAND",
+		DisableFlagsInUseLine: true,
+		Short:                 "Manage authorization policies on onex platform",
+		Long:                  policyLong,
+		Run:                   cmdutil.DefaultSubCommandRun(ioStreams.ErrOut),
+	}
+
+	cmd.AddCommand(NewCmdCreate(f, ioStreams))
+	cmd.AddCommand(NewCmdList(f, ioStreams))
+	cmd.AddCommand(NewCmdDelete(f, ioStreams))
+
+	return cmd
+}
+
+// setHeader set headers for policy commands.
+func setHeader(table *tablewriter.Table) *tablewriter.Table {
+	table.SetHeader([]string{"Subject", "Domain", "Object", "Action", "Effect"})
+	table.SetHeaderColor(tablewriter.Colors{tablewriter.FgGreenColor},
+		tablewriter.Colors{tablewriter.FgMagentaColor},
+		tablewriter.Colors{tablewriter.FgCyanColor},
+		tablewriter.Colors{tablewriter.FgWhiteColor},
+		tablewriter.Colors{tablewriter.FgRedColor})
+
+	return table
+}
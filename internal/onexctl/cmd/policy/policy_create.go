@@ -0,0 +1,118 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	cmdutil "github.com/superproj/onex/internal/onexctl/cmd/util"
+	"github.com/superproj/onex/internal/onexctl/util/templates"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+	"github.com/superproj/onex/pkg/cli/genericclioptions"
+)
+
+const (
+	createUsageStr = "create SUBJECT OBJECT ACTION"
+)
+
+// CreateOptions is an options struct to support create subcommands.
+type CreateOptions struct {
+	Effect string
+	Domain string
+
+	CreatePolicyRequest *v1.CreatePolicyRequest
+	client              v1.UserCenterHTTPClient
+
+	genericclioptions.IOStreams
+}
+
+var (
+	createExample = templates.Examples(`
+		# Allow role operator to list minersets
+		onexctl policy create operator /v1/minersets GET
+
+		# Deny role operator to delete any minerset in domain tenant-a
+		onexctl policy create operator /v1/minersets/* DELETE --effect=deny --domain=tenant-a`)
+
+	createUsageErrStr = fmt.Sprintf(
+		"expected '%s'.\nSUBJECT, OBJECT and ACTION are required arguments for the create command",
+		createUsageStr,
+	)
+)
+
+// NewCreateOptions returns an initialized CreateOptions instance.
+func NewCreateOptions(ioStreams genericclioptions.IOStreams) *CreateOptions {
+	return &CreateOptions{
+		Effect:    "allow",
+		IOStreams: ioStreams,
+	}
+}
+
+// NewCmdCreate returns new initialized instance of create sub command.
+func NewCmdCreate(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	o := NewCreateOptions(ioStreams)
+
+	cmd := &cobra.Command{
+		Use:                   createUsageStr,
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{},
+		Short:                 "Create an authorization policy",
+		TraverseChildren:      true,
+		Long:                  "Create an authorization policy.",
+		Example:               createExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(f, cmd, args))
+			cmdutil.CheckErr(o.Validate(cmd, args))
+			cmdutil.CheckErr(o.Run(f, args))
+		},
+		SuggestFor: []string{},
+	}
+
+	cmd.Flags().StringVar(&o.Effect, "effect", o.Effect, "The effect of the policy, allow or deny.")
+	cmd.Flags().StringVar(&o.Domain, "domain", o.Domain, "The domain (tenant) the policy applies to, all the domains if empty.")
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *CreateOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args []string) error {
+	if len(args) < 3 {
+		return cmdutil.UsageErrorf(cmd, createUsageErrStr)
+	}
+
+	o.CreatePolicyRequest = &v1.CreatePolicyRequest{
+		Sub: args[0],
+		Obj: args[1],
+		Act: args[2],
+		Eft: o.Effect,
+		Dom: o.Domain,
+	}
+
+	o.client = f.UserCenterClient()
+	return nil
+}
+
+// Validate makes sure there is no discrepency in command options.
+func (o *CreateOptions) Validate(cmd *cobra.Command, args []string) error {
+	return o.CreatePolicyRequest.Validate()
+}
+
+// Run executes a create subcommand using the specified options.
+func (o *CreateOptions) Run(f cmdutil.Factory, args []string) error {
+	_, err := o.client.CreatePolicy(context.Background(), o.CreatePolicyRequest)
+	if err != nil {
+		return err
+	}
+
+	rq := o.CreatePolicyRequest
+	fmt.Fprintf(o.Out, "policy/%s:%s:%s:%s:%s created\n", rq.Sub, rq.Dom, rq.Obj, rq.Act, rq.Eft)
+
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	cmdutil "github.com/superproj/onex/internal/onexctl/cmd/util"
+	"github.com/superproj/onex/internal/onexctl/util/templates"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+	"github.com/superproj/onex/pkg/cli/genericclioptions"
+)
+
+const (
+	deleteUsageStr = "delete SUBJECT OBJECT ACTION"
+)
+
+// DeleteOptions is an options struct to support delete subcommands.
+type DeleteOptions struct {
+	Effect string
+	Domain string
+
+	DeletePolicyRequest *v1.DeletePolicyRequest
+	client              v1.UserCenterHTTPClient
+
+	genericclioptions.IOStreams
+}
+
+var (
+	deleteExample = templates.Examples(`
+		# Delete the policy allowing role operator to list minersets
+		onexctl policy delete operator /v1/minersets GET`)
+
+	deleteUsageErrStr = fmt.Sprintf(
+		"expected '%s'.\nSUBJECT, OBJECT and ACTION are required arguments for the delete command",
+		deleteUsageStr,
+	)
+)
+
+// NewDeleteOptions returns an initialized DeleteOptions instance.
+func NewDeleteOptions(ioStreams genericclioptions.IOStreams) *DeleteOptions {
+	return &DeleteOptions{
+		Effect:    "allow",
+		IOStreams: ioStreams,
+	}
+}
+
+// NewCmdDelete returns new initialized instance of delete sub command.
+func NewCmdDelete(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	o := NewDeleteOptions(ioStreams)
+
+	cmd := &cobra.Command{
+		Use:                   deleteUsageStr,
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{},
+		Short:                 "Delete an authorization policy",
+		TraverseChildren:      true,
+		Long:                  "Delete an authorization policy.",
+		Example:               deleteExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(f, cmd, args))
+			cmdutil.CheckErr(o.Validate(cmd, args))
+			cmdutil.CheckErr(o.Run(f))
+		},
+		SuggestFor: []string{},
+	}
+
+	cmd.Flags().StringVar(&o.Effect, "effect", o.Effect, "The effect of the policy, allow or deny.")
+	cmd.Flags().StringVar(&o.Domain, "domain", o.Domain, "The domain (tenant) of the policy, all the domains if empty.")
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *DeleteOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args []string) error {
+	if len(args) < 3 {
+		return cmdutil.UsageErrorf(cmd, deleteUsageErrStr)
+	}
+
+	o.DeletePolicyRequest = &v1.DeletePolicyRequest{
+		Sub: args[0],
+		Obj: args[1],
+		Act: args[2],
+		Eft: o.Effect,
+		Dom: o.Domain,
+	}
+
+	o.client = f.UserCenterClient()
+
+	return nil
+}
+
+// Validate makes sure there is no discrepency in command options.
+func (o *DeleteOptions) Validate(cmd *cobra.Command, args []string) error {
+	return o.DeletePolicyRequest.Validate()
+}
+
+// Run executes a delete subcommand using the specified options.
+func (o *DeleteOptions) Run(f cmdutil.Factory) error {
+	_, err := o.client.DeletePolicy(context.Background(), o.DeletePolicyRequest)
+	if err != nil {
+		return err
+	}
+
+	rq := o.DeletePolicyRequest
+	fmt.Fprintf(o.Out, "policy/%s:%s:%s:%s:%s deleted\n", rq.Sub, rq.Dom, rq.Obj, rq.Act, rq.Eft)
+
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package policy
+
+import (
+	"context"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	cmdutil "github.com/superproj/onex/internal/onexctl/cmd/util"
+	"github.com/superproj/onex/internal/onexctl/util/templates"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+	"github.com/superproj/onex/pkg/cli/genericclioptions"
+)
+
+// ListOptions is an options struct to support list subcommands.
+type ListOptions struct {
+	Subject string
+	Domain  string
+
+	ListPolicyRequest *v1.ListPolicyRequest
+	client            v1.UserCenterHTTPClient
+	genericclioptions.IOStreams
+}
+
+var listExample = templates.Examples(`
+		# List all policies
+		onexctl policy list
+
+		# List the policies of role operator
+		onexctl policy list --subject=operator`)
+
+// NewListOptions returns an initialized ListOptions instance.
+func NewListOptions(ioStreams genericclioptions.IOStreams) *ListOptions {
+	return &ListOptions{
+		IOStreams: ioStreams,
+	}
+}
+
+// NewCmdList returns new initialized instance of list sub command.
+func NewCmdList(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	o := NewListOptions(ioStreams)
+
+	cmd := &cobra.Command{
+		Use:                   "list",
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{},
+		Short:                 "Display authorization policies",
+		TraverseChildren:      true,
+		Long:                  "Display authorization policies.",
+		Example:               listExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(f, cmd, args))
+			cmdutil.CheckErr(o.Validate(cmd, args))
+			cmdutil.CheckErr(o.Run(f, args))
+		},
+		SuggestFor: []string{},
+	}
+
+	cmd.Flags().StringVar(&o.Subject, "subject", o.Subject, "Only display the policies of the subject.")
+	cmd.Flags().StringVar(&o.Domain, "domain", o.Domain, "Only display the policies in the domain.")
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *ListOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args []string) error {
+	o.ListPolicyRequest = &v1.ListPolicyRequest{Sub: o.Subject, Dom: o.Domain}
+	o.client = f.UserCenterClient()
+
+	return nil
+}
+
+// Validate makes sure there is no discrepency in command options.
+func (o *ListOptions) Validate(cmd *cobra.Command, args []string) error {
+	return o.ListPolicyRequest.Validate()
+}
+
+// Run executes a list subcommand using the specified options.
+func (o *ListOptions) Run(f cmdutil.Factory, args []string) error {
+	policies, err := o.client.ListPolicy(context.Background(), o.ListPolicyRequest)
+	if err != nil {
+		return err
+	}
+
+	data := make([][]string, 0, len(policies.Policies))
+	for _, policy := range policies.Policies {
+		data = append(data, []string{policy.Sub, policy.Dom, policy.Obj, policy.Act, policy.Eft})
+	}
+
+	table := tablewriter.NewWriter(o.Out)
+	table = setHeader(table)
+	table = cmdutil.TableWriterDefaultConfig(table)
+	table.AppendBulk(data)
+	table.Render()
+
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+// Package role provides functions to manage roles on onex platform.
+package role
+
+import (
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	cmdutil "github.com/superproj/onex/internal/onexctl/cmd/util"
+	"github.com/superproj/onex/internal/onexctl/util/templates"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+	"github.com/superproj/onex/pkg/cli/genericclioptions"
+)
+
+var roleLong = templates.LongDesc(`
+	Role management commands.
+
+	This commands allow you to manage the roles on onex platform. Roles are
+	bound to users by 'onexctl rolebinding' and granted permissions by 'onexctl policy'.`)
+
+// NewCmdRole returns new initialized instance of 'role' sub command.
+func NewCmdRole(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "role SUBCOMMAND",
+		DisableFlagsInUseLine: true,
+		Short:                 "Manage roles on onex platform",
+		Long:                  roleLong,
+		Run:                   cmdutil.DefaultSubCommandRun(ioStreams.ErrOut),
+	}
+
+	cmd.AddCommand(NewCmdCreate(f, ioStreams))
+	cmd.AddCommand(NewCmdGet(f, ioStreams))
+	cmd.AddCommand(NewCmdList(f, ioStreams))
+	cmd.AddCommand(NewCmdDelete(f, ioStreams))
+	cmd.AddCommand(NewCmdUpdate(f, ioStreams))
+
+	return cmd
+}
+
+// setHeader set headers for role commands.
+func setHeader(table *tablewriter.Table) *tablewriter.Table {
+	table.SetHeader([]string{"Name", "Description", "Created", "Updated"})
+	table.SetHeaderColor(tablewriter.Colors{tablewriter.FgGreenColor},
+		tablewriter.Colors{tablewriter.FgWhiteColor},
+		tablewriter.Colors{tablewriter.FgCyanColor},
+		tablewriter.Colors{tablewriter.FgMagentaColor})
+
+	return table
+}
+
+// toRow converts a role to a table row.
+func toRow(role *v1.RoleReply) []string {
+	return []string{
+		role.Name,
+		role.Description,
+		role.CreatedAt.AsTime().Format(time.DateTime),
+		role.UpdatedAt.AsTime().Format(time.DateTime),
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package role
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	cmdutil "github.com/superproj/onex/internal/onexctl/cmd/util"
+	"github.com/superproj/onex/internal/onexctl/util/templates"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+	"github.com/superproj/onex/pkg/cli/genericclioptions"
+)
+
+const (
+	createUsageStr = "create ROLE_NAME"
+)
+
+// CreateOptions is an options struct to support create subcommands.
+type CreateOptions struct {
+	Description string
+
+	CreateRoleRequest *v1.CreateRoleRequest
+	client            v1.UserCenterHTTPClient
+
+	genericclioptions.IOStreams
+}
+
+var (
+	createExample = templates.Examples(`
+		# Create a role
+		onexctl role create operator
+
+		# Create a role with description
+		onexctl role create operator --description="operators of the miners"`)
+
+	createUsageErrStr = fmt.Sprintf(
+		"expected '%s'.\nROLE_NAME is required arguments for the create command",
+		createUsageStr,
+	)
+)
+
+// NewCreateOptions returns an initialized CreateOptions instance.
+func NewCreateOptions(ioStreams genericclioptions.IOStreams) *CreateOptions {
+	return &CreateOptions{
+		IOStreams: ioStreams,
+	}
+}
+
+// NewCmdCreate returns new initialized instance of create sub command.
+func NewCmdCreate(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	o := NewCreateOptions(ioStreams)
+
+	cmd := &cobra.Command{
+		Use:                   createUsageStr,
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{},
+		Short:                 "Create a role resource",
+		TraverseChildren:      true,
+		Long:                  "Create a role resource.",
+		Example:               createExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(f, cmd, args))
+			cmdutil.CheckErr(o.Validate(cmd, args))
+			cmdutil.CheckErr(o.Run(f, args))
+		},
+		SuggestFor: []string{},
+	}
+
+	cmd.Flags().StringVar(&o.Description, "description", o.Description, "The description of the role.")
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *CreateOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return cmdutil.UsageErrorf(cmd, createUsageErrStr)
+	}
+
+	o.CreateRoleRequest = &v1.CreateRoleRequest{
+		Name:        args[0],
+		Description: o.Description,
+	}
+
+	o.client = f.UserCenterClient()
+	return nil
+}
+
+// Validate makes sure there is no discrepency in command options.
+func (o *CreateOptions) Validate(cmd *cobra.Command, args []string) error {
+	return o.CreateRoleRequest.Validate()
+}
+
+// Run executes a create subcommand using the specified options.
+func (o *CreateOptions) Run(f cmdutil.Factory, args []string) error {
+	_, err := o.client.CreateRole(context.Background(), o.CreateRoleRequest)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "role/%s created\n", o.CreateRoleRequest.Name)
+
+	return nil
+}
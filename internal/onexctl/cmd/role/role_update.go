@@ -0,0 +1,112 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package role
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	cmdutil "github.com/superproj/onex/internal/onexctl/cmd/util"
+	"github.com/superproj/onex/internal/onexctl/util/templates"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+	"github.com/superproj/onex/pkg/cli/genericclioptions"
+)
+
+const (
+	updateUsageStr = "update ROLE_NAME"
+)
+
+// UpdateOptions is an options struct to support update subcommands.
+type UpdateOptions struct {
+	Description string
+
+	UpdateRoleRequest *v1.UpdateRoleRequest
+	client            v1.UserCenterHTTPClient
+
+	genericclioptions.IOStreams
+}
+
+var (
+	updateExample = templates.Examples(`
+		# Update the description of a role
+		onexctl role update operator --description="new description"`)
+
+	updateUsageErrStr = fmt.Sprintf(
+		"expected '%s'.\nROLE_NAME is required arguments for the update command",
+		updateUsageStr,
+	)
+)
+
+// NewUpdateOptions returns an initialized UpdateOptions instance.
+func NewUpdateOptions(ioStreams genericclioptions.IOStreams) *UpdateOptions {
+	return &UpdateOptions{
+		IOStreams: ioStreams,
+	}
+}
+
+// NewCmdUpdate returns new initialized instance of update sub command.
+func NewCmdUpdate(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	o := NewUpdateOptions(ioStreams)
+
+	cmd := &cobra.Command{
+		Use:                   updateUsageStr,
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{},
+		Short:                 "Update a role resource",
+		TraverseChildren:      true,
+		Long:                  "Update a role resource.",
+		Example:               updateExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(f, cmd, args))
+			cmdutil.CheckErr(o.Validate(cmd, args))
+			cmdutil.CheckErr(o.Run(f, args))
+		},
+		SuggestFor: []string{},
+	}
+
+	cmd.Flags().StringVar(&o.Description, "description", o.Description, "The description of the role.")
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *UpdateOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return cmdutil.UsageErrorf(cmd, updateUsageErrStr)
+	}
+
+	o.UpdateRoleRequest = &v1.UpdateRoleRequest{
+		Name: args[0],
+	}
+
+	if cmd.Flags().Changed("description") {
+		o.UpdateRoleRequest.Description = &o.Description
+	}
+
+	o.client = f.UserCenterClient()
+
+	return nil
+}
+
+// Validate makes sure there is no discrepency in command options.
+func (o *UpdateOptions) Validate(cmd *cobra.Command, args []string) error {
+	return o.UpdateRoleRequest.Validate()
+}
+
+// Run executes a update subcommand using the specified options.
+func (o *UpdateOptions) Run(f cmdutil.Factory, args []string) error {
+	_, err := o.client.UpdateRole(context.Background(), o.UpdateRoleRequest)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "role/%s updated\n", o.UpdateRoleRequest.Name)
+
+	return nil
+}
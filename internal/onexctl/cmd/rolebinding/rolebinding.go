@@ -0,0 +1,50 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+// Package rolebinding provides functions to bind roles to users on onex platform.
+package rolebinding
+
+import (
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	cmdutil "github.com/superproj/onex/internal/onexctl/cmd/util"
+	"github.com/superproj/onex/internal/onexctl/util/templates"
+	"github.com/superproj/onex/pkg/cli/genericclioptions"
+)
+
+var rolebindingLong = templates.LongDesc(`
+	Role binding management commands.
+
+	This commands allow you to bind roles to users on onex platform.`)
+
+// NewCmdRoleBinding returns new initialized instance of 'rolebinding' sub command.
+func NewCmdRoleBinding(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "rolebinding SUBCOMMAND",
+		DisableFlagsInUseLine: true,
+		Short:                 "Manage role bindings on onex platform",
+		Long:                  rolebindingLong,
+		Run:                   cmdutil.DefaultSubCommandRun(ioStreams.ErrOut),
+	}
+
+	cmd.AddCommand(NewCmdCreate(f, ioStreams))
+	cmd.AddCommand(NewCmdList(f, ioStreams))
+	cmd.AddCommand(NewCmdDelete(f, ioStreams))
+
+	return cmd
+}
+
+// setHeader set headers for rolebinding commands.
+func setHeader(table *tablewriter.Table) *tablewriter.Table {
+	table.SetHeader([]string{"Role", "Username", "UserID", "Domain"})
+	table.SetHeaderColor(tablewriter.Colors{tablewriter.FgGreenColor},
+		tablewriter.Colors{tablewriter.FgCyanColor},
+		tablewriter.Colors{tablewriter.FgWhiteColor},
+		tablewriter.Colors{tablewriter.FgMagentaColor})
+
+	return table
+}
@@ -0,0 +1,112 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package rolebinding
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	cmdutil "github.com/superproj/onex/internal/onexctl/cmd/util"
+	"github.com/superproj/onex/internal/onexctl/util/templates"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+	"github.com/superproj/onex/pkg/cli/genericclioptions"
+)
+
+const (
+	createUsageStr = "create ROLE_NAME USERNAME"
+)
+
+// CreateOptions is an options struct to support create subcommands.
+type CreateOptions struct {
+	Domain string
+
+	CreateRoleBindingRequest *v1.CreateRoleBindingRequest
+	client                   v1.UserCenterHTTPClient
+
+	genericclioptions.IOStreams
+}
+
+var (
+	createExample = templates.Examples(`
+		# Bind role operator to user colin in all the domains
+		onexctl rolebinding create operator colin
+
+		# Bind role operator to user colin in domain tenant-a only
+		onexctl rolebinding create operator colin --domain=tenant-a`)
+
+	createUsageErrStr = fmt.Sprintf(
+		"expected '%s'.\nROLE_NAME and USERNAME are required arguments for the create command",
+		createUsageStr,
+	)
+)
+
+// NewCreateOptions returns an initialized CreateOptions instance.
+func NewCreateOptions(ioStreams genericclioptions.IOStreams) *CreateOptions {
+	return &CreateOptions{
+		IOStreams: ioStreams,
+	}
+}
+
+// NewCmdCreate returns new initialized instance of create sub command.
+func NewCmdCreate(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	o := NewCreateOptions(ioStreams)
+
+	cmd := &cobra.Command{
+		Use:                   createUsageStr,
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{},
+		Short:                 "Bind a role to a user",
+		TraverseChildren:      true,
+		Long:                  "Bind a role to a user.",
+		Example:               createExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(f, cmd, args))
+			cmdutil.CheckErr(o.Validate(cmd, args))
+			cmdutil.CheckErr(o.Run(f, args))
+		},
+		SuggestFor: []string{},
+	}
+
+	cmd.Flags().StringVar(&o.Domain, "domain", o.Domain, "The domain (tenant) the binding applies to, all the domains if empty.")
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *CreateOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args []string) error {
+	if len(args) < 2 {
+		return cmdutil.UsageErrorf(cmd, createUsageErrStr)
+	}
+
+	o.CreateRoleBindingRequest = &v1.CreateRoleBindingRequest{
+		Role:     args[0],
+		Username: args[1],
+		Domain:   o.Domain,
+	}
+
+	o.client = f.UserCenterClient()
+	return nil
+}
+
+// Validate makes sure there is no discrepency in command options.
+func (o *CreateOptions) Validate(cmd *cobra.Command, args []string) error {
+	return o.CreateRoleBindingRequest.Validate()
+}
+
+// Run executes a create subcommand using the specified options.
+func (o *CreateOptions) Run(f cmdutil.Factory, args []string) error {
+	_, err := o.client.CreateRoleBinding(context.Background(), o.CreateRoleBindingRequest)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "rolebinding/%s:%s created\n", o.CreateRoleBindingRequest.Role, o.CreateRoleBindingRequest.Username)
+
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package rolebinding
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	cmdutil "github.com/superproj/onex/internal/onexctl/cmd/util"
+	"github.com/superproj/onex/internal/onexctl/util/templates"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+	"github.com/superproj/onex/pkg/cli/genericclioptions"
+)
+
+const (
+	deleteUsageStr = "delete ROLE_NAME USERNAME"
+)
+
+// DeleteOptions is an options struct to support delete subcommands.
+type DeleteOptions struct {
+	Domain string
+
+	DeleteRoleBindingRequest *v1.DeleteRoleBindingRequest
+	client                   v1.UserCenterHTTPClient
+
+	genericclioptions.IOStreams
+}
+
+var (
+	deleteExample = templates.Examples(`
+		# Unbind role operator from user colin
+		onexctl rolebinding delete operator colin`)
+
+	deleteUsageErrStr = fmt.Sprintf(
+		"expected '%s'.\nROLE_NAME and USERNAME are required arguments for the delete command",
+		deleteUsageStr,
+	)
+)
+
+// NewDeleteOptions returns an initialized DeleteOptions instance.
+func NewDeleteOptions(ioStreams genericclioptions.IOStreams) *DeleteOptions {
+	return &DeleteOptions{
+		IOStreams: ioStreams,
+	}
+}
+
+// NewCmdDelete returns new initialized instance of delete sub command.
+func NewCmdDelete(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	o := NewDeleteOptions(ioStreams)
+
+	cmd := &cobra.Command{
+		Use:                   deleteUsageStr,
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{},
+		Short:                 "Unbind a role from a user",
+		TraverseChildren:      true,
+		Long:                  "Unbind a role from a user.",
+		Example:               deleteExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(f, cmd, args))
+			cmdutil.CheckErr(o.Validate(cmd, args))
+			cmdutil.CheckErr(o.Run(f))
+		},
+		SuggestFor: []string{},
+	}
+
+	cmd.Flags().StringVar(&o.Domain, "domain", o.Domain, "The domain (tenant) of the binding, all the domains if empty.")
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *DeleteOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args []string) error {
+	if len(args) < 2 {
+		return cmdutil.UsageErrorf(cmd, deleteUsageErrStr)
+	}
+
+	o.DeleteRoleBindingRequest = &v1.DeleteRoleBindingRequest{
+		Role:     args[0],
+		Username: args[1],
+		Domain:   o.Domain,
+	}
+
+	o.client = f.UserCenterClient()
+
+	return nil
+}
+
+// Validate makes sure there is no discrepency in command options.
+func (o *DeleteOptions) Validate(cmd *cobra.Command, args []string) error {
+	return o.DeleteRoleBindingRequest.Validate()
+}
+
+// Run executes a delete subcommand using the specified options.
+func (o *DeleteOptions) Run(f cmdutil.Factory) error {
+	_, err := o.client.DeleteRoleBinding(context.Background(), o.DeleteRoleBindingRequest)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "rolebinding/%s:%s deleted\n", o.DeleteRoleBindingRequest.Role, o.DeleteRoleBindingRequest.Username)
+
+	return nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package rolebinding
+
+import (
+	"context"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	cmdutil "github.com/superproj/onex/internal/onexctl/cmd/util"
+	"github.com/superproj/onex/internal/onexctl/util/templates"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+	"github.com/superproj/onex/pkg/cli/genericclioptions"
+)
+
+// ListOptions is an options struct to support list subcommands.
+type ListOptions struct {
+	Role     string
+	Username string
+	Domain   string
+
+	ListRoleBindingRequest *v1.ListRoleBindingRequest
+	client                 v1.UserCenterHTTPClient
+	genericclioptions.IOStreams
+}
+
+var listExample = templates.Examples(`
+		# List all role bindings
+		onexctl rolebinding list
+
+		# List the users bound to role operator
+		onexctl rolebinding list --role=operator
+
+		# List the roles bound to user colin
+		onexctl rolebinding list --username=colin`)
+
+// NewListOptions returns an initialized ListOptions instance.
+func NewListOptions(ioStreams genericclioptions.IOStreams) *ListOptions {
+	return &ListOptions{
+		IOStreams: ioStreams,
+	}
+}
+
+// NewCmdList returns new initialized instance of list sub command.
+func NewCmdList(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	o := NewListOptions(ioStreams)
+
+	cmd := &cobra.Command{
+		Use:                   "list",
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{},
+		Short:                 "Display role bindings",
+		TraverseChildren:      true,
+		Long:                  "Display role bindings.",
+		Example:               listExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(f, cmd, args))
+			cmdutil.CheckErr(o.Validate(cmd, args))
+			cmdutil.CheckErr(o.Run(f, args))
+		},
+		SuggestFor: []string{},
+	}
+
+	cmd.Flags().StringVar(&o.Role, "role", o.Role, "Only display the bindings of the role.")
+	cmd.Flags().StringVar(&o.Username, "username", o.Username, "Only display the bindings of the user.")
+	cmd.Flags().StringVar(&o.Domain, "domain", o.Domain, "Only display the bindings in the domain.")
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *ListOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args []string) error {
+	o.ListRoleBindingRequest = &v1.ListRoleBindingRequest{
+		Role:     o.Role,
+		Username: o.Username,
+		Domain:   o.Domain,
+	}
+	o.client = f.UserCenterClient()
+
+	return nil
+}
+
+// Validate makes sure there is no discrepency in command options.
+func (o *ListOptions) Validate(cmd *cobra.Command, args []string) error {
+	return o.ListRoleBindingRequest.Validate()
+}
+
+// Run executes a list subcommand using the specified options.
+func (o *ListOptions) Run(f cmdutil.Factory, args []string) error {
+	bindings, err := o.client.ListRoleBinding(context.Background(), o.ListRoleBindingRequest)
+	if err != nil {
+		return err
+	}
+
+	data := make([][]string, 0, len(bindings.RoleBindings))
+	for _, binding := range bindings.RoleBindings {
+		data = append(data, []string{binding.Role, binding.Username, binding.UserID, binding.Domain})
+	}
+
+	table := tablewriter.NewWriter(o.Out)
+	table = setHeader(table)
+	table = cmdutil.TableWriterDefaultConfig(table)
+	table.AppendBulk(data)
+	table.Render()
+
+	return nil
+}
@@ -19,7 +19,7 @@ import (
 var userLong = templates.LongDesc(`
 	User management commands.
 
-Administrator can use all subcommands, non-administrator only allow to use create/get/upate. When call get/update non-administrator 
+Administrator can use all subcommands, non-administrator only allow to use create/get/upate/sessions. When call get/update/sessions non-administrator 
 only allow to operate their own resources, if permission not allowed, will return an 'Permission denied' error.`)
 
 // NewCmdUser returns new initialized instance of 'user' sub command.
@@ -37,6 +37,7 @@ func NewCmdUser(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra
 	cmd.AddCommand(NewCmdGet(f, ioStreams))
 	cmd.AddCommand(NewCmdDelete(f, ioStreams))
 	cmd.AddCommand(NewCmdUpdate(f, ioStreams))
+	cmd.AddCommand(NewCmdSessions(f, ioStreams))
 
 	return cmd
 }
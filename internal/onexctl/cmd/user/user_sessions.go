@@ -0,0 +1,165 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	cmdutil "github.com/superproj/onex/internal/onexctl/cmd/util"
+	"github.com/superproj/onex/internal/onexctl/util/templates"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+	"github.com/superproj/onex/pkg/cli/genericclioptions"
+)
+
+const (
+	sessionsUsageStr = "sessions [USERNAME]"
+)
+
+// SessionsOptions is an options struct to support sessions subcommands.
+type SessionsOptions struct {
+	Username    string
+	Revoke      string
+	RevokeAll   bool
+	KeepCurrent bool
+
+	client v1.UserCenterHTTPClient
+	genericclioptions.IOStreams
+}
+
+var sessionsExample = templates.Examples(`
+		# List the login sessions of the current user
+		onexctl user sessions
+
+		# List the login sessions of user foo (Administrator rights required)
+		onexctl user sessions foo
+
+		# Revoke a login session of the current user
+		onexctl user sessions --revoke=4d4f1c9e-3a5b-4f0e-9a67-2f7c0f1d8e21
+
+		# Sign out all the other devices of the current user
+		onexctl user sessions --revoke-all --keep-current`)
+
+// NewSessionsOptions returns an initialized SessionsOptions instance.
+func NewSessionsOptions(ioStreams genericclioptions.IOStreams) *SessionsOptions {
+	return &SessionsOptions{
+		IOStreams: ioStreams,
+	}
+}
+
+// NewCmdSessions returns new initialized instance of sessions sub command.
+func NewCmdSessions(f cmdutil.Factory, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	o := NewSessionsOptions(ioStreams)
+
+	cmd := &cobra.Command{
+		Use:                   sessionsUsageStr,
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{"session"},
+		Short:                 "List or revoke the login sessions of a user",
+		TraverseChildren:      true,
+		Long: `List or revoke the login sessions of a user. Revoking a session invalidates both its refresh
+token and access token. Non-administrator can only operate their own sessions.`,
+		Example: sessionsExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(f, cmd, args))
+			cmdutil.CheckErr(o.Validate(cmd, args))
+			cmdutil.CheckErr(o.Run(f, args))
+		},
+		SuggestFor: []string{},
+	}
+
+	cmd.Flags().StringVar(&o.Revoke, "revoke", o.Revoke, "Revoke the login session with the given ID.")
+	cmd.Flags().BoolVar(&o.RevokeAll, "revoke-all", o.RevokeAll, "Revoke all the login sessions.")
+	cmd.Flags().BoolVar(&o.KeepCurrent, "keep-current", o.KeepCurrent, "Keep the current session when --revoke-all is specified.")
+
+	return cmd
+}
+
+// Complete completes all the required options.
+func (o *SessionsOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args []string) error {
+	o.Username = f.GetOptions().UserOptions.Username
+	if len(args) != 0 {
+		o.Username = args[0]
+	}
+
+	o.client = f.UserCenterClient()
+	return nil
+}
+
+// Validate makes sure there is no discrepency in command options.
+func (o *SessionsOptions) Validate(cmd *cobra.Command, args []string) error {
+	if len(args) > 1 {
+		return cmdutil.UsageErrorf(cmd, "expected '%s'", sessionsUsageStr)
+	}
+
+	if o.Revoke != "" && o.RevokeAll {
+		return cmdutil.UsageErrorf(cmd, "--revoke and --revoke-all can not be specified together")
+	}
+
+	if o.KeepCurrent && !o.RevokeAll {
+		return cmdutil.UsageErrorf(cmd, "--keep-current can only be specified with --revoke-all")
+	}
+
+	return nil
+}
+
+// Run executes a sessions subcommand using the specified options.
+func (o *SessionsOptions) Run(f cmdutil.Factory, args []string) error {
+	switch {
+	case o.Revoke != "":
+		rq := &v1.RevokeSessionRequest{Username: o.Username, SessionID: o.Revoke}
+		if _, err := o.client.RevokeSession(context.Background(), rq); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(o.Out, "session/%s revoked\n", o.Revoke)
+		return nil
+	case o.RevokeAll:
+		rq := &v1.RevokeSessionsRequest{Username: o.Username, KeepCurrent: o.KeepCurrent}
+		if _, err := o.client.RevokeSessions(context.Background(), rq); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(o.Out, "sessions of user/%s revoked\n", o.Username)
+		return nil
+	}
+
+	sessions, err := o.client.ListSession(context.Background(), &v1.ListSessionRequest{Username: o.Username})
+	if err != nil {
+		return err
+	}
+
+	data := make([][]string, 0, len(sessions.Sessions))
+	for _, session := range sessions.Sessions {
+		id := session.SessionID
+		if session.Current {
+			id += " (current)"
+		}
+
+		data = append(data, []string{
+			id,
+			session.Device,
+			session.Ip,
+			session.UserAgent,
+			session.CreatedAt.AsTime().Format(time.DateTime),
+			session.IssuedAt.AsTime().Format(time.DateTime),
+			session.ExpiresAt.AsTime().Format(time.DateTime),
+		})
+	}
+
+	table := tablewriter.NewWriter(o.Out)
+	table.SetHeader([]string{"Session", "Device", "IP", "UserAgent", "Created", "Issued", "Expires"})
+	table = cmdutil.TableWriterDefaultConfig(table)
+	table.AppendBulk(data)
+	table.Render()
+
+	return nil
+}
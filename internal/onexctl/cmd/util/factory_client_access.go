@@ -10,6 +10,8 @@ package util
 
 import (
 	"context"
+	"fmt"
+	"os"
 
 	"github.com/go-kratos/kratos/v2/middleware"
 	transhttp "github.com/go-kratos/kratos/v2/transport/http"
@@ -17,10 +19,12 @@ import (
 	"k8s.io/klog/v2"
 
 	clioptions "github.com/superproj/onex/internal/onexctl/util/options"
+	"github.com/superproj/onex/internal/pkg/middleware/authn/hmac"
 	"github.com/superproj/onex/internal/pkg/middleware/authn/jwt"
 	kubeutil "github.com/superproj/onex/internal/pkg/util/kube"
 	gatewayv1 "github.com/superproj/onex/pkg/api/gateway/v1"
 	usercenterv1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+	"github.com/superproj/onex/pkg/authn/totp"
 )
 
 type factoryImpl struct {
@@ -47,6 +51,13 @@ func (f *factoryImpl) UserCenterClient() usercenterv1.UserCenterHTTPClient {
 }
 
 func (f *factoryImpl) GatewayClient() gatewayv1.GatewayHTTPClient {
+	// Machine clients sign each request with the secret instead of sending a bearer token.
+	opts := f.opts.UserOptions
+	if opts.BearerToken == "" && opts.SecretID != "" && opts.SecretKey != "" {
+		conn := newConnect(f.opts.GatewayOptions, hmac.WithSecret(opts.SecretID, opts.SecretKey))
+		return gatewayv1.NewGatewayHTTPClient(conn)
+	}
+
 	conn := newConnect(f.opts.GatewayOptions, jwt.WithToken(f.MustToken()))
 	return gatewayv1.NewGatewayHTTPClient(conn)
 }
@@ -89,14 +100,36 @@ func (f *factoryImpl) WithToken(ctx context.Context) (context.Context, error) {
 
 func (f *factoryImpl) Login() (token string, err error) {
 	client := usercenterv1.NewUserCenterHTTPClient(newConnect(f.opts.UserCenterOptions))
+	// The hostname tells the sessions of this machine from the others in `onexctl user sessions`.
+	device, _ := os.Hostname()
 	rp, err := client.Login(context.Background(), &usercenterv1.LoginRequest{
 		Username: f.opts.UserOptions.Username,
 		Password: f.opts.UserOptions.Password,
+		Device:   device,
 	})
 	if err != nil {
 		return "", err
 	}
 
+	// Complete the second login step if multi-factor authentication is enabled.
+	if rp.MfaToken != "" {
+		if f.opts.UserOptions.OTP == "" {
+			if rp.TotpSecret != "" {
+				return "", fmt.Errorf("multi-factor authentication is required, add the secret %s to "+
+					"your authenticator app and login again with --user.otp", rp.TotpSecret)
+			}
+			return "", fmt.Errorf("multi-factor authentication is enabled, please specify --user.otp")
+		}
+
+		rq := &usercenterv1.LoginMFARequest{MfaToken: rp.MfaToken, Code: f.opts.UserOptions.OTP, Device: device}
+		if len(rq.Code) != totp.Digits {
+			rq.Code, rq.RecoveryCode = "", f.opts.UserOptions.OTP
+		}
+		if rp, err = client.LoginMFA(context.Background(), rq); err != nil {
+			return "", err
+		}
+	}
+
 	klog.V(4).Infof("Get login token: %s", rp.AccessToken)
 	return rp.AccessToken, nil
 }
@@ -17,6 +17,7 @@ type UserOptions struct {
 	BearerToken string `json:"token" mapstructure:"token"`
 	Username    string `json:"username" mapstructure:"username"`
 	Password    string `json:"password" mapstructure:"password"`
+	OTP         string `json:"otp" mapstructure:"otp"`
 	SecretID    string `json:"secret-id" mapstructure:"secret-id"`
 	SecretKey   string `json:"secret-key" mapstructure:"secret-key"`
 	CertFile    string `json:"client-certificate" mapstructure:"client-certificate"`
@@ -48,8 +49,9 @@ func (o *UserOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.BearerToken, "user.token", o.BearerToken, "Bearer token for authentication to the API server")
 	fs.StringVar(&o.Username, "user.username", o.Username, "Username for basic authentication to the API server")
 	fs.StringVar(&o.Password, "user.password", o.Password, "Password for basic authentication to the API server")
-	fs.StringVar(&o.SecretID, "user.secret-id", o.SecretID, "SecretID for JWT authentication to the API server")
-	fs.StringVar(&o.SecretKey, "user.secret-key", o.SecretKey, "SecretKey for jwt authentication to the API server")
+	fs.StringVar(&o.OTP, "user.otp", o.OTP, "One-time passcode or recovery code used when multi-factor authentication is enabled")
+	fs.StringVar(&o.SecretID, "user.secret-id", o.SecretID, "SecretID used to sign the requests to the gateway, and the JWT tokens to the other API servers")
+	fs.StringVar(&o.SecretKey, "user.secret-key", o.SecretKey, "SecretKey used to sign the requests to the gateway, and the JWT tokens to the other API servers")
 	fs.StringVar(&o.CertFile, "user.client-certificate", o.CertFile, "Path to a client certificate file for TLS")
 	fs.StringVar(&o.KeyFile, "user.client-key", o.KeyFile, "Path to a client key file for TLS")
 }
@@ -0,0 +1,119 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/google/wire"
+	"gorm.io/gorm"
+
+	"github.com/superproj/onex/pkg/log"
+)
+
+// ProviderSet is audit providers.
+var ProviderSet = wire.NewSet(NewAuditor)
+
+// TableNameAuditEvent is the table of the audit events written by the mysql sink.
+const TableNameAuditEvent = "audit_event"
+
+// Event is a mutating operation, who did it, on which resource, and its result.
+type Event struct {
+	ID      int64     `gorm:"column:id;primaryKey;autoIncrement:true" json:"-"`
+	EventID string    `gorm:"column:event_id;not null" json:"eventID"`
+	Time    time.Time `gorm:"column:time;not null" json:"time"`
+	// UserID is the user calling the operation, it is empty for the anonymous calls, e.g. the registrations.
+	UserID string `gorm:"column:user_id;not null" json:"userID"`
+	// SecretID is the secret signing the request, if the request is signed.
+	SecretID  string `gorm:"column:secret_id;not null" json:"secretID,omitempty"`
+	Operation string `gorm:"column:operation;not null" json:"operation"`
+	// Resource is the kind of the resource, e.g. `MinerSet`, and Name is its name.
+	Resource string `gorm:"column:resource;not null" json:"resource"`
+	Name     string `gorm:"column:name;not null" json:"name,omitempty"`
+	// Request is the request in json, whose sensitive fields, e.g. the passwords, are redacted.
+	Request string `gorm:"column:request" json:"request,omitempty"`
+	// Diff is the json merge patch from the resource before the operation to the resource after it.
+	Diff string `gorm:"column:diff" json:"diff,omitempty"`
+	// Code is the http status code of the result, Reason and Message describe the error if it failed.
+	Code    int32  `gorm:"column:code;not null" json:"code"`
+	Reason  string `gorm:"column:reason;not null" json:"reason,omitempty"`
+	Message string `gorm:"column:message;not null" json:"message,omitempty"`
+	TraceID string `gorm:"column:trace_id;not null" json:"traceID,omitempty"`
+}
+
+// TableName Event's table name.
+func (*Event) TableName() string {
+	return TableNameAuditEvent
+}
+
+// Sink writes the audit events.
+type Sink interface {
+	Write(ctx context.Context, e *Event) error
+}
+
+// Auditor records the audit events to a sink.
+type Auditor struct {
+	sink    Sink
+	timeout time.Duration
+}
+
+// NewAuditor creates an auditor writing to the sink configured by opts. The mysql sink
+// writes to db. It does nothing if the audit is disabled.
+func NewAuditor(opts *AuditOptions, db *gorm.DB) (*Auditor, func(), error) {
+	if !opts.Enabled {
+		return &Auditor{}, func() {}, nil
+	}
+
+	var (
+		sink    Sink
+		cleanup = func() {}
+	)
+	switch opts.Sink {
+	case SinkFile:
+		fs, err := newFileSink(opts.File)
+		if err != nil {
+			return nil, nil, err
+		}
+		sink, cleanup = fs, func() { _ = fs.Close() }
+	case SinkKafka:
+		ks, err := newKafkaSink(opts.Kafka, opts.QueueSize, opts.Timeout)
+		if err != nil {
+			return nil, nil, err
+		}
+		sink, cleanup = ks, ks.Close
+	default:
+		sink = &dbSink{db: db}
+	}
+
+	log.Infow("Initialize auditor success", "sink", opts.Sink)
+	return &Auditor{sink: sink, timeout: opts.Timeout}, cleanup, nil
+}
+
+// Record writes the audit event. The failures are logged, they don't fail the operation,
+// which has been done anyway.
+func (a *Auditor) Record(ctx context.Context, e *Event) {
+	if a == nil || a.sink == nil {
+		return
+	}
+
+	if e.EventID == "" {
+		e.EventID = uuid.NewString()
+	}
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+
+	// The event is recorded even if the request has been canceled, but a slow sink
+	// does not hold the request for longer than the timeout.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), a.timeout)
+	defer cancel()
+	if err := a.sink.Write(ctx, e); err != nil {
+		log.C(ctx).Errorw(err, "Failed to record audit event", "operation", e.Operation, "event", e.EventID)
+	}
+}
@@ -0,0 +1,9 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+// Package audit records who changed what through the APIs of onex, and writes the
+// audit events to a sink, i.e. the `audit_event` table of mysql, a file or kafka.
+package audit // import "github.com/superproj/onex/internal/pkg/audit"
@@ -0,0 +1,92 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package audit
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	genericoptions "github.com/superproj/onex/pkg/options"
+)
+
+// The sinks the audit events can be written to.
+const (
+	SinkMySQL = "mysql"
+	SinkFile  = "file"
+	SinkKafka = "kafka"
+)
+
+// AuditOptions defines options for the audit events.
+type AuditOptions struct {
+	Enabled bool `json:"enabled" mapstructure:"enabled"`
+	// Sink is one of `mysql`, `file` and `kafka`.
+	Sink string `json:"sink" mapstructure:"sink"`
+	// File is the file the events are appended to as json lines by the `file` sink.
+	File string `json:"file" mapstructure:"file"`
+	// Kafka configures the `kafka` sink. It can only be set in the config file.
+	Kafka *genericoptions.KafkaOptions `json:"kafka" mapstructure:"kafka"`
+	// QueueSize is the number of events the `kafka` sink buffers, the events are dropped when it is full.
+	QueueSize int `json:"queue-size" mapstructure:"queue-size"`
+	// Timeout is the maximum time to write an event, independently of the cancellation of the request.
+	Timeout time.Duration `json:"timeout" mapstructure:"timeout"`
+}
+
+// NewAuditOptions returns initialized AuditOptions.
+func NewAuditOptions() *AuditOptions {
+	return &AuditOptions{
+		Enabled:   true,
+		Sink:      SinkMySQL,
+		File:      "/var/log/onex/audit.log",
+		Kafka:     genericoptions.NewKafkaOptions(),
+		QueueSize: 1024,
+		Timeout:   5 * time.Second,
+	}
+}
+
+// Validate verifies flags passed to AuditOptions.
+func (o *AuditOptions) Validate() []error {
+	errs := []error{}
+
+	if !o.Enabled {
+		return errs
+	}
+
+	switch o.Sink {
+	case SinkMySQL:
+	case SinkFile:
+		if o.File == "" {
+			errs = append(errs, fmt.Errorf("--audit.file is required by the file sink"))
+		}
+	case SinkKafka:
+		if len(o.Kafka.Brokers) == 0 || o.Kafka.Topic == "" {
+			errs = append(errs, fmt.Errorf("audit.kafka.brokers and audit.kafka.topic are required by the kafka sink"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("--audit.sink must be one of %s, %s and %s", SinkMySQL, SinkFile, SinkKafka))
+	}
+
+	if o.QueueSize <= 0 {
+		errs = append(errs, fmt.Errorf("--audit.queue-size must be greater than 0"))
+	}
+	if o.Timeout <= 0 {
+		errs = append(errs, fmt.Errorf("--audit.timeout must be greater than 0"))
+	}
+
+	return errs
+}
+
+// AddFlags adds flags related to audit to the specified FlagSet.
+func (o *AuditOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.Enabled, "audit.enabled", o.Enabled, "Record the audit events of the mutating operations.")
+	fs.StringVar(&o.Sink, "audit.sink", o.Sink, "Sink of the audit events, one of mysql, file and kafka.")
+	fs.StringVar(&o.File, "audit.file", o.File, "File the audit events are appended to by the file sink.")
+	fs.IntVar(&o.QueueSize, "audit.queue-size", o.QueueSize, "Number of audit events buffered by the kafka sink, "+
+		"the events are dropped when the queue is full.")
+	fs.DurationVar(&o.Timeout, "audit.timeout", o.Timeout, "Maximum time to write an audit event.")
+}
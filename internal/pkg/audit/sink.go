@@ -0,0 +1,143 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/superproj/onex/pkg/log"
+	genericoptions "github.com/superproj/onex/pkg/options"
+	"github.com/superproj/onex/pkg/streams/connector/kafka"
+)
+
+// ErrQueueFull is returned when an event is dropped because the queue of the sink is full.
+var ErrQueueFull = errors.New("audit queue is full")
+
+// dbSink inserts the events into the `audit_event` table.
+type dbSink struct {
+	db *gorm.DB
+}
+
+func (s *dbSink) Write(ctx context.Context, e *Event) error {
+	return s.db.WithContext(ctx).Create(e).Error
+}
+
+// fileSink appends the events to a file as json lines.
+type fileSink struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+func newFileSink(name string) (*fileSink, error) {
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileSink{f: f}, nil
+}
+
+func (s *fileSink) Write(ctx context.Context, e *Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.f.Write(append(data, '\n'))
+	return err
+}
+
+func (s *fileSink) Close() error {
+	return s.f.Close()
+}
+
+// kafkaSink sends the events in json to the kafka sink connector of `pkg/streams`.
+// The events are buffered in a queue sent to the connector in the background, so that
+// the requests are not held by a slow or unavailable kafka, the events are dropped when
+// the queue is full or when the connector does not accept them in time.
+type kafkaSink struct {
+	ks      *kafka.KafkaSink
+	queue   chan []byte
+	timeout time.Duration
+	// abort drops the queued events which are not sent in time when the sink is closed.
+	abort chan struct{}
+	done  chan struct{}
+}
+
+func newKafkaSink(opts *genericoptions.KafkaOptions, size int, timeout time.Duration) (*kafkaSink, error) {
+	config, err := opts.WriterConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	ks, err := kafka.NewKafkaSink(context.Background(), config)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &kafkaSink{ks: ks, queue: make(chan []byte, size), timeout: timeout, abort: make(chan struct{}), done: make(chan struct{})}
+	go s.run()
+	return s, nil
+}
+
+func (s *kafkaSink) Write(ctx context.Context, e *Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case s.queue <- data:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// run sends the queued events to the connector until the queue is closed.
+func (s *kafkaSink) run() {
+	defer close(s.done)
+
+	for data := range s.queue {
+		timer := time.NewTimer(s.timeout)
+		select {
+		case s.ks.In() <- data:
+		case <-timer.C:
+			log.Warnw("Dropped audit event, kafka is not accepting events", "timeout", s.timeout)
+		case <-s.abort:
+			log.Warnw("Dropped audit event, the sink is closed")
+		}
+		timer.Stop()
+	}
+}
+
+// Close sends the queued events, then stops the connector, which closes the kafka writer.
+// The events which are not sent within the timeout are dropped.
+func (s *kafkaSink) Close() {
+	close(s.queue)
+	select {
+	case <-s.done:
+	case <-time.After(s.timeout):
+		close(s.abort)
+		<-s.done
+	}
+	close(s.ks.In())
+}
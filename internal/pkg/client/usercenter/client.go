@@ -16,13 +16,19 @@ import (
 
 	"github.com/superproj/onex/internal/pkg/client"
 	"github.com/superproj/onex/internal/pkg/middleware/auth"
+	"github.com/superproj/onex/internal/pkg/middleware/authn/hmac"
 	"github.com/superproj/onex/internal/pkg/middleware/tracing"
 	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
 	genericoptions "github.com/superproj/onex/pkg/options"
 )
 
 // ProviderSet is the usercenter providers.
-var ProviderSet = wire.NewSet(NewUserCenter, wire.Bind(new(Interface), new(*impl)), wire.Bind(new(auth.AuthProvider), new(*impl)))
+var ProviderSet = wire.NewSet(
+	NewUserCenter,
+	wire.Bind(new(Interface), new(*impl)),
+	wire.Bind(new(auth.AuthProvider), new(*impl)),
+	wire.Bind(new(hmac.Verifier), new(*impl)),
+)
 
 var (
 	once sync.Once
@@ -31,7 +37,9 @@ var (
 
 // Interface is an interface that presents a subset of the usercenter API.
 type Interface interface {
-	Auth(ctx context.Context, token string, obj, act string) (string, bool, error)
+	Auth(ctx context.Context, token string, dom, obj, act string) (string, bool, error)
+	Authorize(ctx context.Context, sub, dom, obj, act string) (bool, error)
+	VerifySignature(ctx context.Context, secretID, stringToSign, signature string) (string, error)
 }
 
 // impl is an implementation of Interface.
@@ -87,8 +95,8 @@ func GetClient() *impl {
 }
 
 // Auth implements the Interface interface.
-func (i *impl) Auth(ctx context.Context, token string, obj, act string) (userID string, allowed bool, err error) {
-	rq := &v1.AuthRequest{Token: token, Obj: obj, Act: act}
+func (i *impl) Auth(ctx context.Context, token string, dom, obj, act string) (userID string, allowed bool, err error) {
+	rq := &v1.AuthRequest{Token: token, Dom: dom, Obj: obj, Act: act}
 	resp, err := i.client.Auth(ctx, rq)
 	if err != nil {
 		return "", false, err
@@ -96,3 +104,25 @@ func (i *impl) Auth(ctx context.Context, token string, obj, act string) (userID
 
 	return resp.UserID, resp.Allowed, nil
 }
+
+// Authorize implements the Interface interface.
+func (i *impl) Authorize(ctx context.Context, sub, dom, obj, act string) (bool, error) {
+	rq := &v1.AuthorizeRequest{Sub: sub, Dom: dom, Obj: obj, Act: act}
+	resp, err := i.client.Authorize(ctx, rq)
+	if err != nil {
+		return false, err
+	}
+
+	return resp.Allowed, nil
+}
+
+// VerifySignature implements the Interface interface.
+func (i *impl) VerifySignature(ctx context.Context, secretID, stringToSign, signature string) (string, error) {
+	rq := &v1.VerifySignatureRequest{SecretID: secretID, StringToSign: stringToSign, Signature: signature}
+	resp, err := i.client.VerifySignature(ctx, rq)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.UserID, nil
+}
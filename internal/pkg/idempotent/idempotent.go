@@ -30,6 +30,18 @@ func (idt Idempotent) Check(ctx context.Context, token string) bool {
 	return idt.idempotent.Check(ctx, token)
 }
 
+func (idt Idempotent) Acquire(ctx context.Context, key string, fingerprint string) (*idempotent.Response, string, error) {
+	return idt.idempotent.Acquire(ctx, key, fingerprint)
+}
+
+func (idt Idempotent) Complete(ctx context.Context, key string, token string, fingerprint string, resp *idempotent.Response) error {
+	return idt.idempotent.Complete(ctx, key, token, fingerprint, resp)
+}
+
+func (idt Idempotent) Release(ctx context.Context, key string, token string) error {
+	return idt.idempotent.Release(ctx, key, token)
+}
+
 // NewIdempotent is initialize idempotent from config.
 func NewIdempotent(redis redis.UniversalClient) (idt *Idempotent, err error) {
 	ins := idempotent.New(idempotent.WithRedis(redis))
@@ -14,6 +14,10 @@ const (
 	AccessTokenExpire = time.Hour * 2
 	// RefreshTokenExpire is the expiration time for the refresh token.
 	RefreshTokenExpire = time.Hour * 24
+	// MFATokenExpire is the expiration time for the token exchanged by the second login step.
+	MFATokenExpire = time.Minute * 5
+	// RecoveryCodeCount is the number of recovery codes generated for a user with TOTP enabled.
+	RecoveryCodeCount = 10
 )
 
 const (
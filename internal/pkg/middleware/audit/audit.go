@@ -0,0 +1,244 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/superproj/onex/internal/pkg/audit"
+	"github.com/superproj/onex/internal/pkg/onexx"
+	"github.com/superproj/onex/pkg/log"
+)
+
+// redacted replaces the values of the sensitive fields of the requests.
+const redacted = "******"
+
+// verbs are the prefixes of the mutating operations. The rest of the method is the resource.
+var verbs = []string{"Create", "Update", "Delete", "Scale", "Revoke", "Enroll", "Activate", "Disable", "Regenerate", "Link"}
+
+// SnapshotFunc returns the resource named name of the caller, which is diffed before and
+// after the updates.
+type SnapshotFunc func(ctx context.Context, resource, name string) (any, error)
+
+// Option configures the audit middleware.
+type Option func(*options)
+
+type options struct {
+	snapshot SnapshotFunc
+}
+
+// WithSnapshot sets the function taking the snapshots of the updated resources.
+// Without it, the events of the updates have no diff.
+func WithSnapshot(fn SnapshotFunc) Option {
+	return func(o *options) {
+		o.snapshot = fn
+	}
+}
+
+// Server records an audit event for each mutating operation, with its caller, the target
+// resource, the redacted request, the diff of the updated resource, the result and the
+// trace ID. The caller is read from the context, so the middleware must run after the
+// authentication.
+func Server(a *audit.Auditor, opts ...Option) middleware.Middleware {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, rq any) (any, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, rq)
+			}
+
+			verb, resource, ok := parseOperation(tr.Operation())
+			if !ok {
+				return handler(ctx, rq)
+			}
+
+			e := &audit.Event{
+				UserID:    onexx.FromUserID(ctx),
+				SecretID:  onexx.FromSecretID(ctx),
+				Operation: tr.Operation(),
+				Resource:  resource,
+				Name:      nameOf(rq),
+				Request:   requestOf(rq),
+			}
+			if span := trace.SpanContextFromContext(ctx); span.HasTraceID() {
+				e.TraceID = span.TraceID().String()
+			}
+
+			var before any
+			diffed := o.snapshot != nil && (verb == "Update" || verb == "Scale") && e.Name != ""
+			if diffed {
+				before, _ = o.snapshot(ctx, resource, e.Name)
+			}
+
+			rp, err := handler(ctx, rq)
+
+			if err != nil {
+				se := errors.FromError(err)
+				e.Code, e.Reason, e.Message = se.Code, se.Reason, se.Message
+			} else {
+				e.Code = http.StatusOK
+				if diffed && before != nil {
+					if after, serr := o.snapshot(ctx, resource, e.Name); serr == nil {
+						e.Diff = diffOf(before, after)
+					}
+				}
+			}
+
+			a.Record(ctx, e)
+			return rp, err
+		}
+	}
+}
+
+// parseOperation returns the verb and the resource of a mutating operation, e.g.
+// `Update` and `MinerSet` of `/gateway.v1.Gateway/UpdateMinerSet`.
+func parseOperation(operation string) (string, string, bool) {
+	method := operation[strings.LastIndex(operation, "/")+1:]
+	for _, verb := range verbs {
+		if resource, ok := strings.CutPrefix(method, verb); ok && resource != "" {
+			return verb, resource, true
+		}
+	}
+
+	return "", "", false
+}
+
+// nameOf returns the name of the resource targeted by the request.
+func nameOf(rq any) string {
+	switch r := rq.(type) {
+	case metav1.Object:
+		return r.GetName()
+	case interface{ GetName() string }:
+		return r.GetName()
+	case interface{ GetRole() string }:
+		return r.GetRole()
+	case interface{ GetSub() string }:
+		return r.GetSub()
+	case interface{ GetUsername() string }:
+		return r.GetUsername()
+	}
+
+	return ""
+}
+
+// requestOf encodes the request in json, with the sensitive fields redacted.
+func requestOf(rq any) string {
+	var (
+		data []byte
+		err  error
+	)
+	if msg, ok := rq.(proto.Message); ok {
+		data, err = protojson.Marshal(msg)
+	} else {
+		// The kubernetes resources, e.g. v1beta1.MinerSet, are gogo messages.
+		data, err = json.Marshal(rq)
+	}
+	if err != nil {
+		log.Errorw(err, "Failed to encode request for audit")
+		return ""
+	}
+
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return ""
+	}
+	data, _ = json.Marshal(redact(v))
+	return string(data)
+}
+
+// redact replaces the values of the passwords, secrets, tokens and one-time codes.
+func redact(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, val := range t {
+			if sensitive(k) {
+				t[k] = redacted
+				continue
+			}
+			t[k] = redact(val)
+		}
+	case []any:
+		for i, val := range t {
+			t[i] = redact(val)
+		}
+	}
+
+	return v
+}
+
+func sensitive(key string) bool {
+	key = strings.ToLower(key)
+	if key == "code" || strings.HasPrefix(key, "recoverycode") {
+		return true
+	}
+
+	for _, s := range []string{"password", "secret", "token"} {
+		if strings.Contains(key, s) && !strings.HasSuffix(key, "id") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// diffOf returns the json merge patch from before to after, ignoring the fields changed
+// by the server, e.g. the status and the resource version.
+func diffOf(before, after any) string {
+	b, err := prunedJSON(before)
+	if err != nil {
+		return ""
+	}
+	a, err := prunedJSON(after)
+	if err != nil {
+		return ""
+	}
+
+	patch, err := jsonpatch.CreateMergePatch(b, a)
+	if err != nil {
+		log.Errorw(err, "Failed to diff resource for audit")
+		return ""
+	}
+
+	return string(patch)
+}
+
+func prunedJSON(obj any) ([]byte, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var m map[string]any
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	delete(m, "status")
+	if meta, ok := m["metadata"].(map[string]any); ok {
+		for _, k := range []string{"resourceVersion", "managedFields", "generation"} {
+			delete(meta, k)
+		}
+	}
+
+	return json.Marshal(redact(m))
+}
@@ -0,0 +1,52 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	ucv1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+)
+
+func TestParseOperation(t *testing.T) {
+	verb, resource, ok := parseOperation("/gateway.v1.Gateway/ScaleMinerSet")
+	assert.True(t, ok)
+	assert.Equal(t, "Scale", verb)
+	assert.Equal(t, "MinerSet", resource)
+
+	_, _, ok = parseOperation("/gateway.v1.Gateway/ListMinerSet")
+	assert.False(t, ok)
+	_, _, ok = parseOperation("/usercenter.v1.UserCenter/Login")
+	assert.False(t, ok)
+}
+
+func TestRequestOf(t *testing.T) {
+	rq := &ucv1.UpdatePasswordRequest{Username: "colin", OldPassword: "old", NewPassword: "new"}
+	assert.Equal(t, "colin", nameOf(rq))
+	assert.JSONEq(t, `{"username":"colin","oldPassword":"******","newPassword":"******"}`, requestOf(rq))
+
+	ms := &v1beta1.MinerSet{ObjectMeta: metav1.ObjectMeta{Name: "ms-1"}}
+	assert.Equal(t, "ms-1", nameOf(ms))
+}
+
+func TestDiffOf(t *testing.T) {
+	before := &v1beta1.MinerSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "ms-1", ResourceVersion: "1"},
+		Spec:       v1beta1.MinerSetSpec{Replicas: pointer.Int32(1)},
+	}
+	after := before.DeepCopy()
+	after.ResourceVersion = "2"
+	after.Spec.Replicas = pointer.Int32(3)
+	after.Status.Replicas = 3
+
+	assert.JSONEq(t, `{"spec":{"replicas":3}}`, diffOf(before, after))
+}
@@ -9,5 +9,9 @@ package auth
 import "context"
 
 type AuthProvider interface {
-	Auth(ctx context.Context, token string, obj, act string) (userID string, allowed bool, err error)
+	// Auth authenticates the token and checks whether the user is allowed to perform act on obj
+	// in the domain (tenant) dom. An empty dom is the global domain.
+	Auth(ctx context.Context, token string, dom, obj, act string) (userID string, allowed bool, err error)
+	// Authorize checks whether the authenticated user sub is allowed to perform act on obj in the domain dom.
+	Authorize(ctx context.Context, sub, dom, obj, act string) (allowed bool, err error)
 }
@@ -0,0 +1,121 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package auth
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// pathVariable matches the path variables of the http rules, e.g. `{name}` or `{name=*}`.
+var pathVariable = regexp.MustCompile(`{([\w.]+)(=[^}]*)?}`)
+
+// Resource returns the object and the action to authorize the request of an operation,
+// which are the path and the method of the http binding of the operation, so that the
+// policies on routes like /v1/minersets/* apply to both HTTP and gRPC requests. The path
+// variables are filled from the request, escaped so that a value like `a/b` stays a single
+// segment and still matches the route patterns. It returns false if the operation has no http binding.
+func Resource(operation string, rq any) (string, string, bool) {
+	method, template, ok := httpRule(operation)
+	if !ok {
+		return "", "", false
+	}
+
+	msg, _ := rq.(proto.Message)
+	path := pathVariable.ReplaceAllStringFunc(template, func(v string) string {
+		return url.PathEscape(fieldValue(msg, pathVariable.FindStringSubmatch(v)[1]))
+	})
+
+	return path, method, true
+}
+
+// Pattern returns the route pattern and the method of the http binding of an operation,
+// whose path variables are replaced by the named parameters of keyMatch2, e.g.
+// /gateway.v1.Gateway/DeleteMinerSet => /v1/minersets/:name, DELETE.
+func Pattern(operation string) (string, string, bool) {
+	method, template, ok := httpRule(operation)
+	if !ok {
+		return "", "", false
+	}
+
+	path := pathVariable.ReplaceAllStringFunc(template, func(v string) string {
+		name := pathVariable.FindStringSubmatch(v)[1]
+		return ":" + name[strings.LastIndex(name, ".")+1:]
+	})
+
+	return path, method, true
+}
+
+// httpRule returns the method and the path template of the http binding of an
+// operation, e.g. /gateway.v1.Gateway/GetMinerSet => GET, /v1/minersets/{name}.
+func httpRule(operation string) (string, string, bool) {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(operation, "/"), "/", "."))
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return "", "", false
+	}
+
+	md, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok || md.Options() == nil {
+		return "", "", false
+	}
+
+	rule, ok := proto.GetExtension(md.Options(), annotations.E_Http).(*annotations.HttpRule)
+	if !ok || rule == nil {
+		return "", "", false
+	}
+
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		return http.MethodGet, pattern.Get, true
+	case *annotations.HttpRule_Put:
+		return http.MethodPut, pattern.Put, true
+	case *annotations.HttpRule_Post:
+		return http.MethodPost, pattern.Post, true
+	case *annotations.HttpRule_Delete:
+		return http.MethodDelete, pattern.Delete, true
+	case *annotations.HttpRule_Patch:
+		return http.MethodPatch, pattern.Patch, true
+	case *annotations.HttpRule_Custom:
+		return pattern.Custom.GetKind(), pattern.Custom.GetPath(), true
+	}
+
+	return "", "", false
+}
+
+// fieldValue returns the value of the field of msg at the dotted path, or an empty string
+// if the field does not exist. The requests which are not protobuf v2 messages have no fields.
+func fieldValue(msg proto.Message, path string) string {
+	if msg == nil {
+		return ""
+	}
+
+	m := msg.ProtoReflect()
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return ""
+		}
+		if i == len(names)-1 {
+			return m.Get(fd).String()
+		}
+		if fd.Message() == nil {
+			return ""
+		}
+		m = m.Get(fd).Message()
+	}
+
+	return ""
+}
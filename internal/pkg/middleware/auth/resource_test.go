@@ -0,0 +1,52 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package auth
+
+import (
+	"testing"
+
+	"github.com/casbin/casbin/v2/util"
+
+	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+)
+
+func TestResource(t *testing.T) {
+	tests := []struct {
+		name      string
+		operation string
+		rq        any
+		obj       string
+		act       string
+		ok        bool
+	}{
+		{"path variable", v1.OperationGatewayDeleteMinerSet, &v1.DeleteMinerSetRequest{Name: "foo"}, "/v1/minersets/foo", "DELETE", true},
+		{"escaped path variable", v1.OperationGatewayDeleteMinerSet, &v1.DeleteMinerSetRequest{Name: "a/../b"}, "/v1/minersets/a%2F..%2Fb", "DELETE", true},
+		{"body request", v1.OperationGatewayCreateChain, &v1beta1.Chain{}, "/v1/chains", "POST", true},
+		{"unknown operation", "/gateway.v1.Gateway/Unknown", nil, "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj, act, ok := Resource(tt.operation, tt.rq)
+			if obj != tt.obj || act != tt.act || ok != tt.ok {
+				t.Errorf("Resource() = %s, %s, %v, want %s, %s, %v", obj, act, ok, tt.obj, tt.act, tt.ok)
+			}
+
+			// The object must still match the route pattern the policies are written on.
+			if pattern, _, ok := Pattern(tt.operation); ok && !util.KeyMatch2(obj, pattern) {
+				t.Errorf("Resource() = %s, does not match %s", obj, pattern)
+			}
+		})
+	}
+}
+
+func TestPattern(t *testing.T) {
+	obj, act, ok := Pattern(v1.OperationGatewayGetMinerSet)
+	if obj != "/v1/minersets/:name" || act != "GET" || !ok {
+		t.Errorf("Pattern() = %s, %s, %v, want /v1/minersets/:name, GET, true", obj, act, ok)
+	}
+}
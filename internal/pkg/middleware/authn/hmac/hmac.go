@@ -0,0 +1,132 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package hmac
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/superproj/onex/internal/pkg/onexx"
+	hmacauthn "github.com/superproj/onex/pkg/authn/hmac"
+	"github.com/superproj/onex/pkg/log"
+)
+
+const (
+	// reason holds the error reason.
+	reason string = "UNAUTHORIZED"
+
+	// nonceKeyPrefix is the prefix of the redis keys remembering the nonces of the signed requests.
+	nonceKeyPrefix = "hmac_nonce_"
+)
+
+var (
+	ErrSignatureInvalid = errors.Unauthorized(reason, "Request signature is invalid")
+	ErrRequestExpired   = errors.Unauthorized(reason, "Request timestamp is out of range")
+	ErrRequestReplayed  = errors.Unauthorized(reason, "Request has already been received")
+	ErrWrongContext     = errors.Unauthorized(reason, "Wrong context for middleware")
+	ErrBodyTooLarge     = errors.New(http.StatusRequestEntityTooLarge, "REQUEST_ENTITY_TOO_LARGE", "Request body is too large")
+)
+
+// Verifier verifies the signature of a request with the secret key of the secret id,
+// and returns the user owning the secret. The secret key is only known by the verifier.
+type Verifier interface {
+	VerifySignature(ctx context.Context, secretID, stringToSign, signature string) (userID string, err error)
+}
+
+// Server is a HTTP filter authenticating the requests signed with a secret id and
+// secret key pair. It runs as a filter instead of a middleware, because the body
+// of the request has been consumed by the time the middlewares run.
+//
+// The requests which are not signed are passed through to be authenticated by
+// the other means. The owner of the secret is put in the context of the signed
+// requests with `onexx.NewUserID`, and the secret id with `onexx.NewSecretID`.
+// Each nonce is only accepted once.
+func Server(v Verifier, rdb redis.UniversalClient) khttp.FilterFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !hmacauthn.Signed(r.Header) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			secretID, userID, err := verify(r, v, rdb)
+			if err != nil {
+				log.C(r.Context()).Errorw(err, "Failed to verify request signature")
+				khttp.DefaultErrorEncoder(w, r, err)
+				return
+			}
+
+			ctx := onexx.NewUserID(r.Context(), userID)
+			ctx = onexx.NewSecretID(ctx, secretID)
+			ctx = log.WithContext(ctx, "user.id", userID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// verify verifies the signature of the request, and returns the secret id and its owner.
+func verify(r *http.Request, v Verifier, rdb redis.UniversalClient) (string, string, error) {
+	c, err := hmacauthn.ParseCredential(r.Header)
+	if err != nil {
+		return "", "", ErrSignatureInvalid
+	}
+
+	if err := c.Check(time.Now()); err != nil {
+		return "", "", ErrRequestExpired
+	}
+
+	body, err := hmacauthn.ReadBody(r)
+	if err != nil {
+		var mbe *http.MaxBytesError
+		if errors.As(err, &mbe) {
+			return "", "", ErrBodyTooLarge
+		}
+		return "", "", err
+	}
+
+	userID, err := v.VerifySignature(r.Context(), c.SecretID, hmacauthn.StringToSign(r, body), c.Signature)
+	if err != nil {
+		return "", "", err
+	}
+
+	// The nonce is recorded after the signature is verified, so that it can not
+	// be taken by an unsigned request. It is kept as long as the timestamp of the
+	// request is accepted, after which the request is rejected as expired anyway.
+	ok, err := rdb.SetNX(r.Context(), nonceKeyPrefix+c.SecretID+"_"+c.Nonce, 1, 2*hmacauthn.MaxSkew).Result()
+	if err != nil {
+		return "", "", err
+	}
+	if !ok {
+		return "", "", ErrRequestReplayed
+	}
+
+	return c.SecretID, userID, nil
+}
+
+// WithSecret is a client middleware which signs the HTTP requests with the secret id and secret key pair.
+func WithSecret(secretID, secretKey string) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, rq any) (any, error) {
+			if tr, ok := transport.FromClientContext(ctx); ok {
+				if ht, ok := tr.(khttp.Transporter); ok {
+					if err := hmacauthn.SignRequest(ht.Request(), secretID, secretKey, time.Now()); err != nil {
+						return nil, err
+					}
+					return handler(ctx, rq)
+				}
+			}
+			return nil, ErrWrongContext
+		}
+	}
+}
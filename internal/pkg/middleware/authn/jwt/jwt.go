@@ -41,10 +41,25 @@ var (
 
 // Server is a server auth middleware. Check the token and extract the info from token.
 func Server(a authn.Authenticator) middleware.Middleware {
+	return server(a, false)
+}
+
+// Optional is like Server, but lets the requests without a token through
+// unauthenticated. The token is still checked when it is present.
+func Optional(a authn.Authenticator) middleware.Middleware {
+	return server(a, true)
+}
+
+func server(a authn.Authenticator, optional bool) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, rq any) (any, error) {
 			if tr, ok := transport.FromServerContext(ctx); ok {
-				auths := strings.SplitN(tr.RequestHeader().Get(authorizationKey), " ", 2)
+				header := tr.RequestHeader().Get(authorizationKey)
+				if header == "" && optional {
+					return handler(ctx, rq)
+				}
+
+				auths := strings.SplitN(header, " ", 2)
 				if len(auths) != 2 || !strings.EqualFold(auths[0], bearerWord) {
 					return nil, ErrMissingJwtToken
 				}
@@ -8,20 +8,49 @@ package idempotent
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/selector"
 	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/superproj/onex/internal/pkg/idempotent"
+	"github.com/superproj/onex/internal/pkg/onexx"
 	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
 	"github.com/superproj/onex/pkg/api/zerrors"
+	pkgidempotent "github.com/superproj/onex/pkg/idempotent"
+	"github.com/superproj/onex/pkg/log"
 )
 
+// KeyHeader is the header carrying the idempotency key of a request.
+const KeyHeader = "X-Idempotent-ID"
+
+// required lists the operations which must carry an idempotency key. The other
+// mutations in idempotentBlacklist only use the key when the client sends one.
+var required = map[string]struct{}{
+	v1.OperationGatewayCreateMiner:    {},
+	v1.OperationGatewayCreateMinerSet: {},
+}
+
 func idempotentBlacklist() selector.MatchFunc {
 	blacklist := make(map[string]struct{})
+	blacklist[v1.OperationGatewayCreateChain] = struct{}{}
+	blacklist[v1.OperationGatewayUpdateChain] = struct{}{}
+	blacklist[v1.OperationGatewayDeleteChain] = struct{}{}
 	blacklist[v1.OperationGatewayCreateMiner] = struct{}{}
 	blacklist[v1.OperationGatewayCreateMinerSet] = struct{}{}
+	blacklist[v1.OperationGatewayUpdateMiner] = struct{}{}
+	blacklist[v1.OperationGatewayUpdateMinerSet] = struct{}{}
+	blacklist[v1.OperationGatewayDeleteMiner] = struct{}{}
+	blacklist[v1.OperationGatewayDeleteMinerSet] = struct{}{}
+	blacklist[v1.OperationGatewayScaleMinerSet] = struct{}{}
 	return func(ctx context.Context, operation string) bool {
 		if _, ok := blacklist[operation]; ok {
 			return true
@@ -30,22 +59,134 @@ func idempotentBlacklist() selector.MatchFunc {
 	}
 }
 
+// Idempotent replays the stored response to the retries of a request with the same
+// idempotency key. The first request with a key is processed and its final response
+// is stored, a concurrent duplicate waits for it or is rejected with 409, and a request
+// reusing the key with different parameters is rejected with 422. Server errors are
+// not stored, so that the request can be retried.
+//
+// The keys are scoped by user, so the middleware must run after the authentication.
 func Idempotent(idt *idempotent.Idempotent) middleware.Middleware {
 	return selector.Server(
 		func(handler middleware.Handler) middleware.Handler {
 			return func(ctx context.Context, rq any) (rp any, err error) {
-				if tr, ok := transport.FromServerContext(ctx); ok {
-					token := tr.RequestHeader().Get("X-Idempotent-ID")
-					if token != "" {
-						if idt.Check(ctx, token) {
-							return handler(ctx, rq)
-						}
-						return nil, zerrors.ErrorIdempotentTokenExpired("idempotent token is invalid")
+				tr, ok := transport.FromServerContext(ctx)
+				if !ok {
+					return handler(ctx, rq)
+				}
+
+				key := tr.RequestHeader().Get(KeyHeader)
+				if key == "" {
+					if _, ok := required[tr.Operation()]; ok {
+						return nil, zerrors.ErrorIdempotentMissingToken("idempotent token is missing")
 					}
+					return handler(ctx, rq)
+				}
+
+				fingerprint, err := fingerprintOf(tr.Operation(), rq)
+				if err != nil {
+					return nil, err
+				}
+
+				key = onexx.FromUserID(ctx) + "_" + key
+				resp, token, err := idt.Acquire(ctx, key, fingerprint)
+				switch {
+				case stderrors.Is(err, pkgidempotent.ErrMismatch):
+					return nil, zerrors.ErrorIdempotentKeyMismatch("idempotent token has been used by a different request")
+				case stderrors.Is(err, pkgidempotent.ErrInProgress):
+					return nil, zerrors.ErrorIdempotentRequestInProgress("a request with the same idempotent token is in progress")
+				case err != nil:
+					return nil, err
+				case resp != nil:
+					return replay(resp)
 				}
 
-				return nil, zerrors.ErrorIdempotentMissingToken("idempotent token is missing")
+				rp, err = handler(ctx, rq)
+				resp, ok = record(rp, err)
+				if !ok {
+					if rerr := idt.Release(ctx, key, token); rerr != nil {
+						log.C(ctx).Errorw(rerr, "Failed to release idempotent token")
+					}
+					return rp, err
+				}
+
+				if cerr := idt.Complete(ctx, key, token, fingerprint, resp); cerr != nil {
+					log.C(ctx).Errorw(cerr, "Failed to store idempotent response")
+				}
+				return rp, err
 			}
 		},
 	).Match(idempotentBlacklist()).Build()
 }
+
+// fingerprintOf identifies a request by its operation and parameters.
+func fingerprintOf(operation string, rq any) (string, error) {
+	h := sha256.New()
+	h.Write([]byte(operation))
+	var (
+		data []byte
+		err  error
+	)
+	if msg, ok := rq.(proto.Message); ok {
+		data, err = proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	} else {
+		// The kubernetes resources, e.g. v1beta1.MinerSet, are gogo messages.
+		data, err = json.Marshal(rq)
+	}
+	if err != nil {
+		return "", err
+	}
+	h.Write(data)
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// record encodes the final response of a request. It returns false if the response
+// should not be stored, e.g. a server error which may succeed on retry.
+func record(rp any, err error) (*pkgidempotent.Response, bool) {
+	if err != nil {
+		se := errors.FromError(err)
+		if se.Code >= http.StatusInternalServerError {
+			return nil, false
+		}
+
+		data, merr := proto.Marshal(&se.Status)
+		if merr != nil {
+			return nil, false
+		}
+		return &pkgidempotent.Response{Status: int(se.Code), Body: data}, true
+	}
+
+	msg, ok := rp.(proto.Message)
+	if !ok {
+		return nil, false
+	}
+	a, aerr := anypb.New(msg)
+	if aerr != nil {
+		return nil, false
+	}
+	data, merr := proto.Marshal(a)
+	if merr != nil {
+		return nil, false
+	}
+
+	return &pkgidempotent.Response{Status: http.StatusOK, Body: data}, true
+}
+
+// replay decodes a stored response into the reply or error of the original request.
+func replay(resp *pkgidempotent.Response) (any, error) {
+	if resp.Status != http.StatusOK {
+		var st errors.Status
+		if err := proto.Unmarshal(resp.Body, &st); err != nil {
+			return nil, err
+		}
+		return nil, errors.New(int(st.Code), st.Reason, st.Message).WithMetadata(st.Metadata)
+	}
+
+	var a anypb.Any
+	if err := proto.Unmarshal(resp.Body, &a); err != nil {
+		return nil, err
+	}
+
+	return a.UnmarshalNew()
+}
@@ -0,0 +1,52 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package idempotent
+
+import (
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/superproj/onex/pkg/api/gateway/v1"
+	"github.com/superproj/onex/pkg/api/zerrors"
+	"github.com/superproj/onex/pkg/apis/apps/v1beta1"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	reply := &v1.IdempotentResponse{Token: "token"}
+	resp, ok := record(reply, nil)
+	assert.True(t, ok)
+	rp, err := replay(resp)
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(reply, rp.(proto.Message)))
+
+	resp, ok = record(nil, zerrors.ErrorNotFound("miner not found"))
+	assert.True(t, ok)
+	_, err = replay(resp)
+	assert.True(t, zerrors.IsNotFound(err))
+	assert.Equal(t, "miner not found", errors.FromError(err).Message)
+
+	_, ok = record(nil, errors.InternalServer("Unknown", "database is down"))
+	assert.False(t, ok)
+}
+
+func TestFingerprintOf(t *testing.T) {
+	a, _ := fingerprintOf(v1.OperationGatewayCreateMinerSet, &v1.DeleteMinerSetRequest{Name: "a"})
+	b, _ := fingerprintOf(v1.OperationGatewayCreateMinerSet, &v1.DeleteMinerSetRequest{Name: "a"})
+	c, _ := fingerprintOf(v1.OperationGatewayCreateMinerSet, &v1.DeleteMinerSetRequest{Name: "b"})
+	d, _ := fingerprintOf(v1.OperationGatewayDeleteMinerSet, &v1.DeleteMinerSetRequest{Name: "a"})
+	assert.Equal(t, a, b)
+	assert.NotEqual(t, a, c)
+	assert.NotEqual(t, a, d)
+
+	e, _ := fingerprintOf(v1.OperationGatewayCreateMinerSet, &v1beta1.MinerSet{Spec: v1beta1.MinerSetSpec{Replicas: ptr.To[int32](1)}})
+	f, _ := fingerprintOf(v1.OperationGatewayCreateMinerSet, &v1beta1.MinerSet{Spec: v1beta1.MinerSetSpec{Replicas: ptr.To[int32](2)}})
+	assert.NotEqual(t, e, f)
+}
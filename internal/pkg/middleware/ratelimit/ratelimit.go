@@ -0,0 +1,109 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+
+	"github.com/superproj/onex/internal/pkg/onexx"
+	"github.com/superproj/onex/pkg/api/zerrors"
+	"github.com/superproj/onex/pkg/log"
+	genericoptions "github.com/superproj/onex/pkg/options"
+	"github.com/superproj/onex/pkg/ratelimit"
+)
+
+// The headers describing the limit of the caller on the operation.
+const (
+	LimitHeader      = "X-RateLimit-Limit"
+	RemainingHeader  = "X-RateLimit-Remaining"
+	ResetHeader      = "X-RateLimit-Reset"
+	RetryAfterHeader = "Retry-After"
+)
+
+// Limiter takes a token from the bucket of the key for a request.
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit ratelimit.Limit) (*ratelimit.Result, error)
+}
+
+// Server limits the rate of the requests of each caller on each operation, with the limits of
+// the routes in opts or the default one. The caller is the secret signing the request, or the
+// authenticated user otherwise, so the middleware must run after the authentication.
+//
+// The requests are allowed if the limiter fails, e.g. redis is unavailable, so that the
+// service is not down with it.
+func Server(l Limiter, opts *genericoptions.RateLimitOptions) middleware.Middleware {
+	if !opts.Enabled {
+		return func(handler middleware.Handler) middleware.Handler {
+			return handler
+		}
+	}
+
+	defaultLimit := ratelimit.Limit{Rate: opts.Rate, Burst: opts.Burst}
+	routes := make(map[string]ratelimit.Limit, len(opts.Routes))
+	for _, r := range opts.Routes {
+		routes[r.Operation] = ratelimit.Limit{Rate: r.Rate, Burst: r.Burst}
+	}
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, rq any) (any, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, rq)
+			}
+
+			caller := callerOf(ctx)
+			if caller == "" {
+				return handler(ctx, rq)
+			}
+
+			limit, ok := routes[tr.Operation()]
+			if !ok {
+				limit = defaultLimit
+			}
+
+			res, err := l.Allow(ctx, caller+"_"+tr.Operation(), limit)
+			if err != nil {
+				log.C(ctx).Errorw(err, "Failed to check rate limit", "operation", tr.Operation())
+				return handler(ctx, rq)
+			}
+
+			h := tr.ReplyHeader()
+			h.Set(LimitHeader, strconv.Itoa(res.Limit))
+			h.Set(RemainingHeader, strconv.Itoa(res.Remaining))
+			h.Set(ResetHeader, strconv.Itoa(ceilSeconds(res.ResetAfter)))
+			if !res.Allowed {
+				retryAfter := ceilSeconds(res.RetryAfter)
+				h.Set(RetryAfterHeader, strconv.Itoa(retryAfter))
+				return nil, zerrors.ErrorTooManyRequests("rate limit exceeded, retry after %d seconds", retryAfter)
+			}
+
+			return handler(ctx, rq)
+		}
+	}
+}
+
+// callerOf returns the key of the caller of the request, or empty if it is anonymous.
+func callerOf(ctx context.Context) string {
+	if secretID := onexx.FromSecretID(ctx); secretID != "" {
+		return "secret_" + secretID
+	}
+	if userID := onexx.FromUserID(ctx); userID != "" {
+		return "user_" + userID
+	}
+
+	return ""
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,104 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/superproj/onex/internal/pkg/onexx"
+	"github.com/superproj/onex/pkg/api/zerrors"
+	genericoptions "github.com/superproj/onex/pkg/options"
+	"github.com/superproj/onex/pkg/ratelimit"
+)
+
+type headerCarrier http.Header
+
+func (hc headerCarrier) Get(key string) string      { return http.Header(hc).Get(key) }
+func (hc headerCarrier) Set(key, value string)      { http.Header(hc).Set(key, value) }
+func (hc headerCarrier) Add(key, value string)      { http.Header(hc).Add(key, value) }
+func (hc headerCarrier) Keys() []string             { return nil }
+func (hc headerCarrier) Values(key string) []string { return http.Header(hc).Values(key) }
+
+type testTransport struct {
+	operation string
+	reply     headerCarrier
+}
+
+func (tr *testTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (tr *testTransport) Endpoint() string                { return "" }
+func (tr *testTransport) Operation() string               { return tr.operation }
+func (tr *testTransport) RequestHeader() transport.Header { return headerCarrier{} }
+func (tr *testTransport) ReplyHeader() transport.Header   { return tr.reply }
+
+// fakeLimiter allows the requests while the bucket of the key has tokens.
+type fakeLimiter struct {
+	keys   []string
+	limits []ratelimit.Limit
+	tokens map[string]int
+}
+
+func (l *fakeLimiter) Allow(ctx context.Context, key string, limit ratelimit.Limit) (*ratelimit.Result, error) {
+	l.keys = append(l.keys, key)
+	l.limits = append(l.limits, limit)
+	if _, ok := l.tokens[key]; !ok {
+		l.tokens[key] = limit.Burst
+	}
+	if l.tokens[key] == 0 {
+		return &ratelimit.Result{Limit: limit.Burst, RetryAfter: 1500 * time.Millisecond, ResetAfter: 3 * time.Second}, nil
+	}
+	l.tokens[key]--
+	return &ratelimit.Result{Allowed: true, Limit: limit.Burst, Remaining: l.tokens[key]}, nil
+}
+
+func TestServer(t *testing.T) {
+	l := &fakeLimiter{tokens: map[string]int{}}
+	opts := &genericoptions.RateLimitOptions{
+		Enabled: true,
+		Rate:    10,
+		Burst:   5,
+		Routes:  []genericoptions.RateLimitRoute{{Operation: "/create", Rate: 1, Burst: 1}},
+	}
+	handler := Server(l, opts)(func(ctx context.Context, rq any) (any, error) {
+		return "ok", nil
+	})
+
+	call := func(ctx context.Context, operation string) (*testTransport, error) {
+		tr := &testTransport{operation: operation, reply: headerCarrier{}}
+		_, err := handler(transport.NewServerContext(ctx, tr), nil)
+		return tr, err
+	}
+
+	user := onexx.NewUserID(context.Background(), "user-1")
+	tr, err := call(user, "/create")
+	assert.Nil(t, err)
+	assert.Equal(t, "1", tr.reply.Get(LimitHeader))
+	assert.Equal(t, "0", tr.reply.Get(RemainingHeader))
+
+	tr, err = call(user, "/create")
+	assert.True(t, zerrors.IsTooManyRequests(err))
+	assert.Equal(t, "2", tr.reply.Get(RetryAfterHeader))
+	assert.Equal(t, "3", tr.reply.Get(ResetHeader))
+
+	// The other operations and the secrets of the user have their own buckets.
+	_, err = call(user, "/list")
+	assert.Nil(t, err)
+	_, err = call(onexx.NewSecretID(user, "secret-1"), "/create")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"user_user-1_/create", "user_user-1_/create", "user_user-1_/list", "secret_secret-1_/create"}, l.keys)
+	assert.Equal(t, ratelimit.Limit{Rate: 10, Burst: 5}, l.limits[2])
+
+	// The anonymous requests are not limited here.
+	_, err = call(context.Background(), "/create")
+	assert.Nil(t, err)
+	assert.Len(t, l.keys, 4)
+}
@@ -29,6 +29,7 @@ type (
 	userKey        struct{}
 	userMKey       struct{}
 	accessTokenKey struct{}
+	secretIDKey    struct{}
 )
 
 // NewContext put auth info into context.
@@ -53,6 +54,17 @@ func FromUserID(ctx context.Context) string {
 	return userID
 }
 
+// NewSecretID put the secret id signing the request into context.
+func NewSecretID(ctx context.Context, secretID string) context.Context {
+	return context.WithValue(ctx, secretIDKey{}, secretID)
+}
+
+// FromSecretID extract the secret id signing the request from context.
+func FromSecretID(ctx context.Context) string {
+	secretID, _ := ctx.Value(secretIDKey{}).(string)
+	return secretID
+}
+
 // NewAccessToken put accessToken into context.
 func NewAccessToken(ctx context.Context, accessToken string) context.Context {
 	return context.WithValue(ctx, accessTokenKey{}, accessToken)
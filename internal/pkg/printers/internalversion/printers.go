@@ -53,6 +53,17 @@ func AddHandlers(h printers.PrintHandler) {
 	h.TableHandler(minerSetColumnDefinitions, printMinerSet)
 	h.TableHandler(minerSetColumnDefinitions, printMinerSetList)
 
+	minerSetAutoscalerColumnDefinitions := []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Reference", Type: "string", Description: v1beta1.MinerSetAutoscalerSpec{}.SwaggerDoc()["scaleTargetRef"]},
+		{Name: "MinReplicas", Type: "string", Description: v1beta1.MinerSetAutoscalerSpec{}.SwaggerDoc()["minReplicas"]},
+		{Name: "MaxReplicas", Type: "integer", Description: v1beta1.MinerSetAutoscalerSpec{}.SwaggerDoc()["maxReplicas"]},
+		{Name: "Replicas", Type: "integer", Description: v1beta1.MinerSetAutoscalerStatus{}.SwaggerDoc()["currentReplicas"]},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+	}
+	h.TableHandler(minerSetAutoscalerColumnDefinitions, printMinerSetAutoscaler)
+	h.TableHandler(minerSetAutoscalerColumnDefinitions, printMinerSetAutoscalerList)
+
 	minerColumnDefinitions := []metav1.TableColumnDefinition{
 		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
 		{Name: "Status", Type: "string", Description: "The status of the miner"},
@@ -63,6 +74,18 @@ func AddHandlers(h printers.PrintHandler) {
 	h.TableHandler(minerColumnDefinitions, printMiner)
 	h.TableHandler(minerColumnDefinitions, printMinerList)
 
+	minerClassColumnDefinitions := []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "CPU", Type: "string", Description: "The cpu limit of the miners"},
+		{Name: "Memory", Type: "string", Description: "The memory limit of the miners"},
+		{Name: "Difficulty", Type: "integer", Description: v1beta1.MinerClassSpec{}.SwaggerDoc()["miningDifficulty"]},
+		{Name: "Price", Type: "integer", Description: v1beta1.MinerClassPricing{}.SwaggerDoc()["hourlyPrice"]},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+		{Name: "Image", Type: "string", Priority: 1, Description: v1beta1.MinerClassSpec{}.SwaggerDoc()["image"]},
+	}
+	h.TableHandler(minerClassColumnDefinitions, printMinerClass)
+	h.TableHandler(minerClassColumnDefinitions, printMinerClassList)
+
 	leaseColumnDefinitions := []metav1.TableColumnDefinition{
 		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
 		{Name: "Holder", Type: "string", Description: coordinationv1.LeaseSpec{}.SwaggerDoc()["holderIdentity"]},
@@ -101,6 +124,19 @@ func AddHandlers(h printers.PrintHandler) {
 
 	h.TableHandler(chainColumnDefinitions, printChain)
 	h.TableHandler(chainColumnDefinitions, printChainList)
+
+	chargeRequestColumnDefinitions := []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Chain", Type: "string", Description: v1beta1.ChargeRequestSpec{}.SwaggerDoc()["chainName"]},
+		{Name: "Amount", Type: "integer", Description: v1beta1.ChargeRequestSpec{}.SwaggerDoc()["amount"]},
+		{Name: "Confirmations", Type: "integer", Description: v1beta1.ChargeRequestStatus{}.SwaggerDoc()["confirmations"]},
+		{Name: "Status", Type: "string", Description: "The status of the charge request"},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+		{Name: "From", Type: "string", Priority: 1, Description: v1beta1.ChargeRequestSpec{}.SwaggerDoc()["from"]},
+		{Name: "Transaction", Type: "string", Priority: 1, Description: v1beta1.ChargeRequestStatus{}.SwaggerDoc()["transactionID"]},
+	}
+	h.TableHandler(chargeRequestColumnDefinitions, printChargeRequest)
+	h.TableHandler(chargeRequestColumnDefinitions, printChargeRequestList)
 }
 
 func printNamespace(obj *api.Namespace, options printers.GenerateOptions) ([]metav1.TableRow, error) {
@@ -222,6 +258,40 @@ func printMinerSet(obj *apps.MinerSet, options printers.GenerateOptions) ([]meta
 	return []metav1.TableRow{row}, nil
 }
 
+func printMinerSetAutoscaler(obj *apps.MinerSetAutoscaler, options printers.GenerateOptions) ([]metav1.TableRow, error) {
+	row := metav1.TableRow{
+		Object: runtime.RawExtension{Object: obj},
+	}
+
+	minReplicas := "<unset>"
+	if obj.Spec.MinReplicas != nil {
+		minReplicas = fmt.Sprintf("%d", *obj.Spec.MinReplicas)
+	}
+	row.Cells = append(
+		row.Cells,
+		obj.Name,
+		"MinerSet/"+obj.Spec.ScaleTargetRef.Name,
+		minReplicas,
+		int64(obj.Spec.MaxReplicas),
+		int64(obj.Status.CurrentReplicas),
+		printersutil.TranslateTimestampSince(obj.CreationTimestamp),
+	)
+
+	return []metav1.TableRow{row}, nil
+}
+
+func printMinerSetAutoscalerList(list *apps.MinerSetAutoscalerList, options printers.GenerateOptions) ([]metav1.TableRow, error) {
+	rows := make([]metav1.TableRow, 0, len(list.Items))
+	for i := range list.Items {
+		r, err := printMinerSetAutoscaler(&list.Items[i], options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
 func printLease(obj *coordination.Lease, options printers.GenerateOptions) ([]metav1.TableRow, error) {
 	row := metav1.TableRow{
 		Object: runtime.RawExtension{Object: obj},
@@ -355,6 +425,52 @@ func printChainList(list *apps.ChainList, options printers.GenerateOptions) ([]m
 	return rows, nil
 }
 
+func printChargeRequest(obj *apps.ChargeRequest, options printers.GenerateOptions) ([]metav1.TableRow, error) {
+	row := metav1.TableRow{
+		Object: runtime.RawExtension{Object: obj},
+	}
+
+	status := "Pending"
+	if obj.Status.TransactionID != "" {
+		status = "Submitted"
+	}
+	for _, condition := range obj.Status.Conditions {
+		if condition.Status != api.ConditionTrue {
+			continue
+		}
+		if condition.Type == apps.ConditionType(v1beta1.ChargeSucceeded) || condition.Type == apps.ConditionType(v1beta1.ChargeFailed) {
+			status = string(condition.Type)
+		}
+	}
+
+	row.Cells = append(
+		row.Cells,
+		obj.Name,
+		obj.Spec.ChainName,
+		obj.Spec.Amount,
+		int64(obj.Status.Confirmations),
+		status,
+		printersutil.TranslateTimestampSince(obj.CreationTimestamp),
+	)
+	if options.Wide {
+		row.Cells = append(row.Cells, obj.Spec.From, obj.Status.TransactionID)
+	}
+
+	return []metav1.TableRow{row}, nil
+}
+
+func printChargeRequestList(list *apps.ChargeRequestList, options printers.GenerateOptions) ([]metav1.TableRow, error) {
+	rows := make([]metav1.TableRow, 0, len(list.Items))
+	for i := range list.Items {
+		r, err := printChargeRequest(&list.Items[i], options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
 // formatEventSource formats EventSource as a comma separated string excluding Host when empty.
 // It uses reportingController when Source.Component is empty and reportingInstance when Source.Host is empty.
 func formatEventSource(es api.EventSource, reportingController, reportingInstance string) string {
@@ -379,3 +495,42 @@ func formatEventSourceComponentInstance(component, instance string) string {
 	}
 	return component + ", " + instance
 }
+
+func printMinerClass(obj *apps.MinerClass, options printers.GenerateOptions) ([]metav1.TableRow, error) {
+	row := metav1.TableRow{
+		Object: runtime.RawExtension{Object: obj},
+	}
+
+	cpu, memory := obj.Spec.Resources.Limits[api.ResourceCPU], obj.Spec.Resources.Limits[api.ResourceMemory]
+	var price int64
+	if obj.Spec.Pricing != nil {
+		price = obj.Spec.Pricing.HourlyPrice
+	}
+
+	row.Cells = append(
+		row.Cells,
+		obj.Name,
+		cpu.String(),
+		memory.String(),
+		int64(obj.Spec.MiningDifficulty),
+		price,
+		printersutil.TranslateTimestampSince(obj.CreationTimestamp),
+	)
+	if options.Wide {
+		row.Cells = append(row.Cells, obj.Spec.Image)
+	}
+
+	return []metav1.TableRow{row}, nil
+}
+
+func printMinerClassList(list *apps.MinerClassList, options printers.GenerateOptions) ([]metav1.TableRow, error) {
+	rows := make([]metav1.TableRow, 0, len(list.Items))
+	for i := range list.Items {
+		r, err := printMinerClass(&list.Items[i], options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package chargerequest
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/caarlos0/env/v8"
+)
+
+// SealedPrefix prefixes the passwords sealed by SealPassword.
+const SealedPrefix = "sealed:"
+
+var (
+	// ErrNoKey is returned when the sealing key is not configured.
+	ErrNoKey = errors.New("chargerequest: ONEX_CHARGE_REQUEST_KEY is not set")
+	// ErrNotSealed is returned when opening a password which is not sealed.
+	ErrNotSealed = errors.New("chargerequest: password is not sealed")
+	// ErrInvalidSealed is returned when a sealed password can not be opened with the configured key.
+	ErrInvalidSealed = errors.New("chargerequest: invalid sealed password")
+)
+
+// Env is the environment the sealing key is read from. The onex-apiserver, which seals the
+// passwords, and the controller which opens them, must be configured with the same key.
+type Env struct {
+	Key string `env:"CHARGE_REQUEST_KEY"`
+}
+
+// GetEnv reads the sealing configuration from the environment.
+func GetEnv() Env {
+	var e Env
+	_ = env.ParseWithOptions(&e, env.Options{Prefix: "ONEX_"})
+	return e
+}
+
+// IsSealed reports whether the password is sealed.
+func IsSealed(password string) bool {
+	return strings.HasPrefix(password, SealedPrefix)
+}
+
+// SealPassword encrypts the password with the key configured in the environment.
+// Sealed passwords are returned unchanged.
+func SealPassword(password string) (string, error) {
+	if password == "" || IsSealed(password) {
+		return password, nil
+	}
+
+	aead, err := newAEAD(GetEnv().Key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	return SealedPrefix + base64.RawStdEncoding.EncodeToString(aead.Seal(nonce, nonce, []byte(password), nil)), nil
+}
+
+// OpenPassword decrypts a password sealed by SealPassword.
+func OpenPassword(sealed string) (string, error) {
+	if !IsSealed(sealed) {
+		return "", ErrNotSealed
+	}
+
+	aead, err := newAEAD(GetEnv().Key)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := base64.RawStdEncoding.DecodeString(strings.TrimPrefix(sealed, SealedPrefix))
+	if err != nil || len(data) < aead.NonceSize() {
+		return "", ErrInvalidSealed
+	}
+
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", ErrInvalidSealed
+	}
+
+	return string(plaintext), nil
+}
+
+func newAEAD(key string) (cipher.AEAD, error) {
+	if key == "" {
+		return nil, ErrNoKey
+	}
+
+	sum := sha256.Sum256([]byte("onex/chargerequest-password/" + key))
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,50 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package chargerequest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSealPassword(t *testing.T) {
+	t.Setenv("ONEX_CHARGE_REQUEST_KEY", "secret")
+
+	sealed, err := SealPassword("onex(#)666")
+	require.NoError(t, err)
+	assert.True(t, IsSealed(sealed))
+	assert.NotContains(t, sealed, "onex(#)666")
+
+	// Sealing twice does not change the password.
+	again, err := SealPassword(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, sealed, again)
+
+	password, err := OpenPassword(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "onex(#)666", password)
+
+	_, err = OpenPassword("onex(#)666")
+	assert.ErrorIs(t, err, ErrNotSealed)
+
+	t.Setenv("ONEX_CHARGE_REQUEST_KEY", "other")
+	_, err = OpenPassword(sealed)
+	assert.ErrorIs(t, err, ErrInvalidSealed)
+}
+
+func TestSealPasswordWithoutKey(t *testing.T) {
+	t.Setenv("ONEX_CHARGE_REQUEST_KEY", "")
+
+	_, err := SealPassword("onex(#)666")
+	assert.ErrorIs(t, err, ErrNoKey)
+
+	sealed, err := SealPassword("")
+	require.NoError(t, err)
+	assert.Empty(t, sealed)
+}
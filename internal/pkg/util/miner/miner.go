@@ -111,3 +111,24 @@ func IsGenesisMiner(m *v1beta1.Miner) bool {
 	_, ok := m.Labels[v1beta1.ChainNameLabel]
 	return ok
 }
+
+// CountQuotaMiners returns the number of miners counted against the miner quota of a user.
+// The miners owned by the minersets are counted by the replicas of the minersets, which may
+// not be created yet.
+func CountQuotaMiners(miners []*v1beta1.Miner, minerSets []*v1beta1.MinerSet) int {
+	count := 0
+	for _, m := range miners {
+		if owner := metav1.GetControllerOf(m); owner == nil || owner.Kind != "MinerSet" {
+			count++
+		}
+	}
+	for _, ms := range minerSets {
+		if ms.Spec.Replicas == nil {
+			count++
+			continue
+		}
+		count += int(*ms.Spec.Replicas)
+	}
+
+	return count
+}
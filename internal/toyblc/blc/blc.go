@@ -9,7 +9,10 @@ package blc
 import (
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math/big"
+	"sync"
 	"time"
 
 	"github.com/google/wire"
@@ -25,17 +28,38 @@ const (
 	QueryLatestAction Action = iota
 	QueryAllAction
 	ResponseAction
+	TransactionAction
+	// HandshakeAction must be the first message sent on a P2P connection.
+	HandshakeAction
+	GetPeersAction
+	PeersAction
+	PingAction
+	PongAction
 )
 
 var ProviderSet = wire.NewSet(NewBlockSet)
 
-var genesis = &Block{
-	Index:        0,
-	PreviousHash: "0",
-	Timestamp:    1465154705,
-	Data:         "genesis block",
-	Hash:         "816534932c2b7154836da6afc367695e6337db8a921823784c14378abed4f7d7",
-	Address:      defaults.GenesisAddress,
+var (
+	// ErrInvalidBlock is returned when a block can not be appended to the tip of the chain.
+	ErrInvalidBlock = errors.New("invalid block")
+	// ErrInsufficientWork is returned when a replacement chain does not have more work than the current one.
+	ErrInsufficientWork = errors.New("chain does not have more cumulative work")
+)
+
+// genesis is the first block of every chain. Its hash is derived with CalHash, so that it
+// stays valid when the fields covered by the hash change.
+var genesis = newGenesis()
+
+func newGenesis() *Block {
+	b := &Block{
+		Index:        0,
+		PreviousHash: "0",
+		Timestamp:    1465154705,
+		Data:         "genesis block",
+		Address:      defaults.GenesisAddress,
+	}
+	b.Hash = b.CalHash()
+	return b
 }
 
 type Block struct {
@@ -45,14 +69,22 @@ type Block struct {
 	Data         string `json:"data"`
 	Hash         string `json:"hash"`
 	Address      string `json:"address"`
+	// Difficulty is the number of leading zero bits the hash of the block must have.
+	Difficulty int `json:"difficulty"`
+	// Nonce is the value found by the miner to make the hash meet the difficulty.
+	Nonce int64 `json:"nonce"`
+	// Transactions are the transactions included in the block, the first one may be the coinbase transaction.
+	Transactions []*Transaction `json:"transactions,omitempty"`
 }
 
 func (b *Block) String() string {
-	return fmt.Sprintf("index: %d,previousHash:%s,timestamp:%d,data:%s,hash:%s", b.Index, b.PreviousHash, b.Timestamp, b.Data, b.Hash)
+	return fmt.Sprintf("index: %d,previousHash:%s,timestamp:%d,data:%s,hash:%s,difficulty:%d,nonce:%d",
+		b.Index, b.PreviousHash, b.Timestamp, b.Data, b.Hash, b.Difficulty, b.Nonce)
 }
 
 func (b *Block) CalHash() string {
-	return fmt.Sprintf("%x", sha256.Sum256([]byte(fmt.Sprintf("%d%s%d%s", b.Index, b.PreviousHash, b.Timestamp, b.Data))))
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(fmt.Sprintf("%d%s%d%s%d%d%s",
+		b.Index, b.PreviousHash, b.Timestamp, b.Data, b.Difficulty, b.Nonce, transactionsRoot(b.Transactions)))))
 }
 
 type ResponseBlockchain struct {
@@ -60,40 +92,248 @@ type ResponseBlockchain struct {
 	Data []byte `json:"data"`
 }
 
+// BlockSet is the local copy of the blockchain. It is safe for concurrent use
+// by the miner, the HTTP controllers and the P2P handlers.
 type BlockSet struct {
 	address string
-	data    []*Block
+	store   Store
+
+	mu   sync.RWMutex
+	data []*Block
+	// state is the account state at the tip of the chain.
+	state *State
+	// work is the cumulative work of the chain.
+	work *big.Int
+	// heights indexes the blocks by hash.
+	heights map[string]int64
+
+	mempool *Mempool
+	events  *eventBus
 }
 
-func NewBlockSet(address string) *BlockSet {
+// NewBlockSet creates a BlockSet backed by the given store. The persisted blocks
+// are verified and loaded, so the chain resumes from the last persisted block.
+// An empty store is initialized with the genesis block.
+func NewBlockSet(address string, store Store) (*BlockSet, error) {
+	blocks, err := store.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load blocks: %w", err)
+	}
+
+	if len(blocks) == 0 {
+		if err := store.Append(genesis); err != nil {
+			return nil, fmt.Errorf("failed to persist genesis block: %w", err)
+		}
+
+		blocks = []*Block{genesis}
+	}
+
+	state, err := replayChain(blocks)
+	if err != nil {
+		return nil, fmt.Errorf("persisted blockchain is invalid: %w", err)
+	}
+
+	log.Infow("Loaded blockchain from store", "height", blocks[len(blocks)-1].Index)
 	return &BlockSet{
 		address: address,
-		data:    []*Block{genesis},
-	}
+		store:   store,
+		data:    blocks,
+		state:   state,
+		work:    chainWork(blocks),
+		heights: indexBlocks(blocks),
+		mempool: NewMempool(),
+		events:  newEventBus(),
+	}, nil
 }
 
+// List returns a snapshot of the blocks in the chain.
 func (bs *BlockSet) List() []*Block {
-	return bs.data
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+
+	blocks := make([]*Block, len(bs.data))
+	copy(blocks, bs.data)
+	return blocks
 }
 
-func (bs *BlockSet) Add(b *Block) {
-	if !isValidNewBlock(b, bs.Latest()) {
-		return
+func (bs *BlockSet) Add(b *Block) error {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	if !isValidNewBlock(b, bs.latest(), requiredDifficulty(bs.data)) {
+		return ErrInvalidBlock
+	}
+
+	state := bs.state.Clone()
+	if err := state.ApplyBlock(b); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidBlock, err)
+	}
+
+	if err := bs.store.Append(b); err != nil {
+		return err
 	}
 
 	bs.data = append(bs.data, b)
+	bs.state = state
+	bs.work = new(big.Int).Add(bs.work, blockWork(b.Difficulty))
+	bs.heights[b.Hash] = b.Index
+	bs.mempool.Prune(state)
+	bs.events.publish(ChainEvent{Type: EventBlockAdded, Tip: b})
+	return nil
+}
+
+// Genesis returns the first block of the chain.
+func (bs *BlockSet) Genesis() *Block {
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+
+	return bs.data[0]
 }
 
 func (bs *BlockSet) Latest() *Block {
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+
+	return bs.latest()
+}
+
+func (bs *BlockSet) latest() *Block {
 	return bs.data[len(bs.data)-1]
 }
 
 func (bs *BlockSet) Len() int {
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+
 	return len(bs.data)
 }
 
-func (bs *BlockSet) SetBlocks(blocks []*Block) {
+// Work returns the cumulative work of the chain.
+func (bs *BlockSet) Work() *big.Int {
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+
+	return new(big.Int).Set(bs.work)
+}
+
+// RequiredDifficulty returns the minimum difficulty of the next block.
+func (bs *BlockSet) RequiredDifficulty() int {
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+
+	return requiredDifficulty(bs.data)
+}
+
+// SetBlocks replaces the chain with the given blocks unconditionally, once they
+// are fully validated. Use ReplaceChain to apply the fork choice rule.
+func (bs *BlockSet) SetBlocks(blocks []*Block) error {
+	state, err := replayChain(blocks)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidBlock, err)
+	}
+
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	return bs.setBlocks(blocks, state)
+}
+
+// ReplaceChain replaces the chain with the given blocks if they are a valid chain
+// with more cumulative work than the current one. The replacement chain is fully
+// validated from the genesis block. ErrInsufficientWork is returned when the
+// current chain is kept.
+func (bs *BlockSet) ReplaceChain(blocks []*Block) error {
+	// Validate outside of the lock, replaying a long chain is expensive.
+	state, err := replayChain(blocks)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidBlock, err)
+	}
+
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	if chainWork(blocks).Cmp(bs.work) <= 0 {
+		return ErrInsufficientWork
+	}
+
+	return bs.setBlocks(blocks, state)
+}
+
+// setBlocks must be called with bs.mu held.
+func (bs *BlockSet) setBlocks(blocks []*Block, state *State) error {
+	if err := bs.store.Reset(blocks); err != nil {
+		return err
+	}
+
+	old := bs.data
+	fork := forkPoint(old, blocks)
+
 	bs.data = blocks
+	bs.state = state
+	bs.work = chainWork(blocks)
+	bs.heights = indexBlocks(blocks)
+
+	// Transactions of the abandoned blocks go back to the mempool, the ones
+	// already included in the new chain are dropped by the nonce check.
+	var orphaned []*Transaction
+	for _, b := range old[fork+1:] {
+		for _, tx := range b.Transactions {
+			if !tx.IsCoinbase() {
+				orphaned = append(orphaned, tx)
+			}
+		}
+	}
+	bs.mempool.Reinject(orphaned, state)
+
+	event := ChainEvent{Type: EventBlockAdded, Tip: bs.latest()}
+	if reverted := len(old) - 1 - fork; reverted > 0 {
+		event = ChainEvent{
+			Type:      EventReorg,
+			Tip:       bs.latest(),
+			OldTip:    old[len(old)-1],
+			ForkIndex: old[fork].Index,
+			Reverted:  reverted,
+		}
+		log.Infow("Blockchain reorganized", "forkIndex", event.ForkIndex, "reverted", reverted, "height", event.Tip.Index)
+	}
+
+	bs.events.publish(event)
+	return nil
+}
+
+// Subscribe returns a channel receiving the chain events and a function to cancel
+// the subscription. Events are dropped when the buffer of the channel is full.
+func (bs *BlockSet) Subscribe(buffer int) (<-chan ChainEvent, func()) {
+	return bs.events.subscribe(buffer)
+}
+
+// Account returns the account state of the given address at the tip of the chain.
+func (bs *BlockSet) Account(address string) Account {
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+
+	return bs.state.Account(address)
+}
+
+// AddTransaction validates the transaction and adds it to the mempool.
+func (bs *BlockSet) AddTransaction(tx *Transaction) error {
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+
+	return bs.mempool.Add(tx, bs.state)
+}
+
+// PendingTransactions returns the transactions waiting to be mined.
+func (bs *BlockSet) PendingTransactions() []*Transaction {
+	return bs.mempool.Pending(-1)
+}
+
+// Close closes the underlying block store.
+func (bs *BlockSet) Close() error {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	return bs.store.Close()
 }
 
 func (bs *BlockSet) LatestMessage() []byte {
@@ -107,8 +347,23 @@ func (bs *BlockSet) LatestMessage() []byte {
 	return data
 }
 
+// TransactionMessage returns the P2P message used to gossip the transaction.
+func TransactionMessage(tx *Transaction) []byte {
+	data, _ := json.Marshal([]*Transaction{tx})
+	resp := &ResponseBlockchain{
+		Type: TransactionAction,
+		Data: data,
+	}
+
+	data, _ = json.Marshal(resp)
+	return data
+}
+
 func (bs *BlockSet) NextBlock(data string) *Block {
-	pre := bs.Latest()
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+
+	pre := bs.latest()
 
 	nb := &Block{
 		Data:         data,
@@ -116,45 +371,93 @@ func (bs *BlockSet) NextBlock(data string) *Block {
 		Index:        pre.Index + 1,
 		Timestamp:    time.Now().Unix(),
 		Address:      bs.address,
+		Difficulty:   requiredDifficulty(bs.data),
 	}
 
+	nb.Transactions = append([]*Transaction{NewCoinbaseTransaction(bs.address, nb.Index)}, bs.mempool.Pending(MaxBlockTransactions)...)
+
 	nb.Hash = nb.CalHash()
 
 	return nb
 }
 
 func (bs *BlockSet) MarshalJSON() ([]byte, error) {
-	return json.Marshal(bs)
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+
+	return json.Marshal(bs.data)
 }
 
-func isValidNewBlock(nb, pb *Block) bool {
-	if nb.Hash == nb.CalHash() && pb.Index+1 == nb.Index && pb.Hash == nb.PreviousHash {
-		return true
+func indexBlocks(blocks []*Block) map[string]int64 {
+	heights := make(map[string]int64, len(blocks))
+	for _, b := range blocks {
+		heights[b.Hash] = b.Index
+	}
+
+	return heights
+}
+
+// forkPoint returns the position of the last block shared by the two chains,
+// both chains start from the genesis block.
+func forkPoint(a, b []*Block) int {
+	i := 0
+	for i+1 < len(a) && i+1 < len(b) && a[i+1].Hash == b[i+1].Hash {
+		i++
+	}
+
+	return i
+}
+
+func isValidNewBlock(nb, pb *Block, difficulty int) bool {
+	if nb.Hash != nb.CalHash() || pb.Index+1 != nb.Index || pb.Hash != nb.PreviousHash {
+		return false
+	}
+
+	if nb.Difficulty < difficulty || !HashMatchesDifficulty(nb.Hash, nb.Difficulty) {
+		log.Warnw("Block does not meet the difficulty target", "index", nb.Index, "difficulty", nb.Difficulty, "required", difficulty)
+		return false
 	}
 
-	return false
+	return isValidTimestamp(nb, pb)
 }
 
 func IsValidChain(blocks []*Block) bool {
-	if len(blocks) == 0 {
+	if _, err := replayChain(blocks); err != nil {
+		log.Warnw("Invalid blockchain", "err", err)
 		return false
 	}
 
+	return true
+}
+
+// replayChain validates the blocks from the genesis block and returns the account state at the tip.
+func replayChain(blocks []*Block) (*State, error) {
+	if len(blocks) == 0 {
+		return nil, errors.New("empty blockchain")
+	}
+
 	if blocks[0].String() != genesis.String() {
-		log.Warnw("No matching GenesisBlock", "block", blocks[0].String())
-		return false
+		return nil, fmt.Errorf("no matching genesis block: %s", blocks[0].String())
 	}
 
-	temp := []*Block{blocks[0]}
+	state := NewState()
+	difficulty := 0
 	for i := 1; i < len(blocks); i++ {
-		if isValidNewBlock(blocks[i], temp[i-1]) {
-			return false
+		if !isValidNewBlock(blocks[i], blocks[i-1], difficulty) {
+			return nil, fmt.Errorf("%w: index %d", ErrInvalidBlock, blocks[i].Index)
+		}
+
+		if err := state.ApplyBlock(blocks[i]); err != nil {
+			return nil, err
 		}
 
-		temp = append(temp, blocks[i])
+		// Keep the required difficulty in step with requiredDifficulty(blocks[:i+1]).
+		if i%RetargetInterval == 0 {
+			difficulty = retarget(difficulty, blocks[i-RetargetInterval], blocks[i])
+		}
 	}
 
-	return true
+	return state, nil
 }
 
 type ByIndex []*Block
@@ -0,0 +1,212 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package blc
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// extendChain mines n blocks of the given difficulty on top of blocks.
+func extendChain(blocks []*Block, address string, difficulty, n int) []*Block {
+	chain := append([]*Block{}, blocks...)
+	for i := 0; i < n; i++ {
+		pre := chain[len(chain)-1]
+		nb := &Block{
+			Index:        pre.Index + 1,
+			PreviousHash: pre.Hash,
+			Timestamp:    time.Now().Unix(),
+			Data:         fmt.Sprintf("%s-%d", address, i),
+			Address:      address,
+			Difficulty:   difficulty,
+		}
+		nb.Transactions = []*Transaction{NewCoinbaseTransaction(address, nb.Index)}
+		for nb.Hash = nb.CalHash(); !HashMatchesDifficulty(nb.Hash, nb.Difficulty); nb.Hash = nb.CalHash() {
+			nb.Nonce++
+		}
+
+		chain = append(chain, nb)
+	}
+
+	return chain
+}
+
+func TestGenesis(t *testing.T) {
+	if genesis.Hash != genesis.CalHash() {
+		t.Fatalf("genesis hash = %s, want %s", genesis.Hash, genesis.CalHash())
+	}
+
+	chain := extendChain([]*Block{genesis}, "alice", 0, 2)
+	if _, err := replayChain(chain); err != nil {
+		t.Fatalf("replayChain() error = %v", err)
+	}
+}
+
+func TestBlockSet_ReplaceChain(t *testing.T) {
+	base := extendChain([]*Block{genesis}, "base", 0, 2)
+	longLight := extendChain(base, "light", 0, 5)
+	shortHeavy := extendChain(base, "heavy", 6, 1)
+
+	invalid := extendChain(base, "invalid", 0, 5)
+	invalid[4].Data = "tampered"
+
+	tests := []struct {
+		name    string
+		current []*Block
+		blocks  []*Block
+		wantErr error
+		wantTip *Block
+	}{
+		{name: "longer chain wins", current: base, blocks: longLight, wantTip: longLight[len(longLight)-1]},
+		{name: "shorter chain with more work wins", current: longLight, blocks: shortHeavy, wantTip: shortHeavy[len(shortHeavy)-1]},
+		{name: "longer chain with less work loses", current: shortHeavy, blocks: longLight, wantErr: ErrInsufficientWork},
+		{name: "same chain is kept", current: base, blocks: base, wantErr: ErrInsufficientWork},
+		{name: "invalid chain is rejected", current: base, blocks: invalid, wantErr: ErrInvalidBlock},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bs, err := NewBlockSet("test", NewMemoryStore())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := bs.SetBlocks(tt.current); err != nil {
+				t.Fatal(err)
+			}
+			tip := bs.Latest()
+
+			err = bs.ReplaceChain(tt.blocks)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ReplaceChain() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				tt.wantTip = tip
+			}
+			if got := bs.Latest(); got.Hash != tt.wantTip.Hash {
+				t.Errorf("Latest() = %d/%s, want %d/%s", got.Index, got.Hash, tt.wantTip.Index, tt.wantTip.Hash)
+			}
+		})
+	}
+}
+
+func TestBlockSet_Reorg(t *testing.T) {
+	key, sender := newTestKey(t)
+
+	base := extendChain([]*Block{genesis}, sender, 0, 1)
+	bs, err := NewBlockSet(sender, NewMemoryStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := bs.SetBlocks(base); err != nil {
+		t.Fatal(err)
+	}
+
+	events, cancel := bs.Subscribe(10)
+	defer cancel()
+
+	// Include a transfer in a block which is going to be abandoned.
+	tx := NewTransaction(key, "0xreceiver", 10, 1)
+	if err := bs.AddTransaction(tx); err != nil {
+		t.Fatal(err)
+	}
+	if err := bs.Add(bs.NextBlock("orphan")); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(bs.PendingTransactions()); n != 0 {
+		t.Fatalf("pending transactions = %d, want 0", n)
+	}
+
+	fork := extendChain(base, "other", 4, 2)
+	if err := bs.ReplaceChain(fork); err != nil {
+		t.Fatalf("ReplaceChain() error = %v", err)
+	}
+
+	if ev := <-events; ev.Type != EventBlockAdded {
+		t.Errorf("first event = %s, want %s", ev.Type, EventBlockAdded)
+	}
+	ev := <-events
+	if ev.Type != EventReorg || ev.ForkIndex != base[1].Index || ev.Reverted != 1 || ev.Tip.Hash != fork[3].Hash {
+		t.Errorf("reorg event = %+v, want fork index %d, 1 reverted block and tip %s", ev, base[1].Index, fork[3].Hash)
+	}
+
+	pending := bs.PendingTransactions()
+	if len(pending) != 1 || pending[0].ID != tx.ID {
+		t.Errorf("pending transactions = %v, want the transaction of the abandoned block", pending)
+	}
+	if got := bs.Account("0xreceiver").Balance; got != 0 {
+		t.Errorf("receiver balance = %d, want 0 after the reorg", got)
+	}
+}
+
+func TestBlockSet_Concurrency(t *testing.T) {
+	key, miner := newTestKey(t)
+
+	bs, err := NewBlockSet(miner, NewMemoryStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := bs.Add(bs.NextBlock("reward")); err != nil {
+		t.Fatal(err)
+	}
+
+	const workers = 4
+	var wg sync.WaitGroup
+
+	// Miners racing to extend the chain, stale blocks are rejected.
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 5; j++ {
+				_ = bs.Add(bs.NextBlock("concurrent"))
+			}
+		}()
+	}
+
+	// Peers sending competing forks.
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_ = bs.ReplaceChain(extendChain(bs.List(), fmt.Sprintf("peer-%d", i), 0, 2))
+		}(i)
+	}
+
+	// Clients sending transactions and reading the chain.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for nonce := uint64(1); nonce <= 5; nonce++ {
+			_ = bs.AddTransaction(NewTransaction(key, "0xreceiver", 1, nonce))
+		}
+	}()
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				_ = bs.List()
+				_ = bs.Latest()
+				_ = bs.Account(miner)
+				_ = bs.PendingTransactions()
+				_, _ = bs.MarshalJSON()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if !IsValidChain(bs.List()) {
+		t.Errorf("IsValidChain() = false after concurrent updates")
+	}
+	if got, want := bs.Work(), chainWork(bs.List()); got.Cmp(want) != 0 {
+		t.Errorf("Work() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package blc
+
+import (
+	"sync"
+)
+
+// EventType is the type of a chain event.
+type EventType string
+
+const (
+	// EventBlockAdded is emitted when a block is appended to the tip of the chain.
+	EventBlockAdded EventType = "BlockAdded"
+	// EventReorg is emitted when the chain is replaced by a fork with more cumulative work.
+	EventReorg EventType = "Reorg"
+)
+
+// ChainEvent describes a change of the chain tip.
+type ChainEvent struct {
+	Type EventType `json:"type"`
+	// Tip is the new tip of the chain.
+	Tip *Block `json:"tip"`
+	// OldTip is the tip of the chain before a reorg.
+	OldTip *Block `json:"oldTip,omitempty"`
+	// ForkIndex is the index of the last block shared by the old and the new chain.
+	ForkIndex int64 `json:"forkIndex,omitempty"`
+	// Reverted is the number of blocks removed from the old chain by a reorg.
+	Reverted int `json:"reverted,omitempty"`
+}
+
+// eventBus fans out chain events to the subscribers. A slow subscriber never
+// blocks the chain: events are dropped when its buffer is full.
+type eventBus struct {
+	mu     sync.Mutex
+	nextID int
+	subs   map[int]chan ChainEvent
+}
+
+func newEventBus() *eventBus {
+	return &eventBus{subs: make(map[int]chan ChainEvent)}
+}
+
+func (eb *eventBus) subscribe(buffer int) (<-chan ChainEvent, func()) {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+
+	id := eb.nextID
+	eb.nextID++
+	ch := make(chan ChainEvent, buffer)
+	eb.subs[id] = ch
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			eb.mu.Lock()
+			defer eb.mu.Unlock()
+
+			delete(eb.subs, id)
+			close(ch)
+		})
+	}
+}
+
+func (eb *eventBus) publish(event ChainEvent) {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+
+	for _, ch := range eb.subs {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package blc
+
+// AddressStats counts the activity of an address on the chain.
+type AddressStats struct {
+	// MinedBlocks is the number of blocks mined by the address.
+	MinedBlocks int64 `json:"minedBlocks"`
+	// SentTransactions is the number of transfers sent by the address.
+	SentTransactions int64 `json:"sentTransactions"`
+	// ReceivedTransactions is the number of transfers received by the address, excluding the block rewards.
+	ReceivedTransactions int64 `json:"receivedTransactions"`
+}
+
+// Stats is a summary of the chain.
+type Stats struct {
+	Height     int64  `json:"height"`
+	Difficulty int    `json:"difficulty"`
+	TotalWork  string `json:"totalWork"`
+	// AverageBlockTime is the average time between two blocks in seconds, the genesis block excluded.
+	AverageBlockTime  float64                  `json:"averageBlockTime"`
+	TotalTransactions int64                    `json:"totalTransactions"`
+	PendingTxs        int                      `json:"pendingTransactions"`
+	Addresses         map[string]*AddressStats `json:"addresses"`
+}
+
+// GetByHeight returns the block at the given height.
+func (bs *BlockSet) GetByHeight(height int64) (*Block, bool) {
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+
+	if height < 0 || height >= int64(len(bs.data)) {
+		return nil, false
+	}
+
+	return bs.data[height], true
+}
+
+// GetByHash returns the block with the given hash.
+func (bs *BlockSet) GetByHash(hash string) (*Block, bool) {
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+
+	height, ok := bs.heights[hash]
+	if !ok {
+		return nil, false
+	}
+
+	return bs.data[height], true
+}
+
+// Range returns at most limit blocks starting from offset, together with the total
+// number of blocks. Blocks are ordered by ascending height, or from the tip if desc is true.
+func (bs *BlockSet) Range(offset, limit int64, desc bool) ([]*Block, int64) {
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+
+	total := int64(len(bs.data))
+	if offset < 0 || offset >= total || limit <= 0 {
+		return []*Block{}, total
+	}
+
+	if limit > total-offset {
+		limit = total - offset
+	}
+
+	blocks := make([]*Block, 0, limit)
+	for i := offset; i < offset+limit; i++ {
+		if desc {
+			blocks = append(blocks, bs.data[total-1-i])
+			continue
+		}
+
+		blocks = append(blocks, bs.data[i])
+	}
+
+	return blocks, total
+}
+
+// Stats summarizes the chain.
+func (bs *BlockSet) Stats() *Stats {
+	bs.mu.RLock()
+	defer bs.mu.RUnlock()
+
+	tip := bs.latest()
+	stats := &Stats{
+		Height:     tip.Index,
+		Difficulty: requiredDifficulty(bs.data),
+		TotalWork:  bs.work.String(),
+		PendingTxs: bs.mempool.Len(),
+		Addresses:  make(map[string]*AddressStats),
+	}
+
+	address := func(addr string) *AddressStats {
+		as, ok := stats.Addresses[addr]
+		if !ok {
+			as = &AddressStats{}
+			stats.Addresses[addr] = as
+		}
+
+		return as
+	}
+
+	// The genesis block has a made-up timestamp, so it is left out of the average.
+	for _, b := range bs.data[1:] {
+		address(b.Address).MinedBlocks++
+		for _, tx := range b.Transactions {
+			if tx.IsCoinbase() {
+				continue
+			}
+
+			stats.TotalTransactions++
+			address(tx.From).SentTransactions++
+			address(tx.To).ReceivedTransactions++
+		}
+	}
+
+	if len(bs.data) > 2 {
+		stats.AverageBlockTime = float64(tip.Timestamp-bs.data[1].Timestamp) / float64(len(bs.data)-2)
+	}
+
+	return stats
+}
@@ -0,0 +1,66 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package blc
+
+import (
+	"testing"
+)
+
+func TestBlockSet_Range(t *testing.T) {
+	bs, err := NewBlockSet("test", NewMemoryStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := bs.SetBlocks(extendChain([]*Block{genesis}, "test", 0, 4)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		offset int64
+		limit  int64
+		desc   bool
+		want   []int64
+	}{
+		{name: "first page", offset: 0, limit: 2, want: []int64{0, 1}},
+		{name: "last page is truncated", offset: 3, limit: 5, want: []int64{3, 4}},
+		{name: "from the tip", offset: 1, limit: 2, desc: true, want: []int64{3, 2}},
+		{name: "out of range", offset: 5, limit: 2, want: []int64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blocks, total := bs.Range(tt.offset, tt.limit, tt.desc)
+			if total != 5 {
+				t.Errorf("Range() total = %d, want 5", total)
+			}
+
+			got := make([]int64, 0, len(blocks))
+			for _, b := range blocks {
+				got = append(got, b.Index)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Range() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Range() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+
+	tip := bs.Latest()
+	if b, ok := bs.GetByHash(tip.Hash); !ok || b.Index != tip.Index {
+		t.Errorf("GetByHash() = %v, %v, want the tip", b, ok)
+	}
+	if _, ok := bs.GetByHeight(tip.Index + 1); ok {
+		t.Errorf("GetByHeight() above the tip = true, want false")
+	}
+	if stats := bs.Stats(); stats.Height != 4 || stats.Addresses["test"].MinedBlocks != 4 {
+		t.Errorf("Stats() = %+v, want height 4 and 4 blocks mined by test", stats)
+	}
+}
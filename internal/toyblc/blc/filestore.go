@@ -0,0 +1,276 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package blc
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/superproj/onex/pkg/log"
+)
+
+const (
+	// blocksFileName is the name of the append-only file which holds the blocks.
+	blocksFileName = "blocks.wal"
+	// recordHeaderSize is the size of the record header: 4 bytes length followed by 4 bytes crc32c checksum.
+	recordHeaderSize = 8
+	// maxRecordSize protects against allocating huge buffers when the length field is corrupted.
+	maxRecordSize = 64 << 20
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// fileStore is an append-only, write-ahead log style Store. Every block is written
+// as a length-prefixed and checksummed record and synced to disk before Append returns.
+// A torn record at the tail of the file (e.g. caused by a crash in the middle of a write)
+// is detected by its checksum and truncated when the store is opened.
+type fileStore struct {
+	mu   sync.Mutex
+	dir  string
+	file *os.File
+}
+
+var _ Store = (*fileStore)(nil)
+
+// NewFileStore opens (or creates) a file based Store in the given directory.
+func NewFileStore(dir string) (Store, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create data directory %s: %w", dir, err)
+	}
+
+	s := &fileStore{dir: dir}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *fileStore) path() string {
+	return filepath.Join(s.dir, blocksFileName)
+}
+
+func (s *fileStore) open() error {
+	f, err := os.OpenFile(s.path(), os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+
+	s.file = f
+	return nil
+}
+
+// Load reads all the valid records from the log. A corrupted record at the end of
+// the log, which is left by a crash in the middle of an append, is truncated so that
+// subsequent appends start at a clean offset. A corrupted record followed by other
+// records fails the load rather than dropping the blocks after it.
+func (s *fileStore) Load() ([]*Block, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	var (
+		blocks []*Block
+		offset int64
+		r      = bufio.NewReader(s.file)
+	)
+
+	for {
+		b, n, err := readRecord(r)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			tail, terr := isTail(r)
+			if terr != nil {
+				return nil, terr
+			}
+			if !tail {
+				return nil, fmt.Errorf("corrupted block record at offset %d: %w", offset, err)
+			}
+
+			log.Warnw("Found corrupted block record, truncate the tail of the block log", "offset", offset, "err", err)
+			break
+		}
+
+		blocks = append(blocks, b)
+		offset += n
+	}
+
+	if err := s.file.Truncate(offset); err != nil {
+		return nil, err
+	}
+	if _, err := s.file.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	return blocks, s.file.Sync()
+}
+
+// isTail reports whether nothing but zeros follows a corrupted record, i.e. the record is
+// the last one of the log, possibly followed by the zeros a crash may leave in the file.
+func isTail(r io.Reader) (bool, error) {
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := r.Read(buf)
+		for _, c := range buf[:n] {
+			if c != 0 {
+				return false, nil
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+}
+
+// Append writes the block to the end of the log and syncs it to disk.
+func (s *fileStore) Append(b *Block) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := encodeRecord(b)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.file.Write(data); err != nil {
+		return err
+	}
+
+	return s.file.Sync()
+}
+
+// Reset writes the blocks to a temporary file and renames it over the log,
+// so a crash in the middle of Reset leaves either the old or the new chain.
+func (s *fileStore) Reset(blocks []*Block) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tmp := s.path() + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	for _, b := range blocks {
+		data, err := encodeRecord(b)
+		if err != nil {
+			_ = f.Close()
+			return err
+		}
+		if _, err := w.Write(data); err != nil {
+			_ = f.Close()
+			return err
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	// The new log is renamed over the old one before the handles are swapped, so the
+	// store keeps writing to the old log if the rename fails.
+	if err := os.Rename(tmp, s.path()); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	_ = s.file.Close()
+	s.file = f
+
+	if _, err := s.file.Seek(0, io.SeekEnd); err != nil {
+		return err
+	}
+
+	return syncDir(s.dir)
+}
+
+// Close closes the underlying file.
+func (s *fileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
+
+func encodeRecord(b *Block) ([]byte, error) {
+	payload, err := json.Marshal(b)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, recordHeaderSize+len(payload))
+	binary.BigEndian.PutUint32(data[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(data[4:8], crc32.Checksum(payload, crcTable))
+	copy(data[recordHeaderSize:], payload)
+
+	return data, nil
+}
+
+// readRecord reads one record from r and returns the decoded block and the
+// number of bytes consumed. io.EOF is returned only at a clean record boundary.
+func readRecord(r io.Reader) (*Block, int64, error) {
+	header := make([]byte, recordHeaderSize)
+	if n, err := io.ReadFull(r, header); err != nil {
+		if errors.Is(err, io.EOF) && n == 0 {
+			return nil, 0, io.EOF
+		}
+		return nil, 0, fmt.Errorf("short record header: %w", err)
+	}
+
+	size := binary.BigEndian.Uint32(header[0:4])
+	if size > maxRecordSize {
+		return nil, 0, fmt.Errorf("record size %d exceeds the limit", size)
+	}
+
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, 0, fmt.Errorf("short record payload: %w", err)
+	}
+
+	if crc32.Checksum(payload, crcTable) != binary.BigEndian.Uint32(header[4:8]) {
+		return nil, 0, errors.New("record checksum mismatch")
+	}
+
+	b := &Block{}
+	if err := json.Unmarshal(payload, b); err != nil {
+		return nil, 0, err
+	}
+
+	return b, int64(recordHeaderSize + size), nil
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	return d.Sync()
+}
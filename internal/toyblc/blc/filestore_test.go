@@ -0,0 +1,136 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package blc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func newTestBlockSet(t *testing.T, dir string) *BlockSet {
+	t.Helper()
+
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileStore() error = %v", err)
+	}
+
+	bs, err := NewBlockSet("test", store)
+	if err != nil {
+		t.Fatalf("NewBlockSet() error = %v", err)
+	}
+
+	return bs
+}
+
+func TestFileStore_Resume(t *testing.T) {
+	dir := t.TempDir()
+
+	bs := newTestBlockSet(t, dir)
+	for i := 0; i < 3; i++ {
+		if err := bs.Add(bs.NextBlock("data")); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+	}
+	latest := bs.Latest()
+	_ = bs.Close()
+
+	bs = newTestBlockSet(t, dir)
+	defer bs.Close()
+
+	if bs.Len() != 4 {
+		t.Errorf("Len() = %d, want 4", bs.Len())
+	}
+	if bs.Latest().Hash != latest.Hash {
+		t.Errorf("Latest().Hash = %s, want %s", bs.Latest().Hash, latest.Hash)
+	}
+}
+
+func TestFileStore_TruncateTornRecord(t *testing.T) {
+	dir := t.TempDir()
+
+	bs := newTestBlockSet(t, dir)
+	if err := bs.Add(bs.NextBlock("data")); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	_ = bs.Close()
+
+	// Simulate a crash in the middle of writing a record.
+	f, err := os.OpenFile(filepath.Join(dir, blocksFileName), os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.Write([]byte{0, 0, 1, 0, 1, 2})
+	_ = f.Close()
+
+	bs = newTestBlockSet(t, dir)
+	defer bs.Close()
+
+	if bs.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", bs.Len())
+	}
+	if err := bs.Add(bs.NextBlock("data")); err != nil {
+		t.Errorf("Add() after truncate error = %v", err)
+	}
+}
+
+func TestFileStore_CorruptedRecord(t *testing.T) {
+	dir := t.TempDir()
+
+	bs := newTestBlockSet(t, dir)
+	for i := 0; i < 2; i++ {
+		if err := bs.Add(bs.NextBlock("data")); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+	}
+	_ = bs.Close()
+
+	// Flip a byte in the payload of the first record, which is followed by other records.
+	name := filepath.Join(dir, blocksFileName)
+	data, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data[recordHeaderSize+1] ^= 0xff
+	if err := os.WriteFile(name, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileStore() error = %v", err)
+	}
+	defer store.Close()
+
+	if _, err := store.Load(); err == nil {
+		t.Errorf("Load() of a corrupted record followed by other records succeeded")
+	}
+	if fi, _ := os.Stat(name); fi.Size() != int64(len(data)) {
+		t.Errorf("Load() truncated the log to %d bytes, want %d", fi.Size(), len(data))
+	}
+}
+
+func TestFileStore_Reset(t *testing.T) {
+	dir := t.TempDir()
+
+	bs := newTestBlockSet(t, dir)
+	if err := bs.Add(bs.NextBlock("a")); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if err := bs.SetBlocks([]*Block{genesis}); err != nil {
+		t.Fatalf("SetBlocks() error = %v", err)
+	}
+	_ = bs.Close()
+
+	bs = newTestBlockSet(t, dir)
+	defer bs.Close()
+
+	if bs.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", bs.Len())
+	}
+}
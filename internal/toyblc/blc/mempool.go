@@ -0,0 +1,145 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package blc
+
+import (
+	"errors"
+	"sync"
+)
+
+// maxMempoolSize is the maximum number of pending transactions kept by a node.
+const maxMempoolSize = 10000
+
+// ErrMempoolFull is returned when the mempool can not accept more transactions.
+var ErrMempoolFull = errors.New("mempool is full")
+
+// Mempool keeps the transactions which are not included in the chain yet.
+// Transactions are kept in arrival order, and every pending transaction is
+// valid when applied in that order on top of the state of the chain tip.
+type Mempool struct {
+	mu  sync.Mutex
+	txs []*Transaction
+	ids map[string]struct{}
+
+	// base is the chain state the pending transactions were applied on, and pending is
+	// the state after them, which is updated with each added transaction, so that only
+	// the new transaction is verified. It is rebuilt when the chain state changes.
+	base    *State
+	pending *State
+}
+
+// NewMempool returns an empty mempool.
+func NewMempool() *Mempool {
+	return &Mempool{ids: make(map[string]struct{})}
+}
+
+// Add validates the transaction against the given chain state plus the
+// pending transactions, and adds it to the mempool.
+func (mp *Mempool) Add(tx *Transaction, state *State) error {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	if _, ok := mp.ids[tx.ID]; ok {
+		return ErrTransactionExists
+	}
+
+	if len(mp.txs) >= maxMempoolSize {
+		return ErrMempoolFull
+	}
+
+	if mp.base != state {
+		mp.rebuild(state)
+	}
+
+	if err := mp.pending.ApplyTransaction(tx); err != nil {
+		return err
+	}
+
+	mp.txs = append(mp.txs, tx)
+	mp.ids[tx.ID] = struct{}{}
+	return nil
+}
+
+// Pending returns at most limit pending transactions in arrival order.
+func (mp *Mempool) Pending(limit int) []*Transaction {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	if limit < 0 || limit > len(mp.txs) {
+		limit = len(mp.txs)
+	}
+
+	txs := make([]*Transaction, limit)
+	copy(txs, mp.txs[:limit])
+	return txs
+}
+
+// Len returns the number of pending transactions.
+func (mp *Mempool) Len() int {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	return len(mp.txs)
+}
+
+// Prune drops the transactions which are no longer valid on top of the given
+// state, e.g. the ones already included in the chain.
+func (mp *Mempool) Prune(state *State) {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	mp.rebuild(state)
+}
+
+// rebuild applies the pending transactions on top of the given state, and drops the
+// ones which are no longer valid. The caller must hold mp.mu.
+func (mp *Mempool) rebuild(state *State) {
+	pending := state.Clone()
+	txs := mp.txs[:0]
+	for _, tx := range mp.txs {
+		if err := pending.ApplyTransaction(tx); err != nil {
+			delete(mp.ids, tx.ID)
+			continue
+		}
+
+		txs = append(txs, tx)
+	}
+
+	// Release the references held by the tail of the underlying array.
+	for i := len(txs); i < len(mp.txs); i++ {
+		mp.txs[i] = nil
+	}
+
+	mp.txs = txs
+	mp.base, mp.pending = state, pending
+}
+
+// Reinject puts the transactions of the blocks abandoned by a reorg back in front
+// of the pending transactions, then drops the ones invalid on top of the new state.
+func (mp *Mempool) Reinject(txs []*Transaction, state *State) {
+	mp.mu.Lock()
+	reinjected := make([]*Transaction, 0, len(txs)+len(mp.txs))
+	for _, tx := range txs {
+		if _, ok := mp.ids[tx.ID]; ok {
+			continue
+		}
+
+		mp.ids[tx.ID] = struct{}{}
+		reinjected = append(reinjected, tx)
+	}
+
+	mp.txs = append(reinjected, mp.txs...)
+	if len(mp.txs) > maxMempoolSize {
+		for _, tx := range mp.txs[maxMempoolSize:] {
+			delete(mp.ids, tx.ID)
+		}
+		mp.txs = mp.txs[:maxMempoolSize]
+	}
+	mp.mu.Unlock()
+
+	mp.Prune(state)
+}
@@ -0,0 +1,48 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package blc
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMempool_Add(t *testing.T) {
+	key, sender := newTestKey(t)
+
+	state := NewState()
+	state.account(sender).Balance = 100
+
+	mp := NewMempool()
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		if err := mp.Add(NewTransaction(key, "0xreceiver", 30, nonce), state); err != nil {
+			t.Fatalf("Add() of nonce %d error = %v", nonce, err)
+		}
+	}
+	if err := mp.Add(NewTransaction(key, "0xreceiver", 30, 4), state); !errors.Is(err, ErrInvalidTransaction) {
+		t.Errorf("Add() overspending the pending balance error = %v, want ErrInvalidTransaction", err)
+	}
+
+	// The first transaction is included in the chain.
+	next := state.Clone()
+	if err := next.ApplyTransaction(mp.Pending(1)[0]); err != nil {
+		t.Fatal(err)
+	}
+	mp.Prune(next)
+	if n := mp.Len(); n != 2 {
+		t.Fatalf("Len() = %d, want 2", n)
+	}
+
+	next.account(sender).Balance += 30
+	mp.Prune(next)
+	if err := mp.Add(NewTransaction(key, "0xreceiver", 30, 4), next); err != nil {
+		t.Errorf("Add() on top of the new state error = %v", err)
+	}
+	if n := mp.Len(); n != 3 {
+		t.Errorf("Len() = %d, want 3", n)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package blc
+
+import (
+	"encoding/hex"
+	"math/big"
+	"math/bits"
+	"time"
+)
+
+// Proof-of-work consensus parameters. They must be the same on every node of a chain.
+const (
+	// TargetBlockInterval is the expected time between two blocks.
+	TargetBlockInterval = time.Minute
+	// RetargetInterval is the number of blocks between two difficulty adjustments.
+	RetargetInterval = 10
+	// MaxDifficulty is the upper bound of the difficulty, which is the bit length of a sha256 hash.
+	MaxDifficulty = 256
+	// maxClockDrift is the tolerated clock difference between two nodes, in seconds.
+	maxClockDrift = 60
+)
+
+// HashMatchesDifficulty reports whether the hex encoded hash has at least
+// difficulty leading zero bits.
+func HashMatchesDifficulty(hash string, difficulty int) bool {
+	data, err := hex.DecodeString(hash)
+	if err != nil {
+		return false
+	}
+
+	zeros := 0
+	for _, b := range data {
+		if b != 0 {
+			zeros += bits.LeadingZeros8(b)
+			break
+		}
+		zeros += 8
+	}
+
+	return zeros >= difficulty
+}
+
+// retarget adjusts the required difficulty according to the time taken
+// to mine the last RetargetInterval blocks. Every step doubles or halves
+// the expected mining work.
+func retarget(difficulty int, first, last *Block) int {
+	expected := int64(RetargetInterval * TargetBlockInterval / time.Second)
+	taken := last.Timestamp - first.Timestamp
+
+	switch {
+	case taken < expected/2 && difficulty < MaxDifficulty:
+		return difficulty + 1
+	case taken > expected*2 && difficulty > 0:
+		return difficulty - 1
+	default:
+		return difficulty
+	}
+}
+
+// requiredDifficulty returns the minimum difficulty of the block following the given blocks.
+// The blocks must start from the genesis block.
+func requiredDifficulty(blocks []*Block) int {
+	difficulty := 0
+	for h := RetargetInterval; h < len(blocks); h += RetargetInterval {
+		difficulty = retarget(difficulty, blocks[h-RetargetInterval], blocks[h])
+	}
+
+	return difficulty
+}
+
+// isValidTimestamp rejects blocks which are too far in the past compared to
+// the previous block or too far in the future compared to the local clock,
+// otherwise miners can manipulate the difficulty retargeting.
+func isValidTimestamp(nb, pb *Block) bool {
+	return pb.Timestamp-maxClockDrift < nb.Timestamp && nb.Timestamp-maxClockDrift < time.Now().Unix()
+}
+
+// blockWork returns the expected number of hashes to mine a block of the given difficulty, which is 2^difficulty.
+func blockWork(difficulty int) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(difficulty))
+}
+
+// chainWork returns the cumulative work of the blocks. The fork choice rule
+// prefers the valid chain with the most cumulative work over the longest one,
+// otherwise a long chain of cheap blocks could replace a heavier chain.
+func chainWork(blocks []*Block) *big.Int {
+	work := new(big.Int)
+	for _, b := range blocks {
+		work.Add(work, blockWork(b.Difficulty))
+	}
+
+	return work
+}
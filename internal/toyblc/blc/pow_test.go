@@ -0,0 +1,89 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package blc
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHashMatchesDifficulty(t *testing.T) {
+	tests := []struct {
+		name       string
+		hash       string
+		difficulty int
+		want       bool
+	}{
+		{name: "zero difficulty", hash: "ff", difficulty: 0, want: true},
+		{name: "exact bits", hash: "00ff", difficulty: 8, want: true},
+		{name: "partial byte", hash: "001f", difficulty: 11, want: true},
+		{name: "not enough bits", hash: "001f", difficulty: 12, want: false},
+		{name: "invalid hash", hash: "zz", difficulty: 0, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HashMatchesDifficulty(tt.hash, tt.difficulty); got != tt.want {
+				t.Errorf("HashMatchesDifficulty() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetarget(t *testing.T) {
+	expected := int64(RetargetInterval * TargetBlockInterval / time.Second)
+	first := &Block{Timestamp: 1000}
+
+	tests := []struct {
+		name       string
+		difficulty int
+		taken      int64
+		want       int
+	}{
+		{name: "too fast", difficulty: 4, taken: expected / 4, want: 5},
+		{name: "on target", difficulty: 4, taken: expected, want: 4},
+		{name: "too slow", difficulty: 4, taken: expected * 4, want: 3},
+		{name: "never negative", difficulty: 0, taken: expected * 4, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			last := &Block{Timestamp: first.Timestamp + tt.taken}
+			if got := retarget(tt.difficulty, first, last); got != tt.want {
+				t.Errorf("retarget() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidNewBlock_Difficulty(t *testing.T) {
+	bs, err := NewBlockSet("test", NewMemoryStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nb := bs.NextBlock("data")
+	nb.Difficulty = 8
+	for !HashMatchesDifficulty(nb.Hash, nb.Difficulty) {
+		nb.Nonce++
+		nb.Hash = nb.CalHash()
+	}
+
+	if !isValidNewBlock(nb, bs.Latest(), 8) {
+		t.Errorf("isValidNewBlock() = false, want true for a mined block")
+	}
+	if isValidNewBlock(nb, bs.Latest(), 9) {
+		t.Errorf("isValidNewBlock() = true, want false when the block is below the required difficulty")
+	}
+
+	nb.Nonce++
+	nb.Hash = nb.CalHash()
+	if HashMatchesDifficulty(nb.Hash, nb.Difficulty) {
+		t.Skip("next nonce also meets the difficulty")
+	}
+	if isValidNewBlock(nb, bs.Latest(), 0) {
+		t.Errorf("isValidNewBlock() = true, want false for a hash not meeting its own difficulty")
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package blc
+
+import (
+	"fmt"
+)
+
+// Account is the state of an address derived from the chain.
+type Account struct {
+	Address string `json:"address"`
+	Balance uint64 `json:"balance"`
+	// Nonce is the sequence number of the last transaction sent by the account.
+	Nonce uint64 `json:"nonce"`
+}
+
+// State holds the balances and nonces of all the accounts, it is built by replaying the blocks.
+type State struct {
+	accounts map[string]*Account
+}
+
+// NewState returns an empty state.
+func NewState() *State {
+	return &State{accounts: make(map[string]*Account)}
+}
+
+// Clone returns a deep copy of the state.
+func (s *State) Clone() *State {
+	ns := NewState()
+	for addr, acc := range s.accounts {
+		cp := *acc
+		ns.accounts[addr] = &cp
+	}
+
+	return ns
+}
+
+// Account returns the account of the given address. Unknown addresses have a zero balance.
+func (s *State) Account(address string) Account {
+	if acc, ok := s.accounts[address]; ok {
+		return *acc
+	}
+
+	return Account{Address: address}
+}
+
+func (s *State) account(address string) *Account {
+	acc, ok := s.accounts[address]
+	if !ok {
+		acc = &Account{Address: address}
+		s.accounts[address] = acc
+	}
+
+	return acc
+}
+
+// ApplyTransaction validates a transfer transaction against the state and applies it.
+// The state is left untouched when an error is returned.
+func (s *State) ApplyTransaction(tx *Transaction) error {
+	if err := tx.Verify(); err != nil {
+		return err
+	}
+
+	from := s.Account(tx.From)
+	if tx.Nonce != from.Nonce+1 {
+		return fmt.Errorf("%w: expected nonce %d, got %d", ErrInvalidTransaction, from.Nonce+1, tx.Nonce)
+	}
+
+	if from.Balance < tx.Amount {
+		return fmt.Errorf("%w: insufficient balance %d of %s", ErrInvalidTransaction, from.Balance, tx.From)
+	}
+
+	sender := s.account(tx.From)
+	sender.Balance -= tx.Amount
+	sender.Nonce = tx.Nonce
+	s.account(tx.To).Balance += tx.Amount
+	return nil
+}
+
+// ApplyBlock applies the transactions of the block to the state. The first transaction
+// of a block may be a coinbase transaction rewarding Block.Address with BlockReward.
+// The state may be partially updated when an error is returned, so callers should apply
+// blocks on a clone.
+func (s *State) ApplyBlock(b *Block) error {
+	if len(b.Transactions) > MaxBlockTransactions+1 {
+		return fmt.Errorf("%w: too many transactions in block %d", ErrInvalidTransaction, b.Index)
+	}
+
+	for i, tx := range b.Transactions {
+		if !tx.IsCoinbase() {
+			if err := s.ApplyTransaction(tx); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if i != 0 || tx.To != b.Address || tx.Amount != BlockReward || tx.Nonce != uint64(b.Index) || tx.ID != tx.CalID() {
+			return fmt.Errorf("%w: invalid coinbase transaction in block %d", ErrInvalidTransaction, b.Index)
+		}
+
+		s.account(tx.To).Balance += tx.Amount
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package blc
+
+// Store is the interface used by BlockSet to persist blocks.
+// Implementations must return blocks from Load in the order they were appended.
+type Store interface {
+	// Load returns all persisted blocks.
+	Load() ([]*Block, error)
+	// Append persists a block at the tip of the chain.
+	Append(b *Block) error
+	// Reset atomically replaces all persisted blocks with the given blocks.
+	Reset(blocks []*Block) error
+	// Close releases the resources held by the store.
+	Close() error
+}
+
+// memoryStore is a Store which keeps nothing, all blocks are lost on restart.
+type memoryStore struct{}
+
+var _ Store = (*memoryStore)(nil)
+
+// NewMemoryStore returns a Store which does not persist any block.
+func NewMemoryStore() Store {
+	return &memoryStore{}
+}
+
+func (s *memoryStore) Load() ([]*Block, error) { return nil, nil }
+
+func (s *memoryStore) Append(b *Block) error { return nil }
+
+func (s *memoryStore) Reset(blocks []*Block) error { return nil }
+
+func (s *memoryStore) Close() error { return nil }
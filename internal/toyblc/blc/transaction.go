@@ -0,0 +1,184 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package blc
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// BlockReward is the amount of coins rewarded to the miner of a block.
+	BlockReward uint64 = 50
+	// MaxBlockTransactions is the maximum number of transactions in a block, excluding the coinbase transaction.
+	MaxBlockTransactions = 100
+	// SaltSize is the minimum size in bytes of the salt the keys are derived with.
+	SaltSize = 16
+)
+
+// The scrypt parameters recommended for interactive logins, which make every guess
+// of a password cost tens of milliseconds.
+const (
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+var (
+	// ErrInvalidTransaction is returned when a transaction fails the signature, nonce or balance check.
+	ErrInvalidTransaction = errors.New("invalid transaction")
+	// ErrTransactionExists is returned when a transaction is already in the mempool.
+	ErrTransactionExists = errors.New("transaction already exists")
+)
+
+// Transaction transfers Amount coins from From to To. A transaction without
+// a sender is a coinbase transaction, which mints the block reward for the miner.
+type Transaction struct {
+	ID     string `json:"id"`
+	From   string `json:"from,omitempty"`
+	To     string `json:"to"`
+	Amount uint64 `json:"amount"`
+	// Nonce is the sequence number of the sender, which starts from 1.
+	// For coinbase transactions it is the index of the block.
+	Nonce     uint64 `json:"nonce"`
+	PublicKey string `json:"publicKey,omitempty"`
+	Signature string `json:"signature,omitempty"`
+}
+
+// NewTransaction creates a transfer transaction signed with the given private key.
+func NewTransaction(key ed25519.PrivateKey, to string, amount, nonce uint64) *Transaction {
+	pub, _ := key.Public().(ed25519.PublicKey)
+	tx := &Transaction{
+		From:      AddressFromPublicKey(pub),
+		To:        to,
+		Amount:    amount,
+		Nonce:     nonce,
+		PublicKey: hex.EncodeToString(pub),
+	}
+
+	tx.Signature = hex.EncodeToString(ed25519.Sign(key, tx.signingPayload()))
+	tx.ID = tx.CalID()
+	return tx
+}
+
+// NewCoinbaseTransaction creates the transaction which rewards the miner of the block at the given index.
+func NewCoinbaseTransaction(address string, index int64) *Transaction {
+	tx := &Transaction{To: address, Amount: BlockReward, Nonce: uint64(index)}
+	tx.ID = tx.CalID()
+	return tx
+}
+
+// AddressFromPublicKey returns the account address owned by the given public key.
+func AddressFromPublicKey(pub ed25519.PublicKey) string {
+	sum := sha256.Sum256(pub)
+	return "0x" + hex.EncodeToString(sum[len(sum)-20:])
+}
+
+// NewSalt returns a random salt to derive the key of a new account with, see KeyFromPassword.
+func NewSalt() ([]byte, error) {
+	salt := make([]byte, SaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	return salt, nil
+}
+
+// KeyFromPassword derives the private key of an account from its password and salt with scrypt.
+// The salt is chosen at random when the account is created, so that the accounts sharing a
+// password have different keys, and the passwords can not be guessed for all accounts at once.
+// The same password and salt always derive the same key, and so the same address.
+func KeyFromPassword(password string, salt []byte) (ed25519.PrivateKey, error) {
+	if len(salt) < SaltSize {
+		return nil, fmt.Errorf("salt must be at least %d bytes", SaltSize)
+	}
+
+	seed, err := scrypt.Key([]byte(password), salt, scryptN, scryptR, scryptP, ed25519.SeedSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+// AddressFromPassword returns the address of the account owned by the key derived from the password and salt.
+func AddressFromPassword(password string, salt []byte) (string, error) {
+	key, err := KeyFromPassword(password, salt)
+	if err != nil {
+		return "", err
+	}
+
+	pub, _ := key.Public().(ed25519.PublicKey)
+	return AddressFromPublicKey(pub), nil
+}
+
+// IsCoinbase reports whether the transaction mints the block reward.
+func (tx *Transaction) IsCoinbase() bool {
+	return tx.From == ""
+}
+
+func (tx *Transaction) signingPayload() []byte {
+	return []byte(fmt.Sprintf("%s%s%d%d%s", tx.From, tx.To, tx.Amount, tx.Nonce, tx.PublicKey))
+}
+
+// CalID calculates the identifier of the transaction, which covers the signature too.
+func (tx *Transaction) CalID() string {
+	return fmt.Sprintf("%x", sha256.Sum256(append(tx.signingPayload(), tx.Signature...)))
+}
+
+// Verify checks the integrity and the signature of a transfer transaction.
+// It does not check the balance and the nonce of the sender, see State.ApplyTransaction.
+func (tx *Transaction) Verify() error {
+	if tx.IsCoinbase() {
+		return fmt.Errorf("%w: unexpected coinbase transaction", ErrInvalidTransaction)
+	}
+
+	if tx.To == "" || tx.Amount == 0 {
+		return fmt.Errorf("%w: empty receiver or amount", ErrInvalidTransaction)
+	}
+
+	if tx.ID != tx.CalID() {
+		return fmt.Errorf("%w: id mismatch", ErrInvalidTransaction)
+	}
+
+	pub, err := hex.DecodeString(tx.PublicKey)
+	if err != nil || len(pub) != ed25519.PublicKeySize {
+		return fmt.Errorf("%w: malformed public key", ErrInvalidTransaction)
+	}
+
+	if !strings.EqualFold(AddressFromPublicKey(pub), tx.From) {
+		return fmt.Errorf("%w: public key does not match the sender", ErrInvalidTransaction)
+	}
+
+	sig, err := hex.DecodeString(tx.Signature)
+	if err != nil || !ed25519.Verify(pub, tx.signingPayload(), sig) {
+		return fmt.Errorf("%w: bad signature", ErrInvalidTransaction)
+	}
+
+	return nil
+}
+
+// transactionsRoot digests the transactions of a block so that they are covered by the block hash.
+func transactionsRoot(txs []*Transaction) string {
+	if len(txs) == 0 {
+		return ""
+	}
+
+	h := sha256.New()
+	for _, tx := range txs {
+		h.Write([]byte(tx.ID))
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
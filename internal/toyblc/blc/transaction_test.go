@@ -0,0 +1,143 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package blc
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"testing"
+)
+
+func newTestKey(t *testing.T) (ed25519.PrivateKey, string) {
+	t.Helper()
+
+	pub, key, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return key, AddressFromPublicKey(pub)
+}
+
+func TestTransaction_Verify(t *testing.T) {
+	key, _ := newTestKey(t)
+
+	tx := NewTransaction(key, "0xreceiver", 10, 1)
+	if err := tx.Verify(); err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+
+	tampered := *tx
+	tampered.Amount = 20
+	tampered.ID = tampered.CalID()
+	if err := tampered.Verify(); !errors.Is(err, ErrInvalidTransaction) {
+		t.Errorf("Verify() of tampered transaction error = %v, want ErrInvalidTransaction", err)
+	}
+
+	_, other := newTestKey(t)
+	forged := *tx
+	forged.From = other
+	forged.ID = forged.CalID()
+	if err := forged.Verify(); !errors.Is(err, ErrInvalidTransaction) {
+		t.Errorf("Verify() of forged sender error = %v, want ErrInvalidTransaction", err)
+	}
+}
+
+func TestKeyFromPassword(t *testing.T) {
+	salt, err := NewSalt()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	address, err := AddressFromPassword("onex(#)666", salt)
+	if err != nil {
+		t.Fatalf("AddressFromPassword() error = %v", err)
+	}
+	if again, _ := AddressFromPassword("onex(#)666", salt); address != again {
+		t.Errorf("AddressFromPassword() is not deterministic")
+	}
+	if other, _ := AddressFromPassword("onex(#)667", salt); address == other {
+		t.Errorf("AddressFromPassword() returned the same address for different passwords")
+	}
+
+	otherSalt, _ := NewSalt()
+	if other, _ := AddressFromPassword("onex(#)666", otherSalt); address == other {
+		t.Errorf("AddressFromPassword() returned the same address for different salts")
+	}
+	if _, err := KeyFromPassword("onex(#)666", salt[:SaltSize-1]); err == nil {
+		t.Errorf("KeyFromPassword() with a short salt succeeded")
+	}
+
+	key, _ := KeyFromPassword("onex(#)666", salt)
+	tx := NewTransaction(key, "0xreceiver", 10, 1)
+	if tx.From != address {
+		t.Errorf("NewTransaction() From = %s, want %s", tx.From, address)
+	}
+	if err := tx.Verify(); err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+}
+
+func TestBlockSet_Transactions(t *testing.T) {
+	key, miner := newTestKey(t)
+
+	bs, err := NewBlockSet(miner, NewMemoryStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Mine a block to earn the block reward.
+	if err := bs.Add(bs.NextBlock("reward")); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if got := bs.Account(miner).Balance; got != BlockReward {
+		t.Fatalf("miner balance = %d, want %d", got, BlockReward)
+	}
+
+	if err := bs.AddTransaction(NewTransaction(key, "0xreceiver", 30, 1)); err != nil {
+		t.Fatalf("AddTransaction() error = %v", err)
+	}
+	if err := bs.AddTransaction(NewTransaction(key, "0xreceiver", 30, 2)); !errors.Is(err, ErrInvalidTransaction) {
+		t.Errorf("AddTransaction() overspending error = %v, want ErrInvalidTransaction", err)
+	}
+	if err := bs.AddTransaction(NewTransaction(key, "0xreceiver", 10, 3)); !errors.Is(err, ErrInvalidTransaction) {
+		t.Errorf("AddTransaction() with nonce gap error = %v, want ErrInvalidTransaction", err)
+	}
+
+	if err := bs.Add(bs.NextBlock("transfer")); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	if got := bs.Account("0xreceiver").Balance; got != 30 {
+		t.Errorf("receiver balance = %d, want 30", got)
+	}
+	if got := bs.Account(miner); got.Balance != 2*BlockReward-30 || got.Nonce != 1 {
+		t.Errorf("miner account = %+v, want balance %d and nonce 1", got, 2*BlockReward-30)
+	}
+	if n := len(bs.PendingTransactions()); n != 0 {
+		t.Errorf("pending transactions = %d, want 0", n)
+	}
+	if !IsValidChain(bs.List()) {
+		t.Errorf("IsValidChain() = false, want true")
+	}
+}
+
+func TestState_ApplyBlock_Coinbase(t *testing.T) {
+	b := &Block{Index: 1, Address: "miner"}
+	b.Transactions = []*Transaction{NewCoinbaseTransaction("miner", 1)}
+	if err := NewState().ApplyBlock(b); err != nil {
+		t.Fatalf("ApplyBlock() error = %v", err)
+	}
+
+	inflated := NewCoinbaseTransaction("miner", 1)
+	inflated.Amount = BlockReward * 2
+	inflated.ID = inflated.CalID()
+	b.Transactions = []*Transaction{inflated}
+	if err := NewState().ApplyBlock(b); !errors.Is(err, ErrInvalidTransaction) {
+		t.Errorf("ApplyBlock() with inflated reward error = %v, want ErrInvalidTransaction", err)
+	}
+}
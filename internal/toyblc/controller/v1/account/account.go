@@ -0,0 +1,24 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package account
+
+import (
+	"github.com/google/wire"
+
+	"github.com/superproj/onex/internal/toyblc/blc"
+)
+
+// ProviderSet is service providers.
+var ProviderSet = wire.NewSet(New)
+
+type AccountController struct {
+	bs *blc.BlockSet
+}
+
+func New(bs *blc.BlockSet) *AccountController {
+	return &AccountController{bs: bs}
+}
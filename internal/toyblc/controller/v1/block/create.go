@@ -21,6 +21,11 @@ func (b *BlockController) Create(c *gin.Context) {
 		return
 	}
 
-	_ = miner.MinerBlock(b.bs, b.ss, r.Data)
+	// Stop mining when the client goes away, rather than holding the request goroutine.
+	if _, err := miner.MinerBlock(c.Request.Context(), b.bs, b.ss, r.Data, 0); err != nil {
+		core.WriteResponse(c, err, nil)
+		return
+	}
+
 	core.WriteResponse(c, nil, nil)
 }
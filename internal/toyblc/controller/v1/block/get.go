@@ -0,0 +1,35 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package block
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/superproj/onex/internal/pkg/core"
+	v1 "github.com/superproj/onex/pkg/api/toyblc/v1"
+)
+
+// Get returns a block by its height or its hash.
+func (b *BlockController) Get(c *gin.Context) {
+	id := c.Param("id")
+
+	block, ok := b.bs.GetByHash(id)
+	if !ok {
+		if height, err := strconv.ParseInt(id, 10, 64); err == nil {
+			block, ok = b.bs.GetByHeight(height)
+		}
+	}
+
+	if !ok {
+		core.WriteResponse(c, v1.ErrorBlockNotFound("block %s not found", id), nil)
+		return
+	}
+
+	core.WriteResponse(c, nil, block)
+}
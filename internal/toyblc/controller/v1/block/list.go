@@ -8,10 +8,43 @@ package block
 
 import (
 	"github.com/gin-gonic/gin"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
 
 	"github.com/superproj/onex/internal/pkg/core"
+	"github.com/superproj/onex/internal/toyblc/blc"
 )
 
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+// ListBlocksRequest is the query of a paginated block listing.
+type ListBlocksRequest struct {
+	Offset int64 `form:"offset"`
+	Limit  int64 `form:"limit"`
+	// Desc lists the blocks from the tip of the chain.
+	Desc bool `form:"desc"`
+}
+
+// ListBlocksResponse is a page of blocks.
+type ListBlocksResponse struct {
+	TotalCount int64        `json:"totalCount"`
+	Blocks     []*blc.Block `json:"blocks"`
+}
+
 func (b *BlockController) List(c *gin.Context) {
-	core.WriteResponse(c, nil, b.bs.List())
+	r := ListBlocksRequest{Limit: defaultListLimit}
+	if err := c.ShouldBindQuery(&r); err != nil {
+		core.WriteResponse(c, kerrors.BadRequest("InvalidParameter", err.Error()), nil)
+		return
+	}
+
+	if r.Offset < 0 || r.Limit <= 0 || r.Limit > maxListLimit {
+		core.WriteResponse(c, kerrors.BadRequest("InvalidParameter", "offset must not be negative and limit must be between 1 and 100"), nil)
+		return
+	}
+
+	blocks, total := b.bs.Range(r.Offset, r.Limit, r.Desc)
+	core.WriteResponse(c, nil, &ListBlocksResponse{TotalCount: total, Blocks: blocks})
 }
@@ -0,0 +1,49 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package block
+
+import (
+	"io"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// keepaliveInterval is the interval of the comments sent to keep idle streams open through proxies.
+const keepaliveInterval = 30 * time.Second
+
+// Watch streams the chain events as server-sent events. Every event is named after
+// its type, BlockAdded or Reorg, and carries the blc.ChainEvent as JSON data.
+func (b *BlockController) Watch(c *gin.Context) {
+	events, cancel := b.bs.Subscribe(64)
+	defer cancel()
+
+	ticker := time.NewTicker(keepaliveInterval)
+	defer ticker.Stop()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case event, ok := <-events:
+			if !ok {
+				return false
+			}
+
+			c.SSEvent(string(event.Type), event)
+		case <-ticker.C:
+			_, _ = io.WriteString(w, ": keepalive\n\n")
+		}
+
+		return true
+	})
+}
@@ -7,23 +7,17 @@
 package peer
 
 import (
-	"strings"
-
 	"github.com/gin-gonic/gin"
 
 	"github.com/superproj/onex/internal/pkg/core"
+	"github.com/superproj/onex/internal/toyblc/ws"
 )
 
 func (b *PeerController) List(c *gin.Context) {
-	var slice []string
-
-	for _, socket := range b.ss.List() {
-		if socket.IsClientConn() {
-			slice = append(slice, strings.Replace(socket.LocalAddr().String(), "ws://", "", 1))
-		} else {
-			slice = append(slice, socket.Request().RemoteAddr)
-		}
+	peers := []ws.PeerInfo{}
+	for _, p := range b.ss.List() {
+		peers = append(peers, p.Info())
 	}
 
-	core.WriteResponse(c, nil, slice)
+	core.WriteResponse(c, nil, peers)
 }
@@ -0,0 +1,51 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package transaction
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+
+	"github.com/superproj/onex/internal/pkg/core"
+	"github.com/superproj/onex/internal/toyblc/blc"
+	v1 "github.com/superproj/onex/pkg/api/toyblc/v1"
+)
+
+func (t *TransactionController) Create(c *gin.Context) {
+	var r v1.CreateTransactionRequest
+	if err := c.ShouldBindJSON(&r); err != nil {
+		core.WriteResponse(c, err, nil)
+		return
+	}
+
+	tx := &blc.Transaction{
+		From:      r.From,
+		To:        r.To,
+		Amount:    r.Amount,
+		Nonce:     r.Nonce,
+		PublicKey: r.PublicKey,
+		Signature: r.Signature,
+	}
+	tx.ID = tx.CalID()
+
+	if err := t.bs.AddTransaction(tx); err != nil {
+		switch {
+		case errors.Is(err, blc.ErrTransactionExists):
+			core.WriteResponse(c, v1.ErrorTransactionAlreadyExists("transaction %s already exists", tx.ID), nil)
+		case errors.Is(err, blc.ErrInvalidTransaction):
+			core.WriteResponse(c, v1.ErrorTransactionInvalid(err.Error()), nil)
+		default:
+			core.WriteResponse(c, kerrors.ServiceUnavailable("MempoolUnavailable", err.Error()), nil)
+		}
+		return
+	}
+
+	t.ss.Broadcast(blc.TransactionMessage(tx))
+	core.WriteResponse(c, nil, tx)
+}
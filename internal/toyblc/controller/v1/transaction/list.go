@@ -0,0 +1,18 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package transaction
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/superproj/onex/internal/pkg/core"
+)
+
+// List returns the pending transactions in the mempool.
+func (t *TransactionController) List(c *gin.Context) {
+	core.WriteResponse(c, nil, t.bs.PendingTransactions())
+}
@@ -7,6 +7,8 @@
 package miner
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -15,31 +17,80 @@ import (
 	"github.com/superproj/onex/pkg/log"
 )
 
+// checkTipInterval is the number of nonces tried before checking whether the tip of the chain
+// changed or the mining is canceled.
+const checkTipInterval = 1 << 14
+
+// ErrStaleTip is returned when another block is appended to the chain while mining.
+var ErrStaleTip = errors.New("the tip of the chain changed while mining")
+
 type Miner struct {
 	bs              *blc.BlockSet
 	ss              *ws.Sockets
 	minMineInterval time.Duration
+	difficulty      int
 }
 
-func NewMiner(bs *blc.BlockSet, ss *ws.Sockets, minMineInterval time.Duration) *Miner {
-	return &Miner{bs: bs, ss: ss, minMineInterval: minMineInterval}
+func NewMiner(bs *blc.BlockSet, ss *ws.Sockets, minMineInterval time.Duration, difficulty int) *Miner {
+	return &Miner{bs: bs, ss: ss, minMineInterval: minMineInterval, difficulty: difficulty}
 }
 
 func (m *Miner) Start() {
 	go func() {
 		for {
 			time.Sleep(interval(m.minMineInterval))
-			block := MinerBlock(m.bs, m.ss, fmt.Sprintf("miner at %s", time.Now().Format("2006-01-02 15:04:05.000")))
-			log.Debugw("Mine a block", "index", block.Index)
+			data := fmt.Sprintf("miner at %s", time.Now().Format("2006-01-02 15:04:05.000"))
+			block, err := MinerBlock(context.Background(), m.bs, m.ss, data, m.difficulty)
+			if err != nil {
+				log.Errorw(err, "Failed to mine a block")
+				continue
+			}
+			log.Debugw("Mine a block", "index", block.Index, "difficulty", block.Difficulty, "nonce", block.Nonce)
 		}
 	}()
 }
 
-func MinerBlock(bs *blc.BlockSet, ss *ws.Sockets, data string) *blc.Block {
+// MinerBlock mines a block with the given data, appends it to the chain and broadcasts it to peers.
+// The block is mined with the larger one of difficulty and the difficulty required by the chain.
+// Mining stops with the error of ctx when ctx is done.
+func MinerBlock(ctx context.Context, bs *blc.BlockSet, ss *ws.Sockets, data string, difficulty int) (*blc.Block, error) {
 	block := bs.NextBlock(data)
-	bs.Add(block)
+	if difficulty > block.Difficulty {
+		block.Difficulty = difficulty
+	}
+
+	if err := Mine(ctx, bs, block); err != nil {
+		return nil, err
+	}
+
+	if err := bs.Add(block); err != nil {
+		return nil, err
+	}
+
 	ss.Broadcast(bs.LatestMessage())
-	return block
+	return block, nil
+}
+
+// Mine searches for a nonce which makes the hash of the block meet its difficulty.
+// It gives up with ErrStaleTip when the block no longer extends the tip of the chain, and
+// with the error of ctx when ctx is done.
+func Mine(ctx context.Context, bs *blc.BlockSet, b *blc.Block) error {
+	for nonce := int64(0); ; nonce++ {
+		if nonce%checkTipInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if bs.Latest().Hash != b.PreviousHash {
+				return ErrStaleTip
+			}
+		}
+
+		b.Nonce = nonce
+		b.Hash = b.CalHash()
+		if blc.HashMatchesDifficulty(b.Hash, b.Difficulty) {
+			return nil
+		}
+	}
 }
 
 func interval(minMineInterval time.Duration) time.Duration {
@@ -0,0 +1,32 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package miner
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/superproj/onex/internal/toyblc/blc"
+)
+
+func TestMine_Canceled(t *testing.T) {
+	bs, err := blc.NewBlockSet("test", blc.NewMemoryStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// No hash has 256 leading zero bits, so only the cancellation can stop the mining.
+	b := bs.NextBlock("canceled")
+	b.Difficulty = 256
+	if err := Mine(ctx, bs, b); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Mine() error = %v, want %v", err, context.Canceled)
+	}
+}
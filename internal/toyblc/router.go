@@ -12,8 +12,10 @@ import (
 
 	"github.com/superproj/onex/internal/pkg/core"
 	"github.com/superproj/onex/internal/toyblc/blc"
+	"github.com/superproj/onex/internal/toyblc/controller/v1/account"
 	"github.com/superproj/onex/internal/toyblc/controller/v1/block"
 	"github.com/superproj/onex/internal/toyblc/controller/v1/peer"
+	"github.com/superproj/onex/internal/toyblc/controller/v1/transaction"
 	mw "github.com/superproj/onex/internal/toyblc/middleware"
 	"github.com/superproj/onex/internal/toyblc/ws"
 	v1 "github.com/superproj/onex/pkg/api/toyblc/v1"
@@ -30,6 +32,8 @@ func installRouters(g *gin.Engine, bs *blc.BlockSet, ss *ws.Sockets, accounts ma
 
 	bc := block.New(bs, ss)
 	pc := peer.New(bs, ss)
+	tc := transaction.New(bs, ss)
+	ac := account.New(bs)
 
 	// 创建 v1 路由分组，并添加认证中间件
 	v1 := g.Group("/v1", mw.BasicAuth(accounts))
@@ -39,6 +43,9 @@ func installRouters(g *gin.Engine, bs *blc.BlockSet, ss *ws.Sockets, accounts ma
 		{
 			userv1.POST("", bc.Create)
 			userv1.GET("", bc.List)
+			userv1.GET("/stats", bc.Stats)
+			userv1.GET("/watch", bc.Watch)
+			userv1.GET("/:id", bc.Get)
 		}
 
 		// 创建 peers 路由分组
@@ -47,5 +54,18 @@ func installRouters(g *gin.Engine, bs *blc.BlockSet, ss *ws.Sockets, accounts ma
 			postv1.POST("", pc.Create)
 			postv1.GET("", pc.List)
 		}
+
+		// 创建 transactions 路由分组
+		txv1 := v1.Group("/transactions")
+		{
+			txv1.POST("", tc.Create)
+			txv1.GET("", tc.List)
+		}
+
+		// 创建 accounts 路由分组
+		accountv1 := v1.Group("/accounts")
+		{
+			accountv1.GET("/:address", ac.Get)
+		}
 	}
 }
@@ -26,14 +26,18 @@ import (
 
 // Config represents the configuration of the service.
 type Config struct {
-	Miner           bool
-	MinMineInterval time.Duration
-	Address         string
-	Accounts        map[string]string
-	HTTPOptions     *genericoptions.HTTPOptions
-	TLSOptions      *genericoptions.TLSOptions
-	P2PAddr         string
-	Peers           []string
+	Miner            bool
+	MinMineInterval  time.Duration
+	MiningDifficulty int
+	Address          string
+	DataDir          string
+	Accounts         map[string]string
+	HTTPOptions      *genericoptions.HTTPOptions
+	TLSOptions       *genericoptions.TLSOptions
+	P2PAddr          string
+	Peers            []string
+	// P2P is the configuration of the local P2P node.
+	P2P ws.Config
 }
 
 // Complete fills in any fields not set that are required to have valid data. It's mutating the receiver.
@@ -47,7 +51,19 @@ type completedConfig struct {
 
 // New returns a new instance of ToyBLC from the given config.
 func (c completedConfig) New() (*ToyBLC, error) {
-	bs, ss := blc.NewBlockSet(c.Address), ws.NewSockets()
+	store := blc.NewMemoryStore()
+	if c.DataDir != "" {
+		var err error
+		if store, err = blc.NewFileStore(c.DataDir); err != nil {
+			return nil, err
+		}
+	}
+
+	bs, err := blc.NewBlockSet(c.Address, store)
+	if err != nil {
+		return nil, err
+	}
+	ss := ws.NewSockets(c.P2P)
 
 	// gin.Recovery() 中间件，用来捕获任何 panic，并恢复
 	mws := []gin.HandlerFunc{gin.Recovery(), mw.NoCache, mw.Cors, mw.Secure, mw.TraceID()}
@@ -81,32 +97,34 @@ func (c completedConfig) New() (*ToyBLC, error) {
 
 	p2psrv := &http.Server{Addr: c.P2PAddr, Handler: p2p}
 	return &ToyBLC{
-		config:          c,
-		srv:             httpsrv,
-		p2psrv:          p2psrv,
-		bs:              bs,
-		ss:              ss,
-		miner:           c.Miner,
-		minMineInterval: c.MinMineInterval,
-		peers:           c.Peers,
+		config:           c,
+		srv:              httpsrv,
+		p2psrv:           p2psrv,
+		bs:               bs,
+		ss:               ss,
+		miner:            c.Miner,
+		minMineInterval:  c.MinMineInterval,
+		miningDifficulty: c.MiningDifficulty,
+		peers:            c.Peers,
 	}, nil
 }
 
 // ToyBLC represents the toyblc application.
 type ToyBLC struct {
-	config          completedConfig
-	srv             *http.Server
-	p2psrv          *http.Server
-	bs              *blc.BlockSet
-	ss              *ws.Sockets
-	miner           bool
-	minMineInterval time.Duration
-	peers           []string
+	config           completedConfig
+	srv              *http.Server
+	p2psrv           *http.Server
+	bs               *blc.BlockSet
+	ss               *ws.Sockets
+	miner            bool
+	minMineInterval  time.Duration
+	miningDifficulty int
+	peers            []string
 }
 
 func (t *ToyBLC) Run(stopCh <-chan struct{}) error {
 	if t.miner {
-		miner.NewMiner(t.bs, t.ss, t.minMineInterval).Start()
+		miner.NewMiner(t.bs, t.ss, t.minMineInterval, t.miningDifficulty).Start()
 	}
 
 	// 运行 HTTP 服务器。在 goroutine 中启动服务器，它不会阻止下面的正常关闭处理流程
@@ -129,10 +147,16 @@ func (t *ToyBLC) Run(stopCh <-chan struct{}) error {
 		}
 	}()
 
-	ws.ConnectToPeers(context.Background(), t.bs, t.ss, t.peers)
+	p2pCtx, stopP2P := context.WithCancel(context.Background())
+	defer stopP2P()
+
+	go t.ss.Heartbeat(p2pCtx)
+	go t.ss.Connector(p2pCtx, t.bs)
+	ws.ConnectToPeers(p2pCtx, t.bs, t.ss, t.peers)
 
 	<-stopCh
 	log.Infow("Shutting down server ...")
+	stopP2P()
 
 	// 创建 ctx 用于通知服务器 goroutine, 它有 10 秒时间完成当前正在处理的请求
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -149,6 +173,11 @@ func (t *ToyBLC) Run(stopCh <-chan struct{}) error {
 		return err
 	}
 
+	if err := t.bs.Close(); err != nil {
+		log.Errorw(err, "Failed to close block store")
+		return err
+	}
+
 	log.Infow("Server exiting")
 	return nil
 }
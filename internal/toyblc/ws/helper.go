@@ -27,97 +27,188 @@ func (b ByIndex) Len() int           { return len(b) }
 func (b ByIndex) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
 func (b ByIndex) Less(i, j int) bool { return b[i].Index < b[j].Index }
 
+// ConnectToPeers dials the given peers, skipping the ones already connected or banned.
 func ConnectToPeers(ctx context.Context, bs *blc.BlockSet, ss *Sockets, peers []string) {
 	for _, peer := range peers {
-		if peer == "" {
+		if peer == "" || peer == ss.cfg.ListenAddr || ss.Connected(peer) {
 			continue
 		}
 
+		if ss.IsBanned(hostOf(peer)) {
+			log.C(ctx).Warnw("Skip banned peer", "peer", peer)
+			continue
+		}
+
+		if ss.Len() >= ss.cfg.MaxPeers {
+			log.C(ctx).Warnw("Too many peers, skip dialing", "peer", peer)
+			return
+		}
+
 		ws, err := websocket.Dial(peer, "", peer)
 		if err != nil {
 			log.C(ctx).Errorw(err, "Dial to peer", "peer", peer)
 			continue
 		}
 
-		go WSHandler(bs, ss, ws)
-
-		log.C(ctx).Debugw("Query latest block")
-		ws.Write(bs.LatestMessage())
+		go handlePeer(bs, ss, newPeer(ws, peer))
 	}
 }
 
+// WSHandler serves an inbound P2P connection.
 func WSHandler(bs *blc.BlockSet, ss *Sockets, ws *websocket.Conn) {
-	var (
-		resp = &blc.ResponseBlockchain{}
-		peer = ws.LocalAddr().String()
-	)
+	handlePeer(bs, ss, newPeer(ws, ""))
+}
 
-	ss.Add(ws)
+func handlePeer(bs *blc.BlockSet, ss *Sockets, p *Peer) {
+	if !ss.Add(p) {
+		log.Warnw("Refuse P2P peer, too many peers or banned", "peer", p.Addr())
+		_ = p.conn.Close()
+		return
+	}
+	defer ss.Remove(p)
+
+	if err := p.Send(handshakeMsg(bs, ss)); err != nil {
+		log.Errorw(err, "Failed to send handshake", "peer", p.Addr())
+		return
+	}
 
 	for {
 		var msg []byte
-		if err := websocket.Message.Receive(ws, &msg); err != nil {
+		if err := websocket.Message.Receive(p.conn, &msg); err != nil {
 			if errors.Is(err, io.EOF) {
-				log.Warnw("P2P peer shutdown, remove it from the peers pool", "peer", peer)
+				log.Warnw("P2P peer shutdown, remove it from the peers pool", "peer", p.Addr())
 				break
 			}
 
-			log.Errorw(err, "Unable to receive P2P message from", "peer", peer)
+			log.Errorw(err, "Unable to receive P2P message from", "peer", p.Addr())
 			break
 		}
 
-		log.Debugw("Received message", "peer", peer, "message", msg)
+		p.touch()
+		log.Debugw("Received message", "peer", p.Addr(), "message", msg)
+
+		resp := &blc.ResponseBlockchain{}
 		if err := json.Unmarshal(msg, resp); err != nil {
-			log.Warnw("Invalid P2P message", "err", err)
+			ss.Penalize(p, penaltyInvalidMessage, "malformed message")
+			continue
+		}
+
+		if !p.Ready() && resp.Type != blc.HandshakeAction {
+			ss.Penalize(p, penaltyInvalidMessage, "message before handshake")
+			continue
 		}
 
 		switch resp.Type {
-		case blc.QueryLatestAction:
-			resp.Type = blc.ResponseAction
+		case blc.HandshakeAction:
+			if err := responseHandshake(bs, ss, p, resp.Data); err != nil {
+				log.Warnw("P2P handshake failed, disconnect", "peer", p.Addr(), "err", err)
+				return
+			}
 
+		case blc.QueryLatestAction:
 			message := bs.LatestMessage()
 			log.Debugw("Responding with the latest message", "message", message)
-			ws.Write(message)
+			p.Send(message)
 
 		case blc.QueryAllAction:
 			resp.Type = blc.ResponseAction
 			resp.Data, _ = bs.MarshalJSON()
 			data, _ := json.Marshal(resp)
 			log.Debugw("Responding with the chain message", "message", data)
-			ws.Write(data)
+			p.Send(data)
 
 		case blc.ResponseAction:
-			ResponseBlockchain(bs, ss, resp.Data)
+			ResponseBlockchain(bs, ss, p, resp.Data)
+
+		case blc.TransactionAction:
+			ResponseTransactions(bs, ss, p, resp.Data)
+
+		case blc.GetPeersAction:
+			p.Send(peersMsg(ss, p))
+
+		case blc.PeersAction:
+			responsePeers(ss, p, resp.Data)
+
+		case blc.PingAction:
+			p.Send(newMessage(blc.PongAction, nil))
+
+		case blc.PongAction:
+			// The peer is alive, which is already recorded by p.touch().
+
+		default:
+			ss.Penalize(p, penaltyInvalidMessage, fmt.Sprintf("unknown message type %d", resp.Type))
 		}
 	}
 }
 
-func ResponseBlockchain(bs *blc.BlockSet, ss *Sockets, msg []byte) {
+func ResponseBlockchain(bs *blc.BlockSet, ss *Sockets, p *Peer, msg []byte) {
 	receivedBlocks := []*blc.Block{}
 
-	if err := json.Unmarshal(msg, &receivedBlocks); err != nil {
-		log.Warnw("Invalid blockchain", "err", err)
+	if err := json.Unmarshal(msg, &receivedBlocks); err != nil || len(receivedBlocks) == 0 {
+		ss.Penalize(p, penaltyInvalidMessage, "malformed blockchain")
+		return
 	}
 
 	sort.Sort(ByIndex(receivedBlocks))
 
 	latestBlockReceived := receivedBlocks[len(receivedBlocks)-1]
+	p.setHeight(latestBlockReceived.Index)
 	latestBlockHeld := bs.Latest()
-	if latestBlockReceived.Index <= latestBlockHeld.Index {
-		log.Infow("Received blockchain is not longer than the current blockchain. No action needed")
+	if latestBlockReceived.Hash == latestBlockHeld.Hash {
+		log.Debugw("Received blockchain has the same tip as the current blockchain. No action needed")
 		return
 	}
 
-	log.Warnf("Blockchain may be behind. We have: %d Peer has: %d", latestBlockHeld.Index, latestBlockReceived.Index)
 	if latestBlockHeld.Hash == latestBlockReceived.PreviousHash {
 		log.Infof("We can append the received block to our chain")
-		bs.Add(latestBlockReceived)
-	} else if len(receivedBlocks) == 1 {
-		log.Infow("We need to query the chain from our peer")
-		ss.Broadcast(queryAllMsg())
-	} else {
-		log.Infow("Received blockchain is longer than the current blockchain")
-		replaceBlocks(receivedBlocks, bs, ss)
+		if err := bs.Add(latestBlockReceived); err != nil {
+			log.Errorw(err, "Failed to append the received block", "index", latestBlockReceived.Index)
+			if errors.Is(err, blc.ErrInvalidBlock) {
+				ss.Penalize(p, penaltyInvalidBlock, "invalid block")
+			}
+			return
+		}
+
+		ss.Broadcast(bs.LatestMessage())
+		return
+	}
+
+	if len(receivedBlocks) == 1 {
+		// A single block tells nothing about the work of the peer's chain, so only
+		// query the full chain when the peer may be ahead of us.
+		if latestBlockReceived.Index < latestBlockHeld.Index {
+			log.Debugw("Received block is behind the current blockchain. No action needed")
+			return
+		}
+
+		log.Infow("We need to query the chain from our peer", "held", latestBlockHeld.Index, "received", latestBlockReceived.Index)
+		p.Send(queryAllMsg())
+		return
+	}
+
+	replaceBlocks(receivedBlocks, bs, ss, p)
+}
+
+// ResponseTransactions adds the received transactions to the mempool and
+// relays the ones which are new to this node.
+func ResponseTransactions(bs *blc.BlockSet, ss *Sockets, p *Peer, msg []byte) {
+	txs := []*blc.Transaction{}
+	if err := json.Unmarshal(msg, &txs); err != nil {
+		ss.Penalize(p, penaltyInvalidMessage, "malformed transactions")
+		return
+	}
+
+	for _, tx := range txs {
+		if err := bs.AddTransaction(tx); err != nil {
+			if errors.Is(err, blc.ErrInvalidTransaction) {
+				// Gossip races with mining, so a transaction may turn invalid in flight: keep the penalty low.
+				ss.Penalize(p, penaltyInvalidTransaction, "invalid transaction")
+			}
+			continue
+		}
+
+		log.Debugw("Relay transaction", "id", tx.ID)
+		ss.Broadcast(blc.TransactionMessage(tx))
 	}
 }
 
@@ -125,13 +216,20 @@ func queryAllMsg() []byte {
 	return []byte(fmt.Sprintf("{\"type\": %d}", blc.QueryAllAction))
 }
 
-func replaceBlocks(src []*blc.Block, dst *blc.BlockSet, ss *Sockets) {
-	if !blc.IsValidChain(src) || len(src) <= dst.Len() {
-		log.Errorf("Received blockchain is invalid")
+func replaceBlocks(src []*blc.Block, dst *blc.BlockSet, ss *Sockets, p *Peer) {
+	if err := dst.ReplaceChain(src); err != nil {
+		if errors.Is(err, blc.ErrInsufficientWork) {
+			log.Infow("Received blockchain does not have more work than the current blockchain. No action needed")
+			return
+		}
+
+		log.Errorw(err, "Failed to replace the current blockchain")
+		if errors.Is(err, blc.ErrInvalidBlock) {
+			ss.Penalize(p, penaltyInvalidChain, "invalid blockchain")
+		}
 		return
 	}
 
-	log.Debugw("Received blockchain is valid. Replacing the current blockchain with the received blockchain. Peer disconnected")
-	dst.SetBlocks(src)
+	log.Infow("Replaced the current blockchain with the received blockchain", "height", dst.Latest().Index)
 	ss.Broadcast(dst.LatestMessage())
 }
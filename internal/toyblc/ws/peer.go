@@ -0,0 +1,189 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package ws
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+// Peer scoring. Every peer starts with a zero score, misbehaviours lower it and
+// the peer is banned once it drops to banScore.
+const (
+	banScore = -100
+
+	penaltyInvalidMessage     = 10
+	penaltyInvalidTransaction = 2
+	penaltyInvalidBlock       = 25
+	penaltyInvalidChain       = 50
+)
+
+// writeTimeout bounds the time spent writing a message to a peer, so a stuck
+// peer can not block the broadcast to the others.
+const writeTimeout = 10 * time.Second
+
+// Peer is a websocket connection to another node.
+type Peer struct {
+	conn     *websocket.Conn
+	outbound bool
+	// dialAddr is the address dialed for outbound connections.
+	dialAddr    string
+	connectedAt time.Time
+
+	// wmu serializes the writes to the connection.
+	wmu sync.Mutex
+
+	mu         sync.Mutex
+	ready      bool
+	listenAddr string
+	height     int64
+	lastSeen   time.Time
+	score      int
+}
+
+// PeerInfo is the public view of a peer.
+type PeerInfo struct {
+	Addr     string    `json:"addr"`
+	Outbound bool      `json:"outbound"`
+	Ready    bool      `json:"ready"`
+	Height   int64     `json:"height"`
+	Score    int       `json:"score"`
+	LastSeen time.Time `json:"lastSeen"`
+}
+
+func newPeer(conn *websocket.Conn, dialAddr string) *Peer {
+	now := time.Now()
+	return &Peer{
+		conn:        conn,
+		outbound:    dialAddr != "",
+		dialAddr:    dialAddr,
+		connectedAt: now,
+		lastSeen:    now,
+	}
+}
+
+// Send writes the message to the peer.
+func (p *Peer) Send(msg []byte) error {
+	p.wmu.Lock()
+	defer p.wmu.Unlock()
+
+	_ = p.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	_, err := p.conn.Write(msg)
+	return err
+}
+
+// Addr returns the address of the peer. It is the websocket address advertised
+// by the peer if any, otherwise the remote address of the connection.
+func (p *Peer) Addr() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.addr()
+}
+
+func (p *Peer) addr() string {
+	switch {
+	case p.listenAddr != "":
+		return p.listenAddr
+	case p.outbound:
+		return p.dialAddr
+	default:
+		return p.conn.Request().RemoteAddr
+	}
+}
+
+// dialableAddr returns the address other nodes can dial to reach the peer, if known.
+func (p *Peer) dialableAddr() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.listenAddr != "" {
+		return p.listenAddr
+	}
+
+	return p.dialAddr
+}
+
+// Host returns the host of the peer, which is the unit of banning.
+func (p *Peer) Host() string {
+	if p.outbound {
+		return hostOf(p.dialAddr)
+	}
+
+	return hostOf(p.conn.Request().RemoteAddr)
+}
+
+// Ready reports whether the peer completed the handshake.
+func (p *Peer) Ready() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.ready
+}
+
+// Info returns the public view of the peer.
+func (p *Peer) Info() PeerInfo {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return PeerInfo{
+		Addr:     p.addr(),
+		Outbound: p.outbound,
+		Ready:    p.ready,
+		Height:   p.height,
+		Score:    p.score,
+		LastSeen: p.lastSeen,
+	}
+}
+
+func (p *Peer) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.Info())
+}
+
+func (p *Peer) setReady(h *Handshake) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.ready = true
+	p.listenAddr = h.ListenAddr
+	p.height = h.Height
+}
+
+func (p *Peer) setHeight(height int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if height > p.height {
+		p.height = height
+	}
+}
+
+func (p *Peer) touch() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.lastSeen = time.Now()
+}
+
+// idle returns how long the peer has been silent.
+func (p *Peer) idle() time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return time.Since(p.lastSeen)
+}
+
+func (p *Peer) addScore(delta int) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.score += delta
+	return p.score
+}
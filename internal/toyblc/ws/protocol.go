@@ -0,0 +1,229 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+//nolint:errchkjson
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/superproj/onex/internal/toyblc/blc"
+	"github.com/superproj/onex/pkg/log"
+)
+
+// ProtocolVersion is the version of the P2P protocol. Peers speaking another version are disconnected.
+const ProtocolVersion = 1
+
+// maxPeersPerMessage is the maximum number of addresses in a peer exchange message.
+const maxPeersPerMessage = 100
+
+// Handshake is the first message sent by both sides of a P2P connection.
+type Handshake struct {
+	Version     int    `json:"version"`
+	ChainID     string `json:"chainID"`
+	GenesisHash string `json:"genesisHash"`
+	Height      int64  `json:"height"`
+	// ListenAddr is the websocket address other nodes can dial to reach the sender.
+	ListenAddr string `json:"listenAddr,omitempty"`
+}
+
+func newMessage(action blc.Action, v any) []byte {
+	resp := &blc.ResponseBlockchain{Type: action}
+	if v != nil {
+		resp.Data, _ = json.Marshal(v)
+	}
+
+	data, _ := json.Marshal(resp)
+	return data
+}
+
+func handshakeMsg(bs *blc.BlockSet, ss *Sockets) []byte {
+	return newMessage(blc.HandshakeAction, &Handshake{
+		Version:     ProtocolVersion,
+		ChainID:     ss.cfg.ChainID,
+		GenesisHash: bs.Genesis().Hash,
+		Height:      bs.Latest().Index,
+		ListenAddr:  ss.cfg.ListenAddr,
+	})
+}
+
+// checkHandshake verifies that the peer runs the same protocol version on the same chain.
+func checkHandshake(bs *blc.BlockSet, ss *Sockets, h *Handshake) error {
+	switch {
+	case h.Version != ProtocolVersion:
+		return fmt.Errorf("unsupported protocol version %d, want %d", h.Version, ProtocolVersion)
+	case h.ChainID != ss.cfg.ChainID:
+		return fmt.Errorf("chain id %q does not match %q", h.ChainID, ss.cfg.ChainID)
+	case h.GenesisHash != bs.Genesis().Hash:
+		return fmt.Errorf("genesis block %s does not match", h.GenesisHash)
+	}
+
+	if h.ListenAddr != "" {
+		if _, err := parseWSURL(h.ListenAddr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// responseHandshake completes the handshake, then asks the peer for its tip and its peers.
+func responseHandshake(bs *blc.BlockSet, ss *Sockets, p *Peer, msg []byte) error {
+	if p.Ready() {
+		ss.Penalize(p, penaltyInvalidMessage, "duplicated handshake")
+		return nil
+	}
+
+	h := &Handshake{}
+	if err := json.Unmarshal(msg, h); err != nil {
+		return fmt.Errorf("invalid handshake: %w", err)
+	}
+
+	if err := checkHandshake(bs, ss, h); err != nil {
+		return err
+	}
+
+	p.setReady(h)
+	log.Infow("P2P handshake completed", "peer", p.Addr(), "height", h.Height)
+
+	if err := p.Send(bs.LatestMessage()); err != nil {
+		return err
+	}
+
+	return p.Send(newMessage(blc.GetPeersAction, nil))
+}
+
+// peersMsg returns the dialable addresses of the ready peers, except the requester.
+func peersMsg(ss *Sockets, requester *Peer) []byte {
+	addrs := []string{}
+	for _, p := range ss.List() {
+		if p == requester || !p.Ready() {
+			continue
+		}
+
+		if addr := p.dialableAddr(); addr != "" {
+			addrs = append(addrs, addr)
+		}
+
+		if len(addrs) == maxPeersPerMessage {
+			break
+		}
+	}
+
+	return newMessage(blc.PeersAction, addrs)
+}
+
+// responsePeers queues the peers learned from the peer exchange for the connector.
+func responsePeers(ss *Sockets, p *Peer, msg []byte) {
+	addrs := []string{}
+	if err := json.Unmarshal(msg, &addrs); err != nil || len(addrs) > maxPeersPerMessage {
+		ss.Penalize(p, penaltyInvalidMessage, "invalid peers message")
+		return
+	}
+
+	for _, addr := range addrs {
+		if _, err := parseWSURL(addr); err != nil {
+			continue
+		}
+
+		ss.enqueueDial(addr)
+	}
+}
+
+// enqueueDial queues the address for the connector. Self, connected and already queued
+// addresses are skipped, and the address is dropped when the queue is full, the peer
+// exchange being repeated on every new connection.
+func (ss *Sockets) enqueueDial(addr string) bool {
+	if addr == "" || addr == ss.cfg.ListenAddr || ss.Connected(addr) {
+		return false
+	}
+
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	if _, ok := ss.dialing[addr]; ok {
+		return false
+	}
+
+	select {
+	case ss.dials <- addr:
+		ss.dialing[addr] = struct{}{}
+		return true
+	default:
+		log.Debugw("Too many pending dials, drop peer", "peer", addr)
+		return false
+	}
+}
+
+// Connector dials the queued peer addresses one at a time until ctx is done.
+func (ss *Sockets) Connector(ctx context.Context, bs *blc.BlockSet) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case addr := <-ss.dials:
+			ConnectToPeers(ctx, bs, ss, []string{addr})
+
+			ss.mu.Lock()
+			delete(ss.dialing, addr)
+			ss.mu.Unlock()
+		}
+	}
+}
+
+// Heartbeat pings the peers every heartbeat interval until ctx is done. Peers which
+// did not complete the handshake within an interval, or stayed silent for three
+// intervals, are evicted.
+func (ss *Sockets) Heartbeat(ctx context.Context) {
+	ticker := time.NewTicker(ss.cfg.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			ss.heartbeat()
+		}
+	}
+}
+
+func (ss *Sockets) heartbeat() {
+	ping := newMessage(blc.PingAction, nil)
+	for _, p := range ss.List() {
+		switch {
+		case !p.Ready() && time.Since(p.connectedAt) > ss.cfg.HeartbeatInterval:
+			log.Warnw("Evict peer which did not complete the handshake", "peer", p.Addr())
+			ss.Remove(p)
+		case p.idle() > 3*ss.cfg.HeartbeatInterval:
+			log.Warnw("Evict unresponsive peer", "peer", p.Addr(), "idle", p.idle())
+			ss.Remove(p)
+		case p.Ready():
+			if err := p.Send(ping); err != nil {
+				log.Warnw("Peer disconnected", "peer", p.Addr(), "err", err)
+				ss.Remove(p)
+			}
+		}
+	}
+}
+
+func parseWSURL(addr string) (*url.URL, error) {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if (u.Scheme != "ws" && u.Scheme != "wss") || u.Host == "" {
+		return nil, errors.New("peer address must be a ws:// or wss:// url")
+	}
+
+	return u, nil
+}
@@ -9,6 +9,9 @@ package ws
 
 import (
 	"encoding/json"
+	"net"
+	"sync"
+	"time"
 
 	"github.com/google/wire"
 	"golang.org/x/net/websocket"
@@ -16,32 +19,202 @@ import (
 	"github.com/superproj/onex/pkg/log"
 )
 
-type Sockets []*websocket.Conn
+const (
+	// DefaultChainID is the chain id used when none is configured.
+	DefaultChainID = "toyblc"
+	// DefaultMaxPeers is the default maximum number of connected peers.
+	DefaultMaxPeers = 25
+	// DefaultHeartbeatInterval is the default interval between two pings sent to a peer.
+	DefaultHeartbeatInterval = 30 * time.Second
+	// banDuration is how long a peer is refused after its score drops to banScore.
+	banDuration = time.Hour
+	// maxPendingDials is the maximum number of addresses waiting to be dialed by the connector.
+	maxPendingDials = 64
+)
 
 var ProviderSet = wire.NewSet(NewSockets)
 
-func NewSockets() *Sockets {
-	return new(Sockets)
+// Config is the configuration of the local P2P node.
+type Config struct {
+	// ChainID identifies the chain, peers on another chain are disconnected.
+	ChainID string
+	// ListenAddr is the websocket address advertised to peers, e.g. ws://10.0.0.1:6001.
+	// The node is not advertised by peer exchange when it is empty.
+	ListenAddr string
+	// MaxPeers is the maximum number of connected peers.
+	MaxPeers int
+	// HeartbeatInterval is the interval between two pings, peers silent for
+	// three intervals are evicted.
+	HeartbeatInterval time.Duration
+}
+
+// Sockets is the set of connected peers. It is safe for concurrent use.
+type Sockets struct {
+	cfg Config
+
+	mu    sync.RWMutex
+	peers map[*websocket.Conn]*Peer
+	// bans records the hosts banned for misbehaving, until the given time.
+	bans map[string]time.Time
+	// dials queues the addresses for the connector, and dialing records the queued
+	// addresses so that each of them is dialed once.
+	dials   chan string
+	dialing map[string]struct{}
+}
+
+// NewSockets creates an empty peer set. Zero fields of cfg are set to the defaults.
+func NewSockets(cfg Config) *Sockets {
+	if cfg.ChainID == "" {
+		cfg.ChainID = DefaultChainID
+	}
+	if cfg.MaxPeers <= 0 {
+		cfg.MaxPeers = DefaultMaxPeers
+	}
+	if cfg.HeartbeatInterval <= 0 {
+		cfg.HeartbeatInterval = DefaultHeartbeatInterval
+	}
+
+	return &Sockets{
+		cfg:     cfg,
+		peers:   make(map[*websocket.Conn]*Peer),
+		bans:    make(map[string]time.Time),
+		dials:   make(chan string, maxPendingDials),
+		dialing: make(map[string]struct{}),
+	}
 }
 
 func (ss *Sockets) String() string {
-	data, _ := json.Marshal(ss)
+	data, _ := json.Marshal(ss.List())
 	return string(data)
 }
 
+// Broadcast sends the message to every peer which completed the handshake.
+// Peers which can not be written to are evicted.
 func (ss *Sockets) Broadcast(msg []byte) {
-	for n, socket := range *ss {
-		if _, err := socket.Write(msg); err != nil {
-			log.Warnw("Peer disconnected", "peer", socket.RemoteAddr().String())
-			*ss = append((*ss)[0:n], (*ss)[n+1:]...)
+	for _, p := range ss.List() {
+		if !p.Ready() {
+			continue
+		}
+
+		if err := p.Send(msg); err != nil {
+			log.Warnw("Peer disconnected", "peer", p.Addr(), "err", err)
+			ss.Remove(p)
 		}
 	}
 }
 
-func (ss *Sockets) List() []*websocket.Conn {
-	return []*websocket.Conn(*ss)
+// List returns the connected peers.
+func (ss *Sockets) List() []*Peer {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+
+	peers := make([]*Peer, 0, len(ss.peers))
+	for _, p := range ss.peers {
+		peers = append(peers, p)
+	}
+
+	return peers
+}
+
+// Len returns the number of connected peers.
+func (ss *Sockets) Len() int {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+
+	return len(ss.peers)
+}
+
+// Add registers the connection as a peer. It returns false if the peer set is
+// full or the remote host is banned, in which case the caller must close the connection.
+func (ss *Sockets) Add(p *Peer) bool {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	if len(ss.peers) >= ss.cfg.MaxPeers || ss.isBanned(p.Host()) {
+		return false
+	}
+
+	ss.peers[p.conn] = p
+	return true
+}
+
+// Remove closes the connection of the peer and removes it from the peer set.
+func (ss *Sockets) Remove(p *Peer) {
+	ss.mu.Lock()
+	delete(ss.peers, p.conn)
+	ss.mu.Unlock()
+
+	_ = p.conn.Close()
 }
 
-func (ss *Sockets) Add(ws *websocket.Conn) {
-	*ss = append(*ss, ws)
+// Connected reports whether a peer with the given address is connected.
+func (ss *Sockets) Connected(addr string) bool {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+
+	for _, p := range ss.peers {
+		if p.Addr() == addr || p.dialAddr == addr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Penalize lowers the score of a misbehaving peer. The peer is disconnected and
+// its host banned for banDuration once the score drops to banScore.
+func (ss *Sockets) Penalize(p *Peer, penalty int, reason string) {
+	score := p.addScore(-penalty)
+	log.Warnw("Penalize peer", "peer", p.Addr(), "reason", reason, "score", score)
+	if score > banScore {
+		return
+	}
+
+	ss.Ban(p.Host())
+	ss.Remove(p)
+}
+
+// Ban refuses the connections from and to the host for banDuration.
+func (ss *Sockets) Ban(host string) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	log.Warnw("Ban peer host", "host", host, "until", time.Now().Add(banDuration))
+	ss.bans[host] = time.Now().Add(banDuration)
+}
+
+// IsBanned reports whether the host is banned.
+func (ss *Sockets) IsBanned(host string) bool {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	return ss.isBanned(host)
+}
+
+// isBanned must be called with ss.mu held for writing, expired bans are dropped.
+func (ss *Sockets) isBanned(host string) bool {
+	until, ok := ss.bans[host]
+	if !ok {
+		return false
+	}
+
+	if time.Now().After(until) {
+		delete(ss.bans, host)
+		return false
+	}
+
+	return true
+}
+
+// hostOf returns the host part of an address, which may be an URL or a host:port pair.
+func hostOf(addr string) string {
+	if u, err := parseWSURL(addr); err == nil {
+		addr = u.Host
+	}
+
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+
+	return addr
 }
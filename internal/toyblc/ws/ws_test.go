@@ -0,0 +1,186 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package ws
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	"github.com/superproj/onex/internal/toyblc/blc"
+)
+
+type testNode struct {
+	bs  *blc.BlockSet
+	ss  *Sockets
+	url string
+}
+
+func newTestNode(t *testing.T, chainID string) *testNode {
+	t.Helper()
+
+	bs, err := blc.NewBlockSet("test", blc.NewMemoryStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n := &testNode{bs: bs}
+	srv := httptest.NewServer(websocket.Handler(func(conn *websocket.Conn) {
+		WSHandler(n.bs, n.ss, conn)
+	}))
+	t.Cleanup(srv.Close)
+
+	n.url = "ws" + strings.TrimPrefix(srv.URL, "http")
+	n.ss = NewSockets(Config{ChainID: chainID, ListenAddr: n.url})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go n.ss.Connector(ctx, n.bs)
+	return n
+}
+
+func eventually(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func readyPeers(ss *Sockets) int {
+	n := 0
+	for _, p := range ss.List() {
+		if p.Ready() {
+			n++
+		}
+	}
+
+	return n
+}
+
+func TestHandshake(t *testing.T) {
+	a := newTestNode(t, "toyblc")
+	b := newTestNode(t, "toyblc")
+	if err := b.bs.Add(b.bs.NextBlock("ahead")); err != nil {
+		t.Fatal(err)
+	}
+
+	ConnectToPeers(context.Background(), a.bs, a.ss, []string{b.url})
+
+	eventually(t, "handshake", func() bool { return readyPeers(a.ss) == 1 && readyPeers(b.ss) == 1 })
+	eventually(t, "sync", func() bool { return a.bs.Latest().Hash == b.bs.Latest().Hash })
+
+	if !a.ss.Connected(b.url) {
+		t.Errorf("Connected(%s) = false, want true", b.url)
+	}
+}
+
+func TestHandshake_ChainMismatch(t *testing.T) {
+	a := newTestNode(t, "toyblc")
+	b := newTestNode(t, "another")
+
+	ConnectToPeers(context.Background(), a.bs, a.ss, []string{b.url})
+
+	eventually(t, "disconnection", func() bool { return a.ss.Len() == 0 && b.ss.Len() == 0 })
+}
+
+func TestPeerExchange(t *testing.T) {
+	a := newTestNode(t, "toyblc")
+	b := newTestNode(t, "toyblc")
+	c := newTestNode(t, "toyblc")
+
+	ConnectToPeers(context.Background(), b.bs, b.ss, []string{c.url})
+	eventually(t, "b connected to c", func() bool { return readyPeers(b.ss) == 1 })
+
+	// a only knows b, and learns c from b.
+	ConnectToPeers(context.Background(), a.bs, a.ss, []string{b.url})
+	eventually(t, "a connected to c", func() bool { return a.ss.Connected(c.url) })
+}
+
+func TestEnqueueDial(t *testing.T) {
+	a := newTestNode(t, "toyblc")
+	ss := NewSockets(Config{ChainID: "toyblc", ListenAddr: "ws://127.0.0.1:1"})
+
+	if ss.enqueueDial(ss.cfg.ListenAddr) {
+		t.Errorf("enqueueDial(self) = true, want false")
+	}
+	if !ss.enqueueDial(a.url) {
+		t.Errorf("enqueueDial(%s) = false, want true", a.url)
+	}
+	if ss.enqueueDial(a.url) {
+		t.Errorf("enqueueDial(%s) twice = true, want false", a.url)
+	}
+
+	for i := 0; len(ss.dials) < maxPendingDials; i++ {
+		ss.enqueueDial(fmt.Sprintf("ws://127.0.0.1:%d", 2+i))
+	}
+	if ss.enqueueDial("ws://127.0.0.2:1") {
+		t.Errorf("enqueueDial() on a full queue = true, want false")
+	}
+
+	// The connected peers are not queued again.
+	b := newTestNode(t, "toyblc")
+	ConnectToPeers(context.Background(), b.bs, b.ss, []string{a.url})
+	eventually(t, "b connected to a", func() bool { return readyPeers(b.ss) == 1 })
+	if b.ss.enqueueDial(a.url) {
+		t.Errorf("enqueueDial(connected) = true, want false")
+	}
+}
+
+func TestPenalize(t *testing.T) {
+	a := newTestNode(t, "toyblc")
+	b := newTestNode(t, "toyblc")
+
+	ConnectToPeers(context.Background(), a.bs, a.ss, []string{b.url})
+	eventually(t, "handshake", func() bool { return readyPeers(a.ss) == 1 })
+
+	p := a.ss.List()[0]
+	a.ss.Penalize(p, penaltyInvalidChain, "test")
+	if a.ss.Len() != 1 {
+		t.Fatalf("peer evicted before reaching the ban score")
+	}
+
+	a.ss.Penalize(p, penaltyInvalidChain, "test")
+	if a.ss.Len() != 0 {
+		t.Errorf("Len() = %d, want 0 after the ban", a.ss.Len())
+	}
+	if !a.ss.IsBanned(hostOf(b.url)) {
+		t.Errorf("IsBanned() = false, want true")
+	}
+
+	ConnectToPeers(context.Background(), a.bs, a.ss, []string{b.url})
+	if a.ss.Len() != 0 {
+		t.Errorf("banned peer was dialed")
+	}
+}
+
+func TestHeartbeat_EvictsSilentPeers(t *testing.T) {
+	a := newTestNode(t, "toyblc")
+	b := newTestNode(t, "toyblc")
+
+	ConnectToPeers(context.Background(), a.bs, a.ss, []string{b.url})
+	eventually(t, "handshake", func() bool { return readyPeers(a.ss) == 1 })
+
+	p := a.ss.List()[0]
+	p.mu.Lock()
+	p.lastSeen = time.Now().Add(-4 * a.ss.cfg.HeartbeatInterval)
+	p.mu.Unlock()
+
+	a.ss.heartbeat()
+	if a.ss.Len() != 0 {
+		t.Errorf("Len() = %d, want 0 after evicting the silent peer", a.ss.Len())
+	}
+}
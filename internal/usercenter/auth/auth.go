@@ -10,14 +10,16 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/wire"
 
 	"github.com/superproj/onex/pkg/authn"
+	jwtauthn "github.com/superproj/onex/pkg/authn/jwt"
 )
 
 // ProviderSet is a Wire provider set that creates a new instance of auth.
-var ProviderSet = wire.NewSet(NewAuth, wire.Bind(new(AuthProvider), new(*auth)), AuthnProviderSet, AuthzProviderSet)
+var ProviderSet = wire.NewSet(NewAuth, wire.Bind(new(AuthProvider), new(*auth)), AuthnProviderSet, AuthzProviderSet, OIDCProviderSet, LockoutProviderSet)
 
 // AuthProvider is an interface that combines both the AuthnInterface and AuthzInterface interfaces.
 type AuthProvider interface {
@@ -46,7 +48,62 @@ func (a *auth) Sign(ctx context.Context, userID string) (authn.IToken, error) {
 	return a.authn.Sign(ctx, userID)
 }
 
+// JWKS is a method that implements JWKS method of AuthnInterface.
+func (a *auth) JWKS() (jwtauthn.JSONWebKeySet, error) {
+	return a.authn.JWKS()
+}
+
+// Revoke is a method that implements Revoke method of AuthnInterface.
+func (a *auth) Revoke(ctx context.Context, sessionID string, expiresAt time.Time) error {
+	return a.authn.Revoke(ctx, sessionID, expiresAt)
+}
+
+// VerifySignature is a method that implements VerifySignature method of AuthnInterface.
+func (a *auth) VerifySignature(ctx context.Context, secretID, stringToSign, signature string) (string, error) {
+	return a.authn.VerifySignature(ctx, secretID, stringToSign, signature)
+}
+
 // Authorize is a method that implements Authorize method of AuthzInterface.
-func (a *auth) Authorize(rvals ...any) (bool, error) {
-	return a.authz.Authorize(rvals...)
+func (a *auth) Authorize(sub, dom, obj, act string) (bool, error) {
+	return a.authz.Authorize(sub, dom, obj, act)
+}
+
+// IsAdmin is a method that implements IsAdmin method of AuthzInterface.
+func (a *auth) IsAdmin(user string) bool {
+	return a.authz.IsAdmin(user)
+}
+
+// AddPolicy is a method that implements AddPolicy method of AuthzInterface.
+func (a *auth) AddPolicy(sub, dom, obj, act, eft string) (bool, error) {
+	return a.authz.AddPolicy(sub, dom, obj, act, eft)
+}
+
+// RemovePolicy is a method that implements RemovePolicy method of AuthzInterface.
+func (a *auth) RemovePolicy(sub, dom, obj, act, eft string) (bool, error) {
+	return a.authz.RemovePolicy(sub, dom, obj, act, eft)
+}
+
+// Policies is a method that implements Policies method of AuthzInterface.
+func (a *auth) Policies(sub, dom string) [][]string {
+	return a.authz.Policies(sub, dom)
+}
+
+// AddRoleForUser is a method that implements AddRoleForUser method of AuthzInterface.
+func (a *auth) AddRoleForUser(user, role, dom string) (bool, error) {
+	return a.authz.AddRoleForUser(user, role, dom)
+}
+
+// DeleteRoleForUser is a method that implements DeleteRoleForUser method of AuthzInterface.
+func (a *auth) DeleteRoleForUser(user, role, dom string) (bool, error) {
+	return a.authz.DeleteRoleForUser(user, role, dom)
+}
+
+// RoleBindings is a method that implements RoleBindings method of AuthzInterface.
+func (a *auth) RoleBindings(user, role, dom string) [][]string {
+	return a.authz.RoleBindings(user, role, dom)
+}
+
+// DeleteRole is a method that implements DeleteRole method of AuthzInterface.
+func (a *auth) DeleteRole(role string) (bool, error) {
+	return a.authz.DeleteRole(role)
 }
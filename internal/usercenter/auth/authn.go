@@ -20,6 +20,7 @@ import (
 	"github.com/superproj/onex/internal/usercenter/model"
 	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
 	"github.com/superproj/onex/pkg/authn"
+	hmacauthn "github.com/superproj/onex/pkg/authn/hmac"
 	jwtauthn "github.com/superproj/onex/pkg/authn/jwt"
 	"github.com/superproj/onex/pkg/log"
 )
@@ -27,6 +28,9 @@ import (
 const (
 	// reasonUnauthorized holds the error reason.
 	reasonUnauthorized string = "Unauthorized"
+
+	// keyRefreshInterval is how often the signing keys are checked for rotation.
+	keyRefreshInterval = time.Minute
 )
 
 // AuthnProviderSet is authn providers.
@@ -46,6 +50,14 @@ type AuthnInterface interface {
 	// Verify is used to verify a access token. If the verification
 	// is successful, userID will be returned.
 	Verify(accessToken string) (string, error)
+	// JWKS returns the public keys used to verify the access tokens. It is empty
+	// when the tokens are signed with per-user HMAC secrets.
+	JWKS() (jwtauthn.JSONWebKeySet, error)
+	// Revoke invalidates the access tokens of a login session until expiresAt.
+	Revoke(ctx context.Context, sessionID string, expiresAt time.Time) error
+	// VerifySignature verifies the signature of a request signed with a secret.
+	// If the verification is successful, the owner of the secret will be returned.
+	VerifySignature(ctx context.Context, secretID, stringToSign, signature string) (string, error)
 }
 
 // SecretSetter is used to set or get a temporary secret key pairs.
@@ -57,25 +69,56 @@ type TemporarySecretSetter interface {
 type authnImpl struct {
 	setter  TemporarySecretSetter
 	secrets *lru.Cache
+	// tokens keeps the revoked sessions.
+	tokens jwtauthn.Storer
+	// keys signs the access tokens when an asymmetric algorithm is configured.
+	keys *jwtauthn.KeySet
 }
 
 // Ensure authnImpl implements AuthnInterface.
 var _ AuthnInterface = (*authnImpl)(nil)
 
-// NewAuthn returns a new instance of authn.
-func NewAuthn(setter TemporarySecretSetter) (*authnImpl, error) {
+// NewAuthn returns a new instance of authn. The returned cleanup function stops the key rotation.
+func NewAuthn(setter TemporarySecretSetter, keyStore jwtauthn.KeyStore, tokens jwtauthn.Storer, opts *AuthnOptions) (*authnImpl, func(), error) {
 	l, err := lru.New(known.DefaultLRUSize)
 	if err != nil {
 		log.Errorw(err, "Failed to create LRU cache")
-		return nil, err
+		return nil, nil, err
+	}
+
+	a := &authnImpl{setter: setter, secrets: l, tokens: tokens}
+	if opts.SigningAlgorithm == jwt.SigningMethodHS512.Alg() {
+		return a, func() {}, nil
+	}
+
+	a.keys, err = jwtauthn.NewKeySet(
+		opts.SigningAlgorithm,
+		jwtauthn.WithKeyStore(keyStore),
+		jwtauthn.WithRotationPeriod(opts.KeyRotationPeriod),
+		jwtauthn.WithGracePeriod(opts.KeyGracePeriod),
+	)
+	if err != nil {
+		log.Errorw(err, "Failed to load signing keys")
+		return nil, nil, err
 	}
 
-	return &authnImpl{setter: setter, secrets: l}, nil
+	ctx, cancel := context.WithCancel(context.Background())
+	go a.keys.Run(ctx, keyRefreshInterval)
+
+	return a, cancel, nil
 }
 
-// Verify is used to verify a access token. If the verification
-// is successful, userID will be returned.
+// Sign is used to generate a access token. userID is the jwt identity key.
 func (a *authnImpl) Sign(ctx context.Context, userID string) (authn.IToken, error) {
+	if a.keys != nil {
+		return jwtauthn.New(
+			nil,
+			jwtauthn.WithKeySet(a.keys),
+			jwtauthn.WithIssuer("onex-usercenter"),
+			jwtauthn.WithExpired(known.AccessTokenExpire),
+		).Sign(ctx, userID)
+	}
+
 	expires := time.Now().Add(known.AccessTokenExpire).Unix()
 
 	secret, err := a.setter.Set(ctx, userID, expires)
@@ -103,9 +146,12 @@ func (a *authnImpl) Sign(ctx context.Context, userID string) (authn.IToken, erro
 func (a *authnImpl) Verify(accessToken string) (string, error) {
 	var secret *model.SecretM
 	token, err := jwt.ParseWithClaims(accessToken, &jwt.RegisteredClaims{}, func(token *jwt.Token) (any, error) {
-		// Validate the alg is HMAC signature
+		// Tokens signed by the key set are verified with its public keys.
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return "", jwtauthn.ErrUnSupportSigningMethod
+			if a.keys == nil {
+				return "", jwtauthn.ErrUnSupportSigningMethod
+			}
+			return a.keys.Keyfunc(token)
 		}
 
 		kid, ok := token.Header["kid"].(string)
@@ -143,15 +189,70 @@ func (a *authnImpl) Verify(accessToken string) (string, error) {
 		return "", jwtauthn.ErrTokenInvalid
 	}
 
+	claims := token.Claims.(*jwt.RegisteredClaims)
+	revoked, err := jwtauthn.Revoked(context.Background(), a.tokens, claims.ID)
+	if err != nil {
+		return "", err
+	}
+	if revoked {
+		return "", jwtauthn.ErrTokenInvalid
+	}
+
+	if secret == nil {
+		return claims.Subject, nil
+	}
+
 	if keyExpired(secret.Expires) {
 		return "", jwtauthn.ErrTokenExpired
 	}
 
-	// you can return claims if you need
-	// claims := token.Claims.(*jwt.RegisteredClaims)
 	return secret.UserID, nil
 }
 
+// VerifySignature verifies the signature of a request signed with a secret, and
+// returns the userID owning the secret. The secret key never leaves the usercenter.
+func (a *authnImpl) VerifySignature(ctx context.Context, secretID, stringToSign, signature string) (string, error) {
+	// The secret is read from the store rather than the cache, so that it stops
+	// working as soon as it is disabled or deleted.
+	secret, err := a.setter.Get(ctx, secretID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", v1.ErrorSignatureInvalid("secret %s not found", secretID)
+		}
+		return "", err
+	}
+
+	if secret.Status == known.SecretStatusDisabled {
+		return "", ErrSecretDisabled
+	}
+
+	if keyExpired(secret.Expires) {
+		return "", v1.ErrorSignatureInvalid("secret %s has expired", secretID)
+	}
+
+	if !hmacauthn.Verify(secret.SecretKey, stringToSign, signature) {
+		return "", v1.ErrorSignatureInvalid("signature does not match")
+	}
+
+	return secret.UserID, nil
+}
+
+// Revoke invalidates the access tokens of a login session until expiresAt.
+// Services verifying the tokens offline with the JWKS still accept them until
+// they expire, so the access tokens are kept short-lived.
+func (a *authnImpl) Revoke(ctx context.Context, sessionID string, expiresAt time.Time) error {
+	return jwtauthn.Revoke(ctx, a.tokens, sessionID, time.Until(expiresAt))
+}
+
+// JWKS returns the public keys used to verify the access tokens.
+func (a *authnImpl) JWKS() (jwtauthn.JSONWebKeySet, error) {
+	if a.keys == nil {
+		return jwtauthn.JSONWebKeySet{Keys: []jwtauthn.JSONWebKey{}}, nil
+	}
+
+	return a.keys.JWKS()
+}
+
 // GetSecret returns the secret associated with the given key.
 func (a *authnImpl) GetSecret(key string) (*model.SecretM, error) {
 	s, ok := a.secrets.Get(key)
@@ -0,0 +1,56 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"gorm.io/gorm"
+
+	known "github.com/superproj/onex/internal/pkg/known/usercenter"
+	"github.com/superproj/onex/internal/usercenter/model"
+	hmacauthn "github.com/superproj/onex/pkg/authn/hmac"
+)
+
+// fakeSecrets is an in-memory secret store.
+type fakeSecrets map[string]*model.SecretM
+
+func (s fakeSecrets) Get(ctx context.Context, secretID string) (*model.SecretM, error) {
+	secret, ok := s[secretID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	copied := *secret
+	return &copied, nil
+}
+
+func (s fakeSecrets) Set(ctx context.Context, userID string, expires int64) (*model.SecretM, error) {
+	return nil, nil
+}
+
+func Test_authnImpl_VerifySignature(t *testing.T) {
+	secrets := fakeSecrets{"ak": {SecretID: "ak", SecretKey: "sk", UserID: "user-colin", Status: known.SecretStatusNormal}}
+	a := &authnImpl{setter: secrets}
+	signature := hmacauthn.Sign("sk", "payload")
+
+	userID, err := a.VerifySignature(context.Background(), "ak", "payload", signature)
+	if err != nil || userID != "user-colin" {
+		t.Fatalf("VerifySignature() = %s, %v, want user-colin", userID, err)
+	}
+
+	// The secret stops working as soon as it is disabled, then deleted.
+	secrets["ak"].Status = known.SecretStatusDisabled
+	if _, err := a.VerifySignature(context.Background(), "ak", "payload", signature); err == nil {
+		t.Errorf("VerifySignature() with a disabled secret succeeded")
+	}
+
+	delete(secrets, "ak")
+	if _, err := a.VerifySignature(context.Background(), "ak", "payload", signature); err == nil {
+		t.Errorf("VerifySignature() with a deleted secret succeeded")
+	}
+}
@@ -7,30 +7,46 @@
 package auth
 
 import (
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/casbin/casbin/v2"
 	clog "github.com/casbin/casbin/v2/log"
 	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/util"
 	gormadapter "github.com/casbin/gorm-adapter/v3"
 	rediswatcher "github.com/casbin/redis-watcher/v2"
 	"github.com/google/wire"
 	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 
+	"github.com/superproj/onex/internal/pkg/known"
 	"github.com/superproj/onex/pkg/log"
 	genericoptions "github.com/superproj/onex/pkg/options"
 )
 
 const (
+	// DefaultAdminGroup is the default role whose members are allowed to do everything.
+	DefaultAdminGroup = "admin"
+	// AllDomains is the domain of the policies and role bindings applying to every domain.
+	AllDomains = "*"
+	// AllUsers is the subject of the policies applying to every user.
+	AllUsers = "*"
+
+	// rbacModel is the RBAC model with domains. Role bindings and policies in the "*"
+	// domain apply to every domain, and policies on the "*" subject apply to every user.
+	// obj is matched with keyMatch2 so a policy can cover a route pattern like
+	// /v1/minersets/*, and act "*" matches every action. No policy
+	// matches the members of the admin group, bound in the "*" domain, so they are never denied.
 	rbacModel = `[request_definition]
-r = sub, obj, act
+r = sub, dom, obj, act
 
 [policy_definition]
-p = sub, obj, act, eft
+p = sub, dom, obj, act, eft
 
 [role_definition]
-g = _, _
+g = _, _, _
 
 [policy_effect]
 # The Effect primitive indicates that if there is no matching rule whose decision
@@ -39,7 +55,7 @@ g = _, _
 e = !some(where (p.eft == deny))
 
 [matchers]
-m = g(r.sub, p.sub) && r.obj == p.obj && r.act == p.act || r.sub == "root"`
+m = !g(r.sub, "%s", "*") && (p.sub == "*" || g(r.sub, p.sub, r.dom)) && keyMatch(r.dom, p.dom) && keyMatch2(r.obj, p.obj) && (r.act == p.act || p.act == "*")`
 )
 
 // AuthzProviderSet defines a wire set for authorization.
@@ -47,11 +63,29 @@ var AuthzProviderSet = wire.NewSet(NewAuthz, wire.Bind(new(AuthzInterface), new(
 
 // AuthzInterface defines the interface for authorization.
 type AuthzInterface interface {
-	Authorize(rvals ...any) (bool, error)
+	// Authorize checks whether sub is allowed to perform act on obj in the domain dom.
+	Authorize(sub, dom, obj, act string) (bool, error)
+	// IsAdmin reports whether the user is a member of the admin group.
+	IsAdmin(user string) bool
+	// AddPolicy adds a policy rule, it returns false if the rule already exists.
+	AddPolicy(sub, dom, obj, act, eft string) (bool, error)
+	// RemovePolicy removes a policy rule, it returns false if the rule does not exist.
+	RemovePolicy(sub, dom, obj, act, eft string) (bool, error)
+	// Policies returns the policy rules filtered by subject and domain, empty values match all.
+	Policies(sub, dom string) [][]string
+	// AddRoleForUser binds the role to the user in the domain, it returns false if the binding already exists.
+	AddRoleForUser(user, role, dom string) (bool, error)
+	// DeleteRoleForUser unbinds the role from the user in the domain, it returns false if the binding does not exist.
+	DeleteRoleForUser(user, role, dom string) (bool, error)
+	// RoleBindings returns the user-role-domain bindings filtered by user, role and domain, empty values match all.
+	RoleBindings(user, role, dom string) [][]string
+	// DeleteRole removes the role together with its bindings and policy rules.
+	DeleteRole(role string) (bool, error)
 }
 
 type authzImpl struct {
-	enforcer *casbin.SyncedEnforcer
+	enforcer   *casbin.SyncedEnforcer
+	adminGroup string
 }
 
 // Ensure authzImpl implements AuthzInterface.
@@ -62,8 +96,8 @@ func updateCallback(rev string) {
 	log.Warnw("New revision detected", "revision", rev)
 }
 
-// NewAuthz creates a new authorization instance using the provided database, Redis options, and logger.
-func NewAuthz(db *gorm.DB, redisOpts *genericoptions.RedisOptions, logger clog.Logger) (*authzImpl, error) {
+// NewAuthz creates a new authorization instance using the provided database, Redis options, authorization options and logger.
+func NewAuthz(db *gorm.DB, redisOpts *genericoptions.RedisOptions, opts *AuthzOptions, logger clog.Logger) (*authzImpl, error) {
 	// Initialize a Gorm adapter and use it in a Casbin enforcer
 	adapter, err := gormadapter.NewAdapterByDB(db)
 	if err != nil {
@@ -71,6 +105,12 @@ func NewAuthz(db *gorm.DB, redisOpts *genericoptions.RedisOptions, logger clog.L
 		return nil, err
 	}
 
+	// Rewrite the policies stored by the previous, domain-less, model.
+	if err := migratePolicies(db); err != nil {
+		log.Errorw(err, "Failed to migrate casbin policies")
+		return nil, err
+	}
+
 	// Initialize the watcher using Redis as a backend.
 	w, err := rediswatcher.NewWatcher(redisOpts.Addr, rediswatcher.WatcherOptions{
 		Options: redis.Options{
@@ -85,10 +125,8 @@ func NewAuthz(db *gorm.DB, redisOpts *genericoptions.RedisOptions, logger clog.L
 		return nil, err
 	}
 
-	m, _ := model.NewModelFromString(rbacModel)
-
 	// Initialize the enforcer.
-	enforcer, err := casbin.NewSyncedEnforcer(m, adapter)
+	enforcer, err := newEnforcer(opts.AdminGroup, adapter)
 	if err != nil {
 		log.Errorw(err, "Failed to create casbin enforcer")
 		return nil, err
@@ -114,11 +152,143 @@ func NewAuthz(db *gorm.DB, redisOpts *genericoptions.RedisOptions, logger clog.L
 	// Start auto-loading the policy every minute.
 	enforcer.StartAutoLoadPolicy(time.Minute)
 
-	// Create a new authzImpl instance.
-	return &authzImpl{enforcer: enforcer}, nil
+	a := &authzImpl{enforcer: enforcer, adminGroup: opts.AdminGroup}
+	if err := a.bootstrapAdmin(); err != nil {
+		log.Errorw(err, "Failed to bootstrap the admin group")
+		return nil, err
+	}
+	if err := a.bootstrapPolicies(opts.AdminRoutes); err != nil {
+		log.Errorw(err, "Failed to bootstrap the built-in policies")
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// newEnforcer creates a casbin enforcer with the RBAC model using adminGroup as
+// the admin group. Params are passed to casbin.NewSyncedEnforcer after the model.
+func newEnforcer(adminGroup string, params ...any) (*casbin.SyncedEnforcer, error) {
+	m, err := model.NewModelFromString(fmt.Sprintf(rbacModel, adminGroup))
+	if err != nil {
+		return nil, err
+	}
+
+	enforcer, err := casbin.NewSyncedEnforcer(append([]any{m}, params...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Let the role bindings in the "*" domain apply to every domain.
+	enforcer.AddNamedDomainMatchingFunc("g", "KeyMatch", util.KeyMatch)
+	return enforcer, nil
+}
+
+// bootstrapAdmin binds the built-in administrator to the admin group if the group is empty,
+// so that the administrator keeps its permissions when the admin group is created or renamed.
+func (a *authzImpl) bootstrapAdmin() error {
+	if len(a.enforcer.GetFilteredGroupingPolicy(1, a.adminGroup, AllDomains)) > 0 {
+		return nil
+	}
+
+	log.Infow("Bind the administrator to the empty admin group", "user", known.AdminUserID, "group", a.adminGroup)
+	_, err := a.enforcer.AddRoleForUser(known.AdminUserID, a.adminGroup, AllDomains)
+	return err
+}
+
+// bootstrapPolicies denies the routes reserved to the admin group to every other user.
+// The model allows what is not denied, so they would otherwise be open to any user.
+func (a *authzImpl) bootstrapPolicies(routes []string) error {
+	for _, route := range routes {
+		act, obj, ok := splitRoute(route)
+		if !ok {
+			return fmt.Errorf("invalid admin route %q", route)
+		}
+
+		if _, err := a.enforcer.AddPolicy(AllUsers, AllDomains, obj, act, "deny"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Authorize checks whether sub is allowed to perform act on obj in the domain dom.
+// The domain is chosen by the caller, so sub must be bound to a role in it, otherwise the
+// caller could escape the policies of its own domain by naming another one. An empty domain
+// is derived from the identity: the request is authorized in every domain sub is bound in,
+// and is denied by the policies of any of them.
+func (a *authzImpl) Authorize(sub, dom, obj, act string) (bool, error) {
+	if a.IsAdmin(sub) {
+		return true, nil
+	}
+
+	domains := a.domains(sub)
+	if dom != "" && dom != AllDomains {
+		if !slices.Contains(domains, dom) {
+			return false, nil
+		}
+		domains = []string{dom}
+	}
+
+	for _, d := range domains {
+		allowed, err := a.enforcer.Enforce(sub, d, obj, act)
+		if err != nil || !allowed {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// domains returns the domains the user is bound to a role in, the "*" domain is always included.
+func (a *authzImpl) domains(user string) []string {
+	domains := []string{AllDomains}
+	for _, binding := range a.enforcer.GetFilteredGroupingPolicy(0, user) {
+		if len(binding) > 2 && !slices.Contains(domains, binding[2]) {
+			domains = append(domains, binding[2])
+		}
+	}
+
+	return domains
+}
+
+// IsAdmin reports whether the user is a member of the admin group.
+func (a *authzImpl) IsAdmin(user string) bool {
+	ok, _ := a.enforcer.HasRoleForUser(user, a.adminGroup, AllDomains)
+	return ok
+}
+
+// AddPolicy adds a policy rule to the enforcer.
+func (a *authzImpl) AddPolicy(sub, dom, obj, act, eft string) (bool, error) {
+	return a.enforcer.AddPolicy(sub, dom, obj, act, eft)
+}
+
+// RemovePolicy removes a policy rule from the enforcer.
+func (a *authzImpl) RemovePolicy(sub, dom, obj, act, eft string) (bool, error) {
+	return a.enforcer.RemovePolicy(sub, dom, obj, act, eft)
+}
+
+// Policies returns the policy rules filtered by subject and domain.
+func (a *authzImpl) Policies(sub, dom string) [][]string {
+	return a.enforcer.GetFilteredPolicy(0, sub, dom)
+}
+
+// AddRoleForUser binds the role to the user in the domain.
+func (a *authzImpl) AddRoleForUser(user, role, dom string) (bool, error) {
+	return a.enforcer.AddRoleForUser(user, role, dom)
+}
+
+// DeleteRoleForUser unbinds the role from the user in the domain.
+func (a *authzImpl) DeleteRoleForUser(user, role, dom string) (bool, error) {
+	return a.enforcer.DeleteRoleForUser(user, role, dom)
+}
+
+// RoleBindings returns the user-role-domain bindings filtered by user, role and domain.
+func (a *authzImpl) RoleBindings(user, role, dom string) [][]string {
+	return a.enforcer.GetFilteredGroupingPolicy(0, user, role, dom)
 }
 
-// Authorize checks whether the given request values satisfy the authorization policy.
-func (a *authzImpl) Authorize(rvals ...any) (bool, error) {
-	return a.enforcer.Enforce(rvals...)
+// DeleteRole removes the role together with its bindings and policy rules.
+func (a *authzImpl) DeleteRole(role string) (bool, error) {
+	return a.enforcer.DeleteRole(role)
 }
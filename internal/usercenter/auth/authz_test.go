@@ -0,0 +1,123 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package auth
+
+import (
+	"slices"
+	"testing"
+
+	mwauth "github.com/superproj/onex/internal/pkg/middleware/auth"
+	gwv1 "github.com/superproj/onex/pkg/api/gateway/v1"
+)
+
+func newTestAuthz(t *testing.T) *authzImpl {
+	t.Helper()
+
+	enforcer, err := newEnforcer("ops-admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := &authzImpl{enforcer: enforcer, adminGroup: "ops-admin"}
+	if err := a.bootstrapPolicies(NewAuthzOptions().AdminRoutes); err != nil {
+		t.Fatal(err)
+	}
+
+	policies := [][]string{
+		{"operator", "tenant-a", "/v1/minersets/*", "DELETE", "deny"},
+		{"viewer", AllDomains, "/v1/*", "*", "deny"},
+		{"user-bob", AllDomains, "/v1/secrets/:name", "GET", "deny"},
+	}
+	for _, p := range policies {
+		if _, err := a.AddPolicy(p[0], p[1], p[2], p[3], p[4]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	bindings := [][]string{
+		{"user-alice", "operator", AllDomains},
+		{"user-erin", "operator", "tenant-a"},
+		{"user-carol", "viewer", "tenant-b"},
+		{"user-root", "ops-admin", AllDomains},
+		{"user-root", "viewer", AllDomains},
+		{"user-dave", "ops-admin", "tenant-a"},
+		{"user-dave", "operator", AllDomains},
+	}
+	for _, b := range bindings {
+		if _, err := a.AddRoleForUser(b[0], b[1], b[2]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return a
+}
+
+func Test_authzImpl_Authorize(t *testing.T) {
+	a := newTestAuthz(t)
+
+	tests := []struct {
+		name string
+		sub  string
+		dom  string
+		obj  string
+		act  string
+		want bool
+	}{
+		{"denied in the policy domain", "user-erin", "tenant-a", "/v1/minersets/foo", "DELETE", false},
+		{"allowed for another action", "user-erin", "tenant-a", "/v1/minersets/foo", "GET", true},
+		{"derived domain applies every bound domain", "user-erin", "", "/v1/minersets/foo", "DELETE", false},
+		{"global binding is not a member of the domains", "user-alice", "", "/v1/minersets/foo", "DELETE", true},
+		{"domain of a non member is denied", "user-alice", "tenant-b", "/v1/miners", "GET", false},
+		{"domain binding applies to its domain", "user-carol", "tenant-b", "/v1/miners", "GET", false},
+		{"derived domain of a domain binding", "user-carol", "", "/v1/miners", "GET", false},
+		{"user without bindings", "user-frank", "", "/v1/miners", "GET", true},
+		{"global policy on the user", "user-bob", AllDomains, "/v1/secrets/foo", "GET", false},
+		{"global domain", "user-bob", "", "/v1/secrets/foo", "GET", false},
+		{"admin group is never denied", "user-root", "tenant-b", "/v1/miners", "DELETE", true},
+		{"admin group bound in a domain is not admin", "user-dave", "tenant-a", "/v1/minersets/foo", "DELETE", false},
+		{"chain creation is denied to users", "user-frank", "", "/v1/chains", "POST", false},
+		{"chain deletion is denied to users", "user-alice", "", "/v1/chains/foo", "DELETE", false},
+		{"chain is readable by users", "user-frank", "", "/v1/chains/foo", "GET", true},
+		{"chain creation is allowed to the admin group", "user-root", "", "/v1/chains", "POST", true},
+		{"chain update is denied to users", "user-frank", "", "/v1/chains", "PUT", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := a.Authorize(tt.sub, tt.dom, tt.obj, tt.act)
+			if err != nil {
+				t.Fatalf("Authorize() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Authorize(%s, %s, %s, %s) = %v, want %v", tt.sub, tt.dom, tt.obj, tt.act, got, tt.want)
+			}
+		})
+	}
+
+	if !a.IsAdmin("user-root") {
+		t.Errorf("IsAdmin(user-root) = false, want true")
+	}
+	if a.IsAdmin("user-dave") {
+		t.Errorf("IsAdmin(user-dave) = true, want false")
+	}
+}
+
+func TestNewAuthzOptions_AdminRoutes(t *testing.T) {
+	routes := NewAuthzOptions().AdminRoutes
+	for _, operation := range []string{
+		gwv1.OperationGatewayCreateChain,
+		gwv1.OperationGatewayUpdateChain,
+		gwv1.OperationGatewayDeleteChain,
+	} {
+		obj, act, ok := mwauth.Pattern(operation)
+		if !ok {
+			t.Fatalf("Pattern(%s) has no http binding", operation)
+		}
+		if !slices.Contains(routes, act+" "+obj) {
+			t.Errorf("admin routes %v do not contain %s %s of %s", routes, act, obj, operation)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/wire"
+	"github.com/redis/go-redis/v9"
+
+	genericoptions "github.com/superproj/onex/pkg/options"
+)
+
+// LockoutProviderSet defines a wire set for locking out users after failed logins.
+var LockoutProviderSet = wire.NewSet(NewLockout)
+
+// lockoutKeyPrefix is the prefix of the redis keys counting the failed logins of each user.
+const lockoutKeyPrefix = "usercenter_login_failures_"
+
+// failScript increases the failure counter, and starts the lockout window with the first failure.
+var failScript = redis.NewScript(`
+local n = redis.call('INCR', KEYS[1])
+if n == 1 then
+  redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return n
+`)
+
+// LockoutInterface counts the failed logins of the users and locks them out.
+type LockoutInterface interface {
+	// Locked returns whether the user is locked out.
+	Locked(ctx context.Context, username string) (bool, error)
+	// Fail records a failed login of the user, and returns whether the user is locked out by it.
+	Fail(ctx context.Context, username string) (bool, error)
+	// Reset clears the failed logins of the user after a successful login.
+	Reset(ctx context.Context, username string) error
+}
+
+// Ensure Lockout implements LockoutInterface.
+var _ LockoutInterface = (*Lockout)(nil)
+
+// Lockout counts the failed logins of each user in redis, so that the count is
+// shared by all the usercenter replicas. A user is locked out once the failures
+// reach the limit, until the lockout duration since the first failure has passed.
+type Lockout struct {
+	rdb         *redis.Client
+	maxAttempts int64
+	duration    time.Duration
+}
+
+// NewLockout creates a Lockout. Users are never locked out if MaxLoginAttempts is 0.
+func NewLockout(redisOpts *genericoptions.RedisOptions, opts *PasswordOptions) (*Lockout, func(), error) {
+	if opts.MaxLoginAttempts == 0 {
+		return &Lockout{}, func() {}, nil
+	}
+
+	rdb, err := redisOpts.NewClient()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l := &Lockout{rdb: rdb, maxAttempts: int64(opts.MaxLoginAttempts), duration: opts.LockoutDuration}
+	return l, func() { _ = rdb.Close() }, nil
+}
+
+// Locked returns whether the user is locked out.
+func (l *Lockout) Locked(ctx context.Context, username string) (bool, error) {
+	if l.rdb == nil {
+		return false, nil
+	}
+
+	n, err := l.rdb.Get(ctx, lockoutKeyPrefix+username).Int64()
+	if err == redis.Nil {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return n >= l.maxAttempts, nil
+}
+
+// Fail records a failed login of the user, and returns whether the user is locked out by it.
+func (l *Lockout) Fail(ctx context.Context, username string) (bool, error) {
+	if l.rdb == nil {
+		return false, nil
+	}
+
+	n, err := failScript.Run(ctx, l.rdb, []string{lockoutKeyPrefix + username}, l.duration.Milliseconds()).Int64()
+	if err != nil {
+		return false, err
+	}
+
+	return n >= l.maxAttempts, nil
+}
+
+// Reset clears the failed logins of the user after a successful login.
+func (l *Lockout) Reset(ctx context.Context, username string) error {
+	if l.rdb == nil {
+		return nil
+	}
+
+	return l.rdb.Del(ctx, lockoutKeyPrefix+username).Err()
+}
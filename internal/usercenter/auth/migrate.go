@@ -0,0 +1,94 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package auth
+
+import (
+	"fmt"
+
+	gormadapter "github.com/casbin/gorm-adapter/v3"
+	"gorm.io/gorm"
+
+	mwauth "github.com/superproj/onex/internal/pkg/middleware/auth"
+	"github.com/superproj/onex/pkg/log"
+
+	// The legacy policies were written on the gateway operations, register their http
+	// bindings so that they can be translated into routes.
+	_ "github.com/superproj/onex/pkg/api/gateway/v1"
+)
+
+// migratePolicies rewrites the rules stored by the previous model, which had no domain,
+// into the "*" domain, so that they keep applying everywhere:
+//
+//	p, sub, *, operation, eft => p, sub, *, route, method, eft
+//	g, user, role             => g, user, role, *
+//
+// The previous model authorized the operations, while the requests are now authorized
+// by route and method, so the operation of a policy is replaced by the route pattern and
+// the method of its http binding. A policy which can not be translated fails the migration,
+// rather than being silently dropped or left unmatched. The legacy rules are told apart
+// by their number of fields, it is safe to run it on every start.
+func migratePolicies(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		var rules []*gormadapter.CasbinRule
+		err := tx.Where("(ptype = ? AND v3 <> '' AND v4 = '') OR (ptype = ? AND v1 <> '' AND v2 = '')", "p", "g").
+			Find(&rules).Error
+		if err != nil {
+			return err
+		}
+
+		for _, rule := range rules {
+			if err := migrateRule(rule); err != nil {
+				return err
+			}
+
+			if err := tx.Save(rule).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(rules) > 0 {
+			log.Infow("Migrated casbin rules to the domain model", "count", len(rules))
+		}
+
+		return nil
+	})
+}
+
+// migrateRule rewrites a legacy rule in place.
+func migrateRule(rule *gormadapter.CasbinRule) error {
+	switch rule.Ptype {
+	case "p":
+		obj, act, err := migrateResource(rule.V1, rule.V2)
+		if err != nil {
+			return fmt.Errorf("failed to migrate policy [%s, %s, %s, %s]: %w", rule.V0, rule.V1, rule.V2, rule.V3, err)
+		}
+		rule.V1, rule.V2, rule.V3, rule.V4 = AllDomains, obj, act, rule.V3
+	case "g":
+		rule.V2 = AllDomains
+	}
+
+	return nil
+}
+
+// migrateResource translates the object and the action of a legacy policy. The gateway
+// authorized the operation as the action of the "*" object, and a policy on every object
+// and action still applies to every route.
+func migrateResource(obj, act string) (string, string, error) {
+	if obj != "*" {
+		return "", "", fmt.Errorf("object %q is not supported", obj)
+	}
+	if act == "*" {
+		return "/*", "*", nil
+	}
+
+	route, method, ok := mwauth.Pattern(act)
+	if !ok {
+		return "", "", fmt.Errorf("operation %q has no http binding", act)
+	}
+
+	return route, method, nil
+}
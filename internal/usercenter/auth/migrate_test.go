@@ -0,0 +1,60 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package auth
+
+import (
+	"testing"
+
+	gormadapter "github.com/casbin/gorm-adapter/v3"
+)
+
+func TestMigrateRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    gormadapter.CasbinRule
+		want    gormadapter.CasbinRule
+		wantErr bool
+	}{
+		{
+			name: "operation policy",
+			rule: gormadapter.CasbinRule{Ptype: "p", V0: "role::viewer", V1: "*", V2: "/gateway.v1.Gateway/DeleteMinerSet", V3: "deny"},
+			want: gormadapter.CasbinRule{Ptype: "p", V0: "role::viewer", V1: "*", V2: "/v1/minersets/:name", V3: "DELETE", V4: "deny"},
+		},
+		{
+			name: "wildcard policy",
+			rule: gormadapter.CasbinRule{Ptype: "p", V0: "role::viewer", V1: "*", V2: "*", V3: "allow"},
+			want: gormadapter.CasbinRule{Ptype: "p", V0: "role::viewer", V1: "*", V2: "/*", V3: "*", V4: "allow"},
+		},
+		{
+			name:    "unknown operation",
+			rule:    gormadapter.CasbinRule{Ptype: "p", V0: "role::viewer", V1: "*", V2: "/gateway.v1.Gateway/Unknown", V3: "deny"},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported object",
+			rule:    gormadapter.CasbinRule{Ptype: "p", V0: "role::viewer", V1: "/gateway.v1.Gateway/GetMinerSet", V2: "*", V3: "deny"},
+			wantErr: true,
+		},
+		{
+			name: "grouping policy",
+			rule: gormadapter.CasbinRule{Ptype: "g", V0: "user-colin", V1: "role::viewer"},
+			want: gormadapter.CasbinRule{Ptype: "g", V0: "user-colin", V1: "role::viewer", V2: "*"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := tt.rule
+			err := migrateRule(&rule)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("migrateRule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && rule != tt.want {
+				t.Errorf("migrateRule() = %+v, want %+v", rule, tt.want)
+			}
+		})
+	}
+}
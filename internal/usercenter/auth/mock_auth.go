@@ -13,9 +13,11 @@ package auth
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	authn "github.com/superproj/onex/pkg/authn"
+	jwt "github.com/superproj/onex/pkg/authn/jwt"
 )
 
 // MockAuthProvider is a mock of AuthProvider interface.
@@ -41,23 +43,165 @@ func (m *MockAuthProvider) EXPECT() *MockAuthProviderMockRecorder {
 	return m.recorder
 }
 
+// AddPolicy mocks base method.
+func (m *MockAuthProvider) AddPolicy(arg0, arg1, arg2, arg3, arg4 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPolicy", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPolicy indicates an expected call of AddPolicy.
+func (mr *MockAuthProviderMockRecorder) AddPolicy(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPolicy", reflect.TypeOf((*MockAuthProvider)(nil).AddPolicy), arg0, arg1, arg2, arg3, arg4)
+}
+
+// AddRoleForUser mocks base method.
+func (m *MockAuthProvider) AddRoleForUser(arg0, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRoleForUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRoleForUser indicates an expected call of AddRoleForUser.
+func (mr *MockAuthProviderMockRecorder) AddRoleForUser(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRoleForUser", reflect.TypeOf((*MockAuthProvider)(nil).AddRoleForUser), arg0, arg1, arg2)
+}
+
 // Authorize mocks base method.
-func (m *MockAuthProvider) Authorize(arg0 ...any) (bool, error) {
+func (m *MockAuthProvider) Authorize(arg0, arg1, arg2, arg3 string) (bool, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{}
-	for _, a := range arg0 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Authorize", varargs...)
+	ret := m.ctrl.Call(m, "Authorize", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Authorize indicates an expected call of Authorize.
-func (mr *MockAuthProviderMockRecorder) Authorize(arg0 ...any) *gomock.Call {
+func (mr *MockAuthProviderMockRecorder) Authorize(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockAuthProvider)(nil).Authorize), arg0, arg1, arg2, arg3)
+}
+
+// DeleteRole mocks base method.
+func (m *MockAuthProvider) DeleteRole(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockAuthProviderMockRecorder) DeleteRole(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockAuthProvider)(nil).DeleteRole), arg0)
+}
+
+// DeleteRoleForUser mocks base method.
+func (m *MockAuthProvider) DeleteRoleForUser(arg0, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoleForUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRoleForUser indicates an expected call of DeleteRoleForUser.
+func (mr *MockAuthProviderMockRecorder) DeleteRoleForUser(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockAuthProvider)(nil).Authorize), arg0...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoleForUser", reflect.TypeOf((*MockAuthProvider)(nil).DeleteRoleForUser), arg0, arg1, arg2)
+}
+
+// IsAdmin mocks base method.
+func (m *MockAuthProvider) IsAdmin(arg0 string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAdmin", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsAdmin indicates an expected call of IsAdmin.
+func (mr *MockAuthProviderMockRecorder) IsAdmin(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAdmin", reflect.TypeOf((*MockAuthProvider)(nil).IsAdmin), arg0)
+}
+
+// JWKS mocks base method.
+func (m *MockAuthProvider) JWKS() (jwt.JSONWebKeySet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JWKS")
+	ret0, _ := ret[0].(jwt.JSONWebKeySet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JWKS indicates an expected call of JWKS.
+func (mr *MockAuthProviderMockRecorder) JWKS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JWKS", reflect.TypeOf((*MockAuthProvider)(nil).JWKS))
+}
+
+// Policies mocks base method.
+func (m *MockAuthProvider) Policies(arg0, arg1 string) [][]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Policies", arg0, arg1)
+	ret0, _ := ret[0].([][]string)
+	return ret0
+}
+
+// Policies indicates an expected call of Policies.
+func (mr *MockAuthProviderMockRecorder) Policies(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Policies", reflect.TypeOf((*MockAuthProvider)(nil).Policies), arg0, arg1)
+}
+
+// RemovePolicy mocks base method.
+func (m *MockAuthProvider) RemovePolicy(arg0, arg1, arg2, arg3, arg4 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePolicy", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemovePolicy indicates an expected call of RemovePolicy.
+func (mr *MockAuthProviderMockRecorder) RemovePolicy(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePolicy", reflect.TypeOf((*MockAuthProvider)(nil).RemovePolicy), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Revoke mocks base method.
+func (m *MockAuthProvider) Revoke(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockAuthProviderMockRecorder) Revoke(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockAuthProvider)(nil).Revoke), arg0, arg1, arg2)
+}
+
+// RoleBindings mocks base method.
+func (m *MockAuthProvider) RoleBindings(arg0, arg1, arg2 string) [][]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RoleBindings", arg0, arg1, arg2)
+	ret0, _ := ret[0].([][]string)
+	return ret0
+}
+
+// RoleBindings indicates an expected call of RoleBindings.
+func (mr *MockAuthProviderMockRecorder) RoleBindings(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RoleBindings", reflect.TypeOf((*MockAuthProvider)(nil).RoleBindings), arg0, arg1, arg2)
 }
 
 // Sign mocks base method.
@@ -90,6 +234,21 @@ func (mr *MockAuthProviderMockRecorder) Verify(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockAuthProvider)(nil).Verify), arg0)
 }
 
+// VerifySignature mocks base method.
+func (m *MockAuthProvider) VerifySignature(arg0 context.Context, arg1, arg2, arg3 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifySignature", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifySignature indicates an expected call of VerifySignature.
+func (mr *MockAuthProviderMockRecorder) VerifySignature(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifySignature", reflect.TypeOf((*MockAuthProvider)(nil).VerifySignature), arg0, arg1, arg2, arg3)
+}
+
 // MockAuthzInterface is a mock of AuthzInterface interface.
 type MockAuthzInterface struct {
 	ctrl     *gomock.Controller
@@ -113,23 +272,136 @@ func (m *MockAuthzInterface) EXPECT() *MockAuthzInterfaceMockRecorder {
 	return m.recorder
 }
 
+// AddPolicy mocks base method.
+func (m *MockAuthzInterface) AddPolicy(arg0, arg1, arg2, arg3, arg4 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPolicy", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPolicy indicates an expected call of AddPolicy.
+func (mr *MockAuthzInterfaceMockRecorder) AddPolicy(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPolicy", reflect.TypeOf((*MockAuthzInterface)(nil).AddPolicy), arg0, arg1, arg2, arg3, arg4)
+}
+
+// AddRoleForUser mocks base method.
+func (m *MockAuthzInterface) AddRoleForUser(arg0, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRoleForUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRoleForUser indicates an expected call of AddRoleForUser.
+func (mr *MockAuthzInterfaceMockRecorder) AddRoleForUser(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRoleForUser", reflect.TypeOf((*MockAuthzInterface)(nil).AddRoleForUser), arg0, arg1, arg2)
+}
+
 // Authorize mocks base method.
-func (m *MockAuthzInterface) Authorize(arg0 ...any) (bool, error) {
+func (m *MockAuthzInterface) Authorize(arg0, arg1, arg2, arg3 string) (bool, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{}
-	for _, a := range arg0 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Authorize", varargs...)
+	ret := m.ctrl.Call(m, "Authorize", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Authorize indicates an expected call of Authorize.
-func (mr *MockAuthzInterfaceMockRecorder) Authorize(arg0 ...any) *gomock.Call {
+func (mr *MockAuthzInterfaceMockRecorder) Authorize(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockAuthzInterface)(nil).Authorize), arg0, arg1, arg2, arg3)
+}
+
+// DeleteRole mocks base method.
+func (m *MockAuthzInterface) DeleteRole(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockAuthzInterfaceMockRecorder) DeleteRole(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockAuthzInterface)(nil).DeleteRole), arg0)
+}
+
+// DeleteRoleForUser mocks base method.
+func (m *MockAuthzInterface) DeleteRoleForUser(arg0, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoleForUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRoleForUser indicates an expected call of DeleteRoleForUser.
+func (mr *MockAuthzInterfaceMockRecorder) DeleteRoleForUser(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockAuthzInterface)(nil).Authorize), arg0...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoleForUser", reflect.TypeOf((*MockAuthzInterface)(nil).DeleteRoleForUser), arg0, arg1, arg2)
+}
+
+// IsAdmin mocks base method.
+func (m *MockAuthzInterface) IsAdmin(arg0 string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAdmin", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsAdmin indicates an expected call of IsAdmin.
+func (mr *MockAuthzInterfaceMockRecorder) IsAdmin(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAdmin", reflect.TypeOf((*MockAuthzInterface)(nil).IsAdmin), arg0)
+}
+
+// Policies mocks base method.
+func (m *MockAuthzInterface) Policies(arg0, arg1 string) [][]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Policies", arg0, arg1)
+	ret0, _ := ret[0].([][]string)
+	return ret0
+}
+
+// Policies indicates an expected call of Policies.
+func (mr *MockAuthzInterfaceMockRecorder) Policies(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Policies", reflect.TypeOf((*MockAuthzInterface)(nil).Policies), arg0, arg1)
+}
+
+// RemovePolicy mocks base method.
+func (m *MockAuthzInterface) RemovePolicy(arg0, arg1, arg2, arg3, arg4 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePolicy", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemovePolicy indicates an expected call of RemovePolicy.
+func (mr *MockAuthzInterfaceMockRecorder) RemovePolicy(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePolicy", reflect.TypeOf((*MockAuthzInterface)(nil).RemovePolicy), arg0, arg1, arg2, arg3, arg4)
+}
+
+// RoleBindings mocks base method.
+func (m *MockAuthzInterface) RoleBindings(arg0, arg1, arg2 string) [][]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RoleBindings", arg0, arg1, arg2)
+	ret0, _ := ret[0].([][]string)
+	return ret0
+}
+
+// RoleBindings indicates an expected call of RoleBindings.
+func (mr *MockAuthzInterfaceMockRecorder) RoleBindings(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RoleBindings", reflect.TypeOf((*MockAuthzInterface)(nil).RoleBindings), arg0, arg1, arg2)
 }
 
 // MockAuthnInterface is a mock of AuthnInterface interface.
@@ -155,6 +427,35 @@ func (m *MockAuthnInterface) EXPECT() *MockAuthnInterfaceMockRecorder {
 	return m.recorder
 }
 
+// JWKS mocks base method.
+func (m *MockAuthnInterface) JWKS() (jwt.JSONWebKeySet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JWKS")
+	ret0, _ := ret[0].(jwt.JSONWebKeySet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JWKS indicates an expected call of JWKS.
+func (mr *MockAuthnInterfaceMockRecorder) JWKS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JWKS", reflect.TypeOf((*MockAuthnInterface)(nil).JWKS))
+}
+
+// Revoke mocks base method.
+func (m *MockAuthnInterface) Revoke(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockAuthnInterfaceMockRecorder) Revoke(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockAuthnInterface)(nil).Revoke), arg0, arg1, arg2)
+}
+
 // Sign mocks base method.
 func (m *MockAuthnInterface) Sign(arg0 context.Context, arg1 string) (authn.IToken, error) {
 	m.ctrl.T.Helper()
@@ -184,3 +485,18 @@ func (mr *MockAuthnInterfaceMockRecorder) Verify(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockAuthnInterface)(nil).Verify), arg0)
 }
+
+// VerifySignature mocks base method.
+func (m *MockAuthnInterface) VerifySignature(arg0 context.Context, arg1, arg2, arg3 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifySignature", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifySignature indicates an expected call of VerifySignature.
+func (mr *MockAuthnInterfaceMockRecorder) VerifySignature(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifySignature", reflect.TypeOf((*MockAuthnInterface)(nil).VerifySignature), arg0, arg1, arg2, arg3)
+}
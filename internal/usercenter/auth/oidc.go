@@ -0,0 +1,176 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/wire"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/superproj/onex/pkg/authn/oidc"
+	genericoptions "github.com/superproj/onex/pkg/options"
+)
+
+// OIDCProviderSet defines a wire set for logging in with an OpenID provider.
+var OIDCProviderSet = wire.NewSet(NewOIDC)
+
+// oidcStateExpire is how long the user has to finish the login at the OpenID provider.
+const oidcStateExpire = 10 * time.Minute
+
+// oidcStateKeyPrefix is the prefix of the redis keys holding the pending logins.
+const oidcStateKeyPrefix = "usercenter_oidc_state_"
+
+// ErrOIDCStateInvalid is returned when the state sent back by the OpenID provider
+// is unknown, has expired, or has already been used.
+var ErrOIDCStateInvalid = errors.New("oidc state is invalid or has expired")
+
+// OIDC is the OpenID Connect relying party of usercenter.
+type OIDC struct {
+	*oidc.Provider
+	opts *OIDCOptions
+
+	// states keeps the PKCE verifier and the nonce of the pending logins, so
+	// that only an opaque state is sent to the client and the provider.
+	states OIDCStateStore
+}
+
+// OIDCState is the state of a pending login, which is kept by usercenter until
+// the login is completed by the callback.
+type OIDCState struct {
+	Verifier string `json:"v"`
+	Nonce    string `json:"n"`
+	// UserID is set when an identity is being linked to an existing user.
+	UserID string `json:"u,omitempty"`
+}
+
+// OIDCStateStore keeps the states of the pending logins.
+type OIDCStateStore interface {
+	// Save stores the state of a pending login under the key until it expires.
+	Save(ctx context.Context, key string, state *OIDCState, expiration time.Duration) error
+	// Take returns the state stored under the key and deletes it, so that every
+	// state can only be used once. It returns ErrOIDCStateInvalid if there is none.
+	Take(ctx context.Context, key string) (*OIDCState, error)
+}
+
+// NewOIDC creates an OIDC relying party. It returns nil if OIDC login is disabled.
+// The pending logins are kept in redis, so that the callback can reach any instance.
+func NewOIDC(redisOpts *genericoptions.RedisOptions, opts *OIDCOptions) (*OIDC, func(), error) {
+	if opts.IssuerURL == "" {
+		return nil, func() {}, nil
+	}
+
+	rdb, err := redisOpts.NewClient()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return NewOIDCWithStore(opts, &redisOIDCStateStore{rdb: rdb}), func() { _ = rdb.Close() }, nil
+}
+
+// NewOIDCWithStore creates an OIDC relying party which keeps the pending logins in the given store.
+func NewOIDCWithStore(opts *OIDCOptions, states OIDCStateStore) *OIDC {
+	provider := oidc.New(oidc.Config{
+		IssuerURL:    opts.IssuerURL,
+		ClientID:     opts.ClientID,
+		ClientSecret: opts.ClientSecret,
+		RedirectURL:  opts.RedirectURL,
+		Scopes:       opts.Scopes,
+	})
+
+	return &OIDC{Provider: provider, opts: opts, states: states}
+}
+
+// Options returns the options of the relying party.
+func (o *OIDC) Options() *OIDCOptions {
+	return o.opts
+}
+
+// AuthorizeURL starts a new login and returns the URL of the consent page of
+// the provider along with the opaque state. userID is set when linking an
+// identity to an existing user.
+func (o *OIDC) AuthorizeURL(ctx context.Context, userID string) (url string, state string, err error) {
+	pending := &OIDCState{
+		Verifier: oidc.GenerateVerifier(),
+		Nonce:    oidc.GenerateNonce(),
+		UserID:   userID,
+	}
+
+	// Only the digest of the state is used as the key, so that the keys in redis
+	// can not be used to complete the logins.
+	state = oidc.GenerateNonce()
+	if err := o.states.Save(ctx, oidcStateKey(state), pending, oidcStateExpire); err != nil {
+		return "", "", err
+	}
+
+	if url, err = o.AuthCodeURL(ctx, state, pending.Nonce, pending.Verifier); err != nil {
+		return "", "", err
+	}
+
+	return url, state, nil
+}
+
+// Callback takes the pending login of the state returned by the provider, and
+// exchanges the code for the verified ID token. The state can not be used again,
+// even if the exchange fails.
+func (o *OIDC) Callback(ctx context.Context, code string, state string) (*oidc.IDToken, *OIDCState, error) {
+	pending, err := o.states.Take(ctx, oidcStateKey(state))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	idToken, err := o.Exchange(ctx, code, pending.Nonce, pending.Verifier)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return idToken, pending, nil
+}
+
+// oidcStateKey returns the key of the pending login of the state.
+func oidcStateKey(state string) string {
+	sum := sha256.Sum256([]byte(state))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// redisOIDCStateStore keeps the pending logins in redis.
+type redisOIDCStateStore struct {
+	rdb *redis.Client
+}
+
+// Save stores the state of a pending login under the key until it expires.
+func (s *redisOIDCStateStore) Save(ctx context.Context, key string, state *OIDCState, expiration time.Duration) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	return s.rdb.Set(ctx, oidcStateKeyPrefix+key, data, expiration).Err()
+}
+
+// Take returns the state stored under the key and deletes it atomically.
+func (s *redisOIDCStateStore) Take(ctx context.Context, key string) (*OIDCState, error) {
+	data, err := s.rdb.GetDel(ctx, oidcStateKeyPrefix+key).Bytes()
+	if err == redis.Nil {
+		return nil, ErrOIDCStateInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var state OIDCState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, ErrOIDCStateInvalid
+	}
+
+	return &state, nil
+}
@@ -0,0 +1,316 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package auth
+
+import (
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	known "github.com/superproj/onex/internal/pkg/known/usercenter"
+	jwtauthn "github.com/superproj/onex/pkg/authn/jwt"
+)
+
+// adminGroupRegexp restricts the admin group name, which is embedded in the casbin model.
+var adminGroupRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.:-]*$`)
+
+// AuthzOptions contains configuration items related to authorization.
+type AuthzOptions struct {
+	// AdminGroup is the role granting every permission in every domain. Users are
+	// made administrators by binding them to this role in the "*" domain.
+	AdminGroup string `json:"admin-group" mapstructure:"admin-group"`
+	// AdminRoutes are the requests only the members of the admin group may perform, every
+	// other user is denied them. Each one is a method and a route pattern, e.g. "DELETE /v1/chains/:name".
+	AdminRoutes []string `json:"admin-routes" mapstructure:"admin-routes"`
+}
+
+// NewAuthzOptions creates an AuthzOptions object with default parameters.
+func NewAuthzOptions() *AuthzOptions {
+	return &AuthzOptions{
+		AdminGroup:  DefaultAdminGroup,
+		AdminRoutes: []string{"POST /v1/chains", "PUT /v1/chains", "DELETE /v1/chains/:name"},
+	}
+}
+
+// Validate is used to parse and validate the parameters entered by the user at
+// the command line when the program starts.
+func (o *AuthzOptions) Validate() []error {
+	var errs []error
+
+	if !adminGroupRegexp.MatchString(o.AdminGroup) {
+		errs = append(errs, fmt.Errorf("--authz.admin-group must match %s", adminGroupRegexp))
+	}
+
+	for _, route := range o.AdminRoutes {
+		if _, _, ok := splitRoute(route); !ok {
+			errs = append(errs, fmt.Errorf("--authz.admin-routes: %q must be a method and a route, e.g. \"DELETE /v1/chains/:name\"", route))
+		}
+	}
+
+	return errs
+}
+
+// AddFlags adds flags related to authorization to the specified FlagSet.
+func (o *AuthzOptions) AddFlags(fs *pflag.FlagSet) {
+	if fs == nil {
+		return
+	}
+
+	fs.StringVar(&o.AdminGroup, "authz.admin-group", o.AdminGroup, "The role whose members are allowed to do everything.")
+	fs.StringSliceVar(&o.AdminRoutes, "authz.admin-routes", o.AdminRoutes, "Requests denied to the users out of the admin group, each one is a method and a route, e.g. \"DELETE /v1/chains/:name\".")
+}
+
+// splitRoute splits an admin route into its method and its route pattern.
+func splitRoute(s string) (string, string, bool) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 || !strings.HasPrefix(fields[1], "/") {
+		return "", "", false
+	}
+
+	return strings.ToUpper(fields[0]), fields[1], true
+}
+
+// OIDCOptions contains configuration items related to logging in with an OpenID provider.
+// OIDC login is disabled if IssuerURL is empty.
+type OIDCOptions struct {
+	// IssuerURL is the issuer identifier of the OpenID provider, which is used to discover its endpoints.
+	IssuerURL    string `json:"issuer-url" mapstructure:"issuer-url"`
+	ClientID     string `json:"client-id" mapstructure:"client-id"`
+	ClientSecret string `json:"client-secret" mapstructure:"client-secret"`
+	// RedirectURL is the callback URL registered at the provider, which must be
+	// served by the client and forwarded to the OIDCCallback API.
+	RedirectURL string   `json:"redirect-url" mapstructure:"redirect-url"`
+	Scopes      []string `json:"scopes" mapstructure:"scopes"`
+	// UsernameClaim is the ID token claim used as the username of auto-provisioned users.
+	UsernameClaim string `json:"username-claim" mapstructure:"username-claim"`
+	// AutoProvision creates a user the first time an unknown identity logs in.
+	// Otherwise the identity must be linked to an existing user first.
+	AutoProvision bool `json:"auto-provision" mapstructure:"auto-provision"`
+}
+
+// NewOIDCOptions creates an OIDCOptions object with default parameters.
+func NewOIDCOptions() *OIDCOptions {
+	return &OIDCOptions{
+		Scopes:        []string{"email", "profile"},
+		UsernameClaim: "preferred_username",
+	}
+}
+
+// Validate is used to parse and validate the parameters entered by the user at
+// the command line when the program starts.
+func (o *OIDCOptions) Validate() []error {
+	var errs []error
+
+	if o.IssuerURL == "" {
+		return errs
+	}
+
+	if o.ClientID == "" {
+		errs = append(errs, fmt.Errorf("--oidc.client-id is required when --oidc.issuer-url is set"))
+	}
+
+	if o.RedirectURL == "" {
+		errs = append(errs, fmt.Errorf("--oidc.redirect-url is required when --oidc.issuer-url is set"))
+	}
+
+	if o.UsernameClaim == "" {
+		errs = append(errs, fmt.Errorf("--oidc.username-claim can not be empty"))
+	}
+
+	return errs
+}
+
+// AddFlags adds flags related to OIDC login to the specified FlagSet.
+func (o *OIDCOptions) AddFlags(fs *pflag.FlagSet) {
+	if fs == nil {
+		return
+	}
+
+	fs.StringVar(&o.IssuerURL, "oidc.issuer-url", o.IssuerURL, "Issuer URL of the OpenID provider. OIDC login is disabled if it is empty.")
+	fs.StringVar(&o.ClientID, "oidc.client-id", o.ClientID, "Client ID registered at the OpenID provider.")
+	fs.StringVar(&o.ClientSecret, "oidc.client-secret", o.ClientSecret, "Client secret registered at the OpenID provider.")
+	fs.StringVar(&o.RedirectURL, "oidc.redirect-url", o.RedirectURL, "Callback URL registered at the OpenID provider.")
+	fs.StringSliceVar(&o.Scopes, "oidc.scopes", o.Scopes, "Scopes requested in addition to openid.")
+	fs.StringVar(&o.UsernameClaim, "oidc.username-claim", o.UsernameClaim, "ID token claim used as the username of auto-provisioned users.")
+	fs.BoolVar(&o.AutoProvision, "oidc.auto-provision", o.AutoProvision, "Create a user the first time an unknown identity logs in.")
+}
+
+// AuthnOptions contains configuration items related to signing access tokens.
+type AuthnOptions struct {
+	// SigningAlgorithm is one of HS512, RS256, ES256 and EdDSA. HS512 signs each
+	// token with a per-user secret, which can only be verified by the usercenter.
+	// The asymmetric algorithms sign with a rotating key set, whose public keys are
+	// served at /.well-known/jwks.json so that other services can verify tokens offline.
+	SigningAlgorithm string `json:"signing-algorithm" mapstructure:"signing-algorithm"`
+	// KeyRotationPeriod is how long a key signs tokens before a new key is generated.
+	KeyRotationPeriod time.Duration `json:"key-rotation-period" mapstructure:"key-rotation-period"`
+	// KeyGracePeriod is how long a rotated key is still published and accepted.
+	KeyGracePeriod time.Duration `json:"key-grace-period" mapstructure:"key-grace-period"`
+	// TrustedProxies are the IPs or CIDRs of the reverse proxies in front of the server.
+	// The client IP recorded in the login sessions is only taken from the X-Forwarded-For
+	// and X-Real-IP headers of the requests sent by them, since any client can set these headers.
+	TrustedProxies []string `json:"trusted-proxies" mapstructure:"trusted-proxies"`
+}
+
+// NewAuthnOptions creates an AuthnOptions object with default parameters.
+func NewAuthnOptions() *AuthnOptions {
+	return &AuthnOptions{
+		SigningAlgorithm:  "HS512",
+		KeyRotationPeriod: 24 * time.Hour,
+		KeyGracePeriod:    known.AccessTokenExpire,
+	}
+}
+
+// Validate is used to parse and validate the parameters entered by the user at
+// the command line when the program starts.
+func (o *AuthnOptions) Validate() []error {
+	var errs []error
+
+	switch o.SigningAlgorithm {
+	case "HS512", jwtauthn.RS256, jwtauthn.ES256, jwtauthn.EdDSA:
+	default:
+		errs = append(errs, fmt.Errorf("--authn.signing-algorithm must be one of HS512, RS256, ES256 and EdDSA"))
+	}
+
+	if o.KeyRotationPeriod < time.Minute {
+		errs = append(errs, fmt.Errorf("--authn.key-rotation-period must be at least 1m"))
+	}
+
+	// Tokens signed right before a rotation must stay verifiable until they expire.
+	if o.KeyGracePeriod < known.AccessTokenExpire {
+		errs = append(errs, fmt.Errorf("--authn.key-grace-period can not be shorter than the access token lifetime %s", known.AccessTokenExpire))
+	}
+
+	for _, proxy := range o.TrustedProxies {
+		if _, err := parsePrefix(proxy); err != nil {
+			errs = append(errs, fmt.Errorf("--authn.trusted-proxies: %w", err))
+		}
+	}
+
+	return errs
+}
+
+// TrustedProxyPrefixes returns the trusted proxies as prefixes, the invalid ones are skipped.
+func (o *AuthnOptions) TrustedProxyPrefixes() []netip.Prefix {
+	prefixes := make([]netip.Prefix, 0, len(o.TrustedProxies))
+	for _, proxy := range o.TrustedProxies {
+		if prefix, err := parsePrefix(proxy); err == nil {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+
+	return prefixes
+}
+
+// parsePrefix parses a CIDR, or an IP as the prefix containing only this IP.
+func parsePrefix(s string) (netip.Prefix, error) {
+	if prefix, err := netip.ParsePrefix(s); err == nil {
+		return prefix.Masked(), nil
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%q is neither an IP nor a CIDR", s)
+	}
+
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// AddFlags adds flags related to token signing to the specified FlagSet.
+func (o *AuthnOptions) AddFlags(fs *pflag.FlagSet) {
+	if fs == nil {
+		return
+	}
+
+	fs.StringVar(&o.SigningAlgorithm, "authn.signing-algorithm", o.SigningAlgorithm, "Algorithm used to sign access tokens, one of HS512, RS256, ES256 and EdDSA.")
+	fs.DurationVar(&o.KeyRotationPeriod, "authn.key-rotation-period", o.KeyRotationPeriod, "How long a key signs access tokens before it is rotated. Only used by asymmetric algorithms.")
+	fs.DurationVar(&o.KeyGracePeriod, "authn.key-grace-period", o.KeyGracePeriod, "How long a rotated key is still accepted. Only used by asymmetric algorithms.")
+	fs.StringSliceVar(&o.TrustedProxies, "authn.trusted-proxies", o.TrustedProxies, "IPs or CIDRs of the reverse proxies whose X-Forwarded-For and X-Real-IP headers are trusted.")
+}
+
+// PasswordOptions contains configuration items related to the password policy.
+type PasswordOptions struct {
+	// MinLength is the minimum number of characters of a password.
+	MinLength        int  `json:"min-length" mapstructure:"min-length"`
+	RequireUppercase bool `json:"require-uppercase" mapstructure:"require-uppercase"`
+	RequireLowercase bool `json:"require-lowercase" mapstructure:"require-lowercase"`
+	RequireDigit     bool `json:"require-digit" mapstructure:"require-digit"`
+	RequireSymbol    bool `json:"require-symbol" mapstructure:"require-symbol"`
+	// MaxLoginAttempts is the number of failed logins after which the user is
+	// locked out for LockoutDuration. Users are never locked out if it is 0.
+	MaxLoginAttempts int           `json:"max-login-attempts" mapstructure:"max-login-attempts"`
+	LockoutDuration  time.Duration `json:"lockout-duration" mapstructure:"lockout-duration"`
+	// MaxAge is how long a password can be used before it must be changed.
+	// Passwords never expire if it is 0.
+	MaxAge time.Duration `json:"max-age" mapstructure:"max-age"`
+	// HistorySize is the number of previous passwords which can not be reused,
+	// in addition to the current one.
+	HistorySize int `json:"history-size" mapstructure:"history-size"`
+}
+
+// NewPasswordOptions creates a PasswordOptions object with default parameters.
+func NewPasswordOptions() *PasswordOptions {
+	return &PasswordOptions{
+		MinLength:        8,
+		RequireLowercase: true,
+		RequireDigit:     true,
+		MaxLoginAttempts: 5,
+		LockoutDuration:  15 * time.Minute,
+		HistorySize:      5,
+	}
+}
+
+// Validate is used to parse and validate the parameters entered by the user at
+// the command line when the program starts.
+func (o *PasswordOptions) Validate() []error {
+	var errs []error
+
+	// The length of a password is limited to [6, 16] by the API.
+	if o.MinLength < 6 || o.MinLength > 16 {
+		errs = append(errs, fmt.Errorf("--password.min-length must be between 6 and 16"))
+	}
+
+	if o.MaxLoginAttempts < 0 {
+		errs = append(errs, fmt.Errorf("--password.max-login-attempts can not be negative"))
+	}
+
+	if o.MaxLoginAttempts > 0 && o.LockoutDuration < time.Second {
+		errs = append(errs, fmt.Errorf("--password.lockout-duration must be at least 1s"))
+	}
+
+	if o.MaxAge < 0 {
+		errs = append(errs, fmt.Errorf("--password.max-age can not be negative"))
+	}
+
+	if o.HistorySize < 0 {
+		errs = append(errs, fmt.Errorf("--password.history-size can not be negative"))
+	}
+
+	return errs
+}
+
+// AddFlags adds flags related to the password policy to the specified FlagSet.
+func (o *PasswordOptions) AddFlags(fs *pflag.FlagSet) {
+	if fs == nil {
+		return
+	}
+
+	fs.IntVar(&o.MinLength, "password.min-length", o.MinLength, "Minimum number of characters of a password.")
+	fs.BoolVar(&o.RequireUppercase, "password.require-uppercase", o.RequireUppercase, "Require at least one uppercase letter in a password.")
+	fs.BoolVar(&o.RequireLowercase, "password.require-lowercase", o.RequireLowercase, "Require at least one lowercase letter in a password.")
+	fs.BoolVar(&o.RequireDigit, "password.require-digit", o.RequireDigit, "Require at least one digit in a password.")
+	fs.BoolVar(&o.RequireSymbol, "password.require-symbol", o.RequireSymbol, "Require at least one symbol in a password.")
+	fs.IntVar(&o.MaxLoginAttempts, "password.max-login-attempts", o.MaxLoginAttempts, "Number of failed logins after which the user is locked out. 0 disables the lockout.")
+	fs.DurationVar(&o.LockoutDuration, "password.lockout-duration", o.LockoutDuration, "How long a user is locked out after too many failed logins.")
+	fs.DurationVar(&o.MaxAge, "password.max-age", o.MaxAge, "How long a password can be used before it must be changed. 0 means passwords never expire.")
+	fs.IntVar(&o.HistorySize, "password.history-size", o.HistorySize, "Number of previous passwords which can not be reused.")
+}
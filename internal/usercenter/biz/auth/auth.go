@@ -10,13 +10,22 @@ package auth
 
 import (
 	"context"
+	"errors"
+	"net/netip"
+	"time"
+
+	"gorm.io/gorm"
 
 	"github.com/superproj/onex/internal/pkg/onexx"
 	"github.com/superproj/onex/internal/usercenter/auth"
+	"github.com/superproj/onex/internal/usercenter/biz/mfa"
+	"github.com/superproj/onex/internal/usercenter/biz/session"
 	"github.com/superproj/onex/internal/usercenter/locales"
+	"github.com/superproj/onex/internal/usercenter/model"
 	"github.com/superproj/onex/internal/usercenter/store"
 	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
 	"github.com/superproj/onex/pkg/authn"
+	jwtauthn "github.com/superproj/onex/pkg/authn/jwt"
 	"github.com/superproj/onex/pkg/i18n"
 	"github.com/superproj/onex/pkg/log"
 )
@@ -26,6 +35,15 @@ type AuthBiz interface {
 	// Login authenticates a user and returns a token.
 	Login(ctx context.Context, rq *v1.LoginRequest) (*v1.LoginReply, error)
 
+	// LoginMFA verifies the second factor of a login and returns a token.
+	LoginMFA(ctx context.Context, rq *v1.LoginMFARequest) (*v1.LoginReply, error)
+
+	// OIDCAuthorize starts a login with the OpenID provider.
+	OIDCAuthorize(ctx context.Context, rq *v1.OIDCAuthorizeRequest) (*v1.OIDCAuthorizeReply, error)
+
+	// OIDCCallback completes a login with the OpenID provider and returns a token.
+	OIDCCallback(ctx context.Context, rq *v1.OIDCCallbackRequest) (*v1.LoginReply, error)
+
 	// Logout invalidates a token.
 	Logout(ctx context.Context, rq *v1.LogoutRequest) error
 
@@ -35,22 +53,39 @@ type AuthBiz interface {
 	// Authenticate validates an access token and returns the associated user ID.
 	Authenticate(ctx context.Context, accessToken string) (*v1.AuthenticateResponse, error)
 
-	// Authorize checks if a user has the necessary permissions to perform an action on an object.
-	Authorize(ctx context.Context, sub, obj, act string) (*v1.AuthorizeResponse, error)
+	// VerifySignature verifies a request signed with a secret and returns the owner of the secret.
+	VerifySignature(ctx context.Context, rq *v1.VerifySignatureRequest) (*v1.AuthenticateResponse, error)
+
+	// Authorize checks if a user has the necessary permissions to perform an action on an object in a domain.
+	Authorize(ctx context.Context, sub, dom, obj, act string) (*v1.AuthorizeResponse, error)
 }
 
 // The authBiz struct contains dependencies rquired for authentication and authorization.
 type authBiz struct {
-	ds    store.IStore
-	authn authn.Authenticator
-	auth  auth.AuthProvider
+	ds       store.IStore
+	authn    authn.Authenticator
+	auth     auth.AuthProvider
+	oidc     *auth.OIDC
+	lockout  auth.LockoutInterface
+	password *auth.PasswordOptions
+	// proxies are the reverse proxies trusted to report the client IP of a login.
+	proxies []netip.Prefix
 }
 
 var _ AuthBiz = (*authBiz)(nil)
 
 // New creates a new authBiz instance.
-func New(ds store.IStore, authn authn.Authenticator, auth auth.AuthProvider) *authBiz {
-	return &authBiz{authn: authn, auth: auth, ds: ds}
+// oidc is nil if OIDC login is disabled.
+func New(
+	ds store.IStore,
+	authn authn.Authenticator,
+	auth auth.AuthProvider,
+	oidc *auth.OIDC,
+	lockout auth.LockoutInterface,
+	password *auth.PasswordOptions,
+	proxies []netip.Prefix,
+) *authBiz {
+	return &authBiz{authn: authn, auth: auth, oidc: oidc, lockout: lockout, password: password, proxies: proxies, ds: ds}
 }
 
 // Login authenticates a user and returns a token.
@@ -62,6 +97,17 @@ func (b *authBiz) Login(ctx context.Context, rq *v1.LoginRequest) (*v1.LoginRepl
 		return nil, i18n.FromContext(ctx).E(locales.RecordNotFound)
 	}
 
+	// A locked out user can not login even with the correct password,
+	// otherwise the password could still be guessed.
+	locked, err := b.lockout.Locked(ctx, userM.Username)
+	if err != nil {
+		log.C(ctx).Errorw(err, "Failed to check login lockout")
+		return nil, err
+	}
+	if locked {
+		return nil, i18n.FromContext(ctx).E(locales.UserLocked)
+	}
+
 	// Compare the obtained user information and the input password.
 	// Because the password `userM.Password` stored in the database is an
 	// encrypted password and cannot be decrypted, the comparison here
@@ -70,24 +116,139 @@ func (b *authBiz) Login(ctx context.Context, rq *v1.LoginRequest) (*v1.LoginRepl
 	// one stored in the database. If they match, the password is verified.
 	if err := authn.Compare(userM.Password, rq.Password); err != nil {
 		log.C(ctx).Errorw(err, "Password does not match")
-		return nil, i18n.FromContext(ctx).E(locales.IncorrectPassword)
+		return nil, b.fail(ctx, userM.Username)
+	}
+
+	// An expired password must be changed with `UpdatePassword` first.
+	if b.password.MaxAge > 0 && time.Since(userM.PasswordUpdatedAt) > b.password.MaxAge {
+		return nil, i18n.FromContext(ctx).E(locales.PasswordExpired)
+	}
+
+	// If the second factor is required, only return a token which can be
+	// exchanged by `LoginMFA` after the passcode is verified. The failed logins
+	// are kept until then, so that they add up with the failed passcodes.
+	if userM.TotpEnabled || userM.MfaRequired {
+		return b.challenge(ctx, userM)
+	}
+
+	b.reset(ctx, userM.Username)
+	return b.issue(ctx, userM.UserID, rq.Device)
+}
+
+// reset clears the failed logins of the user once the login has succeeded.
+func (b *authBiz) reset(ctx context.Context, username string) {
+	if err := b.lockout.Reset(ctx, username); err != nil {
+		log.C(ctx).Errorw(err, "Failed to reset failed logins")
+	}
+}
+
+// fail records a failed login, and returns the error to report to the user.
+func (b *authBiz) fail(ctx context.Context, username string) error {
+	locked, err := b.lockout.Fail(ctx, username)
+	if err != nil {
+		log.C(ctx).Errorw(err, "Failed to record failed login")
+	}
+	if locked {
+		return i18n.FromContext(ctx).E(locales.UserLocked)
+	}
+
+	return i18n.FromContext(ctx).E(locales.IncorrectPassword)
+}
+
+// LoginMFA verifies the second factor of a login and returns a token.
+func (b *authBiz) LoginMFA(ctx context.Context, rq *v1.LoginMFARequest) (*v1.LoginReply, error) {
+	userM, err := b.verifyChallenge(ctx, rq.MfaToken)
+	if err != nil {
+		log.C(ctx).Errorw(err, "Failed to verify mfa token")
+		return nil, err
 	}
 
-	// If the comparison passes, it means the password is correct.
+	// The passcodes are counted by the login lockout like the passwords,
+	// otherwise the second factor could be guessed with new mfa tokens.
+	locked, err := b.lockout.Locked(ctx, userM.Username)
+	if err != nil {
+		log.C(ctx).Errorw(err, "Failed to check login lockout")
+		return nil, err
+	}
+	if locked {
+		return nil, i18n.FromContext(ctx).E(locales.UserLocked)
+	}
+
+	// A user who is required to use MFA but has not enrolled yet activates
+	// the secret returned by `Login` with the first passcode.
+	var codes []string
+	if userM.TotpEnabled {
+		err = mfa.Verify(userM, rq.Code, rq.RecoveryCode)
+	} else {
+		codes, err = mfa.Activate(userM, rq.Code)
+	}
+	if err != nil {
+		log.C(ctx).Errorw(err, "Failed to verify second factor")
+		if locked, ferr := b.lockout.Fail(ctx, userM.Username); ferr != nil {
+			log.C(ctx).Errorw(ferr, "Failed to record failed login")
+		} else if locked {
+			return nil, i18n.FromContext(ctx).E(locales.UserLocked)
+		}
+		return nil, err
+	}
+
+	if err := b.ds.Users().Update(ctx, userM); err != nil {
+		return nil, err
+	}
+	b.reset(ctx, userM.Username)
+
+	reply, err := b.issue(ctx, userM.UserID, rq.Device)
+	if err != nil {
+		return nil, err
+	}
+	reply.RecoveryCodes = codes
+
+	return reply, nil
+}
+
+// issue starts a new login session of the user, and generates a refresh token and an access token for it.
+func (b *authBiz) issue(ctx context.Context, userID string, device string) (*v1.LoginReply, error) {
+	sessionM := session.NewModel(ctx, userID, device, b.proxies)
+	reply, err := b.sign(ctx, sessionM)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.ds.Sessions().Create(ctx, sessionM); err != nil {
+		log.C(ctx).Errorw(err, "Failed to create login session")
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+// sign generates a refresh token and an access token of the session. Both tokens
+// carry the session id in the `jti` claim, so that they are revoked with the session.
+func (b *authBiz) sign(ctx context.Context, sessionM *model.SessionM) (*v1.LoginReply, error) {
+	ctx = jwtauthn.NewTokenIDContext(ctx, sessionM.SessionID)
+
 	// Call `b.authn.Sign` to generate a refresh token.
-	refreshToken, err := b.authn.Sign(ctx, userM.UserID)
+	refreshToken, err := b.authn.Sign(ctx, sessionM.UserID)
 	if err != nil {
 		log.C(ctx).Errorw(err, "Failed to generate refresh token")
 		return nil, i18n.FromContext(ctx).E(locales.JWTTokenSignFail)
 	}
 
 	// Generate an access token for resource access.
-	accessToken, err := b.auth.Sign(ctx, userM.UserID)
+	accessToken, err := b.auth.Sign(ctx, sessionM.UserID)
 	if err != nil {
 		log.C(ctx).Errorw(err, "Failed to generate access token")
 		return nil, i18n.FromContext(ctx).E(locales.JWTTokenSignFail)
 	}
 
+	// The session lasts as long as the last of its tokens.
+	expiresAt := refreshToken.GetExpiresAt()
+	if accessToken.GetExpiresAt() > expiresAt {
+		expiresAt = accessToken.GetExpiresAt()
+	}
+	sessionM.IssuedAt = time.Now()
+	sessionM.ExpiresAt = time.Unix(expiresAt, 0)
+
 	// Return
 	return &v1.LoginReply{
 		RefreshToken: refreshToken.GetToken(),
@@ -97,13 +258,23 @@ func (b *authBiz) Login(ctx context.Context, rq *v1.LoginRequest) (*v1.LoginRepl
 	}, nil
 }
 
-// Logout invalidates a token.
+// Logout invalidates a token, and revokes the login session of the caller.
 func (b *authBiz) Logout(ctx context.Context, rq *v1.LogoutRequest) error {
 	if err := b.authn.Destroy(ctx, onexx.FromAccessToken(ctx)); err != nil {
 		log.C(ctx).Errorw(err, "Failed to remove token from cache")
 		return err
 	}
 
+	sessionM, err := b.currentSession(ctx)
+	if err != nil || sessionM == nil {
+		return err
+	}
+
+	if err := session.Revoke(ctx, b.ds, b.auth, sessionM); err != nil {
+		log.C(ctx).Errorw(err, "Failed to revoke login session")
+		return err
+	}
+
 	return nil
 }
 
@@ -112,25 +283,46 @@ func (b *authBiz) RefreshToken(ctx context.Context, rq *v1.RefreshTokenRequest)
 	// Because a new token is issued, the old token needs to be destroyed.
 	_ = b.authn.Destroy(ctx, onexx.FromAccessToken(ctx))
 
-	userID := onexx.FromUserID(ctx)
-	refreshToken, err := b.authn.Sign(ctx, userID)
+	sessionM, err := b.currentSession(ctx)
 	if err != nil {
-		log.C(ctx).Errorw(err, "Failed to generate refresh token")
-		return nil, i18n.FromContext(ctx).E(locales.JWTTokenSignFail)
+		return nil, err
 	}
 
-	accessToken, err := b.auth.Sign(ctx, userID)
+	// Tokens issued before sessions were recorded start a new session.
+	if sessionM == nil {
+		return b.issue(ctx, onexx.FromUserID(ctx), "")
+	}
+
+	reply, err := b.sign(ctx, sessionM)
 	if err != nil {
-		log.C(ctx).Errorw(err, "Failed to generate access token")
-		return nil, i18n.FromContext(ctx).E(locales.JWTTokenSignFail)
+		return nil, err
 	}
 
-	return &v1.LoginReply{
-		RefreshToken: refreshToken.GetToken(),
-		AccessToken:  accessToken.GetToken(),
-		Type:         accessToken.GetTokenType(),
-		ExpiresAt:    accessToken.GetExpiresAt(),
-	}, nil
+	if err := b.ds.Sessions().Update(ctx, sessionM); err != nil {
+		log.C(ctx).Errorw(err, "Failed to update login session")
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+// currentSession returns the login session of the caller, or nil if its token carries no session.
+func (b *authBiz) currentSession(ctx context.Context) (*model.SessionM, error) {
+	claims, ok := onexx.FromContext(ctx)
+	if !ok || claims.ID == "" {
+		return nil, nil
+	}
+
+	sessionM, err := b.ds.Sessions().Get(ctx, onexx.FromUserID(ctx), claims.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorSessionNotFound("session %s not found", claims.ID)
+		}
+
+		return nil, err
+	}
+
+	return sessionM, nil
 }
 
 // Authenticate validates an access token and returns the associated user ID.
@@ -144,9 +336,20 @@ func (b *authBiz) Authenticate(ctx context.Context, accessToken string) (*v1.Aut
 	return &v1.AuthenticateResponse{UserID: userID}, nil
 }
 
-// Authorize checks if a user has the necessary permissions to perform an action on an object.
-func (b *authBiz) Authorize(ctx context.Context, sub, obj, act string) (*v1.AuthorizeResponse, error) {
-	allowed, err := b.auth.Authorize(sub, obj, act)
+// VerifySignature verifies a request signed with a secret and returns the owner of the secret.
+func (b *authBiz) VerifySignature(ctx context.Context, rq *v1.VerifySignatureRequest) (*v1.AuthenticateResponse, error) {
+	userID, err := b.auth.VerifySignature(ctx, rq.SecretID, rq.StringToSign, rq.Signature)
+	if err != nil {
+		log.C(ctx).Errorw(err, "Failed to verify request signature", "secretID", rq.SecretID)
+		return nil, err
+	}
+
+	return &v1.AuthenticateResponse{UserID: userID}, nil
+}
+
+// Authorize checks if a user has the necessary permissions to perform an action on an object in a domain.
+func (b *authBiz) Authorize(ctx context.Context, sub, dom, obj, act string) (*v1.AuthorizeResponse, error) {
+	allowed, err := b.auth.Authorize(sub, dom, obj, act)
 	if err != nil {
 		log.C(ctx).Errorw(err, "Failed to authorize")
 		return nil, err
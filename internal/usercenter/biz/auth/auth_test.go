@@ -0,0 +1,121 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/superproj/onex/internal/usercenter/auth"
+	"github.com/superproj/onex/internal/usercenter/locales"
+	"github.com/superproj/onex/internal/usercenter/model"
+	"github.com/superproj/onex/internal/usercenter/store"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+	"github.com/superproj/onex/pkg/authn/jwt"
+	"github.com/superproj/onex/pkg/authn/totp"
+	"github.com/superproj/onex/pkg/i18n"
+)
+
+// fakeLockout counts the failed logins in memory.
+type fakeLockout struct {
+	maxAttempts int
+	failures    map[string]int
+}
+
+func (l *fakeLockout) Locked(ctx context.Context, username string) (bool, error) {
+	return l.failures[username] >= l.maxAttempts, nil
+}
+
+func (l *fakeLockout) Fail(ctx context.Context, username string) (bool, error) {
+	l.failures[username]++
+	return l.failures[username] >= l.maxAttempts, nil
+}
+
+func (l *fakeLockout) Reset(ctx context.Context, username string) error {
+	delete(l.failures, username)
+	return nil
+}
+
+func newMFATestBiz(t *testing.T, userM *model.UserM, lockout auth.LockoutInterface) *authBiz {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	ds := store.NewMockIStore(ctrl)
+	users := store.NewMockUserStore(ctrl)
+	sessions := store.NewMockSessionStore(ctrl)
+	ds.EXPECT().Users().Return(users).AnyTimes()
+	ds.EXPECT().Sessions().Return(sessions).AnyTimes()
+	users.EXPECT().Fetch(gomock.Any(), map[string]any{"user_id": userM.UserID}).Return(userM, nil).AnyTimes()
+	users.EXPECT().Update(gomock.Any(), userM).Return(nil).AnyTimes()
+	sessions.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	authenticator := jwt.New(nil)
+	token, _ := authenticator.Sign(context.Background(), userM.UserID)
+	provider := auth.NewMockAuthProvider(ctrl)
+	provider.EXPECT().Sign(gomock.Any(), userM.UserID).Return(token, nil).AnyTimes()
+
+	return New(ds, authenticator, provider, nil, lockout, &auth.PasswordOptions{}, nil)
+}
+
+func newMFATestUser(t *testing.T) *model.UserM {
+	t.Helper()
+
+	secret, err := totp.GenerateSecret()
+	assert.Nil(t, err)
+	return &model.UserM{UserID: "user-colin", Username: "colin", Password: "hash", TotpEnabled: true, TotpSecret: secret}
+}
+
+func Test_authBiz_LoginMFA_Lockout(t *testing.T) {
+	ctx := context.Background()
+	userM := newMFATestUser(t)
+	lockout := &fakeLockout{maxAttempts: 3, failures: map[string]int{}}
+	b := newMFATestBiz(t, userM, lockout)
+	userLocked := i18n.FromContext(ctx).E(locales.UserLocked).Error()
+
+	// Each failed passcode counts, whichever mfa token it is sent with.
+	for i := 0; i < 2; i++ {
+		challenge, err := b.challenge(ctx, userM)
+		assert.Nil(t, err)
+		_, err = b.LoginMFA(ctx, &v1.LoginMFARequest{MfaToken: challenge.MfaToken, Code: "000000x"})
+		assert.Equal(t, v1.ErrorReason_MFACodeInvalid.String(), errors.Reason(err))
+	}
+
+	challenge, err := b.challenge(ctx, userM)
+	assert.Nil(t, err)
+	_, err = b.LoginMFA(ctx, &v1.LoginMFARequest{MfaToken: challenge.MfaToken, Code: "000000x"})
+	assert.EqualError(t, err, userLocked)
+
+	// A locked out user is refused even with the right passcode.
+	code, _ := totp.Generate(userM.TotpSecret, time.Now())
+	_, err = b.LoginMFA(ctx, &v1.LoginMFARequest{MfaToken: challenge.MfaToken, Code: code})
+	assert.EqualError(t, err, userLocked)
+}
+
+func Test_authBiz_LoginMFA_Replay(t *testing.T) {
+	ctx := context.Background()
+	userM := newMFATestUser(t)
+	lockout := &fakeLockout{maxAttempts: 3, failures: map[string]int{"colin": 1}}
+	b := newMFATestBiz(t, userM, lockout)
+
+	challenge, err := b.challenge(ctx, userM)
+	assert.Nil(t, err)
+	code, _ := totp.Generate(userM.TotpSecret, time.Now())
+	reply, err := b.LoginMFA(ctx, &v1.LoginMFARequest{MfaToken: challenge.MfaToken, Code: code})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, reply.AccessToken)
+	// The failed logins are only cleared once the second factor is verified.
+	assert.Zero(t, lockout.failures["colin"])
+
+	_, err = b.LoginMFA(ctx, &v1.LoginMFARequest{MfaToken: challenge.MfaToken, Code: code})
+	assert.Equal(t, v1.ErrorReason_MFACodeInvalid.String(), errors.Reason(err))
+	assert.Equal(t, 1, lockout.failures["colin"])
+}
@@ -0,0 +1,92 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	ucknown "github.com/superproj/onex/internal/pkg/known/usercenter"
+	"github.com/superproj/onex/internal/usercenter/biz/mfa"
+	"github.com/superproj/onex/internal/usercenter/model"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+	"github.com/superproj/onex/pkg/authn/totp"
+)
+
+// challenge returns a short-lived mfa token instead of the login tokens. If the
+// user has not activated TOTP yet, the pending secret is returned too, and is
+// generated first if the user has not enrolled at all.
+func (b *authBiz) challenge(ctx context.Context, userM *model.UserM) (*v1.LoginReply, error) {
+	reply := &v1.LoginReply{}
+	if !userM.TotpEnabled {
+		if userM.TotpSecret == "" {
+			if _, err := mfa.Enroll(userM); err != nil {
+				return nil, err
+			}
+
+			if err := b.ds.Users().Update(ctx, userM); err != nil {
+				return nil, err
+			}
+		}
+
+		reply.TotpSecret, reply.TotpUrl = userM.TotpSecret, totp.URL(mfa.Issuer, userM.Username, userM.TotpSecret)
+	}
+
+	expiresAt := time.Now().Add(ucknown.MFATokenExpire).Unix()
+	reply.MfaToken = fmt.Sprintf("%s.%d.%s", base64.RawURLEncoding.EncodeToString([]byte(userM.UserID)), expiresAt, mfaSignature(userM, expiresAt))
+	reply.ExpiresAt = expiresAt
+
+	return reply, nil
+}
+
+// verifyChallenge verifies the mfa token returned by `challenge` and returns the user it was issued to.
+func (b *authBiz) verifyChallenge(ctx context.Context, token string) (*model.UserM, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, v1.ErrorMFATokenInvalid("malformed mfa token")
+	}
+
+	userID, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, v1.ErrorMFATokenInvalid("malformed mfa token")
+	}
+
+	expiresAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, v1.ErrorMFATokenInvalid("malformed mfa token")
+	}
+
+	if time.Now().Unix() > expiresAt {
+		return nil, v1.ErrorMFATokenInvalid("mfa token has expired")
+	}
+
+	userM, err := b.ds.Users().Fetch(ctx, map[string]any{"user_id": string(userID)})
+	if err != nil {
+		return nil, v1.ErrorMFATokenInvalid("mfa token is invalid")
+	}
+
+	if !hmac.Equal([]byte(parts[2]), []byte(mfaSignature(userM, expiresAt))) {
+		return nil, v1.ErrorMFATokenInvalid("mfa token is invalid")
+	}
+
+	return userM, nil
+}
+
+// mfaSignature signs the mfa token with a key derived from the password hash and
+// the TOTP secret of the user, so that a token is invalidated once either of them changes.
+func mfaSignature(userM *model.UserM, expiresAt int64) string {
+	key := sha256.Sum256([]byte(userM.Password + "\x00" + userM.TotpSecret))
+	mac := hmac.New(sha256.New, key[:])
+	fmt.Fprintf(mac, "%s.%d", userM.UserID, expiresAt)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
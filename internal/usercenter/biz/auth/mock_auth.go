@@ -57,18 +57,18 @@ func (mr *MockAuthBizMockRecorder) Authenticate(arg0, arg1 any) *gomock.Call {
 }
 
 // Authorize mocks base method.
-func (m *MockAuthBiz) Authorize(arg0 context.Context, arg1, arg2, arg3 string) (*v1.AuthorizeResponse, error) {
+func (m *MockAuthBiz) Authorize(arg0 context.Context, arg1, arg2, arg3, arg4 string) (*v1.AuthorizeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Authorize", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "Authorize", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(*v1.AuthorizeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Authorize indicates an expected call of Authorize.
-func (mr *MockAuthBizMockRecorder) Authorize(arg0, arg1, arg2, arg3 any) *gomock.Call {
+func (mr *MockAuthBizMockRecorder) Authorize(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockAuthBiz)(nil).Authorize), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockAuthBiz)(nil).Authorize), arg0, arg1, arg2, arg3, arg4)
 }
 
 // Login mocks base method.
@@ -86,6 +86,21 @@ func (mr *MockAuthBizMockRecorder) Login(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthBiz)(nil).Login), arg0, arg1)
 }
 
+// LoginMFA mocks base method.
+func (m *MockAuthBiz) LoginMFA(arg0 context.Context, arg1 *v1.LoginMFARequest) (*v1.LoginReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginMFA", arg0, arg1)
+	ret0, _ := ret[0].(*v1.LoginReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoginMFA indicates an expected call of LoginMFA.
+func (mr *MockAuthBizMockRecorder) LoginMFA(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginMFA", reflect.TypeOf((*MockAuthBiz)(nil).LoginMFA), arg0, arg1)
+}
+
 // Logout mocks base method.
 func (m *MockAuthBiz) Logout(arg0 context.Context, arg1 *v1.LogoutRequest) error {
 	m.ctrl.T.Helper()
@@ -100,6 +115,36 @@ func (mr *MockAuthBizMockRecorder) Logout(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthBiz)(nil).Logout), arg0, arg1)
 }
 
+// OIDCAuthorize mocks base method.
+func (m *MockAuthBiz) OIDCAuthorize(arg0 context.Context, arg1 *v1.OIDCAuthorizeRequest) (*v1.OIDCAuthorizeReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OIDCAuthorize", arg0, arg1)
+	ret0, _ := ret[0].(*v1.OIDCAuthorizeReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OIDCAuthorize indicates an expected call of OIDCAuthorize.
+func (mr *MockAuthBizMockRecorder) OIDCAuthorize(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OIDCAuthorize", reflect.TypeOf((*MockAuthBiz)(nil).OIDCAuthorize), arg0, arg1)
+}
+
+// OIDCCallback mocks base method.
+func (m *MockAuthBiz) OIDCCallback(arg0 context.Context, arg1 *v1.OIDCCallbackRequest) (*v1.LoginReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OIDCCallback", arg0, arg1)
+	ret0, _ := ret[0].(*v1.LoginReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OIDCCallback indicates an expected call of OIDCCallback.
+func (mr *MockAuthBizMockRecorder) OIDCCallback(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OIDCCallback", reflect.TypeOf((*MockAuthBiz)(nil).OIDCCallback), arg0, arg1)
+}
+
 // RefreshToken mocks base method.
 func (m *MockAuthBiz) RefreshToken(arg0 context.Context, arg1 *v1.RefreshTokenRequest) (*v1.LoginReply, error) {
 	m.ctrl.T.Helper()
@@ -114,3 +159,18 @@ func (mr *MockAuthBizMockRecorder) RefreshToken(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshToken", reflect.TypeOf((*MockAuthBiz)(nil).RefreshToken), arg0, arg1)
 }
+
+// VerifySignature mocks base method.
+func (m *MockAuthBiz) VerifySignature(arg0 context.Context, arg1 *v1.VerifySignatureRequest) (*v1.AuthenticateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifySignature", arg0, arg1)
+	ret0, _ := ret[0].(*v1.AuthenticateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifySignature indicates an expected call of VerifySignature.
+func (mr *MockAuthBizMockRecorder) VerifySignature(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifySignature", reflect.TypeOf((*MockAuthBiz)(nil).VerifySignature), arg0, arg1)
+}
@@ -0,0 +1,170 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/superproj/onex/internal/pkg/onexx"
+	"github.com/superproj/onex/internal/usercenter/biz/user"
+	"github.com/superproj/onex/internal/usercenter/model"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+	"github.com/superproj/onex/pkg/authn/oidc"
+	"github.com/superproj/onex/pkg/log"
+)
+
+// invalidUsernameChars matches the characters which are not allowed in the username of a provisioned user.
+var invalidUsernameChars = regexp.MustCompile(`[^a-zA-Z0-9_.-]`)
+
+// OIDCAuthorize starts a login with the OpenID provider.
+func (b *authBiz) OIDCAuthorize(ctx context.Context, rq *v1.OIDCAuthorizeRequest) (*v1.OIDCAuthorizeReply, error) {
+	if b.oidc == nil {
+		return nil, v1.ErrorOIDCDisabled("OIDC login is disabled")
+	}
+
+	url, state, err := b.oidc.AuthorizeURL(ctx, "")
+	if err != nil {
+		log.C(ctx).Errorw(err, "Failed to create oidc authorization url")
+		return nil, err
+	}
+
+	return &v1.OIDCAuthorizeReply{Url: url, State: state}, nil
+}
+
+// OIDCCallback completes a login with the OpenID provider and returns a token.
+// If the login was started by LinkIdentity, the identity is linked to the user
+// who started it first, which must also be the authenticated caller.
+func (b *authBiz) OIDCCallback(ctx context.Context, rq *v1.OIDCCallbackRequest) (*v1.LoginReply, error) {
+	if b.oidc == nil {
+		return nil, v1.ErrorOIDCDisabled("OIDC login is disabled")
+	}
+
+	idToken, state, err := b.oidc.Callback(ctx, rq.Code, rq.State)
+	if err != nil {
+		log.C(ctx).Errorw(err, "Failed to complete oidc login")
+		return nil, v1.ErrorOIDCLoginFailed(err.Error())
+	}
+
+	var userM *model.UserM
+	if state.UserID != "" {
+		// Otherwise the link could be completed by anyone who is sent the
+		// redirect URL, and link their identity to the user.
+		if onexx.FromUserID(ctx) != state.UserID {
+			return nil, v1.ErrorUserOperationForbidden("identity can only be linked by the user who started it")
+		}
+		userM, err = b.link(ctx, state.UserID, idToken)
+	} else {
+		userM, err = b.identify(ctx, idToken)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The second factor of the user is still required, like the password login.
+	if userM.TotpEnabled || userM.MfaRequired {
+		return b.challenge(ctx, userM)
+	}
+
+	return b.issue(ctx, userM.UserID, "")
+}
+
+// link links the identity to the user, unless it has been linked to another user.
+func (b *authBiz) link(ctx context.Context, userID string, idToken *oidc.IDToken) (*model.UserM, error) {
+	identityM, err := b.ds.Identities().Get(ctx, idToken.Issuer, idToken.Subject)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if identityM != nil && identityM.UserID != userID {
+		return nil, v1.ErrorIdentityAlreadyLinked("identity %s has been linked to another user", idToken.Subject)
+	}
+
+	if identityM == nil {
+		identityM = &model.UserIdentityM{UserID: userID, Issuer: idToken.Issuer, Subject: idToken.Subject, Email: idToken.Email}
+		if err := b.ds.Identities().Create(ctx, identityM); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.ds.Users().Fetch(ctx, map[string]any{"user_id": userID})
+}
+
+// identify returns the user linked to the identity. If there is none, a new
+// user is provisioned when auto-provisioning is enabled.
+func (b *authBiz) identify(ctx context.Context, idToken *oidc.IDToken) (*model.UserM, error) {
+	identityM, err := b.ds.Identities().Get(ctx, idToken.Issuer, idToken.Subject)
+	if err == nil {
+		return b.ds.Users().Fetch(ctx, map[string]any{"user_id": identityM.UserID})
+	}
+
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if !b.oidc.Options().AutoProvision {
+		return nil, v1.ErrorIdentityNotLinked("identity %s has not been linked to any user", idToken.Subject)
+	}
+
+	return b.provision(ctx, idToken)
+}
+
+// provision creates a user for the identity. Existing users are never linked
+// automatically, since the username claim can be chosen by the identity owner.
+func (b *authBiz) provision(ctx context.Context, idToken *oidc.IDToken) (*model.UserM, error) {
+	username := provisionedUsername(idToken, b.oidc.Options().UsernameClaim)
+	if _, err := b.ds.Users().GetByUsername(ctx, username); err == nil {
+		return nil, v1.ErrorIdentityNotLinked("user %s already exists, login and link the identity to it first", username)
+	}
+
+	nickname := idToken.Name
+	if nickname == "" {
+		nickname = username
+	}
+
+	// The password is random since the user logs in with the OpenID provider.
+	reply, err := user.New(b.ds, b.auth, b.lockout, b.password).Create(ctx, &v1.CreateUserRequest{
+		Username: username,
+		Nickname: nickname,
+		Password: oidc.GenerateVerifier(),
+		Email:    idToken.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	identityM := &model.UserIdentityM{UserID: reply.UserID, Issuer: idToken.Issuer, Subject: idToken.Subject, Email: idToken.Email}
+	if err := b.ds.Identities().Create(ctx, identityM); err != nil {
+		return nil, err
+	}
+
+	log.C(ctx).Infow("Provisioned user for oidc identity", "username", username, "subject", idToken.Subject)
+	return b.ds.Users().GetByUsername(ctx, username)
+}
+
+// provisionedUsername returns the username of a user provisioned for the identity. It is
+// taken from the configured claim, and falls back to the local part of the email and the subject.
+func provisionedUsername(idToken *oidc.IDToken, claim string) string {
+	username, _ := idToken.Claims[claim].(string)
+	if username == "" && idToken.Email != "" {
+		username, _, _ = strings.Cut(idToken.Email, "@")
+	}
+	if username == "" {
+		username = idToken.Subject
+	}
+
+	username = invalidUsernameChars.ReplaceAllString(username, "-")
+	if len(username) > 253 {
+		username = username[:253]
+	}
+
+	return username
+}
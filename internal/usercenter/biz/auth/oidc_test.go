@@ -0,0 +1,192 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package auth
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"github.com/superproj/onex/internal/pkg/onexx"
+	"github.com/superproj/onex/internal/usercenter/auth"
+	"github.com/superproj/onex/internal/usercenter/model"
+	"github.com/superproj/onex/internal/usercenter/store"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+	"github.com/superproj/onex/pkg/authn/jwt"
+	"github.com/superproj/onex/pkg/authn/oidc/oidctest"
+)
+
+func Test_authBiz_OIDCCallback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idp := oidctest.NewServer("onex", "secret")
+	defer idp.Close()
+
+	rp := auth.NewOIDCWithStore(&auth.OIDCOptions{
+		IssuerURL:     idp.URL,
+		ClientID:      idp.ClientID,
+		ClientSecret:  idp.ClientSecret,
+		RedirectURL:   "http://localhost/callback",
+		UsernameClaim: "preferred_username",
+	}, &fakeOIDCStateStore{states: map[string]*auth.OIDCState{}})
+
+	authenticator := jwt.New(nil)
+	token, _ := authenticator.Sign(context.Background(), "user-colin")
+
+	tests := []struct {
+		name       string
+		linkUserID string
+		callerID   string
+		identity   *model.UserIdentityM
+		user       *model.UserM
+		wantMFA    bool
+		wantReason string
+	}{
+		{
+			name:     "linked identity",
+			identity: &model.UserIdentityM{UserID: "user-colin", Issuer: idp.URL, Subject: "user-1"},
+			user:     &model.UserM{UserID: "user-colin", Username: "colin"},
+		},
+		{
+			name:     "linked identity with mfa",
+			identity: &model.UserIdentityM{UserID: "user-colin", Issuer: idp.URL, Subject: "user-1"},
+			user:     &model.UserM{UserID: "user-colin", Username: "colin", TotpEnabled: true, TotpSecret: "JBSWY3DPEHPK3PXP"},
+			wantMFA:  true,
+		},
+		{
+			name:       "unknown identity",
+			wantReason: v1.ErrorReason_IdentityNotLinked.String(),
+		},
+		{
+			name:       "link identity",
+			linkUserID: "user-colin",
+			callerID:   "user-colin",
+			user:       &model.UserM{UserID: "user-colin", Username: "colin"},
+		},
+		{
+			name:       "identity linked to another user",
+			linkUserID: "user-colin",
+			callerID:   "user-colin",
+			identity:   &model.UserIdentityM{UserID: "user-other", Issuer: idp.URL, Subject: "user-1"},
+			wantReason: v1.ErrorReason_IdentityAlreadyLinked.String(),
+		},
+		{
+			name:       "link completed by another user",
+			linkUserID: "user-colin",
+			callerID:   "user-other",
+			wantReason: v1.ErrorReason_UserOperationForbidden.String(),
+		},
+		{
+			name:       "link completed anonymously",
+			linkUserID: "user-colin",
+			wantReason: v1.ErrorReason_UserOperationForbidden.String(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := store.NewMockIStore(ctrl)
+			identities := store.NewMockIdentityStore(ctrl)
+			users := store.NewMockUserStore(ctrl)
+			sessions := store.NewMockSessionStore(ctrl)
+			ds.EXPECT().Identities().Return(identities).AnyTimes()
+			ds.EXPECT().Users().Return(users).AnyTimes()
+			ds.EXPECT().Sessions().Return(sessions).AnyTimes()
+
+			linking := tt.linkUserID != ""
+			switch {
+			case tt.identity != nil:
+				identities.EXPECT().Get(gomock.Any(), idp.URL, "user-1").Return(tt.identity, nil)
+			case !linking || tt.callerID == tt.linkUserID:
+				identities.EXPECT().Get(gomock.Any(), idp.URL, "user-1").Return(nil, gorm.ErrRecordNotFound)
+			}
+			if linking && tt.identity == nil && tt.callerID == tt.linkUserID {
+				identities.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			}
+			if tt.user != nil {
+				users.EXPECT().Fetch(gomock.Any(), map[string]any{"user_id": tt.user.UserID}).Return(tt.user, nil)
+			}
+
+			provider := auth.NewMockAuthProvider(ctrl)
+			var sessionID string
+			if tt.wantReason == "" && !tt.wantMFA {
+				provider.EXPECT().Sign(gomock.Any(), tt.user.UserID).Return(token, nil)
+				sessions.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, s *model.SessionM) error {
+					sessionID = s.SessionID
+					return nil
+				})
+			}
+
+			b := New(ds, authenticator, provider, rp, nil, nil, nil)
+
+			url, state, err := rp.AuthorizeURL(context.Background(), tt.linkUserID)
+			assert.Nil(t, err)
+			code, _, err := idp.Authorize(url)
+			assert.Nil(t, err)
+
+			ctx := context.Background()
+			if tt.callerID != "" {
+				ctx = onexx.NewUserID(ctx, tt.callerID)
+			}
+			rq := &v1.OIDCCallbackRequest{Code: code, State: state}
+			reply, err := b.OIDCCallback(ctx, rq)
+
+			// The state can not be used again, whether the login succeeded or not.
+			_, replayErr := b.OIDCCallback(ctx, rq)
+			assert.Equal(t, v1.ErrorReason_OIDCLoginFailed.String(), errors.Reason(replayErr))
+
+			if tt.wantReason != "" {
+				assert.Equal(t, tt.wantReason, errors.Reason(err))
+				return
+			}
+
+			assert.Nil(t, err)
+			if tt.wantMFA {
+				assert.NotEmpty(t, reply.MfaToken)
+				assert.Empty(t, reply.AccessToken)
+				return
+			}
+			assert.NotEmpty(t, reply.AccessToken)
+			assert.NotEmpty(t, reply.RefreshToken)
+
+			// The refresh token belongs to the recorded session.
+			claims, err := authenticator.ParseClaims(context.Background(), reply.RefreshToken)
+			assert.Nil(t, err)
+			assert.Equal(t, sessionID, claims.ID)
+		})
+	}
+}
+
+// fakeOIDCStateStore keeps the pending logins in memory.
+type fakeOIDCStateStore struct {
+	mu     sync.Mutex
+	states map[string]*auth.OIDCState
+}
+
+func (s *fakeOIDCStateStore) Save(_ context.Context, key string, state *auth.OIDCState, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.states[key] = state
+	return nil
+}
+
+func (s *fakeOIDCStateStore) Take(_ context.Context, key string) (*auth.OIDCState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.states[key]
+	if !ok {
+		return nil, auth.ErrOIDCStateInvalid
+	}
+	delete(s.states, key)
+	return state, nil
+}
@@ -9,11 +9,18 @@ package biz
 //go:generate mockgen -self_package github.com/superproj/onex/internal/usercenter/biz -destination mock_biz.go -package biz github.com/superproj/onex/internal/usercenter/biz IBiz
 
 import (
+	"net/netip"
+
 	"github.com/google/wire"
 
 	"github.com/superproj/onex/internal/usercenter/auth"
 	authbiz "github.com/superproj/onex/internal/usercenter/biz/auth"
+	"github.com/superproj/onex/internal/usercenter/biz/identity"
+	"github.com/superproj/onex/internal/usercenter/biz/mfa"
+	"github.com/superproj/onex/internal/usercenter/biz/policy"
+	"github.com/superproj/onex/internal/usercenter/biz/role"
 	"github.com/superproj/onex/internal/usercenter/biz/secret"
+	"github.com/superproj/onex/internal/usercenter/biz/session"
 	"github.com/superproj/onex/internal/usercenter/biz/user"
 	"github.com/superproj/onex/internal/usercenter/store"
 	"github.com/superproj/onex/pkg/authn"
@@ -27,30 +34,73 @@ type IBiz interface {
 	Secrets() secret.SecretBiz
 	Users() user.UserBiz
 	Auths() authbiz.AuthBiz
+	Roles() role.RoleBiz
+	Policies() policy.PolicyBiz
+	MFAs() mfa.MFABiz
+	Identities() identity.IdentityBiz
+	Sessions() session.SessionBiz
 }
 
 type biz struct {
-	ds    store.IStore
-	authn authn.Authenticator
-	auth  auth.AuthProvider
+	ds       store.IStore
+	authn    authn.Authenticator
+	auth     auth.AuthProvider
+	oidc     *auth.OIDC
+	lockout  *auth.Lockout
+	password *auth.PasswordOptions
+	proxies  []netip.Prefix
 }
 
 // NewBiz returns a pointer to a new instance of the biz struct.
-func NewBiz(ds store.IStore, authn authn.Authenticator, auth auth.AuthProvider) *biz {
-	return &biz{ds: ds, authn: authn, auth: auth}
+func NewBiz(
+	ds store.IStore,
+	authn authn.Authenticator,
+	auth auth.AuthProvider,
+	oidc *auth.OIDC,
+	lockout *auth.Lockout,
+	password *auth.PasswordOptions,
+	authnOpts *auth.AuthnOptions,
+) *biz {
+	proxies := authnOpts.TrustedProxyPrefixes()
+	return &biz{ds: ds, authn: authn, auth: auth, oidc: oidc, lockout: lockout, password: password, proxies: proxies}
 }
 
 // Auths returns a new instance of the AuthBiz interface.
 func (b *biz) Auths() authbiz.AuthBiz {
-	return authbiz.New(b.ds, b.authn, b.auth)
+	return authbiz.New(b.ds, b.authn, b.auth, b.oidc, b.lockout, b.password, b.proxies)
 }
 
 // Users returns a new instance of the UserBiz interface.
 func (b *biz) Users() user.UserBiz {
-	return user.New(b.ds)
+	return user.New(b.ds, b.auth, b.lockout, b.password)
 }
 
 // Secrets returns a new instance of the SecretBiz interface.
 func (b *biz) Secrets() secret.SecretBiz {
 	return secret.New(b.ds)
 }
+
+// Roles returns a new instance of the RoleBiz interface.
+func (b *biz) Roles() role.RoleBiz {
+	return role.New(b.ds, b.auth)
+}
+
+// Policies returns a new instance of the PolicyBiz interface.
+func (b *biz) Policies() policy.PolicyBiz {
+	return policy.New(b.auth)
+}
+
+// MFAs returns a new instance of the MFABiz interface.
+func (b *biz) MFAs() mfa.MFABiz {
+	return mfa.New(b.ds)
+}
+
+// Identities returns a new instance of the IdentityBiz interface.
+func (b *biz) Identities() identity.IdentityBiz {
+	return identity.New(b.ds, b.oidc)
+}
+
+// Sessions returns a new instance of the SessionBiz interface.
+func (b *biz) Sessions() session.SessionBiz {
+	return session.New(b.ds, b.auth)
+}
@@ -0,0 +1,25 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package identity
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/superproj/onex/internal/usercenter/model"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// ModelToReply converts a model.UserIdentityM to a v1.IdentityReply.
+func ModelToReply(username string, identityM *model.UserIdentityM) *v1.IdentityReply {
+	return &v1.IdentityReply{
+		Username:  username,
+		Issuer:    identityM.Issuer,
+		Subject:   identityM.Subject,
+		Email:     identityM.Email,
+		CreatedAt: timestamppb.New(identityM.CreatedAt),
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package identity
+
+//go:generate mockgen -self_package github.com/superproj/onex/internal/usercenter/biz/identity -destination mock_identity.go -package identity github.com/superproj/onex/internal/usercenter/biz/identity IdentityBiz
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/superproj/onex/internal/usercenter/auth"
+	"github.com/superproj/onex/internal/usercenter/model"
+	"github.com/superproj/onex/internal/usercenter/store"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+	"github.com/superproj/onex/pkg/log"
+)
+
+// IdentityBiz defines methods used to manage the external identities linked to users.
+type IdentityBiz interface {
+	Link(ctx context.Context, rq *v1.LinkIdentityRequest) (*v1.OIDCAuthorizeReply, error)
+	List(ctx context.Context, rq *v1.ListIdentityRequest) (*v1.ListIdentityResponse, error)
+	Delete(ctx context.Context, rq *v1.DeleteIdentityRequest) error
+}
+
+// identityBiz struct implements the IdentityBiz interface.
+type identityBiz struct {
+	ds   store.IStore
+	oidc *auth.OIDC
+}
+
+var _ IdentityBiz = (*identityBiz)(nil)
+
+// New returns a new instance of identityBiz. oidc is nil if OIDC login is disabled.
+func New(ds store.IStore, oidc *auth.OIDC) *identityBiz {
+	return &identityBiz{ds: ds, oidc: oidc}
+}
+
+// Link starts a login with the OpenID provider, whose identity is linked to the user
+// when the login is completed by OIDCCallback with the token of the same user.
+func (b *identityBiz) Link(ctx context.Context, rq *v1.LinkIdentityRequest) (*v1.OIDCAuthorizeReply, error) {
+	if b.oidc == nil {
+		return nil, v1.ErrorOIDCDisabled("OIDC login is disabled")
+	}
+
+	userM, err := b.getUser(ctx, rq.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	url, state, err := b.oidc.AuthorizeURL(ctx, userM.UserID)
+	if err != nil {
+		log.C(ctx).Errorw(err, "Failed to create oidc authorization url")
+		return nil, err
+	}
+
+	return &v1.OIDCAuthorizeReply{Url: url, State: state}, nil
+}
+
+// List returns the external identities linked to the user.
+func (b *identityBiz) List(ctx context.Context, rq *v1.ListIdentityRequest) (*v1.ListIdentityResponse, error) {
+	userM, err := b.getUser(ctx, rq.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := b.ds.Identities().List(ctx, userM.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	replies := make([]*v1.IdentityReply, 0, len(identities))
+	for _, identity := range identities {
+		replies = append(replies, ModelToReply(userM.Username, identity))
+	}
+
+	return &v1.ListIdentityResponse{TotalCount: int64(len(replies)), Identities: replies}, nil
+}
+
+// Delete unlinks an external identity from the user.
+func (b *identityBiz) Delete(ctx context.Context, rq *v1.DeleteIdentityRequest) error {
+	userM, err := b.getUser(ctx, rq.Username)
+	if err != nil {
+		return err
+	}
+
+	identities, err := b.ds.Identities().List(ctx, userM.UserID)
+	if err != nil {
+		return err
+	}
+
+	for _, identity := range identities {
+		if identity.Subject == rq.Subject {
+			return b.ds.Identities().Delete(ctx, userM.UserID, identity.Issuer, identity.Subject)
+		}
+	}
+
+	return v1.ErrorIdentityNotFound("identity %s is not linked to user %s", rq.Subject, rq.Username)
+}
+
+// getUser retrieves a user by username. Ownership is checked by the validation layer.
+func (b *identityBiz) getUser(ctx context.Context, username string) (*model.UserM, error) {
+	userM, err := b.ds.Users().GetByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorUserNotFound(err.Error())
+		}
+
+		return nil, err
+	}
+
+	return userM, nil
+}
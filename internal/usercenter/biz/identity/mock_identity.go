@@ -0,0 +1,86 @@
+// Copyright 2024 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/superproj/onex/internal/usercenter/biz/identity (interfaces: IdentityBiz)
+
+// Package identity is a generated GoMock package.
+package identity
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// MockIdentityBiz is a mock of IdentityBiz interface.
+type MockIdentityBiz struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdentityBizMockRecorder
+}
+
+// MockIdentityBizMockRecorder is the mock recorder for MockIdentityBiz.
+type MockIdentityBizMockRecorder struct {
+	mock *MockIdentityBiz
+}
+
+// NewMockIdentityBiz creates a new mock instance.
+func NewMockIdentityBiz(ctrl *gomock.Controller) *MockIdentityBiz {
+	mock := &MockIdentityBiz{ctrl: ctrl}
+	mock.recorder = &MockIdentityBizMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdentityBiz) EXPECT() *MockIdentityBizMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockIdentityBiz) Delete(arg0 context.Context, arg1 *v1.DeleteIdentityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIdentityBizMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIdentityBiz)(nil).Delete), arg0, arg1)
+}
+
+// Link mocks base method.
+func (m *MockIdentityBiz) Link(arg0 context.Context, arg1 *v1.LinkIdentityRequest) (*v1.OIDCAuthorizeReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Link", arg0, arg1)
+	ret0, _ := ret[0].(*v1.OIDCAuthorizeReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Link indicates an expected call of Link.
+func (mr *MockIdentityBizMockRecorder) Link(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Link", reflect.TypeOf((*MockIdentityBiz)(nil).Link), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockIdentityBiz) List(arg0 context.Context, arg1 *v1.ListIdentityRequest) (*v1.ListIdentityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ListIdentityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIdentityBizMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIdentityBiz)(nil).List), arg0, arg1)
+}
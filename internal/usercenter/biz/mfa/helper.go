@@ -0,0 +1,139 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package mfa
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	ucknown "github.com/superproj/onex/internal/pkg/known/usercenter"
+	"github.com/superproj/onex/internal/usercenter/model"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+	"github.com/superproj/onex/pkg/authn"
+	"github.com/superproj/onex/pkg/authn/totp"
+)
+
+// Issuer is the issuer displayed by authenticator apps.
+const Issuer = "onex"
+
+// recoveryEncoding is used to format recovery codes in a way which is easy to type.
+var recoveryEncoding = base32.NewEncoding("abcdefghijkmnpqrstuvwxyz23456789").WithPadding(base32.NoPadding)
+
+// get retrieves a user by username. Ownership is checked by the validation layer.
+func (b *mfaBiz) get(ctx context.Context, username string) (*model.UserM, error) {
+	userM, err := b.ds.Users().GetByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorUserNotFound(err.Error())
+		}
+
+		return nil, err
+	}
+
+	return userM, nil
+}
+
+// Enroll generates a new TOTP secret for the user. The caller is responsible for saving the user.
+func Enroll(userM *model.UserM) (*v1.EnrollTOTPReply, error) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	userM.TotpSecret, userM.TotpCounter = secret, 0
+	return &v1.EnrollTOTPReply{Secret: secret, Url: totp.URL(Issuer, userM.Username, secret)}, nil
+}
+
+// Activate enables TOTP for the user if the passcode matches the enrolled secret,
+// and returns a new set of recovery codes. The caller is responsible for saving the user.
+func Activate(userM *model.UserM, code string) ([]string, error) {
+	if userM.TotpSecret == "" {
+		return nil, v1.ErrorTOTPNotEnabled("TOTP is not enrolled for user %s", userM.Username)
+	}
+
+	counter, ok := totp.ValidateCounter(code, userM.TotpSecret, time.Now())
+	if !ok {
+		return nil, v1.ErrorMFACodeInvalid("invalid passcode")
+	}
+
+	userM.TotpEnabled, userM.TotpCounter = true, counter
+	return newRecoveryCodes(userM)
+}
+
+// Verify checks the passcode, or the recovery code if no passcode is given.
+// A passcode is refused if its time step is not after the one of the last accepted
+// passcode, and a matched recovery code is removed from the user, so that each of them
+// can only be used once. The caller is responsible for saving the user.
+func Verify(userM *model.UserM, code string, recoveryCode string) error {
+	if code != "" {
+		counter, ok := totp.ValidateCounter(code, userM.TotpSecret, time.Now())
+		if !ok {
+			return v1.ErrorMFACodeInvalid("invalid passcode")
+		}
+		if counter <= userM.TotpCounter {
+			return v1.ErrorMFACodeInvalid("passcode has already been used")
+		}
+
+		userM.TotpCounter = counter
+		return nil
+	}
+
+	var hashes []string
+	if userM.RecoveryCodes != "" {
+		if err := json.Unmarshal([]byte(userM.RecoveryCodes), &hashes); err != nil {
+			return err
+		}
+	}
+
+	recoveryCode = strings.ToLower(strings.TrimSpace(recoveryCode))
+	for i, hash := range hashes {
+		if recoveryCode != "" && authn.Compare(hash, recoveryCode) == nil {
+			hashes = append(hashes[:i], hashes[i+1:]...)
+			data, _ := json.Marshal(hashes)
+			userM.RecoveryCodes = string(data)
+			return nil
+		}
+	}
+
+	return v1.ErrorMFACodeInvalid("invalid recovery code")
+}
+
+// newRecoveryCodes generates a new set of recovery codes, and saves their hashes to the user.
+func newRecoveryCodes(userM *model.UserM) ([]string, error) {
+	codes := make([]string, ucknown.RecoveryCodeCount)
+	hashes := make([]string, ucknown.RecoveryCodeCount)
+	for i := range codes {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+
+		code := recoveryEncoding.EncodeToString(b)
+		codes[i] = code[:4] + "-" + code[4:]
+
+		hash, err := authn.Encrypt(codes[i])
+		if err != nil {
+			return nil, err
+		}
+		hashes[i] = hash
+	}
+
+	data, err := json.Marshal(hashes)
+	if err != nil {
+		return nil, err
+	}
+	userM.RecoveryCodes = string(data)
+
+	return codes, nil
+}
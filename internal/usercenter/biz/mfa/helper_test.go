@@ -0,0 +1,58 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package mfa
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/superproj/onex/internal/usercenter/model"
+	"github.com/superproj/onex/pkg/authn/totp"
+)
+
+func TestVerify(t *testing.T) {
+	userM := &model.UserM{Username: "colin"}
+	_, err := Enroll(userM)
+	assert.Nil(t, err)
+
+	_, err = Activate(userM, "000000x")
+	assert.NotNil(t, err)
+	assert.False(t, userM.TotpEnabled)
+
+	code, _ := totp.Generate(userM.TotpSecret, time.Now())
+	codes, err := Activate(userM, code)
+	assert.Nil(t, err)
+	assert.True(t, userM.TotpEnabled)
+	assert.Len(t, codes, 10)
+
+	// The passcode used for the activation can not be used again, the next one is accepted within the skew.
+	next, _ := totp.Generate(userM.TotpSecret, time.Now().Add(totp.Period*time.Second))
+
+	tests := []struct {
+		name         string
+		code         string
+		recoveryCode string
+		wantErr      bool
+	}{
+		{name: "activation passcode", code: code, wantErr: true},
+		{name: "passcode", code: next},
+		{name: "replayed passcode", code: next, wantErr: true},
+		{name: "wrong passcode", code: "abcdef", wantErr: true},
+		{name: "recovery code", recoveryCode: codes[0]},
+		{name: "used recovery code", recoveryCode: codes[0], wantErr: true},
+		{name: "recovery code with spaces", recoveryCode: " " + codes[1] + " "},
+		{name: "empty", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify(userM, tt.code, tt.recoveryCode)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package mfa
+
+//go:generate mockgen -self_package github.com/superproj/onex/internal/usercenter/biz/mfa -destination mock_mfa.go -package mfa github.com/superproj/onex/internal/usercenter/biz/mfa MFABiz
+
+import (
+	"context"
+
+	"github.com/superproj/onex/internal/usercenter/store"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// MFABiz defines methods used to manage the second authentication factor of a user.
+type MFABiz interface {
+	EnrollTOTP(ctx context.Context, rq *v1.EnrollTOTPRequest) (*v1.EnrollTOTPReply, error)
+	ActivateTOTP(ctx context.Context, rq *v1.ActivateTOTPRequest) (*v1.RecoveryCodesReply, error)
+	DisableTOTP(ctx context.Context, rq *v1.DisableTOTPRequest) error
+	RegenerateRecoveryCodes(ctx context.Context, rq *v1.RegenerateRecoveryCodesRequest) (*v1.RecoveryCodesReply, error)
+}
+
+// mfaBiz struct implements the MFABiz interface.
+type mfaBiz struct {
+	ds store.IStore
+}
+
+var _ MFABiz = (*mfaBiz)(nil)
+
+// New returns a new instance of mfaBiz.
+func New(ds store.IStore) *mfaBiz {
+	return &mfaBiz{ds: ds}
+}
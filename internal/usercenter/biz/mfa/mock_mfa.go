@@ -0,0 +1,101 @@
+// Copyright 2024 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/superproj/onex/internal/usercenter/biz/mfa (interfaces: MFABiz)
+
+// Package mfa is a generated GoMock package.
+package mfa
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// MockMFABiz is a mock of MFABiz interface.
+type MockMFABiz struct {
+	ctrl     *gomock.Controller
+	recorder *MockMFABizMockRecorder
+}
+
+// MockMFABizMockRecorder is the mock recorder for MockMFABiz.
+type MockMFABizMockRecorder struct {
+	mock *MockMFABiz
+}
+
+// NewMockMFABiz creates a new mock instance.
+func NewMockMFABiz(ctrl *gomock.Controller) *MockMFABiz {
+	mock := &MockMFABiz{ctrl: ctrl}
+	mock.recorder = &MockMFABizMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMFABiz) EXPECT() *MockMFABizMockRecorder {
+	return m.recorder
+}
+
+// ActivateTOTP mocks base method.
+func (m *MockMFABiz) ActivateTOTP(arg0 context.Context, arg1 *v1.ActivateTOTPRequest) (*v1.RecoveryCodesReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateTOTP", arg0, arg1)
+	ret0, _ := ret[0].(*v1.RecoveryCodesReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivateTOTP indicates an expected call of ActivateTOTP.
+func (mr *MockMFABizMockRecorder) ActivateTOTP(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateTOTP", reflect.TypeOf((*MockMFABiz)(nil).ActivateTOTP), arg0, arg1)
+}
+
+// DisableTOTP mocks base method.
+func (m *MockMFABiz) DisableTOTP(arg0 context.Context, arg1 *v1.DisableTOTPRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTOTP", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTOTP indicates an expected call of DisableTOTP.
+func (mr *MockMFABizMockRecorder) DisableTOTP(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTOTP", reflect.TypeOf((*MockMFABiz)(nil).DisableTOTP), arg0, arg1)
+}
+
+// EnrollTOTP mocks base method.
+func (m *MockMFABiz) EnrollTOTP(arg0 context.Context, arg1 *v1.EnrollTOTPRequest) (*v1.EnrollTOTPReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollTOTP", arg0, arg1)
+	ret0, _ := ret[0].(*v1.EnrollTOTPReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollTOTP indicates an expected call of EnrollTOTP.
+func (mr *MockMFABizMockRecorder) EnrollTOTP(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollTOTP", reflect.TypeOf((*MockMFABiz)(nil).EnrollTOTP), arg0, arg1)
+}
+
+// RegenerateRecoveryCodes mocks base method.
+func (m *MockMFABiz) RegenerateRecoveryCodes(arg0 context.Context, arg1 *v1.RegenerateRecoveryCodesRequest) (*v1.RecoveryCodesReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateRecoveryCodes", arg0, arg1)
+	ret0, _ := ret[0].(*v1.RecoveryCodesReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateRecoveryCodes indicates an expected call of RegenerateRecoveryCodes.
+func (mr *MockMFABizMockRecorder) RegenerateRecoveryCodes(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateRecoveryCodes", reflect.TypeOf((*MockMFABiz)(nil).RegenerateRecoveryCodes), arg0, arg1)
+}
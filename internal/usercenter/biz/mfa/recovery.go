@@ -0,0 +1,41 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package mfa
+
+import (
+	"context"
+
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// RegenerateRecoveryCodes invalidates the existing recovery codes of the user and
+// returns a new set.
+func (b *mfaBiz) RegenerateRecoveryCodes(ctx context.Context, rq *v1.RegenerateRecoveryCodesRequest) (*v1.RecoveryCodesReply, error) {
+	userM, err := b.get(ctx, rq.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if !userM.TotpEnabled {
+		return nil, v1.ErrorTOTPNotEnabled("TOTP is not enabled for user %s", rq.Username)
+	}
+
+	if err := Verify(userM, rq.Code, ""); err != nil {
+		return nil, err
+	}
+
+	codes, err := newRecoveryCodes(userM)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.ds.Users().Update(ctx, userM); err != nil {
+		return nil, err
+	}
+
+	return &v1.RecoveryCodesReply{Codes: codes}, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package mfa
+
+import (
+	"context"
+
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// EnrollTOTP generates a new TOTP secret for the user. The secret does not take
+// effect until it is activated by ActivateTOTP.
+func (b *mfaBiz) EnrollTOTP(ctx context.Context, rq *v1.EnrollTOTPRequest) (*v1.EnrollTOTPReply, error) {
+	userM, err := b.get(ctx, rq.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if userM.TotpEnabled {
+		return nil, v1.ErrorTOTPAlreadyEnabled("TOTP is already enabled for user %s", rq.Username)
+	}
+
+	reply, err := Enroll(userM)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.ds.Users().Update(ctx, userM); err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+// ActivateTOTP enables TOTP if the passcode matches the enrolled secret, and
+// returns a new set of recovery codes.
+func (b *mfaBiz) ActivateTOTP(ctx context.Context, rq *v1.ActivateTOTPRequest) (*v1.RecoveryCodesReply, error) {
+	userM, err := b.get(ctx, rq.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if userM.TotpEnabled {
+		return nil, v1.ErrorTOTPAlreadyEnabled("TOTP is already enabled for user %s", rq.Username)
+	}
+
+	codes, err := Activate(userM, rq.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.ds.Users().Update(ctx, userM); err != nil {
+		return nil, err
+	}
+
+	return &v1.RecoveryCodesReply{Codes: codes}, nil
+}
+
+// DisableTOTP disables TOTP and removes the secret and recovery codes of the user.
+// The passcode can be omitted when the request is sent by an administrator, which
+// is checked by the validation layer.
+func (b *mfaBiz) DisableTOTP(ctx context.Context, rq *v1.DisableTOTPRequest) error {
+	userM, err := b.get(ctx, rq.Username)
+	if err != nil {
+		return err
+	}
+
+	if !userM.TotpEnabled {
+		return v1.ErrorTOTPNotEnabled("TOTP is not enabled for user %s", rq.Username)
+	}
+
+	if rq.Code != "" {
+		if err := Verify(userM, rq.Code, ""); err != nil {
+			return err
+		}
+	}
+
+	userM.TotpEnabled = false
+	userM.TotpSecret = ""
+	userM.RecoveryCodes = ""
+
+	return b.ds.Users().Update(ctx, userM)
+}
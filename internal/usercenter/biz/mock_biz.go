@@ -15,7 +15,12 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	auth "github.com/superproj/onex/internal/usercenter/biz/auth"
+	identity "github.com/superproj/onex/internal/usercenter/biz/identity"
+	mfa "github.com/superproj/onex/internal/usercenter/biz/mfa"
+	policy "github.com/superproj/onex/internal/usercenter/biz/policy"
+	role "github.com/superproj/onex/internal/usercenter/biz/role"
 	secret "github.com/superproj/onex/internal/usercenter/biz/secret"
+	session "github.com/superproj/onex/internal/usercenter/biz/session"
 	user "github.com/superproj/onex/internal/usercenter/biz/user"
 )
 
@@ -56,6 +61,62 @@ func (mr *MockIBizMockRecorder) Auths() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auths", reflect.TypeOf((*MockIBiz)(nil).Auths))
 }
 
+// Identities mocks base method.
+func (m *MockIBiz) Identities() identity.IdentityBiz {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Identities")
+	ret0, _ := ret[0].(identity.IdentityBiz)
+	return ret0
+}
+
+// Identities indicates an expected call of Identities.
+func (mr *MockIBizMockRecorder) Identities() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Identities", reflect.TypeOf((*MockIBiz)(nil).Identities))
+}
+
+// MFAs mocks base method.
+func (m *MockIBiz) MFAs() mfa.MFABiz {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MFAs")
+	ret0, _ := ret[0].(mfa.MFABiz)
+	return ret0
+}
+
+// MFAs indicates an expected call of MFAs.
+func (mr *MockIBizMockRecorder) MFAs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFAs", reflect.TypeOf((*MockIBiz)(nil).MFAs))
+}
+
+// Policies mocks base method.
+func (m *MockIBiz) Policies() policy.PolicyBiz {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Policies")
+	ret0, _ := ret[0].(policy.PolicyBiz)
+	return ret0
+}
+
+// Policies indicates an expected call of Policies.
+func (mr *MockIBizMockRecorder) Policies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Policies", reflect.TypeOf((*MockIBiz)(nil).Policies))
+}
+
+// Roles mocks base method.
+func (m *MockIBiz) Roles() role.RoleBiz {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Roles")
+	ret0, _ := ret[0].(role.RoleBiz)
+	return ret0
+}
+
+// Roles indicates an expected call of Roles.
+func (mr *MockIBizMockRecorder) Roles() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Roles", reflect.TypeOf((*MockIBiz)(nil).Roles))
+}
+
 // Secrets mocks base method.
 func (m *MockIBiz) Secrets() secret.SecretBiz {
 	m.ctrl.T.Helper()
@@ -70,6 +131,20 @@ func (mr *MockIBizMockRecorder) Secrets() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Secrets", reflect.TypeOf((*MockIBiz)(nil).Secrets))
 }
 
+// Sessions mocks base method.
+func (m *MockIBiz) Sessions() session.SessionBiz {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sessions")
+	ret0, _ := ret[0].(session.SessionBiz)
+	return ret0
+}
+
+// Sessions indicates an expected call of Sessions.
+func (mr *MockIBizMockRecorder) Sessions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sessions", reflect.TypeOf((*MockIBiz)(nil).Sessions))
+}
+
 // Users mocks base method.
 func (m *MockIBiz) Users() user.UserBiz {
 	m.ctrl.T.Helper()
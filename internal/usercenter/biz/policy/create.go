@@ -0,0 +1,28 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package policy
+
+import (
+	"context"
+
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// Create adds a new policy.
+func (b *policyBiz) Create(ctx context.Context, rq *v1.CreatePolicyRequest) (*v1.PolicyReply, error) {
+	eft, dom := defaults(rq.Eft, rq.Dom)
+
+	added, err := b.authz.AddPolicy(rq.Sub, dom, rq.Obj, rq.Act, eft)
+	if err != nil {
+		return nil, err
+	}
+	if !added {
+		return nil, v1.ErrorPolicyAlreadyExists("policy (%s, %s, %s, %s, %s) already exists", rq.Sub, dom, rq.Obj, rq.Act, eft)
+	}
+
+	return &v1.PolicyReply{Sub: rq.Sub, Dom: dom, Obj: rq.Obj, Act: rq.Act, Eft: eft}, nil
+}
@@ -0,0 +1,28 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package policy
+
+import (
+	"context"
+
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// Delete removes a policy.
+func (b *policyBiz) Delete(ctx context.Context, rq *v1.DeletePolicyRequest) error {
+	eft, dom := defaults(rq.Eft, rq.Dom)
+
+	removed, err := b.authz.RemovePolicy(rq.Sub, dom, rq.Obj, rq.Act, eft)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return v1.ErrorPolicyNotFound("policy (%s, %s, %s, %s, %s) not found", rq.Sub, dom, rq.Obj, rq.Act, eft)
+	}
+
+	return nil
+}
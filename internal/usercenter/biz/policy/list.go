@@ -0,0 +1,27 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package policy
+
+import (
+	"context"
+
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// List returns the policies, optionally filtered by subject and domain.
+func (b *policyBiz) List(ctx context.Context, rq *v1.ListPolicyRequest) (*v1.ListPolicyResponse, error) {
+	policies := make([]*v1.PolicyReply, 0)
+	for _, rule := range b.authz.Policies(rq.Sub, rq.Dom) {
+		if len(rule) < 5 {
+			continue
+		}
+
+		policies = append(policies, &v1.PolicyReply{Sub: rule[0], Dom: rule[1], Obj: rule[2], Act: rule[3], Eft: rule[4]})
+	}
+
+	return &v1.ListPolicyResponse{TotalCount: int64(len(policies)), Policies: policies}, nil
+}
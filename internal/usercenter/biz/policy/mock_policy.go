@@ -0,0 +1,86 @@
+// Copyright 2024 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/superproj/onex/internal/usercenter/biz/policy (interfaces: PolicyBiz)
+
+// Package policy is a generated GoMock package.
+package policy
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// MockPolicyBiz is a mock of PolicyBiz interface.
+type MockPolicyBiz struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyBizMockRecorder
+}
+
+// MockPolicyBizMockRecorder is the mock recorder for MockPolicyBiz.
+type MockPolicyBizMockRecorder struct {
+	mock *MockPolicyBiz
+}
+
+// NewMockPolicyBiz creates a new mock instance.
+func NewMockPolicyBiz(ctrl *gomock.Controller) *MockPolicyBiz {
+	mock := &MockPolicyBiz{ctrl: ctrl}
+	mock.recorder = &MockPolicyBizMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicyBiz) EXPECT() *MockPolicyBizMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPolicyBiz) Create(arg0 context.Context, arg1 *v1.CreatePolicyRequest) (*v1.PolicyReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*v1.PolicyReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPolicyBizMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPolicyBiz)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockPolicyBiz) Delete(arg0 context.Context, arg1 *v1.DeletePolicyRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPolicyBizMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPolicyBiz)(nil).Delete), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockPolicyBiz) List(arg0 context.Context, arg1 *v1.ListPolicyRequest) (*v1.ListPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ListPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPolicyBizMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPolicyBiz)(nil).List), arg0, arg1)
+}
@@ -0,0 +1,49 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package policy
+
+//go:generate mockgen -self_package github.com/superproj/onex/internal/usercenter/biz/policy -destination mock_policy.go -package policy github.com/superproj/onex/internal/usercenter/biz/policy PolicyBiz
+
+import (
+	"context"
+
+	"github.com/superproj/onex/internal/usercenter/auth"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// defaultEffect is the effect of the policies created without one.
+const defaultEffect = "allow"
+
+// defaults fills in the default effect and domain.
+func defaults(eft, dom string) (string, string) {
+	if eft == "" {
+		eft = defaultEffect
+	}
+	if dom == "" {
+		dom = auth.AllDomains
+	}
+
+	return eft, dom
+}
+
+// PolicyBiz defines functions used to handle authorization policy request.
+type PolicyBiz interface {
+	Create(ctx context.Context, rq *v1.CreatePolicyRequest) (*v1.PolicyReply, error)
+	List(ctx context.Context, rq *v1.ListPolicyRequest) (*v1.ListPolicyResponse, error)
+	Delete(ctx context.Context, rq *v1.DeletePolicyRequest) error
+}
+
+type policyBiz struct {
+	authz auth.AuthzInterface
+}
+
+var _ PolicyBiz = (*policyBiz)(nil)
+
+// New creates a new instance of the policyBiz struct.
+func New(authz auth.AuthzInterface) *policyBiz {
+	return &policyBiz{authz: authz}
+}
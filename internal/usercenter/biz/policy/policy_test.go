@@ -0,0 +1,74 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/superproj/onex/internal/usercenter/auth"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+func Test_policyBiz_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name    string
+		rq      *v1.CreatePolicyRequest
+		dom     string
+		eft     string
+		added   bool
+		wantErr bool
+	}{
+		{
+			name:  "default effect",
+			rq:    &v1.CreatePolicyRequest{Sub: "operator", Obj: "/v1/minersets", Act: "GET"},
+			dom:   auth.AllDomains,
+			eft:   "allow",
+			added: true,
+		},
+		{
+			name:  "deny",
+			rq:    &v1.CreatePolicyRequest{Sub: "operator", Dom: "tenant-a", Obj: "/v1/minersets", Act: "DELETE", Eft: "deny"},
+			dom:   "tenant-a",
+			eft:   "deny",
+			added: true,
+		},
+		{
+			name:    "already exists",
+			rq:      &v1.CreatePolicyRequest{Sub: "operator", Obj: "/v1/minersets", Act: "GET"},
+			dom:     auth.AllDomains,
+			eft:     "allow",
+			added:   false,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAuthz := auth.NewMockAuthzInterface(ctrl)
+			mockAuthz.EXPECT().AddPolicy(tt.rq.Sub, tt.dom, tt.rq.Obj, tt.rq.Act, tt.eft).Return(tt.added, nil)
+
+			got, err := New(mockAuthz).Create(context.Background(), tt.rq)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("policyBiz.Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !v1.IsPolicyAlreadyExists(err) {
+					t.Errorf("policyBiz.Create() error = %v, want PolicyAlreadyExists", err)
+				}
+				return
+			}
+			if got.Eft != tt.eft || got.Dom != tt.dom {
+				t.Errorf("policyBiz.Create() = (%s, %s), want (%s, %s)", got.Dom, got.Eft, tt.dom, tt.eft)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package role
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/superproj/onex/internal/usercenter/auth"
+	"github.com/superproj/onex/internal/usercenter/model"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+	"github.com/superproj/onex/pkg/log"
+)
+
+// CreateBinding binds a role to a user in a domain, all the domains by default. Users
+// are bound by user id, which is the subject used by the authorizer.
+func (b *roleBiz) CreateBinding(ctx context.Context, rq *v1.CreateRoleBindingRequest) (*v1.RoleBindingReply, error) {
+	if _, err := b.get(ctx, rq.Role); err != nil {
+		return nil, err
+	}
+
+	userM, err := b.getUser(ctx, rq.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	dom := domainOrDefault(rq.Domain)
+	added, err := b.authz.AddRoleForUser(userM.UserID, rq.Role, dom)
+	if err != nil {
+		return nil, err
+	}
+	if !added {
+		return nil, v1.ErrorRoleBindingAlreadyExists("user %s is already bound to role %s in domain %s", rq.Username, rq.Role, dom)
+	}
+
+	return &v1.RoleBindingReply{Role: rq.Role, Username: userM.Username, UserID: userM.UserID, Domain: dom}, nil
+}
+
+// ListBindings returns the role bindings, optionally filtered by role, username and domain.
+func (b *roleBiz) ListBindings(ctx context.Context, rq *v1.ListRoleBindingRequest) (*v1.ListRoleBindingResponse, error) {
+	var userID string
+	if rq.Username != "" {
+		userM, err := b.getUser(ctx, rq.Username)
+		if err != nil {
+			return nil, err
+		}
+		userID = userM.UserID
+	}
+
+	// Cache the usernames, a user is usually bound to several roles.
+	usernames := make(map[string]string)
+	bindings := make([]*v1.RoleBindingReply, 0)
+	for _, rule := range b.authz.RoleBindings(userID, rq.Role, rq.Domain) {
+		if len(rule) < 3 {
+			continue
+		}
+
+		username, ok := usernames[rule[0]]
+		if !ok {
+			userM, err := b.ds.Users().Fetch(ctx, map[string]any{"user_id": rule[0]})
+			if err != nil {
+				log.C(ctx).Warnw("Failed to get the user of role binding", "userID", rule[0], "err", err)
+			} else {
+				username = userM.Username
+			}
+			usernames[rule[0]] = username
+		}
+
+		bindings = append(bindings, &v1.RoleBindingReply{Role: rule[1], Username: username, UserID: rule[0], Domain: rule[2]})
+	}
+
+	return &v1.ListRoleBindingResponse{TotalCount: int64(len(bindings)), RoleBindings: bindings}, nil
+}
+
+// DeleteBinding unbinds a role from a user in a domain, all the domains by default.
+func (b *roleBiz) DeleteBinding(ctx context.Context, rq *v1.DeleteRoleBindingRequest) error {
+	userM, err := b.getUser(ctx, rq.Username)
+	if err != nil {
+		return err
+	}
+
+	dom := domainOrDefault(rq.Domain)
+	deleted, err := b.authz.DeleteRoleForUser(userM.UserID, rq.Role, dom)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return v1.ErrorRoleBindingNotFound("user %s is not bound to role %s in domain %s", rq.Username, rq.Role, dom)
+	}
+
+	return nil
+}
+
+// domainOrDefault returns the domain of a binding, bindings without domain apply to all the domains.
+func domainOrDefault(dom string) string {
+	if dom == "" {
+		return auth.AllDomains
+	}
+
+	return dom
+}
+
+func (b *roleBiz) getUser(ctx context.Context, username string) (*model.UserM, error) {
+	userM, err := b.ds.Users().GetByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorUserNotFound("user %s not found", username)
+		}
+
+		return nil, err
+	}
+
+	return userM, nil
+}
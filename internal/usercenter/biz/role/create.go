@@ -0,0 +1,36 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package role
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jinzhu/copier"
+	"gorm.io/gorm"
+
+	"github.com/superproj/onex/internal/usercenter/model"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// Create creates a new role.
+func (b *roleBiz) Create(ctx context.Context, rq *v1.CreateRoleRequest) (*v1.RoleReply, error) {
+	if _, err := b.ds.Roles().Get(ctx, rq.Name); err == nil {
+		return nil, v1.ErrorRoleAlreadyExists("role %s already exists", rq.Name)
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	var roleM model.RoleM
+	_ = copier.Copy(&roleM, rq)
+
+	if err := b.ds.Roles().Create(ctx, &roleM); err != nil {
+		return nil, err
+	}
+
+	return ModelToReply(&roleM), nil
+}
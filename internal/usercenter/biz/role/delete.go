@@ -0,0 +1,22 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package role
+
+import (
+	"context"
+
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// Delete deletes a role together with its bindings and policies.
+func (b *roleBiz) Delete(ctx context.Context, rq *v1.DeleteRoleRequest) error {
+	if _, err := b.authz.DeleteRole(rq.Name); err != nil {
+		return err
+	}
+
+	return b.ds.Roles().Delete(ctx, rq.Name)
+}
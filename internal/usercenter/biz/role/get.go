@@ -0,0 +1,41 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package role
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/superproj/onex/internal/usercenter/model"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// Get retrieves a role by its name.
+func (b *roleBiz) Get(ctx context.Context, rq *v1.GetRoleRequest) (*v1.RoleReply, error) {
+	roleM, err := b.get(ctx, rq.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return ModelToReply(roleM), nil
+}
+
+// get retrieves a role by its name and maps the missing record to v1.ErrorRoleNotFound.
+func (b *roleBiz) get(ctx context.Context, name string) (*model.RoleM, error) {
+	roleM, err := b.ds.Roles().Get(ctx, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorRoleNotFound("role %s not found", name)
+		}
+
+		return nil, err
+	}
+
+	return roleM, nil
+}
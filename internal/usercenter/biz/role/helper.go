@@ -0,0 +1,24 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package role
+
+import (
+	"github.com/jinzhu/copier"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/superproj/onex/internal/usercenter/model"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// ModelToReply converts a model.RoleM to a v1.RoleReply.
+func ModelToReply(roleM *model.RoleM) *v1.RoleReply {
+	var role v1.RoleReply
+	_ = copier.Copy(&role, roleM)
+	role.CreatedAt = timestamppb.New(roleM.CreatedAt)
+	role.UpdatedAt = timestamppb.New(roleM.UpdatedAt)
+	return &role
+}
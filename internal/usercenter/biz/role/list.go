@@ -0,0 +1,29 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package role
+
+import (
+	"context"
+
+	"github.com/superproj/onex/internal/pkg/meta"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// List returns a list of roles.
+func (b *roleBiz) List(ctx context.Context, rq *v1.ListRoleRequest) (*v1.ListRoleResponse, error) {
+	count, list, err := b.ds.Roles().List(ctx, meta.WithOffset(rq.Offset), meta.WithLimit(rq.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]*v1.RoleReply, 0, len(list))
+	for _, item := range list {
+		roles = append(roles, ModelToReply(item))
+	}
+
+	return &v1.ListRoleResponse{TotalCount: count, Roles: roles}, nil
+}
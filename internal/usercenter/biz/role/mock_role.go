@@ -0,0 +1,159 @@
+// Copyright 2024 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/superproj/onex/internal/usercenter/biz/role (interfaces: RoleBiz)
+
+// Package role is a generated GoMock package.
+package role
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// MockRoleBiz is a mock of RoleBiz interface.
+type MockRoleBiz struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleBizMockRecorder
+}
+
+// MockRoleBizMockRecorder is the mock recorder for MockRoleBiz.
+type MockRoleBizMockRecorder struct {
+	mock *MockRoleBiz
+}
+
+// NewMockRoleBiz creates a new mock instance.
+func NewMockRoleBiz(ctrl *gomock.Controller) *MockRoleBiz {
+	mock := &MockRoleBiz{ctrl: ctrl}
+	mock.recorder = &MockRoleBizMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleBiz) EXPECT() *MockRoleBizMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRoleBiz) Create(arg0 context.Context, arg1 *v1.CreateRoleRequest) (*v1.RoleReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*v1.RoleReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRoleBizMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoleBiz)(nil).Create), arg0, arg1)
+}
+
+// CreateBinding mocks base method.
+func (m *MockRoleBiz) CreateBinding(arg0 context.Context, arg1 *v1.CreateRoleBindingRequest) (*v1.RoleBindingReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBinding", arg0, arg1)
+	ret0, _ := ret[0].(*v1.RoleBindingReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBinding indicates an expected call of CreateBinding.
+func (mr *MockRoleBizMockRecorder) CreateBinding(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBinding", reflect.TypeOf((*MockRoleBiz)(nil).CreateBinding), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockRoleBiz) Delete(arg0 context.Context, arg1 *v1.DeleteRoleRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRoleBizMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRoleBiz)(nil).Delete), arg0, arg1)
+}
+
+// DeleteBinding mocks base method.
+func (m *MockRoleBiz) DeleteBinding(arg0 context.Context, arg1 *v1.DeleteRoleBindingRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBinding", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBinding indicates an expected call of DeleteBinding.
+func (mr *MockRoleBizMockRecorder) DeleteBinding(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBinding", reflect.TypeOf((*MockRoleBiz)(nil).DeleteBinding), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockRoleBiz) Get(arg0 context.Context, arg1 *v1.GetRoleRequest) (*v1.RoleReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*v1.RoleReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRoleBizMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRoleBiz)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockRoleBiz) List(arg0 context.Context, arg1 *v1.ListRoleRequest) (*v1.ListRoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ListRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRoleBizMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRoleBiz)(nil).List), arg0, arg1)
+}
+
+// ListBindings mocks base method.
+func (m *MockRoleBiz) ListBindings(arg0 context.Context, arg1 *v1.ListRoleBindingRequest) (*v1.ListRoleBindingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBindings", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ListRoleBindingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBindings indicates an expected call of ListBindings.
+func (mr *MockRoleBizMockRecorder) ListBindings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBindings", reflect.TypeOf((*MockRoleBiz)(nil).ListBindings), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockRoleBiz) Update(arg0 context.Context, arg1 *v1.UpdateRoleRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRoleBizMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRoleBiz)(nil).Update), arg0, arg1)
+}
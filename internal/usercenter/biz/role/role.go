@@ -0,0 +1,42 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package role
+
+//go:generate mockgen -self_package github.com/superproj/onex/internal/usercenter/biz/role -destination mock_role.go -package role github.com/superproj/onex/internal/usercenter/biz/role RoleBiz
+
+import (
+	"context"
+
+	"github.com/superproj/onex/internal/usercenter/auth"
+	"github.com/superproj/onex/internal/usercenter/store"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// RoleBiz defines functions used to handle role and role binding request.
+type RoleBiz interface {
+	Create(ctx context.Context, rq *v1.CreateRoleRequest) (*v1.RoleReply, error)
+	List(ctx context.Context, rq *v1.ListRoleRequest) (*v1.ListRoleResponse, error)
+	Get(ctx context.Context, rq *v1.GetRoleRequest) (*v1.RoleReply, error)
+	Update(ctx context.Context, rq *v1.UpdateRoleRequest) error
+	Delete(ctx context.Context, rq *v1.DeleteRoleRequest) error
+
+	CreateBinding(ctx context.Context, rq *v1.CreateRoleBindingRequest) (*v1.RoleBindingReply, error)
+	ListBindings(ctx context.Context, rq *v1.ListRoleBindingRequest) (*v1.ListRoleBindingResponse, error)
+	DeleteBinding(ctx context.Context, rq *v1.DeleteRoleBindingRequest) error
+}
+
+type roleBiz struct {
+	ds    store.IStore
+	authz auth.AuthzInterface
+}
+
+var _ RoleBiz = (*roleBiz)(nil)
+
+// New creates a new instance of the roleBiz struct.
+func New(ds store.IStore, authz auth.AuthzInterface) *roleBiz {
+	return &roleBiz{ds: ds, authz: authz}
+}
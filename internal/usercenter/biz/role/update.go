@@ -0,0 +1,27 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package role
+
+import (
+	"context"
+
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// Update updates a role.
+func (b *roleBiz) Update(ctx context.Context, rq *v1.UpdateRoleRequest) error {
+	roleM, err := b.get(ctx, rq.Name)
+	if err != nil {
+		return err
+	}
+
+	if rq.Description != nil {
+		roleM.Description = *rq.Description
+	}
+
+	return b.ds.Roles().Update(ctx, roleM)
+}
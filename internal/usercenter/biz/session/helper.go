@@ -0,0 +1,168 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package session
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/superproj/onex/internal/usercenter/auth"
+	"github.com/superproj/onex/internal/usercenter/model"
+	"github.com/superproj/onex/internal/usercenter/store"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+const (
+	// maxDeviceLength and maxUserAgentLength are the sizes of the columns in uc_session.
+	maxDeviceLength    = 253
+	maxUserAgentLength = 512
+)
+
+// NewModel returns a new login session of the user, recording the device and the client of the login.
+// proxies are the reverse proxies trusted to report the client IP.
+func NewModel(ctx context.Context, userID string, device string, proxies []netip.Prefix) *model.SessionM {
+	ip, userAgent := ClientInfo(ctx, proxies)
+	return &model.SessionM{
+		SessionID: uuid.New().String(),
+		UserID:    userID,
+		Device:    truncate(device, maxDeviceLength),
+		IP:        ip,
+		UserAgent: truncate(userAgent, maxUserAgentLength),
+	}
+}
+
+// ModelToReply converts a model.SessionM to a v1.SessionReply. current is the session of the caller.
+func ModelToReply(sessionM *model.SessionM, current string) *v1.SessionReply {
+	return &v1.SessionReply{
+		SessionID: sessionM.SessionID,
+		Device:    sessionM.Device,
+		Ip:        sessionM.IP,
+		UserAgent: sessionM.UserAgent,
+		Current:   sessionM.SessionID == current,
+		CreatedAt: timestamppb.New(sessionM.CreatedAt),
+		IssuedAt:  timestamppb.New(sessionM.IssuedAt),
+		ExpiresAt: timestamppb.New(sessionM.ExpiresAt),
+	}
+}
+
+// Revoke removes the sessions and invalidates both their refresh tokens and access tokens.
+func Revoke(ctx context.Context, ds store.IStore, a auth.AuthnInterface, sessions ...*model.SessionM) error {
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	// Invalidate the tokens first, so that a failure leaves the session listed and can be retried.
+	ids := make([]string, 0, len(sessions))
+	for _, session := range sessions {
+		if session.ExpiresAt.After(time.Now()) {
+			if err := a.Revoke(ctx, session.SessionID, session.ExpiresAt); err != nil {
+				return err
+			}
+		}
+		ids = append(ids, session.SessionID)
+	}
+
+	if err := ds.Sessions().Delete(ctx, sessions[0].UserID, ids...); err != nil {
+		return err
+	}
+
+	logRevoked(ctx, sessions)
+	return nil
+}
+
+// ClientInfo returns the IP and the user agent of the client. The X-Forwarded-For and
+// X-Real-IP headers are only honored when the request comes from one of the trusted proxies,
+// X-Forwarded-For is then walked from the right, the client being the first untrusted hop.
+func ClientInfo(ctx context.Context, proxies []netip.Prefix) (ip string, userAgent string) {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return "", ""
+	}
+
+	header := tr.RequestHeader()
+	userAgent = header.Get("User-Agent")
+
+	var remote string
+	switch tr.Kind() {
+	case transport.KindHTTP:
+		if ht, ok := tr.(http.Transporter); ok {
+			remote = ht.Request().RemoteAddr
+		}
+	case transport.KindGRPC:
+		if p, ok := peer.FromContext(ctx); ok {
+			remote = p.Addr.String()
+		}
+	}
+
+	if host, _, err := net.SplitHostPort(remote); err == nil {
+		remote = host
+	}
+
+	if !trusted(proxies, remote) {
+		return remote, userAgent
+	}
+
+	if forwarded := header.Get("X-Forwarded-For"); forwarded != "" {
+		ip = remote
+		hops := strings.Split(forwarded, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if _, err := netip.ParseAddr(hop); err != nil {
+				break
+			}
+
+			ip = hop
+			if !trusted(proxies, hop) {
+				break
+			}
+		}
+
+		return ip, userAgent
+	}
+
+	if realIP := header.Get("X-Real-IP"); realIP != "" {
+		if _, err := netip.ParseAddr(realIP); err == nil {
+			return realIP, userAgent
+		}
+	}
+
+	return remote, userAgent
+}
+
+// trusted reports whether ip is in one of the prefixes.
+func trusted(prefixes []netip.Prefix, ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	return s[:n]
+}
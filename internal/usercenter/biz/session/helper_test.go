@@ -0,0 +1,76 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package session
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/netip"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+type headerCarrier nethttp.Header
+
+func (hc headerCarrier) Get(key string) string      { return nethttp.Header(hc).Get(key) }
+func (hc headerCarrier) Set(key, value string)      { nethttp.Header(hc).Set(key, value) }
+func (hc headerCarrier) Add(key, value string)      { nethttp.Header(hc).Add(key, value) }
+func (hc headerCarrier) Keys() []string             { return nil }
+func (hc headerCarrier) Values(key string) []string { return nethttp.Header(hc).Values(key) }
+
+type testTransport struct {
+	request *nethttp.Request
+}
+
+func (tr *testTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (tr *testTransport) Endpoint() string                { return "" }
+func (tr *testTransport) Operation() string               { return "" }
+func (tr *testTransport) RequestHeader() transport.Header { return headerCarrier(tr.request.Header) }
+func (tr *testTransport) ReplyHeader() transport.Header   { return headerCarrier{} }
+func (tr *testTransport) Request() *nethttp.Request       { return tr.request }
+func (tr *testTransport) PathTemplate() string            { return "" }
+
+func TestClientInfo(t *testing.T) {
+	proxies := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("192.168.1.1/32")}
+
+	tests := []struct {
+		name    string
+		remote  string
+		headers map[string]string
+		want    string
+	}{
+		{"direct client", "203.0.113.7:5000", nil, "203.0.113.7"},
+		{"forwarded header of an untrusted client", "203.0.113.7:5000", map[string]string{"X-Forwarded-For": "1.2.3.4"}, "203.0.113.7"},
+		{"real ip header of an untrusted client", "203.0.113.7:5000", map[string]string{"X-Real-IP": "1.2.3.4"}, "203.0.113.7"},
+		{"forwarded by a trusted proxy", "10.1.2.3:5000", map[string]string{"X-Forwarded-For": "198.51.100.1"}, "198.51.100.1"},
+		{"spoofed hops before the client", "10.1.2.3:5000", map[string]string{"X-Forwarded-For": "1.2.3.4, 198.51.100.1, 192.168.1.1"}, "198.51.100.1"},
+		{"every hop is trusted", "10.1.2.3:5000", map[string]string{"X-Forwarded-For": "10.0.0.2, 10.0.0.1"}, "10.0.0.2"},
+		{"invalid hop", "10.1.2.3:5000", map[string]string{"X-Forwarded-For": "1.2.3.4, bogus"}, "10.1.2.3"},
+		{"real ip set by a trusted proxy", "10.1.2.3:5000", map[string]string{"X-Real-IP": "198.51.100.1"}, "198.51.100.1"},
+		{"trusted proxy without header", "10.1.2.3:5000", nil, "10.1.2.3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rq, _ := nethttp.NewRequest(nethttp.MethodPost, "/v1/auth/login", nil)
+			rq.RemoteAddr = tt.remote
+			rq.Header.Set("User-Agent", "onexctl")
+			for k, v := range tt.headers {
+				rq.Header.Set(k, v)
+			}
+
+			ctx := transport.NewServerContext(context.Background(), &testTransport{request: rq})
+			ip, userAgent := ClientInfo(ctx, proxies)
+			if ip != tt.want {
+				t.Errorf("ClientInfo() ip = %s, want %s", ip, tt.want)
+			}
+			if userAgent != "onexctl" {
+				t.Errorf("ClientInfo() userAgent = %s, want onexctl", userAgent)
+			}
+		})
+	}
+}
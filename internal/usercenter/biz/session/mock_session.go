@@ -0,0 +1,85 @@
+// Copyright 2024 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/superproj/onex/internal/usercenter/biz/session (interfaces: SessionBiz)
+
+// Package session is a generated GoMock package.
+package session
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// MockSessionBiz is a mock of SessionBiz interface.
+type MockSessionBiz struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionBizMockRecorder
+}
+
+// MockSessionBizMockRecorder is the mock recorder for MockSessionBiz.
+type MockSessionBizMockRecorder struct {
+	mock *MockSessionBiz
+}
+
+// NewMockSessionBiz creates a new mock instance.
+func NewMockSessionBiz(ctrl *gomock.Controller) *MockSessionBiz {
+	mock := &MockSessionBiz{ctrl: ctrl}
+	mock.recorder = &MockSessionBizMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionBiz) EXPECT() *MockSessionBizMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockSessionBiz) List(arg0 context.Context, arg1 *v1.ListSessionRequest) (*v1.ListSessionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ListSessionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockSessionBizMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSessionBiz)(nil).List), arg0, arg1)
+}
+
+// Revoke mocks base method.
+func (m *MockSessionBiz) Revoke(arg0 context.Context, arg1 *v1.RevokeSessionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockSessionBizMockRecorder) Revoke(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockSessionBiz)(nil).Revoke), arg0, arg1)
+}
+
+// RevokeAll mocks base method.
+func (m *MockSessionBiz) RevokeAll(arg0 context.Context, arg1 *v1.RevokeSessionsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAll", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAll indicates an expected call of RevokeAll.
+func (mr *MockSessionBizMockRecorder) RevokeAll(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAll", reflect.TypeOf((*MockSessionBiz)(nil).RevokeAll), arg0, arg1)
+}
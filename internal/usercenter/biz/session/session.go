@@ -0,0 +1,138 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package session
+
+//go:generate mockgen -self_package github.com/superproj/onex/internal/usercenter/biz/session -destination mock_session.go -package session github.com/superproj/onex/internal/usercenter/biz/session SessionBiz
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/superproj/onex/internal/pkg/onexx"
+	"github.com/superproj/onex/internal/usercenter/auth"
+	"github.com/superproj/onex/internal/usercenter/model"
+	"github.com/superproj/onex/internal/usercenter/store"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+	"github.com/superproj/onex/pkg/log"
+)
+
+// SessionBiz defines methods used to manage the login sessions of users.
+type SessionBiz interface {
+	List(ctx context.Context, rq *v1.ListSessionRequest) (*v1.ListSessionResponse, error)
+	Revoke(ctx context.Context, rq *v1.RevokeSessionRequest) error
+	RevokeAll(ctx context.Context, rq *v1.RevokeSessionsRequest) error
+}
+
+// sessionBiz struct implements the SessionBiz interface.
+type sessionBiz struct {
+	ds   store.IStore
+	auth auth.AuthnInterface
+}
+
+var _ SessionBiz = (*sessionBiz)(nil)
+
+// New returns a new instance of sessionBiz.
+func New(ds store.IStore, auth auth.AuthnInterface) *sessionBiz {
+	return &sessionBiz{ds: ds, auth: auth}
+}
+
+// List returns the active login sessions of the user.
+func (b *sessionBiz) List(ctx context.Context, rq *v1.ListSessionRequest) (*v1.ListSessionResponse, error) {
+	userM, err := b.getUser(ctx, rq.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := b.ds.Sessions().List(ctx, userM.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	current := currentSessionID(ctx)
+	replies := make([]*v1.SessionReply, 0, len(sessions))
+	for _, session := range sessions {
+		replies = append(replies, ModelToReply(session, current))
+	}
+
+	return &v1.ListSessionResponse{TotalCount: int64(len(replies)), Sessions: replies}, nil
+}
+
+// Revoke revokes a login session of the user.
+func (b *sessionBiz) Revoke(ctx context.Context, rq *v1.RevokeSessionRequest) error {
+	userM, err := b.getUser(ctx, rq.Username)
+	if err != nil {
+		return err
+	}
+
+	sessionM, err := b.ds.Sessions().Get(ctx, userM.UserID, rq.SessionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return v1.ErrorSessionNotFound("session %s of user %s not found", rq.SessionID, rq.Username)
+		}
+
+		return err
+	}
+
+	return Revoke(ctx, b.ds, b.auth, sessionM)
+}
+
+// RevokeAll revokes all the login sessions of the user, except the session of
+// the caller if rq.KeepCurrent is set.
+func (b *sessionBiz) RevokeAll(ctx context.Context, rq *v1.RevokeSessionsRequest) error {
+	userM, err := b.getUser(ctx, rq.Username)
+	if err != nil {
+		return err
+	}
+
+	sessions, err := b.ds.Sessions().List(ctx, userM.UserID)
+	if err != nil {
+		return err
+	}
+
+	current := currentSessionID(ctx)
+	revoked := make([]*model.SessionM, 0, len(sessions))
+	for _, session := range sessions {
+		if rq.KeepCurrent && session.SessionID == current {
+			continue
+		}
+		revoked = append(revoked, session)
+	}
+
+	return Revoke(ctx, b.ds, b.auth, revoked...)
+}
+
+// getUser retrieves a user by username. Ownership is checked by the validation layer.
+func (b *sessionBiz) getUser(ctx context.Context, username string) (*model.UserM, error) {
+	userM, err := b.ds.Users().GetByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorUserNotFound(err.Error())
+		}
+
+		return nil, err
+	}
+
+	return userM, nil
+}
+
+// currentSessionID returns the session of the caller, which is the `jti` claim of its token.
+func currentSessionID(ctx context.Context) string {
+	if claims, ok := onexx.FromContext(ctx); ok {
+		return claims.ID
+	}
+
+	return ""
+}
+
+// logRevoked logs the revoked sessions for auditing.
+func logRevoked(ctx context.Context, sessions []*model.SessionM) {
+	for _, session := range sessions {
+		log.C(ctx).Infow("Revoked login session", "session.id", session.SessionID, "session.user", session.UserID)
+	}
+}
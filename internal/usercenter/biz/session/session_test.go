@@ -0,0 +1,67 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/superproj/onex/internal/pkg/onexx"
+	"github.com/superproj/onex/internal/usercenter/auth"
+	"github.com/superproj/onex/internal/usercenter/model"
+	"github.com/superproj/onex/internal/usercenter/store"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+func Test_sessionBiz_RevokeAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expiresAt := time.Now().Add(time.Hour)
+	current := &model.SessionM{SessionID: "s1", UserID: "user-colin", ExpiresAt: expiresAt}
+	other := &model.SessionM{SessionID: "s2", UserID: "user-colin", ExpiresAt: expiresAt}
+
+	tests := []struct {
+		name        string
+		keepCurrent bool
+		wantRevoked []string
+	}{
+		{"revoke all", false, []string{"s1", "s2"}},
+		{"keep current", true, []string{"s2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := store.NewMockIStore(ctrl)
+			users := store.NewMockUserStore(ctrl)
+			sessions := store.NewMockSessionStore(ctrl)
+			ds.EXPECT().Users().Return(users).AnyTimes()
+			ds.EXPECT().Sessions().Return(sessions).AnyTimes()
+
+			users.EXPECT().GetByUsername(gomock.Any(), "colin").Return(&model.UserM{UserID: "user-colin", Username: "colin"}, nil)
+			sessions.EXPECT().List(gomock.Any(), "user-colin").Return([]*model.SessionM{current, other}, nil)
+
+			a := auth.NewMockAuthnInterface(ctrl)
+			for _, id := range tt.wantRevoked {
+				a.EXPECT().Revoke(gomock.Any(), id, expiresAt).Return(nil)
+			}
+			ids := make([]any, 0, len(tt.wantRevoked))
+			for _, id := range tt.wantRevoked {
+				ids = append(ids, id)
+			}
+			sessions.EXPECT().Delete(gomock.Any(), "user-colin", ids...).Return(nil)
+
+			ctx := onexx.NewContext(context.Background(), &jwt.RegisteredClaims{ID: current.SessionID})
+			err := New(ds, a).RevokeAll(ctx, &v1.RevokeSessionsRequest{Username: "colin", KeepCurrent: tt.keepCurrent})
+			assert.Nil(t, err)
+		})
+	}
+}
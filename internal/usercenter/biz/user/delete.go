@@ -10,14 +10,13 @@ import (
 	"context"
 
 	"github.com/superproj/onex/internal/pkg/onexx"
-	validationutil "github.com/superproj/onex/internal/pkg/util/validation"
 	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
 )
 
 // Delete deletes a user from the database.
 func (b *userBiz) Delete(ctx context.Context, rq *v1.DeleteUserRequest) error {
 	filters := map[string]any{"username": rq.Username}
-	if !validationutil.IsAdminUser(onexx.FromUserID(ctx)) {
+	if !b.auth.IsAdmin(onexx.FromUserID(ctx)) {
 		filters["user_id"] = onexx.FromUserID(ctx)
 	}
 
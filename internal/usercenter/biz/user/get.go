@@ -13,14 +13,13 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/superproj/onex/internal/pkg/onexx"
-	validationutil "github.com/superproj/onex/internal/pkg/util/validation"
 	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
 )
 
 // Get retrieves a single user from the database.
 func (b *userBiz) Get(ctx context.Context, rq *v1.GetUserRequest) (*v1.UserReply, error) {
 	filters := map[string]any{"username": rq.Username}
-	if !validationutil.IsAdminUser(onexx.FromUserID(ctx)) {
+	if !b.auth.IsAdmin(onexx.FromUserID(ctx)) {
 		filters["user_id"] = onexx.FromUserID(ctx)
 	}
 
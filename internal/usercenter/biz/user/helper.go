@@ -7,6 +7,8 @@
 package user
 
 import (
+	"encoding/json"
+
 	"github.com/jinzhu/copier"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -23,3 +25,16 @@ func ModelToReply(userM *model.UserM) *v1.UserReply {
 	user.UpdatedAt = timestamppb.New(userM.UpdatedAt)
 	return &user
 }
+
+// passwordHistory returns the hashes of the passwords which can not be reused,
+// the current password included.
+func passwordHistory(userM *model.UserM) ([]string, error) {
+	var hashes []string
+	if userM.PasswordHistory != "" {
+		if err := json.Unmarshal([]byte(userM.PasswordHistory), &hashes); err != nil {
+			return nil, err
+		}
+	}
+
+	return append(hashes, userM.Password), nil
+}
@@ -8,17 +8,23 @@ package user
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/superproj/onex/internal/pkg/onexx"
-	validationutil "github.com/superproj/onex/internal/pkg/util/validation"
+	"github.com/superproj/onex/internal/usercenter/biz/mfa"
+	"github.com/superproj/onex/internal/usercenter/biz/session"
+	"github.com/superproj/onex/internal/usercenter/locales"
 	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
 	"github.com/superproj/onex/pkg/authn"
+	"github.com/superproj/onex/pkg/i18n"
+	"github.com/superproj/onex/pkg/log"
 )
 
 // Update updates a user's information in the database.
 func (b *userBiz) Update(ctx context.Context, rq *v1.UpdateUserRequest) error {
 	filters := map[string]any{"username": rq.Username}
-	if !validationutil.IsAdminUser(onexx.FromUserID(ctx)) {
+	if !b.auth.IsAdmin(onexx.FromUserID(ctx)) {
 		filters["user_id"] = onexx.FromUserID(ctx)
 	}
 
@@ -36,23 +42,90 @@ func (b *userBiz) Update(ctx context.Context, rq *v1.UpdateUserRequest) error {
 	if rq.Phone != nil {
 		userM.Phone = *rq.Phone
 	}
+	if rq.MfaRequired != nil {
+		userM.MfaRequired = *rq.MfaRequired
+	}
 
 	return b.ds.Users().Update(ctx, userM)
 }
 
 // UpdatePassword updates a user's password in the database.
-// Note that after updating the password, if the JWT Token has not expired, it can
-// still be accessed through the token, the token is not deleted synchronously here.
+// The password can be updated without a token, so that a user whose password has
+// expired can change it, therefore it is checked like a login: the old password
+// is counted by the login lockout, and the second factor is required if TOTP is
+// enabled. All the login sessions of the user are revoked after the update.
 func (b *userBiz) UpdatePassword(ctx context.Context, rq *v1.UpdatePasswordRequest) error {
-	userM, err := b.ds.Users().Get(ctx, onexx.FromUserID(ctx), rq.Username)
+	userM, err := b.ds.Users().GetByUsername(ctx, rq.Username)
+	if err != nil {
+		return err
+	}
+
+	locked, err := b.lockout.Locked(ctx, userM.Username)
 	if err != nil {
 		return err
 	}
+	if locked {
+		return i18n.FromContext(ctx).E(locales.UserLocked)
+	}
 
 	if err := authn.Compare(userM.Password, rq.OldPassword); err != nil {
+		if locked, _ := b.lockout.Fail(ctx, userM.Username); locked {
+			return i18n.FromContext(ctx).E(locales.UserLocked)
+		}
 		return v1.ErrorUserLoginFailed("password incorrect")
 	}
-	userM.Password, _ = authn.Encrypt(rq.NewPassword)
+	if userM.TotpEnabled {
+		if err := mfa.Verify(userM, rq.Code, rq.RecoveryCode); err != nil {
+			if locked, _ := b.lockout.Fail(ctx, userM.Username); locked {
+				return i18n.FromContext(ctx).E(locales.UserLocked)
+			}
+			return err
+		}
+	}
+	if err := b.lockout.Reset(ctx, userM.Username); err != nil {
+		log.C(ctx).Errorw(err, "Failed to reset failed logins")
+	}
 
-	return b.ds.Users().Update(ctx, userM)
+	if rq.NewPassword == rq.OldPassword {
+		return i18n.FromContext(ctx).E(locales.SamePassword)
+	}
+
+	history, err := passwordHistory(userM)
+	if err != nil {
+		return err
+	}
+	for _, hash := range history {
+		if authn.Compare(hash, rq.NewPassword) == nil {
+			return i18n.FromContext(ctx).E(locales.PasswordReused)
+		}
+	}
+
+	// The replaced password is kept in the history, and the ones beyond the history size are forgotten.
+	if len(history) > b.password.HistorySize {
+		history = history[len(history)-b.password.HistorySize:]
+	}
+	data, err := json.Marshal(history)
+	if err != nil {
+		return err
+	}
+
+	password, err := authn.Encrypt(rq.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	userM.PasswordHistory = string(data)
+	userM.Password = password
+	userM.PasswordUpdatedAt = time.Now()
+	if err := b.ds.Users().Update(ctx, userM); err != nil {
+		return err
+	}
+
+	// The sessions logged in with the old password are no longer trusted.
+	sessions, err := b.ds.Sessions().List(ctx, userM.UserID)
+	if err != nil {
+		return err
+	}
+
+	return session.Revoke(ctx, b.ds, b.auth, sessions...)
 }
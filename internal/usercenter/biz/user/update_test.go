@@ -0,0 +1,82 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/superproj/onex/internal/usercenter/auth"
+	"github.com/superproj/onex/internal/usercenter/model"
+	"github.com/superproj/onex/internal/usercenter/store"
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+	"github.com/superproj/onex/pkg/authn"
+	"github.com/superproj/onex/pkg/authn/totp"
+)
+
+func Test_userBiz_UpdatePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	secret, _ := totp.GenerateSecret()
+	code, _ := totp.Generate(secret, time.Now())
+
+	tests := []struct {
+		name       string
+		totp       bool
+		code       string
+		wantReason string
+	}{
+		{name: "without mfa"},
+		{name: "mfa with passcode", totp: true, code: code},
+		{name: "mfa without passcode", totp: true, wantReason: v1.ErrorReason_MFACodeInvalid.String()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			password, _ := authn.Encrypt("onex(#)666")
+			userM := &model.UserM{UserID: "user-colin", Username: "colin", Password: password, TotpEnabled: tt.totp, TotpSecret: secret}
+
+			ds := store.NewMockIStore(ctrl)
+			users := store.NewMockUserStore(ctrl)
+			sessions := store.NewMockSessionStore(ctrl)
+			ds.EXPECT().Users().Return(users).AnyTimes()
+			ds.EXPECT().Sessions().Return(sessions).AnyTimes()
+			users.EXPECT().GetByUsername(gomock.Any(), "colin").Return(userM, nil)
+
+			provider := auth.NewMockAuthProvider(ctrl)
+			if tt.wantReason == "" {
+				expiresAt := time.Now().Add(time.Hour)
+				active := []*model.SessionM{{SessionID: "s1", UserID: "user-colin", ExpiresAt: expiresAt}}
+				users.EXPECT().Update(gomock.Any(), userM).Return(nil)
+				sessions.EXPECT().List(gomock.Any(), "user-colin").Return(active, nil)
+				provider.EXPECT().Revoke(gomock.Any(), "s1", expiresAt).Return(nil)
+				sessions.EXPECT().Delete(gomock.Any(), "user-colin", "s1").Return(nil)
+			}
+
+			b := New(ds, provider, &auth.Lockout{}, &auth.PasswordOptions{})
+			err := b.UpdatePassword(context.Background(), &v1.UpdatePasswordRequest{
+				Username:    "colin",
+				OldPassword: "onex(#)666",
+				NewPassword: "onex(#)888",
+				Code:        tt.code,
+			})
+			if tt.wantReason != "" {
+				assert.Equal(t, tt.wantReason, errors.Reason(err))
+				assert.Nil(t, authn.Compare(userM.Password, "onex(#)666"))
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Nil(t, authn.Compare(userM.Password, "onex(#)888"))
+		})
+	}
+}
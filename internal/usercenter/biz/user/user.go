@@ -11,6 +11,7 @@ package user
 import (
 	"context"
 
+	"github.com/superproj/onex/internal/usercenter/auth"
 	"github.com/superproj/onex/internal/usercenter/store"
 	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
 )
@@ -29,12 +30,15 @@ type UserBiz interface {
 
 // userBiz struct implements the UserBiz interface and contains a store.IStore instance.
 type userBiz struct {
-	ds store.IStore
+	ds       store.IStore
+	auth     auth.AuthProvider
+	lockout  auth.LockoutInterface
+	password *auth.PasswordOptions
 }
 
 var _ UserBiz = (*userBiz)(nil)
 
 // New returns a new instance of userBiz.
-func New(ds store.IStore) *userBiz {
-	return &userBiz{ds: ds}
+func New(ds store.IStore, auth auth.AuthProvider, lockout auth.LockoutInterface, password *auth.PasswordOptions) *userBiz {
+	return &userBiz{ds: ds, auth: auth, lockout: lockout, password: password}
 }
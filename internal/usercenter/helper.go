@@ -15,8 +15,19 @@ import (
 	genericoptions "github.com/superproj/onex/pkg/options"
 )
 
-// NewAuthenticator creates a new JWT-based Authenticator using the provided JWT and Redis options.
-func NewAuthenticator(jwtOpts *genericoptions.JWTOptions, redisOpts *genericoptions.RedisOptions) (authn.Authenticator, func(), error) {
+// NewTokenStore creates the Redis store which keeps the destroyed tokens and the revoked sessions.
+func NewTokenStore(redisOpts *genericoptions.RedisOptions) jwtauthn.Storer {
+	return redis.NewStore(&redis.Config{
+		Addr:      redisOpts.Addr,
+		Username:  redisOpts.Username,
+		Password:  redisOpts.Password,
+		Database:  redisOpts.Database,
+		KeyPrefix: "authn_",
+	})
+}
+
+// NewAuthenticator creates a new JWT-based Authenticator using the provided JWT options and token store.
+func NewAuthenticator(jwtOpts *genericoptions.JWTOptions, store jwtauthn.Storer) (authn.Authenticator, func(), error) {
 	// Create a list of options for jwtauthn.
 	opts := []jwtauthn.Option{
 		// Specify the issuer of the token
@@ -52,15 +63,6 @@ func NewAuthenticator(jwtOpts *genericoptions.JWTOptions, redisOpts *genericopti
 
 	opts = append(opts, jwtauthn.WithSigningMethod(method))
 
-	// Create a Redis store for jwtauthn.
-	store := redis.NewStore(&redis.Config{
-		Addr:      redisOpts.Addr,
-		Username:  redisOpts.Username,
-		Password:  redisOpts.Password,
-		Database:  redisOpts.Database,
-		KeyPrefix: "authn_",
-	})
-
 	// Create a new jwtauthn instance using the Redis store and options.
 	authn := jwtauthn.New(store, opts...)
 	// Define a function to release the resources used by jwtauthn.
@@ -36,4 +36,12 @@ const (
 	UserLocked         = "login.user.locked"
 	KeepLeastOntAction = "action.keep.least.one.action"
 	DeleteYourself     = "user.delete.yourself"
+
+	PasswordTooShort         = "password.too.short"
+	PasswordMissingUppercase = "password.missing.uppercase"
+	PasswordMissingLowercase = "password.missing.lowercase"
+	PasswordMissingDigit     = "password.missing.digit"
+	PasswordMissingSymbol    = "password.missing.symbol"
+	PasswordExpired          = "password.expired"
+	PasswordReused           = "password.reused"
 )
@@ -7,6 +7,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
@@ -46,6 +48,7 @@ func (u *UserM) BeforeCreate(tx *gorm.DB) (err error) {
 	if err != nil {
 		return err // Return error if there's a problem with encryption.
 	}
+	u.PasswordUpdatedAt = time.Now() // Start the password age from the creation of the user.
 
 	u.Status = known.UserStatusActived // Set the default status for the user as active.
 
@@ -26,6 +26,13 @@ type UserM struct {
 	Password  string    `gorm:"column:password;type:varchar(64);not null;comment:用户加密后的密码" json:"password"`                                  // 用户加密后的密码
 	Email     string    `gorm:"column:email;type:varchar(253);not null;comment:用户电子邮箱" json:"email"`                                         // 用户电子邮箱
 	Phone     string    `gorm:"column:phone;type:varchar(16);not null;comment:用户手机号" json:"phone"`                                           // 用户手机号
+	MfaRequired   bool      `gorm:"column:mfa_required;type:tinyint(1);not null;comment:是否强制开启多因素认证" json:"mfa_required"` // 是否强制开启多因素认证
+	TotpEnabled   bool      `gorm:"column:totp_enabled;type:tinyint(1);not null;comment:是否已启用 TOTP 认证" json:"totp_enabled"` // 是否已启用 TOTP 认证
+	TotpSecret    string    `gorm:"column:totp_secret;type:varchar(64);not null;comment:TOTP 密钥" json:"totp_secret"` // TOTP 密钥
+	TotpCounter   int64     `gorm:"column:totp_counter;type:bigint(20);not null;comment:最后一次通过校验的 TOTP 时间步" json:"totp_counter"` // 最后一次通过校验的 TOTP 时间步
+	RecoveryCodes string    `gorm:"column:recovery_codes;type:text;comment:加密后的恢复码列表" json:"recovery_codes"` // 加密后的恢复码列表
+	PasswordHistory   string    `gorm:"column:password_history;type:text;comment:加密后的历史密码列表" json:"password_history"` // 加密后的历史密码列表
+	PasswordUpdatedAt time.Time `gorm:"column:password_updated_at;type:datetime;not null;default:current_timestamp();comment:最后修改密码时间" json:"password_updated_at"` // 最后修改密码时间
 	CreatedAt time.Time `gorm:"column:created_at;type:datetime;not null;default:current_timestamp();comment:创建时间" json:"created_at"`         // 创建时间
 	UpdatedAt time.Time `gorm:"column:updated_at;type:datetime;not null;default:current_timestamp();comment:最后修改时间" json:"updated_at"`       // 最后修改时间
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/superproj/onex/internal/pkg/pprof"
+	"github.com/superproj/onex/internal/usercenter/auth"
 	"github.com/superproj/onex/internal/usercenter/service"
 	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
 	"github.com/superproj/onex/pkg/authn"
@@ -25,10 +26,15 @@ import (
 func NewWhiteListMatcher() selector.MatchFunc {
 	whitelist := make(map[string]struct{})
 	whitelist[v1.OperationUserCenterLogin] = struct{}{}
+	whitelist[v1.OperationUserCenterLoginMFA] = struct{}{}
+	whitelist[v1.OperationUserCenterOIDCAuthorize] = struct{}{}
+	whitelist[v1.OperationUserCenterOIDCCallback] = struct{}{}
 	whitelist[v1.OperationUserCenterCreateUser] = struct{}{}
+	whitelist[v1.OperationUserCenterUpdatePassword] = struct{}{}
 	whitelist[v1.OperationUserCenterAuth] = struct{}{}
 	whitelist[v1.OperationUserCenterAuthorize] = struct{}{}
 	whitelist[v1.OperationUserCenterAuthenticate] = struct{}{}
+	whitelist[v1.OperationUserCenterVerifySignature] = struct{}{}
 	return func(ctx context.Context, operation string) bool {
 		if _, ok := whitelist[operation]; ok {
 			return false
@@ -37,8 +43,22 @@ func NewWhiteListMatcher() selector.MatchFunc {
 	}
 }
 
+// NewOptionalAuthMatcher matches the whitelisted operations which still need to
+// know the caller if there is one. OIDCCallback needs it to complete identity links.
+func NewOptionalAuthMatcher() selector.MatchFunc {
+	return func(ctx context.Context, operation string) bool {
+		return operation == v1.OperationUserCenterOIDCCallback
+	}
+}
+
 // NewHTTPServer creates a new HTTP server with middleware and handler chain.
-func NewHTTPServer(c *Config, gw *service.UserCenterService, a authn.Authenticator, middlewares []middleware.Middleware) *http.Server {
+func NewHTTPServer(
+	c *Config,
+	gw *service.UserCenterService,
+	a authn.Authenticator,
+	keys auth.AuthnInterface,
+	middlewares []middleware.Middleware,
+) *http.Server {
 	// Define the server options with the middleware chain and other configuration.
 	opts := []http.ServerOption{
 		// http.WithDiscovery(nil),
@@ -76,6 +96,7 @@ func NewHTTPServer(c *Config, gw *service.UserCenterService, a authn.Authenticat
 	h := openapiv2.NewHandler()
 	srv.HandlePrefix("/openapi/", h)
 	srv.Handle("/metrics", promhttp.Handler())
+	srv.HandleFunc("/.well-known/jwks.json", NewJWKSHandler(keys))
 	srv.Handle("", pprof.NewHandler())
 
 	v1.RegisterUserCenterHTTPServer(srv, gw)
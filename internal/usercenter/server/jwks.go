@@ -0,0 +1,33 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/superproj/onex/internal/usercenter/auth"
+	"github.com/superproj/onex/pkg/log"
+)
+
+// NewJWKSHandler serves the public keys of the access tokens, so that other
+// services can verify the tokens without calling the usercenter.
+func NewJWKSHandler(a auth.AuthnInterface) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		jwks, err := a.JWKS()
+		if err != nil {
+			log.Errorw(err, "Failed to get the public keys")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		// Verifiers fetch the keys again when they see an unknown kid, so a short cache is enough.
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		_ = json.NewEncoder(w).Encode(jwks)
+	}
+}
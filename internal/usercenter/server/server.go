@@ -24,7 +24,9 @@ import (
 	"github.com/google/wire"
 	"golang.org/x/text/language"
 
+	"github.com/superproj/onex/internal/pkg/audit"
 	onexmetrics "github.com/superproj/onex/internal/pkg/metrics"
+	auditmw "github.com/superproj/onex/internal/pkg/middleware/audit"
 	"github.com/superproj/onex/internal/pkg/middleware/authn/jwt"
 	i18nmw "github.com/superproj/onex/internal/pkg/middleware/i18n"
 	"github.com/superproj/onex/internal/pkg/middleware/logging"
@@ -45,7 +47,7 @@ func NewServers(hs *http.Server, gs *grpc.Server) []transport.Server {
 }
 
 // NewMiddlewares return middlewares used by grpc and http server both.
-func NewMiddlewares(logger krtlog.Logger, a authn.Authenticator, v validate.IValidator) []middleware.Middleware {
+func NewMiddlewares(logger krtlog.Logger, a authn.Authenticator, v validate.IValidator, ad *audit.Auditor) []middleware.Middleware {
 	return []middleware.Middleware{
 		recovery.Recovery(
 			recovery.WithHandler(func(ctx context.Context, rq, err any) error {
@@ -64,6 +66,9 @@ func NewMiddlewares(logger krtlog.Logger, a authn.Authenticator, v validate.IVal
 		tracing.Server(),
 		metadata.Server(),
 		selector.Server(jwt.Server(a)).Match(NewWhiteListMatcher()).Build(),
+		selector.Server(jwt.Optional(a)).Match(NewOptionalAuthMatcher()).Build(),
+		// Runs before the validation, so that the rejected requests are audited too.
+		auditmw.Server(ad),
 		validate.Validator(v),
 		logging.Server(logger),
 	}
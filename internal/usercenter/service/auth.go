@@ -27,6 +27,31 @@ func (s *UserCenterService) Login(ctx context.Context, rq *v1.LoginRequest) (*v1
 	return resp, nil
 }
 
+// LoginMFA exchanges the mfa token returned by Login and a second factor for a token.
+func (s *UserCenterService) LoginMFA(ctx context.Context, rq *v1.LoginMFARequest) (*v1.LoginReply, error) {
+	resp, err := s.biz.Auths().LoginMFA(ctx, rq)
+	if err != nil {
+		return &v1.LoginReply{}, err
+	}
+
+	return resp, nil
+}
+
+// OIDCAuthorize returns the URL of the OpenID provider where the user logs in.
+func (s *UserCenterService) OIDCAuthorize(ctx context.Context, rq *v1.OIDCAuthorizeRequest) (*v1.OIDCAuthorizeReply, error) {
+	return s.biz.Auths().OIDCAuthorize(ctx, rq)
+}
+
+// OIDCCallback exchanges the code returned by the OpenID provider for a token.
+func (s *UserCenterService) OIDCCallback(ctx context.Context, rq *v1.OIDCCallbackRequest) (*v1.LoginReply, error) {
+	resp, err := s.biz.Auths().OIDCCallback(ctx, rq)
+	if err != nil {
+		return &v1.LoginReply{}, err
+	}
+
+	return resp, nil
+}
+
 // Logout invalidates the user token.
 func (s *UserCenterService) Logout(ctx context.Context, rq *v1.LogoutRequest) (*emptypb.Empty, error) {
 	if err := s.biz.Auths().Logout(ctx, rq); err != nil {
@@ -53,7 +78,7 @@ func (s *UserCenterService) Auth(ctx context.Context, rq *v1.AuthRequest) (*v1.A
 		return &v1.AuthResponse{}, err
 	}
 
-	authz, err := s.Authorize(ctx, &v1.AuthorizeRequest{Sub: authn.UserID, Obj: rq.Obj, Act: rq.Act})
+	authz, err := s.Authorize(ctx, &v1.AuthorizeRequest{Sub: authn.UserID, Dom: rq.Dom, Obj: rq.Obj, Act: rq.Act})
 	if err != nil {
 		return &v1.AuthResponse{}, err
 	}
@@ -71,9 +96,19 @@ func (s *UserCenterService) Authenticate(ctx context.Context, rq *v1.Authenticat
 	return resp, nil
 }
 
+// VerifySignature verifies a request signed with a secret and returns the user ID owning the secret.
+func (s *UserCenterService) VerifySignature(ctx context.Context, rq *v1.VerifySignatureRequest) (*v1.AuthenticateResponse, error) {
+	resp, err := s.biz.Auths().VerifySignature(ctx, rq)
+	if err != nil {
+		return &v1.AuthenticateResponse{}, err
+	}
+
+	return resp, nil
+}
+
 // Authorize checks whether the user is authorized for the object/action.
 func (s *UserCenterService) Authorize(ctx context.Context, rq *v1.AuthorizeRequest) (*v1.AuthorizeResponse, error) {
-	allowed, err := s.biz.Auths().Authorize(ctx, rq.Sub, rq.Obj, rq.Act)
+	allowed, err := s.biz.Auths().Authorize(ctx, rq.Sub, rq.Dom, rq.Obj, rq.Act)
 	if err != nil {
 		return &v1.AuthorizeResponse{}, err
 	}
@@ -0,0 +1,33 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package service
+
+import (
+	"context"
+
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// LinkIdentity is a method for starting to link an external identity to a user.
+// It takes a LinkIdentityRequest as input and returns an OIDCAuthorizeReply or an error.
+func (s *UserCenterService) LinkIdentity(ctx context.Context, rq *v1.LinkIdentityRequest) (*v1.OIDCAuthorizeReply, error) {
+	return s.biz.Identities().Link(ctx, rq)
+}
+
+// ListIdentity is a method for listing the external identities linked to a user.
+// It takes a ListIdentityRequest as input and returns a ListIdentityResponse or an error.
+func (s *UserCenterService) ListIdentity(ctx context.Context, rq *v1.ListIdentityRequest) (*v1.ListIdentityResponse, error) {
+	return s.biz.Identities().List(ctx, rq)
+}
+
+// DeleteIdentity is a method for unlinking an external identity from a user.
+// It takes a DeleteIdentityRequest as input and returns an empty response or an error.
+func (s *UserCenterService) DeleteIdentity(ctx context.Context, rq *v1.DeleteIdentityRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.biz.Identities().Delete(ctx, rq)
+}
@@ -0,0 +1,39 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package service
+
+import (
+	"context"
+
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// EnrollTOTP is a method for generating a new TOTP secret for a user.
+// It takes an EnrollTOTPRequest as input and returns an EnrollTOTPReply with the secret or an error.
+func (s *UserCenterService) EnrollTOTP(ctx context.Context, rq *v1.EnrollTOTPRequest) (*v1.EnrollTOTPReply, error) {
+	return s.biz.MFAs().EnrollTOTP(ctx, rq)
+}
+
+// ActivateTOTP is a method for enabling the TOTP secret enrolled by a user.
+// It takes an ActivateTOTPRequest as input and returns a RecoveryCodesReply or an error.
+func (s *UserCenterService) ActivateTOTP(ctx context.Context, rq *v1.ActivateTOTPRequest) (*v1.RecoveryCodesReply, error) {
+	return s.biz.MFAs().ActivateTOTP(ctx, rq)
+}
+
+// DisableTOTP is a method for disabling TOTP for a user.
+// It takes a DisableTOTPRequest as input and returns an empty response or an error.
+func (s *UserCenterService) DisableTOTP(ctx context.Context, rq *v1.DisableTOTPRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.biz.MFAs().DisableTOTP(ctx, rq)
+}
+
+// RegenerateRecoveryCodes is a method for replacing the recovery codes of a user.
+// It takes a RegenerateRecoveryCodesRequest as input and returns a RecoveryCodesReply or an error.
+func (s *UserCenterService) RegenerateRecoveryCodes(ctx context.Context, rq *v1.RegenerateRecoveryCodesRequest) (*v1.RecoveryCodesReply, error) {
+	return s.biz.MFAs().RegenerateRecoveryCodes(ctx, rq)
+}
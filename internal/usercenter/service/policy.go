@@ -0,0 +1,33 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package service
+
+import (
+	"context"
+
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// CreatePolicy is a method for adding an authorization policy.
+// It takes a CreatePolicyRequest as input and returns a PolicyReply or an error.
+func (s *UserCenterService) CreatePolicy(ctx context.Context, rq *v1.CreatePolicyRequest) (*v1.PolicyReply, error) {
+	return s.biz.Policies().Create(ctx, rq)
+}
+
+// ListPolicy is a method for listing authorization policies.
+// It takes a ListPolicyRequest as input and returns a ListPolicyResponse with the policies or an error.
+func (s *UserCenterService) ListPolicy(ctx context.Context, rq *v1.ListPolicyRequest) (*v1.ListPolicyResponse, error) {
+	return s.biz.Policies().List(ctx, rq)
+}
+
+// DeletePolicy is a method for removing an authorization policy.
+// It takes a DeletePolicyRequest as input and returns an Empty message or an error.
+func (s *UserCenterService) DeletePolicy(ctx context.Context, rq *v1.DeletePolicyRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.biz.Policies().Delete(ctx, rq)
+}
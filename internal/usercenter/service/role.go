@@ -0,0 +1,63 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package service
+
+import (
+	"context"
+
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// CreateRole is a method for creating a new role.
+// It takes a CreateRoleRequest as input and returns a RoleReply or an error.
+func (s *UserCenterService) CreateRole(ctx context.Context, rq *v1.CreateRoleRequest) (*v1.RoleReply, error) {
+	return s.biz.Roles().Create(ctx, rq)
+}
+
+// ListRole is a method for listing roles.
+// It takes a ListRoleRequest as input and returns a ListRoleResponse with the roles or an error.
+func (s *UserCenterService) ListRole(ctx context.Context, rq *v1.ListRoleRequest) (*v1.ListRoleResponse, error) {
+	return s.biz.Roles().List(ctx, rq)
+}
+
+// GetRole is a method for retrieving a specific role.
+// It takes a GetRoleRequest as input and returns a RoleReply with the role or an error.
+func (s *UserCenterService) GetRole(ctx context.Context, rq *v1.GetRoleRequest) (*v1.RoleReply, error) {
+	return s.biz.Roles().Get(ctx, rq)
+}
+
+// UpdateRole is a method for updating a role.
+// It takes an UpdateRoleRequest as input and returns an Empty message or an error.
+func (s *UserCenterService) UpdateRole(ctx context.Context, rq *v1.UpdateRoleRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.biz.Roles().Update(ctx, rq)
+}
+
+// DeleteRole is a method for deleting a role, together with its bindings and policies.
+// It takes a DeleteRoleRequest as input and returns an Empty message or an error.
+func (s *UserCenterService) DeleteRole(ctx context.Context, rq *v1.DeleteRoleRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.biz.Roles().Delete(ctx, rq)
+}
+
+// CreateRoleBinding is a method for binding a role to a user.
+// It takes a CreateRoleBindingRequest as input and returns a RoleBindingReply or an error.
+func (s *UserCenterService) CreateRoleBinding(ctx context.Context, rq *v1.CreateRoleBindingRequest) (*v1.RoleBindingReply, error) {
+	return s.biz.Roles().CreateBinding(ctx, rq)
+}
+
+// ListRoleBinding is a method for listing role bindings.
+// It takes a ListRoleBindingRequest as input and returns a ListRoleBindingResponse with the bindings or an error.
+func (s *UserCenterService) ListRoleBinding(ctx context.Context, rq *v1.ListRoleBindingRequest) (*v1.ListRoleBindingResponse, error) {
+	return s.biz.Roles().ListBindings(ctx, rq)
+}
+
+// DeleteRoleBinding is a method for unbinding a role from a user.
+// It takes a DeleteRoleBindingRequest as input and returns an Empty message or an error.
+func (s *UserCenterService) DeleteRoleBinding(ctx context.Context, rq *v1.DeleteRoleBindingRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.biz.Roles().DeleteBinding(ctx, rq)
+}
@@ -0,0 +1,33 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package service
+
+import (
+	"context"
+
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+
+	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
+)
+
+// ListSession is a method for listing the active login sessions of a user.
+// It takes a ListSessionRequest as input and returns a ListSessionResponse or an error.
+func (s *UserCenterService) ListSession(ctx context.Context, rq *v1.ListSessionRequest) (*v1.ListSessionResponse, error) {
+	return s.biz.Sessions().List(ctx, rq)
+}
+
+// RevokeSession is a method for revoking a login session of a user.
+// It takes a RevokeSessionRequest as input and returns an empty response or an error.
+func (s *UserCenterService) RevokeSession(ctx context.Context, rq *v1.RevokeSessionRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.biz.Sessions().Revoke(ctx, rq)
+}
+
+// RevokeSessions is a method for revoking all the login sessions of a user.
+// It takes a RevokeSessionsRequest as input and returns an empty response or an error.
+func (s *UserCenterService) RevokeSessions(ctx context.Context, rq *v1.RevokeSessionsRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.biz.Sessions().RevokeAll(ctx, rq)
+}
@@ -0,0 +1,73 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package store
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/superproj/onex/internal/usercenter/model"
+)
+
+// IdentityStore defines the interface for managing the external identities linked to users.
+type IdentityStore interface {
+	Create(ctx context.Context, identity *model.UserIdentityM) error
+	Delete(ctx context.Context, userID string, issuer string, subject string) error
+	Get(ctx context.Context, issuer string, subject string) (*model.UserIdentityM, error)
+	List(ctx context.Context, userID string) ([]*model.UserIdentityM, error)
+}
+
+// identityStore is an implementation of the IdentityStore interface
+// that manages the user identity model in a datastore.
+type identityStore struct {
+	ds *datastore
+}
+
+// newIdentityStore initializes a new identityStore instance using the provided datastore.
+func newIdentityStore(ds *datastore) *identityStore {
+	return &identityStore{ds}
+}
+
+// db is an alias for accessing the Core method of the datastore using the provided context.
+func (d *identityStore) db(ctx context.Context) *gorm.DB {
+	return d.ds.Core(ctx)
+}
+
+// Create links a new external identity to a user.
+func (d *identityStore) Create(ctx context.Context, identity *model.UserIdentityM) error {
+	return d.db(ctx).Create(&identity).Error
+}
+
+// Delete unlinks an external identity from a user.
+func (d *identityStore) Delete(ctx context.Context, userID string, issuer string, subject string) error {
+	err := d.db(ctx).
+		Where("user_id = ? and issuer = ? and subject = ?", userID, issuer, subject).
+		Delete(&model.UserIdentityM{}).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+// Get retrieves an external identity by the issuer and the subject of the identity provider.
+func (d *identityStore) Get(ctx context.Context, issuer string, subject string) (*model.UserIdentityM, error) {
+	identity := &model.UserIdentityM{}
+	if err := d.db(ctx).Where("issuer = ? and subject = ?", issuer, subject).First(&identity).Error; err != nil {
+		return nil, err
+	}
+
+	return identity, nil
+}
+
+// List returns all the external identities linked to a user.
+func (d *identityStore) List(ctx context.Context, userID string) (ret []*model.UserIdentityM, err error) {
+	err = d.db(ctx).Where("user_id = ?", userID).Order("id desc").Find(&ret).Error
+	return ret, err
+}
@@ -0,0 +1,75 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package store
+
+import (
+	"context"
+
+	"github.com/google/wire"
+
+	"github.com/superproj/onex/internal/usercenter/model"
+	jwtauthn "github.com/superproj/onex/pkg/authn/jwt"
+	"github.com/superproj/onex/pkg/log"
+)
+
+// keyStore is an implementation of the `github.com/superproj/onex/pkg/authn/jwt.KeyStore`
+// interface. It shares the token signing keys between all the usercenter replicas.
+type keyStore struct {
+	ds *datastore
+}
+
+var (
+	KeyStoreProviderSet                   = wire.NewSet(NewKeyStore, wire.Bind(new(jwtauthn.KeyStore), new(*keyStore)))
+	_                   jwtauthn.KeyStore = (*keyStore)(nil)
+)
+
+// NewKeyStore initializes a new keyStore instance using the provided datastore.
+func NewKeyStore(ds *datastore) *keyStore {
+	return &keyStore{ds}
+}
+
+// List returns all the signing keys in the datastore.
+func (d *keyStore) List(ctx context.Context) ([]*jwtauthn.SigningKey, error) {
+	var ret []*model.SigningKeyM
+	if err := d.ds.Core(ctx).Find(&ret).Error; err != nil {
+		return nil, err
+	}
+
+	keys := make([]*jwtauthn.SigningKey, 0, len(ret))
+	for _, m := range ret {
+		priv, err := jwtauthn.ParsePrivateKey([]byte(m.PrivateKey))
+		if err != nil {
+			// A broken key must not stop the others from being used.
+			log.Errorw(err, "Failed to parse signing key", "kid", m.Kid)
+			continue
+		}
+
+		keys = append(keys, &jwtauthn.SigningKey{ID: m.Kid, Algorithm: m.Algorithm, PrivateKey: priv, CreatedAt: m.CreatedAt})
+	}
+
+	return keys, nil
+}
+
+// Create adds a new signing key in the datastore.
+func (d *keyStore) Create(ctx context.Context, key *jwtauthn.SigningKey) error {
+	data, err := jwtauthn.MarshalPrivateKey(key.PrivateKey)
+	if err != nil {
+		return err
+	}
+
+	return d.ds.Core(ctx).Create(&model.SigningKeyM{
+		Kid:        key.ID,
+		Algorithm:  key.Algorithm,
+		PrivateKey: string(data),
+		CreatedAt:  key.CreatedAt,
+	}).Error
+}
+
+// Delete removes the signing key with the given kid from the datastore.
+func (d *keyStore) Delete(ctx context.Context, kid string) error {
+	return d.ds.Core(ctx).Where("kid = ?", kid).Delete(&model.SigningKeyM{}).Error
+}
@@ -5,7 +5,7 @@
 //
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/superproj/onex/internal/usercenter/store (interfaces: IStore,SecretStore,UserStore)
+// Source: github.com/superproj/onex/internal/usercenter/store (interfaces: IStore,SecretStore,UserStore,RoleStore,IdentityStore,SessionStore)
 
 // Package store is a generated GoMock package.
 package store
@@ -42,6 +42,34 @@ func (m *MockIStore) EXPECT() *MockIStoreMockRecorder {
 	return m.recorder
 }
 
+// Identities mocks base method.
+func (m *MockIStore) Identities() IdentityStore {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Identities")
+	ret0, _ := ret[0].(IdentityStore)
+	return ret0
+}
+
+// Identities indicates an expected call of Identities.
+func (mr *MockIStoreMockRecorder) Identities() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Identities", reflect.TypeOf((*MockIStore)(nil).Identities))
+}
+
+// Roles mocks base method.
+func (m *MockIStore) Roles() RoleStore {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Roles")
+	ret0, _ := ret[0].(RoleStore)
+	return ret0
+}
+
+// Roles indicates an expected call of Roles.
+func (mr *MockIStoreMockRecorder) Roles() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Roles", reflect.TypeOf((*MockIStore)(nil).Roles))
+}
+
 // Secrets mocks base method.
 func (m *MockIStore) Secrets() SecretStore {
 	m.ctrl.T.Helper()
@@ -56,6 +84,20 @@ func (mr *MockIStoreMockRecorder) Secrets() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Secrets", reflect.TypeOf((*MockIStore)(nil).Secrets))
 }
 
+// Sessions mocks base method.
+func (m *MockIStore) Sessions() SessionStore {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sessions")
+	ret0, _ := ret[0].(SessionStore)
+	return ret0
+}
+
+// Sessions indicates an expected call of Sessions.
+func (mr *MockIStoreMockRecorder) Sessions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sessions", reflect.TypeOf((*MockIStore)(nil).Sessions))
+}
+
 // TX mocks base method.
 func (m *MockIStore) TX(arg0 context.Context, arg1 func(context.Context) error) error {
 	m.ctrl.T.Helper()
@@ -315,3 +357,285 @@ func (mr *MockUserStoreMockRecorder) Update(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserStore)(nil).Update), arg0, arg1)
 }
+
+// MockRoleStore is a mock of RoleStore interface.
+type MockRoleStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleStoreMockRecorder
+}
+
+// MockRoleStoreMockRecorder is the mock recorder for MockRoleStore.
+type MockRoleStoreMockRecorder struct {
+	mock *MockRoleStore
+}
+
+// NewMockRoleStore creates a new mock instance.
+func NewMockRoleStore(ctrl *gomock.Controller) *MockRoleStore {
+	mock := &MockRoleStore{ctrl: ctrl}
+	mock.recorder = &MockRoleStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleStore) EXPECT() *MockRoleStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRoleStore) Create(arg0 context.Context, arg1 *model.RoleM) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRoleStoreMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoleStore)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockRoleStore) Delete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRoleStoreMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRoleStore)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockRoleStore) Get(arg0 context.Context, arg1 string) (*model.RoleM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*model.RoleM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRoleStoreMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRoleStore)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockRoleStore) List(arg0 context.Context, arg1 ...meta.ListOption) (int64, []*model.RoleM, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "List", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].([]*model.RoleM)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockRoleStoreMockRecorder) List(arg0 any, arg1 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRoleStore)(nil).List), varargs...)
+}
+
+// Update mocks base method.
+func (m *MockRoleStore) Update(arg0 context.Context, arg1 *model.RoleM) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRoleStoreMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRoleStore)(nil).Update), arg0, arg1)
+}
+
+// MockIdentityStore is a mock of IdentityStore interface.
+type MockIdentityStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdentityStoreMockRecorder
+}
+
+// MockIdentityStoreMockRecorder is the mock recorder for MockIdentityStore.
+type MockIdentityStoreMockRecorder struct {
+	mock *MockIdentityStore
+}
+
+// NewMockIdentityStore creates a new mock instance.
+func NewMockIdentityStore(ctrl *gomock.Controller) *MockIdentityStore {
+	mock := &MockIdentityStore{ctrl: ctrl}
+	mock.recorder = &MockIdentityStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdentityStore) EXPECT() *MockIdentityStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIdentityStore) Create(arg0 context.Context, arg1 *model.UserIdentityM) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIdentityStoreMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIdentityStore)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockIdentityStore) Delete(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIdentityStoreMockRecorder) Delete(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIdentityStore)(nil).Delete), arg0, arg1, arg2, arg3)
+}
+
+// Get mocks base method.
+func (m *MockIdentityStore) Get(arg0 context.Context, arg1, arg2 string) (*model.UserIdentityM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.UserIdentityM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIdentityStoreMockRecorder) Get(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIdentityStore)(nil).Get), arg0, arg1, arg2)
+}
+
+// List mocks base method.
+func (m *MockIdentityStore) List(arg0 context.Context, arg1 string) ([]*model.UserIdentityM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]*model.UserIdentityM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIdentityStoreMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIdentityStore)(nil).List), arg0, arg1)
+}
+
+// MockSessionStore is a mock of SessionStore interface.
+type MockSessionStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionStoreMockRecorder
+}
+
+// MockSessionStoreMockRecorder is the mock recorder for MockSessionStore.
+type MockSessionStoreMockRecorder struct {
+	mock *MockSessionStore
+}
+
+// NewMockSessionStore creates a new mock instance.
+func NewMockSessionStore(ctrl *gomock.Controller) *MockSessionStore {
+	mock := &MockSessionStore{ctrl: ctrl}
+	mock.recorder = &MockSessionStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionStore) EXPECT() *MockSessionStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSessionStore) Create(arg0 context.Context, arg1 *model.SessionM) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSessionStoreMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSessionStore)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockSessionStore) Delete(arg0 context.Context, arg1 string, arg2 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Delete", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSessionStoreMockRecorder) Delete(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSessionStore)(nil).Delete), varargs...)
+}
+
+// Get mocks base method.
+func (m *MockSessionStore) Get(arg0 context.Context, arg1, arg2 string) (*model.SessionM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.SessionM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSessionStoreMockRecorder) Get(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSessionStore)(nil).Get), arg0, arg1, arg2)
+}
+
+// List mocks base method.
+func (m *MockSessionStore) List(arg0 context.Context, arg1 string) ([]*model.SessionM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]*model.SessionM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockSessionStoreMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSessionStore)(nil).List), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockSessionStore) Update(arg0 context.Context, arg1 *model.SessionM) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSessionStoreMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSessionStore)(nil).Update), arg0, arg1)
+}
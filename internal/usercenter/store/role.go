@@ -0,0 +1,92 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package store
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/superproj/onex/internal/pkg/meta"
+	"github.com/superproj/onex/internal/usercenter/model"
+)
+
+// RoleStore defines the role storage interface, containing methods
+// for managing role records in a datastore.
+type RoleStore interface {
+	Create(ctx context.Context, role *model.RoleM) error
+	Delete(ctx context.Context, name string) error
+	Update(ctx context.Context, role *model.RoleM) error
+	Get(ctx context.Context, name string) (*model.RoleM, error)
+	List(ctx context.Context, opts ...meta.ListOption) (int64, []*model.RoleM, error)
+}
+
+// roleStore is an implementation of the RoleStore interface
+// that manages the role model in a datastore.
+type roleStore struct {
+	ds *datastore
+}
+
+// newRoleStore initializes a new roleStore instance using the provided datastore.
+func newRoleStore(ds *datastore) *roleStore {
+	return &roleStore{ds}
+}
+
+// db is an alias for accessing the Core method of the datastore using the provided context.
+func (d *roleStore) db(ctx context.Context) *gorm.DB {
+	return d.ds.Core(ctx)
+}
+
+// Create adds a new role record in the datastore.
+func (d *roleStore) Create(ctx context.Context, role *model.RoleM) error {
+	return d.db(ctx).Create(&role).Error
+}
+
+// Delete removes a role record from the datastore based on name.
+func (d *roleStore) Delete(ctx context.Context, name string) error {
+	err := d.db(ctx).Where("name = ?", name).Delete(&model.RoleM{}).Error
+	// If error is not a "record not found" error, return the error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+// Update modifies an existing role record in the datastore.
+func (d *roleStore) Update(ctx context.Context, role *model.RoleM) error {
+	return d.db(ctx).Save(role).Error
+}
+
+// Get retrieves a role record from the datastore based on name.
+func (d *roleStore) Get(ctx context.Context, name string) (*model.RoleM, error) {
+	role := &model.RoleM{}
+	if err := d.db(ctx).Where("name = ?", name).First(&role).Error; err != nil {
+		return nil, err
+	}
+
+	return role, nil
+}
+
+// List returns a list of role records that match the specified query conditions.
+// It returns the total count of records and a slice of role records.
+func (d *roleStore) List(ctx context.Context, opts ...meta.ListOption) (count int64, ret []*model.RoleM, err error) {
+	o := meta.NewListOptions(opts...)
+
+	ans := d.db(ctx).
+		Where(o.Filters).
+		Offset(o.Offset).
+		Limit(o.Limit).
+		Order("id desc").
+		Find(&ret).
+		Offset(-1).
+		Limit(-1).
+		Count(&count)
+
+	return count, ret, ans.Error
+}
@@ -0,0 +1,93 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/superproj/onex/internal/usercenter/model"
+)
+
+// SessionStore defines the interface for managing the login sessions of users.
+type SessionStore interface {
+	Create(ctx context.Context, session *model.SessionM) error
+	Update(ctx context.Context, session *model.SessionM) error
+	Delete(ctx context.Context, userID string, sessionIDs ...string) error
+	Get(ctx context.Context, userID string, sessionID string) (*model.SessionM, error)
+	List(ctx context.Context, userID string) ([]*model.SessionM, error)
+}
+
+// sessionStore is an implementation of the SessionStore interface
+// that manages the session model in a datastore.
+type sessionStore struct {
+	ds *datastore
+}
+
+// newSessionStore initializes a new sessionStore instance using the provided datastore.
+func newSessionStore(ds *datastore) *sessionStore {
+	return &sessionStore{ds}
+}
+
+// db is an alias for accessing the Core method of the datastore using the provided context.
+func (d *sessionStore) db(ctx context.Context) *gorm.DB {
+	return d.ds.Core(ctx)
+}
+
+// Create records a new login session, and removes the expired sessions of the same user.
+func (d *sessionStore) Create(ctx context.Context, session *model.SessionM) error {
+	err := d.db(ctx).
+		Where("user_id = ? and expires_at < ?", session.UserID, time.Now()).
+		Delete(&model.SessionM{}).Error
+	if err != nil {
+		return err
+	}
+
+	return d.db(ctx).Create(&session).Error
+}
+
+// Update modifies an existing session.
+func (d *sessionStore) Update(ctx context.Context, session *model.SessionM) error {
+	return d.db(ctx).Save(session).Error
+}
+
+// Delete removes the sessions of a user. All the sessions are removed if no sessionIDs is given.
+func (d *sessionStore) Delete(ctx context.Context, userID string, sessionIDs ...string) error {
+	db := d.db(ctx).Where("user_id = ?", userID)
+	if len(sessionIDs) > 0 {
+		db = db.Where("session_id in ?", sessionIDs)
+	}
+
+	err := db.Delete(&model.SessionM{}).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+// Get retrieves a session of a user.
+func (d *sessionStore) Get(ctx context.Context, userID string, sessionID string) (*model.SessionM, error) {
+	session := &model.SessionM{}
+	if err := d.db(ctx).Where("user_id = ? and session_id = ?", userID, sessionID).First(&session).Error; err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// List returns the unexpired sessions of a user, the most recently issued first.
+func (d *sessionStore) List(ctx context.Context, userID string) (ret []*model.SessionM, err error) {
+	err = d.db(ctx).
+		Where("user_id = ? and expires_at >= ?", userID, time.Now()).
+		Order("issued_at desc").
+		Find(&ret).Error
+	return ret, err
+}
@@ -6,7 +6,7 @@
 
 package store
 
-//go:generate mockgen -self_package github.com/superproj/onex/internal/usercenter/store -destination mock_store.go -package store github.com/superproj/onex/internal/usercenter/store IStore,SecretStore,UserStore
+//go:generate mockgen -self_package github.com/superproj/onex/internal/usercenter/store -destination mock_store.go -package store github.com/superproj/onex/internal/usercenter/store IStore,SecretStore,UserStore,RoleStore,IdentityStore,SessionStore
 
 import (
 	"context"
@@ -36,6 +36,9 @@ type IStore interface {
 	TX(context.Context, func(ctx context.Context) error) error
 	Users() UserStore
 	Secrets() SecretStore
+	Roles() RoleStore
+	Identities() IdentityStore
+	Sessions() SessionStore
 }
 
 // datastore is an implementation of IStore that provides methods
@@ -98,3 +101,18 @@ func (ds *datastore) Users() UserStore {
 func (ds *datastore) Secrets() SecretStore {
 	return newSecretStore(ds)
 }
+
+// Roles returns an initialized instance of RoleStore.
+func (ds *datastore) Roles() RoleStore {
+	return newRoleStore(ds)
+}
+
+// Identities returns an initialized instance of IdentityStore.
+func (ds *datastore) Identities() IdentityStore {
+	return newIdentityStore(ds)
+}
+
+// Sessions returns an initialized instance of SessionStore.
+func (ds *datastore) Sessions() SessionStore {
+	return newSessionStore(ds)
+}
@@ -12,7 +12,9 @@ import (
 	"github.com/go-kratos/kratos/v2"
 	"github.com/jinzhu/copier"
 
+	"github.com/superproj/onex/internal/pkg/audit"
 	"github.com/superproj/onex/internal/pkg/bootstrap"
+	"github.com/superproj/onex/internal/usercenter/auth"
 	"github.com/superproj/onex/internal/usercenter/server"
 	"github.com/superproj/onex/pkg/db"
 	"github.com/superproj/onex/pkg/log"
@@ -30,16 +32,21 @@ var (
 
 // Config represents the configuration of the service.
 type Config struct {
-	GRPCOptions   *genericoptions.GRPCOptions
-	HTTPOptions   *genericoptions.HTTPOptions
-	TLSOptions    *genericoptions.TLSOptions
-	JWTOptions    *genericoptions.JWTOptions
-	MySQLOptions  *genericoptions.MySQLOptions
-	RedisOptions  *genericoptions.RedisOptions
-	EtcdOptions   *genericoptions.EtcdOptions
-	KafkaOptions  *genericoptions.KafkaOptions
-	JaegerOptions *genericoptions.JaegerOptions
-	ConsulOptions *genericoptions.ConsulOptions
+	GRPCOptions     *genericoptions.GRPCOptions
+	HTTPOptions     *genericoptions.HTTPOptions
+	TLSOptions      *genericoptions.TLSOptions
+	JWTOptions      *genericoptions.JWTOptions
+	MySQLOptions    *genericoptions.MySQLOptions
+	RedisOptions    *genericoptions.RedisOptions
+	EtcdOptions     *genericoptions.EtcdOptions
+	KafkaOptions    *genericoptions.KafkaOptions
+	JaegerOptions   *genericoptions.JaegerOptions
+	ConsulOptions   *genericoptions.ConsulOptions
+	AuthnOptions    *auth.AuthnOptions
+	AuthzOptions    *auth.AuthzOptions
+	OIDCOptions     *auth.OIDCOptions
+	PasswordOptions *auth.PasswordOptions
+	AuditOptions    *audit.AuditOptions
 }
 
 // Complete fills in any fields not set that are required to have valid data. It's mutating the receiver.
@@ -70,7 +77,7 @@ func (c completedConfig) New(stopCh <-chan struct{}) (*Server, error) {
 	_ = copier.Copy(&dbOptions, c.MySQLOptions)
 
 	// Initialize Kratos application with the provided configurations.
-	app, cleanup, err := wireApp(appInfo, conf, &dbOptions, c.JWTOptions, c.RedisOptions, c.EtcdOptions, c.KafkaOptions)
+	app, cleanup, err := wireApp(appInfo, conf, &dbOptions, c.JWTOptions, c.RedisOptions, c.EtcdOptions, c.KafkaOptions, c.AuthnOptions, c.AuthzOptions, c.OIDCOptions, c.PasswordOptions, c.AuditOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,50 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/superproj/onex/internal/usercenter/locales"
+	"github.com/superproj/onex/pkg/i18n"
+)
+
+// validatePassword checks the password against the complexity rules of the password policy.
+func (vd *validator) validatePassword(ctx context.Context, password string) error {
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			symbol = true
+		}
+	}
+
+	l := i18n.FromContext(ctx)
+	switch {
+	case utf8.RuneCountInString(password) < vd.password.MinLength:
+		return fmt.Errorf(l.T(locales.PasswordTooShort), vd.password.MinLength)
+	case vd.password.RequireUppercase && !upper:
+		return l.E(locales.PasswordMissingUppercase)
+	case vd.password.RequireLowercase && !lower:
+		return l.E(locales.PasswordMissingLowercase)
+	case vd.password.RequireDigit && !digit:
+		return l.E(locales.PasswordMissingDigit)
+	case vd.password.RequireSymbol && !symbol:
+		return l.E(locales.PasswordMissingSymbol)
+	}
+
+	return nil
+}
@@ -12,9 +12,9 @@ import (
 
 	"github.com/google/wire"
 
-	"github.com/superproj/onex/internal/pkg/known"
 	ucknown "github.com/superproj/onex/internal/pkg/known/usercenter"
 	"github.com/superproj/onex/internal/pkg/onexx"
+	"github.com/superproj/onex/internal/usercenter/auth"
 	"github.com/superproj/onex/internal/usercenter/locales"
 	"github.com/superproj/onex/internal/usercenter/store"
 	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
@@ -26,14 +26,17 @@ var ProviderSet = wire.NewSet(New, wire.Bind(new(any), new(*validator)))
 
 // validator struct implements the custom validator interface.
 type validator struct {
-	ds store.IStore
+	ds       store.IStore
+	authz    auth.AuthzInterface
+	password *auth.PasswordOptions
 }
 
 // New creates and initializes a custom validator.
-// It receives an instance of store.IStore interface as parameter ds
-// and returns a new *validator and an error.
-func New(ds store.IStore) (*validator, error) {
-	vd := &validator{ds: ds}
+// It receives an instance of store.IStore interface as parameter ds, the authorizer
+// used to check the membership of the admin group, the password policy, and returns
+// a new *validator and an error.
+func New(ds store.IStore, authz auth.AuthzInterface, password *auth.PasswordOptions) (*validator, error) {
+	vd := &validator{ds: ds, authz: authz, password: password}
 
 	return vd, nil
 }
@@ -45,19 +48,116 @@ func (vd *validator) ValidateCreateUserRequest(ctx context.Context, rq *v1.Creat
 		return i18n.FromContext(ctx).E(locales.UserAlreadyExists)
 	}
 
-	return nil
+	return vd.validatePassword(ctx, rq.Password)
 }
 
 // ValidateListUserRequest validates the rquest to list users.
-// Ensures that only a user with the AdminUserID can view the list of users, otherwise returning an error.
+// Ensures that only the members of the admin group can view the list of users, otherwise returning an error.
 func (vd *validator) ValidateListUserRequest(ctx context.Context, rq *v1.ListUserRequest) error {
-	if userID := onexx.FromUserID(ctx); userID != known.AdminUserID {
+	if !vd.authz.IsAdmin(onexx.FromUserID(ctx)) {
 		return i18n.FromContext(ctx).E(locales.UserListUnauthorized)
 	}
 
 	return nil
 }
 
+// ValidateUpdateUserRequest validates the rquest to update a user.
+// Only the members of the admin group can change whether MFA is required for a user.
+func (vd *validator) ValidateUpdateUserRequest(ctx context.Context, rq *v1.UpdateUserRequest) error {
+	if rq.MfaRequired != nil {
+		return vd.requireAdmin(ctx)
+	}
+
+	return nil
+}
+
+// ValidateUpdatePasswordRequest validates the rquest to update the password of a user.
+// The new password must comply with the password policy.
+func (vd *validator) ValidateUpdatePasswordRequest(ctx context.Context, rq *v1.UpdatePasswordRequest) error {
+	return vd.validatePassword(ctx, rq.NewPassword)
+}
+
+// ValidateEnrollTOTPRequest validates the rquest to enroll a TOTP secret.
+func (vd *validator) ValidateEnrollTOTPRequest(ctx context.Context, rq *v1.EnrollTOTPRequest) error {
+	return vd.requireSelf(ctx, rq.Username)
+}
+
+// ValidateActivateTOTPRequest validates the rquest to activate a TOTP secret.
+func (vd *validator) ValidateActivateTOTPRequest(ctx context.Context, rq *v1.ActivateTOTPRequest) error {
+	return vd.requireSelf(ctx, rq.Username)
+}
+
+// ValidateDisableTOTPRequest validates the rquest to disable TOTP.
+// Users must confirm with a passcode, while the members of the admin group can
+// disable TOTP for any user without one, e.g. when the device is lost.
+func (vd *validator) ValidateDisableTOTPRequest(ctx context.Context, rq *v1.DisableTOTPRequest) error {
+	if vd.authz.IsAdmin(onexx.FromUserID(ctx)) {
+		return nil
+	}
+
+	if rq.Code == "" {
+		return v1.ErrorMFACodeInvalid("passcode is required")
+	}
+
+	return vd.requireSelf(ctx, rq.Username)
+}
+
+// ValidateRegenerateRecoveryCodesRequest validates the rquest to regenerate recovery codes.
+func (vd *validator) ValidateRegenerateRecoveryCodesRequest(ctx context.Context, rq *v1.RegenerateRecoveryCodesRequest) error {
+	return vd.requireSelf(ctx, rq.Username)
+}
+
+// ValidateLinkIdentityRequest validates the rquest to link an external identity.
+// Users can only link identities to themselves.
+func (vd *validator) ValidateLinkIdentityRequest(ctx context.Context, rq *v1.LinkIdentityRequest) error {
+	return vd.requireSelf(ctx, rq.Username)
+}
+
+// ValidateListIdentityRequest validates the rquest to list external identities.
+func (vd *validator) ValidateListIdentityRequest(ctx context.Context, rq *v1.ListIdentityRequest) error {
+	return vd.requireSelfOrAdmin(ctx, rq.Username)
+}
+
+// ValidateDeleteIdentityRequest validates the rquest to unlink an external identity.
+func (vd *validator) ValidateDeleteIdentityRequest(ctx context.Context, rq *v1.DeleteIdentityRequest) error {
+	return vd.requireSelfOrAdmin(ctx, rq.Username)
+}
+
+// ValidateListSessionRequest validates the rquest to list login sessions.
+func (vd *validator) ValidateListSessionRequest(ctx context.Context, rq *v1.ListSessionRequest) error {
+	return vd.requireSelfOrAdmin(ctx, rq.Username)
+}
+
+// ValidateRevokeSessionRequest validates the rquest to revoke a login session.
+func (vd *validator) ValidateRevokeSessionRequest(ctx context.Context, rq *v1.RevokeSessionRequest) error {
+	return vd.requireSelfOrAdmin(ctx, rq.Username)
+}
+
+// ValidateRevokeSessionsRequest validates the rquest to revoke all the login sessions.
+func (vd *validator) ValidateRevokeSessionsRequest(ctx context.Context, rq *v1.RevokeSessionsRequest) error {
+	return vd.requireSelfOrAdmin(ctx, rq.Username)
+}
+
+// requireSelfOrAdmin returns an error if the request is neither sent by the user
+// nor by a member of the admin group.
+func (vd *validator) requireSelfOrAdmin(ctx context.Context, username string) error {
+	if vd.authz.IsAdmin(onexx.FromUserID(ctx)) {
+		return nil
+	}
+
+	return vd.requireSelf(ctx, username)
+}
+
+// requireSelf returns an error if the user is not the one who sends the request.
+func (vd *validator) requireSelf(ctx context.Context, username string) error {
+	userM, err := vd.ds.Users().GetByUsername(ctx, username)
+	if err != nil || userM.UserID != onexx.FromUserID(ctx) {
+		return i18n.FromContext(ctx).E(locales.UserOperationForbidden)
+	}
+
+	return nil
+}
+
 // ValidateCreateSecretRequest validates the rquest to create a secret.
 // Returns an error if the maximum number of secrets is reached.
 func (vd *validator) ValidateCreateSecretRequest(ctx context.Context, rq *v1.CreateSecretRequest) error {
@@ -84,3 +184,68 @@ func (vd *validator) ValidateAuthRequest(ctx context.Context, rq *v1.AuthRequest
 func (vd *validator) ValidateAuthorizeRequest(ctx context.Context, rq *v1.AuthorizeRequest) error {
 	return nil
 }
+
+// requireAdmin returns an error if the request is not sent by a member of the admin group.
+// Roles, role bindings and policies can only be managed by the administrators.
+func (vd *validator) requireAdmin(ctx context.Context) error {
+	if !vd.authz.IsAdmin(onexx.FromUserID(ctx)) {
+		return i18n.FromContext(ctx).E(locales.NoPermission)
+	}
+
+	return nil
+}
+
+// ValidateCreateRoleRequest validates the rquest to create a role.
+func (vd *validator) ValidateCreateRoleRequest(ctx context.Context, rq *v1.CreateRoleRequest) error {
+	return vd.requireAdmin(ctx)
+}
+
+// ValidateListRoleRequest validates the rquest to list roles.
+func (vd *validator) ValidateListRoleRequest(ctx context.Context, rq *v1.ListRoleRequest) error {
+	return vd.requireAdmin(ctx)
+}
+
+// ValidateGetRoleRequest validates the rquest to get a role.
+func (vd *validator) ValidateGetRoleRequest(ctx context.Context, rq *v1.GetRoleRequest) error {
+	return vd.requireAdmin(ctx)
+}
+
+// ValidateUpdateRoleRequest validates the rquest to update a role.
+func (vd *validator) ValidateUpdateRoleRequest(ctx context.Context, rq *v1.UpdateRoleRequest) error {
+	return vd.requireAdmin(ctx)
+}
+
+// ValidateDeleteRoleRequest validates the rquest to delete a role.
+func (vd *validator) ValidateDeleteRoleRequest(ctx context.Context, rq *v1.DeleteRoleRequest) error {
+	return vd.requireAdmin(ctx)
+}
+
+// ValidateCreateRoleBindingRequest validates the rquest to bind a role to a user.
+func (vd *validator) ValidateCreateRoleBindingRequest(ctx context.Context, rq *v1.CreateRoleBindingRequest) error {
+	return vd.requireAdmin(ctx)
+}
+
+// ValidateListRoleBindingRequest validates the rquest to list role bindings.
+func (vd *validator) ValidateListRoleBindingRequest(ctx context.Context, rq *v1.ListRoleBindingRequest) error {
+	return vd.requireAdmin(ctx)
+}
+
+// ValidateDeleteRoleBindingRequest validates the rquest to unbind a role from a user.
+func (vd *validator) ValidateDeleteRoleBindingRequest(ctx context.Context, rq *v1.DeleteRoleBindingRequest) error {
+	return vd.requireAdmin(ctx)
+}
+
+// ValidateCreatePolicyRequest validates the rquest to create a policy.
+func (vd *validator) ValidateCreatePolicyRequest(ctx context.Context, rq *v1.CreatePolicyRequest) error {
+	return vd.requireAdmin(ctx)
+}
+
+// ValidateListPolicyRequest validates the rquest to list policies.
+func (vd *validator) ValidateListPolicyRequest(ctx context.Context, rq *v1.ListPolicyRequest) error {
+	return vd.requireAdmin(ctx)
+}
+
+// ValidateDeletePolicyRequest validates the rquest to delete a policy.
+func (vd *validator) ValidateDeletePolicyRequest(ctx context.Context, rq *v1.DeletePolicyRequest) error {
+	return vd.requireAdmin(ctx)
+}
@@ -15,6 +15,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/superproj/onex/internal/pkg/onexx"
+	"github.com/superproj/onex/internal/usercenter/auth"
 	"github.com/superproj/onex/internal/usercenter/store"
 	v1 "github.com/superproj/onex/pkg/api/usercenter/v1"
 )
@@ -24,9 +25,13 @@ func TestNew(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockStore := store.NewMockIStore(ctrl)
+	mockAuthz := auth.NewMockAuthzInterface(ctrl)
+	password := auth.NewPasswordOptions()
 
 	type args struct {
-		ds store.IStore
+		ds       store.IStore
+		authz    auth.AuthzInterface
+		password *auth.PasswordOptions
 	}
 	tests := []struct {
 		name    string
@@ -36,13 +41,13 @@ func TestNew(t *testing.T) {
 	}{
 		{
 			name: "default",
-			args: args{ds: mockStore},
-			want: &validator{mockStore},
+			args: args{ds: mockStore, authz: mockAuthz, password: password},
+			want: &validator{ds: mockStore, authz: mockAuthz, password: password},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := New(tt.args.ds)
+			got, err := New(tt.args.ds, tt.args.authz, tt.args.password)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -94,7 +99,8 @@ func Test_validator_ValidateCreateUserRequest(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			vd := &validator{
-				ds: tt.fields.ds,
+				ds:       tt.fields.ds,
+				password: auth.NewPasswordOptions(),
 			}
 			if err := vd.ValidateCreateUserRequest(tt.args.ctx, tt.args.rq); (err != nil) != tt.wantErr {
 				t.Errorf("validator.ValidateCreateUserRequest() error = %v, wantErr %v", err, tt.wantErr)
@@ -103,10 +109,47 @@ func Test_validator_ValidateCreateUserRequest(t *testing.T) {
 	}
 }
 
+func Test_validator_ValidateUpdatePasswordRequest(t *testing.T) {
+	strict := &auth.PasswordOptions{
+		MinLength:        10,
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireDigit:     true,
+		RequireSymbol:    true,
+	}
+
+	tests := []struct {
+		name     string
+		password *auth.PasswordOptions
+		new      string
+		wantErr  bool
+	}{
+		{name: "default", password: auth.NewPasswordOptions(), new: "onex(#)666", wantErr: false},
+		{name: "too short", password: auth.NewPasswordOptions(), new: "onex666", wantErr: true},
+		{name: "missing digit", password: auth.NewPasswordOptions(), new: "onex(#)onex", wantErr: true},
+		{name: "strict", password: strict, new: "Onex(#)6666", wantErr: false},
+		{name: "strict missing uppercase", password: strict, new: "onex(#)6666", wantErr: true},
+		{name: "strict missing symbol", password: strict, new: "Onex666666", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vd := &validator{password: tt.password}
+			rq := &v1.UpdatePasswordRequest{Username: "colin", OldPassword: "onex(#)666", NewPassword: tt.new}
+			if err := vd.ValidateUpdatePasswordRequest(context.Background(), rq); (err != nil) != tt.wantErr {
+				t.Errorf("validator.ValidateUpdatePasswordRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func Test_validator_ValidateListUserRequest(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	mockAuthz := auth.NewMockAuthzInterface(ctrl)
+	mockAuthz.EXPECT().IsAdmin("user-admin").Return(true)
+	mockAuthz.EXPECT().IsAdmin("user-xxx").Return(false)
+
 	type fields struct {
 		ds store.IStore
 	}
@@ -152,7 +195,8 @@ func Test_validator_ValidateListUserRequest(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			vd := &validator{
-				ds: tt.fields.ds,
+				ds:    tt.fields.ds,
+				authz: mockAuthz,
 			}
 			if err := vd.ValidateListUserRequest(tt.args.ctx, tt.args.rq); (err != nil) != tt.wantErr {
 				t.Errorf("validator.ValidateListUserRequest() error = %v, wantErr %v", err, tt.wantErr)
@@ -17,6 +17,7 @@ import (
 	"github.com/go-kratos/kratos/v2"
 	"github.com/google/wire"
 
+	"github.com/superproj/onex/internal/pkg/audit"
 	"github.com/superproj/onex/internal/pkg/bootstrap"
 	"github.com/superproj/onex/internal/pkg/validation"
 	"github.com/superproj/onex/internal/usercenter/auth"
@@ -39,6 +40,11 @@ func wireApp(
 	*genericoptions.RedisOptions,
 	*genericoptions.EtcdOptions,
 	*genericoptions.KafkaOptions,
+	*auth.AuthnOptions,
+	*auth.AuthzOptions,
+	*auth.OIDCOptions,
+	*auth.PasswordOptions,
+	*audit.AuditOptions,
 ) (*kratos.App, func(), error) {
 	wire.Build(
 		bootstrap.ProviderSet,
@@ -50,9 +56,12 @@ func wireApp(
 		service.ProviderSet,
 		auth.ProviderSet,
 		store.SetterProviderSet,
+		store.KeyStoreProviderSet,
+		NewTokenStore,
 		NewAuthenticator,
 		validation.ProviderSet,
 		customvalidation.ProviderSet,
+		audit.ProviderSet,
 	)
 
 	return nil, nil, nil
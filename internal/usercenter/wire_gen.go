@@ -14,6 +14,7 @@ package usercenter
 
 import (
 	"github.com/go-kratos/kratos/v2"
+	"github.com/superproj/onex/internal/pkg/audit"
 	"github.com/superproj/onex/internal/pkg/bootstrap"
 	validation2 "github.com/superproj/onex/internal/pkg/validation"
 	"github.com/superproj/onex/internal/usercenter/auth"
@@ -30,7 +31,7 @@ import (
 
 // wireApp builds and returns a Kratos app with the given options.
 // It uses the Wire library to automatically generate the dependency injection code.
-func wireApp(appInfo bootstrap.AppInfo, config *server.Config, mySQLOptions *db.MySQLOptions, jwtOptions *options.JWTOptions, redisOptions *options.RedisOptions, etcdOptions *options.EtcdOptions, kafkaOptions *options.KafkaOptions) (*kratos.App, func(), error) {
+func wireApp(appInfo bootstrap.AppInfo, config *server.Config, mySQLOptions *db.MySQLOptions, jwtOptions *options.JWTOptions, redisOptions *options.RedisOptions, etcdOptions *options.EtcdOptions, kafkaOptions *options.KafkaOptions, authnOptions *auth.AuthnOptions, authzOptions *auth.AuthzOptions, oidcOptions *auth.OIDCOptions, passwordOptions *auth.PasswordOptions, auditOptions *audit.AuditOptions) (*kratos.App, func(), error) {
 	logger := bootstrap.NewLogger(appInfo)
 	registrar := bootstrap.NewEtcdRegistrar(etcdOptions)
 	appConfig := bootstrap.AppConfig{
@@ -43,41 +44,73 @@ func wireApp(appInfo bootstrap.AppInfo, config *server.Config, mySQLOptions *db.
 		return nil, nil, err
 	}
 	datastore := store.NewStore(gormDB)
-	authenticator, cleanup, err := NewAuthenticator(jwtOptions, redisOptions)
+	storer := NewTokenStore(redisOptions)
+	authenticator, cleanup, err := NewAuthenticator(jwtOptions, storer)
 	if err != nil {
 		return nil, nil, err
 	}
 	secretSetter := store.NewSecretSetter(datastore)
-	authnImpl, err := auth.NewAuthn(secretSetter)
+	keyStore := store.NewKeyStore(datastore)
+	authnImpl, cleanup2, err := auth.NewAuthn(secretSetter, keyStore, storer, authnOptions)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
 	kafkaLogger, err := auth.NewLogger(kafkaOptions)
 	if err != nil {
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	authzImpl, err := auth.NewAuthz(gormDB, redisOptions, kafkaLogger)
+	authzImpl, err := auth.NewAuthz(gormDB, redisOptions, authzOptions, kafkaLogger)
 	if err != nil {
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
 	authAuth := auth.NewAuth(authnImpl, authzImpl)
-	bizBiz := biz.NewBiz(datastore, authenticator, authAuth)
+	oidc, cleanup3, err := auth.NewOIDC(redisOptions, oidcOptions)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	lockout, cleanup4, err := auth.NewLockout(redisOptions, passwordOptions)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	bizBiz := biz.NewBiz(datastore, authenticator, authAuth, oidc, lockout, passwordOptions, authnOptions)
 	userCenterService := service.NewUserCenterService(bizBiz)
-	validator, err := validation.New(datastore)
+	validator, err := validation.New(datastore, authzImpl, passwordOptions)
 	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
 	validationValidator := validation2.New(validator)
-	v := server.NewMiddlewares(logger, authenticator, validationValidator)
-	httpServer := server.NewHTTPServer(config, userCenterService, authenticator, v)
+	auditor, cleanup5, err := audit.NewAuditor(auditOptions, gormDB)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	v := server.NewMiddlewares(logger, authenticator, validationValidator, auditor)
+	httpServer := server.NewHTTPServer(config, userCenterService, authenticator, authnImpl, v)
 	grpcServer := server.NewGRPCServer(config, userCenterService, v)
 	v2 := server.NewServers(httpServer, grpcServer)
 	app := bootstrap.NewApp(appConfig, v2...)
 	return app, func() {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -38,6 +38,18 @@ const (
 	ErrorReason_UserNotFound ErrorReason = 2
 	// 创建用户失败错误
 	ErrorReason_UserCreateFailed ErrorReason = 3
+	// 区块链未找到错误
+	ErrorReason_ChainNotFound ErrorReason = 4
+	// 区块链已存在错误
+	ErrorReason_ChainAlreadyExists ErrorReason = 5
+	// 监听的资源版本已过期，需要重新全量监听
+	ErrorReason_ResourceVersionExpired ErrorReason = 6
+	// 超出了用户的资源配额
+	ErrorReason_QuotaExceeded ErrorReason = 7
+	// 充值请求未找到错误
+	ErrorReason_ChargeRequestNotFound ErrorReason = 8
+	// 充值请求已存在错误
+	ErrorReason_ChargeRequestAlreadyExists ErrorReason = 9
 )
 
 // Enum value maps for ErrorReason.
@@ -47,12 +59,24 @@ var (
 		1: "UserAlreadyExists",
 		2: "UserNotFound",
 		3: "UserCreateFailed",
+		4: "ChainNotFound",
+		5: "ChainAlreadyExists",
+		6: "ResourceVersionExpired",
+		7: "QuotaExceeded",
+		8: "ChargeRequestNotFound",
+		9: "ChargeRequestAlreadyExists",
 	}
 	ErrorReason_value = map[string]int32{
-		"UserLoginFailed":   0,
-		"UserAlreadyExists": 1,
-		"UserNotFound":      2,
-		"UserCreateFailed":  3,
+		"UserLoginFailed":            0,
+		"UserAlreadyExists":          1,
+		"UserNotFound":               2,
+		"UserCreateFailed":           3,
+		"ChainNotFound":              4,
+		"ChainAlreadyExists":         5,
+		"ResourceVersionExpired":     6,
+		"QuotaExceeded":              7,
+		"ChargeRequestNotFound":      8,
+		"ChargeRequestAlreadyExists": 9,
 	}
 )
 
@@ -89,19 +113,30 @@ var file_gateway_v1_errors_proto_rawDesc = []byte{
 	0x0a, 0x17, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x72, 0x72,
 	0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x77,
 	0x61, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x13, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x7f, 0x0a, 0x0b, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x0f, 0x55, 0x73, 0x65,
-	0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x00, 0x1a, 0x04,
-	0xa8, 0x45, 0x91, 0x03, 0x12, 0x1b, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x72, 0x65,
-	0x61, 0x64, 0x79, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x99,
-	0x03, 0x12, 0x16, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e,
-	0x64, 0x10, 0x02, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x1a, 0x0a, 0x10, 0x55, 0x73, 0x65,
-	0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x03, 0x1a,
-	0x04, 0xa8, 0x45, 0x9d, 0x04, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x31, 0x5a, 0x2f, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x75, 0x70, 0x65, 0x72, 0x70,
-	0x72, 0x6f, 0x6a, 0x2f, 0x6f, 0x6e, 0x65, 0x78, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0xb8, 0x02, 0x0a, 0x0b, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x0f, 0x55, 0x73,
+	0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x00, 0x1a,
+	0x04, 0xa8, 0x45, 0x91, 0x03, 0x12, 0x1b, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6c, 0x72,
+	0x65, 0x61, 0x64, 0x79, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0x01, 0x1a, 0x04, 0xa8, 0x45,
+	0x99, 0x03, 0x12, 0x16, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75,
+	0x6e, 0x64, 0x10, 0x02, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x1a, 0x0a, 0x10, 0x55, 0x73,
+	0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x03,
+	0x1a, 0x04, 0xa8, 0x45, 0x9d, 0x04, 0x12, 0x17, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4e,
+	0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x10, 0x04, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12,
+	0x1c, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0x05, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03, 0x12, 0x20, 0x0a,
+	0x16, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x10, 0x06, 0x1a, 0x04, 0xa8, 0x45, 0x9a, 0x03, 0x12,
+	0x17, 0x0a, 0x0d, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64,
+	0x10, 0x07, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x1f, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e,
+	0x64, 0x10, 0x08, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x24, 0x0a, 0x1a, 0x43, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64,
+	0x79, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0x09, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03, 0x1a,
+	0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x75, 0x70, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x6a, 0x2f, 0x6f, 0x6e,
+	0x65, 0x78, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -66,3 +66,87 @@ func IsUserCreateFailed(err error) bool {
 func ErrorUserCreateFailed(format string, args ...interface{}) *errors.Error {
 	return errors.New(541, ErrorReason_UserCreateFailed.String(), fmt.Sprintf(format, args...))
 }
+
+// 区块链未找到错误
+func IsChainNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_ChainNotFound.String() && e.Code == 404
+}
+
+// 区块链未找到错误
+func ErrorChainNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_ChainNotFound.String(), fmt.Sprintf(format, args...))
+}
+
+// 区块链已存在错误
+func IsChainAlreadyExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_ChainAlreadyExists.String() && e.Code == 409
+}
+
+// 区块链已存在错误
+func ErrorChainAlreadyExists(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_ChainAlreadyExists.String(), fmt.Sprintf(format, args...))
+}
+
+// 监听的资源版本已过期，需要重新全量监听
+func IsResourceVersionExpired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_ResourceVersionExpired.String() && e.Code == 410
+}
+
+// 监听的资源版本已过期，需要重新全量监听
+func ErrorResourceVersionExpired(format string, args ...interface{}) *errors.Error {
+	return errors.New(410, ErrorReason_ResourceVersionExpired.String(), fmt.Sprintf(format, args...))
+}
+
+// 超出了用户的资源配额
+func IsQuotaExceeded(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_QuotaExceeded.String() && e.Code == 403
+}
+
+// 超出了用户的资源配额
+func ErrorQuotaExceeded(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_QuotaExceeded.String(), fmt.Sprintf(format, args...))
+}
+
+// 充值请求未找到错误
+func IsChargeRequestNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_ChargeRequestNotFound.String() && e.Code == 404
+}
+
+// 充值请求未找到错误
+func ErrorChargeRequestNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_ChargeRequestNotFound.String(), fmt.Sprintf(format, args...))
+}
+
+// 充值请求已存在错误
+func IsChargeRequestAlreadyExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_ChargeRequestAlreadyExists.String() && e.Code == 409
+}
+
+// 充值请求已存在错误
+func ErrorChargeRequestAlreadyExists(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_ChargeRequestAlreadyExists.String(), fmt.Sprintf(format, args...))
+}
@@ -172,22 +172,22 @@ func (x *GetVersionResponse) GetPlatform() string {
 	return ""
 }
 
-type MinerSet struct {
+type Chain struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Replicas      int32                  `protobuf:"varint,2,opt,name=replicas,proto3" json:"replicas,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,3,opt,name=displayName,proto3" json:"displayName,omitempty"`
-	DeletePolicy  string                 `protobuf:"bytes,4,opt,name=deletePolicy,proto3" json:"deletePolicy,omitempty"`
-	MinerTemplate *MinerTemplate         `protobuf:"bytes,5,opt,name=MinerTemplate,proto3" json:"MinerTemplate,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Name                   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName            string                 `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	MinerType              string                 `protobuf:"bytes,3,opt,name=minerType,proto3" json:"minerType,omitempty"`
+	Image                  string                 `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
+	MinMineIntervalSeconds int32                  `protobuf:"varint,5,opt,name=minMineIntervalSeconds,proto3" json:"minMineIntervalSeconds,omitempty"`
+	CreatedAt              *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt              *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 }
 
-func (x *MinerSet) Reset() {
-	*x = MinerSet{}
+func (x *Chain) Reset() {
+	*x = Chain{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gateway_v1_gateway_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -195,13 +195,13 @@ func (x *MinerSet) Reset() {
 	}
 }
 
-func (x *MinerSet) String() string {
+func (x *Chain) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MinerSet) ProtoMessage() {}
+func (*Chain) ProtoMessage() {}
 
-func (x *MinerSet) ProtoReflect() protoreflect.Message {
+func (x *Chain) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_v1_gateway_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -213,72 +213,71 @@ func (x *MinerSet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MinerSet.ProtoReflect.Descriptor instead.
-func (*MinerSet) Descriptor() ([]byte, []int) {
+// Deprecated: Use Chain.ProtoReflect.Descriptor instead.
+func (*Chain) Descriptor() ([]byte, []int) {
 	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *MinerSet) GetName() string {
+func (x *Chain) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *MinerSet) GetReplicas() int32 {
+func (x *Chain) GetDisplayName() string {
 	if x != nil {
-		return x.Replicas
+		return x.DisplayName
 	}
-	return 0
+	return ""
 }
 
-func (x *MinerSet) GetDisplayName() string {
+func (x *Chain) GetMinerType() string {
 	if x != nil {
-		return x.DisplayName
+		return x.MinerType
 	}
 	return ""
 }
 
-func (x *MinerSet) GetDeletePolicy() string {
+func (x *Chain) GetImage() string {
 	if x != nil {
-		return x.DeletePolicy
+		return x.Image
 	}
 	return ""
 }
 
-func (x *MinerSet) GetMinerTemplate() *MinerTemplate {
+func (x *Chain) GetMinMineIntervalSeconds() int32 {
 	if x != nil {
-		return x.MinerTemplate
+		return x.MinMineIntervalSeconds
 	}
-	return nil
+	return 0
 }
 
-func (x *MinerSet) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Chain) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *MinerSet) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Chain) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return nil
 }
 
-type MinerTemplate struct {
+type ListChainRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MinerType string `protobuf:"bytes,1,opt,name=minerType,proto3" json:"minerType,omitempty"`
-	ChainName string `protobuf:"bytes,2,opt,name=chainName,proto3" json:"chainName,omitempty"`
-	DataDir   string `protobuf:"bytes,3,opt,name=dataDir,proto3" json:"dataDir,omitempty"`
+	Limit  int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
 }
 
-func (x *MinerTemplate) Reset() {
-	*x = MinerTemplate{}
+func (x *ListChainRequest) Reset() {
+	*x = ListChainRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gateway_v1_gateway_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -286,13 +285,13 @@ func (x *MinerTemplate) Reset() {
 	}
 }
 
-func (x *MinerTemplate) String() string {
+func (x *ListChainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MinerTemplate) ProtoMessage() {}
+func (*ListChainRequest) ProtoMessage() {}
 
-func (x *MinerTemplate) ProtoReflect() protoreflect.Message {
+func (x *ListChainRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_v1_gateway_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -304,45 +303,36 @@ func (x *MinerTemplate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MinerTemplate.ProtoReflect.Descriptor instead.
-func (*MinerTemplate) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListChainRequest.ProtoReflect.Descriptor instead.
+func (*ListChainRequest) Descriptor() ([]byte, []int) {
 	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *MinerTemplate) GetMinerType() string {
-	if x != nil {
-		return x.MinerType
-	}
-	return ""
-}
-
-func (x *MinerTemplate) GetChainName() string {
+func (x *ListChainRequest) GetLimit() int64 {
 	if x != nil {
-		return x.ChainName
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *MinerTemplate) GetDataDir() string {
+func (x *ListChainRequest) GetOffset() int64 {
 	if x != nil {
-		return x.DataDir
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-type CreateMinerSetRequest struct {
+type ListChainResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Replicas      int32          `protobuf:"varint,1,opt,name=replicas,proto3" json:"replicas,omitempty"`
-	DisplayName   string         `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
-	DeletePolicy  string         `protobuf:"bytes,3,opt,name=deletePolicy,proto3" json:"deletePolicy,omitempty"`
-	MinerTemplate *MinerTemplate `protobuf:"bytes,4,opt,name=MinerTemplate,proto3" json:"MinerTemplate,omitempty"`
+	TotalCount int64    `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	Chains     []*Chain `protobuf:"bytes,2,rep,name=Chains,proto3" json:"Chains,omitempty"`
 }
 
-func (x *CreateMinerSetRequest) Reset() {
-	*x = CreateMinerSetRequest{}
+func (x *ListChainResponse) Reset() {
+	*x = ListChainResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gateway_v1_gateway_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -350,13 +340,13 @@ func (x *CreateMinerSetRequest) Reset() {
 	}
 }
 
-func (x *CreateMinerSetRequest) String() string {
+func (x *ListChainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMinerSetRequest) ProtoMessage() {}
+func (*ListChainResponse) ProtoMessage() {}
 
-func (x *CreateMinerSetRequest) ProtoReflect() protoreflect.Message {
+func (x *ListChainResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_v1_gateway_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -368,50 +358,35 @@ func (x *CreateMinerSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMinerSetRequest.ProtoReflect.Descriptor instead.
-func (*CreateMinerSetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListChainResponse.ProtoReflect.Descriptor instead.
+func (*ListChainResponse) Descriptor() ([]byte, []int) {
 	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateMinerSetRequest) GetReplicas() int32 {
+func (x *ListChainResponse) GetTotalCount() int64 {
 	if x != nil {
-		return x.Replicas
+		return x.TotalCount
 	}
 	return 0
 }
 
-func (x *CreateMinerSetRequest) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
-	}
-	return ""
-}
-
-func (x *CreateMinerSetRequest) GetDeletePolicy() string {
-	if x != nil {
-		return x.DeletePolicy
-	}
-	return ""
-}
-
-func (x *CreateMinerSetRequest) GetMinerTemplate() *MinerTemplate {
+func (x *ListChainResponse) GetChains() []*Chain {
 	if x != nil {
-		return x.MinerTemplate
+		return x.Chains
 	}
 	return nil
 }
 
-type ListMinerSetRequest struct {
+type GetChainRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limit  int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *ListMinerSetRequest) Reset() {
-	*x = ListMinerSetRequest{}
+func (x *GetChainRequest) Reset() {
+	*x = GetChainRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gateway_v1_gateway_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -419,13 +394,13 @@ func (x *ListMinerSetRequest) Reset() {
 	}
 }
 
-func (x *ListMinerSetRequest) String() string {
+func (x *GetChainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMinerSetRequest) ProtoMessage() {}
+func (*GetChainRequest) ProtoMessage() {}
 
-func (x *ListMinerSetRequest) ProtoReflect() protoreflect.Message {
+func (x *GetChainRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_v1_gateway_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -437,36 +412,28 @@ func (x *ListMinerSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMinerSetRequest.ProtoReflect.Descriptor instead.
-func (*ListMinerSetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetChainRequest.ProtoReflect.Descriptor instead.
+func (*GetChainRequest) Descriptor() ([]byte, []int) {
 	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ListMinerSetRequest) GetLimit() int64 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *ListMinerSetRequest) GetOffset() int64 {
+func (x *GetChainRequest) GetName() string {
 	if x != nil {
-		return x.Offset
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type ListMinerSetResponse struct {
+type DeleteChainRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalCount int64       `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
-	MinerSets  []*MinerSet `protobuf:"bytes,2,rep,name=MinerSets,proto3" json:"MinerSets,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *ListMinerSetResponse) Reset() {
-	*x = ListMinerSetResponse{}
+func (x *DeleteChainRequest) Reset() {
+	*x = DeleteChainRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gateway_v1_gateway_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -474,13 +441,13 @@ func (x *ListMinerSetResponse) Reset() {
 	}
 }
 
-func (x *ListMinerSetResponse) String() string {
+func (x *DeleteChainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMinerSetResponse) ProtoMessage() {}
+func (*DeleteChainRequest) ProtoMessage() {}
 
-func (x *ListMinerSetResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteChainRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_v1_gateway_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -492,35 +459,34 @@ func (x *ListMinerSetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMinerSetResponse.ProtoReflect.Descriptor instead.
-func (*ListMinerSetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteChainRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChainRequest) Descriptor() ([]byte, []int) {
 	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListMinerSetResponse) GetTotalCount() int64 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *ListMinerSetResponse) GetMinerSets() []*MinerSet {
+func (x *DeleteChainRequest) GetName() string {
 	if x != nil {
-		return x.MinerSets
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type GetMinerSetRequest struct {
+type MinerSet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Replicas      int32                  `protobuf:"varint,2,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	DeletePolicy  string                 `protobuf:"bytes,4,opt,name=deletePolicy,proto3" json:"deletePolicy,omitempty"`
+	MinerTemplate *MinerTemplate         `protobuf:"bytes,5,opt,name=MinerTemplate,proto3" json:"MinerTemplate,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 }
 
-func (x *GetMinerSetRequest) Reset() {
-	*x = GetMinerSetRequest{}
+func (x *MinerSet) Reset() {
+	*x = MinerSet{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gateway_v1_gateway_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -528,13 +494,13 @@ func (x *GetMinerSetRequest) Reset() {
 	}
 }
 
-func (x *GetMinerSetRequest) String() string {
+func (x *MinerSet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMinerSetRequest) ProtoMessage() {}
+func (*MinerSet) ProtoMessage() {}
 
-func (x *GetMinerSetRequest) ProtoReflect() protoreflect.Message {
+func (x *MinerSet) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_v1_gateway_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -546,30 +512,72 @@ func (x *GetMinerSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMinerSetRequest.ProtoReflect.Descriptor instead.
-func (*GetMinerSetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MinerSet.ProtoReflect.Descriptor instead.
+func (*MinerSet) Descriptor() ([]byte, []int) {
 	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetMinerSetRequest) GetName() string {
+func (x *MinerSet) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type UpdateMinerSetRequest struct {
+func (x *MinerSet) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *MinerSet) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *MinerSet) GetDeletePolicy() string {
+	if x != nil {
+		return x.DeletePolicy
+	}
+	return ""
+}
+
+func (x *MinerSet) GetMinerTemplate() *MinerTemplate {
+	if x != nil {
+		return x.MinerTemplate
+	}
+	return nil
+}
+
+func (x *MinerSet) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MinerSet) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type MinerTemplate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	DisplayName  *string `protobuf:"bytes,2,opt,name=displayName,proto3,oneof" json:"displayName,omitempty"`
-	DeletePolicy *string `protobuf:"bytes,3,opt,name=deletePolicy,proto3,oneof" json:"deletePolicy,omitempty"`
+	MinerType string `protobuf:"bytes,1,opt,name=minerType,proto3" json:"minerType,omitempty"`
+	ChainName string `protobuf:"bytes,2,opt,name=chainName,proto3" json:"chainName,omitempty"`
+	DataDir   string `protobuf:"bytes,3,opt,name=dataDir,proto3" json:"dataDir,omitempty"`
 }
 
-func (x *UpdateMinerSetRequest) Reset() {
-	*x = UpdateMinerSetRequest{}
+func (x *MinerTemplate) Reset() {
+	*x = MinerTemplate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gateway_v1_gateway_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -577,13 +585,13 @@ func (x *UpdateMinerSetRequest) Reset() {
 	}
 }
 
-func (x *UpdateMinerSetRequest) String() string {
+func (x *MinerTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMinerSetRequest) ProtoMessage() {}
+func (*MinerTemplate) ProtoMessage() {}
 
-func (x *UpdateMinerSetRequest) ProtoReflect() protoreflect.Message {
+func (x *MinerTemplate) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_v1_gateway_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -595,56 +603,59 @@ func (x *UpdateMinerSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMinerSetRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMinerSetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MinerTemplate.ProtoReflect.Descriptor instead.
+func (*MinerTemplate) Descriptor() ([]byte, []int) {
 	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateMinerSetRequest) GetName() string {
+func (x *MinerTemplate) GetMinerType() string {
 	if x != nil {
-		return x.Name
+		return x.MinerType
 	}
 	return ""
 }
 
-func (x *UpdateMinerSetRequest) GetDisplayName() string {
-	if x != nil && x.DisplayName != nil {
-		return *x.DisplayName
+func (x *MinerTemplate) GetChainName() string {
+	if x != nil {
+		return x.ChainName
 	}
 	return ""
 }
 
-func (x *UpdateMinerSetRequest) GetDeletePolicy() string {
-	if x != nil && x.DeletePolicy != nil {
-		return *x.DeletePolicy
+func (x *MinerTemplate) GetDataDir() string {
+	if x != nil {
+		return x.DataDir
 	}
 	return ""
 }
 
-type DeleteMinerSetRequest struct {
+type CreateMinerSetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Replicas      int32          `protobuf:"varint,1,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	DisplayName   string         `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	DeletePolicy  string         `protobuf:"bytes,3,opt,name=deletePolicy,proto3" json:"deletePolicy,omitempty"`
+	MinerTemplate *MinerTemplate `protobuf:"bytes,4,opt,name=MinerTemplate,proto3" json:"MinerTemplate,omitempty"`
 }
 
-func (x *DeleteMinerSetRequest) Reset() {
-	*x = DeleteMinerSetRequest{}
-	if protoimpl.UnsafeEnabled {
+func (x *CreateMinerSetRequest) Reset() {
+	*x = CreateMinerSetRequest{}
+	if protoimpl.UnsafeEnabled {
 		mi := &file_gateway_v1_gateway_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteMinerSetRequest) String() string {
+func (x *CreateMinerSetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMinerSetRequest) ProtoMessage() {}
+func (*CreateMinerSetRequest) ProtoMessage() {}
 
-func (x *DeleteMinerSetRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateMinerSetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_v1_gateway_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -656,29 +667,61 @@ func (x *DeleteMinerSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMinerSetRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMinerSetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateMinerSetRequest.ProtoReflect.Descriptor instead.
+func (*CreateMinerSetRequest) Descriptor() ([]byte, []int) {
 	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeleteMinerSetRequest) GetName() string {
+func (x *CreateMinerSetRequest) GetReplicas() int32 {
 	if x != nil {
-		return x.Name
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *CreateMinerSetRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
 	}
 	return ""
 }
 
-type ScaleMinerSetRequest struct {
+func (x *CreateMinerSetRequest) GetDeletePolicy() string {
+	if x != nil {
+		return x.DeletePolicy
+	}
+	return ""
+}
+
+func (x *CreateMinerSetRequest) GetMinerTemplate() *MinerTemplate {
+	if x != nil {
+		return x.MinerTemplate
+	}
+	return nil
+}
+
+type ListMinerSetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Replicas int32  `protobuf:"varint,2,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// offset is ignored if continue is set.
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// labelSelector selects by labels, e.g. `env=prod,tier in (a,b)`.
+	LabelSelector string `protobuf:"bytes,3,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
+	// fieldSelector selects by fields, e.g. `status.phase=Failed`. The supported fields are `metadata.name`, `metadata.namespace`,
+	// `spec.template.spec.chainName` and `spec.template.spec.minerType`.
+	FieldSelector string `protobuf:"bytes,4,opt,name=fieldSelector,proto3" json:"fieldSelector,omitempty"`
+	// orderBy is one of `name`, `createdAt`, `updatedAt` and `replicas`, prefixed with `-`
+	// for the descending order. The newest are returned first by default.
+	OrderBy string `protobuf:"bytes,5,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
+	// continue is the token returned by the previous page.
+	Continue string `protobuf:"bytes,6,opt,name=continue,proto3" json:"continue,omitempty"`
 }
 
-func (x *ScaleMinerSetRequest) Reset() {
-	*x = ScaleMinerSetRequest{}
+func (x *ListMinerSetRequest) Reset() {
+	*x = ListMinerSetRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gateway_v1_gateway_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -686,13 +729,13 @@ func (x *ScaleMinerSetRequest) Reset() {
 	}
 }
 
-func (x *ScaleMinerSetRequest) String() string {
+func (x *ListMinerSetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScaleMinerSetRequest) ProtoMessage() {}
+func (*ListMinerSetRequest) ProtoMessage() {}
 
-func (x *ScaleMinerSetRequest) ProtoReflect() protoreflect.Message {
+func (x *ListMinerSetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_v1_gateway_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -704,57 +747,1059 @@ func (x *ScaleMinerSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScaleMinerSetRequest.ProtoReflect.Descriptor instead.
-func (*ScaleMinerSetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListMinerSetRequest.ProtoReflect.Descriptor instead.
+func (*ListMinerSetRequest) Descriptor() ([]byte, []int) {
 	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ScaleMinerSetRequest) GetName() string {
+func (x *ListMinerSetRequest) GetLimit() int64 {
 	if x != nil {
-		return x.Name
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMinerSetRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListMinerSetRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
 	}
 	return ""
 }
 
-func (x *ScaleMinerSetRequest) GetReplicas() int32 {
+func (x *ListMinerSetRequest) GetFieldSelector() string {
 	if x != nil {
-		return x.Replicas
+		return x.FieldSelector
+	}
+	return ""
+}
+
+func (x *ListMinerSetRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListMinerSetRequest) GetContinue() string {
+	if x != nil {
+		return x.Continue
+	}
+	return ""
+}
+
+type ListMinerSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalCount int64       `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	MinerSets  []*MinerSet `protobuf:"bytes,2,rep,name=MinerSets,proto3" json:"MinerSets,omitempty"`
+	// continue is the token of the next page, which is empty on the last page.
+	Continue string `protobuf:"bytes,3,opt,name=continue,proto3" json:"continue,omitempty"`
+}
+
+func (x *ListMinerSetResponse) Reset() {
+	*x = ListMinerSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_v1_gateway_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMinerSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMinerSetResponse) ProtoMessage() {}
+
+func (x *ListMinerSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMinerSetResponse.ProtoReflect.Descriptor instead.
+func (*ListMinerSetResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListMinerSetResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
 	}
 	return 0
 }
 
-type Miner struct {
+func (x *ListMinerSetResponse) GetMinerSets() []*MinerSet {
+	if x != nil {
+		return x.MinerSets
+	}
+	return nil
+}
+
+func (x *ListMinerSetResponse) GetContinue() string {
+	if x != nil {
+		return x.Continue
+	}
+	return ""
+}
+
+type GetMinerSetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	DisplayName string                 `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
-	MinerType   string                 `protobuf:"bytes,3,opt,name=minerType,proto3" json:"minerType,omitempty"`
-	ChainName   string                 `protobuf:"bytes,4,opt,name=chainName,proto3" json:"chainName,omitempty"`
-	DataDir     string                 `protobuf:"bytes,5,opt,name=dataDir,proto3" json:"dataDir,omitempty"`
-	Status      string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetMinerSetRequest) Reset() {
+	*x = GetMinerSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_v1_gateway_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMinerSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMinerSetRequest) ProtoMessage() {}
+
+func (x *GetMinerSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMinerSetRequest.ProtoReflect.Descriptor instead.
+func (*GetMinerSetRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetMinerSetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateMinerSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName  *string `protobuf:"bytes,2,opt,name=displayName,proto3,oneof" json:"displayName,omitempty"`
+	DeletePolicy *string `protobuf:"bytes,3,opt,name=deletePolicy,proto3,oneof" json:"deletePolicy,omitempty"`
+}
+
+func (x *UpdateMinerSetRequest) Reset() {
+	*x = UpdateMinerSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_v1_gateway_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMinerSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMinerSetRequest) ProtoMessage() {}
+
+func (x *UpdateMinerSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMinerSetRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMinerSetRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateMinerSetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateMinerSetRequest) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateMinerSetRequest) GetDeletePolicy() string {
+	if x != nil && x.DeletePolicy != nil {
+		return *x.DeletePolicy
+	}
+	return ""
+}
+
+type DeleteMinerSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteMinerSetRequest) Reset() {
+	*x = DeleteMinerSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_v1_gateway_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteMinerSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMinerSetRequest) ProtoMessage() {}
+
+func (x *DeleteMinerSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMinerSetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMinerSetRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteMinerSetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ScaleMinerSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Replicas int32  `protobuf:"varint,2,opt,name=replicas,proto3" json:"replicas,omitempty"`
+}
+
+func (x *ScaleMinerSetRequest) Reset() {
+	*x = ScaleMinerSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_v1_gateway_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScaleMinerSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleMinerSetRequest) ProtoMessage() {}
+
+func (x *ScaleMinerSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleMinerSetRequest.ProtoReflect.Descriptor instead.
+func (*ScaleMinerSetRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ScaleMinerSetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScaleMinerSetRequest) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+type Miner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName string                 `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	MinerType   string                 `protobuf:"bytes,3,opt,name=minerType,proto3" json:"minerType,omitempty"`
+	ChainName   string                 `protobuf:"bytes,4,opt,name=chainName,proto3" json:"chainName,omitempty"`
+	DataDir     string                 `protobuf:"bytes,5,opt,name=dataDir,proto3" json:"dataDir,omitempty"`
+	Status      string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *Miner) Reset() {
+	*x = Miner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_v1_gateway_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Miner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Miner) ProtoMessage() {}
+
+func (x *Miner) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Miner.ProtoReflect.Descriptor instead.
+func (*Miner) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Miner) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Miner) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Miner) GetMinerType() string {
+	if x != nil {
+		return x.MinerType
+	}
+	return ""
+}
+
+func (x *Miner) GetChainName() string {
+	if x != nil {
+		return x.ChainName
+	}
+	return ""
+}
+
+func (x *Miner) GetDataDir() string {
+	if x != nil {
+		return x.DataDir
+	}
+	return ""
+}
+
+func (x *Miner) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Miner) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Miner) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateMinerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DisplayName string `protobuf:"bytes,1,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	MinerType   string `protobuf:"bytes,2,opt,name=minerType,proto3" json:"minerType,omitempty"`
+	ChainName   string `protobuf:"bytes,3,opt,name=chainName,proto3" json:"chainName,omitempty"`
+	DataDir     string `protobuf:"bytes,4,opt,name=dataDir,proto3" json:"dataDir,omitempty"`
+}
+
+func (x *CreateMinerRequest) Reset() {
+	*x = CreateMinerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_v1_gateway_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateMinerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMinerRequest) ProtoMessage() {}
+
+func (x *CreateMinerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMinerRequest.ProtoReflect.Descriptor instead.
+func (*CreateMinerRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateMinerRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *CreateMinerRequest) GetMinerType() string {
+	if x != nil {
+		return x.MinerType
+	}
+	return ""
+}
+
+func (x *CreateMinerRequest) GetChainName() string {
+	if x != nil {
+		return x.ChainName
+	}
+	return ""
+}
+
+func (x *CreateMinerRequest) GetDataDir() string {
+	if x != nil {
+		return x.DataDir
+	}
+	return ""
+}
+
+type ListMinerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// offset is ignored if continue is set.
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// labelSelector selects by labels, e.g. `env=prod,tier in (a,b)`.
+	LabelSelector string `protobuf:"bytes,3,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
+	// fieldSelector selects by fields, e.g. `status.phase=Failed`. The supported fields are `metadata.name`, `metadata.namespace`,
+	// `spec.chainName`, `spec.minerType` and `status.phase`.
+	FieldSelector string `protobuf:"bytes,4,opt,name=fieldSelector,proto3" json:"fieldSelector,omitempty"`
+	// orderBy is one of `name`, `createdAt` and `updatedAt`, prefixed with `-`
+	// for the descending order. The newest are returned first by default.
+	OrderBy string `protobuf:"bytes,5,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
+	// continue is the token returned by the previous page.
+	Continue string `protobuf:"bytes,6,opt,name=continue,proto3" json:"continue,omitempty"`
+}
+
+func (x *ListMinerRequest) Reset() {
+	*x = ListMinerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_v1_gateway_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMinerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMinerRequest) ProtoMessage() {}
+
+func (x *ListMinerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMinerRequest.ProtoReflect.Descriptor instead.
+func (*ListMinerRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListMinerRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMinerRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListMinerRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+func (x *ListMinerRequest) GetFieldSelector() string {
+	if x != nil {
+		return x.FieldSelector
+	}
+	return ""
+}
+
+func (x *ListMinerRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListMinerRequest) GetContinue() string {
+	if x != nil {
+		return x.Continue
+	}
+	return ""
+}
+
+type ListMinerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalCount int64    `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	Miners     []*Miner `protobuf:"bytes,2,rep,name=Miners,proto3" json:"Miners,omitempty"`
+	// continue is the token of the next page, which is empty on the last page.
+	Continue string `protobuf:"bytes,3,opt,name=continue,proto3" json:"continue,omitempty"`
+}
+
+func (x *ListMinerResponse) Reset() {
+	*x = ListMinerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_v1_gateway_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMinerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMinerResponse) ProtoMessage() {}
+
+func (x *ListMinerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMinerResponse.ProtoReflect.Descriptor instead.
+func (*ListMinerResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListMinerResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListMinerResponse) GetMiners() []*Miner {
+	if x != nil {
+		return x.Miners
+	}
+	return nil
+}
+
+func (x *ListMinerResponse) GetContinue() string {
+	if x != nil {
+		return x.Continue
+	}
+	return ""
+}
+
+type GetMinerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetMinerRequest) Reset() {
+	*x = GetMinerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_v1_gateway_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMinerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMinerRequest) ProtoMessage() {}
+
+func (x *GetMinerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMinerRequest.ProtoReflect.Descriptor instead.
+func (*GetMinerRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetMinerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateMinerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName  *string `protobuf:"bytes,2,opt,name=displayName,proto3,oneof" json:"displayName,omitempty"`
+	DeletePolicy *string `protobuf:"bytes,3,opt,name=deletePolicy,proto3,oneof" json:"deletePolicy,omitempty"`
+}
+
+func (x *UpdateMinerRequest) Reset() {
+	*x = UpdateMinerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_v1_gateway_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMinerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMinerRequest) ProtoMessage() {}
+
+func (x *UpdateMinerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMinerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMinerRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateMinerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateMinerRequest) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateMinerRequest) GetDeletePolicy() string {
+	if x != nil && x.DeletePolicy != nil {
+		return *x.DeletePolicy
+	}
+	return ""
+}
+
+type DeleteMinerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteMinerRequest) Reset() {
+	*x = DeleteMinerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_v1_gateway_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteMinerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMinerRequest) ProtoMessage() {}
+
+func (x *DeleteMinerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMinerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMinerRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteMinerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type WatchMinerSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// resourceVersion resumes the watch after the event with this resource version. The current
+	// minersets are sent as ADDED events first if it is empty.
+	ResourceVersion string `protobuf:"bytes,1,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+}
+
+func (x *WatchMinerSetRequest) Reset() {
+	*x = WatchMinerSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_v1_gateway_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchMinerSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchMinerSetRequest) ProtoMessage() {}
+
+func (x *WatchMinerSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchMinerSetRequest.ProtoReflect.Descriptor instead.
+func (*WatchMinerSetRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *WatchMinerSetRequest) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+type MinerSetEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type is one of `ADDED`, `MODIFIED` and `DELETED`.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// object is the minerset after the event, or its last state for the `DELETED` event.
+	// Its resourceVersion is the one to resume the watch from.
+	Object *v1beta1.MinerSet `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+}
+
+func (x *MinerSetEvent) Reset() {
+	*x = MinerSetEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_v1_gateway_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MinerSetEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinerSetEvent) ProtoMessage() {}
+
+func (x *MinerSetEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinerSetEvent.ProtoReflect.Descriptor instead.
+func (*MinerSetEvent) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MinerSetEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MinerSetEvent) GetObject() *v1beta1.MinerSet {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+type WatchMinerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// resourceVersion resumes the watch after the event with this resource version. The current
+	// miners are sent as ADDED events first if it is empty.
+	ResourceVersion string `protobuf:"bytes,1,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+}
+
+func (x *WatchMinerRequest) Reset() {
+	*x = WatchMinerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_v1_gateway_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchMinerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchMinerRequest) ProtoMessage() {}
+
+func (x *WatchMinerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchMinerRequest.ProtoReflect.Descriptor instead.
+func (*WatchMinerRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *WatchMinerRequest) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+type MinerEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type is one of `ADDED`, `MODIFIED` and `DELETED`.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// object is the miner after the event, or its last state for the `DELETED` event.
+	// Its resourceVersion is the one to resume the watch from.
+	Object *v1beta1.Miner `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+}
+
+func (x *MinerEvent) Reset() {
+	*x = MinerEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_v1_gateway_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MinerEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinerEvent) ProtoMessage() {}
+
+func (x *MinerEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinerEvent.ProtoReflect.Descriptor instead.
+func (*MinerEvent) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MinerEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MinerEvent) GetObject() *v1beta1.Miner {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+type ListChargeRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// continue is the token returned by the previous page.
+	Continue string `protobuf:"bytes,2,opt,name=continue,proto3" json:"continue,omitempty"`
 }
 
-func (x *Miner) Reset() {
-	*x = Miner{}
+func (x *ListChargeRequestRequest) Reset() {
+	*x = ListChargeRequestRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_v1_gateway_proto_msgTypes[11]
+		mi := &file_gateway_v1_gateway_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Miner) String() string {
+func (x *ListChargeRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Miner) ProtoMessage() {}
+func (*ListChargeRequestRequest) ProtoMessage() {}
 
-func (x *Miner) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_v1_gateway_proto_msgTypes[11]
+func (x *ListChargeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -765,95 +1810,106 @@ func (x *Miner) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Miner.ProtoReflect.Descriptor instead.
-func (*Miner) Descriptor() ([]byte, []int) {
-	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListChargeRequestRequest.ProtoReflect.Descriptor instead.
+func (*ListChargeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *Miner) GetName() string {
+func (x *ListChargeRequestRequest) GetLimit() int64 {
 	if x != nil {
-		return x.Name
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *Miner) GetDisplayName() string {
+func (x *ListChargeRequestRequest) GetContinue() string {
 	if x != nil {
-		return x.DisplayName
+		return x.Continue
 	}
 	return ""
 }
 
-func (x *Miner) GetMinerType() string {
-	if x != nil {
-		return x.MinerType
-	}
-	return ""
+type ListChargeRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChargeRequests []*v1beta1.ChargeRequest `protobuf:"bytes,1,rep,name=chargeRequests,proto3" json:"chargeRequests,omitempty"`
+	// continue is the token of the next page, which is empty on the last page.
+	Continue string `protobuf:"bytes,2,opt,name=continue,proto3" json:"continue,omitempty"`
 }
 
-func (x *Miner) GetChainName() string {
-	if x != nil {
-		return x.ChainName
+func (x *ListChargeRequestResponse) Reset() {
+	*x = ListChargeRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_v1_gateway_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Miner) GetDataDir() string {
-	if x != nil {
-		return x.DataDir
-	}
-	return ""
+func (x *ListChargeRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Miner) GetStatus() string {
-	if x != nil {
-		return x.Status
+func (*ListChargeRequestResponse) ProtoMessage() {}
+
+func (x *ListChargeRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Miner) GetCreatedAt() *timestamppb.Timestamp {
+// Deprecated: Use ListChargeRequestResponse.ProtoReflect.Descriptor instead.
+func (*ListChargeRequestResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListChargeRequestResponse) GetChargeRequests() []*v1beta1.ChargeRequest {
 	if x != nil {
-		return x.CreatedAt
+		return x.ChargeRequests
 	}
 	return nil
 }
 
-func (x *Miner) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ListChargeRequestResponse) GetContinue() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Continue
 	}
-	return nil
+	return ""
 }
 
-type CreateMinerRequest struct {
+type GetChargeRequestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DisplayName string `protobuf:"bytes,1,opt,name=displayName,proto3" json:"displayName,omitempty"`
-	MinerType   string `protobuf:"bytes,2,opt,name=minerType,proto3" json:"minerType,omitempty"`
-	ChainName   string `protobuf:"bytes,3,opt,name=chainName,proto3" json:"chainName,omitempty"`
-	DataDir     string `protobuf:"bytes,4,opt,name=dataDir,proto3" json:"dataDir,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *CreateMinerRequest) Reset() {
-	*x = CreateMinerRequest{}
+func (x *GetChargeRequestRequest) Reset() {
+	*x = GetChargeRequestRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_v1_gateway_proto_msgTypes[12]
+		mi := &file_gateway_v1_gateway_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateMinerRequest) String() string {
+func (x *GetChargeRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMinerRequest) ProtoMessage() {}
+func (*GetChargeRequestRequest) ProtoMessage() {}
 
-func (x *CreateMinerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_v1_gateway_proto_msgTypes[12]
+func (x *GetChargeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -864,65 +1920,82 @@ func (x *CreateMinerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMinerRequest.ProtoReflect.Descriptor instead.
-func (*CreateMinerRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetChargeRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetChargeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *CreateMinerRequest) GetDisplayName() string {
+func (x *GetChargeRequestRequest) GetName() string {
 	if x != nil {
-		return x.DisplayName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateMinerRequest) GetMinerType() string {
-	if x != nil {
-		return x.MinerType
-	}
-	return ""
+type ListMinerClassRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *CreateMinerRequest) GetChainName() string {
-	if x != nil {
-		return x.ChainName
+func (x *ListMinerClassRequest) Reset() {
+	*x = ListMinerClassRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_v1_gateway_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *CreateMinerRequest) GetDataDir() string {
-	if x != nil {
-		return x.DataDir
+func (x *ListMinerClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMinerClassRequest) ProtoMessage() {}
+
+func (x *ListMinerClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type ListMinerRequest struct {
+// Deprecated: Use ListMinerClassRequest.ProtoReflect.Descriptor instead.
+func (*ListMinerClassRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{30}
+}
+
+type ListMinerClassResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limit  int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	TotalCount   int64                 `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	MinerClasses []*v1beta1.MinerClass `protobuf:"bytes,2,rep,name=minerClasses,proto3" json:"minerClasses,omitempty"`
 }
 
-func (x *ListMinerRequest) Reset() {
-	*x = ListMinerRequest{}
+func (x *ListMinerClassResponse) Reset() {
+	*x = ListMinerClassResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_v1_gateway_proto_msgTypes[13]
+		mi := &file_gateway_v1_gateway_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListMinerRequest) String() string {
+func (x *ListMinerClassResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMinerRequest) ProtoMessage() {}
+func (*ListMinerClassResponse) ProtoMessage() {}
 
-func (x *ListMinerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_v1_gateway_proto_msgTypes[13]
+func (x *ListMinerClassResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -933,51 +2006,67 @@ func (x *ListMinerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMinerRequest.ProtoReflect.Descriptor instead.
-func (*ListMinerRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ListMinerClassResponse.ProtoReflect.Descriptor instead.
+func (*ListMinerClassResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ListMinerRequest) GetLimit() int64 {
+func (x *ListMinerClassResponse) GetTotalCount() int64 {
 	if x != nil {
-		return x.Limit
+		return x.TotalCount
 	}
 	return 0
 }
 
-func (x *ListMinerRequest) GetOffset() int64 {
+func (x *ListMinerClassResponse) GetMinerClasses() []*v1beta1.MinerClass {
 	if x != nil {
-		return x.Offset
+		return x.MinerClasses
 	}
-	return 0
+	return nil
 }
 
-type ListMinerResponse struct {
+type AuditEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalCount int64    `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
-	Miners     []*Miner `protobuf:"bytes,2,rep,name=Miners,proto3" json:"Miners,omitempty"`
-}
-
-func (x *ListMinerResponse) Reset() {
-	*x = ListMinerResponse{}
+	EventID string                 `protobuf:"bytes,1,opt,name=eventID,proto3" json:"eventID,omitempty"`
+	Time    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	UserID  string                 `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	// secretID is the secret signing the request, if the request is signed.
+	SecretID  string `protobuf:"bytes,4,opt,name=secretID,proto3" json:"secretID,omitempty"`
+	Operation string `protobuf:"bytes,5,opt,name=operation,proto3" json:"operation,omitempty"`
+	// resource is the kind of the resource, e.g. `MinerSet`, and name is its name.
+	Resource string `protobuf:"bytes,6,opt,name=resource,proto3" json:"resource,omitempty"`
+	Name     string `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	// request is the request in json, whose sensitive fields, e.g. the passwords, are redacted.
+	Request string `protobuf:"bytes,8,opt,name=request,proto3" json:"request,omitempty"`
+	// diff is the json merge patch from the resource before the operation to the resource after it.
+	Diff string `protobuf:"bytes,9,opt,name=diff,proto3" json:"diff,omitempty"`
+	// code is the http status code of the result, reason and message describe the error if it failed.
+	Code    int32  `protobuf:"varint,10,opt,name=code,proto3" json:"code,omitempty"`
+	Reason  string `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message string `protobuf:"bytes,12,opt,name=message,proto3" json:"message,omitempty"`
+	TraceID string `protobuf:"bytes,13,opt,name=traceID,proto3" json:"traceID,omitempty"`
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_v1_gateway_proto_msgTypes[14]
+		mi := &file_gateway_v1_gateway_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListMinerResponse) String() string {
+func (x *AuditEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMinerResponse) ProtoMessage() {}
+func (*AuditEvent) ProtoMessage() {}
 
-func (x *ListMinerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_v1_gateway_proto_msgTypes[14]
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -988,99 +2077,135 @@ func (x *ListMinerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMinerResponse.ProtoReflect.Descriptor instead.
-func (*ListMinerResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ListMinerResponse) GetTotalCount() int64 {
+func (x *AuditEvent) GetEventID() string {
 	if x != nil {
-		return x.TotalCount
+		return x.EventID
 	}
-	return 0
+	return ""
 }
 
-func (x *ListMinerResponse) GetMiners() []*Miner {
+func (x *AuditEvent) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Miners
+		return x.Time
 	}
 	return nil
 }
 
-type GetMinerRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AuditEvent) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+func (x *AuditEvent) GetSecretID() string {
+	if x != nil {
+		return x.SecretID
+	}
+	return ""
 }
 
-func (x *GetMinerRequest) Reset() {
-	*x = GetMinerRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_v1_gateway_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AuditEvent) GetOperation() string {
+	if x != nil {
+		return x.Operation
 	}
+	return ""
 }
 
-func (x *GetMinerRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AuditEvent) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
 }
 
-func (*GetMinerRequest) ProtoMessage() {}
+func (x *AuditEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
 
-func (x *GetMinerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_v1_gateway_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AuditEvent) GetRequest() string {
+	if x != nil {
+		return x.Request
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetMinerRequest.ProtoReflect.Descriptor instead.
-func (*GetMinerRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{15}
+func (x *AuditEvent) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
 }
 
-func (x *GetMinerRequest) GetName() string {
+func (x *AuditEvent) GetCode() int32 {
 	if x != nil {
-		return x.Name
+		return x.Code
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-type UpdateMinerRequest struct {
+func (x *AuditEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTraceID() string {
+	if x != nil {
+		return x.TraceID
+	}
+	return ""
+}
+
+type ListAuditEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	DisplayName  *string `protobuf:"bytes,2,opt,name=displayName,proto3,oneof" json:"displayName,omitempty"`
-	DeletePolicy *string `protobuf:"bytes,3,opt,name=deletePolicy,proto3,oneof" json:"deletePolicy,omitempty"`
+	Limit  int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// operation, resource and name select the events by their fields if they are set.
+	Operation string `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	Resource  string `protobuf:"bytes,4,opt,name=resource,proto3" json:"resource,omitempty"`
+	Name      string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// since and until select the events in the time range [since, until) if they are set.
+	Since *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=since,proto3" json:"since,omitempty"`
+	Until *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=until,proto3" json:"until,omitempty"`
 }
 
-func (x *UpdateMinerRequest) Reset() {
-	*x = UpdateMinerRequest{}
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_v1_gateway_proto_msgTypes[16]
+		mi := &file_gateway_v1_gateway_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateMinerRequest) String() string {
+func (x *ListAuditEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMinerRequest) ProtoMessage() {}
+func (*ListAuditEventsRequest) ProtoMessage() {}
 
-func (x *UpdateMinerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_v1_gateway_proto_msgTypes[16]
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1091,57 +2216,86 @@ func (x *UpdateMinerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMinerRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMinerRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListAuditEventsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
 }
 
-func (x *UpdateMinerRequest) GetName() string {
+func (x *ListAuditEventsRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateMinerRequest) GetDisplayName() string {
-	if x != nil && x.DisplayName != nil {
-		return *x.DisplayName
+func (x *ListAuditEventsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateMinerRequest) GetDeletePolicy() string {
-	if x != nil && x.DeletePolicy != nil {
-		return *x.DeletePolicy
+func (x *ListAuditEventsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
 	}
-	return ""
+	return nil
 }
 
-type DeleteMinerRequest struct {
+type ListAuditEventsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TotalCount int64         `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	Events     []*AuditEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (x *DeleteMinerRequest) Reset() {
-	*x = DeleteMinerRequest{}
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_v1_gateway_proto_msgTypes[17]
+		mi := &file_gateway_v1_gateway_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteMinerRequest) String() string {
+func (x *ListAuditEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMinerRequest) ProtoMessage() {}
+func (*ListAuditEventsResponse) ProtoMessage() {}
 
-func (x *DeleteMinerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_v1_gateway_proto_msgTypes[17]
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_v1_gateway_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1152,16 +2306,23 @@ func (x *DeleteMinerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMinerRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMinerRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_v1_gateway_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *DeleteMinerRequest) GetName() string {
+func (x *ListAuditEventsResponse) GetTotalCount() int64 {
 	if x != nil {
-		return x.Name
+		return x.TotalCount
 	}
-	return ""
+	return 0
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
 }
 
 var File_gateway_v1_gateway_proto protoreflect.FileDescriptor
@@ -1194,128 +2355,278 @@ var file_gateway_v1_gateway_proto_rawDesc = []byte{
 	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x18, 0x06, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x1a, 0x0a,
 	0x08, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0xb5, 0x02, 0x0a, 0x08, 0x4d, 0x69,
-	0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3f, 0x0a, 0x0d,
-	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x0d,
-	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x22, 0x65, 0x0a, 0x0d, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x08, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0x9d, 0x02, 0x0a, 0x05, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x6e,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69,
+	0x6e, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a,
+	0x16, 0x6d, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x6d,
+	0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x40, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x5e, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x29, 0x0a, 0x06, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x52, 0x06, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x25, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb5, 0x02, 0x0a,
+	0x08, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x3f, 0x0a, 0x0d, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x0d, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x65, 0x0a, 0x0d, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x22, 0xba, 0x01, 0x0a, 0x15,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3f, 0x0a, 0x0d, 0x4d, 0x69, 0x6e, 0x65, 0x72,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65,
+	0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x4d, 0x69, 0x6e, 0x65, 0x72,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x24,
+	0x0a, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x42, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65,
+	0x22, 0x86, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x4d, 0x69, 0x6e,
+	0x65, 0x72, 0x53, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53,
+	0x65, 0x74, 0x52, 0x09, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x69,
+	0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01,
+	0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x22, 0x2b, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65,
+	0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x46, 0x0a, 0x14, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x22, 0x9f, 0x02, 0x0a, 0x05, 0x4d, 0x69, 0x6e, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x22, 0xba, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x12, 0x3f, 0x0a, 0x0d, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x43, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e,
-	0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x6a, 0x0a, 0x14, 0x4c, 0x69,
-	0x73, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x22, 0xc2, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x22, 0x7a, 0x0a,
+	0x11, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x09, 0x4d, 0x69, 0x6e,
-	0x65, 0x72, 0x53, 0x65, 0x74, 0x73, 0x22, 0x28, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e,
-	0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x22, 0x25, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x9c, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72,
-	0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25,
-	0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61,
-	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0e,
-	0x0a, 0x0c, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0f,
-	0x0a, 0x0d, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22,
-	0x2b, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x46, 0x0a, 0x14,
-	0x53, 0x63, 0x61, 0x6c, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x73, 0x22, 0x9f, 0x02, 0x0a, 0x05, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64,
-	0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x61,
-	0x74, 0x61, 0x44, 0x69, 0x72, 0x22, 0x40, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x22, 0x99, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0b, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x28, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x40, 0x0a, 0x14, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d,
+	0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28,
+	0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x76, 0x0a, 0x0d, 0x4d, 0x69, 0x6e, 0x65,
+	0x72, 0x53, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x51, 0x0a,
+	0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72,
+	0x70, 0x72, 0x6f, 0x6a, 0x2e, 0x6f, 0x6e, 0x65, 0x78, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x22, 0x3d, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x70, 0x0a, 0x0a, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x4e, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x36, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x6a, 0x2e, 0x6f, 0x6e, 0x65, 0x78, 0x2e, 0x70, 0x6b,
+	0x67, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x22, 0x4c, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x22,
+	0x9f, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a,
+	0x0e, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x6a, 0x2e, 0x6f, 0x6e, 0x65,
+	0x78, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75,
+	0x65, 0x22, 0x2d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x17, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5f, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x70, 0x72, 0x6f,
+	0x6a, 0x2e, 0x6f, 0x6e, 0x65, 0x78, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x61, 0x70, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x69, 0x6e,
+	0x65, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x65, 0x73, 0x22, 0xe6, 0x02, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x2e,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69,
+	0x66, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x44, 0x22, 0xf8,
+	0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
 	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
 	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x5e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4d,
-	0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x06,
-	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52,
-	0x06, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x25, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x69,
-	0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x99,
-	0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x27, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x69,
-	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x28, 0x0a, 0x12, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x32, 0xbe, 0x0b, 0x0a, 0x07, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x69, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x32, 0xea, 0x15, 0x0a, 0x07, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
 	0x12, 0x56, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
@@ -1328,89 +2639,172 @@ var file_gateway_v1_gateway_proto_rawDesc = []byte{
 	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f,
 	0x2f, 0x76, 0x31, 0x2f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x7d, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65,
-	0x74, 0x12, 0x39, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x73,
-	0x75, 0x70, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x6a, 0x2e, 0x6f, 0x6e, 0x65, 0x78, 0x2e, 0x70, 0x6b,
-	0x67, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22,
-	0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x65, 0x74, 0x73, 0x12, 0x68,
-	0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x1f,
-	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x20, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
-	0x69, 0x6e, 0x65, 0x72, 0x73, 0x65, 0x74, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x6a, 0x2e,
-	0x6f, 0x6e, 0x65, 0x78, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x61, 0x70,
-	0x70, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72,
-	0x53, 0x65, 0x74, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31,
-	0x2f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
-	0x7d, 0x12, 0x7d, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72,
-	0x53, 0x65, 0x74, 0x12, 0x39, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x6a, 0x2e, 0x6f, 0x6e, 0x65, 0x78, 0x2e,
-	0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01,
-	0x2a, 0x1a, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x65, 0x74, 0x73,
-	0x12, 0x69, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53,
-	0x65, 0x74, 0x12, 0x21, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x74, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x36,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x70, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x6a, 0x2e, 0x6f, 0x6e, 0x65, 0x78, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x15,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x5c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x12, 0x1c, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x73, 0x12, 0x55, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12,
+	0x1b, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x22,
+	0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x74, 0x0a, 0x0b, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x36, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x6a,
+	0x2e, 0x6f, 0x6e, 0x65, 0x78, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x61,
+	0x70, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0f, 0x3a, 0x01, 0x2a, 0x1a, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73,
+	0x12, 0x60, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12,
+	0x1e, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x2a,
+	0x11, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x12, 0x7d, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65,
+	0x72, 0x53, 0x65, 0x74, 0x12, 0x39, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x6a, 0x2e, 0x6f, 0x6e, 0x65, 0x78,
+	0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a,
+	0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x65, 0x74,
+	0x73, 0x12, 0x68, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65,
+	0x74, 0x12, 0x1f, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76,
+	0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x65, 0x74, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65,
+	0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x70, 0x72,
+	0x6f, 0x6a, 0x2e, 0x6f, 0x6e, 0x65, 0x78, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x69,
+	0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14,
+	0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x12, 0x7d, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x69,
+	0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x39, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x6a, 0x2e, 0x6f, 0x6e,
+	0x65, 0x78, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x73,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x12, 0x3a, 0x01, 0x2a, 0x1a, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73,
+	0x65, 0x74, 0x73, 0x12, 0x69, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69, 0x6e,
+	0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x21, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x2a, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69,
+	0x6e, 0x65, 0x72, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x70,
+	0x0a, 0x0d, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12,
+	0x20, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x61,
+	0x6c, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1f, 0x3a, 0x01, 0x2a, 0x1a, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73,
+	0x65, 0x74, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x12, 0x4e, 0x0a, 0x0d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65,
+	0x74, 0x12, 0x20, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01,
+	0x12, 0x74, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12,
+	0x36, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x70,
+	0x65, 0x72, 0x70, 0x72, 0x6f, 0x6a, 0x2e, 0x6f, 0x6e, 0x65, 0x78, 0x2e, 0x70, 0x6b, 0x67, 0x2e,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f,
+	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x5c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69,
+	0x6e, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69,
+	0x6e, 0x65, 0x72, 0x73, 0x12, 0x7a, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72,
+	0x12, 0x1b, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72,
+	0x70, 0x72, 0x6f, 0x6a, 0x2e, 0x6f, 0x6e, 0x65, 0x78, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f,
+	0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x12, 0x74, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12,
+	0x36, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x70,
+	0x65, 0x72, 0x70, 0x72, 0x6f, 0x6a, 0x2e, 0x6f, 0x6e, 0x65, 0x78, 0x2e, 0x70, 0x6b, 0x67, 0x2e,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x1a, 0x0a, 0x2f, 0x76, 0x31, 0x2f,
+	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x60, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1c, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x16, 0x2a, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x65, 0x72,
-	0x73, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x70, 0x0a, 0x0d, 0x53,
-	0x63, 0x61, 0x6c, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x20, 0x2e, 0x67,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x4d,
-	0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01,
-	0x2a, 0x1a, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x65, 0x74, 0x73,
-	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x74, 0x0a,
-	0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x36, 0x2e, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x70,
-	0x72, 0x6f, 0x6a, 0x2e, 0x6f, 0x6e, 0x65, 0x78, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69,
-	0x73, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d,
-	0x69, 0x6e, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x15, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e,
-	0x65, 0x72, 0x73, 0x12, 0x5c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72,
-	0x12, 0x1c, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x19, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x13, 0x2a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x65, 0x72,
+	0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x45, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12,
+	0x8c, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x6a, 0x2e, 0x6f,
+	0x6e, 0x65, 0x78, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x70,
+	0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x7c,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x9a, 0x01, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x6a, 0x2e, 0x6f, 0x6e,
+	0x65, 0x78, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x73,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x71, 0x0a, 0x0e, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x21, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x6e,
+	0x65, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
 	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x65, 0x72,
-	0x73, 0x12, 0x7a, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x2e,
-	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69,
-	0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x70, 0x72, 0x6f,
-	0x6a, 0x2e, 0x6f, 0x6e, 0x65, 0x78, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e,
-	0x61, 0x70, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x69, 0x6e,
-	0x65, 0x72, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f,
-	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x74, 0x0a,
-	0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x36, 0x2e, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x70,
-	0x72, 0x6f, 0x6a, 0x2e, 0x6f, 0x6e, 0x65, 0x78, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x61, 0x70, 0x69,
-	0x73, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d,
-	0x69, 0x6e, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x15, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x1a, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e,
-	0x65, 0x72, 0x73, 0x12, 0x60, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69, 0x6e,
-	0x65, 0x72, 0x12, 0x1e, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x13, 0x2a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x2f, 0x7b,
-	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x75, 0x70, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x6a, 0x2f, 0x6f, 0x6e,
-	0x65, 0x78, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f,
+	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x73, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x22, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11,
+	0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x73, 0x75, 0x70, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x6a, 0x2f, 0x6f, 0x6e, 0x65, 0x78, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x76,
+	0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1425,71 +2819,126 @@ func file_gateway_v1_gateway_proto_rawDescGZIP() []byte {
 	return file_gateway_v1_gateway_proto_rawDescData
 }
 
-var file_gateway_v1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_gateway_v1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_gateway_v1_gateway_proto_goTypes = []interface{}{
-	(*IdempotentResponse)(nil),    // 0: gateway.v1.IdempotentResponse
-	(*GetVersionResponse)(nil),    // 1: gateway.v1.GetVersionResponse
-	(*MinerSet)(nil),              // 2: gateway.v1.MinerSet
-	(*MinerTemplate)(nil),         // 3: gateway.v1.MinerTemplate
-	(*CreateMinerSetRequest)(nil), // 4: gateway.v1.CreateMinerSetRequest
-	(*ListMinerSetRequest)(nil),   // 5: gateway.v1.ListMinerSetRequest
-	(*ListMinerSetResponse)(nil),  // 6: gateway.v1.ListMinerSetResponse
-	(*GetMinerSetRequest)(nil),    // 7: gateway.v1.GetMinerSetRequest
-	(*UpdateMinerSetRequest)(nil), // 8: gateway.v1.UpdateMinerSetRequest
-	(*DeleteMinerSetRequest)(nil), // 9: gateway.v1.DeleteMinerSetRequest
-	(*ScaleMinerSetRequest)(nil),  // 10: gateway.v1.ScaleMinerSetRequest
-	(*Miner)(nil),                 // 11: gateway.v1.Miner
-	(*CreateMinerRequest)(nil),    // 12: gateway.v1.CreateMinerRequest
-	(*ListMinerRequest)(nil),      // 13: gateway.v1.ListMinerRequest
-	(*ListMinerResponse)(nil),     // 14: gateway.v1.ListMinerResponse
-	(*GetMinerRequest)(nil),       // 15: gateway.v1.GetMinerRequest
-	(*UpdateMinerRequest)(nil),    // 16: gateway.v1.UpdateMinerRequest
-	(*DeleteMinerRequest)(nil),    // 17: gateway.v1.DeleteMinerRequest
-	(*timestamppb.Timestamp)(nil), // 18: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 19: google.protobuf.Empty
-	(*v1beta1.MinerSet)(nil),      // 20: github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSet
-	(*v1beta1.Miner)(nil),         // 21: github.com.superproj.onex.pkg.apis.apps.v1beta1.Miner
+	(*IdempotentResponse)(nil),        // 0: gateway.v1.IdempotentResponse
+	(*GetVersionResponse)(nil),        // 1: gateway.v1.GetVersionResponse
+	(*Chain)(nil),                     // 2: gateway.v1.Chain
+	(*ListChainRequest)(nil),          // 3: gateway.v1.ListChainRequest
+	(*ListChainResponse)(nil),         // 4: gateway.v1.ListChainResponse
+	(*GetChainRequest)(nil),           // 5: gateway.v1.GetChainRequest
+	(*DeleteChainRequest)(nil),        // 6: gateway.v1.DeleteChainRequest
+	(*MinerSet)(nil),                  // 7: gateway.v1.MinerSet
+	(*MinerTemplate)(nil),             // 8: gateway.v1.MinerTemplate
+	(*CreateMinerSetRequest)(nil),     // 9: gateway.v1.CreateMinerSetRequest
+	(*ListMinerSetRequest)(nil),       // 10: gateway.v1.ListMinerSetRequest
+	(*ListMinerSetResponse)(nil),      // 11: gateway.v1.ListMinerSetResponse
+	(*GetMinerSetRequest)(nil),        // 12: gateway.v1.GetMinerSetRequest
+	(*UpdateMinerSetRequest)(nil),     // 13: gateway.v1.UpdateMinerSetRequest
+	(*DeleteMinerSetRequest)(nil),     // 14: gateway.v1.DeleteMinerSetRequest
+	(*ScaleMinerSetRequest)(nil),      // 15: gateway.v1.ScaleMinerSetRequest
+	(*Miner)(nil),                     // 16: gateway.v1.Miner
+	(*CreateMinerRequest)(nil),        // 17: gateway.v1.CreateMinerRequest
+	(*ListMinerRequest)(nil),          // 18: gateway.v1.ListMinerRequest
+	(*ListMinerResponse)(nil),         // 19: gateway.v1.ListMinerResponse
+	(*GetMinerRequest)(nil),           // 20: gateway.v1.GetMinerRequest
+	(*UpdateMinerRequest)(nil),        // 21: gateway.v1.UpdateMinerRequest
+	(*DeleteMinerRequest)(nil),        // 22: gateway.v1.DeleteMinerRequest
+	(*WatchMinerSetRequest)(nil),      // 23: gateway.v1.WatchMinerSetRequest
+	(*MinerSetEvent)(nil),             // 24: gateway.v1.MinerSetEvent
+	(*WatchMinerRequest)(nil),         // 25: gateway.v1.WatchMinerRequest
+	(*MinerEvent)(nil),                // 26: gateway.v1.MinerEvent
+	(*ListChargeRequestRequest)(nil),  // 27: gateway.v1.ListChargeRequestRequest
+	(*ListChargeRequestResponse)(nil), // 28: gateway.v1.ListChargeRequestResponse
+	(*GetChargeRequestRequest)(nil),   // 29: gateway.v1.GetChargeRequestRequest
+	(*ListMinerClassRequest)(nil),     // 30: gateway.v1.ListMinerClassRequest
+	(*ListMinerClassResponse)(nil),    // 31: gateway.v1.ListMinerClassResponse
+	(*AuditEvent)(nil),                // 32: gateway.v1.AuditEvent
+	(*ListAuditEventsRequest)(nil),    // 33: gateway.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),   // 34: gateway.v1.ListAuditEventsResponse
+	(*timestamppb.Timestamp)(nil),     // 35: google.protobuf.Timestamp
+	(*v1beta1.MinerSet)(nil),          // 36: github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSet
+	(*v1beta1.Miner)(nil),             // 37: github.com.superproj.onex.pkg.apis.apps.v1beta1.Miner
+	(*v1beta1.ChargeRequest)(nil),     // 38: github.com.superproj.onex.pkg.apis.apps.v1beta1.ChargeRequest
+	(*v1beta1.MinerClass)(nil),        // 39: github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerClass
+	(*emptypb.Empty)(nil),             // 40: google.protobuf.Empty
+	(*v1beta1.Chain)(nil),             // 41: github.com.superproj.onex.pkg.apis.apps.v1beta1.Chain
 }
 var file_gateway_v1_gateway_proto_depIdxs = []int32{
-	3,  // 0: gateway.v1.MinerSet.MinerTemplate:type_name -> gateway.v1.MinerTemplate
-	18, // 1: gateway.v1.MinerSet.createdAt:type_name -> google.protobuf.Timestamp
-	18, // 2: gateway.v1.MinerSet.updatedAt:type_name -> google.protobuf.Timestamp
-	3,  // 3: gateway.v1.CreateMinerSetRequest.MinerTemplate:type_name -> gateway.v1.MinerTemplate
-	2,  // 4: gateway.v1.ListMinerSetResponse.MinerSets:type_name -> gateway.v1.MinerSet
-	18, // 5: gateway.v1.Miner.createdAt:type_name -> google.protobuf.Timestamp
-	18, // 6: gateway.v1.Miner.updatedAt:type_name -> google.protobuf.Timestamp
-	11, // 7: gateway.v1.ListMinerResponse.Miners:type_name -> gateway.v1.Miner
-	19, // 8: gateway.v1.Gateway.GetVersion:input_type -> google.protobuf.Empty
-	19, // 9: gateway.v1.Gateway.GetIdempotentToken:input_type -> google.protobuf.Empty
-	20, // 10: gateway.v1.Gateway.CreateMinerSet:input_type -> github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSet
-	5,  // 11: gateway.v1.Gateway.ListMinerSet:input_type -> gateway.v1.ListMinerSetRequest
-	7,  // 12: gateway.v1.Gateway.GetMinerSet:input_type -> gateway.v1.GetMinerSetRequest
-	20, // 13: gateway.v1.Gateway.UpdateMinerSet:input_type -> github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSet
-	9,  // 14: gateway.v1.Gateway.DeleteMinerSet:input_type -> gateway.v1.DeleteMinerSetRequest
-	10, // 15: gateway.v1.Gateway.ScaleMinerSet:input_type -> gateway.v1.ScaleMinerSetRequest
-	21, // 16: gateway.v1.Gateway.CreateMiner:input_type -> github.com.superproj.onex.pkg.apis.apps.v1beta1.Miner
-	13, // 17: gateway.v1.Gateway.ListMiner:input_type -> gateway.v1.ListMinerRequest
-	15, // 18: gateway.v1.Gateway.GetMiner:input_type -> gateway.v1.GetMinerRequest
-	21, // 19: gateway.v1.Gateway.UpdateMiner:input_type -> github.com.superproj.onex.pkg.apis.apps.v1beta1.Miner
-	17, // 20: gateway.v1.Gateway.DeleteMiner:input_type -> gateway.v1.DeleteMinerRequest
-	1,  // 21: gateway.v1.Gateway.GetVersion:output_type -> gateway.v1.GetVersionResponse
-	0,  // 22: gateway.v1.Gateway.GetIdempotentToken:output_type -> gateway.v1.IdempotentResponse
-	19, // 23: gateway.v1.Gateway.CreateMinerSet:output_type -> google.protobuf.Empty
-	6,  // 24: gateway.v1.Gateway.ListMinerSet:output_type -> gateway.v1.ListMinerSetResponse
-	20, // 25: gateway.v1.Gateway.GetMinerSet:output_type -> github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSet
-	19, // 26: gateway.v1.Gateway.UpdateMinerSet:output_type -> google.protobuf.Empty
-	19, // 27: gateway.v1.Gateway.DeleteMinerSet:output_type -> google.protobuf.Empty
-	19, // 28: gateway.v1.Gateway.ScaleMinerSet:output_type -> google.protobuf.Empty
-	19, // 29: gateway.v1.Gateway.CreateMiner:output_type -> google.protobuf.Empty
-	14, // 30: gateway.v1.Gateway.ListMiner:output_type -> gateway.v1.ListMinerResponse
-	21, // 31: gateway.v1.Gateway.GetMiner:output_type -> github.com.superproj.onex.pkg.apis.apps.v1beta1.Miner
-	19, // 32: gateway.v1.Gateway.UpdateMiner:output_type -> google.protobuf.Empty
-	19, // 33: gateway.v1.Gateway.DeleteMiner:output_type -> google.protobuf.Empty
-	21, // [21:34] is the sub-list for method output_type
-	8,  // [8:21] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	35, // 0: gateway.v1.Chain.createdAt:type_name -> google.protobuf.Timestamp
+	35, // 1: gateway.v1.Chain.updatedAt:type_name -> google.protobuf.Timestamp
+	2,  // 2: gateway.v1.ListChainResponse.Chains:type_name -> gateway.v1.Chain
+	8,  // 3: gateway.v1.MinerSet.MinerTemplate:type_name -> gateway.v1.MinerTemplate
+	35, // 4: gateway.v1.MinerSet.createdAt:type_name -> google.protobuf.Timestamp
+	35, // 5: gateway.v1.MinerSet.updatedAt:type_name -> google.protobuf.Timestamp
+	8,  // 6: gateway.v1.CreateMinerSetRequest.MinerTemplate:type_name -> gateway.v1.MinerTemplate
+	7,  // 7: gateway.v1.ListMinerSetResponse.MinerSets:type_name -> gateway.v1.MinerSet
+	35, // 8: gateway.v1.Miner.createdAt:type_name -> google.protobuf.Timestamp
+	35, // 9: gateway.v1.Miner.updatedAt:type_name -> google.protobuf.Timestamp
+	16, // 10: gateway.v1.ListMinerResponse.Miners:type_name -> gateway.v1.Miner
+	36, // 11: gateway.v1.MinerSetEvent.object:type_name -> github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSet
+	37, // 12: gateway.v1.MinerEvent.object:type_name -> github.com.superproj.onex.pkg.apis.apps.v1beta1.Miner
+	38, // 13: gateway.v1.ListChargeRequestResponse.chargeRequests:type_name -> github.com.superproj.onex.pkg.apis.apps.v1beta1.ChargeRequest
+	39, // 14: gateway.v1.ListMinerClassResponse.minerClasses:type_name -> github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerClass
+	35, // 15: gateway.v1.AuditEvent.time:type_name -> google.protobuf.Timestamp
+	35, // 16: gateway.v1.ListAuditEventsRequest.since:type_name -> google.protobuf.Timestamp
+	35, // 17: gateway.v1.ListAuditEventsRequest.until:type_name -> google.protobuf.Timestamp
+	32, // 18: gateway.v1.ListAuditEventsResponse.events:type_name -> gateway.v1.AuditEvent
+	40, // 19: gateway.v1.Gateway.GetVersion:input_type -> google.protobuf.Empty
+	40, // 20: gateway.v1.Gateway.GetIdempotentToken:input_type -> google.protobuf.Empty
+	41, // 21: gateway.v1.Gateway.CreateChain:input_type -> github.com.superproj.onex.pkg.apis.apps.v1beta1.Chain
+	3,  // 22: gateway.v1.Gateway.ListChain:input_type -> gateway.v1.ListChainRequest
+	5,  // 23: gateway.v1.Gateway.GetChain:input_type -> gateway.v1.GetChainRequest
+	41, // 24: gateway.v1.Gateway.UpdateChain:input_type -> github.com.superproj.onex.pkg.apis.apps.v1beta1.Chain
+	6,  // 25: gateway.v1.Gateway.DeleteChain:input_type -> gateway.v1.DeleteChainRequest
+	36, // 26: gateway.v1.Gateway.CreateMinerSet:input_type -> github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSet
+	10, // 27: gateway.v1.Gateway.ListMinerSet:input_type -> gateway.v1.ListMinerSetRequest
+	12, // 28: gateway.v1.Gateway.GetMinerSet:input_type -> gateway.v1.GetMinerSetRequest
+	36, // 29: gateway.v1.Gateway.UpdateMinerSet:input_type -> github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSet
+	14, // 30: gateway.v1.Gateway.DeleteMinerSet:input_type -> gateway.v1.DeleteMinerSetRequest
+	15, // 31: gateway.v1.Gateway.ScaleMinerSet:input_type -> gateway.v1.ScaleMinerSetRequest
+	23, // 32: gateway.v1.Gateway.WatchMinerSet:input_type -> gateway.v1.WatchMinerSetRequest
+	37, // 33: gateway.v1.Gateway.CreateMiner:input_type -> github.com.superproj.onex.pkg.apis.apps.v1beta1.Miner
+	18, // 34: gateway.v1.Gateway.ListMiner:input_type -> gateway.v1.ListMinerRequest
+	20, // 35: gateway.v1.Gateway.GetMiner:input_type -> gateway.v1.GetMinerRequest
+	37, // 36: gateway.v1.Gateway.UpdateMiner:input_type -> github.com.superproj.onex.pkg.apis.apps.v1beta1.Miner
+	22, // 37: gateway.v1.Gateway.DeleteMiner:input_type -> gateway.v1.DeleteMinerRequest
+	25, // 38: gateway.v1.Gateway.WatchMiner:input_type -> gateway.v1.WatchMinerRequest
+	38, // 39: gateway.v1.Gateway.CreateChargeRequest:input_type -> github.com.superproj.onex.pkg.apis.apps.v1beta1.ChargeRequest
+	27, // 40: gateway.v1.Gateway.ListChargeRequest:input_type -> gateway.v1.ListChargeRequestRequest
+	29, // 41: gateway.v1.Gateway.GetChargeRequest:input_type -> gateway.v1.GetChargeRequestRequest
+	30, // 42: gateway.v1.Gateway.ListMinerClass:input_type -> gateway.v1.ListMinerClassRequest
+	33, // 43: gateway.v1.Gateway.ListAuditEvents:input_type -> gateway.v1.ListAuditEventsRequest
+	1,  // 44: gateway.v1.Gateway.GetVersion:output_type -> gateway.v1.GetVersionResponse
+	0,  // 45: gateway.v1.Gateway.GetIdempotentToken:output_type -> gateway.v1.IdempotentResponse
+	40, // 46: gateway.v1.Gateway.CreateChain:output_type -> google.protobuf.Empty
+	4,  // 47: gateway.v1.Gateway.ListChain:output_type -> gateway.v1.ListChainResponse
+	2,  // 48: gateway.v1.Gateway.GetChain:output_type -> gateway.v1.Chain
+	40, // 49: gateway.v1.Gateway.UpdateChain:output_type -> google.protobuf.Empty
+	40, // 50: gateway.v1.Gateway.DeleteChain:output_type -> google.protobuf.Empty
+	40, // 51: gateway.v1.Gateway.CreateMinerSet:output_type -> google.protobuf.Empty
+	11, // 52: gateway.v1.Gateway.ListMinerSet:output_type -> gateway.v1.ListMinerSetResponse
+	36, // 53: gateway.v1.Gateway.GetMinerSet:output_type -> github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSet
+	40, // 54: gateway.v1.Gateway.UpdateMinerSet:output_type -> google.protobuf.Empty
+	40, // 55: gateway.v1.Gateway.DeleteMinerSet:output_type -> google.protobuf.Empty
+	40, // 56: gateway.v1.Gateway.ScaleMinerSet:output_type -> google.protobuf.Empty
+	24, // 57: gateway.v1.Gateway.WatchMinerSet:output_type -> gateway.v1.MinerSetEvent
+	40, // 58: gateway.v1.Gateway.CreateMiner:output_type -> google.protobuf.Empty
+	19, // 59: gateway.v1.Gateway.ListMiner:output_type -> gateway.v1.ListMinerResponse
+	37, // 60: gateway.v1.Gateway.GetMiner:output_type -> github.com.superproj.onex.pkg.apis.apps.v1beta1.Miner
+	40, // 61: gateway.v1.Gateway.UpdateMiner:output_type -> google.protobuf.Empty
+	40, // 62: gateway.v1.Gateway.DeleteMiner:output_type -> google.protobuf.Empty
+	26, // 63: gateway.v1.Gateway.WatchMiner:output_type -> gateway.v1.MinerEvent
+	40, // 64: gateway.v1.Gateway.CreateChargeRequest:output_type -> google.protobuf.Empty
+	28, // 65: gateway.v1.Gateway.ListChargeRequest:output_type -> gateway.v1.ListChargeRequestResponse
+	38, // 66: gateway.v1.Gateway.GetChargeRequest:output_type -> github.com.superproj.onex.pkg.apis.apps.v1beta1.ChargeRequest
+	31, // 67: gateway.v1.Gateway.ListMinerClass:output_type -> gateway.v1.ListMinerClassResponse
+	34, // 68: gateway.v1.Gateway.ListAuditEvents:output_type -> gateway.v1.ListAuditEventsResponse
+	44, // [44:69] is the sub-list for method output_type
+	19, // [19:44] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_gateway_v1_gateway_proto_init() }
@@ -1523,7 +2972,7 @@ func file_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_gateway_v1_gateway_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MinerSet); i {
+			switch v := v.(*Chain); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1535,7 +2984,7 @@ func file_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_gateway_v1_gateway_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MinerTemplate); i {
+			switch v := v.(*ListChainRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1547,7 +2996,7 @@ func file_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_gateway_v1_gateway_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMinerSetRequest); i {
+			switch v := v.(*ListChainResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1559,7 +3008,7 @@ func file_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_gateway_v1_gateway_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMinerSetRequest); i {
+			switch v := v.(*GetChainRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1571,7 +3020,7 @@ func file_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_gateway_v1_gateway_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMinerSetResponse); i {
+			switch v := v.(*DeleteChainRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1583,7 +3032,7 @@ func file_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_gateway_v1_gateway_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMinerSetRequest); i {
+			switch v := v.(*MinerSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1595,7 +3044,7 @@ func file_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_gateway_v1_gateway_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateMinerSetRequest); i {
+			switch v := v.(*MinerTemplate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1607,7 +3056,7 @@ func file_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_gateway_v1_gateway_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteMinerSetRequest); i {
+			switch v := v.(*CreateMinerSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1619,7 +3068,7 @@ func file_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_gateway_v1_gateway_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScaleMinerSetRequest); i {
+			switch v := v.(*ListMinerSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1631,7 +3080,7 @@ func file_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_gateway_v1_gateway_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Miner); i {
+			switch v := v.(*ListMinerSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1643,7 +3092,7 @@ func file_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_gateway_v1_gateway_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMinerRequest); i {
+			switch v := v.(*GetMinerSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1655,7 +3104,7 @@ func file_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_gateway_v1_gateway_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMinerRequest); i {
+			switch v := v.(*UpdateMinerSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1667,7 +3116,7 @@ func file_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_gateway_v1_gateway_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMinerResponse); i {
+			switch v := v.(*DeleteMinerSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1679,7 +3128,7 @@ func file_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_gateway_v1_gateway_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMinerRequest); i {
+			switch v := v.(*ScaleMinerSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1691,7 +3140,7 @@ func file_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_gateway_v1_gateway_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateMinerRequest); i {
+			switch v := v.(*Miner); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1703,6 +3152,66 @@ func file_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_gateway_v1_gateway_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMinerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_v1_gateway_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMinerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_v1_gateway_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMinerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_v1_gateway_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMinerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_v1_gateway_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMinerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_v1_gateway_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteMinerRequest); i {
 			case 0:
 				return &v.state
@@ -1714,16 +3223,160 @@ func file_gateway_v1_gateway_proto_init() {
 				return nil
 			}
 		}
+		file_gateway_v1_gateway_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchMinerSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_v1_gateway_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MinerSetEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_v1_gateway_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchMinerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_v1_gateway_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MinerEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_v1_gateway_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChargeRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_v1_gateway_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChargeRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_v1_gateway_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChargeRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_v1_gateway_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMinerClassRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_v1_gateway_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMinerClassResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_v1_gateway_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_v1_gateway_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_v1_gateway_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_gateway_v1_gateway_proto_msgTypes[8].OneofWrappers = []interface{}{}
-	file_gateway_v1_gateway_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_gateway_v1_gateway_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_gateway_v1_gateway_proto_msgTypes[21].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gateway_v1_gateway_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -255,22 +255,21 @@ var _ interface {
 	ErrorName() string
 } = GetVersionResponseValidationError{}
 
-// Validate checks the field values on MinerSet with the rules defined in the
+// Validate checks the field values on Chain with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *MinerSet) Validate() error {
+func (m *Chain) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MinerSet with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MinerSetMultiError, or nil
-// if none found.
-func (m *MinerSet) ValidateAll() error {
+// ValidateAll checks the field values on Chain with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ChainMultiError, or nil if none found.
+func (m *Chain) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MinerSet) validate(all bool) error {
+func (m *Chain) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -279,46 +278,19 @@ func (m *MinerSet) validate(all bool) error {
 
 	// no validation rules for Name
 
-	// no validation rules for Replicas
-
 	// no validation rules for DisplayName
 
-	// no validation rules for DeletePolicy
+	// no validation rules for MinerType
 
-	if all {
-		switch v := interface{}(m.GetMinerTemplate()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MinerSetValidationError{
-					field:  "MinerTemplate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MinerSetValidationError{
-					field:  "MinerTemplate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMinerTemplate()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MinerSetValidationError{
-				field:  "MinerTemplate",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Image
+
+	// no validation rules for MinMineIntervalSeconds
 
 	if all {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MinerSetValidationError{
+				errors = append(errors, ChainValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -326,7 +298,7 @@ func (m *MinerSet) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MinerSetValidationError{
+				errors = append(errors, ChainValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -335,7 +307,7 @@ func (m *MinerSet) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MinerSetValidationError{
+			return ChainValidationError{
 				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -347,7 +319,7 @@ func (m *MinerSet) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MinerSetValidationError{
+				errors = append(errors, ChainValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -355,7 +327,7 @@ func (m *MinerSet) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MinerSetValidationError{
+				errors = append(errors, ChainValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -364,7 +336,7 @@ func (m *MinerSet) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MinerSetValidationError{
+			return ChainValidationError{
 				field:  "UpdatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -373,18 +345,18 @@ func (m *MinerSet) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MinerSetMultiError(errors)
+		return ChainMultiError(errors)
 	}
 
 	return nil
 }
 
-// MinerSetMultiError is an error wrapping multiple validation errors returned
-// by MinerSet.ValidateAll() if the designated constraints aren't met.
-type MinerSetMultiError []error
+// ChainMultiError is an error wrapping multiple validation errors returned by
+// Chain.ValidateAll() if the designated constraints aren't met.
+type ChainMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MinerSetMultiError) Error() string {
+func (m ChainMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -393,11 +365,11 @@ func (m MinerSetMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MinerSetMultiError) AllErrors() []error { return m }
+func (m ChainMultiError) AllErrors() []error { return m }
 
-// MinerSetValidationError is the validation error returned by
-// MinerSet.Validate if the designated constraints aren't met.
-type MinerSetValidationError struct {
+// ChainValidationError is the validation error returned by Chain.Validate if
+// the designated constraints aren't met.
+type ChainValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -405,22 +377,22 @@ type MinerSetValidationError struct {
 }
 
 // Field function returns field value.
-func (e MinerSetValidationError) Field() string { return e.field }
+func (e ChainValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MinerSetValidationError) Reason() string { return e.reason }
+func (e ChainValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MinerSetValidationError) Cause() error { return e.cause }
+func (e ChainValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MinerSetValidationError) Key() bool { return e.key }
+func (e ChainValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MinerSetValidationError) ErrorName() string { return "MinerSetValidationError" }
+func (e ChainValidationError) ErrorName() string { return "ChainValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MinerSetValidationError) Error() string {
+func (e ChainValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -432,14 +404,14 @@ func (e MinerSetValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMinerSet.%s: %s%s",
+		"invalid %sChain.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MinerSetValidationError{}
+var _ error = ChainValidationError{}
 
 var _ interface {
 	Field() string
@@ -447,50 +419,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MinerSetValidationError{}
+} = ChainValidationError{}
 
-// Validate checks the field values on MinerTemplate with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *MinerTemplate) Validate() error {
+// Validate checks the field values on ListChainRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListChainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MinerTemplate with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MinerTemplateMultiError, or
-// nil if none found.
-func (m *MinerTemplate) ValidateAll() error {
+// ValidateAll checks the field values on ListChainRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListChainRequestMultiError, or nil if none found.
+func (m *ListChainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MinerTemplate) validate(all bool) error {
+func (m *ListChainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for MinerType
-
-	// no validation rules for ChainName
+	// no validation rules for Limit
 
-	// no validation rules for DataDir
+	// no validation rules for Offset
 
 	if len(errors) > 0 {
-		return MinerTemplateMultiError(errors)
+		return ListChainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MinerTemplateMultiError is an error wrapping multiple validation errors
-// returned by MinerTemplate.ValidateAll() if the designated constraints
+// ListChainRequestMultiError is an error wrapping multiple validation errors
+// returned by ListChainRequest.ValidateAll() if the designated constraints
 // aren't met.
-type MinerTemplateMultiError []error
+type ListChainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MinerTemplateMultiError) Error() string {
+func (m ListChainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -499,11 +469,11 @@ func (m MinerTemplateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MinerTemplateMultiError) AllErrors() []error { return m }
+func (m ListChainRequestMultiError) AllErrors() []error { return m }
 
-// MinerTemplateValidationError is the validation error returned by
-// MinerTemplate.Validate if the designated constraints aren't met.
-type MinerTemplateValidationError struct {
+// ListChainRequestValidationError is the validation error returned by
+// ListChainRequest.Validate if the designated constraints aren't met.
+type ListChainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -511,22 +481,22 @@ type MinerTemplateValidationError struct {
 }
 
 // Field function returns field value.
-func (e MinerTemplateValidationError) Field() string { return e.field }
+func (e ListChainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MinerTemplateValidationError) Reason() string { return e.reason }
+func (e ListChainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MinerTemplateValidationError) Cause() error { return e.cause }
+func (e ListChainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MinerTemplateValidationError) Key() bool { return e.key }
+func (e ListChainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MinerTemplateValidationError) ErrorName() string { return "MinerTemplateValidationError" }
+func (e ListChainRequestValidationError) ErrorName() string { return "ListChainRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MinerTemplateValidationError) Error() string {
+func (e ListChainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -538,14 +508,14 @@ func (e MinerTemplateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMinerTemplate.%s: %s%s",
+		"invalid %sListChainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MinerTemplateValidationError{}
+var _ error = ListChainRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -553,79 +523,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MinerTemplateValidationError{}
+} = ListChainRequestValidationError{}
 
-// Validate checks the field values on CreateMinerSetRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateMinerSetRequest) Validate() error {
+// Validate checks the field values on ListChainResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListChainResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateMinerSetRequest with the rules
+// ValidateAll checks the field values on ListChainResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateMinerSetRequestMultiError, or nil if none found.
-func (m *CreateMinerSetRequest) ValidateAll() error {
+// ListChainResponseMultiError, or nil if none found.
+func (m *ListChainResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateMinerSetRequest) validate(all bool) error {
+func (m *ListChainResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Replicas
-
-	// no validation rules for DisplayName
+	// no validation rules for TotalCount
 
-	// no validation rules for DeletePolicy
+	for idx, item := range m.GetChains() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetMinerTemplate()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateMinerSetRequestValidationError{
-					field:  "MinerTemplate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListChainResponseValidationError{
+						field:  fmt.Sprintf("Chains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListChainResponseValidationError{
+						field:  fmt.Sprintf("Chains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateMinerSetRequestValidationError{
-					field:  "MinerTemplate",
+				return ListChainResponseValidationError{
+					field:  fmt.Sprintf("Chains[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMinerTemplate()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateMinerSetRequestValidationError{
-				field:  "MinerTemplate",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return CreateMinerSetRequestMultiError(errors)
+		return ListChainResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateMinerSetRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateMinerSetRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateMinerSetRequestMultiError []error
+// ListChainResponseMultiError is an error wrapping multiple validation errors
+// returned by ListChainResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListChainResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateMinerSetRequestMultiError) Error() string {
+func (m ListChainResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -634,11 +605,11 @@ func (m CreateMinerSetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateMinerSetRequestMultiError) AllErrors() []error { return m }
+func (m ListChainResponseMultiError) AllErrors() []error { return m }
 
-// CreateMinerSetRequestValidationError is the validation error returned by
-// CreateMinerSetRequest.Validate if the designated constraints aren't met.
-type CreateMinerSetRequestValidationError struct {
+// ListChainResponseValidationError is the validation error returned by
+// ListChainResponse.Validate if the designated constraints aren't met.
+type ListChainResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -646,24 +617,24 @@ type CreateMinerSetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateMinerSetRequestValidationError) Field() string { return e.field }
+func (e ListChainResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateMinerSetRequestValidationError) Reason() string { return e.reason }
+func (e ListChainResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateMinerSetRequestValidationError) Cause() error { return e.cause }
+func (e ListChainResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateMinerSetRequestValidationError) Key() bool { return e.key }
+func (e ListChainResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateMinerSetRequestValidationError) ErrorName() string {
-	return "CreateMinerSetRequestValidationError"
+func (e ListChainResponseValidationError) ErrorName() string {
+	return "ListChainResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateMinerSetRequestValidationError) Error() string {
+func (e ListChainResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -675,14 +646,14 @@ func (e CreateMinerSetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateMinerSetRequest.%s: %s%s",
+		"invalid %sListChainResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateMinerSetRequestValidationError{}
+var _ error = ListChainResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -690,48 +661,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateMinerSetRequestValidationError{}
+} = ListChainResponseValidationError{}
 
-// Validate checks the field values on ListMinerSetRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListMinerSetRequest) Validate() error {
+// Validate checks the field values on GetChainRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetChainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListMinerSetRequest with the rules
+// ValidateAll checks the field values on GetChainRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListMinerSetRequestMultiError, or nil if none found.
-func (m *ListMinerSetRequest) ValidateAll() error {
+// GetChainRequestMultiError, or nil if none found.
+func (m *GetChainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListMinerSetRequest) validate(all bool) error {
+func (m *GetChainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Limit
-
-	// no validation rules for Offset
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return ListMinerSetRequestMultiError(errors)
+		return GetChainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListMinerSetRequestMultiError is an error wrapping multiple validation
-// errors returned by ListMinerSetRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListMinerSetRequestMultiError []error
+// GetChainRequestMultiError is an error wrapping multiple validation errors
+// returned by GetChainRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetChainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListMinerSetRequestMultiError) Error() string {
+func (m GetChainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -740,11 +709,11 @@ func (m ListMinerSetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListMinerSetRequestMultiError) AllErrors() []error { return m }
+func (m GetChainRequestMultiError) AllErrors() []error { return m }
 
-// ListMinerSetRequestValidationError is the validation error returned by
-// ListMinerSetRequest.Validate if the designated constraints aren't met.
-type ListMinerSetRequestValidationError struct {
+// GetChainRequestValidationError is the validation error returned by
+// GetChainRequest.Validate if the designated constraints aren't met.
+type GetChainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -752,24 +721,22 @@ type ListMinerSetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListMinerSetRequestValidationError) Field() string { return e.field }
+func (e GetChainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListMinerSetRequestValidationError) Reason() string { return e.reason }
+func (e GetChainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListMinerSetRequestValidationError) Cause() error { return e.cause }
+func (e GetChainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListMinerSetRequestValidationError) Key() bool { return e.key }
+func (e GetChainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListMinerSetRequestValidationError) ErrorName() string {
-	return "ListMinerSetRequestValidationError"
-}
+func (e GetChainRequestValidationError) ErrorName() string { return "GetChainRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListMinerSetRequestValidationError) Error() string {
+func (e GetChainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -781,14 +748,14 @@ func (e ListMinerSetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListMinerSetRequest.%s: %s%s",
+		"invalid %sGetChainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListMinerSetRequestValidationError{}
+var _ error = GetChainRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -796,80 +763,2011 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListMinerSetRequestValidationError{}
+} = GetChainRequestValidationError{}
 
-// Validate checks the field values on ListMinerSetResponse with the rules
+// Validate checks the field values on DeleteChainRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListMinerSetResponse) Validate() error {
+func (m *DeleteChainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListMinerSetResponse with the rules
+// ValidateAll checks the field values on DeleteChainRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListMinerSetResponseMultiError, or nil if none found.
-func (m *ListMinerSetResponse) ValidateAll() error {
+// DeleteChainRequestMultiError, or nil if none found.
+func (m *DeleteChainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListMinerSetResponse) validate(all bool) error {
+func (m *DeleteChainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TotalCount
+	// no validation rules for Name
 
-	for idx, item := range m.GetMinerSets() {
-		_, _ = idx, item
+	if len(errors) > 0 {
+		return DeleteChainRequestMultiError(errors)
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListMinerSetResponseValidationError{
-						field:  fmt.Sprintf("MinerSets[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListMinerSetResponseValidationError{
-						field:  fmt.Sprintf("MinerSets[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListMinerSetResponseValidationError{
-					field:  fmt.Sprintf("MinerSets[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	return nil
+}
+
+// DeleteChainRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteChainRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteChainRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteChainRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteChainRequestMultiError) AllErrors() []error { return m }
+
+// DeleteChainRequestValidationError is the validation error returned by
+// DeleteChainRequest.Validate if the designated constraints aren't met.
+type DeleteChainRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteChainRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteChainRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteChainRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteChainRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteChainRequestValidationError) ErrorName() string {
+	return "DeleteChainRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteChainRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteChainRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteChainRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteChainRequestValidationError{}
+
+// Validate checks the field values on MinerSet with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MinerSet) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MinerSet with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MinerSetMultiError, or nil
+// if none found.
+func (m *MinerSet) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MinerSet) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Replicas
+
+	// no validation rules for DisplayName
+
+	// no validation rules for DeletePolicy
+
+	if all {
+		switch v := interface{}(m.GetMinerTemplate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MinerSetValidationError{
+					field:  "MinerTemplate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MinerSetValidationError{
+					field:  "MinerTemplate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMinerTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MinerSetValidationError{
+				field:  "MinerTemplate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MinerSetValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MinerSetValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MinerSetValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MinerSetValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MinerSetValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MinerSetValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MinerSetMultiError(errors)
+	}
+
+	return nil
+}
+
+// MinerSetMultiError is an error wrapping multiple validation errors returned
+// by MinerSet.ValidateAll() if the designated constraints aren't met.
+type MinerSetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MinerSetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MinerSetMultiError) AllErrors() []error { return m }
+
+// MinerSetValidationError is the validation error returned by
+// MinerSet.Validate if the designated constraints aren't met.
+type MinerSetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MinerSetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MinerSetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MinerSetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MinerSetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MinerSetValidationError) ErrorName() string { return "MinerSetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MinerSetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMinerSet.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MinerSetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MinerSetValidationError{}
+
+// Validate checks the field values on MinerTemplate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MinerTemplate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MinerTemplate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MinerTemplateMultiError, or
+// nil if none found.
+func (m *MinerTemplate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MinerTemplate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MinerType
+
+	// no validation rules for ChainName
+
+	// no validation rules for DataDir
+
+	if len(errors) > 0 {
+		return MinerTemplateMultiError(errors)
+	}
+
+	return nil
+}
+
+// MinerTemplateMultiError is an error wrapping multiple validation errors
+// returned by MinerTemplate.ValidateAll() if the designated constraints
+// aren't met.
+type MinerTemplateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MinerTemplateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MinerTemplateMultiError) AllErrors() []error { return m }
+
+// MinerTemplateValidationError is the validation error returned by
+// MinerTemplate.Validate if the designated constraints aren't met.
+type MinerTemplateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MinerTemplateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MinerTemplateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MinerTemplateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MinerTemplateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MinerTemplateValidationError) ErrorName() string { return "MinerTemplateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MinerTemplateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMinerTemplate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MinerTemplateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MinerTemplateValidationError{}
+
+// Validate checks the field values on CreateMinerSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateMinerSetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateMinerSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateMinerSetRequestMultiError, or nil if none found.
+func (m *CreateMinerSetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateMinerSetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Replicas
+
+	// no validation rules for DisplayName
+
+	// no validation rules for DeletePolicy
+
+	if all {
+		switch v := interface{}(m.GetMinerTemplate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateMinerSetRequestValidationError{
+					field:  "MinerTemplate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateMinerSetRequestValidationError{
+					field:  "MinerTemplate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMinerTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateMinerSetRequestValidationError{
+				field:  "MinerTemplate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateMinerSetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateMinerSetRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateMinerSetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateMinerSetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateMinerSetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateMinerSetRequestMultiError) AllErrors() []error { return m }
+
+// CreateMinerSetRequestValidationError is the validation error returned by
+// CreateMinerSetRequest.Validate if the designated constraints aren't met.
+type CreateMinerSetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateMinerSetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateMinerSetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateMinerSetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateMinerSetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateMinerSetRequestValidationError) ErrorName() string {
+	return "CreateMinerSetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateMinerSetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateMinerSetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateMinerSetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateMinerSetRequestValidationError{}
+
+// Validate checks the field values on ListMinerSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMinerSetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMinerSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMinerSetRequestMultiError, or nil if none found.
+func (m *ListMinerSetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMinerSetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	// no validation rules for LabelSelector
+
+	// no validation rules for FieldSelector
+
+	// no validation rules for OrderBy
+
+	// no validation rules for Continue
+
+	if len(errors) > 0 {
+		return ListMinerSetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMinerSetRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMinerSetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMinerSetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMinerSetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMinerSetRequestMultiError) AllErrors() []error { return m }
+
+// ListMinerSetRequestValidationError is the validation error returned by
+// ListMinerSetRequest.Validate if the designated constraints aren't met.
+type ListMinerSetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMinerSetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMinerSetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMinerSetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMinerSetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMinerSetRequestValidationError) ErrorName() string {
+	return "ListMinerSetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMinerSetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMinerSetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMinerSetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMinerSetRequestValidationError{}
+
+// Validate checks the field values on ListMinerSetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMinerSetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMinerSetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMinerSetResponseMultiError, or nil if none found.
+func (m *ListMinerSetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMinerSetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalCount
+
+	for idx, item := range m.GetMinerSets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMinerSetResponseValidationError{
+						field:  fmt.Sprintf("MinerSets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMinerSetResponseValidationError{
+						field:  fmt.Sprintf("MinerSets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMinerSetResponseValidationError{
+					field:  fmt.Sprintf("MinerSets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Continue
+
+	if len(errors) > 0 {
+		return ListMinerSetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMinerSetResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMinerSetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListMinerSetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMinerSetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMinerSetResponseMultiError) AllErrors() []error { return m }
+
+// ListMinerSetResponseValidationError is the validation error returned by
+// ListMinerSetResponse.Validate if the designated constraints aren't met.
+type ListMinerSetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMinerSetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMinerSetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMinerSetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMinerSetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMinerSetResponseValidationError) ErrorName() string {
+	return "ListMinerSetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMinerSetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMinerSetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMinerSetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMinerSetResponseValidationError{}
+
+// Validate checks the field values on GetMinerSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMinerSetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMinerSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMinerSetRequestMultiError, or nil if none found.
+func (m *GetMinerSetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMinerSetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return GetMinerSetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMinerSetRequestMultiError is an error wrapping multiple validation errors
+// returned by GetMinerSetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetMinerSetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMinerSetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMinerSetRequestMultiError) AllErrors() []error { return m }
+
+// GetMinerSetRequestValidationError is the validation error returned by
+// GetMinerSetRequest.Validate if the designated constraints aren't met.
+type GetMinerSetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMinerSetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMinerSetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMinerSetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMinerSetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMinerSetRequestValidationError) ErrorName() string {
+	return "GetMinerSetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMinerSetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMinerSetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMinerSetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMinerSetRequestValidationError{}
+
+// Validate checks the field values on UpdateMinerSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateMinerSetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateMinerSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateMinerSetRequestMultiError, or nil if none found.
+func (m *UpdateMinerSetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMinerSetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if m.DisplayName != nil {
+		// no validation rules for DisplayName
+	}
+
+	if m.DeletePolicy != nil {
+		// no validation rules for DeletePolicy
+	}
+
+	if len(errors) > 0 {
+		return UpdateMinerSetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateMinerSetRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateMinerSetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateMinerSetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMinerSetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMinerSetRequestMultiError) AllErrors() []error { return m }
+
+// UpdateMinerSetRequestValidationError is the validation error returned by
+// UpdateMinerSetRequest.Validate if the designated constraints aren't met.
+type UpdateMinerSetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMinerSetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMinerSetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMinerSetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMinerSetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMinerSetRequestValidationError) ErrorName() string {
+	return "UpdateMinerSetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMinerSetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMinerSetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMinerSetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMinerSetRequestValidationError{}
+
+// Validate checks the field values on DeleteMinerSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMinerSetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteMinerSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteMinerSetRequestMultiError, or nil if none found.
+func (m *DeleteMinerSetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteMinerSetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return DeleteMinerSetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteMinerSetRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteMinerSetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteMinerSetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteMinerSetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteMinerSetRequestMultiError) AllErrors() []error { return m }
+
+// DeleteMinerSetRequestValidationError is the validation error returned by
+// DeleteMinerSetRequest.Validate if the designated constraints aren't met.
+type DeleteMinerSetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteMinerSetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteMinerSetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteMinerSetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteMinerSetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteMinerSetRequestValidationError) ErrorName() string {
+	return "DeleteMinerSetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteMinerSetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteMinerSetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteMinerSetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteMinerSetRequestValidationError{}
+
+// Validate checks the field values on ScaleMinerSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScaleMinerSetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScaleMinerSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScaleMinerSetRequestMultiError, or nil if none found.
+func (m *ScaleMinerSetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScaleMinerSetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Replicas
+
+	if len(errors) > 0 {
+		return ScaleMinerSetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScaleMinerSetRequestMultiError is an error wrapping multiple validation
+// errors returned by ScaleMinerSetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ScaleMinerSetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScaleMinerSetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScaleMinerSetRequestMultiError) AllErrors() []error { return m }
+
+// ScaleMinerSetRequestValidationError is the validation error returned by
+// ScaleMinerSetRequest.Validate if the designated constraints aren't met.
+type ScaleMinerSetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScaleMinerSetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScaleMinerSetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScaleMinerSetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScaleMinerSetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScaleMinerSetRequestValidationError) ErrorName() string {
+	return "ScaleMinerSetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScaleMinerSetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScaleMinerSetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScaleMinerSetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScaleMinerSetRequestValidationError{}
+
+// Validate checks the field values on Miner with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Miner) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Miner with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MinerMultiError, or nil if none found.
+func (m *Miner) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Miner) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for DisplayName
+
+	// no validation rules for MinerType
+
+	// no validation rules for ChainName
+
+	// no validation rules for DataDir
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MinerValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MinerValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MinerValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MinerValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MinerValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MinerValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MinerMultiError(errors)
+	}
+
+	return nil
+}
+
+// MinerMultiError is an error wrapping multiple validation errors returned by
+// Miner.ValidateAll() if the designated constraints aren't met.
+type MinerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MinerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MinerMultiError) AllErrors() []error { return m }
+
+// MinerValidationError is the validation error returned by Miner.Validate if
+// the designated constraints aren't met.
+type MinerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MinerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MinerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MinerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MinerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MinerValidationError) ErrorName() string { return "MinerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MinerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMiner.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MinerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MinerValidationError{}
+
+// Validate checks the field values on CreateMinerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateMinerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateMinerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateMinerRequestMultiError, or nil if none found.
+func (m *CreateMinerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateMinerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DisplayName
+
+	// no validation rules for MinerType
+
+	// no validation rules for ChainName
+
+	// no validation rules for DataDir
+
+	if len(errors) > 0 {
+		return CreateMinerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateMinerRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateMinerRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateMinerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateMinerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateMinerRequestMultiError) AllErrors() []error { return m }
+
+// CreateMinerRequestValidationError is the validation error returned by
+// CreateMinerRequest.Validate if the designated constraints aren't met.
+type CreateMinerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateMinerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateMinerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateMinerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateMinerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateMinerRequestValidationError) ErrorName() string {
+	return "CreateMinerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateMinerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateMinerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateMinerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateMinerRequestValidationError{}
+
+// Validate checks the field values on ListMinerRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListMinerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMinerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMinerRequestMultiError, or nil if none found.
+func (m *ListMinerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMinerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	// no validation rules for LabelSelector
+
+	// no validation rules for FieldSelector
+
+	// no validation rules for OrderBy
+
+	// no validation rules for Continue
+
+	if len(errors) > 0 {
+		return ListMinerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMinerRequestMultiError is an error wrapping multiple validation errors
+// returned by ListMinerRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListMinerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMinerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMinerRequestMultiError) AllErrors() []error { return m }
+
+// ListMinerRequestValidationError is the validation error returned by
+// ListMinerRequest.Validate if the designated constraints aren't met.
+type ListMinerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMinerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMinerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMinerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMinerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMinerRequestValidationError) ErrorName() string { return "ListMinerRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListMinerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMinerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMinerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMinerRequestValidationError{}
+
+// Validate checks the field values on ListMinerResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListMinerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMinerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMinerResponseMultiError, or nil if none found.
+func (m *ListMinerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMinerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalCount
+
+	for idx, item := range m.GetMiners() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMinerResponseValidationError{
+						field:  fmt.Sprintf("Miners[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMinerResponseValidationError{
+						field:  fmt.Sprintf("Miners[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMinerResponseValidationError{
+					field:  fmt.Sprintf("Miners[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Continue
+
+	if len(errors) > 0 {
+		return ListMinerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMinerResponseMultiError is an error wrapping multiple validation errors
+// returned by ListMinerResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListMinerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMinerResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMinerResponseMultiError) AllErrors() []error { return m }
+
+// ListMinerResponseValidationError is the validation error returned by
+// ListMinerResponse.Validate if the designated constraints aren't met.
+type ListMinerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMinerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMinerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMinerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMinerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMinerResponseValidationError) ErrorName() string {
+	return "ListMinerResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMinerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMinerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMinerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMinerResponseValidationError{}
+
+// Validate checks the field values on GetMinerRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetMinerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMinerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMinerRequestMultiError, or nil if none found.
+func (m *GetMinerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMinerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return GetMinerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMinerRequestMultiError is an error wrapping multiple validation errors
+// returned by GetMinerRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetMinerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMinerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMinerRequestMultiError) AllErrors() []error { return m }
+
+// GetMinerRequestValidationError is the validation error returned by
+// GetMinerRequest.Validate if the designated constraints aren't met.
+type GetMinerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMinerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMinerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMinerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMinerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMinerRequestValidationError) ErrorName() string { return "GetMinerRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetMinerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMinerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMinerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMinerRequestValidationError{}
+
+// Validate checks the field values on UpdateMinerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateMinerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateMinerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateMinerRequestMultiError, or nil if none found.
+func (m *UpdateMinerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMinerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if m.DisplayName != nil {
+		// no validation rules for DisplayName
+	}
+
+	if m.DeletePolicy != nil {
+		// no validation rules for DeletePolicy
+	}
+
+	if len(errors) > 0 {
+		return UpdateMinerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateMinerRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateMinerRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateMinerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMinerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMinerRequestMultiError) AllErrors() []error { return m }
+
+// UpdateMinerRequestValidationError is the validation error returned by
+// UpdateMinerRequest.Validate if the designated constraints aren't met.
+type UpdateMinerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMinerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMinerRequestValidationError) Reason() string { return e.reason }
 
+// Cause function returns cause value.
+func (e UpdateMinerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMinerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMinerRequestValidationError) ErrorName() string {
+	return "UpdateMinerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMinerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMinerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMinerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMinerRequestValidationError{}
+
+// Validate checks the field values on DeleteMinerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMinerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteMinerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteMinerRequestMultiError, or nil if none found.
+func (m *DeleteMinerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteMinerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Name
+
 	if len(errors) > 0 {
-		return ListMinerSetResponseMultiError(errors)
+		return DeleteMinerRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListMinerSetResponseMultiError is an error wrapping multiple validation
-// errors returned by ListMinerSetResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListMinerSetResponseMultiError []error
+// DeleteMinerRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteMinerRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteMinerRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListMinerSetResponseMultiError) Error() string {
+func (m DeleteMinerRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -878,11 +2776,11 @@ func (m ListMinerSetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListMinerSetResponseMultiError) AllErrors() []error { return m }
+func (m DeleteMinerRequestMultiError) AllErrors() []error { return m }
 
-// ListMinerSetResponseValidationError is the validation error returned by
-// ListMinerSetResponse.Validate if the designated constraints aren't met.
-type ListMinerSetResponseValidationError struct {
+// DeleteMinerRequestValidationError is the validation error returned by
+// DeleteMinerRequest.Validate if the designated constraints aren't met.
+type DeleteMinerRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -890,24 +2788,24 @@ type ListMinerSetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListMinerSetResponseValidationError) Field() string { return e.field }
+func (e DeleteMinerRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListMinerSetResponseValidationError) Reason() string { return e.reason }
+func (e DeleteMinerRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListMinerSetResponseValidationError) Cause() error { return e.cause }
+func (e DeleteMinerRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListMinerSetResponseValidationError) Key() bool { return e.key }
+func (e DeleteMinerRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListMinerSetResponseValidationError) ErrorName() string {
-	return "ListMinerSetResponseValidationError"
+func (e DeleteMinerRequestValidationError) ErrorName() string {
+	return "DeleteMinerRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListMinerSetResponseValidationError) Error() string {
+func (e DeleteMinerRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -919,14 +2817,14 @@ func (e ListMinerSetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListMinerSetResponse.%s: %s%s",
+		"invalid %sDeleteMinerRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListMinerSetResponseValidationError{}
+var _ error = DeleteMinerRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -934,46 +2832,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListMinerSetResponseValidationError{}
+} = DeleteMinerRequestValidationError{}
 
-// Validate checks the field values on GetMinerSetRequest with the rules
+// Validate checks the field values on WatchMinerSetRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetMinerSetRequest) Validate() error {
+func (m *WatchMinerSetRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetMinerSetRequest with the rules
+// ValidateAll checks the field values on WatchMinerSetRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetMinerSetRequestMultiError, or nil if none found.
-func (m *GetMinerSetRequest) ValidateAll() error {
+// WatchMinerSetRequestMultiError, or nil if none found.
+func (m *WatchMinerSetRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetMinerSetRequest) validate(all bool) error {
+func (m *WatchMinerSetRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for ResourceVersion
 
 	if len(errors) > 0 {
-		return GetMinerSetRequestMultiError(errors)
+		return WatchMinerSetRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetMinerSetRequestMultiError is an error wrapping multiple validation errors
-// returned by GetMinerSetRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetMinerSetRequestMultiError []error
+// WatchMinerSetRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchMinerSetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchMinerSetRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetMinerSetRequestMultiError) Error() string {
+func (m WatchMinerSetRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -982,11 +2880,11 @@ func (m GetMinerSetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetMinerSetRequestMultiError) AllErrors() []error { return m }
+func (m WatchMinerSetRequestMultiError) AllErrors() []error { return m }
 
-// GetMinerSetRequestValidationError is the validation error returned by
-// GetMinerSetRequest.Validate if the designated constraints aren't met.
-type GetMinerSetRequestValidationError struct {
+// WatchMinerSetRequestValidationError is the validation error returned by
+// WatchMinerSetRequest.Validate if the designated constraints aren't met.
+type WatchMinerSetRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -994,24 +2892,24 @@ type GetMinerSetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetMinerSetRequestValidationError) Field() string { return e.field }
+func (e WatchMinerSetRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetMinerSetRequestValidationError) Reason() string { return e.reason }
+func (e WatchMinerSetRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetMinerSetRequestValidationError) Cause() error { return e.cause }
+func (e WatchMinerSetRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetMinerSetRequestValidationError) Key() bool { return e.key }
+func (e WatchMinerSetRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetMinerSetRequestValidationError) ErrorName() string {
-	return "GetMinerSetRequestValidationError"
+func (e WatchMinerSetRequestValidationError) ErrorName() string {
+	return "WatchMinerSetRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetMinerSetRequestValidationError) Error() string {
+func (e WatchMinerSetRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1023,14 +2921,14 @@ func (e GetMinerSetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetMinerSetRequest.%s: %s%s",
+		"invalid %sWatchMinerSetRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetMinerSetRequestValidationError{}
+var _ error = WatchMinerSetRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1038,54 +2936,177 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetMinerSetRequestValidationError{}
+} = WatchMinerSetRequestValidationError{}
 
-// Validate checks the field values on UpdateMinerSetRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateMinerSetRequest) Validate() error {
+// Validate checks the field values on MinerSetEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MinerSetEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateMinerSetRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateMinerSetRequestMultiError, or nil if none found.
-func (m *UpdateMinerSetRequest) ValidateAll() error {
+// ValidateAll checks the field values on MinerSetEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MinerSetEventMultiError, or
+// nil if none found.
+func (m *MinerSetEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateMinerSetRequest) validate(all bool) error {
+func (m *MinerSetEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for Type
 
-	if m.DisplayName != nil {
-		// no validation rules for DisplayName
+	if all {
+		switch v := interface{}(m.GetObject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MinerSetEventValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MinerSetEventValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetObject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MinerSetEventValidationError{
+				field:  "Object",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	if m.DeletePolicy != nil {
-		// no validation rules for DeletePolicy
+	if len(errors) > 0 {
+		return MinerSetEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// MinerSetEventMultiError is an error wrapping multiple validation errors
+// returned by MinerSetEvent.ValidateAll() if the designated constraints
+// aren't met.
+type MinerSetEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MinerSetEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MinerSetEventMultiError) AllErrors() []error { return m }
+
+// MinerSetEventValidationError is the validation error returned by
+// MinerSetEvent.Validate if the designated constraints aren't met.
+type MinerSetEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MinerSetEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MinerSetEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MinerSetEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MinerSetEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MinerSetEventValidationError) ErrorName() string { return "MinerSetEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MinerSetEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMinerSetEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MinerSetEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MinerSetEventValidationError{}
+
+// Validate checks the field values on WatchMinerRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatchMinerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchMinerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchMinerRequestMultiError, or nil if none found.
+func (m *WatchMinerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchMinerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for ResourceVersion
+
 	if len(errors) > 0 {
-		return UpdateMinerSetRequestMultiError(errors)
+		return WatchMinerRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateMinerSetRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateMinerSetRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateMinerSetRequestMultiError []error
+// WatchMinerRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchMinerRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchMinerRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateMinerSetRequestMultiError) Error() string {
+func (m WatchMinerRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1094,11 +3115,11 @@ func (m UpdateMinerSetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateMinerSetRequestMultiError) AllErrors() []error { return m }
-
-// UpdateMinerSetRequestValidationError is the validation error returned by
-// UpdateMinerSetRequest.Validate if the designated constraints aren't met.
-type UpdateMinerSetRequestValidationError struct {
+func (m WatchMinerRequestMultiError) AllErrors() []error { return m }
+
+// WatchMinerRequestValidationError is the validation error returned by
+// WatchMinerRequest.Validate if the designated constraints aren't met.
+type WatchMinerRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1106,24 +3127,24 @@ type UpdateMinerSetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateMinerSetRequestValidationError) Field() string { return e.field }
+func (e WatchMinerRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateMinerSetRequestValidationError) Reason() string { return e.reason }
+func (e WatchMinerRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateMinerSetRequestValidationError) Cause() error { return e.cause }
+func (e WatchMinerRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateMinerSetRequestValidationError) Key() bool { return e.key }
+func (e WatchMinerRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateMinerSetRequestValidationError) ErrorName() string {
-	return "UpdateMinerSetRequestValidationError"
+func (e WatchMinerRequestValidationError) ErrorName() string {
+	return "WatchMinerRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateMinerSetRequestValidationError) Error() string {
+func (e WatchMinerRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1135,14 +3156,14 @@ func (e UpdateMinerSetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateMinerSetRequest.%s: %s%s",
+		"invalid %sWatchMinerRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateMinerSetRequestValidationError{}
+var _ error = WatchMinerRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1150,46 +3171,74 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateMinerSetRequestValidationError{}
+} = WatchMinerRequestValidationError{}
 
-// Validate checks the field values on DeleteMinerSetRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteMinerSetRequest) Validate() error {
+// Validate checks the field values on MinerEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MinerEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteMinerSetRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteMinerSetRequestMultiError, or nil if none found.
-func (m *DeleteMinerSetRequest) ValidateAll() error {
+// ValidateAll checks the field values on MinerEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MinerEventMultiError, or
+// nil if none found.
+func (m *MinerEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteMinerSetRequest) validate(all bool) error {
+func (m *MinerEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetObject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MinerEventValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MinerEventValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetObject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MinerEventValidationError{
+				field:  "Object",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteMinerSetRequestMultiError(errors)
+		return MinerEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteMinerSetRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteMinerSetRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteMinerSetRequestMultiError []error
+// MinerEventMultiError is an error wrapping multiple validation errors
+// returned by MinerEvent.ValidateAll() if the designated constraints aren't met.
+type MinerEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteMinerSetRequestMultiError) Error() string {
+func (m MinerEventMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1198,11 +3247,11 @@ func (m DeleteMinerSetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteMinerSetRequestMultiError) AllErrors() []error { return m }
+func (m MinerEventMultiError) AllErrors() []error { return m }
 
-// DeleteMinerSetRequestValidationError is the validation error returned by
-// DeleteMinerSetRequest.Validate if the designated constraints aren't met.
-type DeleteMinerSetRequestValidationError struct {
+// MinerEventValidationError is the validation error returned by
+// MinerEvent.Validate if the designated constraints aren't met.
+type MinerEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1210,24 +3259,22 @@ type DeleteMinerSetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteMinerSetRequestValidationError) Field() string { return e.field }
+func (e MinerEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteMinerSetRequestValidationError) Reason() string { return e.reason }
+func (e MinerEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteMinerSetRequestValidationError) Cause() error { return e.cause }
+func (e MinerEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteMinerSetRequestValidationError) Key() bool { return e.key }
+func (e MinerEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteMinerSetRequestValidationError) ErrorName() string {
-	return "DeleteMinerSetRequestValidationError"
-}
+func (e MinerEventValidationError) ErrorName() string { return "MinerEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteMinerSetRequestValidationError) Error() string {
+func (e MinerEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1239,14 +3286,14 @@ func (e DeleteMinerSetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteMinerSetRequest.%s: %s%s",
+		"invalid %sMinerEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteMinerSetRequestValidationError{}
+var _ error = MinerEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -1254,48 +3301,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteMinerSetRequestValidationError{}
+} = MinerEventValidationError{}
 
-// Validate checks the field values on ScaleMinerSetRequest with the rules
+// Validate checks the field values on ListChargeRequestRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ScaleMinerSetRequest) Validate() error {
+func (m *ListChargeRequestRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ScaleMinerSetRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListChargeRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ScaleMinerSetRequestMultiError, or nil if none found.
-func (m *ScaleMinerSetRequest) ValidateAll() error {
+// ListChargeRequestRequestMultiError, or nil if none found.
+func (m *ListChargeRequestRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ScaleMinerSetRequest) validate(all bool) error {
+func (m *ListChargeRequestRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for Limit
 
-	// no validation rules for Replicas
+	// no validation rules for Continue
 
 	if len(errors) > 0 {
-		return ScaleMinerSetRequestMultiError(errors)
+		return ListChargeRequestRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ScaleMinerSetRequestMultiError is an error wrapping multiple validation
-// errors returned by ScaleMinerSetRequest.ValidateAll() if the designated
+// ListChargeRequestRequestMultiError is an error wrapping multiple validation
+// errors returned by ListChargeRequestRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ScaleMinerSetRequestMultiError []error
+type ListChargeRequestRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ScaleMinerSetRequestMultiError) Error() string {
+func (m ListChargeRequestRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1304,11 +3351,11 @@ func (m ScaleMinerSetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ScaleMinerSetRequestMultiError) AllErrors() []error { return m }
+func (m ListChargeRequestRequestMultiError) AllErrors() []error { return m }
 
-// ScaleMinerSetRequestValidationError is the validation error returned by
-// ScaleMinerSetRequest.Validate if the designated constraints aren't met.
-type ScaleMinerSetRequestValidationError struct {
+// ListChargeRequestRequestValidationError is the validation error returned by
+// ListChargeRequestRequest.Validate if the designated constraints aren't met.
+type ListChargeRequestRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1316,24 +3363,24 @@ type ScaleMinerSetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ScaleMinerSetRequestValidationError) Field() string { return e.field }
+func (e ListChargeRequestRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ScaleMinerSetRequestValidationError) Reason() string { return e.reason }
+func (e ListChargeRequestRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ScaleMinerSetRequestValidationError) Cause() error { return e.cause }
+func (e ListChargeRequestRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ScaleMinerSetRequestValidationError) Key() bool { return e.key }
+func (e ListChargeRequestRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ScaleMinerSetRequestValidationError) ErrorName() string {
-	return "ScaleMinerSetRequestValidationError"
+func (e ListChargeRequestRequestValidationError) ErrorName() string {
+	return "ListChargeRequestRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ScaleMinerSetRequestValidationError) Error() string {
+func (e ListChargeRequestRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1345,14 +3392,14 @@ func (e ScaleMinerSetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sScaleMinerSetRequest.%s: %s%s",
+		"invalid %sListChargeRequestRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ScaleMinerSetRequestValidationError{}
+var _ error = ListChargeRequestRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1360,112 +3407,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ScaleMinerSetRequestValidationError{}
+} = ListChargeRequestRequestValidationError{}
 
-// Validate checks the field values on Miner with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Miner) Validate() error {
+// Validate checks the field values on ListChargeRequestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListChargeRequestResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Miner with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in MinerMultiError, or nil if none found.
-func (m *Miner) ValidateAll() error {
+// ValidateAll checks the field values on ListChargeRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListChargeRequestResponseMultiError, or nil if none found.
+func (m *ListChargeRequestResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Miner) validate(all bool) error {
+func (m *ListChargeRequestResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
-
-	// no validation rules for DisplayName
-
-	// no validation rules for MinerType
-
-	// no validation rules for ChainName
-
-	// no validation rules for DataDir
-
-	// no validation rules for Status
+	for idx, item := range m.GetChargeRequests() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MinerValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListChargeRequestResponseValidationError{
+						field:  fmt.Sprintf("ChargeRequests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListChargeRequestResponseValidationError{
+						field:  fmt.Sprintf("ChargeRequests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MinerValidationError{
-					field:  "CreatedAt",
+				return ListChargeRequestResponseValidationError{
+					field:  fmt.Sprintf("ChargeRequests[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MinerValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MinerValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MinerValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MinerValidationError{
-				field:  "UpdatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
 	}
 
+	// no validation rules for Continue
+
 	if len(errors) > 0 {
-		return MinerMultiError(errors)
+		return ListChargeRequestResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// MinerMultiError is an error wrapping multiple validation errors returned by
-// Miner.ValidateAll() if the designated constraints aren't met.
-type MinerMultiError []error
+// ListChargeRequestResponseMultiError is an error wrapping multiple validation
+// errors returned by ListChargeRequestResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListChargeRequestResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MinerMultiError) Error() string {
+func (m ListChargeRequestResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1474,11 +3489,11 @@ func (m MinerMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MinerMultiError) AllErrors() []error { return m }
+func (m ListChargeRequestResponseMultiError) AllErrors() []error { return m }
 
-// MinerValidationError is the validation error returned by Miner.Validate if
-// the designated constraints aren't met.
-type MinerValidationError struct {
+// ListChargeRequestResponseValidationError is the validation error returned by
+// ListChargeRequestResponse.Validate if the designated constraints aren't met.
+type ListChargeRequestResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1486,22 +3501,24 @@ type MinerValidationError struct {
 }
 
 // Field function returns field value.
-func (e MinerValidationError) Field() string { return e.field }
+func (e ListChargeRequestResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MinerValidationError) Reason() string { return e.reason }
+func (e ListChargeRequestResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MinerValidationError) Cause() error { return e.cause }
+func (e ListChargeRequestResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MinerValidationError) Key() bool { return e.key }
+func (e ListChargeRequestResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MinerValidationError) ErrorName() string { return "MinerValidationError" }
+func (e ListChargeRequestResponseValidationError) ErrorName() string {
+	return "ListChargeRequestResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MinerValidationError) Error() string {
+func (e ListChargeRequestResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1513,14 +3530,14 @@ func (e MinerValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMiner.%s: %s%s",
+		"invalid %sListChargeRequestResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MinerValidationError{}
+var _ error = ListChargeRequestResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1528,52 +3545,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MinerValidationError{}
+} = ListChargeRequestResponseValidationError{}
 
-// Validate checks the field values on CreateMinerRequest with the rules
+// Validate checks the field values on GetChargeRequestRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateMinerRequest) Validate() error {
+func (m *GetChargeRequestRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateMinerRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetChargeRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateMinerRequestMultiError, or nil if none found.
-func (m *CreateMinerRequest) ValidateAll() error {
+// GetChargeRequestRequestMultiError, or nil if none found.
+func (m *GetChargeRequestRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateMinerRequest) validate(all bool) error {
+func (m *GetChargeRequestRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DisplayName
-
-	// no validation rules for MinerType
-
-	// no validation rules for ChainName
-
-	// no validation rules for DataDir
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return CreateMinerRequestMultiError(errors)
+		return GetChargeRequestRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateMinerRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateMinerRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CreateMinerRequestMultiError []error
+// GetChargeRequestRequestMultiError is an error wrapping multiple validation
+// errors returned by GetChargeRequestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetChargeRequestRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateMinerRequestMultiError) Error() string {
+func (m GetChargeRequestRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1582,11 +3593,11 @@ func (m CreateMinerRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateMinerRequestMultiError) AllErrors() []error { return m }
+func (m GetChargeRequestRequestMultiError) AllErrors() []error { return m }
 
-// CreateMinerRequestValidationError is the validation error returned by
-// CreateMinerRequest.Validate if the designated constraints aren't met.
-type CreateMinerRequestValidationError struct {
+// GetChargeRequestRequestValidationError is the validation error returned by
+// GetChargeRequestRequest.Validate if the designated constraints aren't met.
+type GetChargeRequestRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1594,24 +3605,24 @@ type CreateMinerRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateMinerRequestValidationError) Field() string { return e.field }
+func (e GetChargeRequestRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateMinerRequestValidationError) Reason() string { return e.reason }
+func (e GetChargeRequestRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateMinerRequestValidationError) Cause() error { return e.cause }
+func (e GetChargeRequestRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateMinerRequestValidationError) Key() bool { return e.key }
+func (e GetChargeRequestRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateMinerRequestValidationError) ErrorName() string {
-	return "CreateMinerRequestValidationError"
+func (e GetChargeRequestRequestValidationError) ErrorName() string {
+	return "GetChargeRequestRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateMinerRequestValidationError) Error() string {
+func (e GetChargeRequestRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1623,14 +3634,14 @@ func (e CreateMinerRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateMinerRequest.%s: %s%s",
+		"invalid %sGetChargeRequestRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateMinerRequestValidationError{}
+var _ error = GetChargeRequestRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1638,48 +3649,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateMinerRequestValidationError{}
+} = GetChargeRequestRequestValidationError{}
 
-// Validate checks the field values on ListMinerRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListMinerRequest) Validate() error {
+// Validate checks the field values on ListMinerClassRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMinerClassRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListMinerRequest with the rules
+// ValidateAll checks the field values on ListMinerClassRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListMinerRequestMultiError, or nil if none found.
-func (m *ListMinerRequest) ValidateAll() error {
+// ListMinerClassRequestMultiError, or nil if none found.
+func (m *ListMinerClassRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListMinerRequest) validate(all bool) error {
+func (m *ListMinerClassRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Limit
-
-	// no validation rules for Offset
-
 	if len(errors) > 0 {
-		return ListMinerRequestMultiError(errors)
+		return ListMinerClassRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListMinerRequestMultiError is an error wrapping multiple validation errors
-// returned by ListMinerRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListMinerRequestMultiError []error
+// ListMinerClassRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMinerClassRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMinerClassRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListMinerRequestMultiError) Error() string {
+func (m ListMinerClassRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1688,11 +3695,11 @@ func (m ListMinerRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListMinerRequestMultiError) AllErrors() []error { return m }
+func (m ListMinerClassRequestMultiError) AllErrors() []error { return m }
 
-// ListMinerRequestValidationError is the validation error returned by
-// ListMinerRequest.Validate if the designated constraints aren't met.
-type ListMinerRequestValidationError struct {
+// ListMinerClassRequestValidationError is the validation error returned by
+// ListMinerClassRequest.Validate if the designated constraints aren't met.
+type ListMinerClassRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1700,22 +3707,24 @@ type ListMinerRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListMinerRequestValidationError) Field() string { return e.field }
+func (e ListMinerClassRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListMinerRequestValidationError) Reason() string { return e.reason }
+func (e ListMinerClassRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListMinerRequestValidationError) Cause() error { return e.cause }
+func (e ListMinerClassRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListMinerRequestValidationError) Key() bool { return e.key }
+func (e ListMinerClassRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListMinerRequestValidationError) ErrorName() string { return "ListMinerRequestValidationError" }
+func (e ListMinerClassRequestValidationError) ErrorName() string {
+	return "ListMinerClassRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListMinerRequestValidationError) Error() string {
+func (e ListMinerClassRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1727,14 +3736,14 @@ func (e ListMinerRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListMinerRequest.%s: %s%s",
+		"invalid %sListMinerClassRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListMinerRequestValidationError{}
+var _ error = ListMinerClassRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1742,24 +3751,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListMinerRequestValidationError{}
+} = ListMinerClassRequestValidationError{}
 
-// Validate checks the field values on ListMinerResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListMinerResponse) Validate() error {
+// Validate checks the field values on ListMinerClassResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMinerClassResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListMinerResponse with the rules
+// ValidateAll checks the field values on ListMinerClassResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListMinerResponseMultiError, or nil if none found.
-func (m *ListMinerResponse) ValidateAll() error {
+// ListMinerClassResponseMultiError, or nil if none found.
+func (m *ListMinerClassResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListMinerResponse) validate(all bool) error {
+func (m *ListMinerClassResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1768,23 +3777,23 @@ func (m *ListMinerResponse) validate(all bool) error {
 
 	// no validation rules for TotalCount
 
-	for idx, item := range m.GetMiners() {
+	for idx, item := range m.GetMinerClasses() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListMinerResponseValidationError{
-						field:  fmt.Sprintf("Miners[%v]", idx),
+					errors = append(errors, ListMinerClassResponseValidationError{
+						field:  fmt.Sprintf("MinerClasses[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListMinerResponseValidationError{
-						field:  fmt.Sprintf("Miners[%v]", idx),
+					errors = append(errors, ListMinerClassResponseValidationError{
+						field:  fmt.Sprintf("MinerClasses[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1792,8 +3801,8 @@ func (m *ListMinerResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListMinerResponseValidationError{
-					field:  fmt.Sprintf("Miners[%v]", idx),
+				return ListMinerClassResponseValidationError{
+					field:  fmt.Sprintf("MinerClasses[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1803,19 +3812,19 @@ func (m *ListMinerResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListMinerResponseMultiError(errors)
+		return ListMinerClassResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListMinerResponseMultiError is an error wrapping multiple validation errors
-// returned by ListMinerResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ListMinerResponseMultiError []error
+// ListMinerClassResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMinerClassResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListMinerClassResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListMinerResponseMultiError) Error() string {
+func (m ListMinerClassResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1824,11 +3833,11 @@ func (m ListMinerResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListMinerResponseMultiError) AllErrors() []error { return m }
+func (m ListMinerClassResponseMultiError) AllErrors() []error { return m }
 
-// ListMinerResponseValidationError is the validation error returned by
-// ListMinerResponse.Validate if the designated constraints aren't met.
-type ListMinerResponseValidationError struct {
+// ListMinerClassResponseValidationError is the validation error returned by
+// ListMinerClassResponse.Validate if the designated constraints aren't met.
+type ListMinerClassResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1836,24 +3845,24 @@ type ListMinerResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListMinerResponseValidationError) Field() string { return e.field }
+func (e ListMinerClassResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListMinerResponseValidationError) Reason() string { return e.reason }
+func (e ListMinerClassResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListMinerResponseValidationError) Cause() error { return e.cause }
+func (e ListMinerClassResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListMinerResponseValidationError) Key() bool { return e.key }
+func (e ListMinerClassResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListMinerResponseValidationError) ErrorName() string {
-	return "ListMinerResponseValidationError"
+func (e ListMinerClassResponseValidationError) ErrorName() string {
+	return "ListMinerClassResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListMinerResponseValidationError) Error() string {
+func (e ListMinerClassResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1865,14 +3874,14 @@ func (e ListMinerResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListMinerResponse.%s: %s%s",
+		"invalid %sListMinerClassResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListMinerResponseValidationError{}
+var _ error = ListMinerClassResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1880,46 +3889,96 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListMinerResponseValidationError{}
+} = ListMinerClassResponseValidationError{}
 
-// Validate checks the field values on GetMinerRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetMinerRequest) Validate() error {
+// Validate checks the field values on AuditEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetMinerRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetMinerRequestMultiError, or nil if none found.
-func (m *GetMinerRequest) ValidateAll() error {
+// ValidateAll checks the field values on AuditEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditEventMultiError, or
+// nil if none found.
+func (m *AuditEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetMinerRequest) validate(all bool) error {
+func (m *AuditEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for EventID
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UserID
+
+	// no validation rules for SecretID
+
+	// no validation rules for Operation
+
+	// no validation rules for Resource
+
 	// no validation rules for Name
 
+	// no validation rules for Request
+
+	// no validation rules for Diff
+
+	// no validation rules for Code
+
+	// no validation rules for Reason
+
+	// no validation rules for Message
+
+	// no validation rules for TraceID
+
 	if len(errors) > 0 {
-		return GetMinerRequestMultiError(errors)
+		return AuditEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetMinerRequestMultiError is an error wrapping multiple validation errors
-// returned by GetMinerRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetMinerRequestMultiError []error
+// AuditEventMultiError is an error wrapping multiple validation errors
+// returned by AuditEvent.ValidateAll() if the designated constraints aren't met.
+type AuditEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetMinerRequestMultiError) Error() string {
+func (m AuditEventMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1928,11 +3987,11 @@ func (m GetMinerRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetMinerRequestMultiError) AllErrors() []error { return m }
+func (m AuditEventMultiError) AllErrors() []error { return m }
 
-// GetMinerRequestValidationError is the validation error returned by
-// GetMinerRequest.Validate if the designated constraints aren't met.
-type GetMinerRequestValidationError struct {
+// AuditEventValidationError is the validation error returned by
+// AuditEvent.Validate if the designated constraints aren't met.
+type AuditEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1940,22 +3999,22 @@ type GetMinerRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetMinerRequestValidationError) Field() string { return e.field }
+func (e AuditEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetMinerRequestValidationError) Reason() string { return e.reason }
+func (e AuditEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetMinerRequestValidationError) Cause() error { return e.cause }
+func (e AuditEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetMinerRequestValidationError) Key() bool { return e.key }
+func (e AuditEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetMinerRequestValidationError) ErrorName() string { return "GetMinerRequestValidationError" }
+func (e AuditEventValidationError) ErrorName() string { return "AuditEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetMinerRequestValidationError) Error() string {
+func (e AuditEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1967,14 +4026,14 @@ func (e GetMinerRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetMinerRequest.%s: %s%s",
+		"invalid %sAuditEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetMinerRequestValidationError{}
+var _ error = AuditEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -1982,54 +4041,112 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetMinerRequestValidationError{}
+} = AuditEventValidationError{}
 
-// Validate checks the field values on UpdateMinerRequest with the rules
+// Validate checks the field values on ListAuditEventsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateMinerRequest) Validate() error {
+func (m *ListAuditEventsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateMinerRequest with the rules
+// ValidateAll checks the field values on ListAuditEventsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateMinerRequestMultiError, or nil if none found.
-func (m *UpdateMinerRequest) ValidateAll() error {
+// ListAuditEventsRequestMultiError, or nil if none found.
+func (m *ListAuditEventsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateMinerRequest) validate(all bool) error {
+func (m *ListAuditEventsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	// no validation rules for Operation
+
+	// no validation rules for Resource
+
 	// no validation rules for Name
 
-	if m.DisplayName != nil {
-		// no validation rules for DisplayName
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	if m.DeletePolicy != nil {
-		// no validation rules for DeletePolicy
+	if all {
+		switch v := interface{}(m.GetUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "Until",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return UpdateMinerRequestMultiError(errors)
+		return ListAuditEventsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateMinerRequestMultiError is an error wrapping multiple validation errors
-// returned by UpdateMinerRequest.ValidateAll() if the designated constraints
-// aren't met.
-type UpdateMinerRequestMultiError []error
+// ListAuditEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateMinerRequestMultiError) Error() string {
+func (m ListAuditEventsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2038,11 +4155,11 @@ func (m UpdateMinerRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateMinerRequestMultiError) AllErrors() []error { return m }
+func (m ListAuditEventsRequestMultiError) AllErrors() []error { return m }
 
-// UpdateMinerRequestValidationError is the validation error returned by
-// UpdateMinerRequest.Validate if the designated constraints aren't met.
-type UpdateMinerRequestValidationError struct {
+// ListAuditEventsRequestValidationError is the validation error returned by
+// ListAuditEventsRequest.Validate if the designated constraints aren't met.
+type ListAuditEventsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2050,24 +4167,24 @@ type UpdateMinerRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateMinerRequestValidationError) Field() string { return e.field }
+func (e ListAuditEventsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateMinerRequestValidationError) Reason() string { return e.reason }
+func (e ListAuditEventsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateMinerRequestValidationError) Cause() error { return e.cause }
+func (e ListAuditEventsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateMinerRequestValidationError) Key() bool { return e.key }
+func (e ListAuditEventsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateMinerRequestValidationError) ErrorName() string {
-	return "UpdateMinerRequestValidationError"
+func (e ListAuditEventsRequestValidationError) ErrorName() string {
+	return "ListAuditEventsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateMinerRequestValidationError) Error() string {
+func (e ListAuditEventsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2079,14 +4196,14 @@ func (e UpdateMinerRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateMinerRequest.%s: %s%s",
+		"invalid %sListAuditEventsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateMinerRequestValidationError{}
+var _ error = ListAuditEventsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2094,46 +4211,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateMinerRequestValidationError{}
+} = ListAuditEventsRequestValidationError{}
 
-// Validate checks the field values on DeleteMinerRequest with the rules
+// Validate checks the field values on ListAuditEventsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteMinerRequest) Validate() error {
+func (m *ListAuditEventsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteMinerRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListAuditEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteMinerRequestMultiError, or nil if none found.
-func (m *DeleteMinerRequest) ValidateAll() error {
+// ListAuditEventsResponseMultiError, or nil if none found.
+func (m *ListAuditEventsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteMinerRequest) validate(all bool) error {
+func (m *ListAuditEventsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for TotalCount
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return DeleteMinerRequestMultiError(errors)
+		return ListAuditEventsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteMinerRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteMinerRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteMinerRequestMultiError []error
+// ListAuditEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteMinerRequestMultiError) Error() string {
+func (m ListAuditEventsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2142,11 +4293,11 @@ func (m DeleteMinerRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteMinerRequestMultiError) AllErrors() []error { return m }
+func (m ListAuditEventsResponseMultiError) AllErrors() []error { return m }
 
-// DeleteMinerRequestValidationError is the validation error returned by
-// DeleteMinerRequest.Validate if the designated constraints aren't met.
-type DeleteMinerRequestValidationError struct {
+// ListAuditEventsResponseValidationError is the validation error returned by
+// ListAuditEventsResponse.Validate if the designated constraints aren't met.
+type ListAuditEventsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2154,24 +4305,24 @@ type DeleteMinerRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteMinerRequestValidationError) Field() string { return e.field }
+func (e ListAuditEventsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteMinerRequestValidationError) Reason() string { return e.reason }
+func (e ListAuditEventsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteMinerRequestValidationError) Cause() error { return e.cause }
+func (e ListAuditEventsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteMinerRequestValidationError) Key() bool { return e.key }
+func (e ListAuditEventsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteMinerRequestValidationError) ErrorName() string {
-	return "DeleteMinerRequestValidationError"
+func (e ListAuditEventsResponseValidationError) ErrorName() string {
+	return "ListAuditEventsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteMinerRequestValidationError) Error() string {
+func (e ListAuditEventsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2183,14 +4334,14 @@ func (e DeleteMinerRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteMinerRequest.%s: %s%s",
+		"invalid %sListAuditEventsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteMinerRequestValidationError{}
+var _ error = ListAuditEventsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2198,4 +4349,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteMinerRequestValidationError{}
+} = ListAuditEventsResponseValidationError{}
@@ -27,19 +27,31 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Gateway_GetVersion_FullMethodName         = "/gateway.v1.Gateway/GetVersion"
-	Gateway_GetIdempotentToken_FullMethodName = "/gateway.v1.Gateway/GetIdempotentToken"
-	Gateway_CreateMinerSet_FullMethodName     = "/gateway.v1.Gateway/CreateMinerSet"
-	Gateway_ListMinerSet_FullMethodName       = "/gateway.v1.Gateway/ListMinerSet"
-	Gateway_GetMinerSet_FullMethodName        = "/gateway.v1.Gateway/GetMinerSet"
-	Gateway_UpdateMinerSet_FullMethodName     = "/gateway.v1.Gateway/UpdateMinerSet"
-	Gateway_DeleteMinerSet_FullMethodName     = "/gateway.v1.Gateway/DeleteMinerSet"
-	Gateway_ScaleMinerSet_FullMethodName      = "/gateway.v1.Gateway/ScaleMinerSet"
-	Gateway_CreateMiner_FullMethodName        = "/gateway.v1.Gateway/CreateMiner"
-	Gateway_ListMiner_FullMethodName          = "/gateway.v1.Gateway/ListMiner"
-	Gateway_GetMiner_FullMethodName           = "/gateway.v1.Gateway/GetMiner"
-	Gateway_UpdateMiner_FullMethodName        = "/gateway.v1.Gateway/UpdateMiner"
-	Gateway_DeleteMiner_FullMethodName        = "/gateway.v1.Gateway/DeleteMiner"
+	Gateway_GetVersion_FullMethodName          = "/gateway.v1.Gateway/GetVersion"
+	Gateway_GetIdempotentToken_FullMethodName  = "/gateway.v1.Gateway/GetIdempotentToken"
+	Gateway_CreateChain_FullMethodName         = "/gateway.v1.Gateway/CreateChain"
+	Gateway_ListChain_FullMethodName           = "/gateway.v1.Gateway/ListChain"
+	Gateway_GetChain_FullMethodName            = "/gateway.v1.Gateway/GetChain"
+	Gateway_UpdateChain_FullMethodName         = "/gateway.v1.Gateway/UpdateChain"
+	Gateway_DeleteChain_FullMethodName         = "/gateway.v1.Gateway/DeleteChain"
+	Gateway_CreateMinerSet_FullMethodName      = "/gateway.v1.Gateway/CreateMinerSet"
+	Gateway_ListMinerSet_FullMethodName        = "/gateway.v1.Gateway/ListMinerSet"
+	Gateway_GetMinerSet_FullMethodName         = "/gateway.v1.Gateway/GetMinerSet"
+	Gateway_UpdateMinerSet_FullMethodName      = "/gateway.v1.Gateway/UpdateMinerSet"
+	Gateway_DeleteMinerSet_FullMethodName      = "/gateway.v1.Gateway/DeleteMinerSet"
+	Gateway_ScaleMinerSet_FullMethodName       = "/gateway.v1.Gateway/ScaleMinerSet"
+	Gateway_WatchMinerSet_FullMethodName       = "/gateway.v1.Gateway/WatchMinerSet"
+	Gateway_CreateMiner_FullMethodName         = "/gateway.v1.Gateway/CreateMiner"
+	Gateway_ListMiner_FullMethodName           = "/gateway.v1.Gateway/ListMiner"
+	Gateway_GetMiner_FullMethodName            = "/gateway.v1.Gateway/GetMiner"
+	Gateway_UpdateMiner_FullMethodName         = "/gateway.v1.Gateway/UpdateMiner"
+	Gateway_DeleteMiner_FullMethodName         = "/gateway.v1.Gateway/DeleteMiner"
+	Gateway_WatchMiner_FullMethodName          = "/gateway.v1.Gateway/WatchMiner"
+	Gateway_CreateChargeRequest_FullMethodName = "/gateway.v1.Gateway/CreateChargeRequest"
+	Gateway_ListChargeRequest_FullMethodName   = "/gateway.v1.Gateway/ListChargeRequest"
+	Gateway_GetChargeRequest_FullMethodName    = "/gateway.v1.Gateway/GetChargeRequest"
+	Gateway_ListMinerClass_FullMethodName      = "/gateway.v1.Gateway/ListMinerClass"
+	Gateway_ListAuditEvents_FullMethodName     = "/gateway.v1.Gateway/ListAuditEvents"
 )
 
 // GatewayClient is the client API for Gateway service.
@@ -50,6 +62,16 @@ type GatewayClient interface {
 	GetVersion(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetVersionResponse, error)
 	// GetIdempotentToken
 	GetIdempotentToken(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*IdempotentResponse, error)
+	// CreateChain
+	CreateChain(ctx context.Context, in *v1beta1.Chain, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListChain
+	ListChain(ctx context.Context, in *ListChainRequest, opts ...grpc.CallOption) (*ListChainResponse, error)
+	// GetChain
+	GetChain(ctx context.Context, in *GetChainRequest, opts ...grpc.CallOption) (*Chain, error)
+	// UpdateChain
+	UpdateChain(ctx context.Context, in *v1beta1.Chain, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DeleteChain
+	DeleteChain(ctx context.Context, in *DeleteChainRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// CreateMinerSet
 	CreateMinerSet(ctx context.Context, in *v1beta1.MinerSet, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ListMinerSet
@@ -62,6 +84,9 @@ type GatewayClient interface {
 	DeleteMinerSet(ctx context.Context, in *DeleteMinerSetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ScaleMinerSet
 	ScaleMinerSet(ctx context.Context, in *ScaleMinerSetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// WatchMinerSet streams the add, update and delete events of the minersets of the user.
+	// It is served over HTTP as server-sent events at `GET /v1/minersets/watch`.
+	WatchMinerSet(ctx context.Context, in *WatchMinerSetRequest, opts ...grpc.CallOption) (Gateway_WatchMinerSetClient, error)
 	// CreateMiner
 	CreateMiner(ctx context.Context, in *v1beta1.Miner, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ListMiner
@@ -72,6 +97,22 @@ type GatewayClient interface {
 	UpdateMiner(ctx context.Context, in *v1beta1.Miner, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// DeleteMiner
 	DeleteMiner(ctx context.Context, in *DeleteMinerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// WatchMiner streams the add, update and delete events of the miners of the user.
+	// It is served over HTTP as server-sent events at `GET /v1/miners/watch`.
+	WatchMiner(ctx context.Context, in *WatchMinerRequest, opts ...grpc.CallOption) (Gateway_WatchMinerClient, error)
+	// CreateChargeRequest charges the account of the user on a chain. The password of the account
+	// is sealed by onex-apiserver, and cleared once the charge is submitted to the chain.
+	CreateChargeRequest(ctx context.Context, in *v1beta1.ChargeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListChargeRequest
+	ListChargeRequest(ctx context.Context, in *ListChargeRequestRequest, opts ...grpc.CallOption) (*ListChargeRequestResponse, error)
+	// GetChargeRequest returns the charge request, whose status tracks the transaction of the charge.
+	GetChargeRequest(ctx context.Context, in *GetChargeRequestRequest, opts ...grpc.CallOption) (*v1beta1.ChargeRequest, error)
+	// ListMinerClass lists the miner classes, which are the miner types to choose from
+	// when creating miners and minersets.
+	ListMinerClass(ctx context.Context, in *ListMinerClassRequest, opts ...grpc.CallOption) (*ListMinerClassResponse, error)
+	// ListAuditEvents lists the audit events of the mutating operations of the user,
+	// from the newest to the oldest.
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
 }
 
 type gatewayClient struct {
@@ -100,6 +141,51 @@ func (c *gatewayClient) GetIdempotentToken(ctx context.Context, in *emptypb.Empt
 	return out, nil
 }
 
+func (c *gatewayClient) CreateChain(ctx context.Context, in *v1beta1.Chain, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Gateway_CreateChain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) ListChain(ctx context.Context, in *ListChainRequest, opts ...grpc.CallOption) (*ListChainResponse, error) {
+	out := new(ListChainResponse)
+	err := c.cc.Invoke(ctx, Gateway_ListChain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) GetChain(ctx context.Context, in *GetChainRequest, opts ...grpc.CallOption) (*Chain, error) {
+	out := new(Chain)
+	err := c.cc.Invoke(ctx, Gateway_GetChain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) UpdateChain(ctx context.Context, in *v1beta1.Chain, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Gateway_UpdateChain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) DeleteChain(ctx context.Context, in *DeleteChainRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Gateway_DeleteChain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gatewayClient) CreateMinerSet(ctx context.Context, in *v1beta1.MinerSet, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Gateway_CreateMinerSet_FullMethodName, in, out, opts...)
@@ -154,6 +240,38 @@ func (c *gatewayClient) ScaleMinerSet(ctx context.Context, in *ScaleMinerSetRequ
 	return out, nil
 }
 
+func (c *gatewayClient) WatchMinerSet(ctx context.Context, in *WatchMinerSetRequest, opts ...grpc.CallOption) (Gateway_WatchMinerSetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Gateway_ServiceDesc.Streams[0], Gateway_WatchMinerSet_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gatewayWatchMinerSetClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Gateway_WatchMinerSetClient interface {
+	Recv() (*MinerSetEvent, error)
+	grpc.ClientStream
+}
+
+type gatewayWatchMinerSetClient struct {
+	grpc.ClientStream
+}
+
+func (x *gatewayWatchMinerSetClient) Recv() (*MinerSetEvent, error) {
+	m := new(MinerSetEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *gatewayClient) CreateMiner(ctx context.Context, in *v1beta1.Miner, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Gateway_CreateMiner_FullMethodName, in, out, opts...)
@@ -199,6 +317,83 @@ func (c *gatewayClient) DeleteMiner(ctx context.Context, in *DeleteMinerRequest,
 	return out, nil
 }
 
+func (c *gatewayClient) WatchMiner(ctx context.Context, in *WatchMinerRequest, opts ...grpc.CallOption) (Gateway_WatchMinerClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Gateway_ServiceDesc.Streams[1], Gateway_WatchMiner_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gatewayWatchMinerClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Gateway_WatchMinerClient interface {
+	Recv() (*MinerEvent, error)
+	grpc.ClientStream
+}
+
+type gatewayWatchMinerClient struct {
+	grpc.ClientStream
+}
+
+func (x *gatewayWatchMinerClient) Recv() (*MinerEvent, error) {
+	m := new(MinerEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gatewayClient) CreateChargeRequest(ctx context.Context, in *v1beta1.ChargeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Gateway_CreateChargeRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) ListChargeRequest(ctx context.Context, in *ListChargeRequestRequest, opts ...grpc.CallOption) (*ListChargeRequestResponse, error) {
+	out := new(ListChargeRequestResponse)
+	err := c.cc.Invoke(ctx, Gateway_ListChargeRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) GetChargeRequest(ctx context.Context, in *GetChargeRequestRequest, opts ...grpc.CallOption) (*v1beta1.ChargeRequest, error) {
+	out := new(v1beta1.ChargeRequest)
+	err := c.cc.Invoke(ctx, Gateway_GetChargeRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) ListMinerClass(ctx context.Context, in *ListMinerClassRequest, opts ...grpc.CallOption) (*ListMinerClassResponse, error) {
+	out := new(ListMinerClassResponse)
+	err := c.cc.Invoke(ctx, Gateway_ListMinerClass_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, Gateway_ListAuditEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GatewayServer is the server API for Gateway service.
 // All implementations must embed UnimplementedGatewayServer
 // for forward compatibility
@@ -207,6 +402,16 @@ type GatewayServer interface {
 	GetVersion(context.Context, *emptypb.Empty) (*GetVersionResponse, error)
 	// GetIdempotentToken
 	GetIdempotentToken(context.Context, *emptypb.Empty) (*IdempotentResponse, error)
+	// CreateChain
+	CreateChain(context.Context, *v1beta1.Chain) (*emptypb.Empty, error)
+	// ListChain
+	ListChain(context.Context, *ListChainRequest) (*ListChainResponse, error)
+	// GetChain
+	GetChain(context.Context, *GetChainRequest) (*Chain, error)
+	// UpdateChain
+	UpdateChain(context.Context, *v1beta1.Chain) (*emptypb.Empty, error)
+	// DeleteChain
+	DeleteChain(context.Context, *DeleteChainRequest) (*emptypb.Empty, error)
 	// CreateMinerSet
 	CreateMinerSet(context.Context, *v1beta1.MinerSet) (*emptypb.Empty, error)
 	// ListMinerSet
@@ -219,6 +424,9 @@ type GatewayServer interface {
 	DeleteMinerSet(context.Context, *DeleteMinerSetRequest) (*emptypb.Empty, error)
 	// ScaleMinerSet
 	ScaleMinerSet(context.Context, *ScaleMinerSetRequest) (*emptypb.Empty, error)
+	// WatchMinerSet streams the add, update and delete events of the minersets of the user.
+	// It is served over HTTP as server-sent events at `GET /v1/minersets/watch`.
+	WatchMinerSet(*WatchMinerSetRequest, Gateway_WatchMinerSetServer) error
 	// CreateMiner
 	CreateMiner(context.Context, *v1beta1.Miner) (*emptypb.Empty, error)
 	// ListMiner
@@ -229,6 +437,22 @@ type GatewayServer interface {
 	UpdateMiner(context.Context, *v1beta1.Miner) (*emptypb.Empty, error)
 	// DeleteMiner
 	DeleteMiner(context.Context, *DeleteMinerRequest) (*emptypb.Empty, error)
+	// WatchMiner streams the add, update and delete events of the miners of the user.
+	// It is served over HTTP as server-sent events at `GET /v1/miners/watch`.
+	WatchMiner(*WatchMinerRequest, Gateway_WatchMinerServer) error
+	// CreateChargeRequest charges the account of the user on a chain. The password of the account
+	// is sealed by onex-apiserver, and cleared once the charge is submitted to the chain.
+	CreateChargeRequest(context.Context, *v1beta1.ChargeRequest) (*emptypb.Empty, error)
+	// ListChargeRequest
+	ListChargeRequest(context.Context, *ListChargeRequestRequest) (*ListChargeRequestResponse, error)
+	// GetChargeRequest returns the charge request, whose status tracks the transaction of the charge.
+	GetChargeRequest(context.Context, *GetChargeRequestRequest) (*v1beta1.ChargeRequest, error)
+	// ListMinerClass lists the miner classes, which are the miner types to choose from
+	// when creating miners and minersets.
+	ListMinerClass(context.Context, *ListMinerClassRequest) (*ListMinerClassResponse, error)
+	// ListAuditEvents lists the audit events of the mutating operations of the user,
+	// from the newest to the oldest.
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
 	mustEmbedUnimplementedGatewayServer()
 }
 
@@ -242,6 +466,21 @@ func (UnimplementedGatewayServer) GetVersion(context.Context, *emptypb.Empty) (*
 func (UnimplementedGatewayServer) GetIdempotentToken(context.Context, *emptypb.Empty) (*IdempotentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetIdempotentToken not implemented")
 }
+func (UnimplementedGatewayServer) CreateChain(context.Context, *v1beta1.Chain) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChain not implemented")
+}
+func (UnimplementedGatewayServer) ListChain(context.Context, *ListChainRequest) (*ListChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChain not implemented")
+}
+func (UnimplementedGatewayServer) GetChain(context.Context, *GetChainRequest) (*Chain, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChain not implemented")
+}
+func (UnimplementedGatewayServer) UpdateChain(context.Context, *v1beta1.Chain) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChain not implemented")
+}
+func (UnimplementedGatewayServer) DeleteChain(context.Context, *DeleteChainRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChain not implemented")
+}
 func (UnimplementedGatewayServer) CreateMinerSet(context.Context, *v1beta1.MinerSet) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMinerSet not implemented")
 }
@@ -260,6 +499,9 @@ func (UnimplementedGatewayServer) DeleteMinerSet(context.Context, *DeleteMinerSe
 func (UnimplementedGatewayServer) ScaleMinerSet(context.Context, *ScaleMinerSetRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ScaleMinerSet not implemented")
 }
+func (UnimplementedGatewayServer) WatchMinerSet(*WatchMinerSetRequest, Gateway_WatchMinerSetServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMinerSet not implemented")
+}
 func (UnimplementedGatewayServer) CreateMiner(context.Context, *v1beta1.Miner) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMiner not implemented")
 }
@@ -275,6 +517,24 @@ func (UnimplementedGatewayServer) UpdateMiner(context.Context, *v1beta1.Miner) (
 func (UnimplementedGatewayServer) DeleteMiner(context.Context, *DeleteMinerRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMiner not implemented")
 }
+func (UnimplementedGatewayServer) WatchMiner(*WatchMinerRequest, Gateway_WatchMinerServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMiner not implemented")
+}
+func (UnimplementedGatewayServer) CreateChargeRequest(context.Context, *v1beta1.ChargeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChargeRequest not implemented")
+}
+func (UnimplementedGatewayServer) ListChargeRequest(context.Context, *ListChargeRequestRequest) (*ListChargeRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChargeRequest not implemented")
+}
+func (UnimplementedGatewayServer) GetChargeRequest(context.Context, *GetChargeRequestRequest) (*v1beta1.ChargeRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChargeRequest not implemented")
+}
+func (UnimplementedGatewayServer) ListMinerClass(context.Context, *ListMinerClassRequest) (*ListMinerClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMinerClass not implemented")
+}
+func (UnimplementedGatewayServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedGatewayServer) mustEmbedUnimplementedGatewayServer() {}
 
 // UnsafeGatewayServer may be embedded to opt out of forward compatibility for this service.
@@ -324,6 +584,96 @@ func _Gateway_GetIdempotentToken_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Gateway_CreateChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1beta1.Chain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).CreateChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_CreateChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).CreateChain(ctx, req.(*v1beta1.Chain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_ListChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).ListChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_ListChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).ListChain(ctx, req.(*ListChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetChain(ctx, req.(*GetChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_UpdateChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1beta1.Chain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).UpdateChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_UpdateChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).UpdateChain(ctx, req.(*v1beta1.Chain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_DeleteChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).DeleteChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_DeleteChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).DeleteChain(ctx, req.(*DeleteChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Gateway_CreateMinerSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1beta1.MinerSet)
 	if err := dec(in); err != nil {
@@ -432,6 +782,27 @@ func _Gateway_ScaleMinerSet_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Gateway_WatchMinerSet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMinerSetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GatewayServer).WatchMinerSet(m, &gatewayWatchMinerSetServer{stream})
+}
+
+type Gateway_WatchMinerSetServer interface {
+	Send(*MinerSetEvent) error
+	grpc.ServerStream
+}
+
+type gatewayWatchMinerSetServer struct {
+	grpc.ServerStream
+}
+
+func (x *gatewayWatchMinerSetServer) Send(m *MinerSetEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Gateway_CreateMiner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1beta1.Miner)
 	if err := dec(in); err != nil {
@@ -522,6 +893,117 @@ func _Gateway_DeleteMiner_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Gateway_WatchMiner_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMinerRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GatewayServer).WatchMiner(m, &gatewayWatchMinerServer{stream})
+}
+
+type Gateway_WatchMinerServer interface {
+	Send(*MinerEvent) error
+	grpc.ServerStream
+}
+
+type gatewayWatchMinerServer struct {
+	grpc.ServerStream
+}
+
+func (x *gatewayWatchMinerServer) Send(m *MinerEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Gateway_CreateChargeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1beta1.ChargeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).CreateChargeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_CreateChargeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).CreateChargeRequest(ctx, req.(*v1beta1.ChargeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_ListChargeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChargeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).ListChargeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_ListChargeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).ListChargeRequest(ctx, req.(*ListChargeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetChargeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChargeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetChargeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetChargeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetChargeRequest(ctx, req.(*GetChargeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_ListMinerClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMinerClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).ListMinerClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_ListMinerClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).ListMinerClass(ctx, req.(*ListMinerClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Gateway_ServiceDesc is the grpc.ServiceDesc for Gateway service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -537,6 +1019,26 @@ var Gateway_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetIdempotentToken",
 			Handler:    _Gateway_GetIdempotentToken_Handler,
 		},
+		{
+			MethodName: "CreateChain",
+			Handler:    _Gateway_CreateChain_Handler,
+		},
+		{
+			MethodName: "ListChain",
+			Handler:    _Gateway_ListChain_Handler,
+		},
+		{
+			MethodName: "GetChain",
+			Handler:    _Gateway_GetChain_Handler,
+		},
+		{
+			MethodName: "UpdateChain",
+			Handler:    _Gateway_UpdateChain_Handler,
+		},
+		{
+			MethodName: "DeleteChain",
+			Handler:    _Gateway_DeleteChain_Handler,
+		},
 		{
 			MethodName: "CreateMinerSet",
 			Handler:    _Gateway_CreateMinerSet_Handler,
@@ -581,7 +1083,38 @@ var Gateway_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMiner",
 			Handler:    _Gateway_DeleteMiner_Handler,
 		},
+		{
+			MethodName: "CreateChargeRequest",
+			Handler:    _Gateway_CreateChargeRequest_Handler,
+		},
+		{
+			MethodName: "ListChargeRequest",
+			Handler:    _Gateway_ListChargeRequest_Handler,
+		},
+		{
+			MethodName: "GetChargeRequest",
+			Handler:    _Gateway_GetChargeRequest_Handler,
+		},
+		{
+			MethodName: "ListMinerClass",
+			Handler:    _Gateway_ListMinerClass_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Gateway_ListAuditEvents_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchMinerSet",
+			Handler:       _Gateway_WatchMinerSet_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchMiner",
+			Handler:       _Gateway_WatchMiner_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "gateway/v1/gateway.proto",
 }
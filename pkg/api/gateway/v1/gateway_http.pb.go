@@ -21,29 +21,50 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationGatewayCreateChain = "/gateway.v1.Gateway/CreateChain"
+const OperationGatewayCreateChargeRequest = "/gateway.v1.Gateway/CreateChargeRequest"
 const OperationGatewayCreateMiner = "/gateway.v1.Gateway/CreateMiner"
 const OperationGatewayCreateMinerSet = "/gateway.v1.Gateway/CreateMinerSet"
+const OperationGatewayDeleteChain = "/gateway.v1.Gateway/DeleteChain"
 const OperationGatewayDeleteMiner = "/gateway.v1.Gateway/DeleteMiner"
 const OperationGatewayDeleteMinerSet = "/gateway.v1.Gateway/DeleteMinerSet"
+const OperationGatewayGetChain = "/gateway.v1.Gateway/GetChain"
+const OperationGatewayGetChargeRequest = "/gateway.v1.Gateway/GetChargeRequest"
 const OperationGatewayGetIdempotentToken = "/gateway.v1.Gateway/GetIdempotentToken"
 const OperationGatewayGetMiner = "/gateway.v1.Gateway/GetMiner"
 const OperationGatewayGetMinerSet = "/gateway.v1.Gateway/GetMinerSet"
 const OperationGatewayGetVersion = "/gateway.v1.Gateway/GetVersion"
+const OperationGatewayListAuditEvents = "/gateway.v1.Gateway/ListAuditEvents"
+const OperationGatewayListChain = "/gateway.v1.Gateway/ListChain"
+const OperationGatewayListChargeRequest = "/gateway.v1.Gateway/ListChargeRequest"
 const OperationGatewayListMiner = "/gateway.v1.Gateway/ListMiner"
+const OperationGatewayListMinerClass = "/gateway.v1.Gateway/ListMinerClass"
 const OperationGatewayListMinerSet = "/gateway.v1.Gateway/ListMinerSet"
 const OperationGatewayScaleMinerSet = "/gateway.v1.Gateway/ScaleMinerSet"
+const OperationGatewayUpdateChain = "/gateway.v1.Gateway/UpdateChain"
 const OperationGatewayUpdateMiner = "/gateway.v1.Gateway/UpdateMiner"
 const OperationGatewayUpdateMinerSet = "/gateway.v1.Gateway/UpdateMinerSet"
 
 type GatewayHTTPServer interface {
+	// CreateChain CreateChain
+	CreateChain(context.Context, *v1beta1.Chain) (*emptypb.Empty, error)
+	// CreateChargeRequest CreateChargeRequest charges the account of the user on a chain. The password of the account
+	// is sealed by onex-apiserver, and cleared once the charge is submitted to the chain.
+	CreateChargeRequest(context.Context, *v1beta1.ChargeRequest) (*emptypb.Empty, error)
 	// CreateMiner CreateMiner
 	CreateMiner(context.Context, *v1beta1.Miner) (*emptypb.Empty, error)
 	// CreateMinerSet CreateMinerSet
 	CreateMinerSet(context.Context, *v1beta1.MinerSet) (*emptypb.Empty, error)
+	// DeleteChain DeleteChain
+	DeleteChain(context.Context, *DeleteChainRequest) (*emptypb.Empty, error)
 	// DeleteMiner DeleteMiner
 	DeleteMiner(context.Context, *DeleteMinerRequest) (*emptypb.Empty, error)
 	// DeleteMinerSet DeleteMinerSet
 	DeleteMinerSet(context.Context, *DeleteMinerSetRequest) (*emptypb.Empty, error)
+	// GetChain GetChain
+	GetChain(context.Context, *GetChainRequest) (*Chain, error)
+	// GetChargeRequest GetChargeRequest returns the charge request, whose status tracks the transaction of the charge.
+	GetChargeRequest(context.Context, *GetChargeRequestRequest) (*v1beta1.ChargeRequest, error)
 	// GetIdempotentToken GetIdempotentToken
 	GetIdempotentToken(context.Context, *emptypb.Empty) (*IdempotentResponse, error)
 	// GetMiner GetMiner
@@ -52,12 +73,24 @@ type GatewayHTTPServer interface {
 	GetMinerSet(context.Context, *GetMinerSetRequest) (*v1beta1.MinerSet, error)
 	// GetVersion GetVersion
 	GetVersion(context.Context, *emptypb.Empty) (*GetVersionResponse, error)
+	// ListAuditEvents ListAuditEvents lists the audit events of the mutating operations of the user,
+	// from the newest to the oldest.
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// ListChain ListChain
+	ListChain(context.Context, *ListChainRequest) (*ListChainResponse, error)
+	// ListChargeRequest ListChargeRequest
+	ListChargeRequest(context.Context, *ListChargeRequestRequest) (*ListChargeRequestResponse, error)
 	// ListMiner ListMiner
 	ListMiner(context.Context, *ListMinerRequest) (*ListMinerResponse, error)
+	// ListMinerClass ListMinerClass lists the miner classes, which are the miner types to choose from
+	// when creating miners and minersets.
+	ListMinerClass(context.Context, *ListMinerClassRequest) (*ListMinerClassResponse, error)
 	// ListMinerSet ListMinerSet
 	ListMinerSet(context.Context, *ListMinerSetRequest) (*ListMinerSetResponse, error)
 	// ScaleMinerSet ScaleMinerSet
 	ScaleMinerSet(context.Context, *ScaleMinerSetRequest) (*emptypb.Empty, error)
+	// UpdateChain UpdateChain
+	UpdateChain(context.Context, *v1beta1.Chain) (*emptypb.Empty, error)
 	// UpdateMiner UpdateMiner
 	UpdateMiner(context.Context, *v1beta1.Miner) (*emptypb.Empty, error)
 	// UpdateMinerSet UpdateMinerSet
@@ -68,6 +101,11 @@ func RegisterGatewayHTTPServer(s *http.Server, srv GatewayHTTPServer) {
 	r := s.Route("/")
 	r.GET("/version", _Gateway_GetVersion0_HTTP_Handler(srv))
 	r.GET("/v1/idempotents", _Gateway_GetIdempotentToken0_HTTP_Handler(srv))
+	r.POST("/v1/chains", _Gateway_CreateChain0_HTTP_Handler(srv))
+	r.GET("/v1/chains", _Gateway_ListChain0_HTTP_Handler(srv))
+	r.GET("/v1/chains/{name}", _Gateway_GetChain0_HTTP_Handler(srv))
+	r.PUT("/v1/chains", _Gateway_UpdateChain0_HTTP_Handler(srv))
+	r.DELETE("/v1/chains/{name}", _Gateway_DeleteChain0_HTTP_Handler(srv))
 	r.POST("/v1/minersets", _Gateway_CreateMinerSet0_HTTP_Handler(srv))
 	r.GET("/v1/minersets", _Gateway_ListMinerSet0_HTTP_Handler(srv))
 	r.GET("/v1/minersets/{name}", _Gateway_GetMinerSet0_HTTP_Handler(srv))
@@ -79,6 +117,11 @@ func RegisterGatewayHTTPServer(s *http.Server, srv GatewayHTTPServer) {
 	r.GET("/v1/miners/{name}", _Gateway_GetMiner0_HTTP_Handler(srv))
 	r.PUT("/v1/miners", _Gateway_UpdateMiner0_HTTP_Handler(srv))
 	r.DELETE("/v1/miners/{name}", _Gateway_DeleteMiner0_HTTP_Handler(srv))
+	r.POST("/v1/chargerequests", _Gateway_CreateChargeRequest0_HTTP_Handler(srv))
+	r.GET("/v1/chargerequests", _Gateway_ListChargeRequest0_HTTP_Handler(srv))
+	r.GET("/v1/chargerequests/{name}", _Gateway_GetChargeRequest0_HTTP_Handler(srv))
+	r.GET("/v1/minerclasses", _Gateway_ListMinerClass0_HTTP_Handler(srv))
+	r.GET("/v1/auditevents", _Gateway_ListAuditEvents0_HTTP_Handler(srv))
 }
 
 func _Gateway_GetVersion0_HTTP_Handler(srv GatewayHTTPServer) func(ctx http.Context) error {
@@ -119,6 +162,113 @@ func _Gateway_GetIdempotentToken0_HTTP_Handler(srv GatewayHTTPServer) func(ctx h
 	}
 }
 
+func _Gateway_CreateChain0_HTTP_Handler(srv GatewayHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1beta1.Chain
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGatewayCreateChain)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateChain(ctx, req.(*v1beta1.Chain))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Gateway_ListChain0_HTTP_Handler(srv GatewayHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListChainRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGatewayListChain)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListChain(ctx, req.(*ListChainRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListChainResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Gateway_GetChain0_HTTP_Handler(srv GatewayHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetChainRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGatewayGetChain)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetChain(ctx, req.(*GetChainRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Chain)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Gateway_UpdateChain0_HTTP_Handler(srv GatewayHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1beta1.Chain
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGatewayUpdateChain)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateChain(ctx, req.(*v1beta1.Chain))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Gateway_DeleteChain0_HTTP_Handler(srv GatewayHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteChainRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGatewayDeleteChain)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteChain(ctx, req.(*DeleteChainRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Gateway_CreateMinerSet0_HTTP_Handler(srv GatewayHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v1beta1.MinerSet
@@ -358,18 +508,129 @@ func _Gateway_DeleteMiner0_HTTP_Handler(srv GatewayHTTPServer) func(ctx http.Con
 	}
 }
 
+func _Gateway_CreateChargeRequest0_HTTP_Handler(srv GatewayHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1beta1.ChargeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGatewayCreateChargeRequest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateChargeRequest(ctx, req.(*v1beta1.ChargeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Gateway_ListChargeRequest0_HTTP_Handler(srv GatewayHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListChargeRequestRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGatewayListChargeRequest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListChargeRequest(ctx, req.(*ListChargeRequestRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListChargeRequestResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Gateway_GetChargeRequest0_HTTP_Handler(srv GatewayHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetChargeRequestRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGatewayGetChargeRequest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetChargeRequest(ctx, req.(*GetChargeRequestRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1beta1.ChargeRequest)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Gateway_ListMinerClass0_HTTP_Handler(srv GatewayHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListMinerClassRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGatewayListMinerClass)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMinerClass(ctx, req.(*ListMinerClassRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListMinerClassResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Gateway_ListAuditEvents0_HTTP_Handler(srv GatewayHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAuditEventsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGatewayListAuditEvents)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAuditEventsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type GatewayHTTPClient interface {
+	CreateChain(ctx context.Context, req *v1beta1.Chain, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	CreateChargeRequest(ctx context.Context, req *v1beta1.ChargeRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	CreateMiner(ctx context.Context, req *v1beta1.Miner, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	CreateMinerSet(ctx context.Context, req *v1beta1.MinerSet, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	DeleteChain(ctx context.Context, req *DeleteChainRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	DeleteMiner(ctx context.Context, req *DeleteMinerRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	DeleteMinerSet(ctx context.Context, req *DeleteMinerSetRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	GetChain(ctx context.Context, req *GetChainRequest, opts ...http.CallOption) (rsp *Chain, err error)
+	GetChargeRequest(ctx context.Context, req *GetChargeRequestRequest, opts ...http.CallOption) (rsp *v1beta1.ChargeRequest, err error)
 	GetIdempotentToken(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *IdempotentResponse, err error)
 	GetMiner(ctx context.Context, req *GetMinerRequest, opts ...http.CallOption) (rsp *v1beta1.Miner, err error)
 	GetMinerSet(ctx context.Context, req *GetMinerSetRequest, opts ...http.CallOption) (rsp *v1beta1.MinerSet, err error)
 	GetVersion(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetVersionResponse, err error)
+	ListAuditEvents(ctx context.Context, req *ListAuditEventsRequest, opts ...http.CallOption) (rsp *ListAuditEventsResponse, err error)
+	ListChain(ctx context.Context, req *ListChainRequest, opts ...http.CallOption) (rsp *ListChainResponse, err error)
+	ListChargeRequest(ctx context.Context, req *ListChargeRequestRequest, opts ...http.CallOption) (rsp *ListChargeRequestResponse, err error)
 	ListMiner(ctx context.Context, req *ListMinerRequest, opts ...http.CallOption) (rsp *ListMinerResponse, err error)
+	ListMinerClass(ctx context.Context, req *ListMinerClassRequest, opts ...http.CallOption) (rsp *ListMinerClassResponse, err error)
 	ListMinerSet(ctx context.Context, req *ListMinerSetRequest, opts ...http.CallOption) (rsp *ListMinerSetResponse, err error)
 	ScaleMinerSet(ctx context.Context, req *ScaleMinerSetRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	UpdateChain(ctx context.Context, req *v1beta1.Chain, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	UpdateMiner(ctx context.Context, req *v1beta1.Miner, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	UpdateMinerSet(ctx context.Context, req *v1beta1.MinerSet, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 }
@@ -382,6 +643,32 @@ func NewGatewayHTTPClient(client *http.Client) GatewayHTTPClient {
 	return &GatewayHTTPClientImpl{client}
 }
 
+func (c *GatewayHTTPClientImpl) CreateChain(ctx context.Context, in *v1beta1.Chain, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/chains"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGatewayCreateChain))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *GatewayHTTPClientImpl) CreateChargeRequest(ctx context.Context, in *v1beta1.ChargeRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/chargerequests"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGatewayCreateChargeRequest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *GatewayHTTPClientImpl) CreateMiner(ctx context.Context, in *v1beta1.Miner, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/v1/miners"
@@ -408,6 +695,19 @@ func (c *GatewayHTTPClientImpl) CreateMinerSet(ctx context.Context, in *v1beta1.
 	return &out, err
 }
 
+func (c *GatewayHTTPClientImpl) DeleteChain(ctx context.Context, in *DeleteChainRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/chains/{name}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGatewayDeleteChain))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *GatewayHTTPClientImpl) DeleteMiner(ctx context.Context, in *DeleteMinerRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/v1/miners/{name}"
@@ -434,6 +734,32 @@ func (c *GatewayHTTPClientImpl) DeleteMinerSet(ctx context.Context, in *DeleteMi
 	return &out, err
 }
 
+func (c *GatewayHTTPClientImpl) GetChain(ctx context.Context, in *GetChainRequest, opts ...http.CallOption) (*Chain, error) {
+	var out Chain
+	pattern := "/v1/chains/{name}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGatewayGetChain))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *GatewayHTTPClientImpl) GetChargeRequest(ctx context.Context, in *GetChargeRequestRequest, opts ...http.CallOption) (*v1beta1.ChargeRequest, error) {
+	var out v1beta1.ChargeRequest
+	pattern := "/v1/chargerequests/{name}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGatewayGetChargeRequest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *GatewayHTTPClientImpl) GetIdempotentToken(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*IdempotentResponse, error) {
 	var out IdempotentResponse
 	pattern := "/v1/idempotents"
@@ -486,6 +812,45 @@ func (c *GatewayHTTPClientImpl) GetVersion(ctx context.Context, in *emptypb.Empt
 	return &out, err
 }
 
+func (c *GatewayHTTPClientImpl) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...http.CallOption) (*ListAuditEventsResponse, error) {
+	var out ListAuditEventsResponse
+	pattern := "/v1/auditevents"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGatewayListAuditEvents))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *GatewayHTTPClientImpl) ListChain(ctx context.Context, in *ListChainRequest, opts ...http.CallOption) (*ListChainResponse, error) {
+	var out ListChainResponse
+	pattern := "/v1/chains"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGatewayListChain))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *GatewayHTTPClientImpl) ListChargeRequest(ctx context.Context, in *ListChargeRequestRequest, opts ...http.CallOption) (*ListChargeRequestResponse, error) {
+	var out ListChargeRequestResponse
+	pattern := "/v1/chargerequests"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGatewayListChargeRequest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *GatewayHTTPClientImpl) ListMiner(ctx context.Context, in *ListMinerRequest, opts ...http.CallOption) (*ListMinerResponse, error) {
 	var out ListMinerResponse
 	pattern := "/v1/miners"
@@ -499,6 +864,19 @@ func (c *GatewayHTTPClientImpl) ListMiner(ctx context.Context, in *ListMinerRequ
 	return &out, err
 }
 
+func (c *GatewayHTTPClientImpl) ListMinerClass(ctx context.Context, in *ListMinerClassRequest, opts ...http.CallOption) (*ListMinerClassResponse, error) {
+	var out ListMinerClassResponse
+	pattern := "/v1/minerclasses"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGatewayListMinerClass))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *GatewayHTTPClientImpl) ListMinerSet(ctx context.Context, in *ListMinerSetRequest, opts ...http.CallOption) (*ListMinerSetResponse, error) {
 	var out ListMinerSetResponse
 	pattern := "/v1/minersets"
@@ -525,6 +903,19 @@ func (c *GatewayHTTPClientImpl) ScaleMinerSet(ctx context.Context, in *ScaleMine
 	return &out, err
 }
 
+func (c *GatewayHTTPClientImpl) UpdateChain(ctx context.Context, in *v1beta1.Chain, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/chains"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGatewayUpdateChain))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *GatewayHTTPClientImpl) UpdateMiner(ctx context.Context, in *v1beta1.Miner, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/v1/miners"
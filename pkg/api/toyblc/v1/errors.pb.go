@@ -32,15 +32,27 @@ type ErrorReason int32
 const (
 	// 页面未找到错误，请求的页面不存在
 	ErrorReason_PageNotFound ErrorReason = 0
+	// 交易无效，可能是签名、余额或交易序号校验未通过
+	ErrorReason_TransactionInvalid ErrorReason = 1
+	// 交易已存在，无法重复提交
+	ErrorReason_TransactionAlreadyExists ErrorReason = 2
+	// 区块未找到，请求的区块高度或哈希不存在
+	ErrorReason_BlockNotFound ErrorReason = 3
 )
 
 // Enum value maps for ErrorReason.
 var (
 	ErrorReason_name = map[int32]string{
 		0: "PageNotFound",
+		1: "TransactionInvalid",
+		2: "TransactionAlreadyExists",
+		3: "BlockNotFound",
 	}
 	ErrorReason_value = map[string]int32{
-		"PageNotFound": 0,
+		"PageNotFound":             0,
+		"TransactionInvalid":       1,
+		"TransactionAlreadyExists": 2,
+		"BlockNotFound":            3,
 	}
 )
 
@@ -77,13 +89,19 @@ var file_toyblc_v1_errors_proto_rawDesc = []byte{
 	0x0a, 0x16, 0x74, 0x6f, 0x79, 0x62, 0x6c, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x72, 0x72, 0x6f,
 	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x74, 0x6f, 0x79, 0x62, 0x6c, 0x63,
 	0x2e, 0x76, 0x31, 0x1a, 0x13, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x2b, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x0c, 0x50, 0x61, 0x67, 0x65, 0x4e,
-	0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x10, 0x00, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x1a,
-	0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x75, 0x70, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x6a, 0x2f, 0x6f, 0x6e,
-	0x65, 0x78, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x6f, 0x79, 0x62, 0x6c,
-	0x63, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x86, 0x01, 0x0a, 0x0b, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x0c, 0x50, 0x61, 0x67, 0x65,
+	0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x10, 0x00, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03,
+	0x12, 0x1c, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x10, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x22,
+	0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x72,
+	0x65, 0x61, 0x64, 0x79, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0x02, 0x1a, 0x04, 0xa8, 0x45,
+	0x99, 0x03, 0x12, 0x17, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x6f, 0x74, 0x46, 0x6f,
+	0x75, 0x6e, 0x64, 0x10, 0x03, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x1a, 0x04, 0xa0, 0x45, 0xf4,
+	0x03, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x73, 0x75, 0x70, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x6a, 0x2f, 0x6f, 0x6e, 0x65, 0x78, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x6f, 0x79, 0x62, 0x6c, 0x63, 0x2f, 0x76, 0x31,
+	0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
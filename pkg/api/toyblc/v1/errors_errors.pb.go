@@ -24,3 +24,45 @@ func IsPageNotFound(err error) bool {
 func ErrorPageNotFound(format string, args ...interface{}) *errors.Error {
 	return errors.New(404, ErrorReason_PageNotFound.String(), fmt.Sprintf(format, args...))
 }
+
+// 交易无效，可能是签名、余额或交易序号校验未通过
+func IsTransactionInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TransactionInvalid.String() && e.Code == 400
+}
+
+// 交易无效，可能是签名、余额或交易序号校验未通过
+func ErrorTransactionInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_TransactionInvalid.String(), fmt.Sprintf(format, args...))
+}
+
+// 交易已存在，无法重复提交
+func IsTransactionAlreadyExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TransactionAlreadyExists.String() && e.Code == 409
+}
+
+// 交易已存在，无法重复提交
+func ErrorTransactionAlreadyExists(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_TransactionAlreadyExists.String(), fmt.Sprintf(format, args...))
+}
+
+// 区块未找到，请求的区块高度或哈希不存在
+func IsBlockNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_BlockNotFound.String() && e.Code == 404
+}
+
+// 区块未找到，请求的区块高度或哈希不存在
+func ErrorBlockNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_BlockNotFound.String(), fmt.Sprintf(format, args...))
+}
@@ -242,3 +242,117 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = CreatePeerRequestValidationError{}
+
+// Validate checks the field values on CreateTransactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTransactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTransactionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTransactionRequestMultiError, or nil if none found.
+func (m *CreateTransactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTransactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	// no validation rules for Amount
+
+	// no validation rules for Nonce
+
+	// no validation rules for PublicKey
+
+	// no validation rules for Signature
+
+	if len(errors) > 0 {
+		return CreateTransactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTransactionRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateTransactionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTransactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTransactionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTransactionRequestMultiError) AllErrors() []error { return m }
+
+// CreateTransactionRequestValidationError is the validation error returned by
+// CreateTransactionRequest.Validate if the designated constraints aren't met.
+type CreateTransactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTransactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTransactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTransactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTransactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTransactionRequestValidationError) ErrorName() string {
+	return "CreateTransactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTransactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTransactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTransactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTransactionRequestValidationError{}
@@ -46,29 +46,97 @@ const (
 	ErrorReason_SecretNotFound ErrorReason = 6
 	// 创建密钥失败，可能是由于服务器或其他问题导致的创建过程中的错误
 	ErrorReason_SecretCreateFailed ErrorReason = 7
+	// 角色已存在，无法创建角色
+	ErrorReason_RoleAlreadyExists ErrorReason = 8
+	// 角色未找到，可能是角色不存在或输入的角色名称有误
+	ErrorReason_RoleNotFound ErrorReason = 9
+	// 角色绑定已存在，用户已经拥有该角色
+	ErrorReason_RoleBindingAlreadyExists ErrorReason = 10
+	// 角色绑定未找到，用户没有该角色
+	ErrorReason_RoleBindingNotFound ErrorReason = 11
+	// 授权策略已存在，无法重复创建
+	ErrorReason_PolicyAlreadyExists ErrorReason = 12
+	// 授权策略未找到，可能是策略不存在或输入的策略有误
+	ErrorReason_PolicyNotFound ErrorReason = 13
+	// 多因素认证令牌无效或已过期，需要重新登录
+	ErrorReason_MFATokenInvalid ErrorReason = 14
+	// 动态验证码或恢复码错误
+	ErrorReason_MFACodeInvalid ErrorReason = 15
+	// 用户尚未启用 TOTP 认证
+	ErrorReason_TOTPNotEnabled ErrorReason = 16
+	// 用户已经启用 TOTP 认证，无法重复绑定
+	ErrorReason_TOTPAlreadyEnabled ErrorReason = 17
+	// 未配置 OIDC 身份提供方，无法使用 OIDC 登录
+	ErrorReason_OIDCDisabled ErrorReason = 18
+	// OIDC 登录失败，可能是登录状态已过期或授权码无效
+	ErrorReason_OIDCLoginFailed ErrorReason = 19
+	// 外部身份未关联任何用户，且未开启自动创建用户
+	ErrorReason_IdentityNotLinked ErrorReason = 20
+	// 外部身份已关联其他用户
+	ErrorReason_IdentityAlreadyLinked ErrorReason = 21
+	// 外部身份未找到
+	ErrorReason_IdentityNotFound ErrorReason = 22
+	// 登录会话未找到，可能已过期或已被撤销
+	ErrorReason_SessionNotFound ErrorReason = 23
+	// 请求签名无效，或签名使用的密钥不存在、已禁用或已过期
+	ErrorReason_SignatureInvalid ErrorReason = 24
 )
 
 // Enum value maps for ErrorReason.
 var (
 	ErrorReason_name = map[int32]string{
-		0: "UserLoginFailed",
-		1: "UserAlreadyExists",
-		2: "UserNotFound",
-		3: "UserCreateFailed",
-		4: "UserOperationForbidden",
-		5: "SecretReachMaxCount",
-		6: "SecretNotFound",
-		7: "SecretCreateFailed",
+		0:  "UserLoginFailed",
+		1:  "UserAlreadyExists",
+		2:  "UserNotFound",
+		3:  "UserCreateFailed",
+		4:  "UserOperationForbidden",
+		5:  "SecretReachMaxCount",
+		6:  "SecretNotFound",
+		7:  "SecretCreateFailed",
+		8:  "RoleAlreadyExists",
+		9:  "RoleNotFound",
+		10: "RoleBindingAlreadyExists",
+		11: "RoleBindingNotFound",
+		12: "PolicyAlreadyExists",
+		13: "PolicyNotFound",
+		14: "MFATokenInvalid",
+		15: "MFACodeInvalid",
+		16: "TOTPNotEnabled",
+		17: "TOTPAlreadyEnabled",
+		18: "OIDCDisabled",
+		19: "OIDCLoginFailed",
+		20: "IdentityNotLinked",
+		21: "IdentityAlreadyLinked",
+		22: "IdentityNotFound",
+		23: "SessionNotFound",
+		24: "SignatureInvalid",
 	}
 	ErrorReason_value = map[string]int32{
-		"UserLoginFailed":        0,
-		"UserAlreadyExists":      1,
-		"UserNotFound":           2,
-		"UserCreateFailed":       3,
-		"UserOperationForbidden": 4,
-		"SecretReachMaxCount":    5,
-		"SecretNotFound":         6,
-		"SecretCreateFailed":     7,
+		"UserLoginFailed":          0,
+		"UserAlreadyExists":        1,
+		"UserNotFound":             2,
+		"UserCreateFailed":         3,
+		"UserOperationForbidden":   4,
+		"SecretReachMaxCount":      5,
+		"SecretNotFound":           6,
+		"SecretCreateFailed":       7,
+		"RoleAlreadyExists":        8,
+		"RoleNotFound":             9,
+		"RoleBindingAlreadyExists": 10,
+		"RoleBindingNotFound":      11,
+		"PolicyAlreadyExists":      12,
+		"PolicyNotFound":           13,
+		"MFATokenInvalid":          14,
+		"MFACodeInvalid":           15,
+		"TOTPNotEnabled":           16,
+		"TOTPAlreadyEnabled":       17,
+		"OIDCDisabled":             18,
+		"OIDCLoginFailed":          19,
+		"IdentityNotLinked":        20,
+		"IdentityAlreadyLinked":    21,
+		"IdentityNotFound":         22,
+		"SessionNotFound":          23,
+		"SignatureInvalid":         24,
 	}
 )
 
@@ -106,7 +174,7 @@ var file_usercenter_v1_errors_proto_rawDesc = []byte{
 	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x75, 0x73,
 	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x13, 0x65, 0x72, 0x72,
 	0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2a, 0xf8, 0x01, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x2a, 0xda, 0x05, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
 	0x12, 0x19, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x61, 0x69,
 	0x6c, 0x65, 0x64, 0x10, 0x00, 0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03, 0x12, 0x1b, 0x0a, 0x11, 0x55,
 	0x73, 0x65, 0x72, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
@@ -121,11 +189,41 @@ var file_usercenter_v1_errors_proto_rawDesc = []byte{
 	0x0e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x10,
 	0x06, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x1c, 0x0a, 0x12, 0x53, 0x65, 0x63, 0x72, 0x65,
 	0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x07, 0x1a,
-	0x04, 0xa8, 0x45, 0x9d, 0x04, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x34, 0x5a, 0x32, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x75, 0x70, 0x65, 0x72, 0x70,
-	0x72, 0x6f, 0x6a, 0x2f, 0x6f, 0x6e, 0x65, 0x78, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x75, 0x73, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x76,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x04, 0xa8, 0x45, 0x9d, 0x04, 0x12, 0x1b, 0x0a, 0x11, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x6c, 0x72,
+	0x65, 0x61, 0x64, 0x79, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0x08, 0x1a, 0x04, 0xa8, 0x45,
+	0x99, 0x03, 0x12, 0x16, 0x0a, 0x0c, 0x52, 0x6f, 0x6c, 0x65, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75,
+	0x6e, 0x64, 0x10, 0x09, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x22, 0x0a, 0x18, 0x52, 0x6f,
+	0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0x0a, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03, 0x12, 0x1d,
+	0x0a, 0x13, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x74,
+	0x46, 0x6f, 0x75, 0x6e, 0x64, 0x10, 0x0b, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x1d, 0x0a,
+	0x13, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x10, 0x0c, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03, 0x12, 0x18, 0x0a, 0x0e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x10, 0x0d,
+	0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x19, 0x0a, 0x0f, 0x4d, 0x46, 0x41, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x10, 0x0e, 0x1a, 0x04, 0xa8, 0x45, 0x91,
+	0x03, 0x12, 0x18, 0x0a, 0x0e, 0x4d, 0x46, 0x41, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x10, 0x0f, 0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03, 0x12, 0x18, 0x0a, 0x0e, 0x54,
+	0x4f, 0x54, 0x50, 0x4e, 0x6f, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x10, 0x10, 0x1a,
+	0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1c, 0x0a, 0x12, 0x54, 0x4f, 0x54, 0x50, 0x41, 0x6c, 0x72,
+	0x65, 0x61, 0x64, 0x79, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x10, 0x11, 0x1a, 0x04, 0xa8,
+	0x45, 0x99, 0x03, 0x12, 0x16, 0x0a, 0x0c, 0x4f, 0x49, 0x44, 0x43, 0x44, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x10, 0x12, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x19, 0x0a, 0x0f, 0x4f,
+	0x49, 0x44, 0x43, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x13,
+	0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03, 0x12, 0x1b, 0x0a, 0x11, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x4e, 0x6f, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x10, 0x14, 0x1a, 0x04, 0xa8,
+	0x45, 0x91, 0x03, 0x12, 0x1f, 0x0a, 0x15, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x41,
+	0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x10, 0x15, 0x1a, 0x04,
+	0xa8, 0x45, 0x99, 0x03, 0x12, 0x1a, 0x0a, 0x10, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x10, 0x16, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03,
+	0x12, 0x19, 0x0a, 0x0f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x46, 0x6f,
+	0x75, 0x6e, 0x64, 0x10, 0x17, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x1a, 0x0a, 0x10, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x10,
+	0x18, 0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x34, 0x5a,
+	0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x75, 0x70, 0x65,
+	0x72, 0x70, 0x72, 0x6f, 0x6a, 0x2f, 0x6f, 0x6e, 0x65, 0x78, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x31,
+	0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -122,3 +122,241 @@ func IsSecretCreateFailed(err error) bool {
 func ErrorSecretCreateFailed(format string, args ...interface{}) *errors.Error {
 	return errors.New(541, ErrorReason_SecretCreateFailed.String(), fmt.Sprintf(format, args...))
 }
+
+// 角色已存在，无法创建角色
+func IsRoleAlreadyExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_RoleAlreadyExists.String() && e.Code == 409
+}
+
+// 角色已存在，无法创建角色
+func ErrorRoleAlreadyExists(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_RoleAlreadyExists.String(), fmt.Sprintf(format, args...))
+}
+
+// 角色未找到，可能是角色不存在或输入的角色名称有误
+func IsRoleNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_RoleNotFound.String() && e.Code == 404
+}
+
+// 角色未找到，可能是角色不存在或输入的角色名称有误
+func ErrorRoleNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_RoleNotFound.String(), fmt.Sprintf(format, args...))
+}
+
+// 角色绑定已存在，用户已经拥有该角色
+func IsRoleBindingAlreadyExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_RoleBindingAlreadyExists.String() && e.Code == 409
+}
+
+// 角色绑定已存在，用户已经拥有该角色
+func ErrorRoleBindingAlreadyExists(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_RoleBindingAlreadyExists.String(), fmt.Sprintf(format, args...))
+}
+
+// 角色绑定未找到，用户没有该角色
+func IsRoleBindingNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_RoleBindingNotFound.String() && e.Code == 404
+}
+
+// 角色绑定未找到，用户没有该角色
+func ErrorRoleBindingNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_RoleBindingNotFound.String(), fmt.Sprintf(format, args...))
+}
+
+// 授权策略已存在，无法重复创建
+func IsPolicyAlreadyExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_PolicyAlreadyExists.String() && e.Code == 409
+}
+
+// 授权策略已存在，无法重复创建
+func ErrorPolicyAlreadyExists(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_PolicyAlreadyExists.String(), fmt.Sprintf(format, args...))
+}
+
+// 授权策略未找到，可能是策略不存在或输入的策略有误
+func IsPolicyNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_PolicyNotFound.String() && e.Code == 404
+}
+
+// 授权策略未找到，可能是策略不存在或输入的策略有误
+func ErrorPolicyNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_PolicyNotFound.String(), fmt.Sprintf(format, args...))
+}
+
+// 多因素认证令牌无效或已过期，需要重新登录
+func IsMFATokenInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_MFATokenInvalid.String() && e.Code == 401
+}
+
+// 多因素认证令牌无效或已过期，需要重新登录
+func ErrorMFATokenInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_MFATokenInvalid.String(), fmt.Sprintf(format, args...))
+}
+
+// 动态验证码或恢复码错误
+func IsMFACodeInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_MFACodeInvalid.String() && e.Code == 401
+}
+
+// 动态验证码或恢复码错误
+func ErrorMFACodeInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_MFACodeInvalid.String(), fmt.Sprintf(format, args...))
+}
+
+// 用户尚未启用 TOTP 认证
+func IsTOTPNotEnabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TOTPNotEnabled.String() && e.Code == 400
+}
+
+// 用户尚未启用 TOTP 认证
+func ErrorTOTPNotEnabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_TOTPNotEnabled.String(), fmt.Sprintf(format, args...))
+}
+
+// 用户已经启用 TOTP 认证，无法重复绑定
+func IsTOTPAlreadyEnabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TOTPAlreadyEnabled.String() && e.Code == 409
+}
+
+// 用户已经启用 TOTP 认证，无法重复绑定
+func ErrorTOTPAlreadyEnabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_TOTPAlreadyEnabled.String(), fmt.Sprintf(format, args...))
+}
+
+// 未配置 OIDC 身份提供方，无法使用 OIDC 登录
+func IsOIDCDisabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_OIDCDisabled.String() && e.Code == 400
+}
+
+// 未配置 OIDC 身份提供方，无法使用 OIDC 登录
+func ErrorOIDCDisabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_OIDCDisabled.String(), fmt.Sprintf(format, args...))
+}
+
+// OIDC 登录失败，可能是登录状态已过期或授权码无效
+func IsOIDCLoginFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_OIDCLoginFailed.String() && e.Code == 401
+}
+
+// OIDC 登录失败，可能是登录状态已过期或授权码无效
+func ErrorOIDCLoginFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_OIDCLoginFailed.String(), fmt.Sprintf(format, args...))
+}
+
+// 外部身份未关联任何用户，且未开启自动创建用户
+func IsIdentityNotLinked(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_IdentityNotLinked.String() && e.Code == 401
+}
+
+// 外部身份未关联任何用户，且未开启自动创建用户
+func ErrorIdentityNotLinked(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_IdentityNotLinked.String(), fmt.Sprintf(format, args...))
+}
+
+// 外部身份已关联其他用户
+func IsIdentityAlreadyLinked(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_IdentityAlreadyLinked.String() && e.Code == 409
+}
+
+// 外部身份已关联其他用户
+func ErrorIdentityAlreadyLinked(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_IdentityAlreadyLinked.String(), fmt.Sprintf(format, args...))
+}
+
+// 外部身份未找到
+func IsIdentityNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_IdentityNotFound.String() && e.Code == 404
+}
+
+// 外部身份未找到
+func ErrorIdentityNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_IdentityNotFound.String(), fmt.Sprintf(format, args...))
+}
+
+// 登录会话未找到，可能已过期或已被撤销
+func IsSessionNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_SessionNotFound.String() && e.Code == 404
+}
+
+// 登录会话未找到，可能已过期或已被撤销
+func ErrorSessionNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_SessionNotFound.String(), fmt.Sprintf(format, args...))
+}
+
+// 请求签名无效，或签名使用的密钥不存在、已禁用或已过期
+func IsSignatureInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_SignatureInvalid.String() && e.Code == 401
+}
+
+// 请求签名无效，或签名使用的密钥不存在、已禁用或已过期
+func ErrorSignatureInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_SignatureInvalid.String(), fmt.Sprintf(format, args...))
+}
@@ -129,6 +129,10 @@ func (m *UserReply) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for MfaRequired
+
+	// no validation rules for TotpEnabled
+
 	if len(errors) > 0 {
 		return UserReplyMultiError(errors)
 	}
@@ -232,6 +236,8 @@ func (m *LoginRequest) validate(all bool) error {
 
 	// no validation rules for Password
 
+	// no validation rules for Device
+
 	if len(errors) > 0 {
 		return LoginRequestMultiError(errors)
 	}
@@ -339,6 +345,12 @@ func (m *LoginReply) validate(all bool) error {
 
 	// no validation rules for ExpiresAt
 
+	// no validation rules for MfaToken
+
+	// no validation rules for TotpSecret
+
+	// no validation rules for TotpUrl
+
 	if len(errors) > 0 {
 		return LoginReplyMultiError(errors)
 	}
@@ -416,6 +428,123 @@ var _ interface {
 	ErrorName() string
 } = LoginReplyValidationError{}
 
+// Validate checks the field values on LoginMFARequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LoginMFARequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoginMFARequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LoginMFARequestMultiError, or nil if none found.
+func (m *LoginMFARequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoginMFARequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetMfaToken()) < 1 {
+		err := LoginMFARequestValidationError{
+			field:  "MfaToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Code
+
+	// no validation rules for RecoveryCode
+
+	// no validation rules for Device
+
+	if len(errors) > 0 {
+		return LoginMFARequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoginMFARequestMultiError is an error wrapping multiple validation errors
+// returned by LoginMFARequest.ValidateAll() if the designated constraints
+// aren't met.
+type LoginMFARequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoginMFARequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoginMFARequestMultiError) AllErrors() []error { return m }
+
+// LoginMFARequestValidationError is the validation error returned by
+// LoginMFARequest.Validate if the designated constraints aren't met.
+type LoginMFARequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoginMFARequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoginMFARequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoginMFARequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoginMFARequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoginMFARequestValidationError) ErrorName() string { return "LoginMFARequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoginMFARequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoginMFARequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoginMFARequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoginMFARequestValidationError{}
+
 // Validate checks the field values on LogoutRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -860,6 +989,10 @@ func (m *UpdateUserRequest) validate(all bool) error {
 		// no validation rules for Phone
 	}
 
+	if m.MfaRequired != nil {
+		// no validation rules for MfaRequired
+	}
+
 	if len(errors) > 0 {
 		return UpdateUserRequestMultiError(errors)
 	}
@@ -1417,6 +1550,10 @@ func (m *UpdatePasswordRequest) validate(all bool) error {
 
 	// no validation rules for NewPassword
 
+	// no validation rules for Code
+
+	// no validation rules for RecoveryCode
+
 	if len(errors) > 0 {
 		return UpdatePasswordRequestMultiError(errors)
 	}
@@ -1497,113 +1634,44 @@ var _ interface {
 	ErrorName() string
 } = UpdatePasswordRequestValidationError{}
 
-// Validate checks the field values on SecretReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SecretReply) Validate() error {
+// Validate checks the field values on EnrollTOTPRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EnrollTOTPRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SecretReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SecretReplyMultiError, or
-// nil if none found.
-func (m *SecretReply) ValidateAll() error {
+// ValidateAll checks the field values on EnrollTOTPRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrollTOTPRequestMultiError, or nil if none found.
+func (m *EnrollTOTPRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SecretReply) validate(all bool) error {
+func (m *EnrollTOTPRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserID
-
-	// no validation rules for Name
-
-	// no validation rules for SecretID
-
-	// no validation rules for SecretKey
-
-	// no validation rules for Expires
-
-	// no validation rules for Status
-
-	// no validation rules for Description
-
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SecretReplyValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SecretReplyValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SecretReplyValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SecretReplyValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SecretReplyValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SecretReplyValidationError{
-				field:  "UpdatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Username
 
 	if len(errors) > 0 {
-		return SecretReplyMultiError(errors)
+		return EnrollTOTPRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SecretReplyMultiError is an error wrapping multiple validation errors
-// returned by SecretReply.ValidateAll() if the designated constraints aren't met.
-type SecretReplyMultiError []error
+// EnrollTOTPRequestMultiError is an error wrapping multiple validation errors
+// returned by EnrollTOTPRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EnrollTOTPRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SecretReplyMultiError) Error() string {
+func (m EnrollTOTPRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1612,11 +1680,11 @@ func (m SecretReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SecretReplyMultiError) AllErrors() []error { return m }
+func (m EnrollTOTPRequestMultiError) AllErrors() []error { return m }
 
-// SecretReplyValidationError is the validation error returned by
-// SecretReply.Validate if the designated constraints aren't met.
-type SecretReplyValidationError struct {
+// EnrollTOTPRequestValidationError is the validation error returned by
+// EnrollTOTPRequest.Validate if the designated constraints aren't met.
+type EnrollTOTPRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1624,22 +1692,24 @@ type SecretReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e SecretReplyValidationError) Field() string { return e.field }
+func (e EnrollTOTPRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SecretReplyValidationError) Reason() string { return e.reason }
+func (e EnrollTOTPRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SecretReplyValidationError) Cause() error { return e.cause }
+func (e EnrollTOTPRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SecretReplyValidationError) Key() bool { return e.key }
+func (e EnrollTOTPRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SecretReplyValidationError) ErrorName() string { return "SecretReplyValidationError" }
+func (e EnrollTOTPRequestValidationError) ErrorName() string {
+	return "EnrollTOTPRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SecretReplyValidationError) Error() string {
+func (e EnrollTOTPRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1651,14 +1721,14 @@ func (e SecretReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSecretReply.%s: %s%s",
+		"invalid %sEnrollTOTPRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SecretReplyValidationError{}
+var _ error = EnrollTOTPRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1666,46 +1736,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SecretReplyValidationError{}
+} = EnrollTOTPRequestValidationError{}
 
-// Validate checks the field values on GetSecretRequest with the rules defined
+// Validate checks the field values on EnrollTOTPReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GetSecretRequest) Validate() error {
+func (m *EnrollTOTPReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSecretRequest with the rules
+// ValidateAll checks the field values on EnrollTOTPReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSecretRequestMultiError, or nil if none found.
-func (m *GetSecretRequest) ValidateAll() error {
+// EnrollTOTPReplyMultiError, or nil if none found.
+func (m *EnrollTOTPReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSecretRequest) validate(all bool) error {
+func (m *EnrollTOTPReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for Secret
+
+	// no validation rules for Url
 
 	if len(errors) > 0 {
-		return GetSecretRequestMultiError(errors)
+		return EnrollTOTPReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSecretRequestMultiError is an error wrapping multiple validation errors
-// returned by GetSecretRequest.ValidateAll() if the designated constraints
+// EnrollTOTPReplyMultiError is an error wrapping multiple validation errors
+// returned by EnrollTOTPReply.ValidateAll() if the designated constraints
 // aren't met.
-type GetSecretRequestMultiError []error
+type EnrollTOTPReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSecretRequestMultiError) Error() string {
+func (m EnrollTOTPReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1714,11 +1786,11 @@ func (m GetSecretRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSecretRequestMultiError) AllErrors() []error { return m }
+func (m EnrollTOTPReplyMultiError) AllErrors() []error { return m }
 
-// GetSecretRequestValidationError is the validation error returned by
-// GetSecretRequest.Validate if the designated constraints aren't met.
-type GetSecretRequestValidationError struct {
+// EnrollTOTPReplyValidationError is the validation error returned by
+// EnrollTOTPReply.Validate if the designated constraints aren't met.
+type EnrollTOTPReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1726,22 +1798,22 @@ type GetSecretRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSecretRequestValidationError) Field() string { return e.field }
+func (e EnrollTOTPReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSecretRequestValidationError) Reason() string { return e.reason }
+func (e EnrollTOTPReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSecretRequestValidationError) Cause() error { return e.cause }
+func (e EnrollTOTPReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSecretRequestValidationError) Key() bool { return e.key }
+func (e EnrollTOTPReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSecretRequestValidationError) ErrorName() string { return "GetSecretRequestValidationError" }
+func (e EnrollTOTPReplyValidationError) ErrorName() string { return "EnrollTOTPReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetSecretRequestValidationError) Error() string {
+func (e EnrollTOTPReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1753,14 +1825,14 @@ func (e GetSecretRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSecretRequest.%s: %s%s",
+		"invalid %sEnrollTOTPReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSecretRequestValidationError{}
+var _ error = EnrollTOTPReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1768,46 +1840,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSecretRequestValidationError{}
+} = EnrollTOTPReplyValidationError{}
 
-// Validate checks the field values on DeleteSecretRequest with the rules
+// Validate checks the field values on ActivateTOTPRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteSecretRequest) Validate() error {
+func (m *ActivateTOTPRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteSecretRequest with the rules
+// ValidateAll checks the field values on ActivateTOTPRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteSecretRequestMultiError, or nil if none found.
-func (m *DeleteSecretRequest) ValidateAll() error {
+// ActivateTOTPRequestMultiError, or nil if none found.
+func (m *ActivateTOTPRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteSecretRequest) validate(all bool) error {
+func (m *ActivateTOTPRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for Username
+
+	if utf8.RuneCountInString(m.GetCode()) != 6 {
+		err := ActivateTOTPRequestValidationError{
+			field:  "Code",
+			reason: "value length must be 6 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
 
 	if len(errors) > 0 {
-		return DeleteSecretRequestMultiError(errors)
+		return ActivateTOTPRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteSecretRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteSecretRequest.ValidateAll() if the designated
+// ActivateTOTPRequestMultiError is an error wrapping multiple validation
+// errors returned by ActivateTOTPRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteSecretRequestMultiError []error
+type ActivateTOTPRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteSecretRequestMultiError) Error() string {
+func (m ActivateTOTPRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1816,11 +1900,11 @@ func (m DeleteSecretRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteSecretRequestMultiError) AllErrors() []error { return m }
+func (m ActivateTOTPRequestMultiError) AllErrors() []error { return m }
 
-// DeleteSecretRequestValidationError is the validation error returned by
-// DeleteSecretRequest.Validate if the designated constraints aren't met.
-type DeleteSecretRequestValidationError struct {
+// ActivateTOTPRequestValidationError is the validation error returned by
+// ActivateTOTPRequest.Validate if the designated constraints aren't met.
+type ActivateTOTPRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1828,24 +1912,24 @@ type DeleteSecretRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteSecretRequestValidationError) Field() string { return e.field }
+func (e ActivateTOTPRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteSecretRequestValidationError) Reason() string { return e.reason }
+func (e ActivateTOTPRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteSecretRequestValidationError) Cause() error { return e.cause }
+func (e ActivateTOTPRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteSecretRequestValidationError) Key() bool { return e.key }
+func (e ActivateTOTPRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteSecretRequestValidationError) ErrorName() string {
-	return "DeleteSecretRequestValidationError"
+func (e ActivateTOTPRequestValidationError) ErrorName() string {
+	return "ActivateTOTPRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteSecretRequestValidationError) Error() string {
+func (e ActivateTOTPRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1857,14 +1941,14 @@ func (e DeleteSecretRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteSecretRequest.%s: %s%s",
+		"invalid %sActivateTOTPRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteSecretRequestValidationError{}
+var _ error = ActivateTOTPRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1872,80 +1956,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteSecretRequestValidationError{}
+} = ActivateTOTPRequestValidationError{}
 
-// Validate checks the field values on UpdateSecretRequest with the rules
+// Validate checks the field values on DisableTOTPRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateSecretRequest) Validate() error {
+func (m *DisableTOTPRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateSecretRequest with the rules
+// ValidateAll checks the field values on DisableTOTPRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateSecretRequestMultiError, or nil if none found.
-func (m *UpdateSecretRequest) ValidateAll() error {
+// DisableTOTPRequestMultiError, or nil if none found.
+func (m *DisableTOTPRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateSecretRequest) validate(all bool) error {
+func (m *DisableTOTPRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
-
-	if m.Expires != nil {
-		// no validation rules for Expires
-	}
-
-	if m.Status != nil {
-
-		if _, ok := _UpdateSecretRequest_Status_InLookup[m.GetStatus()]; !ok {
-			err := UpdateSecretRequestValidationError{
-				field:  "Status",
-				reason: "value must be in list [0 1]",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.Description != nil {
-
-		if utf8.RuneCountInString(m.GetDescription()) > 256 {
-			err := UpdateSecretRequestValidationError{
-				field:  "Description",
-				reason: "value length must be at most 256 runes",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for Username
 
-	}
+	// no validation rules for Code
 
 	if len(errors) > 0 {
-		return UpdateSecretRequestMultiError(errors)
+		return DisableTOTPRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateSecretRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateSecretRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateSecretRequestMultiError []error
+// DisableTOTPRequestMultiError is an error wrapping multiple validation errors
+// returned by DisableTOTPRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DisableTOTPRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateSecretRequestMultiError) Error() string {
+func (m DisableTOTPRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1954,11 +2006,11 @@ func (m UpdateSecretRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateSecretRequestMultiError) AllErrors() []error { return m }
+func (m DisableTOTPRequestMultiError) AllErrors() []error { return m }
 
-// UpdateSecretRequestValidationError is the validation error returned by
-// UpdateSecretRequest.Validate if the designated constraints aren't met.
-type UpdateSecretRequestValidationError struct {
+// DisableTOTPRequestValidationError is the validation error returned by
+// DisableTOTPRequest.Validate if the designated constraints aren't met.
+type DisableTOTPRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1966,24 +2018,24 @@ type UpdateSecretRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateSecretRequestValidationError) Field() string { return e.field }
+func (e DisableTOTPRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateSecretRequestValidationError) Reason() string { return e.reason }
+func (e DisableTOTPRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateSecretRequestValidationError) Cause() error { return e.cause }
+func (e DisableTOTPRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateSecretRequestValidationError) Key() bool { return e.key }
+func (e DisableTOTPRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateSecretRequestValidationError) ErrorName() string {
-	return "UpdateSecretRequestValidationError"
+func (e DisableTOTPRequestValidationError) ErrorName() string {
+	return "DisableTOTPRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateSecretRequestValidationError) Error() string {
+func (e DisableTOTPRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1995,14 +2047,14 @@ func (e UpdateSecretRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateSecretRequest.%s: %s%s",
+		"invalid %sDisableTOTPRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateSecretRequestValidationError{}
+var _ error = DisableTOTPRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2010,53 +2062,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateSecretRequestValidationError{}
-
-var _UpdateSecretRequest_Status_InLookup = map[int32]struct{}{
-	0: {},
-	1: {},
-}
+} = DisableTOTPRequestValidationError{}
 
-// Validate checks the field values on ListSecretRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListSecretRequest) Validate() error {
+// Validate checks the field values on RegenerateRecoveryCodesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegenerateRecoveryCodesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListSecretRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListSecretRequestMultiError, or nil if none found.
-func (m *ListSecretRequest) ValidateAll() error {
+// ValidateAll checks the field values on RegenerateRecoveryCodesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RegenerateRecoveryCodesRequestMultiError, or nil if none found.
+func (m *RegenerateRecoveryCodesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListSecretRequest) validate(all bool) error {
+func (m *RegenerateRecoveryCodesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Limit
+	// no validation rules for Username
 
-	// no validation rules for Offset
+	if utf8.RuneCountInString(m.GetCode()) != 6 {
+		err := RegenerateRecoveryCodesRequestValidationError{
+			field:  "Code",
+			reason: "value length must be 6 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
 
 	if len(errors) > 0 {
-		return ListSecretRequestMultiError(errors)
+		return RegenerateRecoveryCodesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListSecretRequestMultiError is an error wrapping multiple validation errors
-// returned by ListSecretRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListSecretRequestMultiError []error
+// RegenerateRecoveryCodesRequestMultiError is an error wrapping multiple
+// validation errors returned by RegenerateRecoveryCodesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RegenerateRecoveryCodesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListSecretRequestMultiError) Error() string {
+func (m RegenerateRecoveryCodesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2065,11 +2122,12 @@ func (m ListSecretRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListSecretRequestMultiError) AllErrors() []error { return m }
+func (m RegenerateRecoveryCodesRequestMultiError) AllErrors() []error { return m }
 
-// ListSecretRequestValidationError is the validation error returned by
-// ListSecretRequest.Validate if the designated constraints aren't met.
-type ListSecretRequestValidationError struct {
+// RegenerateRecoveryCodesRequestValidationError is the validation error
+// returned by RegenerateRecoveryCodesRequest.Validate if the designated
+// constraints aren't met.
+type RegenerateRecoveryCodesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2077,24 +2135,24 @@ type ListSecretRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListSecretRequestValidationError) Field() string { return e.field }
+func (e RegenerateRecoveryCodesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListSecretRequestValidationError) Reason() string { return e.reason }
+func (e RegenerateRecoveryCodesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListSecretRequestValidationError) Cause() error { return e.cause }
+func (e RegenerateRecoveryCodesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListSecretRequestValidationError) Key() bool { return e.key }
+func (e RegenerateRecoveryCodesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListSecretRequestValidationError) ErrorName() string {
-	return "ListSecretRequestValidationError"
+func (e RegenerateRecoveryCodesRequestValidationError) ErrorName() string {
+	return "RegenerateRecoveryCodesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListSecretRequestValidationError) Error() string {
+func (e RegenerateRecoveryCodesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2106,14 +2164,14 @@ func (e ListSecretRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListSecretRequest.%s: %s%s",
+		"invalid %sRegenerateRecoveryCodesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListSecretRequestValidationError{}
+var _ error = RegenerateRecoveryCodesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2121,80 +2179,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListSecretRequestValidationError{}
+} = RegenerateRecoveryCodesRequestValidationError{}
 
-// Validate checks the field values on ListSecretResponse with the rules
+// Validate checks the field values on RecoveryCodesReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListSecretResponse) Validate() error {
+func (m *RecoveryCodesReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListSecretResponse with the rules
+// ValidateAll checks the field values on RecoveryCodesReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListSecretResponseMultiError, or nil if none found.
-func (m *ListSecretResponse) ValidateAll() error {
+// RecoveryCodesReplyMultiError, or nil if none found.
+func (m *RecoveryCodesReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListSecretResponse) validate(all bool) error {
+func (m *RecoveryCodesReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TotalCount
-
-	for idx, item := range m.GetSecrets() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListSecretResponseValidationError{
-						field:  fmt.Sprintf("Secrets[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListSecretResponseValidationError{
-						field:  fmt.Sprintf("Secrets[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListSecretResponseValidationError{
-					field:  fmt.Sprintf("Secrets[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return ListSecretResponseMultiError(errors)
+		return RecoveryCodesReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListSecretResponseMultiError is an error wrapping multiple validation errors
-// returned by ListSecretResponse.ValidateAll() if the designated constraints
+// RecoveryCodesReplyMultiError is an error wrapping multiple validation errors
+// returned by RecoveryCodesReply.ValidateAll() if the designated constraints
 // aren't met.
-type ListSecretResponseMultiError []error
+type RecoveryCodesReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListSecretResponseMultiError) Error() string {
+func (m RecoveryCodesReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2203,11 +2225,11 @@ func (m ListSecretResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListSecretResponseMultiError) AllErrors() []error { return m }
+func (m RecoveryCodesReplyMultiError) AllErrors() []error { return m }
 
-// ListSecretResponseValidationError is the validation error returned by
-// ListSecretResponse.Validate if the designated constraints aren't met.
-type ListSecretResponseValidationError struct {
+// RecoveryCodesReplyValidationError is the validation error returned by
+// RecoveryCodesReply.Validate if the designated constraints aren't met.
+type RecoveryCodesReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2215,24 +2237,24 @@ type ListSecretResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListSecretResponseValidationError) Field() string { return e.field }
+func (e RecoveryCodesReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListSecretResponseValidationError) Reason() string { return e.reason }
+func (e RecoveryCodesReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListSecretResponseValidationError) Cause() error { return e.cause }
+func (e RecoveryCodesReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListSecretResponseValidationError) Key() bool { return e.key }
+func (e RecoveryCodesReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListSecretResponseValidationError) ErrorName() string {
-	return "ListSecretResponseValidationError"
+func (e RecoveryCodesReplyValidationError) ErrorName() string {
+	return "RecoveryCodesReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListSecretResponseValidationError) Error() string {
+func (e RecoveryCodesReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2244,14 +2266,14 @@ func (e ListSecretResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListSecretResponse.%s: %s%s",
+		"invalid %sRecoveryCodesReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListSecretResponseValidationError{}
+var _ error = RecoveryCodesReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2259,68 +2281,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListSecretResponseValidationError{}
+} = RecoveryCodesReplyValidationError{}
 
-// Validate checks the field values on CreateSecretRequest with the rules
+// Validate checks the field values on OIDCAuthorizeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateSecretRequest) Validate() error {
+func (m *OIDCAuthorizeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateSecretRequest with the rules
+// ValidateAll checks the field values on OIDCAuthorizeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateSecretRequestMultiError, or nil if none found.
-func (m *CreateSecretRequest) ValidateAll() error {
+// OIDCAuthorizeRequestMultiError, or nil if none found.
+func (m *OIDCAuthorizeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateSecretRequest) validate(all bool) error {
+func (m *OIDCAuthorizeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 253 {
-		err := CreateSecretRequestValidationError{
-			field:  "Name",
-			reason: "value length must be between 1 and 253 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Expires
-
-	if utf8.RuneCountInString(m.GetDescription()) > 256 {
-		err := CreateSecretRequestValidationError{
-			field:  "Description",
-			reason: "value length must be at most 256 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return CreateSecretRequestMultiError(errors)
+		return OIDCAuthorizeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateSecretRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateSecretRequest.ValidateAll() if the designated
+// OIDCAuthorizeRequestMultiError is an error wrapping multiple validation
+// errors returned by OIDCAuthorizeRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateSecretRequestMultiError []error
+type OIDCAuthorizeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateSecretRequestMultiError) Error() string {
+func (m OIDCAuthorizeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2329,11 +2327,11 @@ func (m CreateSecretRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateSecretRequestMultiError) AllErrors() []error { return m }
+func (m OIDCAuthorizeRequestMultiError) AllErrors() []error { return m }
 
-// CreateSecretRequestValidationError is the validation error returned by
-// CreateSecretRequest.Validate if the designated constraints aren't met.
-type CreateSecretRequestValidationError struct {
+// OIDCAuthorizeRequestValidationError is the validation error returned by
+// OIDCAuthorizeRequest.Validate if the designated constraints aren't met.
+type OIDCAuthorizeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2341,24 +2339,24 @@ type CreateSecretRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateSecretRequestValidationError) Field() string { return e.field }
+func (e OIDCAuthorizeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateSecretRequestValidationError) Reason() string { return e.reason }
+func (e OIDCAuthorizeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateSecretRequestValidationError) Cause() error { return e.cause }
+func (e OIDCAuthorizeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateSecretRequestValidationError) Key() bool { return e.key }
+func (e OIDCAuthorizeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateSecretRequestValidationError) ErrorName() string {
-	return "CreateSecretRequestValidationError"
+func (e OIDCAuthorizeRequestValidationError) ErrorName() string {
+	return "OIDCAuthorizeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateSecretRequestValidationError) Error() string {
+func (e OIDCAuthorizeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2370,14 +2368,14 @@ func (e CreateSecretRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateSecretRequest.%s: %s%s",
+		"invalid %sOIDCAuthorizeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateSecretRequestValidationError{}
+var _ error = OIDCAuthorizeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2385,46 +2383,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateSecretRequestValidationError{}
+} = OIDCAuthorizeRequestValidationError{}
 
-// Validate checks the field values on AuthenticateRequest with the rules
+// Validate checks the field values on OIDCAuthorizeReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthenticateRequest) Validate() error {
+func (m *OIDCAuthorizeReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthenticateRequest with the rules
+// ValidateAll checks the field values on OIDCAuthorizeReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthenticateRequestMultiError, or nil if none found.
-func (m *AuthenticateRequest) ValidateAll() error {
+// OIDCAuthorizeReplyMultiError, or nil if none found.
+func (m *OIDCAuthorizeReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthenticateRequest) validate(all bool) error {
+func (m *OIDCAuthorizeReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Token
+	// no validation rules for Url
+
+	// no validation rules for State
 
 	if len(errors) > 0 {
-		return AuthenticateRequestMultiError(errors)
+		return OIDCAuthorizeReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthenticateRequestMultiError is an error wrapping multiple validation
-// errors returned by AuthenticateRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AuthenticateRequestMultiError []error
+// OIDCAuthorizeReplyMultiError is an error wrapping multiple validation errors
+// returned by OIDCAuthorizeReply.ValidateAll() if the designated constraints
+// aren't met.
+type OIDCAuthorizeReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthenticateRequestMultiError) Error() string {
+func (m OIDCAuthorizeReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2433,11 +2433,11 @@ func (m AuthenticateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthenticateRequestMultiError) AllErrors() []error { return m }
+func (m OIDCAuthorizeReplyMultiError) AllErrors() []error { return m }
 
-// AuthenticateRequestValidationError is the validation error returned by
-// AuthenticateRequest.Validate if the designated constraints aren't met.
-type AuthenticateRequestValidationError struct {
+// OIDCAuthorizeReplyValidationError is the validation error returned by
+// OIDCAuthorizeReply.Validate if the designated constraints aren't met.
+type OIDCAuthorizeReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2445,24 +2445,24 @@ type AuthenticateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthenticateRequestValidationError) Field() string { return e.field }
+func (e OIDCAuthorizeReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthenticateRequestValidationError) Reason() string { return e.reason }
+func (e OIDCAuthorizeReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthenticateRequestValidationError) Cause() error { return e.cause }
+func (e OIDCAuthorizeReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthenticateRequestValidationError) Key() bool { return e.key }
+func (e OIDCAuthorizeReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthenticateRequestValidationError) ErrorName() string {
-	return "AuthenticateRequestValidationError"
+func (e OIDCAuthorizeReplyValidationError) ErrorName() string {
+	return "OIDCAuthorizeReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthenticateRequestValidationError) Error() string {
+func (e OIDCAuthorizeReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2474,14 +2474,14 @@ func (e AuthenticateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthenticateRequest.%s: %s%s",
+		"invalid %sOIDCAuthorizeReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthenticateRequestValidationError{}
+var _ error = OIDCAuthorizeReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2489,46 +2489,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthenticateRequestValidationError{}
+} = OIDCAuthorizeReplyValidationError{}
 
-// Validate checks the field values on AuthenticateResponse with the rules
+// Validate checks the field values on OIDCCallbackRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthenticateResponse) Validate() error {
+func (m *OIDCCallbackRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthenticateResponse with the rules
+// ValidateAll checks the field values on OIDCCallbackRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthenticateResponseMultiError, or nil if none found.
-func (m *AuthenticateResponse) ValidateAll() error {
+// OIDCCallbackRequestMultiError, or nil if none found.
+func (m *OIDCCallbackRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthenticateResponse) validate(all bool) error {
+func (m *OIDCCallbackRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserID
+	if utf8.RuneCountInString(m.GetCode()) < 1 {
+		err := OIDCCallbackRequestValidationError{
+			field:  "Code",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetState()) < 1 {
+		err := OIDCCallbackRequestValidationError{
+			field:  "State",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return AuthenticateResponseMultiError(errors)
+		return OIDCCallbackRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthenticateResponseMultiError is an error wrapping multiple validation
-// errors returned by AuthenticateResponse.ValidateAll() if the designated
+// OIDCCallbackRequestMultiError is an error wrapping multiple validation
+// errors returned by OIDCCallbackRequest.ValidateAll() if the designated
 // constraints aren't met.
-type AuthenticateResponseMultiError []error
+type OIDCCallbackRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthenticateResponseMultiError) Error() string {
+func (m OIDCCallbackRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2537,11 +2557,11 @@ func (m AuthenticateResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthenticateResponseMultiError) AllErrors() []error { return m }
+func (m OIDCCallbackRequestMultiError) AllErrors() []error { return m }
 
-// AuthenticateResponseValidationError is the validation error returned by
-// AuthenticateResponse.Validate if the designated constraints aren't met.
-type AuthenticateResponseValidationError struct {
+// OIDCCallbackRequestValidationError is the validation error returned by
+// OIDCCallbackRequest.Validate if the designated constraints aren't met.
+type OIDCCallbackRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2549,24 +2569,24 @@ type AuthenticateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthenticateResponseValidationError) Field() string { return e.field }
+func (e OIDCCallbackRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthenticateResponseValidationError) Reason() string { return e.reason }
+func (e OIDCCallbackRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthenticateResponseValidationError) Cause() error { return e.cause }
+func (e OIDCCallbackRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthenticateResponseValidationError) Key() bool { return e.key }
+func (e OIDCCallbackRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthenticateResponseValidationError) ErrorName() string {
-	return "AuthenticateResponseValidationError"
+func (e OIDCCallbackRequestValidationError) ErrorName() string {
+	return "OIDCCallbackRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthenticateResponseValidationError) Error() string {
+func (e OIDCCallbackRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2578,14 +2598,14 @@ func (e AuthenticateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthenticateResponse.%s: %s%s",
+		"invalid %sOIDCCallbackRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthenticateResponseValidationError{}
+var _ error = OIDCCallbackRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2593,50 +2613,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthenticateResponseValidationError{}
+} = OIDCCallbackRequestValidationError{}
 
-// Validate checks the field values on AuthorizeRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AuthorizeRequest) Validate() error {
+// Validate checks the field values on IdentityReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IdentityReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthorizeRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AuthorizeRequestMultiError, or nil if none found.
-func (m *AuthorizeRequest) ValidateAll() error {
+// ValidateAll checks the field values on IdentityReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IdentityReplyMultiError, or
+// nil if none found.
+func (m *IdentityReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthorizeRequest) validate(all bool) error {
+func (m *IdentityReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Sub
+	// no validation rules for Username
 
-	// no validation rules for Obj
+	// no validation rules for Issuer
 
-	// no validation rules for Act
+	// no validation rules for Subject
+
+	// no validation rules for Email
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IdentityReplyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IdentityReplyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IdentityReplyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return AuthorizeRequestMultiError(errors)
+		return IdentityReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthorizeRequestMultiError is an error wrapping multiple validation errors
-// returned by AuthorizeRequest.ValidateAll() if the designated constraints
+// IdentityReplyMultiError is an error wrapping multiple validation errors
+// returned by IdentityReply.ValidateAll() if the designated constraints
 // aren't met.
-type AuthorizeRequestMultiError []error
+type IdentityReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthorizeRequestMultiError) Error() string {
+func (m IdentityReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2645,11 +2696,11 @@ func (m AuthorizeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthorizeRequestMultiError) AllErrors() []error { return m }
+func (m IdentityReplyMultiError) AllErrors() []error { return m }
 
-// AuthorizeRequestValidationError is the validation error returned by
-// AuthorizeRequest.Validate if the designated constraints aren't met.
-type AuthorizeRequestValidationError struct {
+// IdentityReplyValidationError is the validation error returned by
+// IdentityReply.Validate if the designated constraints aren't met.
+type IdentityReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2657,22 +2708,22 @@ type AuthorizeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthorizeRequestValidationError) Field() string { return e.field }
+func (e IdentityReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthorizeRequestValidationError) Reason() string { return e.reason }
+func (e IdentityReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthorizeRequestValidationError) Cause() error { return e.cause }
+func (e IdentityReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthorizeRequestValidationError) Key() bool { return e.key }
+func (e IdentityReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthorizeRequestValidationError) ErrorName() string { return "AuthorizeRequestValidationError" }
+func (e IdentityReplyValidationError) ErrorName() string { return "IdentityReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AuthorizeRequestValidationError) Error() string {
+func (e IdentityReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2684,14 +2735,14 @@ func (e AuthorizeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthorizeRequest.%s: %s%s",
+		"invalid %sIdentityReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthorizeRequestValidationError{}
+var _ error = IdentityReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2699,46 +2750,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthorizeRequestValidationError{}
+} = IdentityReplyValidationError{}
 
-// Validate checks the field values on AuthorizeResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AuthorizeResponse) Validate() error {
+// Validate checks the field values on LinkIdentityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LinkIdentityRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthorizeResponse with the rules
+// ValidateAll checks the field values on LinkIdentityRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthorizeResponseMultiError, or nil if none found.
-func (m *AuthorizeResponse) ValidateAll() error {
+// LinkIdentityRequestMultiError, or nil if none found.
+func (m *LinkIdentityRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthorizeResponse) validate(all bool) error {
+func (m *LinkIdentityRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Allowed
+	// no validation rules for Username
 
 	if len(errors) > 0 {
-		return AuthorizeResponseMultiError(errors)
+		return LinkIdentityRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthorizeResponseMultiError is an error wrapping multiple validation errors
-// returned by AuthorizeResponse.ValidateAll() if the designated constraints
-// aren't met.
-type AuthorizeResponseMultiError []error
+// LinkIdentityRequestMultiError is an error wrapping multiple validation
+// errors returned by LinkIdentityRequest.ValidateAll() if the designated
+// constraints aren't met.
+type LinkIdentityRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthorizeResponseMultiError) Error() string {
+func (m LinkIdentityRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2747,11 +2798,11 @@ func (m AuthorizeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthorizeResponseMultiError) AllErrors() []error { return m }
+func (m LinkIdentityRequestMultiError) AllErrors() []error { return m }
 
-// AuthorizeResponseValidationError is the validation error returned by
-// AuthorizeResponse.Validate if the designated constraints aren't met.
-type AuthorizeResponseValidationError struct {
+// LinkIdentityRequestValidationError is the validation error returned by
+// LinkIdentityRequest.Validate if the designated constraints aren't met.
+type LinkIdentityRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2759,24 +2810,24 @@ type AuthorizeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthorizeResponseValidationError) Field() string { return e.field }
+func (e LinkIdentityRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthorizeResponseValidationError) Reason() string { return e.reason }
+func (e LinkIdentityRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthorizeResponseValidationError) Cause() error { return e.cause }
+func (e LinkIdentityRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthorizeResponseValidationError) Key() bool { return e.key }
+func (e LinkIdentityRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthorizeResponseValidationError) ErrorName() string {
-	return "AuthorizeResponseValidationError"
+func (e LinkIdentityRequestValidationError) ErrorName() string {
+	return "LinkIdentityRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthorizeResponseValidationError) Error() string {
+func (e LinkIdentityRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2788,14 +2839,14 @@ func (e AuthorizeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthorizeResponse.%s: %s%s",
+		"invalid %sLinkIdentityRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthorizeResponseValidationError{}
+var _ error = LinkIdentityRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2803,49 +2854,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthorizeResponseValidationError{}
+} = LinkIdentityRequestValidationError{}
 
-// Validate checks the field values on AuthRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AuthRequest) Validate() error {
+// Validate checks the field values on ListIdentityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListIdentityRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AuthRequestMultiError, or
-// nil if none found.
-func (m *AuthRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListIdentityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListIdentityRequestMultiError, or nil if none found.
+func (m *ListIdentityRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthRequest) validate(all bool) error {
+func (m *ListIdentityRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Token
-
-	// no validation rules for Obj
-
-	// no validation rules for Act
+	// no validation rules for Username
 
 	if len(errors) > 0 {
-		return AuthRequestMultiError(errors)
+		return ListIdentityRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthRequestMultiError is an error wrapping multiple validation errors
-// returned by AuthRequest.ValidateAll() if the designated constraints aren't met.
-type AuthRequestMultiError []error
+// ListIdentityRequestMultiError is an error wrapping multiple validation
+// errors returned by ListIdentityRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListIdentityRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthRequestMultiError) Error() string {
+func (m ListIdentityRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2854,11 +2902,11 @@ func (m AuthRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthRequestMultiError) AllErrors() []error { return m }
+func (m ListIdentityRequestMultiError) AllErrors() []error { return m }
 
-// AuthRequestValidationError is the validation error returned by
-// AuthRequest.Validate if the designated constraints aren't met.
-type AuthRequestValidationError struct {
+// ListIdentityRequestValidationError is the validation error returned by
+// ListIdentityRequest.Validate if the designated constraints aren't met.
+type ListIdentityRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2866,22 +2914,24 @@ type AuthRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthRequestValidationError) Field() string { return e.field }
+func (e ListIdentityRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthRequestValidationError) Reason() string { return e.reason }
+func (e ListIdentityRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthRequestValidationError) Cause() error { return e.cause }
+func (e ListIdentityRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthRequestValidationError) Key() bool { return e.key }
+func (e ListIdentityRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthRequestValidationError) ErrorName() string { return "AuthRequestValidationError" }
+func (e ListIdentityRequestValidationError) ErrorName() string {
+	return "ListIdentityRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AuthRequestValidationError) Error() string {
+func (e ListIdentityRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2893,14 +2943,14 @@ func (e AuthRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthRequest.%s: %s%s",
+		"invalid %sListIdentityRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthRequestValidationError{}
+var _ error = ListIdentityRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2908,47 +2958,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthRequestValidationError{}
+} = ListIdentityRequestValidationError{}
 
-// Validate checks the field values on AuthResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AuthResponse) Validate() error {
+// Validate checks the field values on ListIdentityResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListIdentityResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AuthResponseMultiError, or
-// nil if none found.
-func (m *AuthResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListIdentityResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListIdentityResponseMultiError, or nil if none found.
+func (m *ListIdentityResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthResponse) validate(all bool) error {
+func (m *ListIdentityResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserID
+	// no validation rules for TotalCount
 
-	// no validation rules for Allowed
+	for idx, item := range m.GetIdentities() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListIdentityResponseValidationError{
+						field:  fmt.Sprintf("Identities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListIdentityResponseValidationError{
+						field:  fmt.Sprintf("Identities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListIdentityResponseValidationError{
+					field:  fmt.Sprintf("Identities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return AuthResponseMultiError(errors)
+		return ListIdentityResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthResponseMultiError is an error wrapping multiple validation errors
-// returned by AuthResponse.ValidateAll() if the designated constraints aren't met.
-type AuthResponseMultiError []error
+// ListIdentityResponseMultiError is an error wrapping multiple validation
+// errors returned by ListIdentityResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListIdentityResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthResponseMultiError) Error() string {
+func (m ListIdentityResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2957,11 +3040,11 @@ func (m AuthResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthResponseMultiError) AllErrors() []error { return m }
+func (m ListIdentityResponseMultiError) AllErrors() []error { return m }
 
-// AuthResponseValidationError is the validation error returned by
-// AuthResponse.Validate if the designated constraints aren't met.
-type AuthResponseValidationError struct {
+// ListIdentityResponseValidationError is the validation error returned by
+// ListIdentityResponse.Validate if the designated constraints aren't met.
+type ListIdentityResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2969,22 +3052,24 @@ type AuthResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthResponseValidationError) Field() string { return e.field }
+func (e ListIdentityResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthResponseValidationError) Reason() string { return e.reason }
+func (e ListIdentityResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthResponseValidationError) Cause() error { return e.cause }
+func (e ListIdentityResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthResponseValidationError) Key() bool { return e.key }
+func (e ListIdentityResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthResponseValidationError) ErrorName() string { return "AuthResponseValidationError" }
+func (e ListIdentityResponseValidationError) ErrorName() string {
+	return "ListIdentityResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AuthResponseValidationError) Error() string {
+func (e ListIdentityResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2996,14 +3081,14 @@ func (e AuthResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthResponse.%s: %s%s",
+		"invalid %sListIdentityResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthResponseValidationError{}
+var _ error = ListIdentityResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3011,4 +3096,4514 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthResponseValidationError{}
+} = ListIdentityResponseValidationError{}
+
+// Validate checks the field values on DeleteIdentityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteIdentityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteIdentityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteIdentityRequestMultiError, or nil if none found.
+func (m *DeleteIdentityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteIdentityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Username
+
+	// no validation rules for Subject
+
+	if len(errors) > 0 {
+		return DeleteIdentityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteIdentityRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteIdentityRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteIdentityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteIdentityRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteIdentityRequestMultiError) AllErrors() []error { return m }
+
+// DeleteIdentityRequestValidationError is the validation error returned by
+// DeleteIdentityRequest.Validate if the designated constraints aren't met.
+type DeleteIdentityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteIdentityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteIdentityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteIdentityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteIdentityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteIdentityRequestValidationError) ErrorName() string {
+	return "DeleteIdentityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteIdentityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteIdentityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteIdentityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteIdentityRequestValidationError{}
+
+// Validate checks the field values on SessionReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SessionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SessionReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SessionReplyMultiError, or
+// nil if none found.
+func (m *SessionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SessionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionID
+
+	// no validation rules for Device
+
+	// no validation rules for Ip
+
+	// no validation rules for UserAgent
+
+	// no validation rules for Current
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionReplyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionReplyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionReplyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetIssuedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionReplyValidationError{
+					field:  "IssuedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionReplyValidationError{
+					field:  "IssuedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIssuedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionReplyValidationError{
+				field:  "IssuedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionReplyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionReplyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionReplyValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SessionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SessionReplyMultiError is an error wrapping multiple validation errors
+// returned by SessionReply.ValidateAll() if the designated constraints aren't met.
+type SessionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SessionReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SessionReplyMultiError) AllErrors() []error { return m }
+
+// SessionReplyValidationError is the validation error returned by
+// SessionReply.Validate if the designated constraints aren't met.
+type SessionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SessionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SessionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SessionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SessionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SessionReplyValidationError) ErrorName() string { return "SessionReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SessionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSessionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SessionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SessionReplyValidationError{}
+
+// Validate checks the field values on ListSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSessionRequestMultiError, or nil if none found.
+func (m *ListSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Username
+
+	if len(errors) > 0 {
+		return ListSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSessionRequestMultiError is an error wrapping multiple validation errors
+// returned by ListSessionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSessionRequestMultiError) AllErrors() []error { return m }
+
+// ListSessionRequestValidationError is the validation error returned by
+// ListSessionRequest.Validate if the designated constraints aren't met.
+type ListSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSessionRequestValidationError) ErrorName() string {
+	return "ListSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSessionRequestValidationError{}
+
+// Validate checks the field values on ListSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSessionResponseMultiError, or nil if none found.
+func (m *ListSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalCount
+
+	for idx, item := range m.GetSessions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSessionResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSessionResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSessionResponseValidationError{
+					field:  fmt.Sprintf("Sessions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSessionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSessionResponseMultiError) AllErrors() []error { return m }
+
+// ListSessionResponseValidationError is the validation error returned by
+// ListSessionResponse.Validate if the designated constraints aren't met.
+type ListSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSessionResponseValidationError) ErrorName() string {
+	return "ListSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSessionResponseValidationError{}
+
+// Validate checks the field values on RevokeSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeSessionRequestMultiError, or nil if none found.
+func (m *RevokeSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Username
+
+	if utf8.RuneCountInString(m.GetSessionID()) < 1 {
+		err := RevokeSessionRequestValidationError{
+			field:  "SessionID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSessionRequestMultiError) AllErrors() []error { return m }
+
+// RevokeSessionRequestValidationError is the validation error returned by
+// RevokeSessionRequest.Validate if the designated constraints aren't met.
+type RevokeSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSessionRequestValidationError) ErrorName() string {
+	return "RevokeSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSessionRequestValidationError{}
+
+// Validate checks the field values on RevokeSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeSessionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeSessionsRequestMultiError, or nil if none found.
+func (m *RevokeSessionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSessionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Username
+
+	// no validation rules for KeepCurrent
+
+	if len(errors) > 0 {
+		return RevokeSessionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeSessionsRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeSessionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeSessionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSessionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSessionsRequestMultiError) AllErrors() []error { return m }
+
+// RevokeSessionsRequestValidationError is the validation error returned by
+// RevokeSessionsRequest.Validate if the designated constraints aren't met.
+type RevokeSessionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSessionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSessionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSessionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSessionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSessionsRequestValidationError) ErrorName() string {
+	return "RevokeSessionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSessionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSessionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSessionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSessionsRequestValidationError{}
+
+// Validate checks the field values on SecretReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SecretReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecretReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SecretReplyMultiError, or
+// nil if none found.
+func (m *SecretReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecretReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserID
+
+	// no validation rules for Name
+
+	// no validation rules for SecretID
+
+	// no validation rules for SecretKey
+
+	// no validation rules for Expires
+
+	// no validation rules for Status
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecretReplyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecretReplyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecretReplyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecretReplyValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecretReplyValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecretReplyValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SecretReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecretReplyMultiError is an error wrapping multiple validation errors
+// returned by SecretReply.ValidateAll() if the designated constraints aren't met.
+type SecretReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecretReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecretReplyMultiError) AllErrors() []error { return m }
+
+// SecretReplyValidationError is the validation error returned by
+// SecretReply.Validate if the designated constraints aren't met.
+type SecretReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecretReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecretReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecretReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecretReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecretReplyValidationError) ErrorName() string { return "SecretReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SecretReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecretReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecretReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecretReplyValidationError{}
+
+// Validate checks the field values on GetSecretRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSecretRequestMultiError, or nil if none found.
+func (m *GetSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return GetSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSecretRequestMultiError is an error wrapping multiple validation errors
+// returned by GetSecretRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSecretRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSecretRequestMultiError) AllErrors() []error { return m }
+
+// GetSecretRequestValidationError is the validation error returned by
+// GetSecretRequest.Validate if the designated constraints aren't met.
+type GetSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSecretRequestValidationError) ErrorName() string { return "GetSecretRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSecretRequestValidationError{}
+
+// Validate checks the field values on DeleteSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSecretRequestMultiError, or nil if none found.
+func (m *DeleteSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return DeleteSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSecretRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSecretRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSecretRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSecretRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSecretRequestValidationError is the validation error returned by
+// DeleteSecretRequest.Validate if the designated constraints aren't met.
+type DeleteSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSecretRequestValidationError) ErrorName() string {
+	return "DeleteSecretRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSecretRequestValidationError{}
+
+// Validate checks the field values on UpdateSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSecretRequestMultiError, or nil if none found.
+func (m *UpdateSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if m.Expires != nil {
+		// no validation rules for Expires
+	}
+
+	if m.Status != nil {
+
+		if _, ok := _UpdateSecretRequest_Status_InLookup[m.GetStatus()]; !ok {
+			err := UpdateSecretRequestValidationError{
+				field:  "Status",
+				reason: "value must be in list [0 1]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Description != nil {
+
+		if utf8.RuneCountInString(m.GetDescription()) > 256 {
+			err := UpdateSecretRequestValidationError{
+				field:  "Description",
+				reason: "value length must be at most 256 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSecretRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateSecretRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSecretRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSecretRequestMultiError) AllErrors() []error { return m }
+
+// UpdateSecretRequestValidationError is the validation error returned by
+// UpdateSecretRequest.Validate if the designated constraints aren't met.
+type UpdateSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSecretRequestValidationError) ErrorName() string {
+	return "UpdateSecretRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSecretRequestValidationError{}
+
+var _UpdateSecretRequest_Status_InLookup = map[int32]struct{}{
+	0: {},
+	1: {},
+}
+
+// Validate checks the field values on ListSecretRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSecretRequestMultiError, or nil if none found.
+func (m *ListSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return ListSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSecretRequestMultiError is an error wrapping multiple validation errors
+// returned by ListSecretRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSecretRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSecretRequestMultiError) AllErrors() []error { return m }
+
+// ListSecretRequestValidationError is the validation error returned by
+// ListSecretRequest.Validate if the designated constraints aren't met.
+type ListSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSecretRequestValidationError) ErrorName() string {
+	return "ListSecretRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSecretRequestValidationError{}
+
+// Validate checks the field values on ListSecretResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSecretResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSecretResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSecretResponseMultiError, or nil if none found.
+func (m *ListSecretResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSecretResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalCount
+
+	for idx, item := range m.GetSecrets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSecretResponseValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSecretResponseValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSecretResponseValidationError{
+					field:  fmt.Sprintf("Secrets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSecretResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSecretResponseMultiError is an error wrapping multiple validation errors
+// returned by ListSecretResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListSecretResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSecretResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSecretResponseMultiError) AllErrors() []error { return m }
+
+// ListSecretResponseValidationError is the validation error returned by
+// ListSecretResponse.Validate if the designated constraints aren't met.
+type ListSecretResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSecretResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSecretResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSecretResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSecretResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSecretResponseValidationError) ErrorName() string {
+	return "ListSecretResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSecretResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSecretResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSecretResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSecretResponseValidationError{}
+
+// Validate checks the field values on CreateSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSecretRequestMultiError, or nil if none found.
+func (m *CreateSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 253 {
+		err := CreateSecretRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 253 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Expires
+
+	if utf8.RuneCountInString(m.GetDescription()) > 256 {
+		err := CreateSecretRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSecretRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSecretRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSecretRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSecretRequestMultiError) AllErrors() []error { return m }
+
+// CreateSecretRequestValidationError is the validation error returned by
+// CreateSecretRequest.Validate if the designated constraints aren't met.
+type CreateSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSecretRequestValidationError) ErrorName() string {
+	return "CreateSecretRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSecretRequestValidationError{}
+
+// Validate checks the field values on AuthenticateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthenticateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthenticateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthenticateRequestMultiError, or nil if none found.
+func (m *AuthenticateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthenticateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return AuthenticateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthenticateRequestMultiError is an error wrapping multiple validation
+// errors returned by AuthenticateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AuthenticateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthenticateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthenticateRequestMultiError) AllErrors() []error { return m }
+
+// AuthenticateRequestValidationError is the validation error returned by
+// AuthenticateRequest.Validate if the designated constraints aren't met.
+type AuthenticateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthenticateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthenticateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthenticateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthenticateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthenticateRequestValidationError) ErrorName() string {
+	return "AuthenticateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthenticateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthenticateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthenticateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthenticateRequestValidationError{}
+
+// Validate checks the field values on AuthenticateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthenticateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthenticateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthenticateResponseMultiError, or nil if none found.
+func (m *AuthenticateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthenticateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserID
+
+	if len(errors) > 0 {
+		return AuthenticateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthenticateResponseMultiError is an error wrapping multiple validation
+// errors returned by AuthenticateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AuthenticateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthenticateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthenticateResponseMultiError) AllErrors() []error { return m }
+
+// AuthenticateResponseValidationError is the validation error returned by
+// AuthenticateResponse.Validate if the designated constraints aren't met.
+type AuthenticateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthenticateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthenticateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthenticateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthenticateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthenticateResponseValidationError) ErrorName() string {
+	return "AuthenticateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthenticateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthenticateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthenticateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthenticateResponseValidationError{}
+
+// Validate checks the field values on VerifySignatureRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifySignatureRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifySignatureRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifySignatureRequestMultiError, or nil if none found.
+func (m *VerifySignatureRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifySignatureRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SecretID
+
+	// no validation rules for StringToSign
+
+	// no validation rules for Signature
+
+	if len(errors) > 0 {
+		return VerifySignatureRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifySignatureRequestMultiError is an error wrapping multiple validation
+// errors returned by VerifySignatureRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VerifySignatureRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifySignatureRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifySignatureRequestMultiError) AllErrors() []error { return m }
+
+// VerifySignatureRequestValidationError is the validation error returned by
+// VerifySignatureRequest.Validate if the designated constraints aren't met.
+type VerifySignatureRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifySignatureRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifySignatureRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifySignatureRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifySignatureRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifySignatureRequestValidationError) ErrorName() string {
+	return "VerifySignatureRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifySignatureRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifySignatureRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifySignatureRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifySignatureRequestValidationError{}
+
+// Validate checks the field values on AuthorizeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuthorizeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorizeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorizeRequestMultiError, or nil if none found.
+func (m *AuthorizeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorizeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sub
+
+	// no validation rules for Dom
+
+	// no validation rules for Obj
+
+	// no validation rules for Act
+
+	if len(errors) > 0 {
+		return AuthorizeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorizeRequestMultiError is an error wrapping multiple validation errors
+// returned by AuthorizeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AuthorizeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorizeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorizeRequestMultiError) AllErrors() []error { return m }
+
+// AuthorizeRequestValidationError is the validation error returned by
+// AuthorizeRequest.Validate if the designated constraints aren't met.
+type AuthorizeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorizeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorizeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorizeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorizeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorizeRequestValidationError) ErrorName() string { return "AuthorizeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorizeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorizeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorizeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorizeRequestValidationError{}
+
+// Validate checks the field values on AuthorizeResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuthorizeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorizeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorizeResponseMultiError, or nil if none found.
+func (m *AuthorizeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorizeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Allowed
+
+	if len(errors) > 0 {
+		return AuthorizeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorizeResponseMultiError is an error wrapping multiple validation errors
+// returned by AuthorizeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AuthorizeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorizeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorizeResponseMultiError) AllErrors() []error { return m }
+
+// AuthorizeResponseValidationError is the validation error returned by
+// AuthorizeResponse.Validate if the designated constraints aren't met.
+type AuthorizeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorizeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorizeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorizeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorizeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorizeResponseValidationError) ErrorName() string {
+	return "AuthorizeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthorizeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorizeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorizeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorizeResponseValidationError{}
+
+// Validate checks the field values on AuthRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuthRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuthRequestMultiError, or
+// nil if none found.
+func (m *AuthRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for Obj
+
+	// no validation rules for Act
+
+	// no validation rules for Dom
+
+	if len(errors) > 0 {
+		return AuthRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthRequestMultiError is an error wrapping multiple validation errors
+// returned by AuthRequest.ValidateAll() if the designated constraints aren't met.
+type AuthRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthRequestMultiError) AllErrors() []error { return m }
+
+// AuthRequestValidationError is the validation error returned by
+// AuthRequest.Validate if the designated constraints aren't met.
+type AuthRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthRequestValidationError) ErrorName() string { return "AuthRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthRequestValidationError{}
+
+// Validate checks the field values on AuthResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuthResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuthResponseMultiError, or
+// nil if none found.
+func (m *AuthResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserID
+
+	// no validation rules for Allowed
+
+	if len(errors) > 0 {
+		return AuthResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthResponseMultiError is an error wrapping multiple validation errors
+// returned by AuthResponse.ValidateAll() if the designated constraints aren't met.
+type AuthResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthResponseMultiError) AllErrors() []error { return m }
+
+// AuthResponseValidationError is the validation error returned by
+// AuthResponse.Validate if the designated constraints aren't met.
+type AuthResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthResponseValidationError) ErrorName() string { return "AuthResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthResponseValidationError{}
+
+// Validate checks the field values on RoleReply with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoleReplyMultiError, or nil
+// if none found.
+func (m *RoleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoleReplyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoleReplyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoleReplyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoleReplyValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoleReplyValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoleReplyValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RoleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleReplyMultiError is an error wrapping multiple validation errors returned
+// by RoleReply.ValidateAll() if the designated constraints aren't met.
+type RoleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleReplyMultiError) AllErrors() []error { return m }
+
+// RoleReplyValidationError is the validation error returned by
+// RoleReply.Validate if the designated constraints aren't met.
+type RoleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleReplyValidationError) ErrorName() string { return "RoleReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleReplyValidationError{}
+
+// Validate checks the field values on CreateRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRoleRequestMultiError, or nil if none found.
+func (m *CreateRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := CreateRoleRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 256 {
+		err := CreateRoleRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRoleRequestMultiError) AllErrors() []error { return m }
+
+// CreateRoleRequestValidationError is the validation error returned by
+// CreateRoleRequest.Validate if the designated constraints aren't met.
+type CreateRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRoleRequestValidationError) ErrorName() string {
+	return "CreateRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRoleRequestValidationError{}
+
+// Validate checks the field values on ListRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRoleRequestMultiError, or nil if none found.
+func (m *ListRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return ListRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by ListRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRoleRequestMultiError) AllErrors() []error { return m }
+
+// ListRoleRequestValidationError is the validation error returned by
+// ListRoleRequest.Validate if the designated constraints aren't met.
+type ListRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRoleRequestValidationError) ErrorName() string { return "ListRoleRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRoleRequestValidationError{}
+
+// Validate checks the field values on ListRoleResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRoleResponseMultiError, or nil if none found.
+func (m *ListRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalCount
+
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRoleResponseValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRoleResponseValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRoleResponseValidationError{
+					field:  fmt.Sprintf("Roles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRoleResponseMultiError is an error wrapping multiple validation errors
+// returned by ListRoleResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRoleResponseMultiError) AllErrors() []error { return m }
+
+// ListRoleResponseValidationError is the validation error returned by
+// ListRoleResponse.Validate if the designated constraints aren't met.
+type ListRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRoleResponseValidationError) ErrorName() string { return "ListRoleResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRoleResponseValidationError{}
+
+// Validate checks the field values on GetRoleRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetRoleRequestMultiError,
+// or nil if none found.
+func (m *GetRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return GetRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRoleRequestMultiError) AllErrors() []error { return m }
+
+// GetRoleRequestValidationError is the validation error returned by
+// GetRoleRequest.Validate if the designated constraints aren't met.
+type GetRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRoleRequestValidationError) ErrorName() string { return "GetRoleRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRoleRequestValidationError{}
+
+// Validate checks the field values on UpdateRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRoleRequestMultiError, or nil if none found.
+func (m *UpdateRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if m.Description != nil {
+
+		if utf8.RuneCountInString(m.GetDescription()) > 256 {
+			err := UpdateRoleRequestValidationError{
+				field:  "Description",
+				reason: "value length must be at most 256 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRoleRequestMultiError) AllErrors() []error { return m }
+
+// UpdateRoleRequestValidationError is the validation error returned by
+// UpdateRoleRequest.Validate if the designated constraints aren't met.
+type UpdateRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRoleRequestValidationError) ErrorName() string {
+	return "UpdateRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRoleRequestValidationError{}
+
+// Validate checks the field values on DeleteRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRoleRequestMultiError, or nil if none found.
+func (m *DeleteRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return DeleteRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRoleRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRoleRequestValidationError is the validation error returned by
+// DeleteRoleRequest.Validate if the designated constraints aren't met.
+type DeleteRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRoleRequestValidationError) ErrorName() string {
+	return "DeleteRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRoleRequestValidationError{}
+
+// Validate checks the field values on RoleBindingReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RoleBindingReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleBindingReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RoleBindingReplyMultiError, or nil if none found.
+func (m *RoleBindingReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleBindingReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Role
+
+	// no validation rules for Username
+
+	// no validation rules for UserID
+
+	// no validation rules for Domain
+
+	if len(errors) > 0 {
+		return RoleBindingReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleBindingReplyMultiError is an error wrapping multiple validation errors
+// returned by RoleBindingReply.ValidateAll() if the designated constraints
+// aren't met.
+type RoleBindingReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleBindingReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleBindingReplyMultiError) AllErrors() []error { return m }
+
+// RoleBindingReplyValidationError is the validation error returned by
+// RoleBindingReply.Validate if the designated constraints aren't met.
+type RoleBindingReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleBindingReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleBindingReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleBindingReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleBindingReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleBindingReplyValidationError) ErrorName() string { return "RoleBindingReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoleBindingReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoleBindingReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleBindingReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleBindingReplyValidationError{}
+
+// Validate checks the field values on CreateRoleBindingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRoleBindingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRoleBindingRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRoleBindingRequestMultiError, or nil if none found.
+func (m *CreateRoleBindingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRoleBindingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := CreateRoleBindingRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+		err := CreateRoleBindingRequestValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDomain()) > 100 {
+		err := CreateRoleBindingRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateRoleBindingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRoleBindingRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateRoleBindingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateRoleBindingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRoleBindingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRoleBindingRequestMultiError) AllErrors() []error { return m }
+
+// CreateRoleBindingRequestValidationError is the validation error returned by
+// CreateRoleBindingRequest.Validate if the designated constraints aren't met.
+type CreateRoleBindingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRoleBindingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRoleBindingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRoleBindingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRoleBindingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRoleBindingRequestValidationError) ErrorName() string {
+	return "CreateRoleBindingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRoleBindingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRoleBindingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRoleBindingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRoleBindingRequestValidationError{}
+
+// Validate checks the field values on ListRoleBindingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRoleBindingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRoleBindingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRoleBindingRequestMultiError, or nil if none found.
+func (m *ListRoleBindingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRoleBindingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Role
+
+	// no validation rules for Username
+
+	// no validation rules for Domain
+
+	if len(errors) > 0 {
+		return ListRoleBindingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRoleBindingRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRoleBindingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListRoleBindingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRoleBindingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRoleBindingRequestMultiError) AllErrors() []error { return m }
+
+// ListRoleBindingRequestValidationError is the validation error returned by
+// ListRoleBindingRequest.Validate if the designated constraints aren't met.
+type ListRoleBindingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRoleBindingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRoleBindingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRoleBindingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRoleBindingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRoleBindingRequestValidationError) ErrorName() string {
+	return "ListRoleBindingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRoleBindingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRoleBindingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRoleBindingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRoleBindingRequestValidationError{}
+
+// Validate checks the field values on ListRoleBindingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRoleBindingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRoleBindingResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRoleBindingResponseMultiError, or nil if none found.
+func (m *ListRoleBindingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRoleBindingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalCount
+
+	for idx, item := range m.GetRoleBindings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRoleBindingResponseValidationError{
+						field:  fmt.Sprintf("RoleBindings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRoleBindingResponseValidationError{
+						field:  fmt.Sprintf("RoleBindings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRoleBindingResponseValidationError{
+					field:  fmt.Sprintf("RoleBindings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRoleBindingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRoleBindingResponseMultiError is an error wrapping multiple validation
+// errors returned by ListRoleBindingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListRoleBindingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRoleBindingResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRoleBindingResponseMultiError) AllErrors() []error { return m }
+
+// ListRoleBindingResponseValidationError is the validation error returned by
+// ListRoleBindingResponse.Validate if the designated constraints aren't met.
+type ListRoleBindingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRoleBindingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRoleBindingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRoleBindingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRoleBindingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRoleBindingResponseValidationError) ErrorName() string {
+	return "ListRoleBindingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRoleBindingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRoleBindingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRoleBindingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRoleBindingResponseValidationError{}
+
+// Validate checks the field values on DeleteRoleBindingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRoleBindingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRoleBindingRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRoleBindingRequestMultiError, or nil if none found.
+func (m *DeleteRoleBindingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRoleBindingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Role
+
+	// no validation rules for Username
+
+	// no validation rules for Domain
+
+	if len(errors) > 0 {
+		return DeleteRoleBindingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRoleBindingRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteRoleBindingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteRoleBindingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRoleBindingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRoleBindingRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRoleBindingRequestValidationError is the validation error returned by
+// DeleteRoleBindingRequest.Validate if the designated constraints aren't met.
+type DeleteRoleBindingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRoleBindingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRoleBindingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRoleBindingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRoleBindingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRoleBindingRequestValidationError) ErrorName() string {
+	return "DeleteRoleBindingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRoleBindingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRoleBindingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRoleBindingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRoleBindingRequestValidationError{}
+
+// Validate checks the field values on PolicyReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PolicyReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PolicyReplyMultiError, or
+// nil if none found.
+func (m *PolicyReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sub
+
+	// no validation rules for Obj
+
+	// no validation rules for Act
+
+	// no validation rules for Eft
+
+	// no validation rules for Dom
+
+	if len(errors) > 0 {
+		return PolicyReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyReplyMultiError is an error wrapping multiple validation errors
+// returned by PolicyReply.ValidateAll() if the designated constraints aren't met.
+type PolicyReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyReplyMultiError) AllErrors() []error { return m }
+
+// PolicyReplyValidationError is the validation error returned by
+// PolicyReply.Validate if the designated constraints aren't met.
+type PolicyReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyReplyValidationError) ErrorName() string { return "PolicyReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PolicyReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyReplyValidationError{}
+
+// Validate checks the field values on CreatePolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePolicyRequestMultiError, or nil if none found.
+func (m *CreatePolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetSub()); l < 1 || l > 100 {
+		err := CreatePolicyRequestValidationError{
+			field:  "Sub",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetObj()); l < 1 || l > 100 {
+		err := CreatePolicyRequestValidationError{
+			field:  "Obj",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetAct()); l < 1 || l > 100 {
+		err := CreatePolicyRequestValidationError{
+			field:  "Act",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreatePolicyRequest_Eft_InLookup[m.GetEft()]; !ok {
+		err := CreatePolicyRequestValidationError{
+			field:  "Eft",
+			reason: "value must be in list [ allow deny]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDom()) > 100 {
+		err := CreatePolicyRequestValidationError{
+			field:  "Dom",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreatePolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by CreatePolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePolicyRequestMultiError) AllErrors() []error { return m }
+
+// CreatePolicyRequestValidationError is the validation error returned by
+// CreatePolicyRequest.Validate if the designated constraints aren't met.
+type CreatePolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePolicyRequestValidationError) ErrorName() string {
+	return "CreatePolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePolicyRequestValidationError{}
+
+var _CreatePolicyRequest_Eft_InLookup = map[string]struct{}{
+	"":      {},
+	"allow": {},
+	"deny":  {},
+}
+
+// Validate checks the field values on ListPolicyRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPolicyRequestMultiError, or nil if none found.
+func (m *ListPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sub
+
+	// no validation rules for Dom
+
+	if len(errors) > 0 {
+		return ListPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPolicyRequestMultiError is an error wrapping multiple validation errors
+// returned by ListPolicyRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPolicyRequestMultiError) AllErrors() []error { return m }
+
+// ListPolicyRequestValidationError is the validation error returned by
+// ListPolicyRequest.Validate if the designated constraints aren't met.
+type ListPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPolicyRequestValidationError) ErrorName() string {
+	return "ListPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPolicyRequestValidationError{}
+
+// Validate checks the field values on ListPolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPolicyResponseMultiError, or nil if none found.
+func (m *ListPolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalCount
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPolicyResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPolicyResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPolicyResponseValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPolicyResponseMultiError is an error wrapping multiple validation errors
+// returned by ListPolicyResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListPolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPolicyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPolicyResponseMultiError) AllErrors() []error { return m }
+
+// ListPolicyResponseValidationError is the validation error returned by
+// ListPolicyResponse.Validate if the designated constraints aren't met.
+type ListPolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPolicyResponseValidationError) ErrorName() string {
+	return "ListPolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPolicyResponseValidationError{}
+
+// Validate checks the field values on DeletePolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePolicyRequestMultiError, or nil if none found.
+func (m *DeletePolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSub()) < 1 {
+		err := DeletePolicyRequestValidationError{
+			field:  "Sub",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetObj()) < 1 {
+		err := DeletePolicyRequestValidationError{
+			field:  "Obj",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAct()) < 1 {
+		err := DeletePolicyRequestValidationError{
+			field:  "Act",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _DeletePolicyRequest_Eft_InLookup[m.GetEft()]; !ok {
+		err := DeletePolicyRequestValidationError{
+			field:  "Eft",
+			reason: "value must be in list [ allow deny]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Dom
+
+	if len(errors) > 0 {
+		return DeletePolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by DeletePolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePolicyRequestMultiError) AllErrors() []error { return m }
+
+// DeletePolicyRequestValidationError is the validation error returned by
+// DeletePolicyRequest.Validate if the designated constraints aren't met.
+type DeletePolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePolicyRequestValidationError) ErrorName() string {
+	return "DeletePolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePolicyRequestValidationError{}
+
+var _DeletePolicyRequest_Eft_InLookup = map[string]struct{}{
+	"":      {},
+	"allow": {},
+	"deny":  {},
+}
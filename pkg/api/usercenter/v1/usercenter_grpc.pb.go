@@ -26,23 +26,48 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	UserCenter_Login_FullMethodName          = "/usercenter.v1.UserCenter/Login"
-	UserCenter_Logout_FullMethodName         = "/usercenter.v1.UserCenter/Logout"
-	UserCenter_RefreshToken_FullMethodName   = "/usercenter.v1.UserCenter/RefreshToken"
-	UserCenter_Authenticate_FullMethodName   = "/usercenter.v1.UserCenter/Authenticate"
-	UserCenter_Authorize_FullMethodName      = "/usercenter.v1.UserCenter/Authorize"
-	UserCenter_Auth_FullMethodName           = "/usercenter.v1.UserCenter/Auth"
-	UserCenter_CreateUser_FullMethodName     = "/usercenter.v1.UserCenter/CreateUser"
-	UserCenter_ListUser_FullMethodName       = "/usercenter.v1.UserCenter/ListUser"
-	UserCenter_GetUser_FullMethodName        = "/usercenter.v1.UserCenter/GetUser"
-	UserCenter_UpdateUser_FullMethodName     = "/usercenter.v1.UserCenter/UpdateUser"
-	UserCenter_DeleteUser_FullMethodName     = "/usercenter.v1.UserCenter/DeleteUser"
-	UserCenter_UpdatePassword_FullMethodName = "/usercenter.v1.UserCenter/UpdatePassword"
-	UserCenter_CreateSecret_FullMethodName   = "/usercenter.v1.UserCenter/CreateSecret"
-	UserCenter_ListSecret_FullMethodName     = "/usercenter.v1.UserCenter/ListSecret"
-	UserCenter_GetSecret_FullMethodName      = "/usercenter.v1.UserCenter/GetSecret"
-	UserCenter_UpdateSecret_FullMethodName   = "/usercenter.v1.UserCenter/UpdateSecret"
-	UserCenter_DeleteSecret_FullMethodName   = "/usercenter.v1.UserCenter/DeleteSecret"
+	UserCenter_Login_FullMethodName                   = "/usercenter.v1.UserCenter/Login"
+	UserCenter_LoginMFA_FullMethodName                = "/usercenter.v1.UserCenter/LoginMFA"
+	UserCenter_OIDCAuthorize_FullMethodName           = "/usercenter.v1.UserCenter/OIDCAuthorize"
+	UserCenter_OIDCCallback_FullMethodName            = "/usercenter.v1.UserCenter/OIDCCallback"
+	UserCenter_Logout_FullMethodName                  = "/usercenter.v1.UserCenter/Logout"
+	UserCenter_RefreshToken_FullMethodName            = "/usercenter.v1.UserCenter/RefreshToken"
+	UserCenter_Authenticate_FullMethodName            = "/usercenter.v1.UserCenter/Authenticate"
+	UserCenter_VerifySignature_FullMethodName         = "/usercenter.v1.UserCenter/VerifySignature"
+	UserCenter_Authorize_FullMethodName               = "/usercenter.v1.UserCenter/Authorize"
+	UserCenter_Auth_FullMethodName                    = "/usercenter.v1.UserCenter/Auth"
+	UserCenter_CreateUser_FullMethodName              = "/usercenter.v1.UserCenter/CreateUser"
+	UserCenter_ListUser_FullMethodName                = "/usercenter.v1.UserCenter/ListUser"
+	UserCenter_GetUser_FullMethodName                 = "/usercenter.v1.UserCenter/GetUser"
+	UserCenter_UpdateUser_FullMethodName              = "/usercenter.v1.UserCenter/UpdateUser"
+	UserCenter_DeleteUser_FullMethodName              = "/usercenter.v1.UserCenter/DeleteUser"
+	UserCenter_UpdatePassword_FullMethodName          = "/usercenter.v1.UserCenter/UpdatePassword"
+	UserCenter_EnrollTOTP_FullMethodName              = "/usercenter.v1.UserCenter/EnrollTOTP"
+	UserCenter_ActivateTOTP_FullMethodName            = "/usercenter.v1.UserCenter/ActivateTOTP"
+	UserCenter_DisableTOTP_FullMethodName             = "/usercenter.v1.UserCenter/DisableTOTP"
+	UserCenter_RegenerateRecoveryCodes_FullMethodName = "/usercenter.v1.UserCenter/RegenerateRecoveryCodes"
+	UserCenter_LinkIdentity_FullMethodName            = "/usercenter.v1.UserCenter/LinkIdentity"
+	UserCenter_ListIdentity_FullMethodName            = "/usercenter.v1.UserCenter/ListIdentity"
+	UserCenter_DeleteIdentity_FullMethodName          = "/usercenter.v1.UserCenter/DeleteIdentity"
+	UserCenter_ListSession_FullMethodName             = "/usercenter.v1.UserCenter/ListSession"
+	UserCenter_RevokeSession_FullMethodName           = "/usercenter.v1.UserCenter/RevokeSession"
+	UserCenter_RevokeSessions_FullMethodName          = "/usercenter.v1.UserCenter/RevokeSessions"
+	UserCenter_CreateSecret_FullMethodName            = "/usercenter.v1.UserCenter/CreateSecret"
+	UserCenter_ListSecret_FullMethodName              = "/usercenter.v1.UserCenter/ListSecret"
+	UserCenter_GetSecret_FullMethodName               = "/usercenter.v1.UserCenter/GetSecret"
+	UserCenter_UpdateSecret_FullMethodName            = "/usercenter.v1.UserCenter/UpdateSecret"
+	UserCenter_DeleteSecret_FullMethodName            = "/usercenter.v1.UserCenter/DeleteSecret"
+	UserCenter_CreateRole_FullMethodName              = "/usercenter.v1.UserCenter/CreateRole"
+	UserCenter_ListRole_FullMethodName                = "/usercenter.v1.UserCenter/ListRole"
+	UserCenter_GetRole_FullMethodName                 = "/usercenter.v1.UserCenter/GetRole"
+	UserCenter_UpdateRole_FullMethodName              = "/usercenter.v1.UserCenter/UpdateRole"
+	UserCenter_DeleteRole_FullMethodName              = "/usercenter.v1.UserCenter/DeleteRole"
+	UserCenter_CreateRoleBinding_FullMethodName       = "/usercenter.v1.UserCenter/CreateRoleBinding"
+	UserCenter_ListRoleBinding_FullMethodName         = "/usercenter.v1.UserCenter/ListRoleBinding"
+	UserCenter_DeleteRoleBinding_FullMethodName       = "/usercenter.v1.UserCenter/DeleteRoleBinding"
+	UserCenter_CreatePolicy_FullMethodName            = "/usercenter.v1.UserCenter/CreatePolicy"
+	UserCenter_ListPolicy_FullMethodName              = "/usercenter.v1.UserCenter/ListPolicy"
+	UserCenter_DeletePolicy_FullMethodName            = "/usercenter.v1.UserCenter/DeletePolicy"
 )
 
 // UserCenterClient is the client API for UserCenter service.
@@ -51,12 +76,20 @@ const (
 type UserCenterClient interface {
 	// Login
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginReply, error)
+	// LoginMFA completes a login which requires a second factor.
+	LoginMFA(ctx context.Context, in *LoginMFARequest, opts ...grpc.CallOption) (*LoginReply, error)
+	// OIDCAuthorize starts a login with the OpenID provider.
+	OIDCAuthorize(ctx context.Context, in *OIDCAuthorizeRequest, opts ...grpc.CallOption) (*OIDCAuthorizeReply, error)
+	// OIDCCallback completes a login, or links an identity, with the code returned by the OpenID provider.
+	OIDCCallback(ctx context.Context, in *OIDCCallbackRequest, opts ...grpc.CallOption) (*LoginReply, error)
 	// Logout
 	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// RefreshToken
 	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*LoginReply, error)
 	// Authenticate
 	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
+	// VerifySignature verifies a request signed with a secret, and returns the owner of the secret.
+	VerifySignature(ctx context.Context, in *VerifySignatureRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
 	// Authorize
 	Authorize(ctx context.Context, in *AuthorizeRequest, opts ...grpc.CallOption) (*AuthorizeResponse, error)
 	// Auth
@@ -73,6 +106,26 @@ type UserCenterClient interface {
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// UpdatePassword
 	UpdatePassword(ctx context.Context, in *UpdatePasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// EnrollTOTP generates a new TOTP secret for a user, which takes effect after activation.
+	EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPReply, error)
+	// ActivateTOTP verifies the first passcode of an enrolled secret and enables TOTP.
+	ActivateTOTP(ctx context.Context, in *ActivateTOTPRequest, opts ...grpc.CallOption) (*RecoveryCodesReply, error)
+	// DisableTOTP
+	DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RegenerateRecoveryCodes
+	RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*RecoveryCodesReply, error)
+	// LinkIdentity starts linking an identity of the OpenID provider to a user.
+	LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...grpc.CallOption) (*OIDCAuthorizeReply, error)
+	// ListIdentity
+	ListIdentity(ctx context.Context, in *ListIdentityRequest, opts ...grpc.CallOption) (*ListIdentityResponse, error)
+	// DeleteIdentity unlinks an identity of the OpenID provider from a user.
+	DeleteIdentity(ctx context.Context, in *DeleteIdentityRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListSession lists the active login sessions of a user.
+	ListSession(ctx context.Context, in *ListSessionRequest, opts ...grpc.CallOption) (*ListSessionResponse, error)
+	// RevokeSession revokes a login session, invalidating both its refresh token and access token.
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RevokeSessions revokes all the login sessions of a user.
+	RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// CreateSecret
 	CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...grpc.CallOption) (*SecretReply, error)
 	// ListSecret
@@ -83,6 +136,28 @@ type UserCenterClient interface {
 	UpdateSecret(ctx context.Context, in *UpdateSecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// DeleteSecret
 	DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CreateRole
+	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*RoleReply, error)
+	// ListRole
+	ListRole(ctx context.Context, in *ListRoleRequest, opts ...grpc.CallOption) (*ListRoleResponse, error)
+	// GetRole
+	GetRole(ctx context.Context, in *GetRoleRequest, opts ...grpc.CallOption) (*RoleReply, error)
+	// UpdateRole
+	UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DeleteRole
+	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CreateRoleBinding
+	CreateRoleBinding(ctx context.Context, in *CreateRoleBindingRequest, opts ...grpc.CallOption) (*RoleBindingReply, error)
+	// ListRoleBinding
+	ListRoleBinding(ctx context.Context, in *ListRoleBindingRequest, opts ...grpc.CallOption) (*ListRoleBindingResponse, error)
+	// DeleteRoleBinding
+	DeleteRoleBinding(ctx context.Context, in *DeleteRoleBindingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CreatePolicy
+	CreatePolicy(ctx context.Context, in *CreatePolicyRequest, opts ...grpc.CallOption) (*PolicyReply, error)
+	// ListPolicy
+	ListPolicy(ctx context.Context, in *ListPolicyRequest, opts ...grpc.CallOption) (*ListPolicyResponse, error)
+	// DeletePolicy
+	DeletePolicy(ctx context.Context, in *DeletePolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type userCenterClient struct {
@@ -102,6 +177,33 @@ func (c *userCenterClient) Login(ctx context.Context, in *LoginRequest, opts ...
 	return out, nil
 }
 
+func (c *userCenterClient) LoginMFA(ctx context.Context, in *LoginMFARequest, opts ...grpc.CallOption) (*LoginReply, error) {
+	out := new(LoginReply)
+	err := c.cc.Invoke(ctx, UserCenter_LoginMFA_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCenterClient) OIDCAuthorize(ctx context.Context, in *OIDCAuthorizeRequest, opts ...grpc.CallOption) (*OIDCAuthorizeReply, error) {
+	out := new(OIDCAuthorizeReply)
+	err := c.cc.Invoke(ctx, UserCenter_OIDCAuthorize_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCenterClient) OIDCCallback(ctx context.Context, in *OIDCCallbackRequest, opts ...grpc.CallOption) (*LoginReply, error) {
+	out := new(LoginReply)
+	err := c.cc.Invoke(ctx, UserCenter_OIDCCallback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userCenterClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, UserCenter_Logout_FullMethodName, in, out, opts...)
@@ -129,6 +231,15 @@ func (c *userCenterClient) Authenticate(ctx context.Context, in *AuthenticateReq
 	return out, nil
 }
 
+func (c *userCenterClient) VerifySignature(ctx context.Context, in *VerifySignatureRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error) {
+	out := new(AuthenticateResponse)
+	err := c.cc.Invoke(ctx, UserCenter_VerifySignature_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userCenterClient) Authorize(ctx context.Context, in *AuthorizeRequest, opts ...grpc.CallOption) (*AuthorizeResponse, error) {
 	out := new(AuthorizeResponse)
 	err := c.cc.Invoke(ctx, UserCenter_Authorize_FullMethodName, in, out, opts...)
@@ -201,6 +312,96 @@ func (c *userCenterClient) UpdatePassword(ctx context.Context, in *UpdatePasswor
 	return out, nil
 }
 
+func (c *userCenterClient) EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPReply, error) {
+	out := new(EnrollTOTPReply)
+	err := c.cc.Invoke(ctx, UserCenter_EnrollTOTP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCenterClient) ActivateTOTP(ctx context.Context, in *ActivateTOTPRequest, opts ...grpc.CallOption) (*RecoveryCodesReply, error) {
+	out := new(RecoveryCodesReply)
+	err := c.cc.Invoke(ctx, UserCenter_ActivateTOTP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCenterClient) DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserCenter_DisableTOTP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCenterClient) RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*RecoveryCodesReply, error) {
+	out := new(RecoveryCodesReply)
+	err := c.cc.Invoke(ctx, UserCenter_RegenerateRecoveryCodes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCenterClient) LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...grpc.CallOption) (*OIDCAuthorizeReply, error) {
+	out := new(OIDCAuthorizeReply)
+	err := c.cc.Invoke(ctx, UserCenter_LinkIdentity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCenterClient) ListIdentity(ctx context.Context, in *ListIdentityRequest, opts ...grpc.CallOption) (*ListIdentityResponse, error) {
+	out := new(ListIdentityResponse)
+	err := c.cc.Invoke(ctx, UserCenter_ListIdentity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCenterClient) DeleteIdentity(ctx context.Context, in *DeleteIdentityRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserCenter_DeleteIdentity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCenterClient) ListSession(ctx context.Context, in *ListSessionRequest, opts ...grpc.CallOption) (*ListSessionResponse, error) {
+	out := new(ListSessionResponse)
+	err := c.cc.Invoke(ctx, UserCenter_ListSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCenterClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserCenter_RevokeSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCenterClient) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserCenter_RevokeSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userCenterClient) CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...grpc.CallOption) (*SecretReply, error) {
 	out := new(SecretReply)
 	err := c.cc.Invoke(ctx, UserCenter_CreateSecret_FullMethodName, in, out, opts...)
@@ -246,18 +447,125 @@ func (c *userCenterClient) DeleteSecret(ctx context.Context, in *DeleteSecretReq
 	return out, nil
 }
 
+func (c *userCenterClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*RoleReply, error) {
+	out := new(RoleReply)
+	err := c.cc.Invoke(ctx, UserCenter_CreateRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCenterClient) ListRole(ctx context.Context, in *ListRoleRequest, opts ...grpc.CallOption) (*ListRoleResponse, error) {
+	out := new(ListRoleResponse)
+	err := c.cc.Invoke(ctx, UserCenter_ListRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCenterClient) GetRole(ctx context.Context, in *GetRoleRequest, opts ...grpc.CallOption) (*RoleReply, error) {
+	out := new(RoleReply)
+	err := c.cc.Invoke(ctx, UserCenter_GetRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCenterClient) UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserCenter_UpdateRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCenterClient) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserCenter_DeleteRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCenterClient) CreateRoleBinding(ctx context.Context, in *CreateRoleBindingRequest, opts ...grpc.CallOption) (*RoleBindingReply, error) {
+	out := new(RoleBindingReply)
+	err := c.cc.Invoke(ctx, UserCenter_CreateRoleBinding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCenterClient) ListRoleBinding(ctx context.Context, in *ListRoleBindingRequest, opts ...grpc.CallOption) (*ListRoleBindingResponse, error) {
+	out := new(ListRoleBindingResponse)
+	err := c.cc.Invoke(ctx, UserCenter_ListRoleBinding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCenterClient) DeleteRoleBinding(ctx context.Context, in *DeleteRoleBindingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserCenter_DeleteRoleBinding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCenterClient) CreatePolicy(ctx context.Context, in *CreatePolicyRequest, opts ...grpc.CallOption) (*PolicyReply, error) {
+	out := new(PolicyReply)
+	err := c.cc.Invoke(ctx, UserCenter_CreatePolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCenterClient) ListPolicy(ctx context.Context, in *ListPolicyRequest, opts ...grpc.CallOption) (*ListPolicyResponse, error) {
+	out := new(ListPolicyResponse)
+	err := c.cc.Invoke(ctx, UserCenter_ListPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCenterClient) DeletePolicy(ctx context.Context, in *DeletePolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserCenter_DeletePolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserCenterServer is the server API for UserCenter service.
 // All implementations must embed UnimplementedUserCenterServer
 // for forward compatibility
 type UserCenterServer interface {
 	// Login
 	Login(context.Context, *LoginRequest) (*LoginReply, error)
+	// LoginMFA completes a login which requires a second factor.
+	LoginMFA(context.Context, *LoginMFARequest) (*LoginReply, error)
+	// OIDCAuthorize starts a login with the OpenID provider.
+	OIDCAuthorize(context.Context, *OIDCAuthorizeRequest) (*OIDCAuthorizeReply, error)
+	// OIDCCallback completes a login, or links an identity, with the code returned by the OpenID provider.
+	OIDCCallback(context.Context, *OIDCCallbackRequest) (*LoginReply, error)
 	// Logout
 	Logout(context.Context, *LogoutRequest) (*emptypb.Empty, error)
 	// RefreshToken
 	RefreshToken(context.Context, *RefreshTokenRequest) (*LoginReply, error)
 	// Authenticate
 	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
+	// VerifySignature verifies a request signed with a secret, and returns the owner of the secret.
+	VerifySignature(context.Context, *VerifySignatureRequest) (*AuthenticateResponse, error)
 	// Authorize
 	Authorize(context.Context, *AuthorizeRequest) (*AuthorizeResponse, error)
 	// Auth
@@ -274,6 +582,26 @@ type UserCenterServer interface {
 	DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error)
 	// UpdatePassword
 	UpdatePassword(context.Context, *UpdatePasswordRequest) (*emptypb.Empty, error)
+	// EnrollTOTP generates a new TOTP secret for a user, which takes effect after activation.
+	EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPReply, error)
+	// ActivateTOTP verifies the first passcode of an enrolled secret and enables TOTP.
+	ActivateTOTP(context.Context, *ActivateTOTPRequest) (*RecoveryCodesReply, error)
+	// DisableTOTP
+	DisableTOTP(context.Context, *DisableTOTPRequest) (*emptypb.Empty, error)
+	// RegenerateRecoveryCodes
+	RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*RecoveryCodesReply, error)
+	// LinkIdentity starts linking an identity of the OpenID provider to a user.
+	LinkIdentity(context.Context, *LinkIdentityRequest) (*OIDCAuthorizeReply, error)
+	// ListIdentity
+	ListIdentity(context.Context, *ListIdentityRequest) (*ListIdentityResponse, error)
+	// DeleteIdentity unlinks an identity of the OpenID provider from a user.
+	DeleteIdentity(context.Context, *DeleteIdentityRequest) (*emptypb.Empty, error)
+	// ListSession lists the active login sessions of a user.
+	ListSession(context.Context, *ListSessionRequest) (*ListSessionResponse, error)
+	// RevokeSession revokes a login session, invalidating both its refresh token and access token.
+	RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error)
+	// RevokeSessions revokes all the login sessions of a user.
+	RevokeSessions(context.Context, *RevokeSessionsRequest) (*emptypb.Empty, error)
 	// CreateSecret
 	CreateSecret(context.Context, *CreateSecretRequest) (*SecretReply, error)
 	// ListSecret
@@ -284,6 +612,28 @@ type UserCenterServer interface {
 	UpdateSecret(context.Context, *UpdateSecretRequest) (*emptypb.Empty, error)
 	// DeleteSecret
 	DeleteSecret(context.Context, *DeleteSecretRequest) (*emptypb.Empty, error)
+	// CreateRole
+	CreateRole(context.Context, *CreateRoleRequest) (*RoleReply, error)
+	// ListRole
+	ListRole(context.Context, *ListRoleRequest) (*ListRoleResponse, error)
+	// GetRole
+	GetRole(context.Context, *GetRoleRequest) (*RoleReply, error)
+	// UpdateRole
+	UpdateRole(context.Context, *UpdateRoleRequest) (*emptypb.Empty, error)
+	// DeleteRole
+	DeleteRole(context.Context, *DeleteRoleRequest) (*emptypb.Empty, error)
+	// CreateRoleBinding
+	CreateRoleBinding(context.Context, *CreateRoleBindingRequest) (*RoleBindingReply, error)
+	// ListRoleBinding
+	ListRoleBinding(context.Context, *ListRoleBindingRequest) (*ListRoleBindingResponse, error)
+	// DeleteRoleBinding
+	DeleteRoleBinding(context.Context, *DeleteRoleBindingRequest) (*emptypb.Empty, error)
+	// CreatePolicy
+	CreatePolicy(context.Context, *CreatePolicyRequest) (*PolicyReply, error)
+	// ListPolicy
+	ListPolicy(context.Context, *ListPolicyRequest) (*ListPolicyResponse, error)
+	// DeletePolicy
+	DeletePolicy(context.Context, *DeletePolicyRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedUserCenterServer()
 }
 
@@ -294,6 +644,15 @@ type UnimplementedUserCenterServer struct {
 func (UnimplementedUserCenterServer) Login(context.Context, *LoginRequest) (*LoginReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
+func (UnimplementedUserCenterServer) LoginMFA(context.Context, *LoginMFARequest) (*LoginReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginMFA not implemented")
+}
+func (UnimplementedUserCenterServer) OIDCAuthorize(context.Context, *OIDCAuthorizeRequest) (*OIDCAuthorizeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OIDCAuthorize not implemented")
+}
+func (UnimplementedUserCenterServer) OIDCCallback(context.Context, *OIDCCallbackRequest) (*LoginReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OIDCCallback not implemented")
+}
 func (UnimplementedUserCenterServer) Logout(context.Context, *LogoutRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
 }
@@ -303,6 +662,9 @@ func (UnimplementedUserCenterServer) RefreshToken(context.Context, *RefreshToken
 func (UnimplementedUserCenterServer) Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
 }
+func (UnimplementedUserCenterServer) VerifySignature(context.Context, *VerifySignatureRequest) (*AuthenticateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySignature not implemented")
+}
 func (UnimplementedUserCenterServer) Authorize(context.Context, *AuthorizeRequest) (*AuthorizeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authorize not implemented")
 }
@@ -327,6 +689,36 @@ func (UnimplementedUserCenterServer) DeleteUser(context.Context, *DeleteUserRequ
 func (UnimplementedUserCenterServer) UpdatePassword(context.Context, *UpdatePasswordRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePassword not implemented")
 }
+func (UnimplementedUserCenterServer) EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (UnimplementedUserCenterServer) ActivateTOTP(context.Context, *ActivateTOTPRequest) (*RecoveryCodesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateTOTP not implemented")
+}
+func (UnimplementedUserCenterServer) DisableTOTP(context.Context, *DisableTOTPRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTOTP not implemented")
+}
+func (UnimplementedUserCenterServer) RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*RecoveryCodesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (UnimplementedUserCenterServer) LinkIdentity(context.Context, *LinkIdentityRequest) (*OIDCAuthorizeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkIdentity not implemented")
+}
+func (UnimplementedUserCenterServer) ListIdentity(context.Context, *ListIdentityRequest) (*ListIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIdentity not implemented")
+}
+func (UnimplementedUserCenterServer) DeleteIdentity(context.Context, *DeleteIdentityRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIdentity not implemented")
+}
+func (UnimplementedUserCenterServer) ListSession(context.Context, *ListSessionRequest) (*ListSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSession not implemented")
+}
+func (UnimplementedUserCenterServer) RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedUserCenterServer) RevokeSessions(context.Context, *RevokeSessionsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSessions not implemented")
+}
 func (UnimplementedUserCenterServer) CreateSecret(context.Context, *CreateSecretRequest) (*SecretReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSecret not implemented")
 }
@@ -342,6 +734,39 @@ func (UnimplementedUserCenterServer) UpdateSecret(context.Context, *UpdateSecret
 func (UnimplementedUserCenterServer) DeleteSecret(context.Context, *DeleteSecretRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSecret not implemented")
 }
+func (UnimplementedUserCenterServer) CreateRole(context.Context, *CreateRoleRequest) (*RoleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (UnimplementedUserCenterServer) ListRole(context.Context, *ListRoleRequest) (*ListRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRole not implemented")
+}
+func (UnimplementedUserCenterServer) GetRole(context.Context, *GetRoleRequest) (*RoleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRole not implemented")
+}
+func (UnimplementedUserCenterServer) UpdateRole(context.Context, *UpdateRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+}
+func (UnimplementedUserCenterServer) DeleteRole(context.Context, *DeleteRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
+}
+func (UnimplementedUserCenterServer) CreateRoleBinding(context.Context, *CreateRoleBindingRequest) (*RoleBindingReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoleBinding not implemented")
+}
+func (UnimplementedUserCenterServer) ListRoleBinding(context.Context, *ListRoleBindingRequest) (*ListRoleBindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleBinding not implemented")
+}
+func (UnimplementedUserCenterServer) DeleteRoleBinding(context.Context, *DeleteRoleBindingRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoleBinding not implemented")
+}
+func (UnimplementedUserCenterServer) CreatePolicy(context.Context, *CreatePolicyRequest) (*PolicyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePolicy not implemented")
+}
+func (UnimplementedUserCenterServer) ListPolicy(context.Context, *ListPolicyRequest) (*ListPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicy not implemented")
+}
+func (UnimplementedUserCenterServer) DeletePolicy(context.Context, *DeletePolicyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicy not implemented")
+}
 func (UnimplementedUserCenterServer) mustEmbedUnimplementedUserCenterServer() {}
 
 // UnsafeUserCenterServer may be embedded to opt out of forward compatibility for this service.
@@ -373,6 +798,60 @@ func _UserCenter_Login_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserCenter_LoginMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).LoginMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_LoginMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).LoginMFA(ctx, req.(*LoginMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_OIDCAuthorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OIDCAuthorizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).OIDCAuthorize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_OIDCAuthorize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).OIDCAuthorize(ctx, req.(*OIDCAuthorizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_OIDCCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OIDCCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).OIDCCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_OIDCCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).OIDCCallback(ctx, req.(*OIDCCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserCenter_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LogoutRequest)
 	if err := dec(in); err != nil {
@@ -427,6 +906,24 @@ func _UserCenter_Authenticate_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserCenter_VerifySignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).VerifySignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_VerifySignature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).VerifySignature(ctx, req.(*VerifySignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserCenter_Authorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AuthorizeRequest)
 	if err := dec(in); err != nil {
@@ -571,67 +1068,247 @@ func _UserCenter_UpdatePassword_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserCenter_CreateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSecretRequest)
+func _UserCenter_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTOTPRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserCenterServer).CreateSecret(ctx, in)
+		return srv.(UserCenterServer).EnrollTOTP(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserCenter_CreateSecret_FullMethodName,
+		FullMethod: UserCenter_EnrollTOTP_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserCenterServer).CreateSecret(ctx, req.(*CreateSecretRequest))
+		return srv.(UserCenterServer).EnrollTOTP(ctx, req.(*EnrollTOTPRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserCenter_ListSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListSecretRequest)
+func _UserCenter_ActivateTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateTOTPRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserCenterServer).ListSecret(ctx, in)
+		return srv.(UserCenterServer).ActivateTOTP(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserCenter_ListSecret_FullMethodName,
+		FullMethod: UserCenter_ActivateTOTP_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserCenterServer).ListSecret(ctx, req.(*ListSecretRequest))
+		return srv.(UserCenterServer).ActivateTOTP(ctx, req.(*ActivateTOTPRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserCenter_GetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSecretRequest)
+func _UserCenter_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTOTPRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserCenterServer).GetSecret(ctx, in)
+		return srv.(UserCenterServer).DisableTOTP(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserCenter_GetSecret_FullMethodName,
+		FullMethod: UserCenter_DisableTOTP_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserCenterServer).GetSecret(ctx, req.(*GetSecretRequest))
+		return srv.(UserCenterServer).DisableTOTP(ctx, req.(*DisableTOTPRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserCenter_UpdateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSecretRequest)
+func _UserCenter_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateRecoveryCodesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserCenterServer).UpdateSecret(ctx, in)
+		return srv.(UserCenterServer).RegenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_RegenerateRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).RegenerateRecoveryCodes(ctx, req.(*RegenerateRecoveryCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_LinkIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).LinkIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_LinkIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).LinkIdentity(ctx, req.(*LinkIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_ListIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).ListIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_ListIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).ListIdentity(ctx, req.(*ListIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_DeleteIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).DeleteIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_DeleteIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).DeleteIdentity(ctx, req.(*DeleteIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_ListSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).ListSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_ListSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).ListSession(ctx, req.(*ListSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_RevokeSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).RevokeSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_RevokeSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).RevokeSessions(ctx, req.(*RevokeSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_CreateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).CreateSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_CreateSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).CreateSecret(ctx, req.(*CreateSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_ListSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).ListSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_ListSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).ListSecret(ctx, req.(*ListSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_GetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).GetSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_GetSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).GetSecret(ctx, req.(*GetSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_UpdateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).UpdateSecret(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -661,6 +1338,204 @@ func _UserCenter_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserCenter_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_CreateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).CreateRole(ctx, req.(*CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_ListRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).ListRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_ListRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).ListRole(ctx, req.(*ListRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_GetRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).GetRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_GetRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).GetRole(ctx, req.(*GetRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).UpdateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_UpdateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).UpdateRole(ctx, req.(*UpdateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).DeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_DeleteRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).DeleteRole(ctx, req.(*DeleteRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_CreateRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).CreateRoleBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_CreateRoleBinding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).CreateRoleBinding(ctx, req.(*CreateRoleBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_ListRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).ListRoleBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_ListRoleBinding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).ListRoleBinding(ctx, req.(*ListRoleBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_DeleteRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).DeleteRoleBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_DeleteRoleBinding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).DeleteRoleBinding(ctx, req.(*DeleteRoleBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_CreatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).CreatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_CreatePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).CreatePolicy(ctx, req.(*CreatePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_ListPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).ListPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_ListPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).ListPolicy(ctx, req.(*ListPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCenter_DeletePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCenterServer).DeletePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCenter_DeletePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCenterServer).DeletePolicy(ctx, req.(*DeletePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserCenter_ServiceDesc is the grpc.ServiceDesc for UserCenter service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -672,6 +1547,18 @@ var UserCenter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Login",
 			Handler:    _UserCenter_Login_Handler,
 		},
+		{
+			MethodName: "LoginMFA",
+			Handler:    _UserCenter_LoginMFA_Handler,
+		},
+		{
+			MethodName: "OIDCAuthorize",
+			Handler:    _UserCenter_OIDCAuthorize_Handler,
+		},
+		{
+			MethodName: "OIDCCallback",
+			Handler:    _UserCenter_OIDCCallback_Handler,
+		},
 		{
 			MethodName: "Logout",
 			Handler:    _UserCenter_Logout_Handler,
@@ -684,6 +1571,10 @@ var UserCenter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Authenticate",
 			Handler:    _UserCenter_Authenticate_Handler,
 		},
+		{
+			MethodName: "VerifySignature",
+			Handler:    _UserCenter_VerifySignature_Handler,
+		},
 		{
 			MethodName: "Authorize",
 			Handler:    _UserCenter_Authorize_Handler,
@@ -716,6 +1607,46 @@ var UserCenter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePassword",
 			Handler:    _UserCenter_UpdatePassword_Handler,
 		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _UserCenter_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ActivateTOTP",
+			Handler:    _UserCenter_ActivateTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _UserCenter_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _UserCenter_RegenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "LinkIdentity",
+			Handler:    _UserCenter_LinkIdentity_Handler,
+		},
+		{
+			MethodName: "ListIdentity",
+			Handler:    _UserCenter_ListIdentity_Handler,
+		},
+		{
+			MethodName: "DeleteIdentity",
+			Handler:    _UserCenter_DeleteIdentity_Handler,
+		},
+		{
+			MethodName: "ListSession",
+			Handler:    _UserCenter_ListSession_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _UserCenter_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeSessions",
+			Handler:    _UserCenter_RevokeSessions_Handler,
+		},
 		{
 			MethodName: "CreateSecret",
 			Handler:    _UserCenter_CreateSecret_Handler,
@@ -736,6 +1667,50 @@ var UserCenter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSecret",
 			Handler:    _UserCenter_DeleteSecret_Handler,
 		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _UserCenter_CreateRole_Handler,
+		},
+		{
+			MethodName: "ListRole",
+			Handler:    _UserCenter_ListRole_Handler,
+		},
+		{
+			MethodName: "GetRole",
+			Handler:    _UserCenter_GetRole_Handler,
+		},
+		{
+			MethodName: "UpdateRole",
+			Handler:    _UserCenter_UpdateRole_Handler,
+		},
+		{
+			MethodName: "DeleteRole",
+			Handler:    _UserCenter_DeleteRole_Handler,
+		},
+		{
+			MethodName: "CreateRoleBinding",
+			Handler:    _UserCenter_CreateRoleBinding_Handler,
+		},
+		{
+			MethodName: "ListRoleBinding",
+			Handler:    _UserCenter_ListRoleBinding_Handler,
+		},
+		{
+			MethodName: "DeleteRoleBinding",
+			Handler:    _UserCenter_DeleteRoleBinding_Handler,
+		},
+		{
+			MethodName: "CreatePolicy",
+			Handler:    _UserCenter_CreatePolicy_Handler,
+		},
+		{
+			MethodName: "ListPolicy",
+			Handler:    _UserCenter_ListPolicy_Handler,
+		},
+		{
+			MethodName: "DeletePolicy",
+			Handler:    _UserCenter_DeletePolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "usercenter/v1/usercenter.proto",
@@ -20,67 +20,146 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationUserCenterActivateTOTP = "/usercenter.v1.UserCenter/ActivateTOTP"
 const OperationUserCenterAuth = "/usercenter.v1.UserCenter/Auth"
 const OperationUserCenterAuthenticate = "/usercenter.v1.UserCenter/Authenticate"
 const OperationUserCenterAuthorize = "/usercenter.v1.UserCenter/Authorize"
+const OperationUserCenterCreatePolicy = "/usercenter.v1.UserCenter/CreatePolicy"
+const OperationUserCenterCreateRole = "/usercenter.v1.UserCenter/CreateRole"
+const OperationUserCenterCreateRoleBinding = "/usercenter.v1.UserCenter/CreateRoleBinding"
 const OperationUserCenterCreateSecret = "/usercenter.v1.UserCenter/CreateSecret"
 const OperationUserCenterCreateUser = "/usercenter.v1.UserCenter/CreateUser"
+const OperationUserCenterDeleteIdentity = "/usercenter.v1.UserCenter/DeleteIdentity"
+const OperationUserCenterDeletePolicy = "/usercenter.v1.UserCenter/DeletePolicy"
+const OperationUserCenterDeleteRole = "/usercenter.v1.UserCenter/DeleteRole"
+const OperationUserCenterDeleteRoleBinding = "/usercenter.v1.UserCenter/DeleteRoleBinding"
 const OperationUserCenterDeleteSecret = "/usercenter.v1.UserCenter/DeleteSecret"
 const OperationUserCenterDeleteUser = "/usercenter.v1.UserCenter/DeleteUser"
+const OperationUserCenterDisableTOTP = "/usercenter.v1.UserCenter/DisableTOTP"
+const OperationUserCenterEnrollTOTP = "/usercenter.v1.UserCenter/EnrollTOTP"
+const OperationUserCenterGetRole = "/usercenter.v1.UserCenter/GetRole"
 const OperationUserCenterGetSecret = "/usercenter.v1.UserCenter/GetSecret"
 const OperationUserCenterGetUser = "/usercenter.v1.UserCenter/GetUser"
+const OperationUserCenterLinkIdentity = "/usercenter.v1.UserCenter/LinkIdentity"
+const OperationUserCenterListIdentity = "/usercenter.v1.UserCenter/ListIdentity"
+const OperationUserCenterListPolicy = "/usercenter.v1.UserCenter/ListPolicy"
+const OperationUserCenterListRole = "/usercenter.v1.UserCenter/ListRole"
+const OperationUserCenterListRoleBinding = "/usercenter.v1.UserCenter/ListRoleBinding"
 const OperationUserCenterListSecret = "/usercenter.v1.UserCenter/ListSecret"
+const OperationUserCenterListSession = "/usercenter.v1.UserCenter/ListSession"
 const OperationUserCenterListUser = "/usercenter.v1.UserCenter/ListUser"
 const OperationUserCenterLogin = "/usercenter.v1.UserCenter/Login"
+const OperationUserCenterLoginMFA = "/usercenter.v1.UserCenter/LoginMFA"
 const OperationUserCenterLogout = "/usercenter.v1.UserCenter/Logout"
+const OperationUserCenterOIDCAuthorize = "/usercenter.v1.UserCenter/OIDCAuthorize"
+const OperationUserCenterOIDCCallback = "/usercenter.v1.UserCenter/OIDCCallback"
 const OperationUserCenterRefreshToken = "/usercenter.v1.UserCenter/RefreshToken"
+const OperationUserCenterRegenerateRecoveryCodes = "/usercenter.v1.UserCenter/RegenerateRecoveryCodes"
+const OperationUserCenterRevokeSession = "/usercenter.v1.UserCenter/RevokeSession"
+const OperationUserCenterRevokeSessions = "/usercenter.v1.UserCenter/RevokeSessions"
 const OperationUserCenterUpdatePassword = "/usercenter.v1.UserCenter/UpdatePassword"
+const OperationUserCenterUpdateRole = "/usercenter.v1.UserCenter/UpdateRole"
 const OperationUserCenterUpdateSecret = "/usercenter.v1.UserCenter/UpdateSecret"
 const OperationUserCenterUpdateUser = "/usercenter.v1.UserCenter/UpdateUser"
+const OperationUserCenterVerifySignature = "/usercenter.v1.UserCenter/VerifySignature"
 
 type UserCenterHTTPServer interface {
+	// ActivateTOTP ActivateTOTP verifies the first passcode of an enrolled secret and enables TOTP.
+	ActivateTOTP(context.Context, *ActivateTOTPRequest) (*RecoveryCodesReply, error)
 	// Auth Auth
 	Auth(context.Context, *AuthRequest) (*AuthResponse, error)
 	// Authenticate Authenticate
 	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
 	// Authorize Authorize
 	Authorize(context.Context, *AuthorizeRequest) (*AuthorizeResponse, error)
+	// CreatePolicy CreatePolicy
+	CreatePolicy(context.Context, *CreatePolicyRequest) (*PolicyReply, error)
+	// CreateRole CreateRole
+	CreateRole(context.Context, *CreateRoleRequest) (*RoleReply, error)
+	// CreateRoleBinding CreateRoleBinding
+	CreateRoleBinding(context.Context, *CreateRoleBindingRequest) (*RoleBindingReply, error)
 	// CreateSecret CreateSecret
 	CreateSecret(context.Context, *CreateSecretRequest) (*SecretReply, error)
 	// CreateUser CreateUser
 	CreateUser(context.Context, *CreateUserRequest) (*UserReply, error)
+	// DeleteIdentity DeleteIdentity unlinks an identity of the OpenID provider from a user.
+	DeleteIdentity(context.Context, *DeleteIdentityRequest) (*emptypb.Empty, error)
+	// DeletePolicy DeletePolicy
+	DeletePolicy(context.Context, *DeletePolicyRequest) (*emptypb.Empty, error)
+	// DeleteRole DeleteRole
+	DeleteRole(context.Context, *DeleteRoleRequest) (*emptypb.Empty, error)
+	// DeleteRoleBinding DeleteRoleBinding
+	DeleteRoleBinding(context.Context, *DeleteRoleBindingRequest) (*emptypb.Empty, error)
 	// DeleteSecret DeleteSecret
 	DeleteSecret(context.Context, *DeleteSecretRequest) (*emptypb.Empty, error)
 	// DeleteUser DeleteUser
 	DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error)
+	// DisableTOTP DisableTOTP
+	DisableTOTP(context.Context, *DisableTOTPRequest) (*emptypb.Empty, error)
+	// EnrollTOTP EnrollTOTP generates a new TOTP secret for a user, which takes effect after activation.
+	EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPReply, error)
+	// GetRole GetRole
+	GetRole(context.Context, *GetRoleRequest) (*RoleReply, error)
 	// GetSecret GetSecret
 	GetSecret(context.Context, *GetSecretRequest) (*SecretReply, error)
 	// GetUser GetUser
 	GetUser(context.Context, *GetUserRequest) (*UserReply, error)
+	// LinkIdentity LinkIdentity starts linking an identity of the OpenID provider to a user.
+	LinkIdentity(context.Context, *LinkIdentityRequest) (*OIDCAuthorizeReply, error)
+	// ListIdentity ListIdentity
+	ListIdentity(context.Context, *ListIdentityRequest) (*ListIdentityResponse, error)
+	// ListPolicy ListPolicy
+	ListPolicy(context.Context, *ListPolicyRequest) (*ListPolicyResponse, error)
+	// ListRole ListRole
+	ListRole(context.Context, *ListRoleRequest) (*ListRoleResponse, error)
+	// ListRoleBinding ListRoleBinding
+	ListRoleBinding(context.Context, *ListRoleBindingRequest) (*ListRoleBindingResponse, error)
 	// ListSecret ListSecret
 	ListSecret(context.Context, *ListSecretRequest) (*ListSecretResponse, error)
+	// ListSession ListSession lists the active login sessions of a user.
+	ListSession(context.Context, *ListSessionRequest) (*ListSessionResponse, error)
 	// ListUser ListUser
 	ListUser(context.Context, *ListUserRequest) (*ListUserResponse, error)
 	// Login Login
 	Login(context.Context, *LoginRequest) (*LoginReply, error)
+	// LoginMFA LoginMFA completes a login which requires a second factor.
+	LoginMFA(context.Context, *LoginMFARequest) (*LoginReply, error)
 	// Logout Logout
 	Logout(context.Context, *LogoutRequest) (*emptypb.Empty, error)
+	// OIDCAuthorize OIDCAuthorize starts a login with the OpenID provider.
+	OIDCAuthorize(context.Context, *OIDCAuthorizeRequest) (*OIDCAuthorizeReply, error)
+	// OIDCCallback OIDCCallback completes a login, or links an identity, with the code returned by the OpenID provider.
+	OIDCCallback(context.Context, *OIDCCallbackRequest) (*LoginReply, error)
 	// RefreshToken RefreshToken
 	RefreshToken(context.Context, *RefreshTokenRequest) (*LoginReply, error)
+	// RegenerateRecoveryCodes RegenerateRecoveryCodes
+	RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*RecoveryCodesReply, error)
+	// RevokeSession RevokeSession revokes a login session, invalidating both its refresh token and access token.
+	RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error)
+	// RevokeSessions RevokeSessions revokes all the login sessions of a user.
+	RevokeSessions(context.Context, *RevokeSessionsRequest) (*emptypb.Empty, error)
 	// UpdatePassword UpdatePassword
 	UpdatePassword(context.Context, *UpdatePasswordRequest) (*emptypb.Empty, error)
+	// UpdateRole UpdateRole
+	UpdateRole(context.Context, *UpdateRoleRequest) (*emptypb.Empty, error)
 	// UpdateSecret UpdateSecret
 	UpdateSecret(context.Context, *UpdateSecretRequest) (*emptypb.Empty, error)
 	// UpdateUser UpdateUser
 	UpdateUser(context.Context, *UpdateUserRequest) (*emptypb.Empty, error)
+	// VerifySignature VerifySignature verifies a request signed with a secret, and returns the owner of the secret.
+	VerifySignature(context.Context, *VerifySignatureRequest) (*AuthenticateResponse, error)
 }
 
 func RegisterUserCenterHTTPServer(s *http.Server, srv UserCenterHTTPServer) {
 	r := s.Route("/")
 	r.POST("/v1/auth/login", _UserCenter_Login0_HTTP_Handler(srv))
+	r.POST("/v1/auth/login/mfa", _UserCenter_LoginMFA0_HTTP_Handler(srv))
+	r.GET("/v1/auth/oidc/authorize", _UserCenter_OIDCAuthorize0_HTTP_Handler(srv))
+	r.GET("/v1/auth/oidc/callback", _UserCenter_OIDCCallback0_HTTP_Handler(srv))
 	r.POST("/v1/auth/logout", _UserCenter_Logout0_HTTP_Handler(srv))
 	r.POST("/v1/auth/refresh-token", _UserCenter_RefreshToken0_HTTP_Handler(srv))
 	r.POST("/v1/auth/authenticate", _UserCenter_Authenticate0_HTTP_Handler(srv))
+	r.POST("/v1/auth/verify-signature", _UserCenter_VerifySignature0_HTTP_Handler(srv))
 	r.POST("/v1/auth/authorize", _UserCenter_Authorize0_HTTP_Handler(srv))
 	r.POST("/v1/auth/auth", _UserCenter_Auth0_HTTP_Handler(srv))
 	r.POST("/v1/users", _UserCenter_CreateUser0_HTTP_Handler(srv))
@@ -89,11 +168,32 @@ func RegisterUserCenterHTTPServer(s *http.Server, srv UserCenterHTTPServer) {
 	r.PUT("/v1/users/{username}", _UserCenter_UpdateUser0_HTTP_Handler(srv))
 	r.DELETE("/v1/users/{username}", _UserCenter_DeleteUser0_HTTP_Handler(srv))
 	r.PUT("/v1/users/{username}/update-password", _UserCenter_UpdatePassword0_HTTP_Handler(srv))
+	r.POST("/v1/users/{username}/mfa/totp", _UserCenter_EnrollTOTP0_HTTP_Handler(srv))
+	r.POST("/v1/users/{username}/mfa/totp/activate", _UserCenter_ActivateTOTP0_HTTP_Handler(srv))
+	r.POST("/v1/users/{username}/mfa/totp/disable", _UserCenter_DisableTOTP0_HTTP_Handler(srv))
+	r.POST("/v1/users/{username}/mfa/recovery-codes", _UserCenter_RegenerateRecoveryCodes0_HTTP_Handler(srv))
+	r.POST("/v1/users/{username}/identities", _UserCenter_LinkIdentity0_HTTP_Handler(srv))
+	r.GET("/v1/users/{username}/identities", _UserCenter_ListIdentity0_HTTP_Handler(srv))
+	r.DELETE("/v1/users/{username}/identities/{subject}", _UserCenter_DeleteIdentity0_HTTP_Handler(srv))
+	r.GET("/v1/users/{username}/sessions", _UserCenter_ListSession0_HTTP_Handler(srv))
+	r.DELETE("/v1/users/{username}/sessions/{sessionID}", _UserCenter_RevokeSession0_HTTP_Handler(srv))
+	r.DELETE("/v1/users/{username}/sessions", _UserCenter_RevokeSessions0_HTTP_Handler(srv))
 	r.POST("/v1/secrets", _UserCenter_CreateSecret0_HTTP_Handler(srv))
 	r.GET("/v1/secrets", _UserCenter_ListSecret0_HTTP_Handler(srv))
 	r.GET("/v1/secrets/{name}", _UserCenter_GetSecret0_HTTP_Handler(srv))
 	r.PUT("/v1/secrets/{name}", _UserCenter_UpdateSecret0_HTTP_Handler(srv))
 	r.DELETE("/v1/secrets/{name}", _UserCenter_DeleteSecret0_HTTP_Handler(srv))
+	r.POST("/v1/roles", _UserCenter_CreateRole0_HTTP_Handler(srv))
+	r.GET("/v1/roles", _UserCenter_ListRole0_HTTP_Handler(srv))
+	r.GET("/v1/roles/{name}", _UserCenter_GetRole0_HTTP_Handler(srv))
+	r.PUT("/v1/roles/{name}", _UserCenter_UpdateRole0_HTTP_Handler(srv))
+	r.DELETE("/v1/roles/{name}", _UserCenter_DeleteRole0_HTTP_Handler(srv))
+	r.POST("/v1/rolebindings", _UserCenter_CreateRoleBinding0_HTTP_Handler(srv))
+	r.GET("/v1/rolebindings", _UserCenter_ListRoleBinding0_HTTP_Handler(srv))
+	r.DELETE("/v1/rolebindings/{role}/{username}", _UserCenter_DeleteRoleBinding0_HTTP_Handler(srv))
+	r.POST("/v1/policies", _UserCenter_CreatePolicy0_HTTP_Handler(srv))
+	r.GET("/v1/policies", _UserCenter_ListPolicy0_HTTP_Handler(srv))
+	r.DELETE("/v1/policies", _UserCenter_DeletePolicy0_HTTP_Handler(srv))
 }
 
 func _UserCenter_Login0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
@@ -118,6 +218,66 @@ func _UserCenter_Login0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Con
 	}
 }
 
+func _UserCenter_LoginMFA0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LoginMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterLoginMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LoginMFA(ctx, req.(*LoginMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_OIDCAuthorize0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in OIDCAuthorizeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterOIDCAuthorize)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.OIDCAuthorize(ctx, req.(*OIDCAuthorizeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OIDCAuthorizeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_OIDCCallback0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in OIDCCallbackRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterOIDCCallback)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.OIDCCallback(ctx, req.(*OIDCCallbackRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _UserCenter_Logout0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in LogoutRequest
@@ -184,6 +344,28 @@ func _UserCenter_Authenticate0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx h
 	}
 }
 
+func _UserCenter_VerifySignature0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifySignatureRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterVerifySignature)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifySignature(ctx, req.(*VerifySignatureRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AuthenticateResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _UserCenter_Authorize0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in AuthorizeRequest
@@ -363,6 +545,241 @@ func _UserCenter_UpdatePassword0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx
 	}
 }
 
+func _UserCenter_EnrollTOTP0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EnrollTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterEnrollTOTP)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EnrollTOTP(ctx, req.(*EnrollTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EnrollTOTPReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ActivateTOTP0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ActivateTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterActivateTOTP)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ActivateTOTP(ctx, req.(*ActivateTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RecoveryCodesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_DisableTOTP0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DisableTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterDisableTOTP)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DisableTOTP(ctx, req.(*DisableTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_RegenerateRecoveryCodes0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RegenerateRecoveryCodesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterRegenerateRecoveryCodes)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RegenerateRecoveryCodes(ctx, req.(*RegenerateRecoveryCodesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RecoveryCodesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_LinkIdentity0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LinkIdentityRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterLinkIdentity)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LinkIdentity(ctx, req.(*LinkIdentityRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OIDCAuthorizeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ListIdentity0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListIdentityRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListIdentity)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListIdentity(ctx, req.(*ListIdentityRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListIdentityResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_DeleteIdentity0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteIdentityRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterDeleteIdentity)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteIdentity(ctx, req.(*DeleteIdentityRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ListSession0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSessionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSession(ctx, req.(*ListSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSessionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_RevokeSession0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeSessionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterRevokeSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeSession(ctx, req.(*RevokeSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_RevokeSessions0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeSessionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterRevokeSessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeSessions(ctx, req.(*RevokeSessionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _UserCenter_CreateSecret0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in CreateSecretRequest
@@ -473,24 +890,282 @@ func _UserCenter_DeleteSecret0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx h
 	}
 }
 
-type UserCenterHTTPClient interface {
-	Auth(ctx context.Context, req *AuthRequest, opts ...http.CallOption) (rsp *AuthResponse, err error)
+func _UserCenter_CreateRole0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterCreateRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateRole(ctx, req.(*CreateRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RoleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ListRole0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRoleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRole(ctx, req.(*ListRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRoleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_GetRole0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRoleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterGetRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetRole(ctx, req.(*GetRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RoleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_UpdateRole0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterUpdateRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateRole(ctx, req.(*UpdateRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_DeleteRole0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteRoleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterDeleteRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteRole(ctx, req.(*DeleteRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_CreateRoleBinding0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateRoleBindingRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterCreateRoleBinding)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateRoleBinding(ctx, req.(*CreateRoleBindingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RoleBindingReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ListRoleBinding0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRoleBindingRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListRoleBinding)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRoleBinding(ctx, req.(*ListRoleBindingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRoleBindingResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_DeleteRoleBinding0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteRoleBindingRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterDeleteRoleBinding)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteRoleBinding(ctx, req.(*DeleteRoleBindingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_CreatePolicy0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreatePolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterCreatePolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreatePolicy(ctx, req.(*CreatePolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PolicyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ListPolicy0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPolicyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPolicy(ctx, req.(*ListPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_DeletePolicy0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeletePolicyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterDeletePolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeletePolicy(ctx, req.(*DeletePolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+type UserCenterHTTPClient interface {
+	ActivateTOTP(ctx context.Context, req *ActivateTOTPRequest, opts ...http.CallOption) (rsp *RecoveryCodesReply, err error)
+	Auth(ctx context.Context, req *AuthRequest, opts ...http.CallOption) (rsp *AuthResponse, err error)
 	Authenticate(ctx context.Context, req *AuthenticateRequest, opts ...http.CallOption) (rsp *AuthenticateResponse, err error)
 	Authorize(ctx context.Context, req *AuthorizeRequest, opts ...http.CallOption) (rsp *AuthorizeResponse, err error)
+	CreatePolicy(ctx context.Context, req *CreatePolicyRequest, opts ...http.CallOption) (rsp *PolicyReply, err error)
+	CreateRole(ctx context.Context, req *CreateRoleRequest, opts ...http.CallOption) (rsp *RoleReply, err error)
+	CreateRoleBinding(ctx context.Context, req *CreateRoleBindingRequest, opts ...http.CallOption) (rsp *RoleBindingReply, err error)
 	CreateSecret(ctx context.Context, req *CreateSecretRequest, opts ...http.CallOption) (rsp *SecretReply, err error)
 	CreateUser(ctx context.Context, req *CreateUserRequest, opts ...http.CallOption) (rsp *UserReply, err error)
+	DeleteIdentity(ctx context.Context, req *DeleteIdentityRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	DeletePolicy(ctx context.Context, req *DeletePolicyRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	DeleteRole(ctx context.Context, req *DeleteRoleRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	DeleteRoleBinding(ctx context.Context, req *DeleteRoleBindingRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	DeleteSecret(ctx context.Context, req *DeleteSecretRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	DeleteUser(ctx context.Context, req *DeleteUserRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	DisableTOTP(ctx context.Context, req *DisableTOTPRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	EnrollTOTP(ctx context.Context, req *EnrollTOTPRequest, opts ...http.CallOption) (rsp *EnrollTOTPReply, err error)
+	GetRole(ctx context.Context, req *GetRoleRequest, opts ...http.CallOption) (rsp *RoleReply, err error)
 	GetSecret(ctx context.Context, req *GetSecretRequest, opts ...http.CallOption) (rsp *SecretReply, err error)
 	GetUser(ctx context.Context, req *GetUserRequest, opts ...http.CallOption) (rsp *UserReply, err error)
+	LinkIdentity(ctx context.Context, req *LinkIdentityRequest, opts ...http.CallOption) (rsp *OIDCAuthorizeReply, err error)
+	ListIdentity(ctx context.Context, req *ListIdentityRequest, opts ...http.CallOption) (rsp *ListIdentityResponse, err error)
+	ListPolicy(ctx context.Context, req *ListPolicyRequest, opts ...http.CallOption) (rsp *ListPolicyResponse, err error)
+	ListRole(ctx context.Context, req *ListRoleRequest, opts ...http.CallOption) (rsp *ListRoleResponse, err error)
+	ListRoleBinding(ctx context.Context, req *ListRoleBindingRequest, opts ...http.CallOption) (rsp *ListRoleBindingResponse, err error)
 	ListSecret(ctx context.Context, req *ListSecretRequest, opts ...http.CallOption) (rsp *ListSecretResponse, err error)
+	ListSession(ctx context.Context, req *ListSessionRequest, opts ...http.CallOption) (rsp *ListSessionResponse, err error)
 	ListUser(ctx context.Context, req *ListUserRequest, opts ...http.CallOption) (rsp *ListUserResponse, err error)
 	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	LoginMFA(ctx context.Context, req *LoginMFARequest, opts ...http.CallOption) (rsp *LoginReply, err error)
 	Logout(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	OIDCAuthorize(ctx context.Context, req *OIDCAuthorizeRequest, opts ...http.CallOption) (rsp *OIDCAuthorizeReply, err error)
+	OIDCCallback(ctx context.Context, req *OIDCCallbackRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
 	RefreshToken(ctx context.Context, req *RefreshTokenRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	RegenerateRecoveryCodes(ctx context.Context, req *RegenerateRecoveryCodesRequest, opts ...http.CallOption) (rsp *RecoveryCodesReply, err error)
+	RevokeSession(ctx context.Context, req *RevokeSessionRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	RevokeSessions(ctx context.Context, req *RevokeSessionsRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	UpdatePassword(ctx context.Context, req *UpdatePasswordRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	UpdateRole(ctx context.Context, req *UpdateRoleRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	UpdateSecret(ctx context.Context, req *UpdateSecretRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	UpdateUser(ctx context.Context, req *UpdateUserRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	VerifySignature(ctx context.Context, req *VerifySignatureRequest, opts ...http.CallOption) (rsp *AuthenticateResponse, err error)
 }
 
 type UserCenterHTTPClientImpl struct {
@@ -501,6 +1176,19 @@ func NewUserCenterHTTPClient(client *http.Client) UserCenterHTTPClient {
 	return &UserCenterHTTPClientImpl{client}
 }
 
+func (c *UserCenterHTTPClientImpl) ActivateTOTP(ctx context.Context, in *ActivateTOTPRequest, opts ...http.CallOption) (*RecoveryCodesReply, error) {
+	var out RecoveryCodesReply
+	pattern := "/v1/users/{username}/mfa/totp/activate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterActivateTOTP))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserCenterHTTPClientImpl) Auth(ctx context.Context, in *AuthRequest, opts ...http.CallOption) (*AuthResponse, error) {
 	var out AuthResponse
 	pattern := "/v1/auth/auth"
@@ -540,6 +1228,45 @@ func (c *UserCenterHTTPClientImpl) Authorize(ctx context.Context, in *AuthorizeR
 	return &out, err
 }
 
+func (c *UserCenterHTTPClientImpl) CreatePolicy(ctx context.Context, in *CreatePolicyRequest, opts ...http.CallOption) (*PolicyReply, error) {
+	var out PolicyReply
+	pattern := "/v1/policies"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterCreatePolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserCenterHTTPClientImpl) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...http.CallOption) (*RoleReply, error) {
+	var out RoleReply
+	pattern := "/v1/roles"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterCreateRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserCenterHTTPClientImpl) CreateRoleBinding(ctx context.Context, in *CreateRoleBindingRequest, opts ...http.CallOption) (*RoleBindingReply, error) {
+	var out RoleBindingReply
+	pattern := "/v1/rolebindings"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterCreateRoleBinding))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserCenterHTTPClientImpl) CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...http.CallOption) (*SecretReply, error) {
 	var out SecretReply
 	pattern := "/v1/secrets"
@@ -566,6 +1293,58 @@ func (c *UserCenterHTTPClientImpl) CreateUser(ctx context.Context, in *CreateUse
 	return &out, err
 }
 
+func (c *UserCenterHTTPClientImpl) DeleteIdentity(ctx context.Context, in *DeleteIdentityRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/users/{username}/identities/{subject}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterDeleteIdentity))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserCenterHTTPClientImpl) DeletePolicy(ctx context.Context, in *DeletePolicyRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/policies"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterDeletePolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserCenterHTTPClientImpl) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/roles/{name}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterDeleteRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserCenterHTTPClientImpl) DeleteRoleBinding(ctx context.Context, in *DeleteRoleBindingRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/rolebindings/{role}/{username}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterDeleteRoleBinding))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserCenterHTTPClientImpl) DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/v1/secrets/{name}"
@@ -592,6 +1371,45 @@ func (c *UserCenterHTTPClientImpl) DeleteUser(ctx context.Context, in *DeleteUse
 	return &out, err
 }
 
+func (c *UserCenterHTTPClientImpl) DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/users/{username}/mfa/totp/disable"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterDisableTOTP))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserCenterHTTPClientImpl) EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...http.CallOption) (*EnrollTOTPReply, error) {
+	var out EnrollTOTPReply
+	pattern := "/v1/users/{username}/mfa/totp"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterEnrollTOTP))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserCenterHTTPClientImpl) GetRole(ctx context.Context, in *GetRoleRequest, opts ...http.CallOption) (*RoleReply, error) {
+	var out RoleReply
+	pattern := "/v1/roles/{name}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterGetRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserCenterHTTPClientImpl) GetSecret(ctx context.Context, in *GetSecretRequest, opts ...http.CallOption) (*SecretReply, error) {
 	var out SecretReply
 	pattern := "/v1/secrets/{name}"
@@ -618,6 +1436,71 @@ func (c *UserCenterHTTPClientImpl) GetUser(ctx context.Context, in *GetUserReque
 	return &out, err
 }
 
+func (c *UserCenterHTTPClientImpl) LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...http.CallOption) (*OIDCAuthorizeReply, error) {
+	var out OIDCAuthorizeReply
+	pattern := "/v1/users/{username}/identities"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterLinkIdentity))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserCenterHTTPClientImpl) ListIdentity(ctx context.Context, in *ListIdentityRequest, opts ...http.CallOption) (*ListIdentityResponse, error) {
+	var out ListIdentityResponse
+	pattern := "/v1/users/{username}/identities"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterListIdentity))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserCenterHTTPClientImpl) ListPolicy(ctx context.Context, in *ListPolicyRequest, opts ...http.CallOption) (*ListPolicyResponse, error) {
+	var out ListPolicyResponse
+	pattern := "/v1/policies"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterListPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserCenterHTTPClientImpl) ListRole(ctx context.Context, in *ListRoleRequest, opts ...http.CallOption) (*ListRoleResponse, error) {
+	var out ListRoleResponse
+	pattern := "/v1/roles"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterListRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserCenterHTTPClientImpl) ListRoleBinding(ctx context.Context, in *ListRoleBindingRequest, opts ...http.CallOption) (*ListRoleBindingResponse, error) {
+	var out ListRoleBindingResponse
+	pattern := "/v1/rolebindings"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterListRoleBinding))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserCenterHTTPClientImpl) ListSecret(ctx context.Context, in *ListSecretRequest, opts ...http.CallOption) (*ListSecretResponse, error) {
 	var out ListSecretResponse
 	pattern := "/v1/secrets"
@@ -631,6 +1514,19 @@ func (c *UserCenterHTTPClientImpl) ListSecret(ctx context.Context, in *ListSecre
 	return &out, err
 }
 
+func (c *UserCenterHTTPClientImpl) ListSession(ctx context.Context, in *ListSessionRequest, opts ...http.CallOption) (*ListSessionResponse, error) {
+	var out ListSessionResponse
+	pattern := "/v1/users/{username}/sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterListSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserCenterHTTPClientImpl) ListUser(ctx context.Context, in *ListUserRequest, opts ...http.CallOption) (*ListUserResponse, error) {
 	var out ListUserResponse
 	pattern := "/v1/users"
@@ -657,6 +1553,19 @@ func (c *UserCenterHTTPClientImpl) Login(ctx context.Context, in *LoginRequest,
 	return &out, err
 }
 
+func (c *UserCenterHTTPClientImpl) LoginMFA(ctx context.Context, in *LoginMFARequest, opts ...http.CallOption) (*LoginReply, error) {
+	var out LoginReply
+	pattern := "/v1/auth/login/mfa"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterLoginMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserCenterHTTPClientImpl) Logout(ctx context.Context, in *LogoutRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/v1/auth/logout"
@@ -670,6 +1579,32 @@ func (c *UserCenterHTTPClientImpl) Logout(ctx context.Context, in *LogoutRequest
 	return &out, err
 }
 
+func (c *UserCenterHTTPClientImpl) OIDCAuthorize(ctx context.Context, in *OIDCAuthorizeRequest, opts ...http.CallOption) (*OIDCAuthorizeReply, error) {
+	var out OIDCAuthorizeReply
+	pattern := "/v1/auth/oidc/authorize"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterOIDCAuthorize))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserCenterHTTPClientImpl) OIDCCallback(ctx context.Context, in *OIDCCallbackRequest, opts ...http.CallOption) (*LoginReply, error) {
+	var out LoginReply
+	pattern := "/v1/auth/oidc/callback"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterOIDCCallback))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserCenterHTTPClientImpl) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...http.CallOption) (*LoginReply, error) {
 	var out LoginReply
 	pattern := "/v1/auth/refresh-token"
@@ -683,6 +1618,45 @@ func (c *UserCenterHTTPClientImpl) RefreshToken(ctx context.Context, in *Refresh
 	return &out, err
 }
 
+func (c *UserCenterHTTPClientImpl) RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...http.CallOption) (*RecoveryCodesReply, error) {
+	var out RecoveryCodesReply
+	pattern := "/v1/users/{username}/mfa/recovery-codes"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterRegenerateRecoveryCodes))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserCenterHTTPClientImpl) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/users/{username}/sessions/{sessionID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterRevokeSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserCenterHTTPClientImpl) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/users/{username}/sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterRevokeSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserCenterHTTPClientImpl) UpdatePassword(ctx context.Context, in *UpdatePasswordRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/v1/users/{username}/update-password"
@@ -696,6 +1670,19 @@ func (c *UserCenterHTTPClientImpl) UpdatePassword(ctx context.Context, in *Updat
 	return &out, err
 }
 
+func (c *UserCenterHTTPClientImpl) UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/v1/roles/{name}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterUpdateRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserCenterHTTPClientImpl) UpdateSecret(ctx context.Context, in *UpdateSecretRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/v1/secrets/{name}"
@@ -721,3 +1708,16 @@ func (c *UserCenterHTTPClientImpl) UpdateUser(ctx context.Context, in *UpdateUse
 	}
 	return &out, err
 }
+
+func (c *UserCenterHTTPClientImpl) VerifySignature(ctx context.Context, in *VerifySignatureRequest, opts ...http.CallOption) (*AuthenticateResponse, error) {
+	var out AuthenticateResponse
+	pattern := "/v1/auth/verify-signature"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterVerifySignature))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
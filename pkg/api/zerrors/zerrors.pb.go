@@ -44,6 +44,12 @@ const (
 	ErrorReason_IdempotentMissingToken ErrorReason = 5
 	// 幂等性令牌已过期错误
 	ErrorReason_IdempotentTokenExpired ErrorReason = 6
+	// 相同幂等性令牌的请求正在处理中
+	ErrorReason_IdempotentRequestInProgress ErrorReason = 7
+	// 幂等性令牌已被参数不同的请求使用
+	ErrorReason_IdempotentKeyMismatch ErrorReason = 8
+	// 请求过于频繁，超过了限流阈值
+	ErrorReason_TooManyRequests ErrorReason = 9
 )
 
 // Enum value maps for ErrorReason.
@@ -56,15 +62,21 @@ var (
 		4: "Forbidden",
 		5: "IdempotentMissingToken",
 		6: "IdempotentTokenExpired",
+		7: "IdempotentRequestInProgress",
+		8: "IdempotentKeyMismatch",
+		9: "TooManyRequests",
 	}
 	ErrorReason_value = map[string]int32{
-		"Unknown":                0,
-		"InvalidParameter":       1,
-		"NotFound":               2,
-		"Unauthorized":           3,
-		"Forbidden":              4,
-		"IdempotentMissingToken": 5,
-		"IdempotentTokenExpired": 6,
+		"Unknown":                     0,
+		"InvalidParameter":            1,
+		"NotFound":                    2,
+		"Unauthorized":                3,
+		"Forbidden":                   4,
+		"IdempotentMissingToken":      5,
+		"IdempotentTokenExpired":      6,
+		"IdempotentRequestInProgress": 7,
+		"IdempotentKeyMismatch":       8,
+		"TooManyRequests":             9,
 	}
 )
 
@@ -101,7 +113,7 @@ var file_zerrors_zerrors_proto_rawDesc = []byte{
 	0x0a, 0x15, 0x7a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x7a, 0x65, 0x72, 0x72, 0x6f, 0x72,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x7a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
 	0x1a, 0x13, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0xc7, 0x01, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0xaa, 0x02, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
 	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x11, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
 	0x10, 0x00, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1a, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x61,
 	0x6c, 0x69, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x10, 0x01, 0x1a, 0x04,
@@ -113,11 +125,17 @@ var file_zerrors_zerrors_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x10,
 	0x05, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x20, 0x0a, 0x16, 0x49, 0x64, 0x65, 0x6d, 0x70,
 	0x6f, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x64, 0x10, 0x06, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42,
-	0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x75,
-	0x70, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x6a, 0x2f, 0x6f, 0x6e, 0x65, 0x78, 0x2f, 0x70, 0x6b, 0x67,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x3b, 0x7a, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x10, 0x06, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x25, 0x0a, 0x1b, 0x49, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x10, 0x07, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03,
+	0x12, 0x1f, 0x0a, 0x15, 0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x4b, 0x65,
+	0x79, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x10, 0x08, 0x1a, 0x04, 0xa8, 0x45, 0xa6,
+	0x03, 0x12, 0x19, 0x0a, 0x0f, 0x54, 0x6f, 0x6f, 0x4d, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x10, 0x09, 0x1a, 0x04, 0xa8, 0x45, 0xad, 0x03, 0x1a, 0x04, 0xa0, 0x45,
+	0xf4, 0x03, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x73, 0x75, 0x70, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x6a, 0x2f, 0x6f, 0x6e, 0x65, 0x78, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x3b,
+	0x7a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
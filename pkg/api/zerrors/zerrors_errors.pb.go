@@ -108,3 +108,45 @@ func IsIdempotentTokenExpired(err error) bool {
 func ErrorIdempotentTokenExpired(format string, args ...interface{}) *errors.Error {
 	return errors.New(400, ErrorReason_IdempotentTokenExpired.String(), fmt.Sprintf(format, args...))
 }
+
+// 相同幂等性令牌的请求正在处理中
+func IsIdempotentRequestInProgress(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_IdempotentRequestInProgress.String() && e.Code == 409
+}
+
+// 相同幂等性令牌的请求正在处理中
+func ErrorIdempotentRequestInProgress(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_IdempotentRequestInProgress.String(), fmt.Sprintf(format, args...))
+}
+
+// 幂等性令牌已被参数不同的请求使用
+func IsIdempotentKeyMismatch(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_IdempotentKeyMismatch.String() && e.Code == 422
+}
+
+// 幂等性令牌已被参数不同的请求使用
+func ErrorIdempotentKeyMismatch(format string, args ...interface{}) *errors.Error {
+	return errors.New(422, ErrorReason_IdempotentKeyMismatch.String(), fmt.Sprintf(format, args...))
+}
+
+// 请求过于频繁，超过了限流阈值
+func IsTooManyRequests(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TooManyRequests.String() && e.Code == 429
+}
+
+// 请求过于频繁，超过了限流阈值
+func ErrorTooManyRequests(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, ErrorReason_TooManyRequests.String(), fmt.Sprintf(format, args...))
+}
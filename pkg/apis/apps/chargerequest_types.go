@@ -0,0 +1,103 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package apps
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ChargeRequest is the Schema for the chargerequests API.
+type ChargeRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the desired behavior of the chargerequest.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Spec ChargeRequestSpec `json:"spec,omitempty"`
+
+	// Status is the most recently observed status of the ChargeRequest.
+	// This data may be out of date by some window of time.
+	// Populated by the system.
+	// Read-only.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status ChargeRequestStatus `json:"status,omitempty"`
+}
+
+// ChargeRequestSpec defines the desired state of ChargeRequest.
+type ChargeRequestSpec struct {
+	// From is the address of the account the charge is paid from.
+	// +optional
+	From string `json:"from,omitempty"`
+
+	// Password is the password the signing key of the From account is derived from.
+	// It is sealed by the apiserver before being stored, and cleared once the charge
+	// is submitted to the chain.
+	// +optional
+	Password string `json:"password,omitempty"`
+
+	// Salt is the hex encoded salt, of at least 16 bytes, the signing key of the From
+	// account is derived from together with the password. It is chosen at random when
+	// the account is created.
+	Salt string `json:"salt"`
+
+	// ChainName is the name of the chain the charge is settled on. The charge is paid
+	// to the bootstrap account of the chain.
+	ChainName string `json:"chainName"`
+
+	// Amount is the number of coins charged.
+	Amount int64 `json:"amount"`
+
+	// Confirmations is the number of blocks, including the one containing the charge,
+	// required before the charge is considered settled.
+	// Defaults to 3.
+	// +optional
+	Confirmations *int32 `json:"confirmations,omitempty"`
+}
+
+// ChargeRequestStatus defines the observed state of ChargeRequest.
+type ChargeRequestStatus struct {
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
+
+	// TransactionID is the identifier of the transaction submitted for the charge.
+	// +optional
+	TransactionID string `json:"transactionID,omitempty"`
+
+	// SubmittedHeight is the height of the chain when the transaction was submitted.
+	// +optional
+	SubmittedHeight int64 `json:"submittedHeight,omitempty"`
+
+	// BlockHeight is the index of the block containing the transaction.
+	// +optional
+	BlockHeight int64 `json:"blockHeight,omitempty"`
+
+	// Confirmations is the number of blocks, including the one containing the
+	// transaction, mined since the transaction was included in the chain.
+	// +optional
+	Confirmations int32 `json:"confirmations,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ChargeRequestList is a list of ChargeRequest objects.
+type ChargeRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of schema objects.
+	Items []ChargeRequest `json:"items"`
+}
@@ -75,6 +75,45 @@ type MinerSpec struct {
 	// Defaults to 10 seconds.
 	// +optional
 	PodDeletionTimeout *metav1.Duration
+
+	// PodOverrides are merged into the Pod created for the miner.
+	// Use ObjectMeta to set the labels and annotations of the Pod.
+	// +optional
+	PodOverrides *MinerPodOverrides
+}
+
+// MinerPodOverrides is the restricted set of Pod fields which can be customized for a miner.
+// The containers, ports and resources of the Pod are always generated by the miner controller.
+type MinerPodOverrides struct {
+	// NodeSelector is a selector which must be true for the Pod to fit on a node.
+	// Selector which must match a node's labels for the pod to be scheduled on that node.
+	// More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
+	// +optional
+	NodeSelector map[string]string
+
+	// If specified, the Pod's tolerations.
+	// +optional
+	Tolerations []core.Toleration
+
+	// If specified, the Pod's scheduling constraints.
+	// +optional
+	Affinity *core.Affinity
+
+	// TopologySpreadConstraints describes how the miner Pods ought to spread across topology
+	// domains, e.g. nodes or zones. The label selectors of the constraints match the labels
+	// set through the ObjectMeta of the miner.
+	// +optional
+	TopologySpreadConstraints []core.TopologySpreadConstraint
+
+	// If specified, indicates the Pod's priority. The "system-" prefixed priority classes
+	// are reserved for the system and cannot be used.
+	// +optional
+	PriorityClassName string
+
+	// Env is a list of extra environment variables to set in the miner container.
+	// Only literal values and references to the fields and resources of the Pod are allowed.
+	// +optional
+	Env []core.EnvVar
 }
 
 // MinerStatus defines the observed state of Miner.
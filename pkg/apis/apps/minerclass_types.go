@@ -0,0 +1,93 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package apps
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/apis/core"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MinerClass describes a machine configuration of the miners. The `minerType` of
+// the miners and the chains refers to the name of a MinerClass, which is resolved
+// by the miner controller when the miner pod is created.
+type MinerClass struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the miners of the class.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Spec MinerClassSpec `json:"spec,omitempty"`
+}
+
+// MinerClassSpec defines the machine configuration of the miners of a MinerClass.
+type MinerClassSpec struct {
+	// The display name of the miner class.
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Resources are the compute resources of the miner container.
+	// The cpu and memory limits are required.
+	Resources core.ResourceRequirements `json:"resources"`
+
+	// MiningDifficulty is the minimum number of leading zero bits of the block hashes
+	// mined by the miners of the class.
+	// +optional
+	MiningDifficulty int32 `json:"miningDifficulty,omitempty"`
+
+	// NodeSelector is a selector which must be true for the miner pods to fit on a node.
+	// The node selector of the pod overrides of a miner can only add keys to it.
+	// More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
+	// +optional
+	// +mapType=atomic
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// If specified, the tolerations of the miner pods. The tolerations of the pod overrides
+	// of a miner are appended.
+	// +optional
+	// +listType=atomic
+	Tolerations []core.Toleration `json:"tolerations,omitempty"`
+
+	// If specified, the scheduling constraints of the miner pods.
+	// The affinity of the pod overrides of a miner is merged into it, and can only narrow the required node affinity.
+	// +optional
+	Affinity *core.Affinity `json:"affinity,omitempty"`
+
+	// Image overrides the blockchain node image of the chain.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Pricing is the price of the miners of the class.
+	// +optional
+	Pricing *MinerClassPricing `json:"pricing,omitempty"`
+}
+
+// MinerClassPricing defines the price of the miners of a MinerClass.
+type MinerClassPricing struct {
+	// HourlyPrice is the number of tokens charged for running a miner of the class for one hour.
+	// +optional
+	HourlyPrice int64 `json:"hourlyPrice,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MinerClassList is a list of MinerClass objects.
+type MinerClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of schema objects.
+	Items []MinerClass `json:"items"`
+}
@@ -8,6 +8,7 @@ package apps
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	cmerrors "github.com/superproj/onex/pkg/errors"
 )
@@ -86,6 +87,88 @@ type MinerSetSpec struct {
 	// reason will be surfaced in the deployment status. Note that progress will
 	// not be estimated during the time a deployment is paused. Defaults to 600s.
 	ProgressDeadlineSeconds *int32 `json:"progressDeadlineSeconds,omitempty" protobuf:"varint,9,opt,name=progressDeadlineSeconds"`
+
+	// The minerset strategy to use to replace existing miners with new ones
+	// when the spec of the miner template changes.
+	// +optional
+	Strategy MinerSetStrategy `json:"strategy,omitempty"`
+
+	// The number of old revisions to retain in the status to allow rollback.
+	// This is a pointer to distinguish between explicit zero and not specified.
+	// Defaults to 10.
+	// +optional
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+
+	// Indicates that the rollout of the miner template is paused. Miners are still
+	// created and deleted to match the replicas, from the last rolled out template.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// The revision to rollback to. The controller copies the miner spec of the revision
+	// into the template and clears this field.
+	// +optional
+	RollbackTo *MinerSetRollback `json:"rollbackTo,omitempty"`
+}
+
+// MinerSetStrategyType defines the type of MinerSet rollout strategies.
+type MinerSetStrategyType string
+
+const (
+	// RecreateMinerSetStrategyType deletes all the old miners before creating new ones.
+	RecreateMinerSetStrategyType MinerSetStrategyType = "Recreate"
+
+	// RollingUpdateMinerSetStrategyType replaces the old miners by new ones progressively,
+	// i.e. gradually scale down the old miners and scale up the new ones.
+	RollingUpdateMinerSetStrategyType MinerSetStrategyType = "RollingUpdate"
+)
+
+// MinerSetStrategy describes how to replace existing miners with new ones.
+type MinerSetStrategy struct {
+	// Type of minerset strategy. Can be "Recreate" or "RollingUpdate". Default is RollingUpdate.
+	// +optional
+	Type MinerSetStrategyType `json:"type,omitempty"`
+
+	// Rolling update config params. Present only if MinerSetStrategyType = RollingUpdate.
+	// +optional
+	RollingUpdate *RollingUpdateMinerSet `json:"rollingUpdate,omitempty"`
+}
+
+// RollingUpdateMinerSet is the spec to control the desired behavior of rolling update.
+type RollingUpdateMinerSet struct {
+	// The maximum number of miners that can be unavailable during the update.
+	// Value can be an absolute number (ex: 5) or a percentage of desired miners (ex: 10%).
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// The maximum number of miners that can be scheduled above the desired number of
+	// miners during the update.
+	// Value can be an absolute number (ex: 5) or a percentage of desired miners (ex: 10%).
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+}
+
+// MinerSetRollback describes the revision a MinerSet is rolled back to.
+type MinerSetRollback struct {
+	// The revision to rollback to. If set to 0, rollback to the last revision.
+	// +optional
+	Revision int64 `json:"revision,omitempty"`
+}
+
+// MinerSetRevision is a revision of the miner template of a MinerSet.
+type MinerSetRevision struct {
+	// Revision is the sequence number of the revision.
+	Revision int64 `json:"revision"`
+
+	// TemplateHash is the hash of the miner spec of the template.
+	TemplateHash string `json:"templateHash"`
+
+	// Spec is the miner spec of the template of the revision.
+	// +optional
+	Spec MinerSpec `json:"spec,omitempty"`
+
+	// CreationTimestamp is the time the revision was rolled out.
+	// +optional
+	CreationTimestamp metav1.Time `json:"creationTimestamp,omitempty"`
 }
 
 // MinerTemplateSpec describes the data needed to create a Miner from a template.
@@ -167,6 +250,23 @@ type MinerSetStatus struct {
 	// +patchMergeKey=type
 	// +patchStrategy=merge
 	Conditions Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,6,rep,name=conditions"`
+
+	// The number of miners created from the current revision of the miner template.
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+
+	// Revision is the current revision of the miner template.
+	// +optional
+	Revision int64 `json:"revision,omitempty"`
+
+	// TemplateHash is the hash of the current revision of the miner template.
+	// +optional
+	TemplateHash string `json:"templateHash,omitempty"`
+
+	// History is the current and the old revisions of the miner template, from
+	// the oldest to the newest.
+	// +optional
+	History []MinerSetRevision `json:"history,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -0,0 +1,237 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package apps
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MinerSetAutoscaler automatically scales the replicas of a MinerSet between the
+// minimum and the maximum, based on the metrics specified.
+type MinerSetAutoscaler struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the behaviour of the autoscaler.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Spec MinerSetAutoscalerSpec `json:"spec,omitempty"`
+
+	// Status is the current information about the autoscaler.
+	// +optional
+	Status MinerSetAutoscalerStatus `json:"status,omitempty"`
+}
+
+// MinerSetAutoscalerSpec describes the desired functionality of the MinerSetAutoscaler.
+type MinerSetAutoscalerSpec struct {
+	// ScaleTargetRef points to the MinerSet to scale, in the namespace of the autoscaler.
+	ScaleTargetRef LocalObjectReference `json:"scaleTargetRef"`
+
+	// MinReplicas is the lower limit for the number of replicas to which the autoscaler
+	// can scale down. Defaults to 1.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper limit for the number of replicas to which the autoscaler
+	// can scale up. It cannot be less than minReplicas.
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// Metrics contains the specifications used to calculate the desired replica count.
+	// The desired replica count is calculated for each metric, and the largest one is used.
+	// If no metric gives a replica count, e.g. all the schedules are inactive, the
+	// MinerSet is scaled to minReplicas.
+	// +optional
+	Metrics []MinerSetMetricSpec `json:"metrics,omitempty"`
+
+	// Behavior configures the scaling behavior of the target in both up and down
+	// directions. If not set, the default behaviors are used.
+	// +optional
+	Behavior *MinerSetAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+// MinerSetMetricSourceType indicates the type of metric.
+type MinerSetMetricSourceType string
+
+const (
+	// PodCPUMetricSourceType is the CPU usage of the miner pods, as a percentage of
+	// their CPU requests.
+	PodCPUMetricSourceType MinerSetMetricSourceType = "PodCPU"
+
+	// ChainBlockIntervalMetricSourceType is the average time between two blocks of the
+	// chain the miners of the MinerSet are mining.
+	ChainBlockIntervalMetricSourceType MinerSetMetricSourceType = "ChainBlockInterval"
+
+	// PendingChargeRequestsMetricSourceType is the number of ChargeRequests which are
+	// not settled yet in the namespace of the autoscaler.
+	PendingChargeRequestsMetricSourceType MinerSetMetricSourceType = "PendingChargeRequests"
+
+	// ScheduleMetricSourceType is a fixed number of replicas during a recurring time window.
+	ScheduleMetricSourceType MinerSetMetricSourceType = "Schedule"
+)
+
+// MinerSetMetricSpec specifies how to scale based on a single metric. Only the field
+// matching the type should be set.
+type MinerSetMetricSpec struct {
+	// Type is the type of metric source. It should be one of "PodCPU", "ChainBlockInterval",
+	// "PendingChargeRequests" or "Schedule", each mapping to a matching field in the object.
+	Type MinerSetMetricSourceType `json:"type"`
+
+	// PodCPU refers to the CPU usage of the miner pods.
+	// +optional
+	PodCPU *PodCPUMetricSource `json:"podCPU,omitempty"`
+
+	// ChainBlockInterval refers to the average block interval of the chain.
+	// +optional
+	ChainBlockInterval *ChainBlockIntervalMetricSource `json:"chainBlockInterval,omitempty"`
+
+	// PendingChargeRequests refers to the ChargeRequests not settled yet.
+	// +optional
+	PendingChargeRequests *PendingChargeRequestsMetricSource `json:"pendingChargeRequests,omitempty"`
+
+	// Schedule refers to a recurring time window.
+	// +optional
+	Schedule *ScheduleMetricSource `json:"schedule,omitempty"`
+}
+
+// PodCPUMetricSource scales the MinerSet to keep the average CPU utilization of its
+// miner pods at the target.
+type PodCPUMetricSource struct {
+	// TargetAverageUtilization is the target value of the average CPU utilization of
+	// the miner pods, as a percentage of their CPU requests, or limits if the requests
+	// are not set.
+	TargetAverageUtilization int32 `json:"targetAverageUtilization"`
+}
+
+// ChainBlockIntervalMetricSource scales the MinerSet to keep the average block interval
+// of the chain at the target. The miners are scaled up when the blocks are mined slower
+// than the target, and down when they are mined faster.
+type ChainBlockIntervalMetricSource struct {
+	// TargetSeconds is the target value of the average time between two blocks.
+	TargetSeconds int32 `json:"targetSeconds"`
+}
+
+// PendingChargeRequestsMetricSource scales the MinerSet to have a replica for every
+// targetPerReplica pending ChargeRequests.
+type PendingChargeRequestsMetricSource struct {
+	// TargetPerReplica is the number of pending ChargeRequests a replica can handle.
+	TargetPerReplica int32 `json:"targetPerReplica"`
+}
+
+// ScheduleMetricSource requests a fixed number of replicas for a duration every time
+// the schedule fires.
+type ScheduleMetricSource struct {
+	// Schedule is the start of the time window, in Cron format, e.g. "0 8 * * 1-5".
+	// The time zone can be set with a "CRON_TZ=" prefix, it defaults to the time zone
+	// of the controller.
+	Schedule string `json:"schedule"`
+
+	// Duration is the length of the time window.
+	Duration metav1.Duration `json:"duration"`
+
+	// Replicas is the number of replicas during the time window.
+	Replicas int32 `json:"replicas"`
+}
+
+// MinerSetAutoscalerBehavior configures the scaling behavior of the target in both
+// up and down directions.
+type MinerSetAutoscalerBehavior struct {
+	// ScaleUp is the scaling policy for scaling up. If not set, the MinerSet is
+	// scaled up immediately.
+	// +optional
+	ScaleUp *MinerSetScalingRules `json:"scaleUp,omitempty"`
+
+	// ScaleDown is the scaling policy for scaling down. If not set, the replica counts
+	// recommended in the last 300 seconds are considered.
+	// +optional
+	ScaleDown *MinerSetScalingRules `json:"scaleDown,omitempty"`
+}
+
+// MinerSetScalingRules configures the scaling behavior for one direction.
+type MinerSetScalingRules struct {
+	// StabilizationWindowSeconds is the number of seconds for which past recommendations
+	// should be considered while scaling up or scaling down, to prevent flapping: the
+	// MinerSet is scaled down to the highest, or up to the lowest, replica count
+	// recommended during the window. It must be between 0 and 3600 (one hour).
+	// +optional
+	StabilizationWindowSeconds *int32 `json:"stabilizationWindowSeconds,omitempty"`
+}
+
+// MinerSetAutoscalerStatus describes the current status of a MinerSetAutoscaler.
+type MinerSetAutoscalerStatus struct {
+	// ObservedGeneration is the most recent generation observed by this autoscaler.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastScaleTime is the last time the autoscaler scaled the MinerSet.
+	// +optional
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+
+	// CurrentReplicas is the current number of replicas of the MinerSet, as last seen
+	// by the autoscaler.
+	// +optional
+	CurrentReplicas int32 `json:"currentReplicas,omitempty"`
+
+	// DesiredReplicas is the desired number of replicas of the MinerSet, as last
+	// calculated by the autoscaler.
+	DesiredReplicas int32 `json:"desiredReplicas"`
+
+	// CurrentMetrics is the last read state of the metrics used by this autoscaler.
+	// +optional
+	CurrentMetrics []MinerSetMetricStatus `json:"currentMetrics,omitempty"`
+
+	// Conditions defines current service state of the autoscaler.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
+}
+
+// MinerSetMetricStatus describes the last read state of a single metric.
+type MinerSetMetricStatus struct {
+	// Type is the type of metric source.
+	Type MinerSetMetricSourceType `json:"type"`
+
+	// Current is the current value of the metric: the average utilization in percent
+	// for PodCPU, the average block interval in seconds for ChainBlockInterval, the
+	// number of pending requests for PendingChargeRequests, and 1 if the time window
+	// is active else 0 for Schedule.
+	// +optional
+	Current resource.Quantity `json:"current,omitempty"`
+
+	// DesiredReplicas is the replica count recommended by the metric. It is not set if
+	// the metric does not recommend any replica count, e.g. an inactive schedule.
+	// +optional
+	DesiredReplicas *int32 `json:"desiredReplicas,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MinerSetAutoscalerList is a list of MinerSetAutoscaler objects.
+type MinerSetAutoscalerList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of MinerSetAutoscalers.
+	Items []MinerSetAutoscaler `json:"items"`
+}
+
+// GetConditions returns the set of conditions for this object.
+func (a *MinerSetAutoscaler) GetConditions() Conditions {
+	return a.Status.Conditions
+}
+
+// SetConditions sets the conditions on this object.
+func (a *MinerSetAutoscaler) SetConditions(conditions Conditions) {
+	a.Status.Conditions = conditions
+}
@@ -40,10 +40,16 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Chain{},
 		&ChainList{},
+		&ChargeRequest{},
+		&ChargeRequestList{},
 		&Miner{},
 		&MinerList{},
+		&MinerClass{},
+		&MinerClassList{},
 		&MinerSet{},
 		&MinerSetList{},
+		&MinerSetAutoscaler{},
+		&MinerSetAutoscalerList{},
 		&autoscaling.Scale{},
 	)
 	return nil
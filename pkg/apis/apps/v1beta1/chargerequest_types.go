@@ -43,15 +43,56 @@ type ChargeRequest struct {
 
 // ChargeRequestSpec defines the desired state of ChargeRequest.
 type ChargeRequestSpec struct {
+	// From is the address of the account the charge is paid from.
+	// +optional
+	From string `json:"from,omitempty" protobuf:"bytes,1,opt,name=from"`
+
+	// Password is the password the signing key of the From account is derived from.
+	// It is sealed by the apiserver before being stored, and cleared once the charge
+	// is submitted to the chain.
 	// +optional
-	From     string `json:"from,omitempty" protobuf:"bytes,1,opt,name=from"`
 	Password string `json:"password,omitempty" protobuf:"bytes,2,opt,name=password"`
+
+	// Salt is the hex encoded salt, of at least 16 bytes, the signing key of the From
+	// account is derived from together with the password. It is chosen at random when
+	// the account is created.
+	Salt string `json:"salt" protobuf:"bytes,6,opt,name=salt"`
+
+	// ChainName is the name of the chain the charge is settled on. The charge is paid
+	// to the bootstrap account of the chain.
+	ChainName string `json:"chainName" protobuf:"bytes,3,opt,name=chainName"`
+
+	// Amount is the number of coins charged.
+	Amount int64 `json:"amount" protobuf:"varint,4,opt,name=amount"`
+
+	// Confirmations is the number of blocks, including the one containing the charge,
+	// required before the charge is considered settled.
+	// Defaults to 3.
+	// +optional
+	Confirmations *int32 `json:"confirmations,omitempty" protobuf:"varint,5,opt,name=confirmations"`
 }
 
 // ChargeRequestStatus defines the observed state of ChargeRequest.
 type ChargeRequestStatus struct {
 	// +optional
 	Conditions Conditions `json:"conditions,omitempty" protobuf:"bytes,1,rep,name=conditions"`
+
+	// TransactionID is the identifier of the transaction submitted for the charge.
+	// +optional
+	TransactionID string `json:"transactionID,omitempty" protobuf:"bytes,2,opt,name=transactionID"`
+
+	// SubmittedHeight is the height of the chain when the transaction was submitted.
+	// +optional
+	SubmittedHeight int64 `json:"submittedHeight,omitempty" protobuf:"varint,3,opt,name=submittedHeight"`
+
+	// BlockHeight is the index of the block containing the transaction.
+	// +optional
+	BlockHeight int64 `json:"blockHeight,omitempty" protobuf:"varint,4,opt,name=blockHeight"`
+
+	// Confirmations is the number of blocks, including the one containing the
+	// transaction, mined since the transaction was included in the chain.
+	// +optional
+	Confirmations int32 `json:"confirmations,omitempty" protobuf:"varint,5,opt,name=confirmations"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -13,6 +13,10 @@ const (
 	// MinerSetNameLabel is the label set on miners linked to a minerset.
 	MinerSetNameLabel = "apps.onex.io/minerset-name"
 
+	// MinerSetTemplateHashLabel is the label set on miners identifying the revision of the
+	// minerset's miner template they are created from.
+	MinerSetTemplateHashLabel = "apps.onex.io/minerset-template-hash"
+
 	// MinerDeploymentNameLabel is the label set on miners if they're controlled by MinerDeployment.
 	MinerDeploymentNameLabel = "apps.onex.io/deployment-name"
 
@@ -246,6 +246,21 @@ const (
 	// ScalingDownReason (Severity=Info) documents a MinerSet is decreasing the number of replicas.
 	ScalingDownReason = "ScalingDown"
 
+	// MinersUpToDateCondition documents that all the miners controlled by the MinerSet are created
+	// from the current revision of the miner template.
+	MinersUpToDateCondition ConditionType = "MinersUpToDate"
+
+	// RollingUpdateInProgressReason (Severity=Info) documents a MinerSet is replacing the miners
+	// created from the old revisions of the miner template.
+	RollingUpdateInProgressReason = "RollingUpdateInProgress"
+
+	// RolloutPausedReason (Severity=Info) documents the rollout of a MinerSet is paused.
+	RolloutPausedReason = "RolloutPaused"
+
+	// ProgressDeadlineExceededReason (Severity=Error) documents the rollout of a MinerSet has not
+	// made progress for more than ProgressDeadlineSeconds.
+	ProgressDeadlineExceededReason = "ProgressDeadlineExceeded"
+
 	ConfigMapsCreatedCondition ConditionType = "ConfigMapsCreated"
 
 	ConfigMapCreationFailedReason = "ConfigMapCreationFailed"
@@ -277,7 +292,65 @@ const (
 	TopologyReconciledHookBlockingReason = "LifecycleHookBlocking"
 )
 
+// Conditions and condition reasons for MinerSetAutoscalers.
+const (
+	// ScalingActiveCondition reports whether the autoscaler is able to calculate the desired
+	// replica count of the MinerSet from its metrics.
+	ScalingActiveCondition ConditionType = "ScalingActive"
+
+	// FailedGetScaleTargetReason (Severity=Error) documents an autoscaler which failed to get
+	// the MinerSet to scale.
+	FailedGetScaleTargetReason = "FailedGetScaleTarget"
+
+	// FailedGetMetricsReason (Severity=Warning) documents an autoscaler which failed to read
+	// all of its metrics.
+	FailedGetMetricsReason = "FailedGetMetrics"
+
+	// AbleToScaleCondition reports whether the autoscaler is able to scale the MinerSet
+	// to the desired replica count.
+	AbleToScaleCondition ConditionType = "AbleToScale"
+
+	// ScaleUpStabilizedReason (Severity=Info) documents an autoscaler which holds back a scale
+	// up because of the scale up stabilization window.
+	ScaleUpStabilizedReason = "ScaleUpStabilized"
+
+	// ScaleDownStabilizedReason (Severity=Info) documents an autoscaler which holds back a scale
+	// down because of the scale down stabilization window.
+	ScaleDownStabilizedReason = "ScaleDownStabilized"
+
+	// FailedUpdateScaleReason (Severity=Warning) documents an autoscaler which failed to update
+	// the replicas of the MinerSet.
+	FailedUpdateScaleReason = "FailedUpdateScale"
+)
+
+// Conditions and condition reasons for ChargeRequests.
 const (
 	// Approved indicates the charge request was approved.
 	ChargeApproved ConditionType = "Approved"
+
+	// ChargeSucceeded indicates the charge request was settled on the chain.
+	ChargeSucceeded ConditionType = "Succeeded"
+
+	// ChargeFailed indicates the charge request cannot be settled.
+	ChargeFailed ConditionType = "Failed"
+
+	// InvalidPasswordReason (Severity=Error) documents a charge request whose password can not be
+	// opened, or does not own the account the charge is paid from.
+	InvalidPasswordReason = "InvalidPassword"
+
+	// WaitingForChainReason (Severity=Info) documents a charge request waiting for its chain to
+	// be created or to have a genesis miner.
+	WaitingForChainReason = "WaitingForChain"
+
+	// WaitingForConfirmationsReason (Severity=Info) documents a charge request whose transaction
+	// is not confirmed by enough blocks yet.
+	WaitingForConfirmationsReason = "WaitingForConfirmations"
+
+	// TransactionRejectedReason documents a charge request whose transaction was rejected by the
+	// chain, e.g. because the balance of the account is not sufficient.
+	TransactionRejectedReason = "TransactionRejected"
+
+	// TransactionDroppedReason documents a charge request whose transaction left the mempool
+	// without being included in a block.
+	TransactionDroppedReason = "TransactionDropped"
 )
@@ -0,0 +1,26 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/kubernetes/pkg/apis/core"
+	k8scorev1 "k8s.io/kubernetes/pkg/apis/core/v1"
+)
+
+// Convert_v1_ResourceRequirements_To_core_ResourceRequirements is used by the generated
+// conversions of the types which embed the resource requirements of a container.
+func Convert_v1_ResourceRequirements_To_core_ResourceRequirements(in *corev1.ResourceRequirements, out *core.ResourceRequirements, s conversion.Scope) error {
+	return k8scorev1.Convert_v1_ResourceRequirements_To_core_ResourceRequirements(in, out, s)
+}
+
+// Convert_core_ResourceRequirements_To_v1_ResourceRequirements is used by the generated
+// conversions of the types which embed the resource requirements of a container.
+func Convert_core_ResourceRequirements_To_v1_ResourceRequirements(in *core.ResourceRequirements, out *corev1.ResourceRequirements, s conversion.Scope) error {
+	return k8scorev1.Convert_core_ResourceRequirements_To_v1_ResourceRequirements(in, out, s)
+}
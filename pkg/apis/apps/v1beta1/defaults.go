@@ -8,6 +8,7 @@ package v1beta1
 
 import (
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 
 	known "github.com/superproj/onex/internal/pkg/known/apiserver"
@@ -36,6 +37,59 @@ func SetDefaults_MinerSet(obj *MinerSet) {
 }
 */
 
+// SetDefaults_MinerSetSpec sets defaults for MinerSet spec.
+func SetDefaults_MinerSetSpec(obj *MinerSetSpec) {
+	if obj.Strategy.Type == "" {
+		obj.Strategy.Type = RollingUpdateMinerSetStrategyType
+	}
+
+	if obj.Strategy.Type == RollingUpdateMinerSetStrategyType {
+		if obj.Strategy.RollingUpdate == nil {
+			obj.Strategy.RollingUpdate = &RollingUpdateMinerSet{}
+		}
+		// Set default MaxUnavailable and MaxSurge as 25% by default.
+		maxUnavailable := intstr.FromString("25%")
+		if obj.Strategy.RollingUpdate.MaxUnavailable == nil {
+			obj.Strategy.RollingUpdate.MaxUnavailable = &maxUnavailable
+		}
+		maxSurge := intstr.FromString("25%")
+		if obj.Strategy.RollingUpdate.MaxSurge == nil {
+			obj.Strategy.RollingUpdate.MaxSurge = &maxSurge
+		}
+	}
+
+	if obj.RevisionHistoryLimit == nil {
+		obj.RevisionHistoryLimit = ptr.To[int32](10)
+	}
+
+	if obj.ProgressDeadlineSeconds == nil {
+		obj.ProgressDeadlineSeconds = ptr.To[int32](600)
+	}
+}
+
+// SetDefaults_MinerSetAutoscalerSpec sets defaults for MinerSetAutoscaler spec.
+func SetDefaults_MinerSetAutoscalerSpec(obj *MinerSetAutoscalerSpec) {
+	if obj.MinReplicas == nil {
+		obj.MinReplicas = ptr.To[int32](1)
+	}
+
+	if obj.Behavior == nil {
+		obj.Behavior = &MinerSetAutoscalerBehavior{}
+	}
+	if obj.Behavior.ScaleUp == nil {
+		obj.Behavior.ScaleUp = &MinerSetScalingRules{}
+	}
+	if obj.Behavior.ScaleUp.StabilizationWindowSeconds == nil {
+		obj.Behavior.ScaleUp.StabilizationWindowSeconds = ptr.To[int32](0)
+	}
+	if obj.Behavior.ScaleDown == nil {
+		obj.Behavior.ScaleDown = &MinerSetScalingRules{}
+	}
+	if obj.Behavior.ScaleDown.StabilizationWindowSeconds == nil {
+		obj.Behavior.ScaleDown.StabilizationWindowSeconds = ptr.To[int32](300)
+	}
+}
+
 // SetDefaults_Miner sets defaults for Miner.
 func SetDefaults_Miner(obj *Miner) {
 	// Miner name prefix is fixed to `mi-`
@@ -69,3 +123,10 @@ func SetDefaults_ChainSpec(obj *ChainSpec) {
 		obj.MinMineIntervalSeconds = 12 * 60 * 60 // 12 hours
 	}
 }
+
+// SetDefaults_ChargeRequestSpec sets defaults for ChargeRequest spec.
+func SetDefaults_ChargeRequestSpec(obj *ChargeRequestSpec) {
+	if obj.Confirmations == nil {
+		obj.Confirmations = ptr.To[int32](3)
+	}
+}
@@ -16,14 +16,16 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	github_com_superproj_onex_pkg_errors "github.com/superproj/onex/pkg/errors"
-
 	k8s_io_api_core_v1 "k8s.io/api/core/v1"
+	v11 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -65,10 +67,38 @@ func (m *Chain) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Chain proto.InternalMessageInfo
 
+func (m *ChainBlockIntervalMetricSource) Reset()      { *m = ChainBlockIntervalMetricSource{} }
+func (*ChainBlockIntervalMetricSource) ProtoMessage() {}
+func (*ChainBlockIntervalMetricSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{1}
+}
+func (m *ChainBlockIntervalMetricSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainBlockIntervalMetricSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ChainBlockIntervalMetricSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainBlockIntervalMetricSource.Merge(m, src)
+}
+func (m *ChainBlockIntervalMetricSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainBlockIntervalMetricSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainBlockIntervalMetricSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainBlockIntervalMetricSource proto.InternalMessageInfo
+
 func (m *ChainList) Reset()      { *m = ChainList{} }
 func (*ChainList) ProtoMessage() {}
 func (*ChainList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced0953b0a13158a, []int{1}
+	return fileDescriptor_ced0953b0a13158a, []int{2}
 }
 func (m *ChainList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -96,7 +126,7 @@ var xxx_messageInfo_ChainList proto.InternalMessageInfo
 func (m *ChainSpec) Reset()      { *m = ChainSpec{} }
 func (*ChainSpec) ProtoMessage() {}
 func (*ChainSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced0953b0a13158a, []int{2}
+	return fileDescriptor_ced0953b0a13158a, []int{3}
 }
 func (m *ChainSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -124,7 +154,7 @@ var xxx_messageInfo_ChainSpec proto.InternalMessageInfo
 func (m *ChainStatus) Reset()      { *m = ChainStatus{} }
 func (*ChainStatus) ProtoMessage() {}
 func (*ChainStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced0953b0a13158a, []int{3}
+	return fileDescriptor_ced0953b0a13158a, []int{4}
 }
 func (m *ChainStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -152,7 +182,7 @@ var xxx_messageInfo_ChainStatus proto.InternalMessageInfo
 func (m *ChargeRequest) Reset()      { *m = ChargeRequest{} }
 func (*ChargeRequest) ProtoMessage() {}
 func (*ChargeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced0953b0a13158a, []int{4}
+	return fileDescriptor_ced0953b0a13158a, []int{5}
 }
 func (m *ChargeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,7 +210,7 @@ var xxx_messageInfo_ChargeRequest proto.InternalMessageInfo
 func (m *ChargeRequestList) Reset()      { *m = ChargeRequestList{} }
 func (*ChargeRequestList) ProtoMessage() {}
 func (*ChargeRequestList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced0953b0a13158a, []int{5}
+	return fileDescriptor_ced0953b0a13158a, []int{6}
 }
 func (m *ChargeRequestList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -208,7 +238,7 @@ var xxx_messageInfo_ChargeRequestList proto.InternalMessageInfo
 func (m *ChargeRequestSpec) Reset()      { *m = ChargeRequestSpec{} }
 func (*ChargeRequestSpec) ProtoMessage() {}
 func (*ChargeRequestSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced0953b0a13158a, []int{6}
+	return fileDescriptor_ced0953b0a13158a, []int{7}
 }
 func (m *ChargeRequestSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -236,7 +266,7 @@ var xxx_messageInfo_ChargeRequestSpec proto.InternalMessageInfo
 func (m *ChargeRequestStatus) Reset()      { *m = ChargeRequestStatus{} }
 func (*ChargeRequestStatus) ProtoMessage() {}
 func (*ChargeRequestStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced0953b0a13158a, []int{7}
+	return fileDescriptor_ced0953b0a13158a, []int{8}
 }
 func (m *ChargeRequestStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,7 +294,7 @@ var xxx_messageInfo_ChargeRequestStatus proto.InternalMessageInfo
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced0953b0a13158a, []int{8}
+	return fileDescriptor_ced0953b0a13158a, []int{9}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -292,7 +322,7 @@ var xxx_messageInfo_Condition proto.InternalMessageInfo
 func (m *LocalObjectReference) Reset()      { *m = LocalObjectReference{} }
 func (*LocalObjectReference) ProtoMessage() {}
 func (*LocalObjectReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced0953b0a13158a, []int{9}
+	return fileDescriptor_ced0953b0a13158a, []int{10}
 }
 func (m *LocalObjectReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -320,7 +350,7 @@ var xxx_messageInfo_LocalObjectReference proto.InternalMessageInfo
 func (m *Miner) Reset()      { *m = Miner{} }
 func (*Miner) ProtoMessage() {}
 func (*Miner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced0953b0a13158a, []int{10}
+	return fileDescriptor_ced0953b0a13158a, []int{11}
 }
 func (m *Miner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -348,7 +378,7 @@ var xxx_messageInfo_Miner proto.InternalMessageInfo
 func (m *MinerAddress) Reset()      { *m = MinerAddress{} }
 func (*MinerAddress) ProtoMessage() {}
 func (*MinerAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced0953b0a13158a, []int{11}
+	return fileDescriptor_ced0953b0a13158a, []int{12}
 }
 func (m *MinerAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -373,10 +403,122 @@ func (m *MinerAddress) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MinerAddress proto.InternalMessageInfo
 
+func (m *MinerClass) Reset()      { *m = MinerClass{} }
+func (*MinerClass) ProtoMessage() {}
+func (*MinerClass) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{13}
+}
+func (m *MinerClass) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinerClass) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MinerClass) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerClass.Merge(m, src)
+}
+func (m *MinerClass) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinerClass) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerClass.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinerClass proto.InternalMessageInfo
+
+func (m *MinerClassList) Reset()      { *m = MinerClassList{} }
+func (*MinerClassList) ProtoMessage() {}
+func (*MinerClassList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{14}
+}
+func (m *MinerClassList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinerClassList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MinerClassList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerClassList.Merge(m, src)
+}
+func (m *MinerClassList) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinerClassList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerClassList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinerClassList proto.InternalMessageInfo
+
+func (m *MinerClassPricing) Reset()      { *m = MinerClassPricing{} }
+func (*MinerClassPricing) ProtoMessage() {}
+func (*MinerClassPricing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{15}
+}
+func (m *MinerClassPricing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinerClassPricing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MinerClassPricing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerClassPricing.Merge(m, src)
+}
+func (m *MinerClassPricing) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinerClassPricing) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerClassPricing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinerClassPricing proto.InternalMessageInfo
+
+func (m *MinerClassSpec) Reset()      { *m = MinerClassSpec{} }
+func (*MinerClassSpec) ProtoMessage() {}
+func (*MinerClassSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{16}
+}
+func (m *MinerClassSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinerClassSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MinerClassSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerClassSpec.Merge(m, src)
+}
+func (m *MinerClassSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinerClassSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerClassSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinerClassSpec proto.InternalMessageInfo
+
 func (m *MinerList) Reset()      { *m = MinerList{} }
 func (*MinerList) ProtoMessage() {}
 func (*MinerList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced0953b0a13158a, []int{12}
+	return fileDescriptor_ced0953b0a13158a, []int{17}
 }
 func (m *MinerList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -401,10 +543,38 @@ func (m *MinerList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MinerList proto.InternalMessageInfo
 
+func (m *MinerPodOverrides) Reset()      { *m = MinerPodOverrides{} }
+func (*MinerPodOverrides) ProtoMessage() {}
+func (*MinerPodOverrides) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{18}
+}
+func (m *MinerPodOverrides) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinerPodOverrides) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MinerPodOverrides) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerPodOverrides.Merge(m, src)
+}
+func (m *MinerPodOverrides) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinerPodOverrides) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerPodOverrides.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinerPodOverrides proto.InternalMessageInfo
+
 func (m *MinerSet) Reset()      { *m = MinerSet{} }
 func (*MinerSet) ProtoMessage() {}
 func (*MinerSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced0953b0a13158a, []int{13}
+	return fileDescriptor_ced0953b0a13158a, []int{19}
 }
 func (m *MinerSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -429,15 +599,15 @@ func (m *MinerSet) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MinerSet proto.InternalMessageInfo
 
-func (m *MinerSetList) Reset()      { *m = MinerSetList{} }
-func (*MinerSetList) ProtoMessage() {}
-func (*MinerSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced0953b0a13158a, []int{14}
+func (m *MinerSetAutoscaler) Reset()      { *m = MinerSetAutoscaler{} }
+func (*MinerSetAutoscaler) ProtoMessage() {}
+func (*MinerSetAutoscaler) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{20}
 }
-func (m *MinerSetList) XXX_Unmarshal(b []byte) error {
+func (m *MinerSetAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MinerSetList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MinerSetAutoscaler) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -445,27 +615,27 @@ func (m *MinerSetList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *MinerSetList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MinerSetList.Merge(m, src)
+func (m *MinerSetAutoscaler) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerSetAutoscaler.Merge(m, src)
 }
-func (m *MinerSetList) XXX_Size() int {
+func (m *MinerSetAutoscaler) XXX_Size() int {
 	return m.Size()
 }
-func (m *MinerSetList) XXX_DiscardUnknown() {
-	xxx_messageInfo_MinerSetList.DiscardUnknown(m)
+func (m *MinerSetAutoscaler) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerSetAutoscaler.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MinerSetList proto.InternalMessageInfo
+var xxx_messageInfo_MinerSetAutoscaler proto.InternalMessageInfo
 
-func (m *MinerSetSpec) Reset()      { *m = MinerSetSpec{} }
-func (*MinerSetSpec) ProtoMessage() {}
-func (*MinerSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced0953b0a13158a, []int{15}
+func (m *MinerSetAutoscalerBehavior) Reset()      { *m = MinerSetAutoscalerBehavior{} }
+func (*MinerSetAutoscalerBehavior) ProtoMessage() {}
+func (*MinerSetAutoscalerBehavior) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{21}
 }
-func (m *MinerSetSpec) XXX_Unmarshal(b []byte) error {
+func (m *MinerSetAutoscalerBehavior) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MinerSetSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MinerSetAutoscalerBehavior) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -473,27 +643,27 @@ func (m *MinerSetSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *MinerSetSpec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MinerSetSpec.Merge(m, src)
+func (m *MinerSetAutoscalerBehavior) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerSetAutoscalerBehavior.Merge(m, src)
 }
-func (m *MinerSetSpec) XXX_Size() int {
+func (m *MinerSetAutoscalerBehavior) XXX_Size() int {
 	return m.Size()
 }
-func (m *MinerSetSpec) XXX_DiscardUnknown() {
-	xxx_messageInfo_MinerSetSpec.DiscardUnknown(m)
+func (m *MinerSetAutoscalerBehavior) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerSetAutoscalerBehavior.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MinerSetSpec proto.InternalMessageInfo
+var xxx_messageInfo_MinerSetAutoscalerBehavior proto.InternalMessageInfo
 
-func (m *MinerSetStatus) Reset()      { *m = MinerSetStatus{} }
-func (*MinerSetStatus) ProtoMessage() {}
-func (*MinerSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced0953b0a13158a, []int{16}
+func (m *MinerSetAutoscalerList) Reset()      { *m = MinerSetAutoscalerList{} }
+func (*MinerSetAutoscalerList) ProtoMessage() {}
+func (*MinerSetAutoscalerList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{22}
 }
-func (m *MinerSetStatus) XXX_Unmarshal(b []byte) error {
+func (m *MinerSetAutoscalerList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MinerSetStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MinerSetAutoscalerList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -501,27 +671,27 @@ func (m *MinerSetStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 	}
 	return b[:n], nil
 }
-func (m *MinerSetStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MinerSetStatus.Merge(m, src)
+func (m *MinerSetAutoscalerList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerSetAutoscalerList.Merge(m, src)
 }
-func (m *MinerSetStatus) XXX_Size() int {
+func (m *MinerSetAutoscalerList) XXX_Size() int {
 	return m.Size()
 }
-func (m *MinerSetStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_MinerSetStatus.DiscardUnknown(m)
+func (m *MinerSetAutoscalerList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerSetAutoscalerList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MinerSetStatus proto.InternalMessageInfo
+var xxx_messageInfo_MinerSetAutoscalerList proto.InternalMessageInfo
 
-func (m *MinerSpec) Reset()      { *m = MinerSpec{} }
-func (*MinerSpec) ProtoMessage() {}
-func (*MinerSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced0953b0a13158a, []int{17}
+func (m *MinerSetAutoscalerSpec) Reset()      { *m = MinerSetAutoscalerSpec{} }
+func (*MinerSetAutoscalerSpec) ProtoMessage() {}
+func (*MinerSetAutoscalerSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{23}
 }
-func (m *MinerSpec) XXX_Unmarshal(b []byte) error {
+func (m *MinerSetAutoscalerSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MinerSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MinerSetAutoscalerSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -529,27 +699,27 @@ func (m *MinerSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (m *MinerSpec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MinerSpec.Merge(m, src)
+func (m *MinerSetAutoscalerSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerSetAutoscalerSpec.Merge(m, src)
 }
-func (m *MinerSpec) XXX_Size() int {
+func (m *MinerSetAutoscalerSpec) XXX_Size() int {
 	return m.Size()
 }
-func (m *MinerSpec) XXX_DiscardUnknown() {
-	xxx_messageInfo_MinerSpec.DiscardUnknown(m)
+func (m *MinerSetAutoscalerSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerSetAutoscalerSpec.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MinerSpec proto.InternalMessageInfo
+var xxx_messageInfo_MinerSetAutoscalerSpec proto.InternalMessageInfo
 
-func (m *MinerStatus) Reset()      { *m = MinerStatus{} }
-func (*MinerStatus) ProtoMessage() {}
-func (*MinerStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced0953b0a13158a, []int{18}
+func (m *MinerSetAutoscalerStatus) Reset()      { *m = MinerSetAutoscalerStatus{} }
+func (*MinerSetAutoscalerStatus) ProtoMessage() {}
+func (*MinerSetAutoscalerStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{24}
 }
-func (m *MinerStatus) XXX_Unmarshal(b []byte) error {
+func (m *MinerSetAutoscalerStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MinerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MinerSetAutoscalerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -557,27 +727,27 @@ func (m *MinerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *MinerStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MinerStatus.Merge(m, src)
+func (m *MinerSetAutoscalerStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerSetAutoscalerStatus.Merge(m, src)
 }
-func (m *MinerStatus) XXX_Size() int {
+func (m *MinerSetAutoscalerStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *MinerStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_MinerStatus.DiscardUnknown(m)
+func (m *MinerSetAutoscalerStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerSetAutoscalerStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MinerStatus proto.InternalMessageInfo
+var xxx_messageInfo_MinerSetAutoscalerStatus proto.InternalMessageInfo
 
-func (m *MinerTemplateSpec) Reset()      { *m = MinerTemplateSpec{} }
-func (*MinerTemplateSpec) ProtoMessage() {}
-func (*MinerTemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced0953b0a13158a, []int{19}
+func (m *MinerSetList) Reset()      { *m = MinerSetList{} }
+func (*MinerSetList) ProtoMessage() {}
+func (*MinerSetList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{25}
 }
-func (m *MinerTemplateSpec) XXX_Unmarshal(b []byte) error {
+func (m *MinerSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MinerTemplateSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MinerSetList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -585,27 +755,27 @@ func (m *MinerTemplateSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 	}
 	return b[:n], nil
 }
-func (m *MinerTemplateSpec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MinerTemplateSpec.Merge(m, src)
+func (m *MinerSetList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerSetList.Merge(m, src)
 }
-func (m *MinerTemplateSpec) XXX_Size() int {
+func (m *MinerSetList) XXX_Size() int {
 	return m.Size()
 }
-func (m *MinerTemplateSpec) XXX_DiscardUnknown() {
-	xxx_messageInfo_MinerTemplateSpec.DiscardUnknown(m)
+func (m *MinerSetList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerSetList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MinerTemplateSpec proto.InternalMessageInfo
+var xxx_messageInfo_MinerSetList proto.InternalMessageInfo
 
-func (m *ObjectMeta) Reset()      { *m = ObjectMeta{} }
-func (*ObjectMeta) ProtoMessage() {}
-func (*ObjectMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced0953b0a13158a, []int{20}
+func (m *MinerSetMetricSpec) Reset()      { *m = MinerSetMetricSpec{} }
+func (*MinerSetMetricSpec) ProtoMessage() {}
+func (*MinerSetMetricSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{26}
 }
-func (m *ObjectMeta) XXX_Unmarshal(b []byte) error {
+func (m *MinerSetMetricSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ObjectMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MinerSetMetricSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -613,27 +783,27 @@ func (m *ObjectMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (m *ObjectMeta) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ObjectMeta.Merge(m, src)
+func (m *MinerSetMetricSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerSetMetricSpec.Merge(m, src)
 }
-func (m *ObjectMeta) XXX_Size() int {
+func (m *MinerSetMetricSpec) XXX_Size() int {
 	return m.Size()
 }
-func (m *ObjectMeta) XXX_DiscardUnknown() {
-	xxx_messageInfo_ObjectMeta.DiscardUnknown(m)
+func (m *MinerSetMetricSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerSetMetricSpec.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ObjectMeta proto.InternalMessageInfo
+var xxx_messageInfo_MinerSetMetricSpec proto.InternalMessageInfo
 
-func (m *PodInfo) Reset()      { *m = PodInfo{} }
-func (*PodInfo) ProtoMessage() {}
-func (*PodInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced0953b0a13158a, []int{21}
+func (m *MinerSetMetricStatus) Reset()      { *m = MinerSetMetricStatus{} }
+func (*MinerSetMetricStatus) ProtoMessage() {}
+func (*MinerSetMetricStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{27}
 }
-func (m *PodInfo) XXX_Unmarshal(b []byte) error {
+func (m *MinerSetMetricStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PodInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MinerSetMetricStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -641,381 +811,712 @@ func (m *PodInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (m *PodInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PodInfo.Merge(m, src)
+func (m *MinerSetMetricStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerSetMetricStatus.Merge(m, src)
 }
-func (m *PodInfo) XXX_Size() int {
+func (m *MinerSetMetricStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *PodInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_PodInfo.DiscardUnknown(m)
+func (m *MinerSetMetricStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerSetMetricStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PodInfo proto.InternalMessageInfo
+var xxx_messageInfo_MinerSetMetricStatus proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Chain)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.Chain")
-	proto.RegisterType((*ChainList)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ChainList")
-	proto.RegisterType((*ChainSpec)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ChainSpec")
-	proto.RegisterType((*ChainStatus)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ChainStatus")
-	proto.RegisterType((*ChargeRequest)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ChargeRequest")
-	proto.RegisterType((*ChargeRequestList)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ChargeRequestList")
-	proto.RegisterType((*ChargeRequestSpec)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ChargeRequestSpec")
-	proto.RegisterType((*ChargeRequestStatus)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ChargeRequestStatus")
-	proto.RegisterType((*Condition)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.Condition")
-	proto.RegisterType((*LocalObjectReference)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.LocalObjectReference")
-	proto.RegisterType((*Miner)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.Miner")
-	proto.RegisterType((*MinerAddress)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerAddress")
-	proto.RegisterType((*MinerList)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerList")
-	proto.RegisterType((*MinerSet)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSet")
-	proto.RegisterType((*MinerSetList)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSetList")
-	proto.RegisterType((*MinerSetSpec)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSetSpec")
-	proto.RegisterType((*MinerSetStatus)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSetStatus")
-	proto.RegisterType((*MinerSpec)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSpec")
-	proto.RegisterType((*MinerStatus)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerStatus")
-	proto.RegisterType((*MinerTemplateSpec)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerTemplateSpec")
-	proto.RegisterType((*ObjectMeta)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ObjectMeta")
-	proto.RegisterMapType((map[string]string)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ObjectMeta.AnnotationsEntry")
-	proto.RegisterMapType((map[string]string)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ObjectMeta.LabelsEntry")
-	proto.RegisterType((*PodInfo)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.PodInfo")
+func (m *MinerSetRevision) Reset()      { *m = MinerSetRevision{} }
+func (*MinerSetRevision) ProtoMessage() {}
+func (*MinerSetRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{28}
 }
-
-func init() {
-	proto.RegisterFile("github.com/superproj/onex/pkg/apis/apps/v1beta1/generated.proto", fileDescriptor_ced0953b0a13158a)
-}
-
-var fileDescriptor_ced0953b0a13158a = []byte{
-	// 1831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4f, 0x73, 0x1b, 0x49,
-	0x15, 0xf7, 0x48, 0x96, 0x6d, 0xb5, 0xec, 0xc4, 0xee, 0x35, 0x89, 0x30, 0x20, 0xb9, 0x94, 0x82,
-	0xca, 0x52, 0x30, 0xda, 0x84, 0xec, 0x96, 0x93, 0x85, 0x5d, 0x3c, 0x71, 0x92, 0xca, 0x96, 0x45,
-	0x54, 0xed, 0x84, 0x03, 0x2c, 0x2c, 0xad, 0x99, 0x96, 0x34, 0xeb, 0x99, 0xe9, 0x61, 0xba, 0x25,
-	0x50, 0x71, 0xa1, 0x6a, 0x0b, 0xce, 0x1c, 0x29, 0xbe, 0x03, 0x17, 0x0e, 0xf0, 0x09, 0xa0, 0x72,
-	0xe0, 0xb0, 0x27, 0x6a, 0x8b, 0x83, 0x8a, 0x88, 0x1b, 0x1f, 0xc1, 0x07, 0x8a, 0xea, 0x9e, 0x9e,
-	0xbf, 0x92, 0x82, 0x25, 0xaf, 0x5d, 0xb5, 0x37, 0xab, 0xdf, 0x7b, 0xbf, 0xd7, 0xaf, 0xfb, 0xbd,
-	0xdf, 0x7b, 0x3d, 0x06, 0xef, 0xf7, 0x6c, 0xde, 0x1f, 0x74, 0x74, 0x93, 0xba, 0x4d, 0x36, 0xf0,
-	0x49, 0xe0, 0x07, 0xf4, 0xe3, 0x26, 0xf5, 0xc8, 0x2f, 0x9b, 0xfe, 0x69, 0xaf, 0x89, 0x7d, 0x9b,
-	0x35, 0xb1, 0xef, 0xb3, 0xe6, 0xf0, 0x4e, 0x87, 0x70, 0x7c, 0xa7, 0xd9, 0x23, 0x1e, 0x09, 0x30,
-	0x27, 0x96, 0xee, 0x07, 0x94, 0x53, 0xd8, 0x4c, 0x00, 0xf4, 0x18, 0x40, 0x17, 0x00, 0xba, 0x7f,
-	0xda, 0xd3, 0x05, 0x80, 0x2e, 0x00, 0x74, 0x05, 0xb0, 0xf7, 0xed, 0x94, 0xc7, 0x1e, 0xed, 0xd1,
-	0xa6, 0xc4, 0xe9, 0x0c, 0xba, 0xf2, 0x97, 0xfc, 0x21, 0xff, 0x0a, 0xf1, 0xf7, 0x1a, 0xa7, 0x07,
-	0x4c, 0xb7, 0xa9, 0xd8, 0x49, 0xd3, 0xa4, 0x01, 0x69, 0x0e, 0xa7, 0xf6, 0xb0, 0x77, 0x2f, 0xd1,
-	0x71, 0xb1, 0xd9, 0xb7, 0x3d, 0x12, 0x8c, 0x92, 0xed, 0xbb, 0x84, 0xe3, 0x59, 0x56, 0xcd, 0x79,
-	0x56, 0xc1, 0xc0, 0xe3, 0xb6, 0x4b, 0xa6, 0x0c, 0xde, 0xf9, 0x7f, 0x06, 0xcc, 0xec, 0x13, 0x17,
-	0xe7, 0xed, 0x1a, 0x7f, 0x2c, 0x80, 0xd2, 0xc3, 0x3e, 0xb6, 0x3d, 0xf8, 0x33, 0xb0, 0x21, 0x76,
-	0x63, 0x61, 0x8e, 0xab, 0xda, 0xbe, 0x76, 0xbb, 0x72, 0xf7, 0x2d, 0x3d, 0x04, 0xd5, 0xd3, 0xa0,
-	0xc9, 0xc9, 0x09, 0x6d, 0x7d, 0x78, 0x47, 0x7f, 0xd6, 0xf9, 0x98, 0x98, 0xbc, 0x45, 0x38, 0x36,
-	0xe0, 0xcb, 0x71, 0x7d, 0x65, 0x32, 0xae, 0x83, 0x64, 0x0d, 0xc5, 0xa8, 0xf0, 0x43, 0xb0, 0xca,
-	0x7c, 0x62, 0x56, 0x0b, 0x12, 0xfd, 0x81, 0xbe, 0xe0, 0xed, 0xe8, 0x72, 0x9f, 0x27, 0x3e, 0x31,
-	0x8d, 0x4d, 0xe5, 0x67, 0x55, 0xfc, 0x42, 0x12, 0x15, 0x5a, 0x60, 0x8d, 0x71, 0xcc, 0x07, 0xac,
-	0x5a, 0x94, 0xf8, 0xdf, 0x5d, 0x12, 0x5f, 0x62, 0x18, 0xd7, 0x94, 0x87, 0xb5, 0xf0, 0x37, 0x52,
-	0xd8, 0x8d, 0xbf, 0x69, 0xa0, 0x2c, 0xf5, 0x8e, 0x6d, 0xc6, 0xe1, 0x87, 0x53, 0x67, 0xa6, 0x9f,
-	0xef, 0xcc, 0x84, 0xb5, 0x3c, 0xb1, 0x6d, 0xe5, 0x67, 0x23, 0x5a, 0x49, 0x9d, 0xd7, 0x8f, 0x41,
-	0xc9, 0xe6, 0xc4, 0x65, 0xd5, 0xc2, 0x7e, 0xf1, 0x76, 0xe5, 0xee, 0x3b, 0xcb, 0x05, 0x64, 0x6c,
-	0x29, 0x17, 0xa5, 0xa7, 0x02, 0x0c, 0x85, 0x98, 0x8d, 0x3f, 0x15, 0x54, 0x20, 0xe2, 0x08, 0xe1,
-	0xdb, 0xa0, 0x62, 0xd9, 0xcc, 0x77, 0xf0, 0xe8, 0x07, 0xd8, 0x25, 0x32, 0x96, 0xb2, 0xf1, 0x86,
-	0x32, 0xac, 0x1c, 0x25, 0x22, 0x94, 0xd6, 0x83, 0x4d, 0x50, 0x76, 0x45, 0x84, 0xcf, 0x47, 0x3e,
-	0x91, 0xd7, 0x5a, 0x36, 0x76, 0x94, 0x51, 0xb9, 0x15, 0x09, 0x50, 0xa2, 0x03, 0x6f, 0x81, 0x92,
-	0xed, 0xe2, 0x1e, 0x91, 0x77, 0x54, 0x4e, 0x6d, 0x4d, 0x2c, 0xa2, 0x50, 0x06, 0x7f, 0x08, 0x6e,
-	0xb8, 0xb6, 0x27, 0xec, 0x9f, 0x7a, 0x9c, 0x04, 0x43, 0xec, 0x9c, 0x10, 0x93, 0x7a, 0x16, 0xab,
-	0xae, 0xee, 0x6b, 0xb7, 0x4b, 0x46, 0x4d, 0x59, 0xdd, 0x68, 0xcd, 0xd4, 0x42, 0x73, 0xac, 0xe1,
-	0xf7, 0xc1, 0x76, 0x87, 0x52, 0xce, 0x78, 0x80, 0xfd, 0x43, 0xd3, 0xa4, 0x03, 0x8f, 0x57, 0x4b,
-	0x72, 0x1f, 0xbb, 0x93, 0x71, 0x7d, 0xdb, 0xc8, 0xc9, 0xd0, 0x94, 0x76, 0xe3, 0x2f, 0x45, 0x50,
-	0x49, 0x65, 0x09, 0xfc, 0x15, 0xd8, 0x34, 0xa9, 0xd7, 0xb5, 0x7b, 0x2d, 0xec, 0x23, 0xd2, 0x55,
-	0x39, 0xf0, 0x68, 0xe1, 0x8b, 0x3a, 0xa6, 0x26, 0x76, 0xc2, 0x9a, 0x41, 0xa4, 0x4b, 0x02, 0xe2,
-	0x99, 0xc4, 0xd8, 0x9e, 0x8c, 0xeb, 0x9b, 0x0f, 0x53, 0xf0, 0x28, 0xe3, 0x0c, 0x52, 0xb0, 0x21,
-	0x0f, 0x56, 0x38, 0x2e, 0x7c, 0x9e, 0x8e, 0x37, 0x45, 0x3e, 0xb6, 0x14, 0x34, 0x8a, 0x9d, 0xc0,
-	0x0f, 0x00, 0xa4, 0x1d, 0x46, 0x82, 0x21, 0xb1, 0x9e, 0x84, 0x34, 0x62, 0x53, 0x4f, 0xde, 0x64,
-	0xd1, 0xd8, 0x53, 0x77, 0x02, 0x9f, 0x4d, 0x69, 0xa0, 0x19, 0x56, 0xd0, 0x03, 0x40, 0x5c, 0x8a,
-	0x2d, 0x7e, 0x88, 0x7b, 0x2d, 0x2e, 0xc7, 0x08, 0x11, 0x44, 0xc2, 0x3c, 0xf1, 0x12, 0x43, 0x29,
-	0x0f, 0x8d, 0xbf, 0x16, 0xc0, 0xd6, 0xc3, 0x3e, 0x0e, 0x7a, 0x04, 0x91, 0x9f, 0x0f, 0x08, 0xe3,
-	0x57, 0xc0, 0x77, 0x56, 0x86, 0xef, 0x8c, 0x65, 0xca, 0x37, 0xd9, 0xef, 0x5c, 0xde, 0x73, 0x72,
-	0xbc, 0x77, 0x74, 0x41, 0x3f, 0xaf, 0xe7, 0xbf, 0x7f, 0x68, 0x60, 0x27, 0xa3, 0x7f, 0x05, 0x3c,
-	0x68, 0x66, 0x79, 0xf0, 0xbd, 0x8b, 0x05, 0x38, 0x87, 0x0f, 0xcd, 0x5c, 0x5c, 0x92, 0x16, 0xf7,
-	0xc1, 0x6a, 0x37, 0xa0, 0xae, 0xe2, 0xc3, 0xf8, 0xf4, 0x1f, 0x07, 0xd4, 0x45, 0x52, 0x02, 0xbf,
-	0x05, 0x36, 0x7c, 0xcc, 0xd8, 0x2f, 0x68, 0x60, 0x29, 0x02, 0x8c, 0x23, 0x69, 0xab, 0x75, 0x14,
-	0x6b, 0x34, 0x7e, 0xa3, 0x81, 0x37, 0x66, 0x9c, 0x76, 0xae, 0x1a, 0xb4, 0x4b, 0xaf, 0x86, 0x3f,
-	0x14, 0x41, 0x39, 0x16, 0xc1, 0x3b, 0x60, 0x95, 0x0b, 0x02, 0x0f, 0xa3, 0xfc, 0x5a, 0x14, 0xa5,
-	0x20, 0xec, 0xb3, 0x71, 0x7d, 0x2b, 0x56, 0x94, 0x64, 0x2e, 0x55, 0xe1, 0x71, 0x9c, 0x74, 0x61,
-	0xd0, 0xf7, 0xb2, 0xe9, 0x72, 0x36, 0xae, 0xcf, 0x98, 0x8d, 0x92, 0x0d, 0x66, 0x93, 0x0a, 0x1e,
-	0x82, 0x0d, 0x46, 0x86, 0x24, 0xb0, 0xf9, 0x48, 0x35, 0x86, 0xaf, 0x47, 0x87, 0x78, 0xa2, 0xd6,
-	0xcf, 0xc6, 0xf5, 0x9d, 0xc4, 0x5c, 0x2d, 0xa2, 0xd8, 0x0c, 0x0e, 0x01, 0x74, 0x30, 0xe3, 0xcf,
-	0x03, 0xec, 0xb1, 0x70, 0xb3, 0xb6, 0x4b, 0x64, 0xbf, 0xa8, 0xdc, 0xfd, 0xe6, 0xf9, 0x72, 0x51,
-	0x58, 0x24, 0x3c, 0x76, 0x3c, 0x85, 0x86, 0x66, 0x78, 0x80, 0xdf, 0x00, 0x6b, 0x01, 0xc1, 0x8c,
-	0x7a, 0xaa, 0x93, 0xc4, 0x75, 0x83, 0xe4, 0x2a, 0x52, 0x52, 0xf8, 0x26, 0x58, 0x77, 0x09, 0x63,
-	0xa2, 0xf5, 0xad, 0x49, 0xc5, 0xeb, 0x4a, 0x71, 0xbd, 0x15, 0x2e, 0xa3, 0x48, 0xde, 0x38, 0x00,
-	0xbb, 0xb3, 0x68, 0x59, 0x24, 0xa3, 0x97, 0x34, 0xe7, 0x38, 0x19, 0x65, 0x57, 0x96, 0x12, 0x39,
-	0xcc, 0x49, 0xde, 0xfe, 0x02, 0x0c, 0x73, 0x72, 0x9f, 0x97, 0x38, 0xcc, 0x85, 0xf8, 0xaf, 0x27,
-	0x33, 0x0a, 0x36, 0xa5, 0xda, 0xa1, 0x65, 0x05, 0x84, 0x31, 0x78, 0x2f, 0x53, 0x08, 0xfb, 0xb9,
-	0x42, 0xd8, 0x4e, 0xeb, 0xa6, 0x6a, 0xe1, 0x4d, 0xb0, 0x8e, 0xc3, 0x45, 0x55, 0x0c, 0xf1, 0xd5,
-	0x2a, 0x5d, 0x14, 0xc9, 0xe5, 0xf4, 0x28, 0x51, 0xbe, 0x08, 0xd3, 0xa3, 0xdc, 0xe8, 0x1c, 0xb6,
-	0xfc, 0x73, 0x01, 0x84, 0x13, 0xc2, 0x09, 0xb9, 0x8a, 0x4e, 0xfa, 0x51, 0x26, 0xd9, 0xbe, 0xb7,
-	0x64, 0x32, 0x90, 0xf9, 0x4d, 0xb4, 0x97, 0xcb, 0xb7, 0xf7, 0x97, 0x77, 0xf1, 0xfa, 0x94, 0xfb,
-	0xbb, 0xa6, 0x72, 0xee, 0x84, 0x5c, 0x45, 0xeb, 0xfc, 0x69, 0x36, 0x09, 0xee, 0x2f, 0x1d, 0xd6,
-	0x9c, 0x3c, 0xf8, 0xfd, 0x6a, 0x12, 0x8e, 0xec, 0x98, 0xb7, 0xc1, 0x46, 0x40, 0x7c, 0xc7, 0x36,
-	0x31, 0x93, 0xe1, 0x94, 0xc2, 0x69, 0x12, 0xa9, 0x35, 0x14, 0x4b, 0x21, 0x16, 0xa4, 0xef, 0x10,
-	0x93, 0xd3, 0x40, 0xdd, 0xeb, 0x77, 0xce, 0x19, 0x38, 0xee, 0x10, 0xe7, 0x44, 0x99, 0x26, 0xd1,
-	0x47, 0x2b, 0x28, 0x86, 0x85, 0x3e, 0xd8, 0xe0, 0xc4, 0xf5, 0x1d, 0xcc, 0x89, 0xba, 0x57, 0x63,
-	0xb9, 0x03, 0x78, 0xae, 0x50, 0x64, 0xfe, 0xc4, 0x1e, 0xa3, 0x55, 0x14, 0x7b, 0xc9, 0xbf, 0xa3,
-	0x56, 0xcf, 0xf9, 0x8e, 0x3a, 0x00, 0x9b, 0x16, 0x71, 0x08, 0x27, 0x6d, 0xea, 0xd8, 0xe6, 0x28,
-	0x7a, 0x95, 0x28, 0xbb, 0xcd, 0xa3, 0x94, 0x0c, 0x65, 0x34, 0xe1, 0x21, 0xb8, 0xee, 0xda, 0x1e,
-	0x22, 0xd8, 0x1a, 0x45, 0x8f, 0xa4, 0x35, 0x79, 0xec, 0x37, 0x95, 0xf1, 0xf5, 0x56, 0x56, 0x8c,
-	0xf2, 0xfa, 0xf0, 0x05, 0xb8, 0xe9, 0x07, 0xb4, 0x27, 0x08, 0xea, 0x88, 0x60, 0xcb, 0xb1, 0x3d,
-	0x12, 0x41, 0xad, 0x4b, 0xa8, 0xaf, 0x4c, 0xc6, 0xf5, 0x9b, 0xed, 0xd9, 0x2a, 0x68, 0x9e, 0x6d,
-	0xe3, 0x93, 0x12, 0xb8, 0x96, 0x2d, 0x0a, 0x31, 0x2c, 0xe5, 0x92, 0x23, 0x3e, 0xcb, 0x19, 0x09,
-	0xd2, 0x06, 0xbb, 0xdd, 0x81, 0xe3, 0x8c, 0xe4, 0x7d, 0x13, 0x2b, 0xd2, 0x90, 0xc9, 0x52, 0x32,
-	0xbe, 0xaa, 0x2c, 0x77, 0x1f, 0xcf, 0xd0, 0x41, 0x33, 0x2d, 0xe1, 0xbb, 0x60, 0x2b, 0x10, 0x91,
-	0xc7, 0x50, 0x45, 0x09, 0xf5, 0x25, 0x05, 0xb5, 0x85, 0xd2, 0x42, 0x94, 0xd5, 0x85, 0x4f, 0xc0,
-	0x0e, 0x1e, 0x62, 0xdb, 0xc1, 0x1d, 0x87, 0xc4, 0x00, 0xe1, 0x83, 0xf4, 0xcb, 0x0a, 0x60, 0xe7,
-	0x30, 0xaf, 0x80, 0xa6, 0x6d, 0xe6, 0x3c, 0xa3, 0x4a, 0x4b, 0x3d, 0xa3, 0x18, 0xd8, 0xea, 0x62,
-	0xdb, 0x19, 0x04, 0x24, 0x9c, 0x37, 0xd4, 0x70, 0xd1, 0x12, 0xd1, 0x3c, 0x4e, 0x0b, 0xce, 0xc6,
-	0xf5, 0x83, 0xd7, 0x7f, 0x4e, 0x23, 0x41, 0x40, 0x03, 0x96, 0xe3, 0xb1, 0x47, 0x62, 0x11, 0x65,
-	0x7d, 0xc0, 0x07, 0xe0, 0x9a, 0x5a, 0x50, 0xb3, 0x8b, 0xcc, 0x93, 0xb2, 0x01, 0x27, 0xe3, 0xfa,
-	0xb5, 0xc7, 0x19, 0x09, 0xca, 0x69, 0xe6, 0x26, 0xdd, 0x8d, 0x4b, 0x9f, 0x74, 0xff, 0x53, 0x54,
-	0x1d, 0x57, 0xb2, 0xd3, 0xe9, 0x14, 0xd9, 0xbe, 0xbb, 0xb0, 0xef, 0x73, 0x37, 0xad, 0x1c, 0x17,
-	0x14, 0x96, 0xf9, 0xa6, 0x52, 0x3c, 0xc7, 0x37, 0x95, 0x26, 0x28, 0x9b, 0x7d, 0x6c, 0x7b, 0xd2,
-	0x4b, 0x29, 0x6b, 0xf0, 0x30, 0x12, 0xa0, 0x44, 0x07, 0x7e, 0x24, 0xca, 0x80, 0x71, 0x1c, 0x70,
-	0x45, 0x37, 0x61, 0xd2, 0xdc, 0x4f, 0xca, 0x20, 0x25, 0x3c, 0x1b, 0xd7, 0xf7, 0x67, 0x8c, 0xf2,
-	0x19, 0x1d, 0x94, 0xc5, 0x13, 0xc3, 0xb8, 0x4f, 0x2d, 0xc9, 0x5a, 0x6a, 0x4e, 0xa6, 0x03, 0x2e,
-	0x93, 0xe4, 0xdc, 0xdd, 0xed, 0x68, 0x10, 0x66, 0xb8, 0x71, 0x43, 0x54, 0x43, 0x7b, 0x0a, 0x0d,
-	0xcd, 0xf0, 0xd0, 0xf8, 0x67, 0x09, 0x54, 0x52, 0x73, 0x1f, 0x74, 0xc1, 0x9a, 0x4f, 0xad, 0xe4,
-	0xc3, 0xcc, 0xad, 0x94, 0x6f, 0x5d, 0x44, 0x92, 0x0c, 0x21, 0xc9, 0xd7, 0x8f, 0xb7, 0x44, 0xd7,
-	0x6e, 0x4b, 0xb3, 0x39, 0xcf, 0x98, 0x9c, 0x05, 0x52, 0x4e, 0xe0, 0x4f, 0x40, 0x45, 0xbc, 0x10,
-	0x5e, 0xf8, 0x16, 0xe6, 0xc4, 0x52, 0x4d, 0x6d, 0x91, 0xc7, 0xc7, 0x75, 0x91, 0x18, 0xc7, 0x09,
-	0x04, 0x4a, 0xe3, 0x41, 0x3f, 0x5f, 0xeb, 0x61, 0x72, 0x7c, 0x30, 0xab, 0xd6, 0xdf, 0x5e, 0xa0,
-	0xd6, 0x17, 0x29, 0xf4, 0xd5, 0x05, 0x0a, 0xbd, 0xac, 0xa6, 0x5e, 0xc2, 0xaa, 0x25, 0x59, 0xe7,
-	0x4b, 0xce, 0x6d, 0x6a, 0x8a, 0x4e, 0x92, 0xfa, 0x30, 0xc2, 0x45, 0x89, 0x0b, 0x78, 0x0b, 0x94,
-	0xfc, 0x3e, 0x66, 0xd1, 0xf3, 0x2a, 0x1e, 0x57, 0xda, 0x62, 0x11, 0x85, 0xb2, 0x39, 0xd4, 0xbb,
-	0xfe, 0x39, 0x7c, 0xc1, 0xba, 0x7c, 0x26, 0x1b, 0x6b, 0x60, 0x67, 0x6a, 0x18, 0xb9, 0x5a, 0x46,
-	0xbb, 0xd4, 0x37, 0x5f, 0xe3, 0xbf, 0x05, 0x90, 0x72, 0x0b, 0x29, 0x58, 0x73, 0x44, 0xff, 0x8e,
-	0xbe, 0x87, 0x3c, 0xb9, 0x40, 0x5c, 0xe1, 0xcc, 0xc8, 0x1e, 0x79, 0x3c, 0x18, 0x25, 0xa3, 0x79,
-	0xb8, 0x88, 0x94, 0x1b, 0xf8, 0x89, 0x06, 0x2a, 0xd8, 0xf3, 0x28, 0xc7, 0xe1, 0x95, 0x86, 0x23,
-	0xf3, 0xf1, 0x45, 0xdc, 0x1e, 0x26, 0x70, 0xa1, 0xef, 0x98, 0xfe, 0x53, 0x12, 0x94, 0xf6, 0xba,
-	0x77, 0x1f, 0x54, 0x52, 0x9b, 0x85, 0xdb, 0xa0, 0x78, 0x4a, 0x46, 0xe1, 0x8b, 0x14, 0x89, 0x3f,
-	0xe1, 0x2e, 0x28, 0x0d, 0xb1, 0x33, 0x50, 0x0d, 0x05, 0x85, 0x3f, 0x1e, 0x14, 0x0e, 0xb4, 0xbd,
-	0xf7, 0xc0, 0x76, 0xde, 0xe1, 0x22, 0xf6, 0x8d, 0xdf, 0x6a, 0x60, 0xbd, 0x4d, 0xad, 0xa7, 0x5e,
-	0x97, 0x8a, 0xb9, 0x92, 0xfa, 0x32, 0xd5, 0xbd, 0xde, 0xc9, 0x88, 0x71, 0xe2, 0x56, 0xcb, 0xb2,
-	0xb0, 0xe2, 0xb9, 0xf2, 0x59, 0x56, 0x8c, 0xf2, 0xfa, 0x62, 0xa8, 0xc5, 0x81, 0xd9, 0xb7, 0x39,
-	0x31, 0xf9, 0x20, 0x20, 0x55, 0x90, 0x1d, 0x6a, 0x0f, 0x53, 0x32, 0x94, 0xd1, 0x34, 0x5e, 0xbc,
-	0x7c, 0x55, 0x5b, 0xf9, 0xf4, 0x55, 0x6d, 0xe5, 0xb3, 0x57, 0xb5, 0x95, 0x5f, 0x4f, 0x6a, 0xda,
-	0xcb, 0x49, 0x4d, 0xfb, 0x74, 0x52, 0xd3, 0x3e, 0x9b, 0xd4, 0xb4, 0x7f, 0x4d, 0x6a, 0xda, 0xef,
-	0xfe, 0x5d, 0x5b, 0xf9, 0x51, 0x73, 0xc1, 0x7f, 0x0f, 0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xbc,
-	0x09, 0x8c, 0x8b, 0x50, 0x1c, 0x00, 0x00,
+func (m *MinerSetRevision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Chain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MinerSetRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *Chain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MinerSetRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerSetRevision.Merge(m, src)
+}
+func (m *MinerSetRevision) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinerSetRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerSetRevision.DiscardUnknown(m)
 }
 
-func (m *Chain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+var xxx_messageInfo_MinerSetRevision proto.InternalMessageInfo
+
+func (m *MinerSetRollback) Reset()      { *m = MinerSetRollback{} }
+func (*MinerSetRollback) ProtoMessage() {}
+func (*MinerSetRollback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{29}
+}
+func (m *MinerSetRollback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinerSetRollback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return b[:n], nil
+}
+func (m *MinerSetRollback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerSetRollback.Merge(m, src)
+}
+func (m *MinerSetRollback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinerSetRollback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerSetRollback.DiscardUnknown(m)
 }
 
-func (m *ChainList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MinerSetRollback proto.InternalMessageInfo
+
+func (m *MinerSetScalingRules) Reset()      { *m = MinerSetScalingRules{} }
+func (*MinerSetScalingRules) ProtoMessage() {}
+func (*MinerSetScalingRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{30}
+}
+func (m *MinerSetScalingRules) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinerSetScalingRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *ChainList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MinerSetScalingRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerSetScalingRules.Merge(m, src)
+}
+func (m *MinerSetScalingRules) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinerSetScalingRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerSetScalingRules.DiscardUnknown(m)
 }
 
-func (m *ChainList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+var xxx_messageInfo_MinerSetScalingRules proto.InternalMessageInfo
+
+func (m *MinerSetSpec) Reset()      { *m = MinerSetSpec{} }
+func (*MinerSetSpec) ProtoMessage() {}
+func (*MinerSetSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{31}
+}
+func (m *MinerSetSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinerSetSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return b[:n], nil
+}
+func (m *MinerSetSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerSetSpec.Merge(m, src)
+}
+func (m *MinerSetSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinerSetSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerSetSpec.DiscardUnknown(m)
 }
 
-func (m *ChainSpec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MinerSetSpec proto.InternalMessageInfo
+
+func (m *MinerSetStatus) Reset()      { *m = MinerSetStatus{} }
+func (*MinerSetStatus) ProtoMessage() {}
+func (*MinerSetStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{32}
+}
+func (m *MinerSetStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinerSetStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *ChainSpec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MinerSetStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerSetStatus.Merge(m, src)
+}
+func (m *MinerSetStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinerSetStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerSetStatus.DiscardUnknown(m)
 }
 
-func (m *ChainSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BootstrapAccount != nil {
-		i -= len(*m.BootstrapAccount)
-		copy(dAtA[i:], *m.BootstrapAccount)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.BootstrapAccount)))
-		i--
-		dAtA[i] = 0x2a
+var xxx_messageInfo_MinerSetStatus proto.InternalMessageInfo
+
+func (m *MinerSetStrategy) Reset()      { *m = MinerSetStrategy{} }
+func (*MinerSetStrategy) ProtoMessage() {}
+func (*MinerSetStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{33}
+}
+func (m *MinerSetStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinerSetStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	i = encodeVarintGenerated(dAtA, i, uint64(m.MinMineIntervalSeconds))
-	i--
-	dAtA[i] = 0x20
-	i -= len(m.Image)
-	copy(dAtA[i:], m.Image)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Image)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.MinerType)
-	copy(dAtA[i:], m.MinerType)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MinerType)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.DisplayName)
-	copy(dAtA[i:], m.DisplayName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return b[:n], nil
+}
+func (m *MinerSetStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerSetStrategy.Merge(m, src)
+}
+func (m *MinerSetStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinerSetStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerSetStrategy.DiscardUnknown(m)
 }
 
-func (m *ChainStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MinerSetStrategy proto.InternalMessageInfo
+
+func (m *MinerSpec) Reset()      { *m = MinerSpec{} }
+func (*MinerSpec) ProtoMessage() {}
+func (*MinerSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{34}
+}
+func (m *MinerSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinerSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *ChainStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MinerSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerSpec.Merge(m, src)
+}
+func (m *MinerSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinerSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerSpec.DiscardUnknown(m)
 }
 
-func (m *ChainStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Conditions) > 0 {
-		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+var xxx_messageInfo_MinerSpec proto.InternalMessageInfo
+
+func (m *MinerStatus) Reset()      { *m = MinerStatus{} }
+func (*MinerStatus) ProtoMessage() {}
+func (*MinerStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{35}
+}
+func (m *MinerStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
-	i--
-	dAtA[i] = 0x18
-	if m.MinerRef != nil {
-		{
-			size, err := m.MinerRef.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return b[:n], nil
+}
+func (m *MinerStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerStatus.Merge(m, src)
+}
+func (m *MinerStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinerStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinerStatus proto.InternalMessageInfo
+
+func (m *MinerTemplateSpec) Reset()      { *m = MinerTemplateSpec{} }
+func (*MinerTemplateSpec) ProtoMessage() {}
+func (*MinerTemplateSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{36}
+}
+func (m *MinerTemplateSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinerTemplateSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	if m.ConfigMapRef != nil {
-		{
-			size, err := m.ConfigMapRef.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return b[:n], nil
+}
+func (m *MinerTemplateSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerTemplateSpec.Merge(m, src)
+}
+func (m *MinerTemplateSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinerTemplateSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerTemplateSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinerTemplateSpec proto.InternalMessageInfo
+
+func (m *ObjectMeta) Reset()      { *m = ObjectMeta{} }
+func (*ObjectMeta) ProtoMessage() {}
+func (*ObjectMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{37}
+}
+func (m *ObjectMeta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return b[:n], nil
+}
+func (m *ObjectMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectMeta.Merge(m, src)
+}
+func (m *ObjectMeta) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectMeta.DiscardUnknown(m)
 }
 
-func (m *ChargeRequest) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_ObjectMeta proto.InternalMessageInfo
+
+func (m *PendingChargeRequestsMetricSource) Reset()      { *m = PendingChargeRequestsMetricSource{} }
+func (*PendingChargeRequestsMetricSource) ProtoMessage() {}
+func (*PendingChargeRequestsMetricSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{38}
+}
+func (m *PendingChargeRequestsMetricSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingChargeRequestsMetricSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PendingChargeRequestsMetricSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChargeRequestsMetricSource.Merge(m, src)
+}
+func (m *PendingChargeRequestsMetricSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingChargeRequestsMetricSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChargeRequestsMetricSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChargeRequestsMetricSource proto.InternalMessageInfo
+
+func (m *PodCPUMetricSource) Reset()      { *m = PodCPUMetricSource{} }
+func (*PodCPUMetricSource) ProtoMessage() {}
+func (*PodCPUMetricSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{39}
+}
+func (m *PodCPUMetricSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PodCPUMetricSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PodCPUMetricSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PodCPUMetricSource.Merge(m, src)
+}
+func (m *PodCPUMetricSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *PodCPUMetricSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_PodCPUMetricSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PodCPUMetricSource proto.InternalMessageInfo
+
+func (m *PodInfo) Reset()      { *m = PodInfo{} }
+func (*PodInfo) ProtoMessage() {}
+func (*PodInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{40}
+}
+func (m *PodInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PodInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PodInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PodInfo.Merge(m, src)
+}
+func (m *PodInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *PodInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PodInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PodInfo proto.InternalMessageInfo
+
+func (m *RollingUpdateMinerSet) Reset()      { *m = RollingUpdateMinerSet{} }
+func (*RollingUpdateMinerSet) ProtoMessage() {}
+func (*RollingUpdateMinerSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{41}
+}
+func (m *RollingUpdateMinerSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollingUpdateMinerSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RollingUpdateMinerSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollingUpdateMinerSet.Merge(m, src)
+}
+func (m *RollingUpdateMinerSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollingUpdateMinerSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollingUpdateMinerSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollingUpdateMinerSet proto.InternalMessageInfo
+
+func (m *ScheduleMetricSource) Reset()      { *m = ScheduleMetricSource{} }
+func (*ScheduleMetricSource) ProtoMessage() {}
+func (*ScheduleMetricSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced0953b0a13158a, []int{42}
+}
+func (m *ScheduleMetricSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleMetricSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ScheduleMetricSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleMetricSource.Merge(m, src)
+}
+func (m *ScheduleMetricSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleMetricSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleMetricSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleMetricSource proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Chain)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.Chain")
+	proto.RegisterType((*ChainBlockIntervalMetricSource)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ChainBlockIntervalMetricSource")
+	proto.RegisterType((*ChainList)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ChainList")
+	proto.RegisterType((*ChainSpec)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ChainSpec")
+	proto.RegisterType((*ChainStatus)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ChainStatus")
+	proto.RegisterType((*ChargeRequest)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ChargeRequest")
+	proto.RegisterType((*ChargeRequestList)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ChargeRequestList")
+	proto.RegisterType((*ChargeRequestSpec)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ChargeRequestSpec")
+	proto.RegisterType((*ChargeRequestStatus)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ChargeRequestStatus")
+	proto.RegisterType((*Condition)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.Condition")
+	proto.RegisterType((*LocalObjectReference)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.LocalObjectReference")
+	proto.RegisterType((*Miner)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.Miner")
+	proto.RegisterType((*MinerAddress)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerAddress")
+	proto.RegisterType((*MinerClass)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerClass")
+	proto.RegisterType((*MinerClassList)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerClassList")
+	proto.RegisterType((*MinerClassPricing)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerClassPricing")
+	proto.RegisterType((*MinerClassSpec)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerClassSpec")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerClassSpec.NodeSelectorEntry")
+	proto.RegisterType((*MinerList)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerList")
+	proto.RegisterType((*MinerPodOverrides)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerPodOverrides")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerPodOverrides.NodeSelectorEntry")
+	proto.RegisterType((*MinerSet)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSet")
+	proto.RegisterType((*MinerSetAutoscaler)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSetAutoscaler")
+	proto.RegisterType((*MinerSetAutoscalerBehavior)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSetAutoscalerBehavior")
+	proto.RegisterType((*MinerSetAutoscalerList)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSetAutoscalerList")
+	proto.RegisterType((*MinerSetAutoscalerSpec)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSetAutoscalerSpec")
+	proto.RegisterType((*MinerSetAutoscalerStatus)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSetAutoscalerStatus")
+	proto.RegisterType((*MinerSetList)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSetList")
+	proto.RegisterType((*MinerSetMetricSpec)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSetMetricSpec")
+	proto.RegisterType((*MinerSetMetricStatus)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSetMetricStatus")
+	proto.RegisterType((*MinerSetRevision)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSetRevision")
+	proto.RegisterType((*MinerSetRollback)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSetRollback")
+	proto.RegisterType((*MinerSetScalingRules)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSetScalingRules")
+	proto.RegisterType((*MinerSetSpec)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSetSpec")
+	proto.RegisterType((*MinerSetStatus)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSetStatus")
+	proto.RegisterType((*MinerSetStrategy)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSetStrategy")
+	proto.RegisterType((*MinerSpec)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerSpec")
+	proto.RegisterType((*MinerStatus)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerStatus")
+	proto.RegisterType((*MinerTemplateSpec)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.MinerTemplateSpec")
+	proto.RegisterType((*ObjectMeta)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ObjectMeta")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ObjectMeta.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ObjectMeta.LabelsEntry")
+	proto.RegisterType((*PendingChargeRequestsMetricSource)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.PendingChargeRequestsMetricSource")
+	proto.RegisterType((*PodCPUMetricSource)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.PodCPUMetricSource")
+	proto.RegisterType((*PodInfo)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.PodInfo")
+	proto.RegisterType((*RollingUpdateMinerSet)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.RollingUpdateMinerSet")
+	proto.RegisterType((*ScheduleMetricSource)(nil), "github.com.superproj.onex.pkg.apis.apps.v1beta1.ScheduleMetricSource")
+}
+
+func init() {
+	proto.RegisterFile("github.com/superproj/onex/pkg/apis/apps/v1beta1/generated.proto", fileDescriptor_ced0953b0a13158a)
+}
+
+var fileDescriptor_ced0953b0a13158a = []byte{
+	// 3431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0xdb, 0x6f, 0x24, 0x47,
+	0xd5, 0xdf, 0x9e, 0xf1, 0xf8, 0x52, 0x63, 0x7b, 0xed, 0x8a, 0x77, 0x33, 0xeb, 0x2f, 0x9f, 0xbd,
+	0x99, 0x00, 0xda, 0xa0, 0x65, 0x26, 0xbb, 0xb9, 0xb0, 0x9b, 0xdb, 0xc6, 0x63, 0xef, 0x2d, 0xd8,
+	0x59, 0xa7, 0xc6, 0x0e, 0x4a, 0xc8, 0xad, 0xdc, 0x5d, 0x1e, 0x77, 0xdc, 0xd3, 0xdd, 0xa9, 0xaa,
+	0x99, 0xec, 0xc0, 0x03, 0x48, 0x08, 0x5e, 0x10, 0x88, 0xb7, 0x20, 0xc4, 0x1b, 0xcf, 0xbc, 0xf0,
+	0x00, 0x7f, 0x01, 0x28, 0x48, 0x44, 0x0a, 0x12, 0x42, 0x11, 0x0f, 0x56, 0xd6, 0x20, 0x9e, 0x10,
+	0x12, 0xbc, 0xb1, 0x0f, 0x11, 0xaa, 0xea, 0xea, 0x4b, 0xf5, 0xf4, 0x78, 0x3d, 0x33, 0xb6, 0xa5,
+	0xbc, 0x4d, 0xd7, 0x39, 0xe7, 0x77, 0xea, 0x76, 0x2e, 0x75, 0xaa, 0x06, 0x5c, 0x6b, 0xd8, 0x7c,
+	0xa7, 0xb5, 0x55, 0x31, 0xbd, 0x66, 0x95, 0xb5, 0x7c, 0x42, 0x7d, 0xea, 0xbd, 0x57, 0xf5, 0x5c,
+	0x72, 0xb7, 0xea, 0xef, 0x36, 0xaa, 0xd8, 0xb7, 0x59, 0x15, 0xfb, 0x3e, 0xab, 0xb6, 0x2f, 0x6d,
+	0x11, 0x8e, 0x2f, 0x55, 0x1b, 0xc4, 0x25, 0x14, 0x73, 0x62, 0x55, 0x7c, 0xea, 0x71, 0x0f, 0x56,
+	0x63, 0x80, 0x4a, 0x04, 0x50, 0x11, 0x00, 0x15, 0x7f, 0xb7, 0x51, 0x11, 0x00, 0x15, 0x01, 0x50,
+	0x51, 0x00, 0xf3, 0x5f, 0x4b, 0x68, 0x6c, 0x78, 0x0d, 0xaf, 0x2a, 0x71, 0xb6, 0x5a, 0xdb, 0xf2,
+	0x4b, 0x7e, 0xc8, 0x5f, 0x01, 0xfe, 0x7c, 0x79, 0xf7, 0x0a, 0xab, 0xd8, 0x9e, 0xe8, 0x49, 0xd5,
+	0xf4, 0x28, 0xa9, 0xb6, 0xbb, 0xfa, 0x30, 0xff, 0x54, 0xcc, 0xd3, 0xc4, 0xe6, 0x8e, 0xed, 0x12,
+	0xda, 0x09, 0xbb, 0x5f, 0xa5, 0x84, 0x79, 0x2d, 0x6a, 0x92, 0xbe, 0xa4, 0x58, 0xb5, 0x49, 0x38,
+	0xce, 0xd2, 0x55, 0xed, 0x25, 0x45, 0x5b, 0x2e, 0xb7, 0x9b, 0xdd, 0x6a, 0x9e, 0x79, 0x90, 0x00,
+	0x33, 0x77, 0x48, 0x13, 0x77, 0xc9, 0x3d, 0xd9, 0x4b, 0xae, 0xc5, 0x6d, 0xa7, 0x6a, 0xbb, 0x9c,
+	0x71, 0x9a, 0x16, 0x2a, 0xff, 0x2a, 0x07, 0x0a, 0xcb, 0x3b, 0xd8, 0x76, 0xe1, 0xbb, 0x60, 0x5c,
+	0x0c, 0xc1, 0xc2, 0x1c, 0x97, 0x8c, 0xf3, 0xc6, 0x85, 0xe2, 0xe5, 0x27, 0x2a, 0x01, 0x62, 0x25,
+	0x89, 0x18, 0x2f, 0x92, 0xe0, 0xae, 0xb4, 0x2f, 0x55, 0xee, 0x6c, 0xbd, 0x47, 0x4c, 0xbe, 0x46,
+	0x38, 0xae, 0xc1, 0x8f, 0xf6, 0x16, 0x4f, 0xed, 0xef, 0x2d, 0x82, 0xb8, 0x0d, 0x45, 0xa8, 0xf0,
+	0x4d, 0x30, 0xc2, 0x7c, 0x62, 0x96, 0x72, 0x12, 0xfd, 0xd9, 0x4a, 0x9f, 0x1b, 0xa1, 0x22, 0xfb,
+	0x59, 0xf7, 0x89, 0x59, 0x9b, 0x54, 0x7a, 0x46, 0xc4, 0x17, 0x92, 0xa8, 0xd0, 0x02, 0xa3, 0x8c,
+	0x63, 0xde, 0x62, 0xa5, 0xbc, 0xc4, 0x7f, 0x7e, 0x40, 0x7c, 0x89, 0x51, 0x9b, 0x56, 0x1a, 0x46,
+	0x83, 0x6f, 0xa4, 0xb0, 0xcb, 0x6f, 0x81, 0x05, 0xc9, 0x56, 0x73, 0x3c, 0x73, 0xf7, 0xb6, 0xcb,
+	0x09, 0x6d, 0x63, 0x67, 0x8d, 0x70, 0x6a, 0x9b, 0x75, 0xb9, 0x6b, 0xe0, 0x73, 0x60, 0x8a, 0x63,
+	0xda, 0x20, 0xbc, 0x4e, 0x4c, 0xcf, 0xb5, 0x98, 0x9c, 0xcc, 0x42, 0xed, 0x8c, 0x02, 0x9c, 0xda,
+	0x48, 0x12, 0x91, 0xce, 0x5b, 0xfe, 0xbd, 0x01, 0x26, 0x24, 0xfe, 0xaa, 0xcd, 0x38, 0x7c, 0xb3,
+	0x6b, 0x49, 0x2a, 0x87, 0x5b, 0x12, 0x21, 0x2d, 0x17, 0x64, 0x46, 0x69, 0x1d, 0x0f, 0x5b, 0x12,
+	0xcb, 0xf1, 0x2d, 0x50, 0xb0, 0x39, 0x69, 0xb2, 0x52, 0xee, 0x7c, 0xfe, 0x42, 0xf1, 0xf2, 0x33,
+	0x83, 0xcd, 0x57, 0x6d, 0x4a, 0xa9, 0x28, 0xdc, 0x16, 0x60, 0x28, 0xc0, 0x2c, 0xff, 0x3a, 0xa7,
+	0x06, 0x22, 0x56, 0x08, 0x3e, 0x0d, 0x8a, 0x96, 0xcd, 0x7c, 0x07, 0x77, 0x5e, 0xc1, 0x4d, 0x22,
+	0xc7, 0x32, 0x51, 0x7b, 0x48, 0x09, 0x16, 0x57, 0x62, 0x12, 0x4a, 0xf2, 0xc1, 0x2a, 0x98, 0x68,
+	0x8a, 0x11, 0x6e, 0x74, 0x7c, 0x22, 0x77, 0xcd, 0x44, 0x6d, 0x56, 0x09, 0x4d, 0xac, 0x85, 0x04,
+	0x14, 0xf3, 0xc0, 0xc7, 0x40, 0xc1, 0x6e, 0xe2, 0x06, 0x91, 0x5b, 0x60, 0x22, 0xd1, 0x35, 0xd1,
+	0x88, 0x02, 0x1a, 0x7c, 0x0d, 0x9c, 0x6d, 0xda, 0xae, 0x90, 0x0f, 0xd7, 0x2f, 0x5c, 0xa9, 0x11,
+	0xb9, 0x52, 0x0b, 0x4a, 0xea, 0xec, 0x5a, 0x26, 0x17, 0xea, 0x21, 0x0d, 0x5f, 0x02, 0x33, 0x5b,
+	0x9e, 0x27, 0xac, 0x0c, 0xfb, 0x4b, 0xa6, 0xe9, 0xb5, 0x5c, 0x5e, 0x2a, 0xc8, 0x7e, 0xcc, 0xed,
+	0xef, 0x2d, 0xce, 0xd4, 0x52, 0x34, 0xd4, 0xc5, 0x5d, 0xfe, 0x6d, 0x1e, 0x14, 0x13, 0x9b, 0x10,
+	0x7e, 0x07, 0x4c, 0x9a, 0x9e, 0xbb, 0x6d, 0x37, 0xd6, 0xb0, 0x8f, 0xc8, 0xb6, 0xda, 0x03, 0xd7,
+	0xfb, 0x5e, 0xa8, 0x55, 0xcf, 0xc4, 0x4e, 0x60, 0x92, 0x88, 0x6c, 0x13, 0x4a, 0x5c, 0x93, 0xd4,
+	0x66, 0xf6, 0xf7, 0x16, 0x27, 0x97, 0x13, 0xf0, 0x48, 0x53, 0x06, 0x3d, 0x30, 0x2e, 0x27, 0x56,
+	0x28, 0xce, 0x1d, 0xa5, 0xe2, 0x49, 0xb1, 0x1f, 0xd7, 0x14, 0x34, 0x8a, 0x94, 0xc0, 0x97, 0x01,
+	0xf4, 0xb6, 0x18, 0xa1, 0x6d, 0x62, 0xdd, 0x0c, 0xbc, 0x94, 0xed, 0xb9, 0x72, 0x25, 0xf3, 0xb5,
+	0x79, 0xb5, 0x26, 0xf0, 0x4e, 0x17, 0x07, 0xca, 0x90, 0x82, 0x2e, 0x00, 0x62, 0x51, 0x6c, 0xf1,
+	0x21, 0xd6, 0x35, 0x3f, 0x98, 0xc3, 0x09, 0x21, 0x62, 0xc7, 0x16, 0x35, 0x31, 0x94, 0xd0, 0x50,
+	0xfe, 0x5d, 0x0e, 0x4c, 0x2d, 0xef, 0x08, 0x53, 0x46, 0xe4, 0xfd, 0x16, 0x61, 0xfc, 0x04, 0xdc,
+	0xa9, 0xa5, 0xb9, 0xd3, 0xda, 0x20, 0xe6, 0x1b, 0xf7, 0xb7, 0xa7, 0x5b, 0x75, 0x52, 0x6e, 0x75,
+	0x65, 0x48, 0x3d, 0x07, 0xbb, 0xd7, 0xbf, 0x18, 0x60, 0x56, 0xe3, 0x3f, 0x01, 0x3f, 0x68, 0xea,
+	0x7e, 0xf0, 0xc5, 0xe1, 0x06, 0xd8, 0xc3, 0x1f, 0x7e, 0x98, 0x4b, 0x0d, 0x4c, 0xfa, 0xc5, 0xf3,
+	0x60, 0x64, 0x9b, 0x7a, 0x4d, 0xe5, 0x10, 0xa3, 0xe9, 0xbf, 0x41, 0xbd, 0x26, 0x92, 0x14, 0x78,
+	0x11, 0x8c, 0xfb, 0x98, 0xb1, 0x0f, 0x3c, 0x6a, 0x29, 0x0f, 0x18, 0x0d, 0x65, 0x5d, 0xb5, 0xa3,
+	0x88, 0x43, 0xe0, 0x31, 0xec, 0xf0, 0xd2, 0xa8, 0x8e, 0x57, 0xc7, 0x0e, 0x47, 0x92, 0x22, 0x5c,
+	0xaa, 0x29, 0x3c, 0x8c, 0xf4, 0xc3, 0x79, 0xdd, 0xa5, 0x2e, 0x87, 0x04, 0x14, 0xf3, 0xc0, 0xaf,
+	0x80, 0x51, 0xdc, 0x94, 0xbe, 0x6c, 0x44, 0x5a, 0x62, 0xb4, 0x72, 0x4b, 0xb2, 0x15, 0x29, 0x2a,
+	0xfc, 0x3a, 0x98, 0x92, 0xee, 0x83, 0x36, 0x71, 0x60, 0x74, 0x05, 0xe9, 0x4c, 0x67, 0x45, 0xc8,
+	0x5b, 0x4e, 0x12, 0x90, 0xce, 0x57, 0xfe, 0x6f, 0x0e, 0x3c, 0x94, 0xb1, 0x45, 0x52, 0x26, 0x6c,
+	0x1c, 0xb7, 0x09, 0xcb, 0xb8, 0x4d, 0xb1, 0xcb, 0xb0, 0x29, 0xbe, 0x6f, 0xaf, 0xa8, 0xe9, 0x8e,
+	0xe3, 0x76, 0x92, 0x88, 0x74, 0x5e, 0xb8, 0x04, 0x4e, 0xb3, 0xd6, 0x56, 0xd3, 0xe6, 0x9c, 0x58,
+	0xb7, 0x88, 0xdd, 0xd8, 0xe1, 0xca, 0x71, 0x3d, 0xac, 0xc4, 0x4f, 0xd7, 0x75, 0x32, 0x4a, 0xf3,
+	0x8b, 0x18, 0xb9, 0x25, 0x92, 0x0a, 0x25, 0x1e, 0xcc, 0x76, 0x14, 0x23, 0x6b, 0x31, 0x09, 0x25,
+	0xf9, 0x44, 0xb7, 0xb3, 0xe6, 0x3d, 0xea, 0xf6, 0x81, 0x73, 0xff, 0xf3, 0x3c, 0x98, 0x88, 0xa6,
+	0x03, 0x5e, 0x02, 0x23, 0x5c, 0x44, 0xda, 0x60, 0x37, 0xfe, 0x7f, 0xb8, 0x7b, 0x44, 0x64, 0xbd,
+	0x1f, 0x20, 0x05, 0x8c, 0xa2, 0x01, 0x49, 0x56, 0xb8, 0x1a, 0x79, 0x87, 0x60, 0xb6, 0x9e, 0xd2,
+	0xed, 0xfa, 0xfe, 0xde, 0x62, 0x46, 0x3a, 0x1e, 0x2f, 0x8a, 0x6e, 0xfd, 0x70, 0x09, 0x8c, 0x33,
+	0xd2, 0x26, 0xd4, 0xe6, 0x1d, 0xb5, 0x37, 0xbf, 0x1c, 0x6e, 0xf6, 0xba, 0x6a, 0xbf, 0xbf, 0xb7,
+	0x38, 0x1b, 0x8b, 0xab, 0x46, 0x14, 0x89, 0xc1, 0x36, 0x80, 0x0e, 0x66, 0x5c, 0x2e, 0x56, 0xd0,
+	0x59, 0xbb, 0x49, 0xe4, 0x64, 0x16, 0x2f, 0x7f, 0xf5, 0x70, 0x4e, 0x43, 0x48, 0xc4, 0x01, 0x67,
+	0xb5, 0x0b, 0x0d, 0x65, 0x68, 0x10, 0x66, 0x42, 0x09, 0x66, 0x9e, 0xab, 0x42, 0x7e, 0x64, 0x26,
+	0x48, 0xb6, 0x22, 0x45, 0x85, 0x8f, 0x83, 0xb1, 0x26, 0x61, 0x4c, 0xe4, 0x28, 0x81, 0x91, 0x9e,
+	0x56, 0x8c, 0x63, 0x6b, 0x41, 0x33, 0x0a, 0xe9, 0xe5, 0x2b, 0x60, 0x2e, 0x2b, 0x7e, 0x0a, 0x23,
+	0x77, 0xe3, 0x2c, 0x2a, 0x32, 0x72, 0x69, 0xb8, 0x92, 0x22, 0x93, 0x7a, 0x19, 0x60, 0xbf, 0x00,
+	0x49, 0xbd, 0xec, 0xe7, 0x31, 0x26, 0xf5, 0x01, 0xfe, 0xc1, 0x51, 0xc7, 0x03, 0x93, 0x92, 0x6d,
+	0xc9, 0xb2, 0x28, 0x61, 0x0c, 0x3e, 0xa5, 0x19, 0xc2, 0xf9, 0x94, 0x21, 0xcc, 0x24, 0x79, 0x13,
+	0xb6, 0xf0, 0x38, 0x18, 0xc3, 0x41, 0x63, 0x29, 0xa7, 0x2f, 0xad, 0xe2, 0x45, 0x21, 0xbd, 0xfc,
+	0x27, 0x03, 0x00, 0x89, 0xb2, 0xec, 0x60, 0xc6, 0x4e, 0x60, 0x95, 0xb0, 0xb6, 0x4a, 0xd7, 0x06,
+	0x9b, 0x45, 0xd9, 0xd9, 0x5e, 0x4b, 0x55, 0xfe, 0xc4, 0x00, 0xd3, 0x31, 0xdb, 0x09, 0xc4, 0xed,
+	0x77, 0xf5, 0xb8, 0xfd, 0xdc, 0x10, 0x83, 0xea, 0x11, 0xb4, 0x5f, 0x06, 0xb3, 0x31, 0xcf, 0x3a,
+	0xb5, 0x4d, 0xdb, 0x6d, 0x08, 0x3f, 0xbd, 0xe3, 0xb5, 0xa8, 0xd3, 0x11, 0x0d, 0xc1, 0x1e, 0x49,
+	0xf8, 0xe9, 0x5b, 0x31, 0x09, 0x25, 0xf9, 0xca, 0xff, 0x28, 0x24, 0xa7, 0x67, 0x98, 0x53, 0xd1,
+	0xeb, 0x60, 0x22, 0x2c, 0x51, 0x30, 0xb5, 0xa0, 0x17, 0x12, 0xd3, 0x5a, 0x11, 0x5e, 0x56, 0x4c,
+	0x22, 0x52, 0x4c, 0x22, 0xac, 0xda, 0x94, 0x34, 0x89, 0xcb, 0x59, 0x1c, 0xec, 0x43, 0x2a, 0x43,
+	0x31, 0x1a, 0x5c, 0x01, 0x33, 0x4d, 0xdb, 0xb5, 0xdd, 0xc6, 0x8a, 0xbd, 0xbd, 0x6d, 0x9b, 0x2d,
+	0x47, 0x39, 0xe2, 0x42, 0xad, 0xa4, 0xe4, 0x66, 0xd6, 0x52, 0x74, 0xd4, 0x25, 0x01, 0x7f, 0x64,
+	0x80, 0x49, 0xd7, 0xb3, 0x48, 0x9d, 0x38, 0xc4, 0xe4, 0x1e, 0x55, 0xf9, 0xf7, 0xab, 0x43, 0xee,
+	0xba, 0xca, 0x2b, 0x09, 0xcc, 0xeb, 0x2e, 0xa7, 0x9d, 0xda, 0x9c, 0xea, 0xd5, 0x64, 0x92, 0x84,
+	0x34, 0xe5, 0x70, 0x13, 0x14, 0xb9, 0xe7, 0x10, 0x1a, 0x85, 0x47, 0xd1, 0x97, 0x85, 0xac, 0x09,
+	0xdb, 0x88, 0xd8, 0xe2, 0x55, 0x88, 0xdb, 0x18, 0x4a, 0xe2, 0xc0, 0x1b, 0x60, 0x1c, 0x6f, 0x6f,
+	0xdb, 0xae, 0x88, 0x55, 0xa3, 0x72, 0x11, 0x1e, 0xc9, 0xc2, 0x5c, 0x52, 0x3c, 0xc1, 0xa9, 0x27,
+	0xfc, 0x42, 0x91, 0x6c, 0x7c, 0x64, 0x1d, 0x3b, 0xe0, 0xc8, 0x6a, 0x83, 0x31, 0x3f, 0xd8, 0x7e,
+	0xa5, 0xf1, 0x01, 0xb3, 0xfd, 0xae, 0x8d, 0x5c, 0x2b, 0x0a, 0xd7, 0xa4, 0x3e, 0x50, 0x88, 0x3f,
+	0x7f, 0x0d, 0xcc, 0x76, 0xcd, 0x33, 0x9c, 0x01, 0xf9, 0x5d, 0xd2, 0x09, 0x76, 0x28, 0x12, 0x3f,
+	0xe1, 0x1c, 0x28, 0xb4, 0xb1, 0xd3, 0x52, 0xc7, 0x72, 0x14, 0x7c, 0x3c, 0x9b, 0xbb, 0x62, 0xc8,
+	0x12, 0x86, 0x54, 0xf6, 0x45, 0x28, 0x61, 0xc8, 0x8e, 0xf6, 0xb0, 0xfe, 0x9f, 0x15, 0x94, 0xf9,
+	0xaf, 0x7b, 0xd6, 0x9d, 0x36, 0xa1, 0xd4, 0xb6, 0x08, 0x83, 0x3f, 0x49, 0x6f, 0xee, 0x20, 0x33,
+	0xdd, 0x18, 0x4c, 0x75, 0x12, 0xfa, 0x68, 0xf6, 0x77, 0xee, 0x18, 0xf6, 0x77, 0x7e, 0x88, 0xfd,
+	0xfd, 0x63, 0x03, 0x9c, 0xe3, 0x9e, 0xef, 0x39, 0x5e, 0xa3, 0x53, 0xf7, 0x29, 0xc1, 0xd6, 0xb2,
+	0xe7, 0x32, 0x4e, 0xb1, 0xed, 0xf2, 0xf0, 0x64, 0x7e, 0x31, 0xbb, 0xb7, 0xd9, 0x42, 0xb5, 0x47,
+	0x55, 0xdf, 0xcf, 0xf5, 0xe2, 0x60, 0xa8, 0xb7, 0x46, 0x78, 0x13, 0xcc, 0xfa, 0xd4, 0xf6, 0x44,
+	0xb2, 0x28, 0xad, 0x41, 0xba, 0xde, 0x20, 0x67, 0x3b, 0xa7, 0x80, 0x67, 0xd7, 0xd3, 0x0c, 0xa8,
+	0x5b, 0x06, 0x5e, 0x05, 0x79, 0xe2, 0xb6, 0x4b, 0xa3, 0x72, 0x04, 0xf3, 0x59, 0x23, 0xb8, 0xee,
+	0xb6, 0x5f, 0xc3, 0xb4, 0x56, 0x54, 0xb0, 0xf9, 0xeb, 0x6e, 0x1b, 0x09, 0x99, 0xe1, 0x6d, 0xec,
+	0x37, 0x39, 0x10, 0x54, 0x50, 0xea, 0xe4, 0x24, 0x2a, 0x0d, 0xef, 0x68, 0xd9, 0xc3, 0x0b, 0x03,
+	0xe6, 0x60, 0xa4, 0x77, 0x91, 0xa1, 0x91, 0x4a, 0xf3, 0xae, 0x0d, 0xae, 0xe2, 0xe0, 0x4c, 0xef,
+	0xcf, 0x39, 0x00, 0x43, 0xd6, 0xa5, 0x16, 0xf7, 0x98, 0x89, 0x9d, 0x13, 0x49, 0x93, 0x6d, 0x6d,
+	0x0a, 0x6f, 0x0e, 0x3c, 0xbe, 0xb8, 0xd3, 0x3d, 0x27, 0xf3, 0xfd, 0xd4, 0x64, 0xde, 0x3e, 0x0a,
+	0x65, 0x07, 0x4f, 0xeb, 0xe7, 0x06, 0x98, 0xef, 0x16, 0xaa, 0x91, 0x1d, 0xdc, 0xb6, 0x3d, 0x0a,
+	0x1d, 0x30, 0x26, 0x5b, 0x36, 0xfd, 0x81, 0x4b, 0x98, 0xd1, 0xfa, 0x9a, 0xd8, 0x11, 0xf1, 0xaa,
+	0xe5, 0x10, 0x16, 0x44, 0xb0, 0x7a, 0x80, 0x8c, 0x42, 0x15, 0x90, 0x82, 0x09, 0xf9, 0x73, 0xc5,
+	0xfb, 0xc0, 0x2d, 0xe5, 0x8e, 0x52, 0xdf, 0x94, 0x48, 0x9c, 0xea, 0x21, 0x36, 0x8a, 0xd5, 0x94,
+	0x3f, 0x33, 0xc0, 0xd9, 0xee, 0x09, 0x38, 0x81, 0x08, 0xb8, 0xa3, 0x47, 0xc0, 0xe5, 0x23, 0x58,
+	0xeb, 0x1e, 0xe1, 0xf0, 0xdf, 0xf9, 0xac, 0x21, 0xca, 0x44, 0xf6, 0x07, 0x06, 0x98, 0x96, 0x9f,
+	0xc1, 0xdd, 0xc6, 0x91, 0x97, 0xaa, 0xcf, 0xaa, 0x0e, 0x4d, 0xd7, 0x35, 0x25, 0x28, 0xa5, 0x14,
+	0x5e, 0x02, 0xc5, 0xa6, 0xed, 0x22, 0xe2, 0x3b, 0xb6, 0x89, 0x83, 0xdc, 0xb8, 0x50, 0x3b, 0x2d,
+	0xc2, 0xdc, 0x5a, 0xdc, 0x8c, 0x92, 0x3c, 0x22, 0x07, 0x6f, 0xe2, 0xbb, 0x91, 0x48, 0x90, 0xec,
+	0x46, 0xd1, 0x71, 0x2d, 0x26, 0xa1, 0x24, 0x1f, 0x74, 0xc5, 0x31, 0x9e, 0x53, 0xdb, 0x0c, 0x43,
+	0xd8, 0xe0, 0x13, 0xaf, 0x2e, 0x8f, 0x84, 0x35, 0x27, 0x6a, 0x01, 0x12, 0x1b, 0x85, 0x4a, 0x60,
+	0x0b, 0x8c, 0x6f, 0x29, 0x6b, 0x92, 0xc1, 0xaa, 0x78, 0xf9, 0x1b, 0x47, 0xb1, 0xd2, 0x0a, 0x32,
+	0x08, 0xde, 0xe1, 0x17, 0x8a, 0x54, 0x95, 0xff, 0x30, 0x02, 0x4a, 0xbd, 0x9c, 0x41, 0x8f, 0x7a,
+	0xbd, 0x31, 0x50, 0xbd, 0xde, 0x04, 0x53, 0x0e, 0x66, 0x3c, 0x58, 0x5f, 0x51, 0xb1, 0xc9, 0xf5,
+	0x5d, 0xb1, 0x91, 0x95, 0xc6, 0xd5, 0x24, 0x08, 0xd2, 0x31, 0x45, 0x91, 0xce, 0x6c, 0x51, 0x4a,
+	0x5c, 0x9e, 0x5a, 0xef, 0xa8, 0x48, 0xb7, 0xac, 0x93, 0x51, 0x9a, 0x5f, 0x40, 0x58, 0x84, 0xd9,
+	0x94, 0x58, 0x11, 0xc4, 0x88, 0x0e, 0xb1, 0xa2, 0x93, 0x51, 0x9a, 0x5f, 0x1a, 0x8b, 0x82, 0x55,
+	0xcb, 0xac, 0xce, 0x24, 0xd7, 0x87, 0xdd, 0x42, 0x81, 0x8f, 0x8e, 0x8c, 0x65, 0x59, 0x53, 0x82,
+	0x52, 0x4a, 0x53, 0xf5, 0xd5, 0xd1, 0x63, 0xbf, 0x22, 0xf9, 0xa3, 0xa1, 0xaa, 0x2c, 0x75, 0x72,
+	0x12, 0x55, 0xfd, 0xb7, 0x75, 0xc7, 0x78, 0x75, 0xe0, 0xc9, 0xed, 0xe1, 0x0e, 0xff, 0x39, 0x12,
+	0x67, 0x12, 0xb1, 0x09, 0xc3, 0xe7, 0xb5, 0xd2, 0xd1, 0x85, 0x54, 0xe9, 0xa8, 0x94, 0x92, 0x90,
+	0xc7, 0xee, 0x44, 0x09, 0xa9, 0x01, 0x46, 0x7d, 0xcf, 0x5a, 0x5e, 0xdf, 0x54, 0xfb, 0xbf, 0x7f,
+	0xaf, 0xb2, 0x2e, 0xc5, 0x93, 0xf0, 0x35, 0x20, 0x02, 0x76, 0xd0, 0x8e, 0x14, 0x3c, 0xfc, 0xd0,
+	0x00, 0xd0, 0xec, 0xba, 0xc7, 0x56, 0x09, 0xc3, 0x9d, 0x01, 0x6f, 0x82, 0x7b, 0x5d, 0x89, 0xd7,
+	0xce, 0x0a, 0x4f, 0xd0, 0xcd, 0x83, 0x32, 0xba, 0x00, 0x7f, 0x69, 0x80, 0x33, 0x3e, 0x71, 0x2d,
+	0xdb, 0x6d, 0x68, 0xb7, 0x02, 0x4c, 0x15, 0x71, 0x51, 0xff, 0x53, 0x92, 0x85, 0xa6, 0xf5, 0xef,
+	0xdc, 0xfe, 0xde, 0xe2, 0x99, 0x4c, 0x36, 0x94, 0xdd, 0x17, 0x71, 0x39, 0x2a, 0x5e, 0x61, 0x58,
+	0x2d, 0x87, 0x28, 0x7f, 0xdc, 0xbf, 0xf5, 0xd6, 0x15, 0x80, 0xd6, 0x15, 0xe9, 0x89, 0x43, 0x0a,
+	0x8a, 0x94, 0x94, 0xff, 0x65, 0x80, 0xb9, 0x2c, 0x73, 0x1f, 0x72, 0xc3, 0xbd, 0x0e, 0xc6, 0x94,
+	0x5b, 0x28, 0xe5, 0x1e, 0x6c, 0x82, 0x95, 0xb0, 0x52, 0x54, 0x79, 0xb5, 0x85, 0x5d, 0x2e, 0x8e,
+	0x7d, 0x51, 0xc8, 0x0a, 0x5d, 0x67, 0x88, 0x07, 0x5f, 0xe8, 0x76, 0x95, 0x2a, 0xba, 0x1e, 0xc6,
+	0x4d, 0x96, 0x3f, 0xce, 0x81, 0x99, 0xb0, 0xf3, 0x88, 0xb4, 0x6d, 0x26, 0xc2, 0xc4, 0x45, 0x30,
+	0x4e, 0xd5, 0x6f, 0x15, 0x68, 0x22, 0x17, 0x10, 0xf2, 0xa0, 0x88, 0x03, 0x5e, 0x01, 0x93, 0x9c,
+	0x34, 0x7d, 0x07, 0x73, 0x72, 0x0b, 0xb3, 0x1d, 0x55, 0x95, 0x8d, 0xce, 0xd4, 0x1b, 0x09, 0x1a,
+	0xd2, 0x38, 0xa3, 0xa2, 0x76, 0xfe, 0x58, 0x8a, 0xda, 0x0c, 0xcc, 0x9a, 0x94, 0xe0, 0xf0, 0xee,
+	0x80, 0x71, 0xdc, 0xf4, 0x07, 0xb8, 0xa2, 0x88, 0x8e, 0xab, 0xcb, 0x69, 0x30, 0xd4, 0x8d, 0x5f,
+	0x7e, 0x29, 0x31, 0x9d, 0x9e, 0xe3, 0x6c, 0x61, 0x73, 0xb7, 0xbf, 0xe9, 0x2c, 0xb7, 0xe3, 0x1d,
+	0x98, 0xcc, 0x8a, 0xe1, 0xdb, 0x60, 0x9e, 0x71, 0xbc, 0x65, 0x3b, 0xf6, 0xb7, 0xa5, 0xce, 0x6f,
+	0xda, 0xae, 0xe5, 0x7d, 0xa0, 0xbf, 0x7e, 0x59, 0xd8, 0xdf, 0x5b, 0x9c, 0xaf, 0xf7, 0xe4, 0x42,
+	0x07, 0x20, 0x94, 0xff, 0x33, 0x1a, 0x07, 0x0e, 0xe9, 0x63, 0x2f, 0x88, 0x6e, 0xab, 0x2d, 0x15,
+	0xc0, 0x4f, 0x06, 0x5d, 0x56, 0x7b, 0x29, 0xa2, 0x42, 0x2c, 0x2e, 0x94, 0x54, 0x9d, 0x26, 0xd8,
+	0xdf, 0x4f, 0x1e, 0x32, 0xc4, 0xe0, 0x2d, 0xe2, 0x84, 0xe7, 0xf3, 0x78, 0x56, 0xc2, 0x16, 0x14,
+	0xc1, 0x42, 0x1f, 0x8c, 0x87, 0x5b, 0xa7, 0x94, 0x1f, 0xa6, 0x36, 0x17, 0x6e, 0x47, 0xb9, 0x6d,
+	0x22, 0x8d, 0x61, 0x2b, 0x8a, 0xb4, 0xa4, 0xcb, 0xc6, 0x23, 0x87, 0x2c, 0x1b, 0x5f, 0x01, 0x93,
+	0x16, 0x71, 0x08, 0x27, 0xeb, 0x9e, 0x63, 0x9b, 0x9d, 0xf0, 0x69, 0x4a, 0x68, 0x0d, 0x2b, 0x09,
+	0x1a, 0xd2, 0x38, 0x45, 0xd2, 0x23, 0x53, 0x66, 0x6c, 0x75, 0xc2, 0x55, 0x1d, 0xd5, 0x93, 0x9e,
+	0x35, 0x9d, 0x8c, 0xd2, 0xfc, 0x70, 0x13, 0x3c, 0xec, 0x53, 0xaf, 0x41, 0x09, 0x63, 0x2b, 0x04,
+	0x5b, 0x8e, 0xed, 0x92, 0x10, 0x6a, 0x4c, 0x42, 0xfd, 0xdf, 0xfe, 0xde, 0xe2, 0xc3, 0xeb, 0xd9,
+	0x2c, 0xa8, 0x97, 0xac, 0x74, 0xc3, 0x9c, 0x62, 0x4e, 0x1a, 0x1d, 0x55, 0x18, 0x5d, 0x1a, 0xa2,
+	0x72, 0x10, 0x00, 0x25, 0x56, 0x5b, 0xb5, 0xa0, 0x48, 0x09, 0x5c, 0x05, 0x73, 0xa1, 0x3d, 0xdc,
+	0xb2, 0x19, 0xf7, 0x68, 0x67, 0xd5, 0x6e, 0xda, 0xbc, 0x34, 0x11, 0x14, 0xc9, 0xf7, 0xf7, 0x16,
+	0xe7, 0x50, 0x06, 0x1d, 0x65, 0x4a, 0x89, 0x4b, 0x43, 0x1f, 0xb7, 0x18, 0xb1, 0x4a, 0xe0, 0xbc,
+	0x71, 0x61, 0x3c, 0x3e, 0x5e, 0xaf, 0xcb, 0x56, 0xa4, 0xa8, 0xf0, 0x7d, 0x00, 0xa8, 0xb2, 0xd9,
+	0x0d, 0xaf, 0x54, 0x1c, 0x72, 0xa0, 0xa1, 0xf9, 0xd7, 0xa6, 0x45, 0xb6, 0x86, 0x22, 0x60, 0x94,
+	0x50, 0x52, 0xfe, 0xc5, 0x18, 0x98, 0x8e, 0x67, 0x46, 0x46, 0x9a, 0x8b, 0x5d, 0x66, 0x97, 0xf0,
+	0x16, 0x5d, 0xa6, 0xb7, 0x0e, 0xe6, 0xb6, 0x5b, 0x8e, 0xd3, 0x91, 0x96, 0x94, 0x88, 0x01, 0xc1,
+	0xa1, 0xec, 0x11, 0x25, 0x39, 0x77, 0x23, 0x83, 0x07, 0x65, 0x4a, 0x8a, 0x9b, 0x6e, 0x51, 0xcc,
+	0xeb, 0xa4, 0xc2, 0x49, 0x74, 0xd3, 0x8d, 0x92, 0x44, 0xa4, 0xf3, 0x8a, 0xb2, 0x1f, 0x6e, 0x63,
+	0xdb, 0xc1, 0x5b, 0x0e, 0x49, 0xa5, 0xee, 0x91, 0x1f, 0x5d, 0x4a, 0x33, 0xa0, 0x6e, 0x99, 0x1e,
+	0xa7, 0x9e, 0xc2, 0x40, 0xa7, 0x1e, 0x06, 0xa6, 0xb6, 0xb1, 0xed, 0xb4, 0x28, 0x09, 0x6e, 0x89,
+	0xd5, 0x95, 0xf0, 0x9a, 0x18, 0xcd, 0x8d, 0x24, 0xe1, 0xfe, 0xde, 0xe2, 0x95, 0x83, 0xdf, 0xdd,
+	0x12, 0x4a, 0x3d, 0xca, 0x52, 0x65, 0xb0, 0xeb, 0xa2, 0x11, 0xe9, 0x3a, 0xe0, 0xb3, 0x60, 0x5a,
+	0x35, 0xa8, 0x1b, 0x67, 0x75, 0xf3, 0x00, 0xc5, 0x99, 0xe1, 0x86, 0x46, 0x41, 0x29, 0xce, 0xd4,
+	0x99, 0x61, 0xfc, 0xd8, 0xdf, 0x64, 0x2c, 0x81, 0xd3, 0x2d, 0xdf, 0xc2, 0x3c, 0xb1, 0x7f, 0x26,
+	0x74, 0xcf, 0xb3, 0xa9, 0x93, 0x51, 0x9a, 0x5f, 0x8b, 0x71, 0xa0, 0xef, 0x94, 0xa1, 0x78, 0xe8,
+	0x94, 0xc1, 0x01, 0x63, 0x3b, 0x81, 0x6d, 0x97, 0x26, 0xcf, 0xe7, 0x87, 0x33, 0x50, 0xd5, 0x9b,
+	0x38, 0xb9, 0x52, 0x5e, 0x03, 0x85, 0x2a, 0xca, 0x1f, 0x1b, 0x71, 0x38, 0x0f, 0xdd, 0x14, 0xbc,
+	0xa2, 0xa5, 0x82, 0x5f, 0x4a, 0xa5, 0x82, 0x73, 0x69, 0xfe, 0x44, 0x1a, 0xf8, 0x5d, 0x30, 0x25,
+	0x6c, 0xdf, 0x76, 0x1b, 0xc1, 0x7c, 0xaa, 0x60, 0x79, 0xa3, 0xef, 0x21, 0xa0, 0x24, 0x4a, 0x74,
+	0x82, 0x92, 0x47, 0x73, 0x8d, 0x84, 0x74, 0x7d, 0xe5, 0xbf, 0x8f, 0xa8, 0x4b, 0x23, 0x19, 0xe0,
+	0x77, 0xbb, 0x4e, 0x86, 0xfd, 0x5f, 0xee, 0x1e, 0xba, 0x32, 0x9b, 0x0a, 0xa7, 0xb9, 0x41, 0xde,
+	0xa6, 0xe6, 0x0f, 0xf1, 0x36, 0x55, 0x7b, 0x79, 0x55, 0x38, 0xc4, 0xcb, 0xab, 0x77, 0x84, 0xbf,
+	0x63, 0x1c, 0x53, 0xae, 0x22, 0x76, 0xe0, 0x1d, 0xae, 0xc6, 0xfe, 0x2e, 0x41, 0xbc, 0xbf, 0xb7,
+	0x78, 0x3e, 0xe3, 0xa5, 0x8d, 0xc6, 0x83, 0x74, 0x3c, 0xf1, 0x56, 0xc6, 0xf7, 0x2c, 0x19, 0xf8,
+	0x55, 0xaa, 0xe8, 0xb5, 0x78, 0x69, 0xec, 0xc1, 0xe7, 0x80, 0x38, 0x4f, 0x5a, 0x69, 0xa9, 0x0b,
+	0x25, 0x79, 0xc4, 0x5b, 0xef, 0x42, 0x43, 0x19, 0x1a, 0xe0, 0x5d, 0x30, 0xe9, 0x27, 0xee, 0xbd,
+	0x86, 0xbb, 0xd2, 0x4c, 0xde, 0xa0, 0x05, 0x6f, 0x5a, 0x93, 0x2d, 0x48, 0xd3, 0x54, 0xfe, 0x6b,
+	0x01, 0x14, 0x13, 0x0f, 0x42, 0x60, 0x53, 0x9e, 0xb7, 0xe3, 0x7a, 0xe5, 0x63, 0x59, 0xd7, 0x38,
+	0xe9, 0x6a, 0xe4, 0x13, 0xea, 0x3c, 0x8d, 0xc8, 0x76, 0x8f, 0xf7, 0x4d, 0x29, 0x09, 0xa4, 0x94,
+	0xc0, 0xb7, 0x40, 0xd1, 0xc1, 0x8c, 0x2b, 0x9f, 0x35, 0x40, 0x8d, 0x4b, 0xd6, 0x32, 0x57, 0x63,
+	0x08, 0x94, 0xc4, 0x83, 0x7e, 0x3a, 0x9c, 0x04, 0xdb, 0xf2, 0xe5, 0xac, 0x70, 0xf2, 0x74, 0x1f,
+	0xe1, 0xa4, 0x9f, 0x58, 0x32, 0xd2, 0x47, 0x2c, 0x99, 0x50, 0xcf, 0x61, 0x48, 0x58, 0x01, 0x1b,
+	0xf0, 0x66, 0x49, 0x3d, 0xaf, 0x89, 0xcd, 0x69, 0x29, 0xc4, 0x45, 0xb1, 0x0a, 0x71, 0xd1, 0xee,
+	0xef, 0x60, 0x16, 0xbe, 0xbb, 0x8a, 0xaa, 0x3a, 0xeb, 0xa2, 0x11, 0x05, 0xb4, 0x1e, 0xd1, 0x7d,
+	0xec, 0x08, 0xde, 0x20, 0x1f, 0x7b, 0xb0, 0x2c, 0xef, 0x19, 0xea, 0xbe, 0x3a, 0x79, 0x92, 0x38,
+	0x59, 0x5f, 0x7a, 0xac, 0x8f, 0xc1, 0xca, 0x9f, 0xe7, 0x40, 0x42, 0x2d, 0xf4, 0xc0, 0xa8, 0x23,
+	0x52, 0xc4, 0xf0, 0x71, 0xe8, 0xcd, 0x21, 0xc6, 0x15, 0x1c, 0xf8, 0x58, 0x70, 0xeb, 0x1e, 0xa5,
+	0xe1, 0x41, 0x23, 0x52, 0x6a, 0xe0, 0xf7, 0x0d, 0x50, 0xc4, 0xae, 0xeb, 0x71, 0xed, 0xaa, 0x7d,
+	0x75, 0x18, 0xb5, 0x4b, 0x31, 0x5c, 0xa0, 0x3b, 0x0a, 0x3c, 0x09, 0x0a, 0x4a, 0x6a, 0x9d, 0xbf,
+	0x0a, 0x8a, 0x89, 0xce, 0xf6, 0x73, 0x6d, 0x3c, 0xff, 0x22, 0x98, 0x49, 0x2b, 0xec, 0xeb, 0xda,
+	0xd9, 0x06, 0x8f, 0x3e, 0xb0, 0x98, 0x26, 0xde, 0x10, 0x05, 0xff, 0x69, 0x59, 0x27, 0x54, 0x65,
+	0x61, 0x25, 0x43, 0x7f, 0x43, 0xb4, 0x91, 0xa2, 0xa3, 0x2e, 0x89, 0x32, 0x05, 0xb0, 0xbb, 0x94,
+	0x09, 0xdf, 0x04, 0xa5, 0x80, 0x73, 0xa9, 0x4d, 0x28, 0x6e, 0x90, 0x4d, 0x1e, 0xd5, 0x0c, 0x94,
+	0x8e, 0xf0, 0xb1, 0x5e, 0x69, 0xa3, 0x07, 0x1f, 0xea, 0x89, 0x50, 0xfe, 0xa1, 0x01, 0xc6, 0xd6,
+	0x3d, 0xeb, 0xb6, 0xbb, 0xed, 0x89, 0xcc, 0xd3, 0xf3, 0xa5, 0x25, 0xbb, 0x8d, 0x7a, 0x87, 0x71,
+	0xd2, 0x94, 0x99, 0xe7, 0x44, 0x9c, 0x79, 0xde, 0xd1, 0xc9, 0x28, 0xcd, 0x2f, 0x72, 0x49, 0x4c,
+	0xcd, 0x1d, 0x9b, 0x13, 0x93, 0xb7, 0x28, 0x29, 0x01, 0x3d, 0x97, 0x5c, 0x4a, 0xd0, 0x90, 0xc6,
+	0x59, 0xbe, 0x67, 0x80, 0x33, 0x99, 0x99, 0x14, 0x74, 0xc0, 0x74, 0x13, 0xdf, 0xdd, 0x74, 0xa3,
+	0x73, 0xc9, 0x03, 0xaf, 0xab, 0xc5, 0x9f, 0xbf, 0x2a, 0xc1, 0x9f, 0xbf, 0x2a, 0xb7, 0x5d, 0x7e,
+	0x87, 0xd6, 0x39, 0x15, 0xaf, 0x7f, 0xa4, 0x8b, 0x5e, 0xd3, 0xb0, 0x50, 0x0a, 0x1b, 0xbe, 0x01,
+	0xc6, 0x9b, 0xf8, 0x6e, 0xbd, 0x45, 0x1b, 0x61, 0x46, 0xd8, 0xbf, 0x9e, 0xe0, 0xdf, 0x1e, 0x0a,
+	0x05, 0x45, 0x78, 0xe2, 0xb9, 0xe0, 0x5c, 0x56, 0x05, 0x54, 0x24, 0xec, 0x51, 0x69, 0xd5, 0xd0,
+	0x5f, 0xbc, 0x77, 0xd7, 0x45, 0xc5, 0x25, 0x82, 0xa5, 0x72, 0x90, 0xc3, 0x54, 0x30, 0x33, 0x32,
+	0x97, 0x08, 0x3d, 0x6c, 0x41, 0x11, 0xa2, 0x76, 0xe4, 0xcd, 0x3f, 0xe8, 0xc8, 0x5b, 0xdb, 0xfc,
+	0xe8, 0xde, 0xc2, 0xa9, 0x4f, 0xee, 0x2d, 0x9c, 0xfa, 0xf4, 0xde, 0xc2, 0xa9, 0xef, 0xed, 0x2f,
+	0x18, 0x1f, 0xed, 0x2f, 0x18, 0x9f, 0xec, 0x2f, 0x18, 0x9f, 0xee, 0x2f, 0x18, 0x9f, 0xed, 0x2f,
+	0x18, 0x3f, 0xfd, 0xdb, 0xc2, 0xa9, 0x37, 0xaa, 0x7d, 0xfe, 0x81, 0xf2, 0x7f, 0x03, 0x00, 0xca,
+	0x92, 0x4d, 0x30, 0x72, 0x39, 0x00, 0x00,
+}
+
+func (m *Chain) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1025,12 +1526,12 @@ func (m *ChargeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChargeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Chain) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChargeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Chain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1068,7 +1569,7 @@ func (m *ChargeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ChargeRequestList) Marshal() (dAtA []byte, err error) {
+func (m *ChainBlockIntervalMetricSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1078,19 +1579,45 @@ func (m *ChargeRequestList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChargeRequestList) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChainBlockIntervalMetricSource) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChargeRequestList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChainBlockIntervalMetricSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TargetSeconds))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
 				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
@@ -1115,7 +1642,7 @@ func (m *ChargeRequestList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ChargeRequestSpec) Marshal() (dAtA []byte, err error) {
+func (m *ChainSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1125,30 +1652,45 @@ func (m *ChargeRequestSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChargeRequestSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChainSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChargeRequestSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChainSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Password)
-	copy(dAtA[i:], m.Password)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Password)))
+	if m.BootstrapAccount != nil {
+		i -= len(*m.BootstrapAccount)
+		copy(dAtA[i:], *m.BootstrapAccount)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.BootstrapAccount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MinMineIntervalSeconds))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Image)
+	copy(dAtA[i:], m.Image)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Image)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.MinerType)
+	copy(dAtA[i:], m.MinerType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MinerType)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.From)
-	copy(dAtA[i:], m.From)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.From)))
+	i -= len(m.DisplayName)
+	copy(dAtA[i:], m.DisplayName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ChargeRequestStatus) Marshal() (dAtA []byte, err error) {
+func (m *ChainStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1158,12 +1700,12 @@ func (m *ChargeRequestStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChargeRequestStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChainStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChargeRequestStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChainStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1179,13 +1721,40 @@ func (m *ChargeRequestStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x22
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+	i--
+	dAtA[i] = 0x18
+	if m.MinerRef != nil {
+		{
+			size, err := m.MinerRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ConfigMapRef != nil {
+		{
+			size, err := m.ConfigMapRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Condition) Marshal() (dAtA []byte, err error) {
+func (m *ChargeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1195,28 +1764,18 @@ func (m *Condition) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Condition) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChargeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Condition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChargeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
-	i--
-	dAtA[i] = 0x32
-	i -= len(m.Reason)
-	copy(dAtA[i:], m.Reason)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
-	i--
-	dAtA[i] = 0x2a
 	{
-		size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1224,26 +1783,31 @@ func (m *Condition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	i -= len(m.Severity)
-	copy(dAtA[i:], m.Severity)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Severity)))
-	i--
 	dAtA[i] = 0x1a
-	i -= len(m.Status)
-	copy(dAtA[i:], m.Status)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Type)
-	copy(dAtA[i:], m.Type)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *LocalObjectReference) Marshal() (dAtA []byte, err error) {
+func (m *ChargeRequestList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1253,25 +1817,44 @@ func (m *LocalObjectReference) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LocalObjectReference) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChargeRequestList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LocalObjectReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChargeRequestList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Miner) Marshal() (dAtA []byte, err error) {
+func (m *ChargeRequestSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1281,50 +1864,48 @@ func (m *Miner) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Miner) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChargeRequestSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Miner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChargeRequestSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i -= len(m.Salt)
+	copy(dAtA[i:], m.Salt)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Salt)))
+	i--
+	dAtA[i] = 0x32
+	if m.Confirmations != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Confirmations))
+		i--
+		dAtA[i] = 0x28
 	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Amount))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.ChainName)
+	copy(dAtA[i:], m.ChainName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ChainName)))
 	i--
 	dAtA[i] = 0x1a
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.Password)
+	copy(dAtA[i:], m.Password)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Password)))
 	i--
 	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.From)
+	copy(dAtA[i:], m.From)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.From)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MinerAddress) Marshal() (dAtA []byte, err error) {
+func (m *ChargeRequestStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1334,30 +1915,48 @@ func (m *MinerAddress) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MinerAddress) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChargeRequestStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MinerAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChargeRequestStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Address)
-	copy(dAtA[i:], m.Address)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Address)))
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Confirmations))
 	i--
-	dAtA[i] = 0x12
-	i -= len(m.Type)
-	copy(dAtA[i:], m.Type)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	dAtA[i] = 0x28
+	i = encodeVarintGenerated(dAtA, i, uint64(m.BlockHeight))
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x20
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SubmittedHeight))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.TransactionID)
+	copy(dAtA[i:], m.TransactionID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TransactionID)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MinerList) Marshal() (dAtA []byte, err error) {
+func (m *Condition) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1367,32 +1966,28 @@ func (m *MinerList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MinerList) MarshalTo(dAtA []byte) (int, error) {
+func (m *Condition) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MinerList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Condition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x2a
 	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1400,11 +1995,26 @@ func (m *MinerList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
+	dAtA[i] = 0x22
+	i -= len(m.Severity)
+	copy(dAtA[i:], m.Severity)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Severity)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Status)
+	copy(dAtA[i:], m.Status)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MinerSet) Marshal() (dAtA []byte, err error) {
+func (m *LocalObjectReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1414,12 +2024,40 @@ func (m *MinerSet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MinerSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *LocalObjectReference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MinerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LocalObjectReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Miner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Miner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Miner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1457,7 +2095,7 @@ func (m *MinerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MinerSetList) Marshal() (dAtA []byte, err error) {
+func (m *MinerAddress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1467,44 +2105,30 @@ func (m *MinerSetList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MinerSetList) MarshalTo(dAtA []byte) (int, error) {
+func (m *MinerAddress) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MinerSetList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MinerAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.Address)
+	copy(dAtA[i:], m.Address)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Address)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MinerSetSpec) Marshal() (dAtA []byte, err error) {
+func (m *MinerClass) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1514,36 +2138,18 @@ func (m *MinerSetSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MinerSetSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *MinerClass) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MinerSetSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MinerClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ProgressDeadlineSeconds != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.ProgressDeadlineSeconds))
-		i--
-		dAtA[i] = 0x38
-	}
-	i = encodeVarintGenerated(dAtA, i, uint64(m.MinReadySeconds))
-	i--
-	dAtA[i] = 0x30
-	i -= len(m.DeletePolicy)
-	copy(dAtA[i:], m.DeletePolicy)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DeletePolicy)))
-	i--
-	dAtA[i] = 0x2a
-	i -= len(m.DisplayName)
-	copy(dAtA[i:], m.DisplayName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
-	i--
-	dAtA[i] = 0x22
 	{
-		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1551,9 +2157,9 @@ func (m *MinerSetSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x12
 	{
-		size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1561,16 +2167,11 @@ func (m *MinerSetSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if m.Replicas != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.Replicas))
-		i--
-		dAtA[i] = 0x8
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MinerSetStatus) Marshal() (dAtA []byte, err error) {
+func (m *MinerClassList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1580,20 +2181,20 @@ func (m *MinerSetStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MinerSetStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *MinerClassList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MinerSetStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MinerClassList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Conditions) > 0 {
-		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1601,42 +2202,23 @@ func (m *MinerSetStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x12
 		}
 	}
-	if m.FailureMessage != nil {
-		i -= len(*m.FailureMessage)
-		copy(dAtA[i:], *m.FailureMessage)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.FailureMessage)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.FailureReason != nil {
-		i -= len(*m.FailureReason)
-		copy(dAtA[i:], *m.FailureReason)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.FailureReason)))
-		i--
-		dAtA[i] = 0x32
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
 	i--
-	dAtA[i] = 0x28
-	i = encodeVarintGenerated(dAtA, i, uint64(m.AvailableReplicas))
-	i--
-	dAtA[i] = 0x20
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ReadyReplicas))
-	i--
-	dAtA[i] = 0x18
-	i = encodeVarintGenerated(dAtA, i, uint64(m.FullyLabeledReplicas))
-	i--
-	dAtA[i] = 0x10
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Replicas))
-	i--
-	dAtA[i] = 0x8
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MinerSpec) Marshal() (dAtA []byte, err error) {
+func (m *MinerClassPricing) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1646,62 +2228,23 @@ func (m *MinerSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MinerSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *MinerClassPricing) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MinerSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MinerClassPricing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PodDeletionTimeout != nil {
-		{
-			size, err := m.PodDeletionTimeout.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	i -= len(m.RestartPolicy)
-	copy(dAtA[i:], m.RestartPolicy)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RestartPolicy)))
-	i--
-	dAtA[i] = 0x32
-	i -= len(m.ChainName)
-	copy(dAtA[i:], m.ChainName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ChainName)))
-	i--
-	dAtA[i] = 0x2a
-	i -= len(m.MinerType)
-	copy(dAtA[i:], m.MinerType)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MinerType)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.DisplayName)
-	copy(dAtA[i:], m.DisplayName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.HourlyPrice))
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x8
 	return len(dAtA) - i, nil
 }
 
-func (m *MinerStatus) Marshal() (dAtA []byte, err error) {
+func (m *MinerClassSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1711,42 +2254,49 @@ func (m *MinerStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MinerStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *MinerClassSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MinerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MinerClassSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Conditions) > 0 {
-		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if m.Pricing != nil {
+		{
+			size, err := m.Pricing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x42
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x42
 	}
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
-	i--
-	dAtA[i] = 0x38
-	i -= len(m.Phase)
-	copy(dAtA[i:], m.Phase)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i -= len(m.Image)
+	copy(dAtA[i:], m.Image)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Image)))
 	i--
-	dAtA[i] = 0x32
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+	dAtA[i] = 0x3a
+	if m.Affinity != nil {
+		{
+			size, err := m.Affinity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Tolerations) > 0 {
+		for iNdEx := len(m.Tolerations) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Addresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Tolerations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1757,48 +2307,52 @@ func (m *MinerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x2a
 		}
 	}
-	if m.FailureMessage != nil {
-		i -= len(*m.FailureMessage)
-		copy(dAtA[i:], *m.FailureMessage)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.FailureMessage)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.FailureReason != nil {
-		i -= len(*m.FailureReason)
-		copy(dAtA[i:], *m.FailureReason)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.FailureReason)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.LastUpdated != nil {
-		{
-			size, err := m.LastUpdated.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if len(m.NodeSelector) > 0 {
+		keysForNodeSelector := make([]string, 0, len(m.NodeSelector))
+		for k := range m.NodeSelector {
+			keysForNodeSelector = append(keysForNodeSelector, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
+		for iNdEx := len(keysForNodeSelector) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.NodeSelector[string(keysForNodeSelector[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForNodeSelector[iNdEx])
+			copy(dAtA[i:], keysForNodeSelector[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForNodeSelector[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.PodRef != nil {
-		{
-			size, err := m.PodRef.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MiningDifficulty))
+	i--
+	dAtA[i] = 0x18
+	{
+		size, err := m.Resources.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.DisplayName)
+	copy(dAtA[i:], m.DisplayName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MinerTemplateSpec) Marshal() (dAtA []byte, err error) {
+func (m *MinerList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1808,28 +2362,32 @@ func (m *MinerTemplateSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MinerTemplateSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *MinerList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MinerTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MinerList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
 	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1841,7 +2399,7 @@ func (m *MinerTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ObjectMeta) Marshal() (dAtA []byte, err error) {
+func (m *MinerPodOverrides) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1851,57 +2409,92 @@ func (m *ObjectMeta) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ObjectMeta) MarshalTo(dAtA []byte) (int, error) {
+func (m *MinerPodOverrides) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ObjectMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MinerPodOverrides) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Annotations) > 0 {
-		keysForAnnotations := make([]string, 0, len(m.Annotations))
-		for k := range m.Annotations {
-			keysForAnnotations = append(keysForAnnotations, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-		for iNdEx := len(keysForAnnotations) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Annotations[string(keysForAnnotations[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+	if len(m.Env) > 0 {
+		for iNdEx := len(m.Env) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Env[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x12
-			i -= len(keysForAnnotations[iNdEx])
-			copy(dAtA[i:], keysForAnnotations[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAnnotations[iNdEx])))
+			dAtA[i] = 0x32
+		}
+	}
+	i -= len(m.PriorityClassName)
+	copy(dAtA[i:], m.PriorityClassName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PriorityClassName)))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TopologySpreadConstraints) > 0 {
+		for iNdEx := len(m.TopologySpreadConstraints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopologySpreadConstraints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Affinity != nil {
+		{
+			size, err := m.Affinity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Tolerations) > 0 {
+		for iNdEx := len(m.Tolerations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tolerations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Labels) > 0 {
-		keysForLabels := make([]string, 0, len(m.Labels))
-		for k := range m.Labels {
-			keysForLabels = append(keysForLabels, string(k))
+	if len(m.NodeSelector) > 0 {
+		keysForNodeSelector := make([]string, 0, len(m.NodeSelector))
+		for k := range m.NodeSelector {
+			keysForNodeSelector = append(keysForNodeSelector, string(k))
 		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-		for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Labels[string(keysForLabels[iNdEx])]
+		github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
+		for iNdEx := len(keysForNodeSelector) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.NodeSelector[string(keysForNodeSelector[iNdEx])]
 			baseI := i
 			i -= len(v)
 			copy(dAtA[i:], v)
 			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
 			i--
 			dAtA[i] = 0x12
-			i -= len(keysForLabels[iNdEx])
-			copy(dAtA[i:], keysForLabels[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLabels[iNdEx])))
+			i -= len(keysForNodeSelector[iNdEx])
+			copy(dAtA[i:], keysForNodeSelector[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForNodeSelector[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
@@ -1912,7 +2505,7 @@ func (m *ObjectMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PodInfo) Marshal() (dAtA []byte, err error) {
+func (m *MinerSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1922,805 +2515,6242 @@ func (m *PodInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PodInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *MinerSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PodInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MinerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Architecture)
-	copy(dAtA[i:], m.Architecture)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Architecture)))
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
-	dAtA[i] = 0x52
-	i -= len(m.OperatingSystem)
-	copy(dAtA[i:], m.OperatingSystem)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OperatingSystem)))
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
-	dAtA[i] = 0x4a
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MinerSetAutoscaler) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Chain) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+
+func (m *MinerSetAutoscaler) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChainList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MinerSetAutoscaler) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *ChainSpec) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.DisplayName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.MinerType)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Image)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.MinMineIntervalSeconds))
-	if m.BootstrapAccount != nil {
-		l = len(*m.BootstrapAccount)
-		n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ChainStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MinerSetAutoscalerBehavior) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MinerSetAutoscalerBehavior) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinerSetAutoscalerBehavior) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ConfigMapRef != nil {
-		l = m.ConfigMapRef.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.MinerRef != nil {
-		l = m.MinerRef.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.ScaleDown != nil {
+		{
+			size, err := m.ScaleDown.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.ScaleUp != nil {
+		{
+			size, err := m.ScaleUp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ChargeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MinerSetAutoscalerList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ChargeRequestList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MinerSetAutoscalerList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinerSetAutoscalerList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
 	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ChargeRequestSpec) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MinerSetAutoscalerSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.From)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Password)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ChargeRequestStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MinerSetAutoscalerSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinerSetAutoscalerSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.Behavior != nil {
+		{
+			size, err := m.Behavior.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	if len(m.Metrics) > 0 {
+		for iNdEx := len(m.Metrics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metrics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxReplicas))
+	i--
+	dAtA[i] = 0x18
+	if m.MinReplicas != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MinReplicas))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ScaleTargetRef.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Condition) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MinerSetAutoscalerStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Type)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Severity)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.LastTransitionTime.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Reason)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LocalObjectReference) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MinerSetAutoscalerStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinerSetAutoscalerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CurrentMetrics) > 0 {
+		for iNdEx := len(m.CurrentMetrics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentMetrics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DesiredReplicas))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintGenerated(dAtA, i, uint64(m.CurrentReplicas))
+	i--
+	dAtA[i] = 0x18
+	if m.LastScaleTime != nil {
+		{
+			size, err := m.LastScaleTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
-func (m *Miner) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MinerSetList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MinerAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Type)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Address)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *MinerSetList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MinerList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MinerSetList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
 	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MinerSet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MinerSetMetricSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MinerSetList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MinerSetMetricSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinerSetMetricSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	if m.PendingChargeRequests != nil {
+		{
+			size, err := m.PendingChargeRequests.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ChainBlockInterval != nil {
+		{
+			size, err := m.ChainBlockInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PodCPU != nil {
+		{
+			size, err := m.PodCPU.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MinerSetSpec) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MinerSetMetricStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MinerSetMetricStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinerSetMetricStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Replicas != nil {
-		n += 1 + sovGenerated(uint64(*m.Replicas))
+	if m.DesiredReplicas != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.DesiredReplicas))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.Selector.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Template.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.DisplayName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.DeletePolicy)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.MinReadySeconds))
-	if m.ProgressDeadlineSeconds != nil {
-		n += 1 + sovGenerated(uint64(*m.ProgressDeadlineSeconds))
+	{
+		size, err := m.Current.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MinerSetStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MinerSetRevision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MinerSetRevision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinerSetRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	n += 1 + sovGenerated(uint64(m.Replicas))
-	n += 1 + sovGenerated(uint64(m.FullyLabeledReplicas))
-	n += 1 + sovGenerated(uint64(m.ReadyReplicas))
-	n += 1 + sovGenerated(uint64(m.AvailableReplicas))
-	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
-	if m.FailureReason != nil {
-		l = len(*m.FailureReason)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.FailureMessage != nil {
-		l = len(*m.FailureMessage)
-		n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.CreationTimestamp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.TemplateHash)
+	copy(dAtA[i:], m.TemplateHash)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TemplateHash)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Revision))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
-func (m *MinerSpec) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MinerSetRollback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MinerSetRollback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinerSetRollback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.DisplayName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.MinerType)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ChainName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.RestartPolicy)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.PodDeletionTimeout != nil {
-		l = m.PodDeletionTimeout.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Revision))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
-func (m *MinerStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MinerSetScalingRules) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MinerSetScalingRules) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinerSetScalingRules) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PodRef != nil {
-		l = m.PodRef.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.LastUpdated != nil {
-		l = m.LastUpdated.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.FailureReason != nil {
-		l = len(*m.FailureReason)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.FailureMessage != nil {
-		l = len(*m.FailureMessage)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.Addresses) > 0 {
-		for _, e := range m.Addresses {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.StabilizationWindowSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.StabilizationWindowSeconds))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MinerTemplateSpec) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MinerSetSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ObjectMeta) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MinerSetSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinerSetSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if m.RollbackTo != nil {
+		{
+			size, err := m.RollbackTo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	i--
+	if m.Paused {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x50
+	if m.RevisionHistoryLimit != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RevisionHistoryLimit))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size, err := m.Strategy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x42
+	if m.ProgressDeadlineSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ProgressDeadlineSeconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MinReadySeconds))
+	i--
+	dAtA[i] = 0x30
+	i -= len(m.DeletePolicy)
+	copy(dAtA[i:], m.DeletePolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DeletePolicy)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.DisplayName)
+	copy(dAtA[i:], m.DisplayName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Replicas != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Replicas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PodInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MinerSetStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.OperatingSystem)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Architecture)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func sovGenerated(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenerated(x uint64) (n int) {
-	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MinerSetStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *Chain) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&Chain{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ChainSpec", "ChainSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ChainStatus", "ChainStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ChainList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForItems := "[]Chain{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Chain", "Chain", 1), `&`, ``, 1) + ","
+
+func (m *MinerSetStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ChainList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ChainSpec) String() string {
-	if this == nil {
-		return "nil"
+	i -= len(m.TemplateHash)
+	copy(dAtA[i:], m.TemplateHash)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TemplateHash)))
+	i--
+	dAtA[i] = 0x5a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Revision))
+	i--
+	dAtA[i] = 0x50
+	i = encodeVarintGenerated(dAtA, i, uint64(m.UpdatedReplicas))
+	i--
+	dAtA[i] = 0x48
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	s := strings.Join([]string{`&ChainSpec{`,
-		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
-		`MinerType:` + fmt.Sprintf("%v", this.MinerType) + `,`,
-		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
-		`MinMineIntervalSeconds:` + fmt.Sprintf("%v", this.MinMineIntervalSeconds) + `,`,
-		`BootstrapAccount:` + valueToStringGenerated(this.BootstrapAccount) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ChainStatus) String() string {
-	if this == nil {
-		return "nil"
+	if m.FailureMessage != nil {
+		i -= len(*m.FailureMessage)
+		copy(dAtA[i:], *m.FailureMessage)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.FailureMessage)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	repeatedStringForConditions := "[]Condition{"
-	for _, f := range this.Conditions {
-		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
+	if m.FailureReason != nil {
+		i -= len(*m.FailureReason)
+		copy(dAtA[i:], *m.FailureReason)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.FailureReason)))
+		i--
+		dAtA[i] = 0x32
 	}
-	repeatedStringForConditions += "}"
-	s := strings.Join([]string{`&ChainStatus{`,
-		`ConfigMapRef:` + strings.Replace(this.ConfigMapRef.String(), "LocalObjectReference", "LocalObjectReference", 1) + `,`,
-		`MinerRef:` + strings.Replace(this.MinerRef.String(), "LocalObjectReference", "LocalObjectReference", 1) + `,`,
-		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
-		`Conditions:` + repeatedStringForConditions + `,`,
-		`}`,
-	}, "")
-	return s
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintGenerated(dAtA, i, uint64(m.AvailableReplicas))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ReadyReplicas))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.FullyLabeledReplicas))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Replicas))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
-func (this *ChargeRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MinerSetStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ChargeRequest{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ChargeRequestSpec", "ChargeRequestSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ChargeRequestStatus", "ChargeRequestStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ChargeRequestList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForItems := "[]ChargeRequest{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ChargeRequest", "ChargeRequest", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ChargeRequestList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *MinerSetStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ChargeRequestSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MinerSetStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RollingUpdate != nil {
+		{
+			size, err := m.RollingUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	s := strings.Join([]string{`&ChargeRequestSpec{`,
-		`From:` + fmt.Sprintf("%v", this.From) + `,`,
-		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
-		`}`,
-	}, "")
-	return s
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *ChargeRequestStatus) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForConditions := "[]Condition{"
-	for _, f := range this.Conditions {
-		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
+
+func (m *MinerSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForConditions += "}"
-	s := strings.Join([]string{`&ChargeRequestStatus{`,
-		`Conditions:` + repeatedStringForConditions + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *Condition) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&Condition{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Severity:` + fmt.Sprintf("%v", this.Severity) + `,`,
-		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *MinerSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *LocalObjectReference) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MinerSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PodOverrides != nil {
+		{
+			size, err := m.PodOverrides.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	s := strings.Join([]string{`&LocalObjectReference{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Miner) String() string {
-	if this == nil {
-		return "nil"
+	if m.PodDeletionTimeout != nil {
+		{
+			size, err := m.PodDeletionTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	s := strings.Join([]string{`&Miner{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "MinerSpec", "MinerSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "MinerStatus", "MinerStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MinerAddress) String() string {
-	if this == nil {
-		return "nil"
+	i -= len(m.RestartPolicy)
+	copy(dAtA[i:], m.RestartPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RestartPolicy)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.ChainName)
+	copy(dAtA[i:], m.ChainName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ChainName)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.MinerType)
+	copy(dAtA[i:], m.MinerType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MinerType)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.DisplayName)
+	copy(dAtA[i:], m.DisplayName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	s := strings.Join([]string{`&MinerAddress{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *MinerList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForItems := "[]Miner{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Miner", "Miner", 1), `&`, ``, 1) + ","
+
+func (m *MinerStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&MinerList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *MinerSet) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&MinerSet{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "MinerSetSpec", "MinerSetSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "MinerSetStatus", "MinerSetStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *MinerStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *MinerSetList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MinerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	repeatedStringForItems := "[]MinerSet{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "MinerSet", "MinerSet", 1), `&`, ``, 1) + ","
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+	i--
+	dAtA[i] = 0x38
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Addresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&MinerSetList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MinerSetSpec) String() string {
-	if this == nil {
-		return "nil"
+	if m.FailureMessage != nil {
+		i -= len(*m.FailureMessage)
+		copy(dAtA[i:], *m.FailureMessage)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.FailureMessage)))
+		i--
+		dAtA[i] = 0x22
 	}
-	s := strings.Join([]string{`&MinerSetSpec{`,
-		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
-		`Selector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1), `&`, ``, 1) + `,`,
-		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "MinerTemplateSpec", "MinerTemplateSpec", 1), `&`, ``, 1) + `,`,
-		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
-		`DeletePolicy:` + fmt.Sprintf("%v", this.DeletePolicy) + `,`,
-		`MinReadySeconds:` + fmt.Sprintf("%v", this.MinReadySeconds) + `,`,
-		`ProgressDeadlineSeconds:` + valueToStringGenerated(this.ProgressDeadlineSeconds) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MinerSetStatus) String() string {
-	if this == nil {
-		return "nil"
+	if m.FailureReason != nil {
+		i -= len(*m.FailureReason)
+		copy(dAtA[i:], *m.FailureReason)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.FailureReason)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	repeatedStringForConditions := "[]Condition{"
-	for _, f := range this.Conditions {
-		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
+	if m.LastUpdated != nil {
+		{
+			size, err := m.LastUpdated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	repeatedStringForConditions += "}"
-	s := strings.Join([]string{`&MinerSetStatus{`,
-		`Replicas:` + fmt.Sprintf("%v", this.Replicas) + `,`,
-		`FullyLabeledReplicas:` + fmt.Sprintf("%v", this.FullyLabeledReplicas) + `,`,
-		`ReadyReplicas:` + fmt.Sprintf("%v", this.ReadyReplicas) + `,`,
-		`AvailableReplicas:` + fmt.Sprintf("%v", this.AvailableReplicas) + `,`,
-		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
-		`FailureReason:` + valueToStringGenerated(this.FailureReason) + `,`,
-		`FailureMessage:` + valueToStringGenerated(this.FailureMessage) + `,`,
-		`Conditions:` + repeatedStringForConditions + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.PodRef != nil {
+		{
+			size, err := m.PodRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (this *MinerSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MinerTemplateSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&MinerSpec{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(this.ObjectMeta.String(), "ObjectMeta", "ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
-		`MinerType:` + fmt.Sprintf("%v", this.MinerType) + `,`,
-		`ChainName:` + fmt.Sprintf("%v", this.ChainName) + `,`,
-		`RestartPolicy:` + fmt.Sprintf("%v", this.RestartPolicy) + `,`,
-		`PodDeletionTimeout:` + strings.Replace(fmt.Sprintf("%v", this.PodDeletionTimeout), "Duration", "v1.Duration", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *MinerStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MinerTemplateSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinerTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	repeatedStringForAddresses := "[]MinerAddress{"
-	for _, f := range this.Addresses {
-		repeatedStringForAddresses += strings.Replace(strings.Replace(f.String(), "MinerAddress", "MinerAddress", 1), `&`, ``, 1) + ","
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	repeatedStringForAddresses += "}"
-	repeatedStringForConditions := "[]Condition{"
-	for _, f := range this.Conditions {
-		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForConditions += "}"
-	s := strings.Join([]string{`&MinerStatus{`,
-		`PodRef:` + strings.Replace(fmt.Sprintf("%v", this.PodRef), "ObjectReference", "v11.ObjectReference", 1) + `,`,
-		`LastUpdated:` + strings.Replace(fmt.Sprintf("%v", this.LastUpdated), "Time", "v1.Time", 1) + `,`,
-		`FailureReason:` + valueToStringGenerated(this.FailureReason) + `,`,
-		`FailureMessage:` + valueToStringGenerated(this.FailureMessage) + `,`,
-		`Addresses:` + repeatedStringForAddresses + `,`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
-		`Conditions:` + repeatedStringForConditions + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *MinerTemplateSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ObjectMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&MinerTemplateSpec{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(this.ObjectMeta.String(), "ObjectMeta", "ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "MinerSpec", "MinerSpec", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ObjectMeta) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ObjectMeta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Annotations) > 0 {
+		keysForAnnotations := make([]string, 0, len(m.Annotations))
+		for k := range m.Annotations {
+			keysForAnnotations = append(keysForAnnotations, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+		for iNdEx := len(keysForAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Annotations[string(keysForAnnotations[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForAnnotations[iNdEx])
+			copy(dAtA[i:], keysForAnnotations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAnnotations[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+		for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Labels[string(keysForLabels[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForLabels[iNdEx])
+			copy(dAtA[i:], keysForLabels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLabels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingChargeRequestsMetricSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	mapStringForLabels += "}"
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	return dAtA[:n], nil
+}
+
+func (m *PendingChargeRequestsMetricSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingChargeRequestsMetricSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TargetPerReplica))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *PodCPUMetricSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	return dAtA[:n], nil
+}
+
+func (m *PodCPUMetricSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PodCPUMetricSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TargetAverageUtilization))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *PodInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	mapStringForAnnotations += "}"
-	s := strings.Join([]string{`&ObjectMeta{`,
-		`Labels:` + mapStringForLabels + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *PodInfo) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PodInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PodInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Architecture)
+	copy(dAtA[i:], m.Architecture)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Architecture)))
+	i--
+	dAtA[i] = 0x52
+	i -= len(m.OperatingSystem)
+	copy(dAtA[i:], m.OperatingSystem)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OperatingSystem)))
+	i--
+	dAtA[i] = 0x4a
+	return len(dAtA) - i, nil
+}
+
+func (m *RollingUpdateMinerSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&PodInfo{`,
-		`OperatingSystem:` + fmt.Sprintf("%v", this.OperatingSystem) + `,`,
-		`Architecture:` + fmt.Sprintf("%v", this.Architecture) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+func (m *RollingUpdateMinerSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollingUpdateMinerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSurge != nil {
+		{
+			size, err := m.MaxSurge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	if m.MaxUnavailable != nil {
+		{
+			size, err := m.MaxUnavailable.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleMetricSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleMetricSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleMetricSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Replicas))
+	i--
+	dAtA[i] = 0x18
+	{
+		size, err := m.Duration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Schedule)
+	copy(dAtA[i:], m.Schedule)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedule)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Chain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ChainBlockIntervalMetricSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.TargetSeconds))
+	return n
+}
+
+func (m *ChainList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChainSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MinerType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Image)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MinMineIntervalSeconds))
+	if m.BootstrapAccount != nil {
+		l = len(*m.BootstrapAccount)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ChainStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConfigMapRef != nil {
+		l = m.ConfigMapRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MinerRef != nil {
+		l = m.MinerRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChargeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ChargeRequestList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChargeRequestSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Password)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ChainName)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Amount))
+	if m.Confirmations != nil {
+		n += 1 + sovGenerated(uint64(*m.Confirmations))
+	}
+	l = len(m.Salt)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ChargeRequestStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.TransactionID)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.SubmittedHeight))
+	n += 1 + sovGenerated(uint64(m.BlockHeight))
+	n += 1 + sovGenerated(uint64(m.Confirmations))
+	return n
+}
+
+func (m *Condition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Severity)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastTransitionTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *LocalObjectReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *Miner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MinerAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Address)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MinerClass) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MinerClassList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MinerClassPricing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.HourlyPrice))
+	return n
+}
+
+func (m *MinerClassSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Resources.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MiningDifficulty))
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Tolerations) > 0 {
+		for _, e := range m.Tolerations {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Affinity != nil {
+		l = m.Affinity.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Image)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Pricing != nil {
+		l = m.Pricing.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *MinerList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MinerPodOverrides) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Tolerations) > 0 {
+		for _, e := range m.Tolerations {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Affinity != nil {
+		l = m.Affinity.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.TopologySpreadConstraints) > 0 {
+		for _, e := range m.TopologySpreadConstraints {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.PriorityClassName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Env) > 0 {
+		for _, e := range m.Env {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MinerSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MinerSetAutoscaler) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MinerSetAutoscalerBehavior) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScaleUp != nil {
+		l = m.ScaleUp.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ScaleDown != nil {
+		l = m.ScaleDown.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *MinerSetAutoscalerList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MinerSetAutoscalerSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScaleTargetRef.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.MinReplicas != nil {
+		n += 1 + sovGenerated(uint64(*m.MinReplicas))
+	}
+	n += 1 + sovGenerated(uint64(m.MaxReplicas))
+	if len(m.Metrics) > 0 {
+		for _, e := range m.Metrics {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Behavior != nil {
+		l = m.Behavior.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *MinerSetAutoscalerStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	if m.LastScaleTime != nil {
+		l = m.LastScaleTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.CurrentReplicas))
+	n += 1 + sovGenerated(uint64(m.DesiredReplicas))
+	if len(m.CurrentMetrics) > 0 {
+		for _, e := range m.CurrentMetrics {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MinerSetList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MinerSetMetricSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.PodCPU != nil {
+		l = m.PodCPU.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ChainBlockInterval != nil {
+		l = m.ChainBlockInterval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PendingChargeRequests != nil {
+		l = m.PendingChargeRequests.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *MinerSetMetricStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Current.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.DesiredReplicas != nil {
+		n += 1 + sovGenerated(uint64(*m.DesiredReplicas))
+	}
+	return n
+}
+
+func (m *MinerSetRevision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Revision))
+	l = len(m.TemplateHash)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.CreationTimestamp.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MinerSetRollback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Revision))
+	return n
+}
+
+func (m *MinerSetScalingRules) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StabilizationWindowSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.StabilizationWindowSeconds))
+	}
+	return n
+}
+
+func (m *MinerSetSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Replicas != nil {
+		n += 1 + sovGenerated(uint64(*m.Replicas))
+	}
+	l = m.Selector.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Template.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DeletePolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MinReadySeconds))
+	if m.ProgressDeadlineSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.ProgressDeadlineSeconds))
+	}
+	l = m.Strategy.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.RevisionHistoryLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.RevisionHistoryLimit))
+	}
+	n += 2
+	if m.RollbackTo != nil {
+		l = m.RollbackTo.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *MinerSetStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Replicas))
+	n += 1 + sovGenerated(uint64(m.FullyLabeledReplicas))
+	n += 1 + sovGenerated(uint64(m.ReadyReplicas))
+	n += 1 + sovGenerated(uint64(m.AvailableReplicas))
+	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	if m.FailureReason != nil {
+		l = len(*m.FailureReason)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.FailureMessage != nil {
+		l = len(*m.FailureMessage)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.UpdatedReplicas))
+	n += 1 + sovGenerated(uint64(m.Revision))
+	l = len(m.TemplateHash)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MinerSetStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.RollingUpdate != nil {
+		l = m.RollingUpdate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *MinerSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MinerType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ChainName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RestartPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.PodDeletionTimeout != nil {
+		l = m.PodDeletionTimeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PodOverrides != nil {
+		l = m.PodOverrides.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *MinerStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PodRef != nil {
+		l = m.PodRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.LastUpdated != nil {
+		l = m.LastUpdated.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.FailureReason != nil {
+		l = len(*m.FailureReason)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.FailureMessage != nil {
+		l = len(*m.FailureMessage)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, e := range m.Addresses {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MinerTemplateSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ObjectMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *PendingChargeRequestsMetricSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.TargetPerReplica))
+	return n
+}
+
+func (m *PodCPUMetricSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.TargetAverageUtilization))
+	return n
+}
+
+func (m *PodInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatingSystem)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Architecture)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RollingUpdateMinerSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxUnavailable != nil {
+		l = m.MaxUnavailable.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MaxSurge != nil {
+		l = m.MaxSurge.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduleMetricSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Schedule)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Duration.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Replicas))
+	return n
+}
+
+func sovGenerated(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenerated(x uint64) (n int) {
+	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Chain) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Chain{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ChainSpec", "ChainSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ChainStatus", "ChainStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChainBlockIntervalMetricSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChainBlockIntervalMetricSource{`,
+		`TargetSeconds:` + fmt.Sprintf("%v", this.TargetSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChainList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Chain{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Chain", "Chain", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ChainList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChainSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChainSpec{`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`MinerType:` + fmt.Sprintf("%v", this.MinerType) + `,`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`MinMineIntervalSeconds:` + fmt.Sprintf("%v", this.MinMineIntervalSeconds) + `,`,
+		`BootstrapAccount:` + valueToStringGenerated(this.BootstrapAccount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChainStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForConditions += "}"
+	s := strings.Join([]string{`&ChainStatus{`,
+		`ConfigMapRef:` + strings.Replace(this.ConfigMapRef.String(), "LocalObjectReference", "LocalObjectReference", 1) + `,`,
+		`MinerRef:` + strings.Replace(this.MinerRef.String(), "LocalObjectReference", "LocalObjectReference", 1) + `,`,
+		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChargeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChargeRequest{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ChargeRequestSpec", "ChargeRequestSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ChargeRequestStatus", "ChargeRequestStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChargeRequestList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ChargeRequest{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ChargeRequest", "ChargeRequest", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ChargeRequestList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChargeRequestSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChargeRequestSpec{`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
+		`ChainName:` + fmt.Sprintf("%v", this.ChainName) + `,`,
+		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
+		`Confirmations:` + valueToStringGenerated(this.Confirmations) + `,`,
+		`Salt:` + fmt.Sprintf("%v", this.Salt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChargeRequestStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForConditions += "}"
+	s := strings.Join([]string{`&ChargeRequestStatus{`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`TransactionID:` + fmt.Sprintf("%v", this.TransactionID) + `,`,
+		`SubmittedHeight:` + fmt.Sprintf("%v", this.SubmittedHeight) + `,`,
+		`BlockHeight:` + fmt.Sprintf("%v", this.BlockHeight) + `,`,
+		`Confirmations:` + fmt.Sprintf("%v", this.Confirmations) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Condition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Condition{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Severity:` + fmt.Sprintf("%v", this.Severity) + `,`,
+		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LocalObjectReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LocalObjectReference{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Miner) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Miner{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "MinerSpec", "MinerSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "MinerStatus", "MinerStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerAddress) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MinerAddress{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerClass) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MinerClass{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "MinerClassSpec", "MinerClassSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerClassList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]MinerClass{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "MinerClass", "MinerClass", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&MinerClassList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerClassPricing) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MinerClassPricing{`,
+		`HourlyPrice:` + fmt.Sprintf("%v", this.HourlyPrice) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerClassSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTolerations := "[]Toleration{"
+	for _, f := range this.Tolerations {
+		repeatedStringForTolerations += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForTolerations += "}"
+	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
+	for k := range this.NodeSelector {
+		keysForNodeSelector = append(keysForNodeSelector, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
+	mapStringForNodeSelector := "map[string]string{"
+	for _, k := range keysForNodeSelector {
+		mapStringForNodeSelector += fmt.Sprintf("%v: %v,", k, this.NodeSelector[k])
+	}
+	mapStringForNodeSelector += "}"
+	s := strings.Join([]string{`&MinerClassSpec{`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`Resources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceRequirements", "v11.ResourceRequirements", 1), `&`, ``, 1) + `,`,
+		`MiningDifficulty:` + fmt.Sprintf("%v", this.MiningDifficulty) + `,`,
+		`NodeSelector:` + mapStringForNodeSelector + `,`,
+		`Tolerations:` + repeatedStringForTolerations + `,`,
+		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v11.Affinity", 1) + `,`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`Pricing:` + strings.Replace(this.Pricing.String(), "MinerClassPricing", "MinerClassPricing", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Miner{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Miner", "Miner", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&MinerList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerPodOverrides) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTolerations := "[]Toleration{"
+	for _, f := range this.Tolerations {
+		repeatedStringForTolerations += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForTolerations += "}"
+	repeatedStringForTopologySpreadConstraints := "[]TopologySpreadConstraint{"
+	for _, f := range this.TopologySpreadConstraints {
+		repeatedStringForTopologySpreadConstraints += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForTopologySpreadConstraints += "}"
+	repeatedStringForEnv := "[]EnvVar{"
+	for _, f := range this.Env {
+		repeatedStringForEnv += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForEnv += "}"
+	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
+	for k := range this.NodeSelector {
+		keysForNodeSelector = append(keysForNodeSelector, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
+	mapStringForNodeSelector := "map[string]string{"
+	for _, k := range keysForNodeSelector {
+		mapStringForNodeSelector += fmt.Sprintf("%v: %v,", k, this.NodeSelector[k])
+	}
+	mapStringForNodeSelector += "}"
+	s := strings.Join([]string{`&MinerPodOverrides{`,
+		`NodeSelector:` + mapStringForNodeSelector + `,`,
+		`Tolerations:` + repeatedStringForTolerations + `,`,
+		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v11.Affinity", 1) + `,`,
+		`TopologySpreadConstraints:` + repeatedStringForTopologySpreadConstraints + `,`,
+		`PriorityClassName:` + fmt.Sprintf("%v", this.PriorityClassName) + `,`,
+		`Env:` + repeatedStringForEnv + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerSet) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MinerSet{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "MinerSetSpec", "MinerSetSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "MinerSetStatus", "MinerSetStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerSetAutoscaler) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MinerSetAutoscaler{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "MinerSetAutoscalerSpec", "MinerSetAutoscalerSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "MinerSetAutoscalerStatus", "MinerSetAutoscalerStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerSetAutoscalerBehavior) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MinerSetAutoscalerBehavior{`,
+		`ScaleUp:` + strings.Replace(this.ScaleUp.String(), "MinerSetScalingRules", "MinerSetScalingRules", 1) + `,`,
+		`ScaleDown:` + strings.Replace(this.ScaleDown.String(), "MinerSetScalingRules", "MinerSetScalingRules", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerSetAutoscalerList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]MinerSetAutoscaler{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "MinerSetAutoscaler", "MinerSetAutoscaler", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&MinerSetAutoscalerList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerSetAutoscalerSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMetrics := "[]MinerSetMetricSpec{"
+	for _, f := range this.Metrics {
+		repeatedStringForMetrics += strings.Replace(strings.Replace(f.String(), "MinerSetMetricSpec", "MinerSetMetricSpec", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMetrics += "}"
+	s := strings.Join([]string{`&MinerSetAutoscalerSpec{`,
+		`ScaleTargetRef:` + strings.Replace(strings.Replace(this.ScaleTargetRef.String(), "LocalObjectReference", "LocalObjectReference", 1), `&`, ``, 1) + `,`,
+		`MinReplicas:` + valueToStringGenerated(this.MinReplicas) + `,`,
+		`MaxReplicas:` + fmt.Sprintf("%v", this.MaxReplicas) + `,`,
+		`Metrics:` + repeatedStringForMetrics + `,`,
+		`Behavior:` + strings.Replace(this.Behavior.String(), "MinerSetAutoscalerBehavior", "MinerSetAutoscalerBehavior", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerSetAutoscalerStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCurrentMetrics := "[]MinerSetMetricStatus{"
+	for _, f := range this.CurrentMetrics {
+		repeatedStringForCurrentMetrics += strings.Replace(strings.Replace(f.String(), "MinerSetMetricStatus", "MinerSetMetricStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCurrentMetrics += "}"
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForConditions += "}"
+	s := strings.Join([]string{`&MinerSetAutoscalerStatus{`,
+		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
+		`LastScaleTime:` + strings.Replace(fmt.Sprintf("%v", this.LastScaleTime), "Time", "v1.Time", 1) + `,`,
+		`CurrentReplicas:` + fmt.Sprintf("%v", this.CurrentReplicas) + `,`,
+		`DesiredReplicas:` + fmt.Sprintf("%v", this.DesiredReplicas) + `,`,
+		`CurrentMetrics:` + repeatedStringForCurrentMetrics + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerSetList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]MinerSet{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "MinerSet", "MinerSet", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&MinerSetList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerSetMetricSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MinerSetMetricSpec{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`PodCPU:` + strings.Replace(this.PodCPU.String(), "PodCPUMetricSource", "PodCPUMetricSource", 1) + `,`,
+		`ChainBlockInterval:` + strings.Replace(this.ChainBlockInterval.String(), "ChainBlockIntervalMetricSource", "ChainBlockIntervalMetricSource", 1) + `,`,
+		`PendingChargeRequests:` + strings.Replace(this.PendingChargeRequests.String(), "PendingChargeRequestsMetricSource", "PendingChargeRequestsMetricSource", 1) + `,`,
+		`Schedule:` + strings.Replace(this.Schedule.String(), "ScheduleMetricSource", "ScheduleMetricSource", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerSetMetricStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MinerSetMetricStatus{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Current:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Current), "Quantity", "resource.Quantity", 1), `&`, ``, 1) + `,`,
+		`DesiredReplicas:` + valueToStringGenerated(this.DesiredReplicas) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerSetRevision) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MinerSetRevision{`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`TemplateHash:` + fmt.Sprintf("%v", this.TemplateHash) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "MinerSpec", "MinerSpec", 1), `&`, ``, 1) + `,`,
+		`CreationTimestamp:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreationTimestamp), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerSetRollback) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MinerSetRollback{`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerSetScalingRules) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MinerSetScalingRules{`,
+		`StabilizationWindowSeconds:` + valueToStringGenerated(this.StabilizationWindowSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerSetSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MinerSetSpec{`,
+		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
+		`Selector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1), `&`, ``, 1) + `,`,
+		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "MinerTemplateSpec", "MinerTemplateSpec", 1), `&`, ``, 1) + `,`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`DeletePolicy:` + fmt.Sprintf("%v", this.DeletePolicy) + `,`,
+		`MinReadySeconds:` + fmt.Sprintf("%v", this.MinReadySeconds) + `,`,
+		`ProgressDeadlineSeconds:` + valueToStringGenerated(this.ProgressDeadlineSeconds) + `,`,
+		`Strategy:` + strings.Replace(strings.Replace(this.Strategy.String(), "MinerSetStrategy", "MinerSetStrategy", 1), `&`, ``, 1) + `,`,
+		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
+		`RollbackTo:` + strings.Replace(this.RollbackTo.String(), "MinerSetRollback", "MinerSetRollback", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerSetStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForConditions += "}"
+	repeatedStringForHistory := "[]MinerSetRevision{"
+	for _, f := range this.History {
+		repeatedStringForHistory += strings.Replace(strings.Replace(f.String(), "MinerSetRevision", "MinerSetRevision", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHistory += "}"
+	s := strings.Join([]string{`&MinerSetStatus{`,
+		`Replicas:` + fmt.Sprintf("%v", this.Replicas) + `,`,
+		`FullyLabeledReplicas:` + fmt.Sprintf("%v", this.FullyLabeledReplicas) + `,`,
+		`ReadyReplicas:` + fmt.Sprintf("%v", this.ReadyReplicas) + `,`,
+		`AvailableReplicas:` + fmt.Sprintf("%v", this.AvailableReplicas) + `,`,
+		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
+		`FailureReason:` + valueToStringGenerated(this.FailureReason) + `,`,
+		`FailureMessage:` + valueToStringGenerated(this.FailureMessage) + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`UpdatedReplicas:` + fmt.Sprintf("%v", this.UpdatedReplicas) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`TemplateHash:` + fmt.Sprintf("%v", this.TemplateHash) + `,`,
+		`History:` + repeatedStringForHistory + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerSetStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MinerSetStrategy{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`RollingUpdate:` + strings.Replace(this.RollingUpdate.String(), "RollingUpdateMinerSet", "RollingUpdateMinerSet", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MinerSpec{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(this.ObjectMeta.String(), "ObjectMeta", "ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`MinerType:` + fmt.Sprintf("%v", this.MinerType) + `,`,
+		`ChainName:` + fmt.Sprintf("%v", this.ChainName) + `,`,
+		`RestartPolicy:` + fmt.Sprintf("%v", this.RestartPolicy) + `,`,
+		`PodDeletionTimeout:` + strings.Replace(fmt.Sprintf("%v", this.PodDeletionTimeout), "Duration", "v1.Duration", 1) + `,`,
+		`PodOverrides:` + strings.Replace(this.PodOverrides.String(), "MinerPodOverrides", "MinerPodOverrides", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAddresses := "[]MinerAddress{"
+	for _, f := range this.Addresses {
+		repeatedStringForAddresses += strings.Replace(strings.Replace(f.String(), "MinerAddress", "MinerAddress", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAddresses += "}"
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForConditions += "}"
+	s := strings.Join([]string{`&MinerStatus{`,
+		`PodRef:` + strings.Replace(fmt.Sprintf("%v", this.PodRef), "ObjectReference", "v11.ObjectReference", 1) + `,`,
+		`LastUpdated:` + strings.Replace(fmt.Sprintf("%v", this.LastUpdated), "Time", "v1.Time", 1) + `,`,
+		`FailureReason:` + valueToStringGenerated(this.FailureReason) + `,`,
+		`FailureMessage:` + valueToStringGenerated(this.FailureMessage) + `,`,
+		`Addresses:` + repeatedStringForAddresses + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinerTemplateSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MinerTemplateSpec{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(this.ObjectMeta.String(), "ObjectMeta", "ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "MinerSpec", "MinerSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ObjectMeta) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&ObjectMeta{`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PendingChargeRequestsMetricSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PendingChargeRequestsMetricSource{`,
+		`TargetPerReplica:` + fmt.Sprintf("%v", this.TargetPerReplica) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodCPUMetricSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PodCPUMetricSource{`,
+		`TargetAverageUtilization:` + fmt.Sprintf("%v", this.TargetAverageUtilization) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PodInfo{`,
+		`OperatingSystem:` + fmt.Sprintf("%v", this.OperatingSystem) + `,`,
+		`Architecture:` + fmt.Sprintf("%v", this.Architecture) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RollingUpdateMinerSet) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RollingUpdateMinerSet{`,
+		`MaxUnavailable:` + strings.Replace(fmt.Sprintf("%v", this.MaxUnavailable), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`MaxSurge:` + strings.Replace(fmt.Sprintf("%v", this.MaxSurge), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduleMetricSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduleMetricSource{`,
+		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
+		`Duration:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "v1.Duration", 1), `&`, ``, 1) + `,`,
+		`Replicas:` + fmt.Sprintf("%v", this.Replicas) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Chain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Chain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Chain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainBlockIntervalMetricSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainBlockIntervalMetricSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainBlockIntervalMetricSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSeconds", wireType)
+			}
+			m.TargetSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetSeconds |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Chain{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinerType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinerType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMineIntervalSeconds", wireType)
+			}
+			m.MinMineIntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinMineIntervalSeconds |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BootstrapAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.BootstrapAccount = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigMapRef == nil {
+				m.ConfigMapRef = &LocalObjectReference{}
+			}
+			if err := m.ConfigMapRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinerRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinerRef == nil {
+				m.MinerRef = &LocalObjectReference{}
+			}
+			if err := m.MinerRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChargeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChargeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChargeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChargeRequestList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChargeRequestList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChargeRequestList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ChargeRequest{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChargeRequestSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChargeRequestSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChargeRequestSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Confirmations = &v
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChargeRequestStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChargeRequestStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChargeRequestStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransactionID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedHeight", wireType)
+			}
+			m.SubmittedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmittedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			m.Confirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confirmations |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Condition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = ConditionType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = k8s_io_api_core_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Severity = ConditionSeverity(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LocalObjectReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocalObjectReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocalObjectReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Miner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Miner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Miner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinerAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinerAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinerAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = MinerAddressType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinerClass) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinerClass: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinerClass: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinerClassList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinerClassList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinerClassList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, MinerClass{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinerClassPricing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinerClassPricing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinerClassPricing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HourlyPrice", wireType)
+			}
+			m.HourlyPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HourlyPrice |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinerClassSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinerClassSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinerClassSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiningDifficulty", wireType)
+			}
+			m.MiningDifficulty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MiningDifficulty |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tolerations = append(m.Tolerations, v11.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Affinity == nil {
+				m.Affinity = &v11.Affinity{}
+			}
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pricing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pricing == nil {
+				m.Pricing = &MinerClassPricing{}
+			}
+			if err := m.Pricing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinerList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinerList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinerList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Miner{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinerPodOverrides) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinerPodOverrides: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinerPodOverrides: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tolerations = append(m.Tolerations, v11.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Affinity == nil {
+				m.Affinity = &v11.Affinity{}
+			}
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopologySpreadConstraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopologySpreadConstraints = append(m.TopologySpreadConstraints, v11.TopologySpreadConstraint{})
+			if err := m.TopologySpreadConstraints[len(m.TopologySpreadConstraints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, v11.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinerSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinerSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinerSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinerSetAutoscaler) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinerSetAutoscaler: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinerSetAutoscaler: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Chain) Unmarshal(dAtA []byte) error {
+func (m *MinerSetAutoscalerBehavior) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2743,15 +8773,15 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Chain: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinerSetAutoscalerBehavior: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Chain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinerSetAutoscalerBehavior: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleUp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2778,13 +8808,16 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ScaleUp == nil {
+				m.ScaleUp = &MinerSetScalingRules{}
+			}
+			if err := m.ScaleUp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDown", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2811,13 +8844,66 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ScaleDown == nil {
+				m.ScaleDown = &MinerSetScalingRules{}
+			}
+			if err := m.ScaleDown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinerSetAutoscalerList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinerSetAutoscalerList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinerSetAutoscalerList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2844,7 +8930,41 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, MinerSetAutoscaler{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2869,7 +8989,7 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainList) Unmarshal(dAtA []byte) error {
+func (m *MinerSetAutoscalerSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2892,15 +9012,15 @@ func (m *ChainList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinerSetAutoscalerSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinerSetAutoscalerSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleTargetRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2927,13 +9047,52 @@ func (m *ChainList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScaleTargetRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReplicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinReplicas = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReplicas", wireType)
+			}
+			m.MaxReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2960,8 +9119,44 @@ func (m *ChainList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Chain{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Metrics = append(m.Metrics, MinerSetMetricSpec{})
+			if err := m.Metrics[len(m.Metrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Behavior", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Behavior == nil {
+				m.Behavior = &MinerSetAutoscalerBehavior{}
+			}
+			if err := m.Behavior.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2986,7 +9181,7 @@ func (m *ChainList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainSpec) Unmarshal(dAtA []byte) error {
+func (m *MinerSetAutoscalerStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3009,17 +9204,17 @@ func (m *ChainSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinerSetAutoscalerStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinerSetAutoscalerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
 			}
-			var stringLen uint64
+			m.ObservedGeneration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3029,29 +9224,16 @@ func (m *ChainSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ObservedGeneration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinerType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScaleTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3061,29 +9243,33 @@ func (m *ChainSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinerType = string(dAtA[iNdEx:postIndex])
+			if m.LastScaleTime == nil {
+				m.LastScaleTime = &v1.Time{}
+			}
+			if err := m.LastScaleTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReplicas", wireType)
 			}
-			var stringLen uint64
+			m.CurrentReplicas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3093,29 +9279,16 @@ func (m *ChainSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CurrentReplicas |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Image = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinMineIntervalSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredReplicas", wireType)
 			}
-			m.MinMineIntervalSeconds = 0
+			m.DesiredReplicas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3125,16 +9298,50 @@ func (m *ChainSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinMineIntervalSeconds |= int32(b&0x7F) << shift
+				m.DesiredReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentMetrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentMetrics = append(m.CurrentMetrics, MinerSetMetricStatus{})
+			if err := m.CurrentMetrics[len(m.CurrentMetrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BootstrapAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3144,24 +9351,25 @@ func (m *ChainSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.BootstrapAccount = &s
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3184,7 +9392,7 @@ func (m *ChainSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainStatus) Unmarshal(dAtA []byte) error {
+func (m *MinerSetList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3207,15 +9415,15 @@ func (m *ChainStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinerSetList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinerSetList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3242,71 +9450,13 @@ func (m *ChainStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigMapRef == nil {
-				m.ConfigMapRef = &LocalObjectReference{}
-			}
-			if err := m.ConfigMapRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinerRef", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MinerRef == nil {
-				m.MinerRef = &LocalObjectReference{}
-			}
-			if err := m.MinerRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
-			}
-			m.ObservedGeneration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3333,8 +9483,8 @@ func (m *ChainStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, MinerSet{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3359,7 +9509,7 @@ func (m *ChainStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChargeRequest) Unmarshal(dAtA []byte) error {
+func (m *MinerSetMetricSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3382,17 +9532,17 @@ func (m *ChargeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChargeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinerSetMetricSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChargeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinerSetMetricSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3402,28 +9552,27 @@ func (m *ChargeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = MinerSetMetricSourceType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodCPU", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3450,13 +9599,16 @@ func (m *ChargeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PodCPU == nil {
+				m.PodCPU = &PodCPUMetricSource{}
+			}
+			if err := m.PodCPU.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainBlockInterval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3483,63 +9635,16 @@ func (m *ChargeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.ChainBlockInterval == nil {
+				m.ChainBlockInterval = &ChainBlockIntervalMetricSource{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ChainBlockInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChargeRequestList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChargeRequestList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChargeRequestList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChargeRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3566,13 +9671,16 @@ func (m *ChargeRequestList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PendingChargeRequests == nil {
+				m.PendingChargeRequests = &PendingChargeRequestsMetricSource{}
+			}
+			if err := m.PendingChargeRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3599,8 +9707,10 @@ func (m *ChargeRequestList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ChargeRequest{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Schedule == nil {
+				m.Schedule = &ScheduleMetricSource{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3625,7 +9735,7 @@ func (m *ChargeRequestList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChargeRequestSpec) Unmarshal(dAtA []byte) error {
+func (m *MinerSetMetricStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3648,15 +9758,15 @@ func (m *ChargeRequestSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChargeRequestSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinerSetMetricStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChargeRequestSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinerSetMetricStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3684,13 +9794,13 @@ func (m *ChargeRequestSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Type = MinerSetMetricSourceType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3700,79 +9810,30 @@ func (m *ChargeRequestSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChargeRequestStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChargeRequestStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChargeRequestStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredReplicas", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3782,26 +9843,12 @@ func (m *ChargeRequestStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Conditions = append(m.Conditions, Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.DesiredReplicas = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -3823,7 +9870,7 @@ func (m *ChargeRequestStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Condition) Unmarshal(dAtA []byte) error {
+func (m *MinerSetRevision) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3846,17 +9893,17 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinerSetRevision: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinerSetRevision: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var stringLen uint64
+			m.Revision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3866,27 +9913,14 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Revision |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = ConditionType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3914,13 +9948,13 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = k8s_io_api_core_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			m.TemplateHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3930,27 +9964,28 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Severity = ConditionSeverity(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTimestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3977,47 +10012,65 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreationTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinerSetRollback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinerSetRollback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinerSetRollback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4027,24 +10080,11 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Revision |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -4066,7 +10106,7 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LocalObjectReference) Unmarshal(dAtA []byte) error {
+func (m *MinerSetScalingRules) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4089,17 +10129,17 @@ func (m *LocalObjectReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LocalObjectReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinerSetScalingRules: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LocalObjectReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinerSetScalingRules: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilizationWindowSeconds", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4109,24 +10149,12 @@ func (m *LocalObjectReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.StabilizationWindowSeconds = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -4148,7 +10176,7 @@ func (m *LocalObjectReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Miner) Unmarshal(dAtA []byte) error {
+func (m *MinerSetSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4171,17 +10199,17 @@ func (m *Miner) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Miner: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinerSetSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Miner: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinerSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4191,28 +10219,15 @@ func (m *Miner) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Replicas = &v
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4239,13 +10254,13 @@ func (m *Miner) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4272,63 +10287,13 @@ func (m *Miner) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MinerAddress) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MinerAddress: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MinerAddress: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4356,11 +10321,11 @@ func (m *MinerAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = MinerAddressType(dAtA[iNdEx:postIndex])
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletePolicy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4388,61 +10353,50 @@ func (m *MinerAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.DeletePolicy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReadySeconds", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MinerList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.MinReadySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinReadySeconds |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineSeconds", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MinerList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MinerList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ProgressDeadlineSeconds = &v
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4469,13 +10423,53 @@ func (m *MinerList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RevisionHistoryLimit = &v
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackTo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4502,8 +10496,10 @@ func (m *MinerList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Miner{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RollbackTo == nil {
+				m.RollbackTo = &MinerSetRollback{}
+			}
+			if err := m.RollbackTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4528,7 +10524,7 @@ func (m *MinerList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MinerSet) Unmarshal(dAtA []byte) error {
+func (m *MinerSetStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4551,17 +10547,112 @@ func (m *MinerSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MinerSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinerSetStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MinerSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinerSetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullyLabeledReplicas", wireType)
+			}
+			m.FullyLabeledReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FullyLabeledReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyReplicas", wireType)
+			}
+			m.ReadyReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableReplicas", wireType)
+			}
+			m.AvailableReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AvailableReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4571,30 +10662,30 @@ func (m *MinerSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := github_com_superproj_onex_pkg_errors.MinerSetStatusError(dAtA[iNdEx:postIndex])
+			m.FailureReason = &s
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureMessage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4604,28 +10695,28 @@ func (m *MinerSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.FailureMessage = &s
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4652,65 +10743,54 @@ func (m *MinerSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedReplicas", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MinerSetList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.UpdatedReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MinerSetList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MinerSetList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4720,28 +10800,27 @@ func (m *MinerSetList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TemplateHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4768,8 +10847,8 @@ func (m *MinerSetList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, MinerSet{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.History = append(m.History, MinerSetRevision{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4794,7 +10873,7 @@ func (m *MinerSetList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MinerSetSpec) Unmarshal(dAtA []byte) error {
+func (m *MinerSetStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4817,133 +10896,15 @@ func (m *MinerSetSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MinerSetSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinerSetStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MinerSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinerSetStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Replicas = &v
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletePolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4971,32 +10932,13 @@ func (m *MinerSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeletePolicy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinReadySeconds", wireType)
-			}
-			m.MinReadySeconds = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinReadySeconds |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineSeconds", wireType)
+			m.Type = MinerSetStrategyType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollingUpdate", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5006,12 +10948,28 @@ func (m *MinerSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ProgressDeadlineSeconds = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RollingUpdate == nil {
+				m.RollingUpdate = &RollingUpdateMinerSet{}
+			}
+			if err := m.RollingUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -5033,7 +10991,7 @@ func (m *MinerSetSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MinerSetStatus) Unmarshal(dAtA []byte) error {
+func (m *MinerSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5056,17 +11014,17 @@ func (m *MinerSetStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MinerSetStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinerSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MinerSetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinerSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			m.Replicas = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5076,16 +11034,30 @@ func (m *MinerSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Replicas |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FullyLabeledReplicas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
 			}
-			m.FullyLabeledReplicas = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5095,16 +11067,29 @@ func (m *MinerSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FullyLabeledReplicas |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadyReplicas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinerType", wireType)
 			}
-			m.ReadyReplicas = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5114,35 +11099,29 @@ func (m *MinerSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadyReplicas |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableReplicas", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.AvailableReplicas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AvailableReplicas |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.MinerType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainName", wireType)
 			}
-			m.ObservedGeneration = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5152,14 +11131,27 @@ func (m *MinerSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartPolicy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5187,14 +11179,13 @@ func (m *MinerSetStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := github_com_superproj_onex_pkg_errors.MinerSetStatusError(dAtA[iNdEx:postIndex])
-			m.FailureReason = &s
+			m.RestartPolicy = k8s_io_api_core_v1.RestartPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodDeletionTimeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5204,28 +11195,31 @@ func (m *MinerSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.FailureMessage = &s
+			if m.PodDeletionTimeout == nil {
+				m.PodDeletionTimeout = &v1.Duration{}
+			}
+			if err := m.PodDeletionTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodOverrides", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5252,8 +11246,10 @@ func (m *MinerSetStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PodOverrides == nil {
+				m.PodOverrides = &MinerPodOverrides{}
+			}
+			if err := m.PodOverrides.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5278,7 +11274,7 @@ func (m *MinerSetStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MinerSpec) Unmarshal(dAtA []byte) error {
+func (m *MinerStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5301,15 +11297,15 @@ func (m *MinerSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MinerSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinerStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MinerSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5336,13 +11332,52 @@ func (m *MinerSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PodRef == nil {
+				m.PodRef = &k8s_io_api_core_v1.ObjectReference{}
+			}
+			if err := m.PodRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUpdated == nil {
+				m.LastUpdated = &v1.Time{}
+			}
+			if err := m.LastUpdated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5370,11 +11405,12 @@ func (m *MinerSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			s := github_com_superproj_onex_pkg_errors.MinerStatusError(dAtA[iNdEx:postIndex])
+			m.FailureReason = &s
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinerType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureMessage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5402,11 +11438,46 @@ func (m *MinerSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinerType = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.FailureMessage = &s
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, MinerAddress{})
+			if err := m.Addresses[len(m.Addresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5434,13 +11505,13 @@ func (m *MinerSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainName = string(dAtA[iNdEx:postIndex])
+			m.Phase = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestartPolicy", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
 			}
-			var stringLen uint64
+			m.ObservedGeneration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5450,27 +11521,14 @@ func (m *MinerSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ObservedGeneration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RestartPolicy = k8s_io_api_core_v1.RestartPolicy(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodDeletionTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5497,10 +11555,8 @@ func (m *MinerSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PodDeletionTimeout == nil {
-				m.PodDeletionTimeout = &v1.Duration{}
-			}
-			if err := m.PodDeletionTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5525,7 +11581,7 @@ func (m *MinerSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MinerStatus) Unmarshal(dAtA []byte) error {
+func (m *MinerTemplateSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5548,15 +11604,15 @@ func (m *MinerStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MinerStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinerTemplateSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MinerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinerTemplateSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5583,16 +11639,13 @@ func (m *MinerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PodRef == nil {
-				m.PodRef = &k8s_io_api_core_v1.ObjectReference{}
-			}
-			if err := m.PodRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5619,82 +11672,63 @@ func (m *MinerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastUpdated == nil {
-				m.LastUpdated = &v1.Time{}
-			}
-			if err := m.LastUpdated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := github_com_superproj_onex_pkg_errors.MinerStatusError(dAtA[iNdEx:postIndex])
-			m.FailureReason = &s
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureMessage", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.FailureMessage = &s
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5721,16 +11755,109 @@ func (m *MinerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, MinerAddress{})
-			if err := m.Addresses[len(m.Addresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5740,29 +11867,174 @@ func (m *MinerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = string(dAtA[iNdEx:postIndex])
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingChargeRequestsMetricSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingChargeRequestsMetricSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingChargeRequestsMetricSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPerReplica", wireType)
 			}
-			m.ObservedGeneration = 0
+			m.TargetPerReplica = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5772,16 +12044,66 @@ func (m *MinerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
+				m.TargetPerReplica |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodCPUMetricSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodCPUMetricSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodCPUMetricSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAverageUtilization", wireType)
+			}
+			m.TargetAverageUtilization = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5791,26 +12113,11 @@ func (m *MinerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TargetAverageUtilization |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Conditions = append(m.Conditions, Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -5832,7 +12139,7 @@ func (m *MinerStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MinerTemplateSpec) Unmarshal(dAtA []byte) error {
+func (m *PodInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5855,17 +12162,17 @@ func (m *MinerTemplateSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MinerTemplateSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MinerTemplateSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatingSystem", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5875,30 +12182,29 @@ func (m *MinerTemplateSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OperatingSystem = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Architecture", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5908,24 +12214,23 @@ func (m *MinerTemplateSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Architecture = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5948,7 +12253,7 @@ func (m *MinerTemplateSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
+func (m *RollingUpdateMinerSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5971,15 +12276,15 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: RollingUpdateMinerSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RollingUpdateMinerSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6006,107 +12311,16 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.MaxUnavailable == nil {
+				m.MaxUnavailable = &intstr.IntOrString{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.MaxUnavailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSurge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6133,103 +12347,12 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.MaxSurge == nil {
+				m.MaxSurge = &intstr.IntOrString{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.MaxSurge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6252,7 +12375,7 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodInfo) Unmarshal(dAtA []byte) error {
+func (m *ScheduleMetricSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6275,15 +12398,15 @@ func (m *PodInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduleMetricSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduleMetricSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 9:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatingSystem", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6311,13 +12434,13 @@ func (m *PodInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatingSystem = string(dAtA[iNdEx:postIndex])
+			m.Schedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Architecture", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6327,24 +12450,44 @@ func (m *PodInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Architecture = string(dAtA[iNdEx:postIndex])
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -74,6 +74,56 @@ type MinerSpec struct {
 	// Defaults to 10 seconds.
 	// +optional
 	PodDeletionTimeout *metav1.Duration `json:"podDeletionTimeout,omitempty" protobuf:"bytes,7,opt,name=podDeletionTimeout"`
+
+	// PodOverrides are merged into the Pod created for the miner.
+	// Use ObjectMeta to set the labels and annotations of the Pod.
+	// +optional
+	PodOverrides *MinerPodOverrides `json:"podOverrides,omitempty" protobuf:"bytes,8,opt,name=podOverrides"`
+}
+
+// MinerPodOverrides is the restricted set of Pod fields which can be customized for a miner.
+// The containers, ports and resources of the Pod are always generated by the miner controller.
+type MinerPodOverrides struct {
+	// NodeSelector is a selector which must be true for the Pod to fit on a node.
+	// Selector which must match a node's labels for the pod to be scheduled on that node.
+	// More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
+	// +optional
+	// +mapType=atomic
+	NodeSelector map[string]string `json:"nodeSelector,omitempty" protobuf:"bytes,1,rep,name=nodeSelector"`
+
+	// If specified, the Pod's tolerations.
+	// +optional
+	// +listType=atomic
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,2,rep,name=tolerations"`
+
+	// If specified, the Pod's scheduling constraints.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty" protobuf:"bytes,3,opt,name=affinity"`
+
+	// TopologySpreadConstraints describes how the miner Pods ought to spread across topology
+	// domains, e.g. nodes or zones. The label selectors of the constraints match the labels
+	// set through the ObjectMeta of the miner.
+	// +optional
+	// +patchMergeKey=topologyKey
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=topologyKey
+	// +listMapKey=whenUnsatisfiable
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty" patchStrategy:"merge" patchMergeKey:"topologyKey" protobuf:"bytes,4,rep,name=topologySpreadConstraints"`
+
+	// If specified, indicates the Pod's priority. The "system-" prefixed priority classes
+	// are reserved for the system and cannot be used.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty" protobuf:"bytes,5,opt,name=priorityClassName"`
+
+	// Env is a list of extra environment variables to set in the miner container.
+	// Only literal values and references to the fields and resources of the Pod are allowed.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=name
+	Env []corev1.EnvVar `json:"env,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,6,rep,name=env"`
 }
 
 // MinerStatus defines the observed state of Miner.
@@ -0,0 +1,95 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MinerClass describes a machine configuration of the miners. The `minerType` of
+// the miners and the chains refers to the name of a MinerClass, which is resolved
+// by the miner controller when the miner pod is created.
+type MinerClass struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Specification of the miners of the class.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Spec MinerClassSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// MinerClassSpec defines the machine configuration of the miners of a MinerClass.
+type MinerClassSpec struct {
+	// The display name of the miner class.
+	// +optional
+	DisplayName string `json:"displayName,omitempty" protobuf:"bytes,1,opt,name=displayName"`
+
+	// Resources are the compute resources of the miner container.
+	// The cpu and memory limits are required.
+	Resources corev1.ResourceRequirements `json:"resources" protobuf:"bytes,2,opt,name=resources"`
+
+	// MiningDifficulty is the minimum number of leading zero bits of the block hashes
+	// mined by the miners of the class.
+	// +optional
+	MiningDifficulty int32 `json:"miningDifficulty,omitempty" protobuf:"varint,3,opt,name=miningDifficulty"`
+
+	// NodeSelector is a selector which must be true for the miner pods to fit on a node.
+	// The node selector of the pod overrides of a miner can only add keys to it.
+	// More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
+	// +optional
+	// +mapType=atomic
+	NodeSelector map[string]string `json:"nodeSelector,omitempty" protobuf:"bytes,4,rep,name=nodeSelector"`
+
+	// If specified, the tolerations of the miner pods. The tolerations of the pod overrides
+	// of a miner are appended.
+	// +optional
+	// +listType=atomic
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,5,rep,name=tolerations"`
+
+	// If specified, the scheduling constraints of the miner pods.
+	// The affinity of the pod overrides of a miner is merged into it, and can only narrow the required node affinity.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty" protobuf:"bytes,6,opt,name=affinity"`
+
+	// Image overrides the blockchain node image of the chain.
+	// +optional
+	Image string `json:"image,omitempty" protobuf:"bytes,7,opt,name=image"`
+
+	// Pricing is the price of the miners of the class.
+	// +optional
+	Pricing *MinerClassPricing `json:"pricing,omitempty" protobuf:"bytes,8,opt,name=pricing"`
+}
+
+// MinerClassPricing defines the price of the miners of a MinerClass.
+type MinerClassPricing struct {
+	// HourlyPrice is the number of tokens charged for running a miner of the class for one hour.
+	// +optional
+	HourlyPrice int64 `json:"hourlyPrice,omitempty" protobuf:"varint,1,opt,name=hourlyPrice"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MinerClassList is a list of MinerClass objects.
+type MinerClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Items is a list of schema objects.
+	Items []MinerClass `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
@@ -10,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	cmerrors "github.com/superproj/onex/pkg/errors"
@@ -93,6 +94,95 @@ type MinerSetSpec struct {
 	// reason will be surfaced in the deployment status. Note that progress will
 	// not be estimated during the time a deployment is paused. Defaults to 600s.
 	ProgressDeadlineSeconds *int32 `json:"progressDeadlineSeconds,omitempty" protobuf:"varint,7,opt,name=progressDeadlineSeconds"`
+
+	// The minerset strategy to use to replace existing miners with new ones
+	// when the spec of the miner template changes.
+	// +optional
+	Strategy MinerSetStrategy `json:"strategy,omitempty" protobuf:"bytes,8,opt,name=strategy"`
+
+	// The number of old revisions to retain in the status to allow rollback.
+	// This is a pointer to distinguish between explicit zero and not specified.
+	// Defaults to 10.
+	// +optional
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty" protobuf:"varint,9,opt,name=revisionHistoryLimit"`
+
+	// Indicates that the rollout of the miner template is paused. Miners are still
+	// created and deleted to match the replicas, from the last rolled out template.
+	// +optional
+	Paused bool `json:"paused,omitempty" protobuf:"varint,10,opt,name=paused"`
+
+	// The revision to rollback to. The controller copies the miner spec of the revision
+	// into the template and clears this field.
+	// +optional
+	RollbackTo *MinerSetRollback `json:"rollbackTo,omitempty" protobuf:"bytes,11,opt,name=rollbackTo"`
+}
+
+// MinerSetStrategyType defines the type of MinerSet rollout strategies.
+type MinerSetStrategyType string
+
+const (
+	// RecreateMinerSetStrategyType deletes all the old miners before creating new ones.
+	RecreateMinerSetStrategyType MinerSetStrategyType = "Recreate"
+
+	// RollingUpdateMinerSetStrategyType replaces the old miners by new ones progressively,
+	// i.e. gradually scale down the old miners and scale up the new ones.
+	RollingUpdateMinerSetStrategyType MinerSetStrategyType = "RollingUpdate"
+)
+
+// MinerSetStrategy describes how to replace existing miners with new ones.
+type MinerSetStrategy struct {
+	// Type of minerset strategy. Can be "Recreate" or "RollingUpdate". Default is RollingUpdate.
+	// +optional
+	Type MinerSetStrategyType `json:"type,omitempty" protobuf:"bytes,1,opt,name=type,casttype=MinerSetStrategyType"`
+
+	// Rolling update config params. Present only if MinerSetStrategyType = RollingUpdate.
+	// +optional
+	RollingUpdate *RollingUpdateMinerSet `json:"rollingUpdate,omitempty" protobuf:"bytes,2,opt,name=rollingUpdate"`
+}
+
+// RollingUpdateMinerSet is the spec to control the desired behavior of rolling update.
+type RollingUpdateMinerSet struct {
+	// The maximum number of miners that can be unavailable during the update.
+	// Value can be an absolute number (ex: 5) or a percentage of desired miners (ex: 10%).
+	// Absolute number is calculated from percentage by rounding down.
+	// This can not be 0 if MaxSurge is 0.
+	// Defaults to 25%.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty" protobuf:"bytes,1,opt,name=maxUnavailable"`
+
+	// The maximum number of miners that can be scheduled above the desired number of
+	// miners during the update.
+	// Value can be an absolute number (ex: 5) or a percentage of desired miners (ex: 10%).
+	// This can not be 0 if MaxUnavailable is 0.
+	// Absolute number is calculated from percentage by rounding up.
+	// Defaults to 25%.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty" protobuf:"bytes,2,opt,name=maxSurge"`
+}
+
+// MinerSetRollback describes the revision a MinerSet is rolled back to.
+type MinerSetRollback struct {
+	// The revision to rollback to. If set to 0, rollback to the last revision.
+	// +optional
+	Revision int64 `json:"revision,omitempty" protobuf:"varint,1,opt,name=revision"`
+}
+
+// MinerSetRevision is a revision of the miner template of a MinerSet.
+type MinerSetRevision struct {
+	// Revision is the sequence number of the revision.
+	Revision int64 `json:"revision" protobuf:"varint,1,opt,name=revision"`
+
+	// TemplateHash is the hash of the miner spec of the template, it is set on the
+	// miners created from the revision with the `apps.onex.io/minerset-template-hash` label.
+	TemplateHash string `json:"templateHash" protobuf:"bytes,2,opt,name=templateHash"`
+
+	// Spec is the miner spec of the template of the revision.
+	// +optional
+	Spec MinerSpec `json:"spec,omitempty" protobuf:"bytes,3,opt,name=spec"`
+
+	// CreationTimestamp is the time the revision was rolled out.
+	// +optional
+	CreationTimestamp metav1.Time `json:"creationTimestamp,omitempty" protobuf:"bytes,4,opt,name=creationTimestamp"`
 }
 
 // MinerTemplateSpec describes the data needed to create a Miner from a template.
@@ -174,6 +264,23 @@ type MinerSetStatus struct {
 	// +patchMergeKey=type
 	// +patchStrategy=merge
 	Conditions Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,8,rep,name=conditions"`
+
+	// The number of miners created from the current revision of the miner template.
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty" protobuf:"varint,9,opt,name=updatedReplicas"`
+
+	// Revision is the current revision of the miner template.
+	// +optional
+	Revision int64 `json:"revision,omitempty" protobuf:"varint,10,opt,name=revision"`
+
+	// TemplateHash is the hash of the current revision of the miner template.
+	// +optional
+	TemplateHash string `json:"templateHash,omitempty" protobuf:"bytes,11,opt,name=templateHash"`
+
+	// History is the current and the old revisions of the miner template, from
+	// the oldest to the newest. The old revisions are limited by RevisionHistoryLimit.
+	// +optional
+	History []MinerSetRevision `json:"history,omitempty" protobuf:"bytes,12,rep,name=history"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
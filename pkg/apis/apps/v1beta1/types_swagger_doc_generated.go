@@ -38,7 +38,13 @@ func (ChargeRequestList) SwaggerDoc() map[string]string {
 }
 
 var map_ChargeRequestSpec = map[string]string{
-	"": "ChargeRequestSpec defines the desired state of ChargeRequest.",
+	"":              "ChargeRequestSpec defines the desired state of ChargeRequest.",
+	"from":          "From is the address of the account the charge is paid from.",
+	"password":      "Password is the password the signing key of the From account is derived from. It is sealed by the apiserver before being stored, and cleared once the charge is submitted to the chain.",
+	"salt":          "Salt is the hex encoded salt, of at least 16 bytes, the signing key of the From account is derived from together with the password. It is chosen at random when the account is created.",
+	"chainName":     "ChainName is the name of the chain the charge is settled on. The charge is paid to the bootstrap account of the chain.",
+	"amount":        "Amount is the number of coins charged.",
+	"confirmations": "Confirmations is the number of blocks, including the one containing the charge, required before the charge is considered settled. Defaults to 3.",
 }
 
 func (ChargeRequestSpec) SwaggerDoc() map[string]string {
@@ -46,7 +52,11 @@ func (ChargeRequestSpec) SwaggerDoc() map[string]string {
 }
 
 var map_ChargeRequestStatus = map[string]string{
-	"": "ChargeRequestStatus defines the observed state of ChargeRequest.",
+	"":                "ChargeRequestStatus defines the observed state of ChargeRequest.",
+	"transactionID":   "TransactionID is the identifier of the transaction submitted for the charge.",
+	"submittedHeight": "SubmittedHeight is the height of the chain when the transaction was submitted.",
+	"blockHeight":     "BlockHeight is the index of the block containing the transaction.",
+	"confirmations":   "Confirmations is the number of blocks, including the one containing the transaction, mined since the transaction was included in the chain.",
 }
 
 func (ChargeRequestStatus) SwaggerDoc() map[string]string {
@@ -147,6 +157,20 @@ func (MinerList) SwaggerDoc() map[string]string {
 	return map_MinerList
 }
 
+var map_MinerPodOverrides = map[string]string{
+	"":                          "MinerPodOverrides is the restricted set of Pod fields which can be customized for a miner. The containers, ports and resources of the Pod are always generated by the miner controller.",
+	"nodeSelector":              "NodeSelector is a selector which must be true for the Pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
+	"tolerations":               "If specified, the Pod's tolerations.",
+	"affinity":                  "If specified, the Pod's scheduling constraints.",
+	"topologySpreadConstraints": "TopologySpreadConstraints describes how the miner Pods ought to spread across topology domains, e.g. nodes or zones. The label selectors of the constraints match the labels set through the ObjectMeta of the miner.",
+	"priorityClassName":         "If specified, indicates the Pod's priority. The \"system-\" prefixed priority classes are reserved for the system and cannot be used.",
+	"env":                       "Env is a list of extra environment variables to set in the miner container. Only literal values and references to the fields and resources of the Pod are allowed.",
+}
+
+func (MinerPodOverrides) SwaggerDoc() map[string]string {
+	return map_MinerPodOverrides
+}
+
 var map_MinerSpec = map[string]string{
 	"":                   "MinerSpec defines the desired state of Miner.",
 	"metadata":           "ObjectMeta will autopopulate the Pod created. Use this to indicate what labels, annotations, name prefix, etc., should be used when creating the Pod.",
@@ -154,6 +178,7 @@ var map_MinerSpec = map[string]string{
 	"minerType":          "Miner machine configuration.",
 	"restartPolicy":      "Restart policy for the miner. One of Always, OnFailure, Never. Default to Always.",
 	"podDeletionTimeout": "PodDeletionTimeout defines how long the controller will attempt to delete the Pod that the Machine hosts after the Machine is marked for deletion. A duration of 0 will retry deletion indefinitely. Defaults to 10 seconds.",
+	"podOverrides":       "PodOverrides are merged into the Pod created for the miner. Use ObjectMeta to set the labels and annotations of the Pod.",
 }
 
 func (MinerSpec) SwaggerDoc() map[string]string {
@@ -186,6 +211,51 @@ func (PodInfo) SwaggerDoc() map[string]string {
 	return map_PodInfo
 }
 
+var map_MinerClass = map[string]string{
+	"":         "MinerClass describes a machine configuration of the miners. The `minerType` of the miners and the chains refers to the name of a MinerClass, which is resolved by the miner controller when the miner pod is created.",
+	"metadata": "Standard object's metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata",
+	"spec":     "Specification of the miners of the class. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status",
+}
+
+func (MinerClass) SwaggerDoc() map[string]string {
+	return map_MinerClass
+}
+
+var map_MinerClassList = map[string]string{
+	"":         "MinerClassList is a list of MinerClass objects.",
+	"metadata": "Standard list metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata",
+	"items":    "Items is a list of schema objects.",
+}
+
+func (MinerClassList) SwaggerDoc() map[string]string {
+	return map_MinerClassList
+}
+
+var map_MinerClassPricing = map[string]string{
+	"":            "MinerClassPricing defines the price of the miners of a MinerClass.",
+	"hourlyPrice": "HourlyPrice is the number of tokens charged for running a miner of the class for one hour.",
+}
+
+func (MinerClassPricing) SwaggerDoc() map[string]string {
+	return map_MinerClassPricing
+}
+
+var map_MinerClassSpec = map[string]string{
+	"":                 "MinerClassSpec defines the machine configuration of the miners of a MinerClass.",
+	"displayName":      "The display name of the miner class.",
+	"resources":        "Resources are the compute resources of the miner container. The cpu and memory limits are required.",
+	"miningDifficulty": "MiningDifficulty is the minimum number of leading zero bits of the block hashes mined by the miners of the class.",
+	"nodeSelector":     "NodeSelector is a selector which must be true for the miner pods to fit on a node. The node selector of the pod overrides of a miner can only add keys to it. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
+	"tolerations":      "If specified, the tolerations of the miner pods. The tolerations of the pod overrides of a miner are appended.",
+	"affinity":         "If specified, the scheduling constraints of the miner pods. The affinity of the pod overrides of a miner is merged into it, and can only narrow the required node affinity.",
+	"image":            "Image overrides the blockchain node image of the chain.",
+	"pricing":          "Pricing is the price of the miners of the class.",
+}
+
+func (MinerClassSpec) SwaggerDoc() map[string]string {
+	return map_MinerClassSpec
+}
+
 var map_MinerSet = map[string]string{
 	"":         "MinerSet ensures that a specified number of miners replicas are running at any given time.",
 	"metadata": "If the Labels of a MinerSet are empty, they are defaulted to be the same as the Miner(s) that the MinerSet manages. Standard object's metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata",
@@ -207,6 +277,27 @@ func (MinerSetList) SwaggerDoc() map[string]string {
 	return map_MinerSetList
 }
 
+var map_MinerSetRevision = map[string]string{
+	"":                  "MinerSetRevision is a revision of the miner template of a MinerSet.",
+	"revision":          "Revision is the sequence number of the revision.",
+	"templateHash":      "TemplateHash is the hash of the miner spec of the template, it is set on the miners created from the revision with the `apps.onex.io/minerset-template-hash` label.",
+	"spec":              "Spec is the miner spec of the template of the revision.",
+	"creationTimestamp": "CreationTimestamp is the time the revision was rolled out.",
+}
+
+func (MinerSetRevision) SwaggerDoc() map[string]string {
+	return map_MinerSetRevision
+}
+
+var map_MinerSetRollback = map[string]string{
+	"":         "MinerSetRollback describes the revision a MinerSet is rolled back to.",
+	"revision": "The revision to rollback to. If set to 0, rollback to the last revision.",
+}
+
+func (MinerSetRollback) SwaggerDoc() map[string]string {
+	return map_MinerSetRollback
+}
+
 var map_MinerSetSpec = map[string]string{
 	"":                        "MinerSetSpec defines the desired state of MinerSet.",
 	"replicas":                "Replicas is the number of desired replicas. This is a pointer to distinguish between explicit zero and unspecified. Defaults to 1. More info: https://kubernetes.io/docs/concepts/workloads/controllers/replicationcontroller/#what-is-a-replicationcontroller",
@@ -216,6 +307,10 @@ var map_MinerSetSpec = map[string]string{
 	"deletePolicy":            "DeletePolicy defines the policy used to identify miners to delete when downscaling. Defaults to \"Random\". Valid values are \"Random, \"Newest\", \"Oldest\"",
 	"minReadySeconds":         "Minimum number of seconds for which a newly created miner should be ready without any of its component crashing, for it to be considered available. Defaults to 0 (miner will be considered available as soon as it is ready)",
 	"progressDeadlineSeconds": "The maximum time in seconds for a minerset to make progress before it is considered to be failed. The deployment controller will continue to process failed deployments and a condition with a ProgressDeadlineExceeded reason will be surfaced in the deployment status. Note that progress will not be estimated during the time a deployment is paused. Defaults to 600s.",
+	"strategy":                "The minerset strategy to use to replace existing miners with new ones when the spec of the miner template changes.",
+	"revisionHistoryLimit":    "The number of old revisions to retain in the status to allow rollback. This is a pointer to distinguish between explicit zero and not specified. Defaults to 10.",
+	"paused":                  "Indicates that the rollout of the miner template is paused. Miners are still created and deleted to match the replicas, from the last rolled out template.",
+	"rollbackTo":              "The revision to rollback to. The controller copies the miner spec of the revision into the template and clears this field.",
 }
 
 func (MinerSetSpec) SwaggerDoc() map[string]string {
@@ -232,12 +327,26 @@ var map_MinerSetStatus = map[string]string{
 	"failureReason":        "In the event that there is a terminal problem reconciling the replicas, both FailureReason and FailureMessage will be set. FailureReason will be populated with a succinct value suitable for miner interpretation, while FailureMessage will contain a more verbose string suitable for logging and human consumption.\n\nThese fields should not be set for transitive errors that a controller faces that are expected to be fixed automatically over time (like service outages), but instead indicate that something is fundamentally wrong with the MinerTemplate's spec or the configuration of the miner controller, and that manual intervention is required. Examples of terminal errors would be invalid combinations of settings in the spec, values that are unsupported by the miner controller, or the responsible miner controller itself being critically misconfigured.\n\nAny transient errors that occur during the reconciliation of Miners can be added as events to the MinerSet object and/or logged in the controller's output.",
 	"failureMessage":       "FailureMessage will be set in the event that there is a terminal problem reconciling the MinerSet and will contain a more verbose string suitable for logging and human consumption.\n\nThis field should not be set for transitive errors that a controller faces that are expected to be fixed automatically over time (like service outages), but instead indicate that something is fundamentally wrong with the MinerSet's spec or the configuration of the controller, and that manual intervention is required. Examples of terminal errors would be invalid combinations of settings in the spec, values that are unsupported by the controller, or the responsible controller itself being critically misconfigured.\n\nAny transient errors that occur during the reconciliation of MinerSets can be added as events to the MinerSet object and/or logged in the controller's output.",
 	"conditions":           "Represents the latest available observations of a miner set's current state.",
+	"updatedReplicas":      "The number of miners created from the current revision of the miner template.",
+	"revision":             "Revision is the current revision of the miner template.",
+	"templateHash":         "TemplateHash is the hash of the current revision of the miner template.",
+	"history":              "History is the current and the old revisions of the miner template, from the oldest to the newest. The old revisions are limited by RevisionHistoryLimit.",
 }
 
 func (MinerSetStatus) SwaggerDoc() map[string]string {
 	return map_MinerSetStatus
 }
 
+var map_MinerSetStrategy = map[string]string{
+	"":              "MinerSetStrategy describes how to replace existing miners with new ones.",
+	"type":          "Type of minerset strategy. Can be \"Recreate\" or \"RollingUpdate\". Default is RollingUpdate.",
+	"rollingUpdate": "Rolling update config params. Present only if MinerSetStrategyType = RollingUpdate.",
+}
+
+func (MinerSetStrategy) SwaggerDoc() map[string]string {
+	return map_MinerSetStrategy
+}
+
 var map_MinerTemplateSpec = map[string]string{
 	"":         "MinerTemplateSpec describes the data needed to create a Miner from a template.",
 	"metadata": "Standard object's metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata",
@@ -248,6 +357,145 @@ func (MinerTemplateSpec) SwaggerDoc() map[string]string {
 	return map_MinerTemplateSpec
 }
 
+var map_RollingUpdateMinerSet = map[string]string{
+	"":               "RollingUpdateMinerSet is the spec to control the desired behavior of rolling update.",
+	"maxUnavailable": "The maximum number of miners that can be unavailable during the update. Value can be an absolute number (ex: 5) or a percentage of desired miners (ex: 10%). Absolute number is calculated from percentage by rounding down. This can not be 0 if MaxSurge is 0. Defaults to 25%.",
+	"maxSurge":       "The maximum number of miners that can be scheduled above the desired number of miners during the update. Value can be an absolute number (ex: 5) or a percentage of desired miners (ex: 10%). This can not be 0 if MaxUnavailable is 0. Absolute number is calculated from percentage by rounding up. Defaults to 25%.",
+}
+
+func (RollingUpdateMinerSet) SwaggerDoc() map[string]string {
+	return map_RollingUpdateMinerSet
+}
+
+var map_ChainBlockIntervalMetricSource = map[string]string{
+	"":              "ChainBlockIntervalMetricSource scales the MinerSet to keep the average block interval of the chain at the target. The miners are scaled up when the blocks are mined slower than the target, and down when they are mined faster.",
+	"targetSeconds": "TargetSeconds is the target value of the average time between two blocks.",
+}
+
+func (ChainBlockIntervalMetricSource) SwaggerDoc() map[string]string {
+	return map_ChainBlockIntervalMetricSource
+}
+
+var map_MinerSetAutoscaler = map[string]string{
+	"":         "MinerSetAutoscaler automatically scales the replicas of a MinerSet between the minimum and the maximum, based on the metrics specified.",
+	"metadata": "Standard object's metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata",
+	"spec":     "Spec defines the behaviour of the autoscaler. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status",
+	"status":   "Status is the current information about the autoscaler.",
+}
+
+func (MinerSetAutoscaler) SwaggerDoc() map[string]string {
+	return map_MinerSetAutoscaler
+}
+
+var map_MinerSetAutoscalerBehavior = map[string]string{
+	"":          "MinerSetAutoscalerBehavior configures the scaling behavior of the target in both up and down directions.",
+	"scaleUp":   "ScaleUp is the scaling policy for scaling up. If not set, the MinerSet is scaled up immediately.",
+	"scaleDown": "ScaleDown is the scaling policy for scaling down. If not set, the replica counts recommended in the last 300 seconds are considered.",
+}
+
+func (MinerSetAutoscalerBehavior) SwaggerDoc() map[string]string {
+	return map_MinerSetAutoscalerBehavior
+}
+
+var map_MinerSetAutoscalerList = map[string]string{
+	"":         "MinerSetAutoscalerList is a list of MinerSetAutoscaler objects.",
+	"metadata": "Standard list metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata",
+	"items":    "Items is the list of MinerSetAutoscalers.",
+}
+
+func (MinerSetAutoscalerList) SwaggerDoc() map[string]string {
+	return map_MinerSetAutoscalerList
+}
+
+var map_MinerSetAutoscalerSpec = map[string]string{
+	"":               "MinerSetAutoscalerSpec describes the desired functionality of the MinerSetAutoscaler.",
+	"scaleTargetRef": "ScaleTargetRef points to the MinerSet to scale, in the namespace of the autoscaler.",
+	"minReplicas":    "MinReplicas is the lower limit for the number of replicas to which the autoscaler can scale down. Defaults to 1.",
+	"maxReplicas":    "MaxReplicas is the upper limit for the number of replicas to which the autoscaler can scale up. It cannot be less than minReplicas.",
+	"metrics":        "Metrics contains the specifications used to calculate the desired replica count. The desired replica count is calculated for each metric, and the largest one is used. If no metric gives a replica count, e.g. all the schedules are inactive, the MinerSet is scaled to minReplicas.",
+	"behavior":       "Behavior configures the scaling behavior of the target in both up and down directions. If not set, the default behaviors are used.",
+}
+
+func (MinerSetAutoscalerSpec) SwaggerDoc() map[string]string {
+	return map_MinerSetAutoscalerSpec
+}
+
+var map_MinerSetAutoscalerStatus = map[string]string{
+	"":                   "MinerSetAutoscalerStatus describes the current status of a MinerSetAutoscaler.",
+	"observedGeneration": "ObservedGeneration is the most recent generation observed by this autoscaler.",
+	"lastScaleTime":      "LastScaleTime is the last time the autoscaler scaled the MinerSet.",
+	"currentReplicas":    "CurrentReplicas is the current number of replicas of the MinerSet, as last seen by the autoscaler.",
+	"desiredReplicas":    "DesiredReplicas is the desired number of replicas of the MinerSet, as last calculated by the autoscaler.",
+	"currentMetrics":     "CurrentMetrics is the last read state of the metrics used by this autoscaler.",
+	"conditions":         "Conditions defines current service state of the autoscaler.",
+}
+
+func (MinerSetAutoscalerStatus) SwaggerDoc() map[string]string {
+	return map_MinerSetAutoscalerStatus
+}
+
+var map_MinerSetMetricSpec = map[string]string{
+	"":                      "MinerSetMetricSpec specifies how to scale based on a single metric. Only the field matching the type should be set.",
+	"type":                  "Type is the type of metric source. It should be one of \"PodCPU\", \"ChainBlockInterval\", \"PendingChargeRequests\" or \"Schedule\", each mapping to a matching field in the object.",
+	"podCPU":                "PodCPU refers to the CPU usage of the miner pods.",
+	"chainBlockInterval":    "ChainBlockInterval refers to the average block interval of the chain.",
+	"pendingChargeRequests": "PendingChargeRequests refers to the ChargeRequests not settled yet.",
+	"schedule":              "Schedule refers to a recurring time window.",
+}
+
+func (MinerSetMetricSpec) SwaggerDoc() map[string]string {
+	return map_MinerSetMetricSpec
+}
+
+var map_MinerSetMetricStatus = map[string]string{
+	"":                "MinerSetMetricStatus describes the last read state of a single metric.",
+	"type":            "Type is the type of metric source.",
+	"current":         "Current is the current value of the metric: the average utilization in percent for PodCPU, the average block interval in seconds for ChainBlockInterval, the number of pending requests for PendingChargeRequests, and 1 if the time window is active else 0 for Schedule.",
+	"desiredReplicas": "DesiredReplicas is the replica count recommended by the metric. It is not set if the metric does not recommend any replica count, e.g. an inactive schedule.",
+}
+
+func (MinerSetMetricStatus) SwaggerDoc() map[string]string {
+	return map_MinerSetMetricStatus
+}
+
+var map_MinerSetScalingRules = map[string]string{
+	"":                           "MinerSetScalingRules configures the scaling behavior for one direction.",
+	"stabilizationWindowSeconds": "StabilizationWindowSeconds is the number of seconds for which past recommendations should be considered while scaling up or scaling down, to prevent flapping: the MinerSet is scaled down to the highest, or up to the lowest, replica count recommended during the window. It must be between 0 and 3600 (one hour).",
+}
+
+func (MinerSetScalingRules) SwaggerDoc() map[string]string {
+	return map_MinerSetScalingRules
+}
+
+var map_PendingChargeRequestsMetricSource = map[string]string{
+	"":                 "PendingChargeRequestsMetricSource scales the MinerSet to have a replica for every targetPerReplica pending ChargeRequests.",
+	"targetPerReplica": "TargetPerReplica is the number of pending ChargeRequests a replica can handle.",
+}
+
+func (PendingChargeRequestsMetricSource) SwaggerDoc() map[string]string {
+	return map_PendingChargeRequestsMetricSource
+}
+
+var map_PodCPUMetricSource = map[string]string{
+	"":                         "PodCPUMetricSource scales the MinerSet to keep the average CPU utilization of its miner pods at the target.",
+	"targetAverageUtilization": "TargetAverageUtilization is the target value of the average CPU utilization of the miner pods, as a percentage of their CPU requests, or limits if the requests are not set.",
+}
+
+func (PodCPUMetricSource) SwaggerDoc() map[string]string {
+	return map_PodCPUMetricSource
+}
+
+var map_ScheduleMetricSource = map[string]string{
+	"":         "ScheduleMetricSource requests a fixed number of replicas for a duration every time the schedule fires.",
+	"schedule": "Schedule is the start of the time window, in Cron format, e.g. \"0 8 * * 1-5\". The time zone can be set with a \"CRON_TZ=\" prefix, it defaults to the time zone of the controller.",
+	"duration": "Duration is the length of the time window.",
+	"replicas": "Replicas is the number of replicas during the time window.",
+}
+
+func (ScheduleMetricSource) SwaggerDoc() map[string]string {
+	return map_ScheduleMetricSource
+}
+
 var map_Condition = map[string]string{
 	"":                   "Condition defines an observation of a cloud miner resource operational state.",
 	"type":               "Type of condition in CamelCase or in foo.example.com/CamelCase. Many .condition.type values are consistent across resources like Available, but because arbitrary conditions can be useful (see .node.status.conditions), the ability to deconflict is important.",
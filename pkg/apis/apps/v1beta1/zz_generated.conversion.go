@@ -19,6 +19,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 	core "k8s.io/kubernetes/pkg/apis/core"
 )
 
@@ -39,6 +40,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ChainBlockIntervalMetricSource)(nil), (*apps.ChainBlockIntervalMetricSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ChainBlockIntervalMetricSource_To_apps_ChainBlockIntervalMetricSource(a.(*ChainBlockIntervalMetricSource), b.(*apps.ChainBlockIntervalMetricSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.ChainBlockIntervalMetricSource)(nil), (*ChainBlockIntervalMetricSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_ChainBlockIntervalMetricSource_To_v1beta1_ChainBlockIntervalMetricSource(a.(*apps.ChainBlockIntervalMetricSource), b.(*ChainBlockIntervalMetricSource), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ChainList)(nil), (*apps.ChainList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ChainList_To_apps_ChainList(a.(*ChainList), b.(*apps.ChainList), scope)
 	}); err != nil {
@@ -69,6 +80,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ChargeRequest)(nil), (*apps.ChargeRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ChargeRequest_To_apps_ChargeRequest(a.(*ChargeRequest), b.(*apps.ChargeRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.ChargeRequest)(nil), (*ChargeRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_ChargeRequest_To_v1beta1_ChargeRequest(a.(*apps.ChargeRequest), b.(*ChargeRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ChargeRequestList)(nil), (*apps.ChargeRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ChargeRequestList_To_apps_ChargeRequestList(a.(*ChargeRequestList), b.(*apps.ChargeRequestList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.ChargeRequestList)(nil), (*ChargeRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_ChargeRequestList_To_v1beta1_ChargeRequestList(a.(*apps.ChargeRequestList), b.(*ChargeRequestList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ChargeRequestSpec)(nil), (*apps.ChargeRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ChargeRequestSpec_To_apps_ChargeRequestSpec(a.(*ChargeRequestSpec), b.(*apps.ChargeRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.ChargeRequestSpec)(nil), (*ChargeRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_ChargeRequestSpec_To_v1beta1_ChargeRequestSpec(a.(*apps.ChargeRequestSpec), b.(*ChargeRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ChargeRequestStatus)(nil), (*apps.ChargeRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ChargeRequestStatus_To_apps_ChargeRequestStatus(a.(*ChargeRequestStatus), b.(*apps.ChargeRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.ChargeRequestStatus)(nil), (*ChargeRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_ChargeRequestStatus_To_v1beta1_ChargeRequestStatus(a.(*apps.ChargeRequestStatus), b.(*ChargeRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Condition)(nil), (*apps.Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Condition_To_apps_Condition(a.(*Condition), b.(*apps.Condition), scope)
 	}); err != nil {
@@ -109,6 +160,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MinerClass)(nil), (*apps.MinerClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MinerClass_To_apps_MinerClass(a.(*MinerClass), b.(*apps.MinerClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.MinerClass)(nil), (*MinerClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_MinerClass_To_v1beta1_MinerClass(a.(*apps.MinerClass), b.(*MinerClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MinerClassList)(nil), (*apps.MinerClassList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MinerClassList_To_apps_MinerClassList(a.(*MinerClassList), b.(*apps.MinerClassList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.MinerClassList)(nil), (*MinerClassList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_MinerClassList_To_v1beta1_MinerClassList(a.(*apps.MinerClassList), b.(*MinerClassList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MinerClassPricing)(nil), (*apps.MinerClassPricing)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MinerClassPricing_To_apps_MinerClassPricing(a.(*MinerClassPricing), b.(*apps.MinerClassPricing), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.MinerClassPricing)(nil), (*MinerClassPricing)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_MinerClassPricing_To_v1beta1_MinerClassPricing(a.(*apps.MinerClassPricing), b.(*MinerClassPricing), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MinerClassSpec)(nil), (*apps.MinerClassSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MinerClassSpec_To_apps_MinerClassSpec(a.(*MinerClassSpec), b.(*apps.MinerClassSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.MinerClassSpec)(nil), (*MinerClassSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_MinerClassSpec_To_v1beta1_MinerClassSpec(a.(*apps.MinerClassSpec), b.(*MinerClassSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MinerList)(nil), (*apps.MinerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MinerList_To_apps_MinerList(a.(*MinerList), b.(*apps.MinerList), scope)
 	}); err != nil {
@@ -119,6 +210,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MinerPodOverrides)(nil), (*apps.MinerPodOverrides)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MinerPodOverrides_To_apps_MinerPodOverrides(a.(*MinerPodOverrides), b.(*apps.MinerPodOverrides), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.MinerPodOverrides)(nil), (*MinerPodOverrides)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_MinerPodOverrides_To_v1beta1_MinerPodOverrides(a.(*apps.MinerPodOverrides), b.(*MinerPodOverrides), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MinerSet)(nil), (*apps.MinerSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MinerSet_To_apps_MinerSet(a.(*MinerSet), b.(*apps.MinerSet), scope)
 	}); err != nil {
@@ -129,6 +230,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MinerSetAutoscaler)(nil), (*apps.MinerSetAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MinerSetAutoscaler_To_apps_MinerSetAutoscaler(a.(*MinerSetAutoscaler), b.(*apps.MinerSetAutoscaler), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.MinerSetAutoscaler)(nil), (*MinerSetAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_MinerSetAutoscaler_To_v1beta1_MinerSetAutoscaler(a.(*apps.MinerSetAutoscaler), b.(*MinerSetAutoscaler), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MinerSetAutoscalerBehavior)(nil), (*apps.MinerSetAutoscalerBehavior)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MinerSetAutoscalerBehavior_To_apps_MinerSetAutoscalerBehavior(a.(*MinerSetAutoscalerBehavior), b.(*apps.MinerSetAutoscalerBehavior), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.MinerSetAutoscalerBehavior)(nil), (*MinerSetAutoscalerBehavior)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_MinerSetAutoscalerBehavior_To_v1beta1_MinerSetAutoscalerBehavior(a.(*apps.MinerSetAutoscalerBehavior), b.(*MinerSetAutoscalerBehavior), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MinerSetAutoscalerList)(nil), (*apps.MinerSetAutoscalerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MinerSetAutoscalerList_To_apps_MinerSetAutoscalerList(a.(*MinerSetAutoscalerList), b.(*apps.MinerSetAutoscalerList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.MinerSetAutoscalerList)(nil), (*MinerSetAutoscalerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_MinerSetAutoscalerList_To_v1beta1_MinerSetAutoscalerList(a.(*apps.MinerSetAutoscalerList), b.(*MinerSetAutoscalerList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MinerSetAutoscalerSpec)(nil), (*apps.MinerSetAutoscalerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MinerSetAutoscalerSpec_To_apps_MinerSetAutoscalerSpec(a.(*MinerSetAutoscalerSpec), b.(*apps.MinerSetAutoscalerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.MinerSetAutoscalerSpec)(nil), (*MinerSetAutoscalerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_MinerSetAutoscalerSpec_To_v1beta1_MinerSetAutoscalerSpec(a.(*apps.MinerSetAutoscalerSpec), b.(*MinerSetAutoscalerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MinerSetAutoscalerStatus)(nil), (*apps.MinerSetAutoscalerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MinerSetAutoscalerStatus_To_apps_MinerSetAutoscalerStatus(a.(*MinerSetAutoscalerStatus), b.(*apps.MinerSetAutoscalerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.MinerSetAutoscalerStatus)(nil), (*MinerSetAutoscalerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_MinerSetAutoscalerStatus_To_v1beta1_MinerSetAutoscalerStatus(a.(*apps.MinerSetAutoscalerStatus), b.(*MinerSetAutoscalerStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MinerSetList)(nil), (*apps.MinerSetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MinerSetList_To_apps_MinerSetList(a.(*MinerSetList), b.(*apps.MinerSetList), scope)
 	}); err != nil {
@@ -139,6 +290,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MinerSetMetricSpec)(nil), (*apps.MinerSetMetricSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MinerSetMetricSpec_To_apps_MinerSetMetricSpec(a.(*MinerSetMetricSpec), b.(*apps.MinerSetMetricSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.MinerSetMetricSpec)(nil), (*MinerSetMetricSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_MinerSetMetricSpec_To_v1beta1_MinerSetMetricSpec(a.(*apps.MinerSetMetricSpec), b.(*MinerSetMetricSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MinerSetMetricStatus)(nil), (*apps.MinerSetMetricStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MinerSetMetricStatus_To_apps_MinerSetMetricStatus(a.(*MinerSetMetricStatus), b.(*apps.MinerSetMetricStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.MinerSetMetricStatus)(nil), (*MinerSetMetricStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_MinerSetMetricStatus_To_v1beta1_MinerSetMetricStatus(a.(*apps.MinerSetMetricStatus), b.(*MinerSetMetricStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MinerSetRevision)(nil), (*apps.MinerSetRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MinerSetRevision_To_apps_MinerSetRevision(a.(*MinerSetRevision), b.(*apps.MinerSetRevision), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.MinerSetRevision)(nil), (*MinerSetRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_MinerSetRevision_To_v1beta1_MinerSetRevision(a.(*apps.MinerSetRevision), b.(*MinerSetRevision), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MinerSetRollback)(nil), (*apps.MinerSetRollback)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MinerSetRollback_To_apps_MinerSetRollback(a.(*MinerSetRollback), b.(*apps.MinerSetRollback), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.MinerSetRollback)(nil), (*MinerSetRollback)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_MinerSetRollback_To_v1beta1_MinerSetRollback(a.(*apps.MinerSetRollback), b.(*MinerSetRollback), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MinerSetScalingRules)(nil), (*apps.MinerSetScalingRules)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MinerSetScalingRules_To_apps_MinerSetScalingRules(a.(*MinerSetScalingRules), b.(*apps.MinerSetScalingRules), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.MinerSetScalingRules)(nil), (*MinerSetScalingRules)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_MinerSetScalingRules_To_v1beta1_MinerSetScalingRules(a.(*apps.MinerSetScalingRules), b.(*MinerSetScalingRules), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MinerSetSpec)(nil), (*apps.MinerSetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MinerSetSpec_To_apps_MinerSetSpec(a.(*MinerSetSpec), b.(*apps.MinerSetSpec), scope)
 	}); err != nil {
@@ -159,6 +360,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MinerSetStrategy)(nil), (*apps.MinerSetStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MinerSetStrategy_To_apps_MinerSetStrategy(a.(*MinerSetStrategy), b.(*apps.MinerSetStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.MinerSetStrategy)(nil), (*MinerSetStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_MinerSetStrategy_To_v1beta1_MinerSetStrategy(a.(*apps.MinerSetStrategy), b.(*MinerSetStrategy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MinerSpec)(nil), (*apps.MinerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MinerSpec_To_apps_MinerSpec(a.(*MinerSpec), b.(*apps.MinerSpec), scope)
 	}); err != nil {
@@ -199,6 +410,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PendingChargeRequestsMetricSource)(nil), (*apps.PendingChargeRequestsMetricSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PendingChargeRequestsMetricSource_To_apps_PendingChargeRequestsMetricSource(a.(*PendingChargeRequestsMetricSource), b.(*apps.PendingChargeRequestsMetricSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.PendingChargeRequestsMetricSource)(nil), (*PendingChargeRequestsMetricSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_PendingChargeRequestsMetricSource_To_v1beta1_PendingChargeRequestsMetricSource(a.(*apps.PendingChargeRequestsMetricSource), b.(*PendingChargeRequestsMetricSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PodCPUMetricSource)(nil), (*apps.PodCPUMetricSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PodCPUMetricSource_To_apps_PodCPUMetricSource(a.(*PodCPUMetricSource), b.(*apps.PodCPUMetricSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.PodCPUMetricSource)(nil), (*PodCPUMetricSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_PodCPUMetricSource_To_v1beta1_PodCPUMetricSource(a.(*apps.PodCPUMetricSource), b.(*PodCPUMetricSource), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PodInfo)(nil), (*apps.PodInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_PodInfo_To_apps_PodInfo(a.(*PodInfo), b.(*apps.PodInfo), scope)
 	}); err != nil {
@@ -209,6 +440,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RollingUpdateMinerSet)(nil), (*apps.RollingUpdateMinerSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RollingUpdateMinerSet_To_apps_RollingUpdateMinerSet(a.(*RollingUpdateMinerSet), b.(*apps.RollingUpdateMinerSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.RollingUpdateMinerSet)(nil), (*RollingUpdateMinerSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_RollingUpdateMinerSet_To_v1beta1_RollingUpdateMinerSet(a.(*apps.RollingUpdateMinerSet), b.(*RollingUpdateMinerSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ScheduleMetricSource)(nil), (*apps.ScheduleMetricSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ScheduleMetricSource_To_apps_ScheduleMetricSource(a.(*ScheduleMetricSource), b.(*apps.ScheduleMetricSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*apps.ScheduleMetricSource)(nil), (*ScheduleMetricSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_apps_ScheduleMetricSource_To_v1beta1_ScheduleMetricSource(a.(*apps.ScheduleMetricSource), b.(*ScheduleMetricSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*core.ResourceRequirements)(nil), (*v1.ResourceRequirements)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ResourceRequirements_To_v1_ResourceRequirements(a.(*core.ResourceRequirements), b.(*v1.ResourceRequirements), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1.ResourceRequirements)(nil), (*core.ResourceRequirements)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ResourceRequirements_To_core_ResourceRequirements(a.(*v1.ResourceRequirements), b.(*core.ResourceRequirements), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -244,6 +505,26 @@ func Convert_apps_Chain_To_v1beta1_Chain(in *apps.Chain, out *Chain, s conversio
 	return autoConvert_apps_Chain_To_v1beta1_Chain(in, out, s)
 }
 
+func autoConvert_v1beta1_ChainBlockIntervalMetricSource_To_apps_ChainBlockIntervalMetricSource(in *ChainBlockIntervalMetricSource, out *apps.ChainBlockIntervalMetricSource, s conversion.Scope) error {
+	out.TargetSeconds = in.TargetSeconds
+	return nil
+}
+
+// Convert_v1beta1_ChainBlockIntervalMetricSource_To_apps_ChainBlockIntervalMetricSource is an autogenerated conversion function.
+func Convert_v1beta1_ChainBlockIntervalMetricSource_To_apps_ChainBlockIntervalMetricSource(in *ChainBlockIntervalMetricSource, out *apps.ChainBlockIntervalMetricSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_ChainBlockIntervalMetricSource_To_apps_ChainBlockIntervalMetricSource(in, out, s)
+}
+
+func autoConvert_apps_ChainBlockIntervalMetricSource_To_v1beta1_ChainBlockIntervalMetricSource(in *apps.ChainBlockIntervalMetricSource, out *ChainBlockIntervalMetricSource, s conversion.Scope) error {
+	out.TargetSeconds = in.TargetSeconds
+	return nil
+}
+
+// Convert_apps_ChainBlockIntervalMetricSource_To_v1beta1_ChainBlockIntervalMetricSource is an autogenerated conversion function.
+func Convert_apps_ChainBlockIntervalMetricSource_To_v1beta1_ChainBlockIntervalMetricSource(in *apps.ChainBlockIntervalMetricSource, out *ChainBlockIntervalMetricSource, s conversion.Scope) error {
+	return autoConvert_apps_ChainBlockIntervalMetricSource_To_v1beta1_ChainBlockIntervalMetricSource(in, out, s)
+}
+
 func autoConvert_v1beta1_ChainList_To_apps_ChainList(in *ChainList, out *apps.ChainList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]apps.Chain)(unsafe.Pointer(&in.Items))
@@ -320,6 +601,118 @@ func Convert_apps_ChainStatus_To_v1beta1_ChainStatus(in *apps.ChainStatus, out *
 	return autoConvert_apps_ChainStatus_To_v1beta1_ChainStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_ChargeRequest_To_apps_ChargeRequest(in *ChargeRequest, out *apps.ChargeRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ChargeRequestSpec_To_apps_ChargeRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ChargeRequestStatus_To_apps_ChargeRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ChargeRequest_To_apps_ChargeRequest is an autogenerated conversion function.
+func Convert_v1beta1_ChargeRequest_To_apps_ChargeRequest(in *ChargeRequest, out *apps.ChargeRequest, s conversion.Scope) error {
+	return autoConvert_v1beta1_ChargeRequest_To_apps_ChargeRequest(in, out, s)
+}
+
+func autoConvert_apps_ChargeRequest_To_v1beta1_ChargeRequest(in *apps.ChargeRequest, out *ChargeRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_apps_ChargeRequestSpec_To_v1beta1_ChargeRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_apps_ChargeRequestStatus_To_v1beta1_ChargeRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_apps_ChargeRequest_To_v1beta1_ChargeRequest is an autogenerated conversion function.
+func Convert_apps_ChargeRequest_To_v1beta1_ChargeRequest(in *apps.ChargeRequest, out *ChargeRequest, s conversion.Scope) error {
+	return autoConvert_apps_ChargeRequest_To_v1beta1_ChargeRequest(in, out, s)
+}
+
+func autoConvert_v1beta1_ChargeRequestList_To_apps_ChargeRequestList(in *ChargeRequestList, out *apps.ChargeRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]apps.ChargeRequest)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_ChargeRequestList_To_apps_ChargeRequestList is an autogenerated conversion function.
+func Convert_v1beta1_ChargeRequestList_To_apps_ChargeRequestList(in *ChargeRequestList, out *apps.ChargeRequestList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ChargeRequestList_To_apps_ChargeRequestList(in, out, s)
+}
+
+func autoConvert_apps_ChargeRequestList_To_v1beta1_ChargeRequestList(in *apps.ChargeRequestList, out *ChargeRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ChargeRequest)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_apps_ChargeRequestList_To_v1beta1_ChargeRequestList is an autogenerated conversion function.
+func Convert_apps_ChargeRequestList_To_v1beta1_ChargeRequestList(in *apps.ChargeRequestList, out *ChargeRequestList, s conversion.Scope) error {
+	return autoConvert_apps_ChargeRequestList_To_v1beta1_ChargeRequestList(in, out, s)
+}
+
+func autoConvert_v1beta1_ChargeRequestSpec_To_apps_ChargeRequestSpec(in *ChargeRequestSpec, out *apps.ChargeRequestSpec, s conversion.Scope) error {
+	out.From = in.From
+	out.Password = in.Password
+	out.Salt = in.Salt
+	out.ChainName = in.ChainName
+	out.Amount = in.Amount
+	out.Confirmations = (*int32)(unsafe.Pointer(in.Confirmations))
+	return nil
+}
+
+// Convert_v1beta1_ChargeRequestSpec_To_apps_ChargeRequestSpec is an autogenerated conversion function.
+func Convert_v1beta1_ChargeRequestSpec_To_apps_ChargeRequestSpec(in *ChargeRequestSpec, out *apps.ChargeRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ChargeRequestSpec_To_apps_ChargeRequestSpec(in, out, s)
+}
+
+func autoConvert_apps_ChargeRequestSpec_To_v1beta1_ChargeRequestSpec(in *apps.ChargeRequestSpec, out *ChargeRequestSpec, s conversion.Scope) error {
+	out.From = in.From
+	out.Password = in.Password
+	out.Salt = in.Salt
+	out.ChainName = in.ChainName
+	out.Amount = in.Amount
+	out.Confirmations = (*int32)(unsafe.Pointer(in.Confirmations))
+	return nil
+}
+
+// Convert_apps_ChargeRequestSpec_To_v1beta1_ChargeRequestSpec is an autogenerated conversion function.
+func Convert_apps_ChargeRequestSpec_To_v1beta1_ChargeRequestSpec(in *apps.ChargeRequestSpec, out *ChargeRequestSpec, s conversion.Scope) error {
+	return autoConvert_apps_ChargeRequestSpec_To_v1beta1_ChargeRequestSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ChargeRequestStatus_To_apps_ChargeRequestStatus(in *ChargeRequestStatus, out *apps.ChargeRequestStatus, s conversion.Scope) error {
+	out.Conditions = *(*apps.Conditions)(unsafe.Pointer(&in.Conditions))
+	out.TransactionID = in.TransactionID
+	out.SubmittedHeight = in.SubmittedHeight
+	out.BlockHeight = in.BlockHeight
+	out.Confirmations = in.Confirmations
+	return nil
+}
+
+// Convert_v1beta1_ChargeRequestStatus_To_apps_ChargeRequestStatus is an autogenerated conversion function.
+func Convert_v1beta1_ChargeRequestStatus_To_apps_ChargeRequestStatus(in *ChargeRequestStatus, out *apps.ChargeRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ChargeRequestStatus_To_apps_ChargeRequestStatus(in, out, s)
+}
+
+func autoConvert_apps_ChargeRequestStatus_To_v1beta1_ChargeRequestStatus(in *apps.ChargeRequestStatus, out *ChargeRequestStatus, s conversion.Scope) error {
+	out.Conditions = *(*Conditions)(unsafe.Pointer(&in.Conditions))
+	out.TransactionID = in.TransactionID
+	out.SubmittedHeight = in.SubmittedHeight
+	out.BlockHeight = in.BlockHeight
+	out.Confirmations = in.Confirmations
+	return nil
+}
+
+// Convert_apps_ChargeRequestStatus_To_v1beta1_ChargeRequestStatus is an autogenerated conversion function.
+func Convert_apps_ChargeRequestStatus_To_v1beta1_ChargeRequestStatus(in *apps.ChargeRequestStatus, out *ChargeRequestStatus, s conversion.Scope) error {
+	return autoConvert_apps_ChargeRequestStatus_To_v1beta1_ChargeRequestStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_Condition_To_apps_Condition(in *Condition, out *apps.Condition, s conversion.Scope) error {
 	out.Type = apps.ConditionType(in.Type)
 	out.Status = core.ConditionStatus(in.Status)
@@ -424,6 +817,132 @@ func Convert_apps_MinerAddress_To_v1beta1_MinerAddress(in *apps.MinerAddress, ou
 	return autoConvert_apps_MinerAddress_To_v1beta1_MinerAddress(in, out, s)
 }
 
+func autoConvert_v1beta1_MinerClass_To_apps_MinerClass(in *MinerClass, out *apps.MinerClass, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_MinerClassSpec_To_apps_MinerClassSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_MinerClass_To_apps_MinerClass is an autogenerated conversion function.
+func Convert_v1beta1_MinerClass_To_apps_MinerClass(in *MinerClass, out *apps.MinerClass, s conversion.Scope) error {
+	return autoConvert_v1beta1_MinerClass_To_apps_MinerClass(in, out, s)
+}
+
+func autoConvert_apps_MinerClass_To_v1beta1_MinerClass(in *apps.MinerClass, out *MinerClass, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_apps_MinerClassSpec_To_v1beta1_MinerClassSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_apps_MinerClass_To_v1beta1_MinerClass is an autogenerated conversion function.
+func Convert_apps_MinerClass_To_v1beta1_MinerClass(in *apps.MinerClass, out *MinerClass, s conversion.Scope) error {
+	return autoConvert_apps_MinerClass_To_v1beta1_MinerClass(in, out, s)
+}
+
+func autoConvert_v1beta1_MinerClassList_To_apps_MinerClassList(in *MinerClassList, out *apps.MinerClassList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]apps.MinerClass, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_MinerClass_To_apps_MinerClass(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_MinerClassList_To_apps_MinerClassList is an autogenerated conversion function.
+func Convert_v1beta1_MinerClassList_To_apps_MinerClassList(in *MinerClassList, out *apps.MinerClassList, s conversion.Scope) error {
+	return autoConvert_v1beta1_MinerClassList_To_apps_MinerClassList(in, out, s)
+}
+
+func autoConvert_apps_MinerClassList_To_v1beta1_MinerClassList(in *apps.MinerClassList, out *MinerClassList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MinerClass, len(*in))
+		for i := range *in {
+			if err := Convert_apps_MinerClass_To_v1beta1_MinerClass(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_apps_MinerClassList_To_v1beta1_MinerClassList is an autogenerated conversion function.
+func Convert_apps_MinerClassList_To_v1beta1_MinerClassList(in *apps.MinerClassList, out *MinerClassList, s conversion.Scope) error {
+	return autoConvert_apps_MinerClassList_To_v1beta1_MinerClassList(in, out, s)
+}
+
+func autoConvert_v1beta1_MinerClassPricing_To_apps_MinerClassPricing(in *MinerClassPricing, out *apps.MinerClassPricing, s conversion.Scope) error {
+	out.HourlyPrice = in.HourlyPrice
+	return nil
+}
+
+// Convert_v1beta1_MinerClassPricing_To_apps_MinerClassPricing is an autogenerated conversion function.
+func Convert_v1beta1_MinerClassPricing_To_apps_MinerClassPricing(in *MinerClassPricing, out *apps.MinerClassPricing, s conversion.Scope) error {
+	return autoConvert_v1beta1_MinerClassPricing_To_apps_MinerClassPricing(in, out, s)
+}
+
+func autoConvert_apps_MinerClassPricing_To_v1beta1_MinerClassPricing(in *apps.MinerClassPricing, out *MinerClassPricing, s conversion.Scope) error {
+	out.HourlyPrice = in.HourlyPrice
+	return nil
+}
+
+// Convert_apps_MinerClassPricing_To_v1beta1_MinerClassPricing is an autogenerated conversion function.
+func Convert_apps_MinerClassPricing_To_v1beta1_MinerClassPricing(in *apps.MinerClassPricing, out *MinerClassPricing, s conversion.Scope) error {
+	return autoConvert_apps_MinerClassPricing_To_v1beta1_MinerClassPricing(in, out, s)
+}
+
+func autoConvert_v1beta1_MinerClassSpec_To_apps_MinerClassSpec(in *MinerClassSpec, out *apps.MinerClassSpec, s conversion.Scope) error {
+	out.DisplayName = in.DisplayName
+	if err := Convert_v1_ResourceRequirements_To_core_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
+		return err
+	}
+	out.MiningDifficulty = in.MiningDifficulty
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.Tolerations = *(*[]core.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.Affinity = (*core.Affinity)(unsafe.Pointer(in.Affinity))
+	out.Image = in.Image
+	out.Pricing = (*apps.MinerClassPricing)(unsafe.Pointer(in.Pricing))
+	return nil
+}
+
+// Convert_v1beta1_MinerClassSpec_To_apps_MinerClassSpec is an autogenerated conversion function.
+func Convert_v1beta1_MinerClassSpec_To_apps_MinerClassSpec(in *MinerClassSpec, out *apps.MinerClassSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_MinerClassSpec_To_apps_MinerClassSpec(in, out, s)
+}
+
+func autoConvert_apps_MinerClassSpec_To_v1beta1_MinerClassSpec(in *apps.MinerClassSpec, out *MinerClassSpec, s conversion.Scope) error {
+	out.DisplayName = in.DisplayName
+	if err := Convert_core_ResourceRequirements_To_v1_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
+		return err
+	}
+	out.MiningDifficulty = in.MiningDifficulty
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.Affinity = (*v1.Affinity)(unsafe.Pointer(in.Affinity))
+	out.Image = in.Image
+	out.Pricing = (*MinerClassPricing)(unsafe.Pointer(in.Pricing))
+	return nil
+}
+
+// Convert_apps_MinerClassSpec_To_v1beta1_MinerClassSpec is an autogenerated conversion function.
+func Convert_apps_MinerClassSpec_To_v1beta1_MinerClassSpec(in *apps.MinerClassSpec, out *MinerClassSpec, s conversion.Scope) error {
+	return autoConvert_apps_MinerClassSpec_To_v1beta1_MinerClassSpec(in, out, s)
+}
+
 func autoConvert_v1beta1_MinerList_To_apps_MinerList(in *MinerList, out *apps.MinerList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]apps.Miner)(unsafe.Pointer(&in.Items))
@@ -446,6 +965,36 @@ func Convert_apps_MinerList_To_v1beta1_MinerList(in *apps.MinerList, out *MinerL
 	return autoConvert_apps_MinerList_To_v1beta1_MinerList(in, out, s)
 }
 
+func autoConvert_v1beta1_MinerPodOverrides_To_apps_MinerPodOverrides(in *MinerPodOverrides, out *apps.MinerPodOverrides, s conversion.Scope) error {
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.Tolerations = *(*[]core.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.Affinity = (*core.Affinity)(unsafe.Pointer(in.Affinity))
+	out.TopologySpreadConstraints = *(*[]core.TopologySpreadConstraint)(unsafe.Pointer(&in.TopologySpreadConstraints))
+	out.PriorityClassName = in.PriorityClassName
+	out.Env = *(*[]core.EnvVar)(unsafe.Pointer(&in.Env))
+	return nil
+}
+
+// Convert_v1beta1_MinerPodOverrides_To_apps_MinerPodOverrides is an autogenerated conversion function.
+func Convert_v1beta1_MinerPodOverrides_To_apps_MinerPodOverrides(in *MinerPodOverrides, out *apps.MinerPodOverrides, s conversion.Scope) error {
+	return autoConvert_v1beta1_MinerPodOverrides_To_apps_MinerPodOverrides(in, out, s)
+}
+
+func autoConvert_apps_MinerPodOverrides_To_v1beta1_MinerPodOverrides(in *apps.MinerPodOverrides, out *MinerPodOverrides, s conversion.Scope) error {
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.Affinity = (*v1.Affinity)(unsafe.Pointer(in.Affinity))
+	out.TopologySpreadConstraints = *(*[]v1.TopologySpreadConstraint)(unsafe.Pointer(&in.TopologySpreadConstraints))
+	out.PriorityClassName = in.PriorityClassName
+	out.Env = *(*[]v1.EnvVar)(unsafe.Pointer(&in.Env))
+	return nil
+}
+
+// Convert_apps_MinerPodOverrides_To_v1beta1_MinerPodOverrides is an autogenerated conversion function.
+func Convert_apps_MinerPodOverrides_To_v1beta1_MinerPodOverrides(in *apps.MinerPodOverrides, out *MinerPodOverrides, s conversion.Scope) error {
+	return autoConvert_apps_MinerPodOverrides_To_v1beta1_MinerPodOverrides(in, out, s)
+}
+
 func autoConvert_v1beta1_MinerSet_To_apps_MinerSet(in *MinerSet, out *apps.MinerSet, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_MinerSetSpec_To_apps_MinerSetSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -478,6 +1027,144 @@ func Convert_apps_MinerSet_To_v1beta1_MinerSet(in *apps.MinerSet, out *MinerSet,
 	return autoConvert_apps_MinerSet_To_v1beta1_MinerSet(in, out, s)
 }
 
+func autoConvert_v1beta1_MinerSetAutoscaler_To_apps_MinerSetAutoscaler(in *MinerSetAutoscaler, out *apps.MinerSetAutoscaler, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_MinerSetAutoscalerSpec_To_apps_MinerSetAutoscalerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_MinerSetAutoscalerStatus_To_apps_MinerSetAutoscalerStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_MinerSetAutoscaler_To_apps_MinerSetAutoscaler is an autogenerated conversion function.
+func Convert_v1beta1_MinerSetAutoscaler_To_apps_MinerSetAutoscaler(in *MinerSetAutoscaler, out *apps.MinerSetAutoscaler, s conversion.Scope) error {
+	return autoConvert_v1beta1_MinerSetAutoscaler_To_apps_MinerSetAutoscaler(in, out, s)
+}
+
+func autoConvert_apps_MinerSetAutoscaler_To_v1beta1_MinerSetAutoscaler(in *apps.MinerSetAutoscaler, out *MinerSetAutoscaler, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_apps_MinerSetAutoscalerSpec_To_v1beta1_MinerSetAutoscalerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_apps_MinerSetAutoscalerStatus_To_v1beta1_MinerSetAutoscalerStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_apps_MinerSetAutoscaler_To_v1beta1_MinerSetAutoscaler is an autogenerated conversion function.
+func Convert_apps_MinerSetAutoscaler_To_v1beta1_MinerSetAutoscaler(in *apps.MinerSetAutoscaler, out *MinerSetAutoscaler, s conversion.Scope) error {
+	return autoConvert_apps_MinerSetAutoscaler_To_v1beta1_MinerSetAutoscaler(in, out, s)
+}
+
+func autoConvert_v1beta1_MinerSetAutoscalerBehavior_To_apps_MinerSetAutoscalerBehavior(in *MinerSetAutoscalerBehavior, out *apps.MinerSetAutoscalerBehavior, s conversion.Scope) error {
+	out.ScaleUp = (*apps.MinerSetScalingRules)(unsafe.Pointer(in.ScaleUp))
+	out.ScaleDown = (*apps.MinerSetScalingRules)(unsafe.Pointer(in.ScaleDown))
+	return nil
+}
+
+// Convert_v1beta1_MinerSetAutoscalerBehavior_To_apps_MinerSetAutoscalerBehavior is an autogenerated conversion function.
+func Convert_v1beta1_MinerSetAutoscalerBehavior_To_apps_MinerSetAutoscalerBehavior(in *MinerSetAutoscalerBehavior, out *apps.MinerSetAutoscalerBehavior, s conversion.Scope) error {
+	return autoConvert_v1beta1_MinerSetAutoscalerBehavior_To_apps_MinerSetAutoscalerBehavior(in, out, s)
+}
+
+func autoConvert_apps_MinerSetAutoscalerBehavior_To_v1beta1_MinerSetAutoscalerBehavior(in *apps.MinerSetAutoscalerBehavior, out *MinerSetAutoscalerBehavior, s conversion.Scope) error {
+	out.ScaleUp = (*MinerSetScalingRules)(unsafe.Pointer(in.ScaleUp))
+	out.ScaleDown = (*MinerSetScalingRules)(unsafe.Pointer(in.ScaleDown))
+	return nil
+}
+
+// Convert_apps_MinerSetAutoscalerBehavior_To_v1beta1_MinerSetAutoscalerBehavior is an autogenerated conversion function.
+func Convert_apps_MinerSetAutoscalerBehavior_To_v1beta1_MinerSetAutoscalerBehavior(in *apps.MinerSetAutoscalerBehavior, out *MinerSetAutoscalerBehavior, s conversion.Scope) error {
+	return autoConvert_apps_MinerSetAutoscalerBehavior_To_v1beta1_MinerSetAutoscalerBehavior(in, out, s)
+}
+
+func autoConvert_v1beta1_MinerSetAutoscalerList_To_apps_MinerSetAutoscalerList(in *MinerSetAutoscalerList, out *apps.MinerSetAutoscalerList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]apps.MinerSetAutoscaler)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_MinerSetAutoscalerList_To_apps_MinerSetAutoscalerList is an autogenerated conversion function.
+func Convert_v1beta1_MinerSetAutoscalerList_To_apps_MinerSetAutoscalerList(in *MinerSetAutoscalerList, out *apps.MinerSetAutoscalerList, s conversion.Scope) error {
+	return autoConvert_v1beta1_MinerSetAutoscalerList_To_apps_MinerSetAutoscalerList(in, out, s)
+}
+
+func autoConvert_apps_MinerSetAutoscalerList_To_v1beta1_MinerSetAutoscalerList(in *apps.MinerSetAutoscalerList, out *MinerSetAutoscalerList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]MinerSetAutoscaler)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_apps_MinerSetAutoscalerList_To_v1beta1_MinerSetAutoscalerList is an autogenerated conversion function.
+func Convert_apps_MinerSetAutoscalerList_To_v1beta1_MinerSetAutoscalerList(in *apps.MinerSetAutoscalerList, out *MinerSetAutoscalerList, s conversion.Scope) error {
+	return autoConvert_apps_MinerSetAutoscalerList_To_v1beta1_MinerSetAutoscalerList(in, out, s)
+}
+
+func autoConvert_v1beta1_MinerSetAutoscalerSpec_To_apps_MinerSetAutoscalerSpec(in *MinerSetAutoscalerSpec, out *apps.MinerSetAutoscalerSpec, s conversion.Scope) error {
+	if err := Convert_v1beta1_LocalObjectReference_To_apps_LocalObjectReference(&in.ScaleTargetRef, &out.ScaleTargetRef, s); err != nil {
+		return err
+	}
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = in.MaxReplicas
+	out.Metrics = *(*[]apps.MinerSetMetricSpec)(unsafe.Pointer(&in.Metrics))
+	out.Behavior = (*apps.MinerSetAutoscalerBehavior)(unsafe.Pointer(in.Behavior))
+	return nil
+}
+
+// Convert_v1beta1_MinerSetAutoscalerSpec_To_apps_MinerSetAutoscalerSpec is an autogenerated conversion function.
+func Convert_v1beta1_MinerSetAutoscalerSpec_To_apps_MinerSetAutoscalerSpec(in *MinerSetAutoscalerSpec, out *apps.MinerSetAutoscalerSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_MinerSetAutoscalerSpec_To_apps_MinerSetAutoscalerSpec(in, out, s)
+}
+
+func autoConvert_apps_MinerSetAutoscalerSpec_To_v1beta1_MinerSetAutoscalerSpec(in *apps.MinerSetAutoscalerSpec, out *MinerSetAutoscalerSpec, s conversion.Scope) error {
+	if err := Convert_apps_LocalObjectReference_To_v1beta1_LocalObjectReference(&in.ScaleTargetRef, &out.ScaleTargetRef, s); err != nil {
+		return err
+	}
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = in.MaxReplicas
+	out.Metrics = *(*[]MinerSetMetricSpec)(unsafe.Pointer(&in.Metrics))
+	out.Behavior = (*MinerSetAutoscalerBehavior)(unsafe.Pointer(in.Behavior))
+	return nil
+}
+
+// Convert_apps_MinerSetAutoscalerSpec_To_v1beta1_MinerSetAutoscalerSpec is an autogenerated conversion function.
+func Convert_apps_MinerSetAutoscalerSpec_To_v1beta1_MinerSetAutoscalerSpec(in *apps.MinerSetAutoscalerSpec, out *MinerSetAutoscalerSpec, s conversion.Scope) error {
+	return autoConvert_apps_MinerSetAutoscalerSpec_To_v1beta1_MinerSetAutoscalerSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_MinerSetAutoscalerStatus_To_apps_MinerSetAutoscalerStatus(in *MinerSetAutoscalerStatus, out *apps.MinerSetAutoscalerStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastScaleTime = (*metav1.Time)(unsafe.Pointer(in.LastScaleTime))
+	out.CurrentReplicas = in.CurrentReplicas
+	out.DesiredReplicas = in.DesiredReplicas
+	out.CurrentMetrics = *(*[]apps.MinerSetMetricStatus)(unsafe.Pointer(&in.CurrentMetrics))
+	out.Conditions = *(*apps.Conditions)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1beta1_MinerSetAutoscalerStatus_To_apps_MinerSetAutoscalerStatus is an autogenerated conversion function.
+func Convert_v1beta1_MinerSetAutoscalerStatus_To_apps_MinerSetAutoscalerStatus(in *MinerSetAutoscalerStatus, out *apps.MinerSetAutoscalerStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_MinerSetAutoscalerStatus_To_apps_MinerSetAutoscalerStatus(in, out, s)
+}
+
+func autoConvert_apps_MinerSetAutoscalerStatus_To_v1beta1_MinerSetAutoscalerStatus(in *apps.MinerSetAutoscalerStatus, out *MinerSetAutoscalerStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastScaleTime = (*metav1.Time)(unsafe.Pointer(in.LastScaleTime))
+	out.CurrentReplicas = in.CurrentReplicas
+	out.DesiredReplicas = in.DesiredReplicas
+	out.CurrentMetrics = *(*[]MinerSetMetricStatus)(unsafe.Pointer(&in.CurrentMetrics))
+	out.Conditions = *(*Conditions)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_apps_MinerSetAutoscalerStatus_To_v1beta1_MinerSetAutoscalerStatus is an autogenerated conversion function.
+func Convert_apps_MinerSetAutoscalerStatus_To_v1beta1_MinerSetAutoscalerStatus(in *apps.MinerSetAutoscalerStatus, out *MinerSetAutoscalerStatus, s conversion.Scope) error {
+	return autoConvert_apps_MinerSetAutoscalerStatus_To_v1beta1_MinerSetAutoscalerStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_MinerSetList_To_apps_MinerSetList(in *MinerSetList, out *apps.MinerSetList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]apps.MinerSet)(unsafe.Pointer(&in.Items))
@@ -500,6 +1187,128 @@ func Convert_apps_MinerSetList_To_v1beta1_MinerSetList(in *apps.MinerSetList, ou
 	return autoConvert_apps_MinerSetList_To_v1beta1_MinerSetList(in, out, s)
 }
 
+func autoConvert_v1beta1_MinerSetMetricSpec_To_apps_MinerSetMetricSpec(in *MinerSetMetricSpec, out *apps.MinerSetMetricSpec, s conversion.Scope) error {
+	out.Type = apps.MinerSetMetricSourceType(in.Type)
+	out.PodCPU = (*apps.PodCPUMetricSource)(unsafe.Pointer(in.PodCPU))
+	out.ChainBlockInterval = (*apps.ChainBlockIntervalMetricSource)(unsafe.Pointer(in.ChainBlockInterval))
+	out.PendingChargeRequests = (*apps.PendingChargeRequestsMetricSource)(unsafe.Pointer(in.PendingChargeRequests))
+	out.Schedule = (*apps.ScheduleMetricSource)(unsafe.Pointer(in.Schedule))
+	return nil
+}
+
+// Convert_v1beta1_MinerSetMetricSpec_To_apps_MinerSetMetricSpec is an autogenerated conversion function.
+func Convert_v1beta1_MinerSetMetricSpec_To_apps_MinerSetMetricSpec(in *MinerSetMetricSpec, out *apps.MinerSetMetricSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_MinerSetMetricSpec_To_apps_MinerSetMetricSpec(in, out, s)
+}
+
+func autoConvert_apps_MinerSetMetricSpec_To_v1beta1_MinerSetMetricSpec(in *apps.MinerSetMetricSpec, out *MinerSetMetricSpec, s conversion.Scope) error {
+	out.Type = MinerSetMetricSourceType(in.Type)
+	out.PodCPU = (*PodCPUMetricSource)(unsafe.Pointer(in.PodCPU))
+	out.ChainBlockInterval = (*ChainBlockIntervalMetricSource)(unsafe.Pointer(in.ChainBlockInterval))
+	out.PendingChargeRequests = (*PendingChargeRequestsMetricSource)(unsafe.Pointer(in.PendingChargeRequests))
+	out.Schedule = (*ScheduleMetricSource)(unsafe.Pointer(in.Schedule))
+	return nil
+}
+
+// Convert_apps_MinerSetMetricSpec_To_v1beta1_MinerSetMetricSpec is an autogenerated conversion function.
+func Convert_apps_MinerSetMetricSpec_To_v1beta1_MinerSetMetricSpec(in *apps.MinerSetMetricSpec, out *MinerSetMetricSpec, s conversion.Scope) error {
+	return autoConvert_apps_MinerSetMetricSpec_To_v1beta1_MinerSetMetricSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_MinerSetMetricStatus_To_apps_MinerSetMetricStatus(in *MinerSetMetricStatus, out *apps.MinerSetMetricStatus, s conversion.Scope) error {
+	out.Type = apps.MinerSetMetricSourceType(in.Type)
+	out.Current = in.Current
+	out.DesiredReplicas = (*int32)(unsafe.Pointer(in.DesiredReplicas))
+	return nil
+}
+
+// Convert_v1beta1_MinerSetMetricStatus_To_apps_MinerSetMetricStatus is an autogenerated conversion function.
+func Convert_v1beta1_MinerSetMetricStatus_To_apps_MinerSetMetricStatus(in *MinerSetMetricStatus, out *apps.MinerSetMetricStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_MinerSetMetricStatus_To_apps_MinerSetMetricStatus(in, out, s)
+}
+
+func autoConvert_apps_MinerSetMetricStatus_To_v1beta1_MinerSetMetricStatus(in *apps.MinerSetMetricStatus, out *MinerSetMetricStatus, s conversion.Scope) error {
+	out.Type = MinerSetMetricSourceType(in.Type)
+	out.Current = in.Current
+	out.DesiredReplicas = (*int32)(unsafe.Pointer(in.DesiredReplicas))
+	return nil
+}
+
+// Convert_apps_MinerSetMetricStatus_To_v1beta1_MinerSetMetricStatus is an autogenerated conversion function.
+func Convert_apps_MinerSetMetricStatus_To_v1beta1_MinerSetMetricStatus(in *apps.MinerSetMetricStatus, out *MinerSetMetricStatus, s conversion.Scope) error {
+	return autoConvert_apps_MinerSetMetricStatus_To_v1beta1_MinerSetMetricStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_MinerSetRevision_To_apps_MinerSetRevision(in *MinerSetRevision, out *apps.MinerSetRevision, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.TemplateHash = in.TemplateHash
+	if err := Convert_v1beta1_MinerSpec_To_apps_MinerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	out.CreationTimestamp = in.CreationTimestamp
+	return nil
+}
+
+// Convert_v1beta1_MinerSetRevision_To_apps_MinerSetRevision is an autogenerated conversion function.
+func Convert_v1beta1_MinerSetRevision_To_apps_MinerSetRevision(in *MinerSetRevision, out *apps.MinerSetRevision, s conversion.Scope) error {
+	return autoConvert_v1beta1_MinerSetRevision_To_apps_MinerSetRevision(in, out, s)
+}
+
+func autoConvert_apps_MinerSetRevision_To_v1beta1_MinerSetRevision(in *apps.MinerSetRevision, out *MinerSetRevision, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.TemplateHash = in.TemplateHash
+	if err := Convert_apps_MinerSpec_To_v1beta1_MinerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	out.CreationTimestamp = in.CreationTimestamp
+	return nil
+}
+
+// Convert_apps_MinerSetRevision_To_v1beta1_MinerSetRevision is an autogenerated conversion function.
+func Convert_apps_MinerSetRevision_To_v1beta1_MinerSetRevision(in *apps.MinerSetRevision, out *MinerSetRevision, s conversion.Scope) error {
+	return autoConvert_apps_MinerSetRevision_To_v1beta1_MinerSetRevision(in, out, s)
+}
+
+func autoConvert_v1beta1_MinerSetRollback_To_apps_MinerSetRollback(in *MinerSetRollback, out *apps.MinerSetRollback, s conversion.Scope) error {
+	out.Revision = in.Revision
+	return nil
+}
+
+// Convert_v1beta1_MinerSetRollback_To_apps_MinerSetRollback is an autogenerated conversion function.
+func Convert_v1beta1_MinerSetRollback_To_apps_MinerSetRollback(in *MinerSetRollback, out *apps.MinerSetRollback, s conversion.Scope) error {
+	return autoConvert_v1beta1_MinerSetRollback_To_apps_MinerSetRollback(in, out, s)
+}
+
+func autoConvert_apps_MinerSetRollback_To_v1beta1_MinerSetRollback(in *apps.MinerSetRollback, out *MinerSetRollback, s conversion.Scope) error {
+	out.Revision = in.Revision
+	return nil
+}
+
+// Convert_apps_MinerSetRollback_To_v1beta1_MinerSetRollback is an autogenerated conversion function.
+func Convert_apps_MinerSetRollback_To_v1beta1_MinerSetRollback(in *apps.MinerSetRollback, out *MinerSetRollback, s conversion.Scope) error {
+	return autoConvert_apps_MinerSetRollback_To_v1beta1_MinerSetRollback(in, out, s)
+}
+
+func autoConvert_v1beta1_MinerSetScalingRules_To_apps_MinerSetScalingRules(in *MinerSetScalingRules, out *apps.MinerSetScalingRules, s conversion.Scope) error {
+	out.StabilizationWindowSeconds = (*int32)(unsafe.Pointer(in.StabilizationWindowSeconds))
+	return nil
+}
+
+// Convert_v1beta1_MinerSetScalingRules_To_apps_MinerSetScalingRules is an autogenerated conversion function.
+func Convert_v1beta1_MinerSetScalingRules_To_apps_MinerSetScalingRules(in *MinerSetScalingRules, out *apps.MinerSetScalingRules, s conversion.Scope) error {
+	return autoConvert_v1beta1_MinerSetScalingRules_To_apps_MinerSetScalingRules(in, out, s)
+}
+
+func autoConvert_apps_MinerSetScalingRules_To_v1beta1_MinerSetScalingRules(in *apps.MinerSetScalingRules, out *MinerSetScalingRules, s conversion.Scope) error {
+	out.StabilizationWindowSeconds = (*int32)(unsafe.Pointer(in.StabilizationWindowSeconds))
+	return nil
+}
+
+// Convert_apps_MinerSetScalingRules_To_v1beta1_MinerSetScalingRules is an autogenerated conversion function.
+func Convert_apps_MinerSetScalingRules_To_v1beta1_MinerSetScalingRules(in *apps.MinerSetScalingRules, out *MinerSetScalingRules, s conversion.Scope) error {
+	return autoConvert_apps_MinerSetScalingRules_To_v1beta1_MinerSetScalingRules(in, out, s)
+}
+
 func autoConvert_v1beta1_MinerSetSpec_To_apps_MinerSetSpec(in *MinerSetSpec, out *apps.MinerSetSpec, s conversion.Scope) error {
 	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
 	out.Selector = in.Selector
@@ -510,6 +1319,12 @@ func autoConvert_v1beta1_MinerSetSpec_To_apps_MinerSetSpec(in *MinerSetSpec, out
 	out.DeletePolicy = in.DeletePolicy
 	out.MinReadySeconds = in.MinReadySeconds
 	out.ProgressDeadlineSeconds = (*int32)(unsafe.Pointer(in.ProgressDeadlineSeconds))
+	if err := Convert_v1beta1_MinerSetStrategy_To_apps_MinerSetStrategy(&in.Strategy, &out.Strategy, s); err != nil {
+		return err
+	}
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.Paused = in.Paused
+	out.RollbackTo = (*apps.MinerSetRollback)(unsafe.Pointer(in.RollbackTo))
 	return nil
 }
 
@@ -528,6 +1343,12 @@ func autoConvert_apps_MinerSetSpec_To_v1beta1_MinerSetSpec(in *apps.MinerSetSpec
 	out.DeletePolicy = in.DeletePolicy
 	out.MinReadySeconds = in.MinReadySeconds
 	out.ProgressDeadlineSeconds = (*int32)(unsafe.Pointer(in.ProgressDeadlineSeconds))
+	if err := Convert_apps_MinerSetStrategy_To_v1beta1_MinerSetStrategy(&in.Strategy, &out.Strategy, s); err != nil {
+		return err
+	}
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.Paused = in.Paused
+	out.RollbackTo = (*MinerSetRollback)(unsafe.Pointer(in.RollbackTo))
 	return nil
 }
 
@@ -545,6 +1366,10 @@ func autoConvert_v1beta1_MinerSetStatus_To_apps_MinerSetStatus(in *MinerSetStatu
 	out.FailureReason = (*errors.MinerSetStatusError)(unsafe.Pointer(in.FailureReason))
 	out.FailureMessage = (*string)(unsafe.Pointer(in.FailureMessage))
 	out.Conditions = *(*apps.Conditions)(unsafe.Pointer(&in.Conditions))
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.Revision = in.Revision
+	out.TemplateHash = in.TemplateHash
+	out.History = *(*[]apps.MinerSetRevision)(unsafe.Pointer(&in.History))
 	return nil
 }
 
@@ -562,6 +1387,10 @@ func autoConvert_apps_MinerSetStatus_To_v1beta1_MinerSetStatus(in *apps.MinerSet
 	out.FailureReason = (*errors.MinerSetStatusError)(unsafe.Pointer(in.FailureReason))
 	out.FailureMessage = (*string)(unsafe.Pointer(in.FailureMessage))
 	out.Conditions = *(*Conditions)(unsafe.Pointer(&in.Conditions))
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.Revision = in.Revision
+	out.TemplateHash = in.TemplateHash
+	out.History = *(*[]MinerSetRevision)(unsafe.Pointer(&in.History))
 	return nil
 }
 
@@ -570,6 +1399,28 @@ func Convert_apps_MinerSetStatus_To_v1beta1_MinerSetStatus(in *apps.MinerSetStat
 	return autoConvert_apps_MinerSetStatus_To_v1beta1_MinerSetStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_MinerSetStrategy_To_apps_MinerSetStrategy(in *MinerSetStrategy, out *apps.MinerSetStrategy, s conversion.Scope) error {
+	out.Type = apps.MinerSetStrategyType(in.Type)
+	out.RollingUpdate = (*apps.RollingUpdateMinerSet)(unsafe.Pointer(in.RollingUpdate))
+	return nil
+}
+
+// Convert_v1beta1_MinerSetStrategy_To_apps_MinerSetStrategy is an autogenerated conversion function.
+func Convert_v1beta1_MinerSetStrategy_To_apps_MinerSetStrategy(in *MinerSetStrategy, out *apps.MinerSetStrategy, s conversion.Scope) error {
+	return autoConvert_v1beta1_MinerSetStrategy_To_apps_MinerSetStrategy(in, out, s)
+}
+
+func autoConvert_apps_MinerSetStrategy_To_v1beta1_MinerSetStrategy(in *apps.MinerSetStrategy, out *MinerSetStrategy, s conversion.Scope) error {
+	out.Type = MinerSetStrategyType(in.Type)
+	out.RollingUpdate = (*RollingUpdateMinerSet)(unsafe.Pointer(in.RollingUpdate))
+	return nil
+}
+
+// Convert_apps_MinerSetStrategy_To_v1beta1_MinerSetStrategy is an autogenerated conversion function.
+func Convert_apps_MinerSetStrategy_To_v1beta1_MinerSetStrategy(in *apps.MinerSetStrategy, out *MinerSetStrategy, s conversion.Scope) error {
+	return autoConvert_apps_MinerSetStrategy_To_v1beta1_MinerSetStrategy(in, out, s)
+}
+
 func autoConvert_v1beta1_MinerSpec_To_apps_MinerSpec(in *MinerSpec, out *apps.MinerSpec, s conversion.Scope) error {
 	if err := Convert_v1beta1_ObjectMeta_To_apps_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
 		return err
@@ -579,6 +1430,7 @@ func autoConvert_v1beta1_MinerSpec_To_apps_MinerSpec(in *MinerSpec, out *apps.Mi
 	out.ChainName = in.ChainName
 	out.RestartPolicy = core.RestartPolicy(in.RestartPolicy)
 	out.PodDeletionTimeout = (*metav1.Duration)(unsafe.Pointer(in.PodDeletionTimeout))
+	out.PodOverrides = (*apps.MinerPodOverrides)(unsafe.Pointer(in.PodOverrides))
 	return nil
 }
 
@@ -596,6 +1448,7 @@ func autoConvert_apps_MinerSpec_To_v1beta1_MinerSpec(in *apps.MinerSpec, out *Mi
 	out.ChainName = in.ChainName
 	out.RestartPolicy = v1.RestartPolicy(in.RestartPolicy)
 	out.PodDeletionTimeout = (*metav1.Duration)(unsafe.Pointer(in.PodDeletionTimeout))
+	out.PodOverrides = (*MinerPodOverrides)(unsafe.Pointer(in.PodOverrides))
 	return nil
 }
 
@@ -690,6 +1543,46 @@ func Convert_apps_ObjectMeta_To_v1beta1_ObjectMeta(in *apps.ObjectMeta, out *Obj
 	return autoConvert_apps_ObjectMeta_To_v1beta1_ObjectMeta(in, out, s)
 }
 
+func autoConvert_v1beta1_PendingChargeRequestsMetricSource_To_apps_PendingChargeRequestsMetricSource(in *PendingChargeRequestsMetricSource, out *apps.PendingChargeRequestsMetricSource, s conversion.Scope) error {
+	out.TargetPerReplica = in.TargetPerReplica
+	return nil
+}
+
+// Convert_v1beta1_PendingChargeRequestsMetricSource_To_apps_PendingChargeRequestsMetricSource is an autogenerated conversion function.
+func Convert_v1beta1_PendingChargeRequestsMetricSource_To_apps_PendingChargeRequestsMetricSource(in *PendingChargeRequestsMetricSource, out *apps.PendingChargeRequestsMetricSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_PendingChargeRequestsMetricSource_To_apps_PendingChargeRequestsMetricSource(in, out, s)
+}
+
+func autoConvert_apps_PendingChargeRequestsMetricSource_To_v1beta1_PendingChargeRequestsMetricSource(in *apps.PendingChargeRequestsMetricSource, out *PendingChargeRequestsMetricSource, s conversion.Scope) error {
+	out.TargetPerReplica = in.TargetPerReplica
+	return nil
+}
+
+// Convert_apps_PendingChargeRequestsMetricSource_To_v1beta1_PendingChargeRequestsMetricSource is an autogenerated conversion function.
+func Convert_apps_PendingChargeRequestsMetricSource_To_v1beta1_PendingChargeRequestsMetricSource(in *apps.PendingChargeRequestsMetricSource, out *PendingChargeRequestsMetricSource, s conversion.Scope) error {
+	return autoConvert_apps_PendingChargeRequestsMetricSource_To_v1beta1_PendingChargeRequestsMetricSource(in, out, s)
+}
+
+func autoConvert_v1beta1_PodCPUMetricSource_To_apps_PodCPUMetricSource(in *PodCPUMetricSource, out *apps.PodCPUMetricSource, s conversion.Scope) error {
+	out.TargetAverageUtilization = in.TargetAverageUtilization
+	return nil
+}
+
+// Convert_v1beta1_PodCPUMetricSource_To_apps_PodCPUMetricSource is an autogenerated conversion function.
+func Convert_v1beta1_PodCPUMetricSource_To_apps_PodCPUMetricSource(in *PodCPUMetricSource, out *apps.PodCPUMetricSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_PodCPUMetricSource_To_apps_PodCPUMetricSource(in, out, s)
+}
+
+func autoConvert_apps_PodCPUMetricSource_To_v1beta1_PodCPUMetricSource(in *apps.PodCPUMetricSource, out *PodCPUMetricSource, s conversion.Scope) error {
+	out.TargetAverageUtilization = in.TargetAverageUtilization
+	return nil
+}
+
+// Convert_apps_PodCPUMetricSource_To_v1beta1_PodCPUMetricSource is an autogenerated conversion function.
+func Convert_apps_PodCPUMetricSource_To_v1beta1_PodCPUMetricSource(in *apps.PodCPUMetricSource, out *PodCPUMetricSource, s conversion.Scope) error {
+	return autoConvert_apps_PodCPUMetricSource_To_v1beta1_PodCPUMetricSource(in, out, s)
+}
+
 func autoConvert_v1beta1_PodInfo_To_apps_PodInfo(in *PodInfo, out *apps.PodInfo, s conversion.Scope) error {
 	out.OperatingSystem = in.OperatingSystem
 	out.Architecture = in.Architecture
@@ -711,3 +1604,49 @@ func autoConvert_apps_PodInfo_To_v1beta1_PodInfo(in *apps.PodInfo, out *PodInfo,
 func Convert_apps_PodInfo_To_v1beta1_PodInfo(in *apps.PodInfo, out *PodInfo, s conversion.Scope) error {
 	return autoConvert_apps_PodInfo_To_v1beta1_PodInfo(in, out, s)
 }
+
+func autoConvert_v1beta1_RollingUpdateMinerSet_To_apps_RollingUpdateMinerSet(in *RollingUpdateMinerSet, out *apps.RollingUpdateMinerSet, s conversion.Scope) error {
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	return nil
+}
+
+// Convert_v1beta1_RollingUpdateMinerSet_To_apps_RollingUpdateMinerSet is an autogenerated conversion function.
+func Convert_v1beta1_RollingUpdateMinerSet_To_apps_RollingUpdateMinerSet(in *RollingUpdateMinerSet, out *apps.RollingUpdateMinerSet, s conversion.Scope) error {
+	return autoConvert_v1beta1_RollingUpdateMinerSet_To_apps_RollingUpdateMinerSet(in, out, s)
+}
+
+func autoConvert_apps_RollingUpdateMinerSet_To_v1beta1_RollingUpdateMinerSet(in *apps.RollingUpdateMinerSet, out *RollingUpdateMinerSet, s conversion.Scope) error {
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	return nil
+}
+
+// Convert_apps_RollingUpdateMinerSet_To_v1beta1_RollingUpdateMinerSet is an autogenerated conversion function.
+func Convert_apps_RollingUpdateMinerSet_To_v1beta1_RollingUpdateMinerSet(in *apps.RollingUpdateMinerSet, out *RollingUpdateMinerSet, s conversion.Scope) error {
+	return autoConvert_apps_RollingUpdateMinerSet_To_v1beta1_RollingUpdateMinerSet(in, out, s)
+}
+
+func autoConvert_v1beta1_ScheduleMetricSource_To_apps_ScheduleMetricSource(in *ScheduleMetricSource, out *apps.ScheduleMetricSource, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	out.Replicas = in.Replicas
+	return nil
+}
+
+// Convert_v1beta1_ScheduleMetricSource_To_apps_ScheduleMetricSource is an autogenerated conversion function.
+func Convert_v1beta1_ScheduleMetricSource_To_apps_ScheduleMetricSource(in *ScheduleMetricSource, out *apps.ScheduleMetricSource, s conversion.Scope) error {
+	return autoConvert_v1beta1_ScheduleMetricSource_To_apps_ScheduleMetricSource(in, out, s)
+}
+
+func autoConvert_apps_ScheduleMetricSource_To_v1beta1_ScheduleMetricSource(in *apps.ScheduleMetricSource, out *ScheduleMetricSource, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	out.Replicas = in.Replicas
+	return nil
+}
+
+// Convert_apps_ScheduleMetricSource_To_v1beta1_ScheduleMetricSource is an autogenerated conversion function.
+func Convert_apps_ScheduleMetricSource_To_v1beta1_ScheduleMetricSource(in *apps.ScheduleMetricSource, out *ScheduleMetricSource, s conversion.Scope) error {
+	return autoConvert_apps_ScheduleMetricSource_To_v1beta1_ScheduleMetricSource(in, out, s)
+}
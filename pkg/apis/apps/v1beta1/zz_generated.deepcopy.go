@@ -12,9 +12,10 @@ package v1beta1
 
 import (
 	errors "github.com/superproj/onex/pkg/errors"
-	corev1 "k8s.io/api/core/v1"
-	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -45,6 +46,22 @@ func (in *Chain) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainBlockIntervalMetricSource) DeepCopyInto(out *ChainBlockIntervalMetricSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainBlockIntervalMetricSource.
+func (in *ChainBlockIntervalMetricSource) DeepCopy() *ChainBlockIntervalMetricSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainBlockIntervalMetricSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChainList) DeepCopyInto(out *ChainList) {
 	*out = *in
@@ -137,7 +154,7 @@ func (in *ChargeRequest) DeepCopyInto(out *ChargeRequest) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -196,6 +213,11 @@ func (in *ChargeRequestList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChargeRequestSpec) DeepCopyInto(out *ChargeRequestSpec) {
 	*out = *in
+	if in.Confirmations != nil {
+		in, out := &in.Confirmations, &out.Confirmations
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -351,6 +373,123 @@ func (in MinerAddresses) DeepCopy() MinerAddresses {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinerClass) DeepCopyInto(out *MinerClass) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinerClass.
+func (in *MinerClass) DeepCopy() *MinerClass {
+	if in == nil {
+		return nil
+	}
+	out := new(MinerClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MinerClass) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinerClassList) DeepCopyInto(out *MinerClassList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MinerClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinerClassList.
+func (in *MinerClassList) DeepCopy() *MinerClassList {
+	if in == nil {
+		return nil
+	}
+	out := new(MinerClassList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MinerClassList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinerClassPricing) DeepCopyInto(out *MinerClassPricing) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinerClassPricing.
+func (in *MinerClassPricing) DeepCopy() *MinerClassPricing {
+	if in == nil {
+		return nil
+	}
+	out := new(MinerClassPricing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinerClassSpec) DeepCopyInto(out *MinerClassSpec) {
+	*out = *in
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pricing != nil {
+		in, out := &in.Pricing, &out.Pricing
+		*out = new(MinerClassPricing)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinerClassSpec.
+func (in *MinerClassSpec) DeepCopy() *MinerClassSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MinerClassSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MinerList) DeepCopyInto(out *MinerList) {
 	*out = *in
@@ -384,6 +523,55 @@ func (in *MinerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinerPodOverrides) DeepCopyInto(out *MinerPodOverrides) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinerPodOverrides.
+func (in *MinerPodOverrides) DeepCopy() *MinerPodOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(MinerPodOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MinerSet) DeepCopyInto(out *MinerSet) {
 	*out = *in
@@ -412,6 +600,161 @@ func (in *MinerSet) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinerSetAutoscaler) DeepCopyInto(out *MinerSetAutoscaler) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinerSetAutoscaler.
+func (in *MinerSetAutoscaler) DeepCopy() *MinerSetAutoscaler {
+	if in == nil {
+		return nil
+	}
+	out := new(MinerSetAutoscaler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MinerSetAutoscaler) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinerSetAutoscalerBehavior) DeepCopyInto(out *MinerSetAutoscalerBehavior) {
+	*out = *in
+	if in.ScaleUp != nil {
+		in, out := &in.ScaleUp, &out.ScaleUp
+		*out = new(MinerSetScalingRules)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScaleDown != nil {
+		in, out := &in.ScaleDown, &out.ScaleDown
+		*out = new(MinerSetScalingRules)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinerSetAutoscalerBehavior.
+func (in *MinerSetAutoscalerBehavior) DeepCopy() *MinerSetAutoscalerBehavior {
+	if in == nil {
+		return nil
+	}
+	out := new(MinerSetAutoscalerBehavior)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinerSetAutoscalerList) DeepCopyInto(out *MinerSetAutoscalerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MinerSetAutoscaler, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinerSetAutoscalerList.
+func (in *MinerSetAutoscalerList) DeepCopy() *MinerSetAutoscalerList {
+	if in == nil {
+		return nil
+	}
+	out := new(MinerSetAutoscalerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MinerSetAutoscalerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinerSetAutoscalerSpec) DeepCopyInto(out *MinerSetAutoscalerSpec) {
+	*out = *in
+	out.ScaleTargetRef = in.ScaleTargetRef
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]MinerSetMetricSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Behavior != nil {
+		in, out := &in.Behavior, &out.Behavior
+		*out = new(MinerSetAutoscalerBehavior)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinerSetAutoscalerSpec.
+func (in *MinerSetAutoscalerSpec) DeepCopy() *MinerSetAutoscalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MinerSetAutoscalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinerSetAutoscalerStatus) DeepCopyInto(out *MinerSetAutoscalerStatus) {
+	*out = *in
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CurrentMetrics != nil {
+		in, out := &in.CurrentMetrics, &out.CurrentMetrics
+		*out = make([]MinerSetMetricStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinerSetAutoscalerStatus.
+func (in *MinerSetAutoscalerStatus) DeepCopy() *MinerSetAutoscalerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MinerSetAutoscalerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MinerSetList) DeepCopyInto(out *MinerSetList) {
 	*out = *in
@@ -445,6 +788,119 @@ func (in *MinerSetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinerSetMetricSpec) DeepCopyInto(out *MinerSetMetricSpec) {
+	*out = *in
+	if in.PodCPU != nil {
+		in, out := &in.PodCPU, &out.PodCPU
+		*out = new(PodCPUMetricSource)
+		**out = **in
+	}
+	if in.ChainBlockInterval != nil {
+		in, out := &in.ChainBlockInterval, &out.ChainBlockInterval
+		*out = new(ChainBlockIntervalMetricSource)
+		**out = **in
+	}
+	if in.PendingChargeRequests != nil {
+		in, out := &in.PendingChargeRequests, &out.PendingChargeRequests
+		*out = new(PendingChargeRequestsMetricSource)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(ScheduleMetricSource)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinerSetMetricSpec.
+func (in *MinerSetMetricSpec) DeepCopy() *MinerSetMetricSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MinerSetMetricSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinerSetMetricStatus) DeepCopyInto(out *MinerSetMetricStatus) {
+	*out = *in
+	out.Current = in.Current.DeepCopy()
+	if in.DesiredReplicas != nil {
+		in, out := &in.DesiredReplicas, &out.DesiredReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinerSetMetricStatus.
+func (in *MinerSetMetricStatus) DeepCopy() *MinerSetMetricStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MinerSetMetricStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinerSetRevision) DeepCopyInto(out *MinerSetRevision) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.CreationTimestamp.DeepCopyInto(&out.CreationTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinerSetRevision.
+func (in *MinerSetRevision) DeepCopy() *MinerSetRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(MinerSetRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinerSetRollback) DeepCopyInto(out *MinerSetRollback) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinerSetRollback.
+func (in *MinerSetRollback) DeepCopy() *MinerSetRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(MinerSetRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinerSetScalingRules) DeepCopyInto(out *MinerSetScalingRules) {
+	*out = *in
+	if in.StabilizationWindowSeconds != nil {
+		in, out := &in.StabilizationWindowSeconds, &out.StabilizationWindowSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinerSetScalingRules.
+func (in *MinerSetScalingRules) DeepCopy() *MinerSetScalingRules {
+	if in == nil {
+		return nil
+	}
+	out := new(MinerSetScalingRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MinerSetSpec) DeepCopyInto(out *MinerSetSpec) {
 	*out = *in
@@ -460,6 +916,17 @@ func (in *MinerSetSpec) DeepCopyInto(out *MinerSetSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	in.Strategy.DeepCopyInto(&out.Strategy)
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RollbackTo != nil {
+		in, out := &in.RollbackTo, &out.RollbackTo
+		*out = new(MinerSetRollback)
+		**out = **in
+	}
 	return
 }
 
@@ -493,6 +960,13 @@ func (in *MinerSetStatus) DeepCopyInto(out *MinerSetStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]MinerSetRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -506,15 +980,41 @@ func (in *MinerSetStatus) DeepCopy() *MinerSetStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinerSetStrategy) DeepCopyInto(out *MinerSetStrategy) {
+	*out = *in
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(RollingUpdateMinerSet)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinerSetStrategy.
+func (in *MinerSetStrategy) DeepCopy() *MinerSetStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(MinerSetStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MinerSpec) DeepCopyInto(out *MinerSpec) {
 	*out = *in
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	if in.PodDeletionTimeout != nil {
 		in, out := &in.PodDeletionTimeout, &out.PodDeletionTimeout
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.PodOverrides != nil {
+		in, out := &in.PodOverrides, &out.PodOverrides
+		*out = new(MinerPodOverrides)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -533,7 +1033,7 @@ func (in *MinerStatus) DeepCopyInto(out *MinerStatus) {
 	*out = *in
 	if in.PodRef != nil {
 		in, out := &in.PodRef, &out.PodRef
-		*out = new(corev1.ObjectReference)
+		*out = new(v1.ObjectReference)
 		**out = **in
 	}
 	if in.LastUpdated != nil {
@@ -623,6 +1123,38 @@ func (in *ObjectMeta) DeepCopy() *ObjectMeta {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingChargeRequestsMetricSource) DeepCopyInto(out *PendingChargeRequestsMetricSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingChargeRequestsMetricSource.
+func (in *PendingChargeRequestsMetricSource) DeepCopy() *PendingChargeRequestsMetricSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingChargeRequestsMetricSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodCPUMetricSource) DeepCopyInto(out *PodCPUMetricSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodCPUMetricSource.
+func (in *PodCPUMetricSource) DeepCopy() *PodCPUMetricSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PodCPUMetricSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodInfo) DeepCopyInto(out *PodInfo) {
 	*out = *in
@@ -638,3 +1170,46 @@ func (in *PodInfo) DeepCopy() *PodInfo {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollingUpdateMinerSet) DeepCopyInto(out *RollingUpdateMinerSet) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingUpdateMinerSet.
+func (in *RollingUpdateMinerSet) DeepCopy() *RollingUpdateMinerSet {
+	if in == nil {
+		return nil
+	}
+	out := new(RollingUpdateMinerSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleMetricSource) DeepCopyInto(out *ScheduleMetricSource) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleMetricSource.
+func (in *ScheduleMetricSource) DeepCopy() *ScheduleMetricSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleMetricSource)
+	in.DeepCopyInto(out)
+	return out
+}
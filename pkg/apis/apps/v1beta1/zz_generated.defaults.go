@@ -20,9 +20,13 @@ import (
 func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&Chain{}, func(obj interface{}) { SetObjectDefaults_Chain(obj.(*Chain)) })
 	scheme.AddTypeDefaultingFunc(&ChainList{}, func(obj interface{}) { SetObjectDefaults_ChainList(obj.(*ChainList)) })
+	scheme.AddTypeDefaultingFunc(&ChargeRequest{}, func(obj interface{}) { SetObjectDefaults_ChargeRequest(obj.(*ChargeRequest)) })
+	scheme.AddTypeDefaultingFunc(&ChargeRequestList{}, func(obj interface{}) { SetObjectDefaults_ChargeRequestList(obj.(*ChargeRequestList)) })
 	scheme.AddTypeDefaultingFunc(&Miner{}, func(obj interface{}) { SetObjectDefaults_Miner(obj.(*Miner)) })
 	scheme.AddTypeDefaultingFunc(&MinerList{}, func(obj interface{}) { SetObjectDefaults_MinerList(obj.(*MinerList)) })
 	scheme.AddTypeDefaultingFunc(&MinerSet{}, func(obj interface{}) { SetObjectDefaults_MinerSet(obj.(*MinerSet)) })
+	scheme.AddTypeDefaultingFunc(&MinerSetAutoscaler{}, func(obj interface{}) { SetObjectDefaults_MinerSetAutoscaler(obj.(*MinerSetAutoscaler)) })
+	scheme.AddTypeDefaultingFunc(&MinerSetAutoscalerList{}, func(obj interface{}) { SetObjectDefaults_MinerSetAutoscalerList(obj.(*MinerSetAutoscalerList)) })
 	scheme.AddTypeDefaultingFunc(&MinerSetList{}, func(obj interface{}) { SetObjectDefaults_MinerSetList(obj.(*MinerSetList)) })
 	return nil
 }
@@ -39,6 +43,17 @@ func SetObjectDefaults_ChainList(in *ChainList) {
 	}
 }
 
+func SetObjectDefaults_ChargeRequest(in *ChargeRequest) {
+	SetDefaults_ChargeRequestSpec(&in.Spec)
+}
+
+func SetObjectDefaults_ChargeRequestList(in *ChargeRequestList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ChargeRequest(a)
+	}
+}
+
 func SetObjectDefaults_Miner(in *Miner) {
 	SetDefaults_Miner(in)
 	SetDefaults_MinerSpec(&in.Spec)
@@ -52,7 +67,23 @@ func SetObjectDefaults_MinerList(in *MinerList) {
 }
 
 func SetObjectDefaults_MinerSet(in *MinerSet) {
+	SetDefaults_MinerSetSpec(&in.Spec)
 	SetDefaults_MinerSpec(&in.Spec.Template.Spec)
+	for i := range in.Status.History {
+		a := &in.Status.History[i]
+		SetDefaults_MinerSpec(&a.Spec)
+	}
+}
+
+func SetObjectDefaults_MinerSetAutoscaler(in *MinerSetAutoscaler) {
+	SetDefaults_MinerSetAutoscalerSpec(&in.Spec)
+}
+
+func SetObjectDefaults_MinerSetAutoscalerList(in *MinerSetAutoscalerList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_MinerSetAutoscaler(a)
+	}
 }
 
 func SetObjectDefaults_MinerSetList(in *MinerSetList) {
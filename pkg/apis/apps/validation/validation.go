@@ -7,9 +7,18 @@
 package validation
 
 import (
+	"strings"
+
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	appsvalidation "k8s.io/kubernetes/pkg/apis/apps/validation"
+	"k8s.io/kubernetes/pkg/apis/core"
 	corevalidation "k8s.io/kubernetes/pkg/apis/core/validation"
+	"k8s.io/kubernetes/pkg/apis/scheduling"
 
 	"github.com/superproj/onex/pkg/apis/apps"
 )
@@ -23,6 +32,7 @@ var ValidateMinerSetName = apimachineryvalidation.NameIsDNSSubdomain
 // ValidateMiner validates a given Miner.
 func ValidateMiner(obj *apps.Miner) field.ErrorList {
 	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, ValidateMinerSpec(&obj.Spec, field.NewPath("spec"))...)
 	return allErrs
 }
 
@@ -30,6 +40,167 @@ func ValidateMiner(obj *apps.Miner) field.ErrorList {
 func ValidateMinerSpec(spec *apps.MinerSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	allErrs = append(allErrs, validateMinerPodMetadata(&spec.ObjectMeta, fldPath.Child("metadata"))...)
+	if spec.PodOverrides != nil {
+		allErrs = append(allErrs, validateMinerPodOverrides(spec.PodOverrides, spec.Labels, fldPath.Child("podOverrides"))...)
+	}
+
+	return allErrs
+}
+
+// validateMinerPodMetadata validates the labels and annotations of the miner pod. The keys
+// in the apps.onex.io domain are reserved for the miner controller.
+func validateMinerPodMetadata(meta *apps.ObjectMeta, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabels(meta.Labels, fldPath.Child("labels"))...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateAnnotations(meta.Annotations, fldPath.Child("annotations"))...)
+	for k := range meta.Labels {
+		if isReservedKey(k) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("labels").Key(k), "is reserved for the miner controller"))
+		}
+	}
+	for k := range meta.Annotations {
+		if isReservedKey(k) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("annotations").Key(k), "is reserved for the miner controller"))
+		}
+	}
+
+	return allErrs
+}
+
+func isReservedKey(key string) bool {
+	prefix, _, found := strings.Cut(key, "/")
+	return found && (prefix == apps.GroupName || strings.HasSuffix(prefix, "."+apps.GroupName))
+}
+
+// validateMinerPodOverrides validates the pod overrides of a miner.
+func validateMinerPodOverrides(overrides *apps.MinerPodOverrides, labels map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	podSpec := &core.PodSpec{
+		NodeSelector:              overrides.NodeSelector,
+		Tolerations:               overrides.Tolerations,
+		Affinity:                  overrides.Affinity,
+		TopologySpreadConstraints: overrides.TopologySpreadConstraints,
+		PriorityClassName:         overrides.PriorityClassName,
+	}
+	allErrs = append(allErrs, validatePodSpecFields(podSpec, labels, fldPath,
+		"nodeSelector", "tolerations", "affinity", "topologySpreadConstraints", "priorityClassName")...)
+
+	allErrs = append(allErrs, validateMinerTolerations(overrides.Tolerations, fldPath.Child("tolerations"))...)
+	allErrs = append(allErrs, validateMinerNodeSelection(overrides.NodeSelector, overrides.Affinity, fldPath)...)
+
+	if strings.HasPrefix(overrides.PriorityClassName, scheduling.SystemPriorityClassPrefix) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("priorityClassName"), "system priority classes are reserved for the system"))
+	}
+
+	allErrs = append(allErrs, corevalidation.ValidateEnv(overrides.Env, fldPath.Child("env"), corevalidation.PodValidationOptions{})...)
+	for i, env := range overrides.Env {
+		if env.ValueFrom == nil {
+			continue
+		}
+		if env.ValueFrom.ConfigMapKeyRef != nil || env.ValueFrom.SecretKeyRef != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("env").Index(i).Child("valueFrom"),
+				"only fieldRef and resourceFieldRef are allowed"))
+		}
+	}
+
+	return allErrs
+}
+
+// controlPlaneKeys are the label and taint keys of the control plane nodes, which the miners
+// must not be scheduled to.
+var controlPlaneKeys = sets.New("node-role.kubernetes.io/control-plane", "node-role.kubernetes.io/master")
+
+// validateMinerTolerations forbids the tolerations which would let the miners run on the nodes
+// reserved for the system: the miners may only tolerate the NoSchedule and PreferNoSchedule
+// taints of the given keys, except the taints of the control plane and of the node lifecycle.
+func validateMinerTolerations(tolerations []core.Toleration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, toleration := range tolerations {
+		idxPath := fldPath.Index(i)
+		switch {
+		case toleration.Key == "":
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("key"), "tolerating all taints is not allowed"))
+		case controlPlaneKeys.Has(toleration.Key) || strings.HasPrefix(toleration.Key, "node.kubernetes.io/"):
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("key"), "tolerating the taints of the system is not allowed"))
+		}
+
+		if toleration.Effect != core.TaintEffectNoSchedule && toleration.Effect != core.TaintEffectPreferNoSchedule {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("effect"), toleration.Effect,
+				[]string{string(core.TaintEffectNoSchedule), string(core.TaintEffectPreferNoSchedule)}))
+		}
+	}
+
+	return allErrs
+}
+
+// validateMinerNodeSelection forbids selecting the control plane nodes with the node selector
+// or the node affinity.
+func validateMinerNodeSelection(nodeSelector map[string]string, affinity *core.Affinity, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for k := range nodeSelector {
+		if controlPlaneKeys.Has(k) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("nodeSelector").Key(k), "selecting the control plane nodes is not allowed"))
+		}
+	}
+
+	if affinity == nil || affinity.NodeAffinity == nil {
+		return allErrs
+	}
+
+	validateTerm := func(term *core.NodeSelectorTerm, termPath *field.Path) {
+		for i, req := range term.MatchExpressions {
+			if controlPlaneKeys.Has(req.Key) {
+				allErrs = append(allErrs, field.Forbidden(termPath.Child("matchExpressions").Index(i).Child("key"),
+					"selecting the control plane nodes is not allowed"))
+			}
+		}
+	}
+
+	nodeAffinityPath := fldPath.Child("affinity", "nodeAffinity")
+	if required := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; required != nil {
+		termsPath := nodeAffinityPath.Child("requiredDuringSchedulingIgnoredDuringExecution", "nodeSelectorTerms")
+		for i := range required.NodeSelectorTerms {
+			validateTerm(&required.NodeSelectorTerms[i], termsPath.Index(i))
+		}
+	}
+	for i := range affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+		termPath := nodeAffinityPath.Child("preferredDuringSchedulingIgnoredDuringExecution").Index(i).Child("preference")
+		validateTerm(&affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution[i].Preference, termPath)
+	}
+
+	return allErrs
+}
+
+// validatePodSpecFields validates the given fields of the miner pods. The scheduling constraints
+// are validated as part of a pod spec, which is the only way to reuse the validation of the affinity
+// and the topology spread constraints. Only the errors of the given fields are kept.
+func validatePodSpecFields(podSpec *core.PodSpec, labels map[string]string, fldPath *field.Path, fields ...string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	podSpec.Containers = []core.Container{{
+		Name:                     "toyblc",
+		Image:                    "toyblc",
+		ImagePullPolicy:          core.PullIfNotPresent,
+		TerminationMessagePolicy: core.TerminationMessageReadFile,
+	}}
+	podSpec.RestartPolicy = core.RestartPolicyAlways
+	podSpec.DNSPolicy = core.DNSClusterFirst
+
+	for _, err := range corevalidation.ValidatePodSpec(podSpec, &metav1.ObjectMeta{Labels: labels}, fldPath, corevalidation.PodValidationOptions{}) {
+		for _, name := range fields {
+			path := fldPath.Child(name).String()
+			if err.Field == path || strings.HasPrefix(err.Field, path+".") || strings.HasPrefix(err.Field, path+"[") {
+				allErrs = append(allErrs, err)
+				break
+			}
+		}
+	}
+
 	return allErrs
 }
 
@@ -43,6 +214,7 @@ func ValidateMinerStatus(status *apps.MinerStatus, fldPath *field.Path) field.Er
 // ValidateMinerUpdate tests if an update to a Miner is valid.
 func ValidateMinerUpdate(update, old *apps.Miner) field.ErrorList {
 	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, ValidateMinerSpec(&update.Spec, field.NewPath("spec"))...)
 
 	return allErrs
 }
@@ -66,6 +238,7 @@ func ValidateMinerStatusUpdate(update, old *apps.Miner) field.ErrorList {
 // ValidateMinerSet validates a given MinerSet.
 func ValidateMinerSet(obj *apps.MinerSet) field.ErrorList {
 	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, ValidateMinerSetSpec(&obj.Spec, field.NewPath("spec"))...)
 	return allErrs
 }
 
@@ -73,6 +246,72 @@ func ValidateMinerSet(obj *apps.MinerSet) field.ErrorList {
 func ValidateMinerSetSpec(spec *apps.MinerSetSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(spec.MinReadySeconds), fldPath.Child("minReadySeconds"))...)
+	allErrs = append(allErrs, ValidateMinerSetStrategy(&spec.Strategy, fldPath.Child("strategy"))...)
+	allErrs = append(allErrs, ValidateMinerSpec(&spec.Template.Spec, fldPath.Child("template", "spec"))...)
+	if spec.RevisionHistoryLimit != nil {
+		allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(*spec.RevisionHistoryLimit), fldPath.Child("revisionHistoryLimit"))...)
+	}
+	if spec.ProgressDeadlineSeconds != nil {
+		allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(*spec.ProgressDeadlineSeconds), fldPath.Child("progressDeadlineSeconds"))...)
+		if *spec.ProgressDeadlineSeconds <= spec.MinReadySeconds {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("progressDeadlineSeconds"), spec.ProgressDeadlineSeconds, "must be greater than minReadySeconds"))
+		}
+	}
+	if spec.RollbackTo != nil {
+		allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(spec.RollbackTo.Revision, fldPath.Child("rollbackTo", "revision"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateMinerSetStrategy validates given minerset strategy.
+func ValidateMinerSetStrategy(strategy *apps.MinerSetStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch strategy.Type {
+	case apps.RecreateMinerSetStrategyType:
+		if strategy.RollingUpdate != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("rollingUpdate"), "may not be specified when strategy `type` is '"+string(apps.RecreateMinerSetStrategyType+"'")))
+		}
+	case apps.RollingUpdateMinerSetStrategyType:
+		// This should never happen since it's set and checked in defaults.go
+		if strategy.RollingUpdate == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("rollingUpdate"), "this should be defaulted and never be nil"))
+		} else {
+			allErrs = append(allErrs, validateRollingUpdateMinerSet(strategy.RollingUpdate, fldPath.Child("rollingUpdate"))...)
+		}
+	default:
+		validValues := []string{string(apps.RecreateMinerSetStrategyType), string(apps.RollingUpdateMinerSetStrategyType)}
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), strategy.Type, validValues))
+	}
+
+	return allErrs
+}
+
+func validateRollingUpdateMinerSet(rollingUpdate *apps.RollingUpdateMinerSet, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	maxUnavailable, maxSurge := intstr.FromInt32(0), intstr.FromInt32(0)
+	if rollingUpdate.MaxUnavailable != nil {
+		maxUnavailable = *rollingUpdate.MaxUnavailable
+	}
+	if rollingUpdate.MaxSurge != nil {
+		maxSurge = *rollingUpdate.MaxSurge
+	}
+
+	allErrs = append(allErrs, appsvalidation.ValidatePositiveIntOrPercent(maxUnavailable, fldPath.Child("maxUnavailable"))...)
+	allErrs = append(allErrs, appsvalidation.ValidatePositiveIntOrPercent(maxSurge, fldPath.Child("maxSurge"))...)
+	// Validate that MaxUnavailable is not more than 100%.
+	allErrs = append(allErrs, appsvalidation.IsNotMoreThan100Percent(maxUnavailable, fldPath.Child("maxUnavailable"))...)
+
+	unavailable, _ := intstr.GetScaledValueFromIntOrPercent(&maxUnavailable, 100, false)
+	surge, _ := intstr.GetScaledValueFromIntOrPercent(&maxSurge, 100, true)
+	if unavailable == 0 && surge == 0 {
+		// Both MaxSurge and MaxUnavailable cannot be zero.
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), maxUnavailable, "may not be 0 when `maxSurge` is 0"))
+	}
+
 	return allErrs
 }
 
@@ -86,7 +325,7 @@ func ValidateMinerSetStatus(status *apps.MinerSetStatus, fldPath *field.Path) fi
 // ValidateMinerSetUpdate tests if an update to a MinerSet is valid.
 func ValidateMinerSetUpdate(update, old *apps.MinerSet) field.ErrorList {
 	allErrs := field.ErrorList{}
-
+	allErrs = append(allErrs, ValidateMinerSetSpec(&update.Spec, field.NewPath("spec"))...)
 	return allErrs
 }
 
@@ -0,0 +1,126 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package validation
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corevalidation "k8s.io/kubernetes/pkg/apis/core/validation"
+
+	"github.com/superproj/onex/pkg/apis/apps"
+)
+
+const (
+	// maxChargeRequestConfirmations is the maximum number of confirmations a charge can wait for.
+	maxChargeRequestConfirmations int32 = 100
+	// minChargeRequestSaltSize is the minimum size in bytes of the salt of the signing key.
+	minChargeRequestSaltSize = 16
+)
+
+// addressRegexp matches the account addresses of toyblc.
+var addressRegexp = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+
+// ValidateChargeRequestName validates that the given name can be used as a chargerequest name.
+var ValidateChargeRequestName = apimachineryvalidation.NameIsDNSSubdomain
+
+// ValidateChargeRequest validates a given ChargeRequest.
+func ValidateChargeRequest(obj *apps.ChargeRequest) field.ErrorList {
+	allErrs := corevalidation.ValidateObjectMeta(&obj.ObjectMeta, true, ValidateChargeRequestName, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateChargeRequestSpec(&obj.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateChargeRequestSpec validates given chargerequest spec.
+func ValidateChargeRequestSpec(spec *apps.ChargeRequestSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.From == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("from"), ""))
+	} else if !addressRegexp.MatchString(spec.From) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("from"), spec.From, "must be a 0x-prefixed hex address of 20 bytes"))
+	}
+
+	if spec.Salt == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("salt"), ""))
+	} else if salt, err := hex.DecodeString(spec.Salt); err != nil || len(salt) < minChargeRequestSaltSize {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("salt"), spec.Salt,
+			fmt.Sprintf("must be a hex string of at least %d bytes", minChargeRequestSaltSize)))
+	}
+
+	if spec.ChainName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("chainName"), ""))
+	} else {
+		for _, msg := range ValidateChainName(spec.ChainName, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("chainName"), spec.ChainName, msg))
+		}
+	}
+
+	if spec.Amount <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("amount"), spec.Amount, "must be greater than 0"))
+	}
+
+	if spec.Confirmations != nil && (*spec.Confirmations < 1 || *spec.Confirmations > maxChargeRequestConfirmations) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("confirmations"), *spec.Confirmations,
+			"must be between 1 and 100, inclusive"))
+	}
+
+	return allErrs
+}
+
+// ValidateChargeRequestUpdate tests if an update to a ChargeRequest is valid.
+func ValidateChargeRequestUpdate(update, old *apps.ChargeRequest) field.ErrorList {
+	allErrs := corevalidation.ValidateObjectMetaUpdate(&update.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateChargeRequestSpecUpdate(&update.Spec, &old.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateChargeRequestSpecUpdate tests if an update to a ChargeRequestSpec is valid.
+// The spec is immutable, except that the password can be cleared.
+func ValidateChargeRequestSpecUpdate(newSpec, oldSpec *apps.ChargeRequestSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if newSpec.Password != "" && newSpec.Password != oldSpec.Password {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("password"), "may only be cleared"))
+	}
+
+	// Compare the spec without the password, which is checked above.
+	newCopy, oldCopy := *newSpec, *oldSpec
+	newCopy.Password, oldCopy.Password = "", ""
+	if !apiequality.Semantic.DeepEqual(newCopy, oldCopy) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "updates to chargerequest spec for fields other than 'password' are forbidden"))
+	}
+
+	return allErrs
+}
+
+// ValidateChargeRequestStatus validates given chargerequest status.
+func ValidateChargeRequestStatus(status *apps.ChargeRequestStatus, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(status.SubmittedHeight, fldPath.Child("submittedHeight"))...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(status.BlockHeight, fldPath.Child("blockHeight"))...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(status.Confirmations), fldPath.Child("confirmations"))...)
+
+	return allErrs
+}
+
+// ValidateChargeRequestStatusUpdate tests if a an update to a ChargeRequest status
+// is valid.
+func ValidateChargeRequestStatusUpdate(update, old *apps.ChargeRequest) field.ErrorList {
+	allErrs := corevalidation.ValidateObjectMetaUpdate(&update.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	fldPath := field.NewPath("status")
+	allErrs = append(allErrs, ValidateChargeRequestStatus(&update.Status, fldPath)...)
+	if old.Status.TransactionID != "" && update.Status.TransactionID != old.Status.TransactionID {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("transactionID"), "may not be changed once set"))
+	}
+	return allErrs
+}
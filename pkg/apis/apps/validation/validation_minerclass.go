@@ -0,0 +1,79 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package validation
+
+import (
+	"strings"
+
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/api/validation/path"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kubernetes/pkg/apis/core"
+	corevalidation "k8s.io/kubernetes/pkg/apis/core/validation"
+
+	"github.com/superproj/onex/pkg/apis/apps"
+)
+
+// maxMiningDifficulty is the maximum mining difficulty supported by toyblc,
+// i.e. the number of bits of a block hash.
+const maxMiningDifficulty int32 = 256
+
+// ValidateMinerClassName validates that the given name can be used as a minerclass name.
+// The miner types, e.g. `S1.SMALL1`, predate the minerclasses and are not DNS subdomains,
+// so any name which can be used in a path is allowed.
+var ValidateMinerClassName = path.ValidatePathSegmentName
+
+// ValidateMinerClass validates a given MinerClass.
+func ValidateMinerClass(obj *apps.MinerClass) field.ErrorList {
+	allErrs := corevalidation.ValidateObjectMeta(&obj.ObjectMeta, false, ValidateMinerClassName, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateMinerClassSpec(&obj.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateMinerClassSpec validates given minerclass spec.
+func ValidateMinerClassSpec(spec *apps.MinerClassSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	resPath := fldPath.Child("resources")
+	allErrs = append(allErrs, corevalidation.ValidateResourceRequirements(&spec.Resources, nil, resPath, corevalidation.PodValidationOptions{})...)
+	// The cpu and memory limits are reported by the miner annotations, so they are required.
+	for _, name := range []core.ResourceName{core.ResourceCPU, core.ResourceMemory} {
+		if _, ok := spec.Resources.Limits[name]; !ok {
+			allErrs = append(allErrs, field.Required(resPath.Child("limits").Key(string(name)), ""))
+		}
+	}
+
+	if spec.MiningDifficulty < 0 || spec.MiningDifficulty > maxMiningDifficulty {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("miningDifficulty"), spec.MiningDifficulty,
+			"must be between 0 and 256, inclusive"))
+	}
+
+	podSpec := &core.PodSpec{
+		NodeSelector: spec.NodeSelector,
+		Tolerations:  spec.Tolerations,
+		Affinity:     spec.Affinity,
+	}
+	allErrs = append(allErrs, validatePodSpecFields(podSpec, nil, fldPath, "nodeSelector", "tolerations", "affinity")...)
+
+	if len(strings.TrimSpace(spec.Image)) != len(spec.Image) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("image"), spec.Image, "must not have leading or trailing whitespace"))
+	}
+
+	if spec.Pricing != nil {
+		allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(spec.Pricing.HourlyPrice, fldPath.Child("pricing", "hourlyPrice"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateMinerClassUpdate tests if an update to a MinerClass is valid.
+// The spec can be updated, the changes only apply to the miner pods created afterwards.
+func ValidateMinerClassUpdate(update, old *apps.MinerClass) field.ErrorList {
+	allErrs := corevalidation.ValidateObjectMetaUpdate(&update.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateMinerClassSpec(&update.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
@@ -0,0 +1,176 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package validation
+
+import (
+	"github.com/robfig/cron/v3"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corevalidation "k8s.io/kubernetes/pkg/apis/core/validation"
+
+	"github.com/superproj/onex/pkg/apis/apps"
+)
+
+// maxStabilizationWindowSeconds is the maximum value of the stabilization windows, i.e. one hour.
+const maxStabilizationWindowSeconds int32 = 3600
+
+// ValidateMinerSetAutoscalerName validates that the given name can be used as a minersetautoscaler name.
+var ValidateMinerSetAutoscalerName = apimachineryvalidation.NameIsDNSSubdomain
+
+// ValidateMinerSetAutoscaler validates a given MinerSetAutoscaler.
+func ValidateMinerSetAutoscaler(obj *apps.MinerSetAutoscaler) field.ErrorList {
+	allErrs := corevalidation.ValidateObjectMeta(&obj.ObjectMeta, true, ValidateMinerSetAutoscalerName, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateMinerSetAutoscalerSpec(&obj.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateMinerSetAutoscalerSpec validates given minersetautoscaler spec.
+func ValidateMinerSetAutoscalerSpec(spec *apps.MinerSetAutoscalerSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.ScaleTargetRef.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("scaleTargetRef", "name"), ""))
+	} else {
+		for _, msg := range ValidateMinerSetName(spec.ScaleTargetRef.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleTargetRef", "name"), spec.ScaleTargetRef.Name, msg))
+		}
+	}
+
+	minReplicas := int32(1)
+	if spec.MinReplicas != nil {
+		minReplicas = *spec.MinReplicas
+		allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(minReplicas), fldPath.Child("minReplicas"))...)
+	}
+	if spec.MaxReplicas < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), spec.MaxReplicas, "must be greater than or equal to 1"))
+	}
+	if spec.MaxReplicas < minReplicas {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), spec.MaxReplicas, "must be greater than or equal to `minReplicas`"))
+	}
+
+	if len(spec.Metrics) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("metrics"), "must specify at least one metric"))
+	}
+	for i := range spec.Metrics {
+		allErrs = append(allErrs, validateMinerSetMetricSpec(&spec.Metrics[i], spec.MaxReplicas, fldPath.Child("metrics").Index(i))...)
+	}
+
+	if spec.Behavior != nil {
+		allErrs = append(allErrs, validateMinerSetScalingRules(spec.Behavior.ScaleUp, fldPath.Child("behavior", "scaleUp"))...)
+		allErrs = append(allErrs, validateMinerSetScalingRules(spec.Behavior.ScaleDown, fldPath.Child("behavior", "scaleDown"))...)
+	}
+
+	return allErrs
+}
+
+func validateMinerSetMetricSpec(spec *apps.MinerSetMetricSpec, maxReplicas int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	sources := map[apps.MinerSetMetricSourceType]bool{
+		apps.PodCPUMetricSourceType:                spec.PodCPU != nil,
+		apps.ChainBlockIntervalMetricSourceType:    spec.ChainBlockInterval != nil,
+		apps.PendingChargeRequestsMetricSourceType: spec.PendingChargeRequests != nil,
+		apps.ScheduleMetricSourceType:              spec.Schedule != nil,
+	}
+
+	set, ok := sources[spec.Type]
+	if !ok {
+		validValues := []string{
+			string(apps.PodCPUMetricSourceType),
+			string(apps.ChainBlockIntervalMetricSourceType),
+			string(apps.PendingChargeRequestsMetricSourceType),
+			string(apps.ScheduleMetricSourceType),
+		}
+		return append(allErrs, field.NotSupported(fldPath.Child("type"), spec.Type, validValues))
+	}
+	if !set {
+		allErrs = append(allErrs, field.Required(fldPath, "must populate information for the given metric source `"+string(spec.Type)+"`"))
+	}
+	for typ, set := range sources {
+		if set && typ != spec.Type {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "must populate the given metric source only: `"+string(spec.Type)+"`"))
+			break
+		}
+	}
+
+	if spec.PodCPU != nil && spec.PodCPU.TargetAverageUtilization < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("podCPU", "targetAverageUtilization"),
+			spec.PodCPU.TargetAverageUtilization, "must be greater than 0"))
+	}
+	if spec.ChainBlockInterval != nil && spec.ChainBlockInterval.TargetSeconds < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("chainBlockInterval", "targetSeconds"),
+			spec.ChainBlockInterval.TargetSeconds, "must be greater than 0"))
+	}
+	if spec.PendingChargeRequests != nil && spec.PendingChargeRequests.TargetPerReplica < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("pendingChargeRequests", "targetPerReplica"),
+			spec.PendingChargeRequests.TargetPerReplica, "must be greater than 0"))
+	}
+	if spec.Schedule != nil {
+		allErrs = append(allErrs, validateScheduleMetricSource(spec.Schedule, maxReplicas, fldPath.Child("schedule"))...)
+	}
+
+	return allErrs
+}
+
+func validateScheduleMetricSource(src *apps.ScheduleMetricSource, maxReplicas int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if src.Schedule == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("schedule"), ""))
+	} else if _, err := cron.ParseStandard(src.Schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("schedule"), src.Schedule, err.Error()))
+	}
+	if src.Duration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("duration"), src.Duration.Duration.String(), "must be greater than 0"))
+	}
+	allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(src.Replicas), fldPath.Child("replicas"))...)
+	if src.Replicas > maxReplicas {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("replicas"), src.Replicas, "must be less than or equal to `maxReplicas`"))
+	}
+
+	return allErrs
+}
+
+func validateMinerSetScalingRules(rules *apps.MinerSetScalingRules, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if rules == nil || rules.StabilizationWindowSeconds == nil {
+		return allErrs
+	}
+
+	window := *rules.StabilizationWindowSeconds
+	if window < 0 || window > maxStabilizationWindowSeconds {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("stabilizationWindowSeconds"), window, "must be between 0 and 3600"))
+	}
+
+	return allErrs
+}
+
+// ValidateMinerSetAutoscalerUpdate tests if an update to a MinerSetAutoscaler is valid.
+func ValidateMinerSetAutoscalerUpdate(update, old *apps.MinerSetAutoscaler) field.ErrorList {
+	allErrs := corevalidation.ValidateObjectMetaUpdate(&update.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateMinerSetAutoscalerSpec(&update.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateMinerSetAutoscalerStatus validates given minersetautoscaler status.
+func ValidateMinerSetAutoscalerStatus(status *apps.MinerSetAutoscalerStatus, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(status.CurrentReplicas), fldPath.Child("currentReplicas"))...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(status.DesiredReplicas), fldPath.Child("desiredReplicas"))...)
+
+	return allErrs
+}
+
+// ValidateMinerSetAutoscalerStatusUpdate tests if a an update to a MinerSetAutoscaler status
+// is valid.
+func ValidateMinerSetAutoscalerStatusUpdate(update, old *apps.MinerSetAutoscaler) field.ErrorList {
+	allErrs := corevalidation.ValidateObjectMetaUpdate(&update.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	fldPath := field.NewPath("status")
+	allErrs = append(allErrs, ValidateMinerSetAutoscalerStatus(&update.Status, fldPath)...)
+	return allErrs
+}
@@ -0,0 +1,20 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+// Package hmac implements the signing of HTTP requests with a secret id and
+// secret key pair (AK/SK), which machine clients use instead of bearer tokens.
+//
+// The client builds a canonical form of the request from its method, path,
+// query, the signed headers and the SHA-256 digest of its body, and signs it
+// with HMAC-SHA256 together with a timestamp and a random nonce:
+//
+//	Authorization: ONEX-HMAC-SHA256 Credential=<secret id>, Signature=<hex signature>
+//	X-Onex-Timestamp: <unix seconds>
+//	X-Onex-Nonce: <random string>
+//
+// The server rejects requests whose timestamp is too far from its clock, and
+// remembers the nonces until then, so that a signed request can not be replayed.
+package hmac // import "github.com/superproj/onex/pkg/authn/hmac"
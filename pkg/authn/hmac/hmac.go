@@ -0,0 +1,242 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package hmac
+
+import (
+	"bytes"
+	stdhmac "crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// Algorithm is the authorization scheme of the signed requests.
+	Algorithm = "ONEX-HMAC-SHA256"
+
+	// TimestampHeader carries the unix time the request is signed at.
+	TimestampHeader = "X-Onex-Timestamp"
+	// NonceHeader carries a random string which is unique for each request.
+	NonceHeader = "X-Onex-Nonce"
+
+	// MaxSkew is the maximum difference between the timestamp of a request and
+	// the clock of the server. Nonces must be remembered at least twice as long.
+	MaxSkew = 5 * time.Minute
+
+	// MaxBodySize is the maximum size of the body of a signed request read by the server.
+	MaxBodySize = 8 << 20
+
+	authorizationHeader = "Authorization"
+)
+
+// SignedHeaders are the headers covered by the signature besides the timestamp
+// and the nonce. The tenant is signed because it changes what the request is authorized for.
+var SignedHeaders = []string{"X-Tenant-ID"}
+
+var (
+	// ErrNotSigned is returned when the request is not signed with Algorithm.
+	ErrNotSigned = errors.New("hmac: request is not signed")
+	// ErrMalformed is returned when the authorization of the request can not be parsed.
+	ErrMalformed = errors.New("hmac: malformed authorization")
+	// ErrExpired is returned when the timestamp of the request is too far from the current time.
+	ErrExpired = errors.New("hmac: request timestamp is out of range")
+)
+
+// Credential is the parsed authorization of a signed request.
+type Credential struct {
+	SecretID  string
+	Signature string
+	Timestamp time.Time
+	Nonce     string
+}
+
+// Signed reports whether the request claims to be signed with Algorithm.
+func Signed(header http.Header) bool {
+	return strings.HasPrefix(header.Get(authorizationHeader), Algorithm+" ")
+}
+
+// ParseCredential parses the authorization, the timestamp and the nonce of a signed request.
+func ParseCredential(header http.Header) (*Credential, error) {
+	if !Signed(header) {
+		return nil, ErrNotSigned
+	}
+
+	var c Credential
+	for _, field := range strings.Split(strings.TrimPrefix(header.Get(authorizationHeader), Algorithm+" "), ",") {
+		k, v, _ := strings.Cut(strings.TrimSpace(field), "=")
+		switch k {
+		case "Credential":
+			c.SecretID = v
+		case "Signature":
+			c.Signature = v
+		}
+	}
+
+	ts, err := strconv.ParseInt(header.Get(TimestampHeader), 10, 64)
+	if err != nil || c.SecretID == "" || c.Signature == "" {
+		return nil, ErrMalformed
+	}
+	c.Timestamp = time.Unix(ts, 0)
+
+	c.Nonce = header.Get(NonceHeader)
+	if c.Nonce == "" {
+		return nil, ErrMalformed
+	}
+
+	return &c, nil
+}
+
+// Check returns ErrExpired if the request was not signed within MaxSkew of now.
+func (c *Credential) Check(now time.Time) error {
+	if d := now.Sub(c.Timestamp); d > MaxSkew || d < -MaxSkew {
+		return ErrExpired
+	}
+
+	return nil
+}
+
+// StringToSign returns the string signed by the client for the request with the given body.
+func StringToSign(r *http.Request, body []byte) string {
+	return strings.Join([]string{
+		Algorithm,
+		r.Header.Get(TimestampHeader),
+		r.Header.Get(NonceHeader),
+		hexSHA256([]byte(canonicalRequest(r, body))),
+	}, "\n")
+}
+
+// Sign returns the signature of stringToSign with the secret key.
+func Sign(secretKey string, stringToSign string) string {
+	mac := stdhmac.New(sha256.New, []byte(secretKey))
+	mac.Write([]byte(stringToSign))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is the signature of stringToSign with the secret key.
+func Verify(secretKey string, stringToSign string, signature string) bool {
+	expected := Sign(secretKey, stringToSign)
+	return stdhmac.Equal([]byte(expected), []byte(strings.ToLower(signature)))
+}
+
+// SignRequest signs the request with the secret id and secret key pair. The body
+// is read with GetBody if it is set, otherwise it is read and replaced.
+func SignRequest(r *http.Request, secretID string, secretKey string, now time.Time) error {
+	body, err := readBody(r)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	r.Header.Set(TimestampHeader, strconv.FormatInt(now.Unix(), 10))
+	r.Header.Set(NonceHeader, hex.EncodeToString(nonce))
+	signature := Sign(secretKey, StringToSign(r, body))
+	r.Header.Set(authorizationHeader, Algorithm+" Credential="+secretID+", Signature="+signature)
+
+	return nil
+}
+
+// ReadBody returns the body of a request received by a server, and replaces it
+// so that it can be read again by the handler. A body larger than MaxBodySize
+// fails with a *http.MaxBytesError.
+func ReadBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, MaxBodySize))
+	if err != nil {
+		return nil, err
+	}
+	_ = r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+func readBody(r *http.Request) ([]byte, error) {
+	if r.GetBody == nil {
+		return ReadBody(r)
+	}
+
+	rc, err := r.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return io.ReadAll(rc)
+}
+
+// canonicalRequest returns the canonical form of the request: the method, the
+// escaped path, the sorted query, the signed headers and the digest of the body.
+func canonicalRequest(r *http.Request, body []byte) string {
+	path := r.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	query := r.URL.Query()
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	params := make([]string, 0, len(keys))
+	for _, k := range keys {
+		values := query[k]
+		sort.Strings(values)
+		for _, v := range values {
+			params = append(params, escape(k)+"="+escape(v))
+		}
+	}
+
+	headers := make([]string, 0, len(SignedHeaders)+2)
+	for _, h := range append([]string{NonceHeader, TimestampHeader}, SignedHeaders...) {
+		headers = append(headers, strings.ToLower(h)+":"+strings.TrimSpace(r.Header.Get(h)))
+	}
+
+	return strings.Join([]string{
+		r.Method,
+		path,
+		strings.Join(params, "&"),
+		strings.Join(headers, "\n"),
+		hexSHA256(body),
+	}, "\n")
+}
+
+// escape percent-encodes everything but the unreserved characters of RFC 3986.
+func escape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		b.WriteString("%" + strings.ToUpper(hex.EncodeToString([]byte{c})))
+	}
+
+	return b.String()
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,97 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package hmac
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignRequest(t *testing.T) {
+	now := time.Now()
+	rq, _ := http.NewRequest(http.MethodPost, "http://127.0.0.1:51843/v1/minersets?limit=10&offset=0", bytes.NewReader([]byte(`{"name":"test"}`)))
+	rq.Header.Set("X-Tenant-ID", "tenant-a")
+	assert.Nil(t, SignRequest(rq, "ak", "sk", now))
+
+	tests := []struct {
+		name   string
+		url    string
+		body   string
+		tenant string
+		key    string
+		valid  bool
+	}{
+		{"valid", "/v1/minersets?offset=0&limit=10", `{"name":"test"}`, "tenant-a", "sk", true},
+		{"wrong key", "/v1/minersets?offset=0&limit=10", `{"name":"test"}`, "tenant-a", "other", false},
+		{"tampered body", "/v1/minersets?offset=0&limit=10", `{"name":"other"}`, "tenant-a", "sk", false},
+		{"tampered query", "/v1/minersets?offset=0&limit=100", `{"name":"test"}`, "tenant-a", "sk", false},
+		{"tampered path", "/v1/miners?offset=0&limit=10", `{"name":"test"}`, "tenant-a", "sk", false},
+		{"tampered tenant", "/v1/minersets?offset=0&limit=10", `{"name":"test"}`, "tenant-b", "sk", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, tt.url, bytes.NewReader([]byte(tt.body)))
+			r.Header = rq.Header.Clone()
+			r.Header.Set("X-Tenant-ID", tt.tenant)
+
+			c, err := ParseCredential(r.Header)
+			assert.Nil(t, err)
+			assert.Equal(t, "ak", c.SecretID)
+			assert.Nil(t, c.Check(now))
+
+			body, err := ReadBody(r)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.valid, Verify(tt.key, StringToSign(r, body), c.Signature))
+
+			// The body can still be read by the handler.
+			again, _ := ReadBody(r)
+			assert.Equal(t, body, again)
+		})
+	}
+}
+
+func TestReadBodyTooLarge(t *testing.T) {
+	rq := httptest.NewRequest(http.MethodPost, "/v1/minersets", bytes.NewReader(make([]byte, MaxBodySize+1)))
+
+	_, err := ReadBody(rq)
+	var mbe *http.MaxBytesError
+	assert.ErrorAs(t, err, &mbe)
+}
+
+func TestParseCredential(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name      string
+		header    map[string]string
+		wantErr   error
+		wantCheck error
+	}{
+		{"not signed", map[string]string{"Authorization": "Bearer xxx"}, ErrNotSigned, nil},
+		{"missing signature", map[string]string{"Authorization": "ONEX-HMAC-SHA256 Credential=ak", TimestampHeader: "1", NonceHeader: "n"}, ErrMalformed, nil},
+		{"missing nonce", map[string]string{"Authorization": "ONEX-HMAC-SHA256 Credential=ak, Signature=s", TimestampHeader: "1"}, ErrMalformed, nil},
+		{"expired", map[string]string{"Authorization": "ONEX-HMAC-SHA256 Credential=ak, Signature=s", TimestampHeader: "1", NonceHeader: "n"}, nil, ErrExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			for k, v := range tt.header {
+				header.Set(k, v)
+			}
+
+			c, err := ParseCredential(header)
+			assert.Equal(t, tt.wantErr, err)
+			if err == nil {
+				assert.Equal(t, tt.wantCheck, c.Check(now))
+			}
+		})
+	}
+}
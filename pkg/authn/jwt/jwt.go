@@ -64,6 +64,7 @@ type options struct {
 	expired       time.Duration
 	tokenType     string
 	tokenHeader   map[string]any
+	keySet        *KeySet
 }
 
 // Option is jwt option.
@@ -111,6 +112,48 @@ func WithTokenHeader(header map[string]any) Option {
 	}
 }
 
+// WithKeySet signs the tokens with the current key of the key set, and verifies
+// them with the key set. It overrides the signing method, key and keyfunc.
+func WithKeySet(ks *KeySet) Option {
+	return func(o *options) {
+		o.keySet = ks
+	}
+}
+
+type tokenIDKey struct{}
+
+// NewTokenIDContext returns a copy of ctx carrying the id, which is set as the
+// `jti` claim of the tokens signed with the returned context. Tokens sharing an
+// id, e.g. the tokens of a login session, can be revoked together by Revoke.
+func NewTokenIDContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, tokenIDKey{}, id)
+}
+
+// TokenIDFromContext returns the token id carried by ctx.
+func TokenIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(tokenIDKey{}).(string)
+	return id
+}
+
+// Revoke invalidates all the tokens with the given `jti` claim. expiration should
+// be no shorter than the remaining lifetime of the tokens.
+func Revoke(ctx context.Context, store Storer, id string, expiration time.Duration) error {
+	return store.Set(ctx, revokedKey(id), expiration)
+}
+
+// Revoked reports whether the tokens with the given `jti` claim are revoked.
+func Revoked(ctx context.Context, store Storer, id string) (bool, error) {
+	if id == "" {
+		return false, nil
+	}
+
+	return store.Check(ctx, revokedKey(id))
+}
+
+func revokedKey(id string) string {
+	return "jti:" + id
+}
+
 // New create a authentication instance.
 func New(store Storer, opts ...Option) *JWTAuth {
 	o := defaultOptions
@@ -132,7 +175,14 @@ func (a *JWTAuth) Sign(ctx context.Context, userID string) (authn.IToken, error)
 	now := time.Now()
 	expiresAt := now.Add(a.opts.expired)
 
-	token := jwt.NewWithClaims(a.opts.signingMethod, &jwt.RegisteredClaims{
+	method, key := a.opts.signingMethod, a.opts.signingKey
+	var kid string
+	if ks := a.opts.keySet; ks != nil {
+		current := ks.Current()
+		method, key, kid = current.SigningMethod(), current.PrivateKey, current.ID
+	}
+
+	token := jwt.NewWithClaims(method, &jwt.RegisteredClaims{
 		// Issuer = iss,令牌颁发者。它表示该令牌是由谁创建的
 		Issuer: a.opts.issuer,
 		// IssuedAt = iat,令牌颁发时的时间戳。它表示令牌是何时被创建的
@@ -143,14 +193,19 @@ func (a *JWTAuth) Sign(ctx context.Context, userID string) (authn.IToken, error)
 		NotBefore: jwt.NewNumericDate(now),
 		// Subject = sub,令牌的主体。它表示该令牌是关于谁的
 		Subject: userID,
+		// ID = jti,令牌的唯一标识。同一登录会话签发的令牌共享该标识
+		ID: TokenIDFromContext(ctx),
 	})
 	if a.opts.tokenHeader != nil {
 		for k, v := range a.opts.tokenHeader {
 			token.Header[k] = v
 		}
 	}
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
 
-	refreshToken, err := token.SignedString(a.opts.signingKey)
+	refreshToken, err := token.SignedString(key)
 	if err != nil {
 		return nil, errors.Unauthorized(reason, i18n.FromContext(ctx).LocalizeT(MessageSignTokenFailed))
 	}
@@ -166,7 +221,12 @@ func (a *JWTAuth) Sign(ctx context.Context, userID string) (authn.IToken, error)
 
 // parseToken is used to parse the input refreshToken.
 func (a *JWTAuth) parseToken(ctx context.Context, refreshToken string) (*jwt.RegisteredClaims, error) {
-	token, err := jwt.ParseWithClaims(refreshToken, &jwt.RegisteredClaims{}, a.opts.keyfunc)
+	keyfunc := a.opts.keyfunc
+	if a.opts.keySet != nil {
+		keyfunc = a.opts.keySet.Keyfunc
+	}
+
+	token, err := jwt.ParseWithClaims(refreshToken, &jwt.RegisteredClaims{}, keyfunc)
 	if err != nil {
 		ve, ok := err.(*jwt.ValidationError)
 		if !ok {
@@ -185,7 +245,8 @@ func (a *JWTAuth) parseToken(ctx context.Context, refreshToken string) (*jwt.Reg
 		return nil, errors.Unauthorized(reason, i18n.FromContext(ctx).LocalizeT(MessageTokenInvalid))
 	}
 
-	if token.Method != a.opts.signingMethod {
+	// The key set checks the signing method against the key in keyfunc.
+	if a.opts.keySet == nil && token.Method != a.opts.signingMethod {
 		return nil, errors.Unauthorized(reason, i18n.FromContext(ctx).LocalizeT(MessageUnSupportSigningMethod))
 	}
 
@@ -235,6 +296,15 @@ func (a *JWTAuth) ParseClaims(ctx context.Context, refreshToken string) (*jwt.Re
 			return errors.Unauthorized(reason, i18n.FromContext(ctx).LocalizeT(MessageTokenInvalid))
 		}
 
+		revoked, err := Revoked(ctx, store, claims.ID)
+		if err != nil {
+			return err
+		}
+
+		if revoked {
+			return errors.Unauthorized(reason, i18n.FromContext(ctx).LocalizeT(MessageTokenInvalid))
+		}
+
 		return nil
 	}
 
@@ -0,0 +1,423 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/superproj/onex/pkg/log"
+)
+
+// Asymmetric signing algorithms supported by KeySet.
+const (
+	RS256 = "RS256"
+	ES256 = "ES256"
+	EdDSA = "EdDSA"
+)
+
+const (
+	// rsaKeySize is the modulus size of generated RSA keys.
+	rsaKeySize = 2048
+	// minReloadInterval limits how often the key store is read again when a token
+	// is signed by an unknown key, e.g. a key just rotated by another replica.
+	minReloadInterval = 5 * time.Second
+)
+
+// SigningKey is an asymmetric key pair used to sign tokens.
+type SigningKey struct {
+	// ID is put in the `kid` header of the tokens signed by this key.
+	ID         string
+	Algorithm  string
+	PrivateKey crypto.Signer
+	CreatedAt  time.Time
+}
+
+// GenerateSigningKey generates a new signing key of the given algorithm.
+func GenerateSigningKey(alg string) (*SigningKey, error) {
+	var (
+		priv crypto.Signer
+		err  error
+	)
+	switch alg {
+	case RS256:
+		priv, err = rsa.GenerateKey(rand.Reader, rsaKeySize)
+	case ES256:
+		priv, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case EdDSA:
+		_, priv, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf("jwt: unsupported signing algorithm %q", alg)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+
+	return &SigningKey{ID: hex.EncodeToString(b), Algorithm: alg, PrivateKey: priv, CreatedAt: time.Now()}, nil
+}
+
+// SigningMethod returns the jwt signing method of the key.
+func (k *SigningKey) SigningMethod() jwt.SigningMethod {
+	return jwt.GetSigningMethod(k.Algorithm)
+}
+
+// JSONWebKey returns the public part of the key in JWK format.
+func (k *SigningKey) JSONWebKey() (JSONWebKey, error) {
+	return NewJSONWebKey(k.ID, k.Algorithm, k.PrivateKey.Public())
+}
+
+// MarshalPrivateKey encodes the private key in PKCS #8 PEM format.
+func MarshalPrivateKey(key crypto.Signer) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// ParsePrivateKey decodes a private key in PKCS #8 PEM format.
+func ParsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("jwt: no PEM block found")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("jwt: unsupported private key type %T", key)
+	}
+
+	return signer, nil
+}
+
+// KeyStore persists the signing keys, so that they are shared by all the replicas
+// of the issuer and survive restarts.
+type KeyStore interface {
+	// List returns all the stored signing keys.
+	List(ctx context.Context) ([]*SigningKey, error)
+	// Create stores a new signing key.
+	Create(ctx context.Context, key *SigningKey) error
+	// Delete removes the signing key with the given id.
+	Delete(ctx context.Context, kid string) error
+}
+
+// memoryKeyStore is a KeyStore which only keeps the keys in memory.
+type memoryKeyStore struct {
+	mu   sync.Mutex
+	keys map[string]*SigningKey
+}
+
+// NewMemoryKeyStore returns a KeyStore which only keeps the keys in memory.
+// It is suitable for a single replica issuer, or for tests.
+func NewMemoryKeyStore() KeyStore {
+	return &memoryKeyStore{keys: make(map[string]*SigningKey)}
+}
+
+func (s *memoryKeyStore) List(ctx context.Context) ([]*SigningKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]*SigningKey, 0, len(s.keys))
+	for _, key := range s.keys {
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func (s *memoryKeyStore) Create(ctx context.Context, key *SigningKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys[key.ID] = key
+	return nil
+}
+
+func (s *memoryKeyStore) Delete(ctx context.Context, kid string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.keys, kid)
+	return nil
+}
+
+type keySetOptions struct {
+	rotationPeriod time.Duration
+	gracePeriod    time.Duration
+	store          KeyStore
+}
+
+// KeySetOption is KeySet option.
+type KeySetOption func(*keySetOptions)
+
+// WithRotationPeriod sets how long a key is used to sign tokens before a new one is generated (default 24h).
+func WithRotationPeriod(period time.Duration) KeySetOption {
+	return func(o *keySetOptions) {
+		o.rotationPeriod = period
+	}
+}
+
+// WithGracePeriod sets how long a rotated key is still published and accepted,
+// which should be no shorter than the lifetime of the tokens it signed (default 2h).
+func WithGracePeriod(period time.Duration) KeySetOption {
+	return func(o *keySetOptions) {
+		o.gracePeriod = period
+	}
+}
+
+// WithKeyStore sets the store of the signing keys (default in memory).
+func WithKeyStore(store KeyStore) KeySetOption {
+	return func(o *keySetOptions) {
+		o.store = store
+	}
+}
+
+// KeySet manages a set of rotating signing keys. The newest key signs the tokens,
+// and the older keys stay in the set until their grace period ends, so that the
+// tokens they signed can still be verified.
+type KeySet struct {
+	alg  string
+	opts keySetOptions
+
+	mu       sync.RWMutex
+	keys     map[string]*SigningKey
+	current  *SigningKey
+	reloaded time.Time
+}
+
+// NewKeySet creates a KeySet which signs with the given algorithm, and loads the keys from the store.
+func NewKeySet(alg string, opts ...KeySetOption) (*KeySet, error) {
+	if alg != RS256 && alg != ES256 && alg != EdDSA {
+		return nil, fmt.Errorf("jwt: unsupported signing algorithm %q", alg)
+	}
+
+	o := keySetOptions{
+		rotationPeriod: 24 * time.Hour,
+		gracePeriod:    2 * time.Hour,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.store == nil {
+		o.store = NewMemoryKeyStore()
+	}
+
+	ks := &KeySet{alg: alg, opts: o, keys: make(map[string]*SigningKey)}
+	if err := ks.Refresh(context.Background()); err != nil {
+		return nil, err
+	}
+
+	return ks, nil
+}
+
+// Refresh loads the keys from the store, deletes the keys whose grace period has
+// ended, and rotates the signing key if it is older than the rotation period.
+func (ks *KeySet) Refresh(ctx context.Context) error {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	if err := ks.load(ctx); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for kid, key := range ks.keys {
+		if now.Before(key.CreatedAt.Add(ks.opts.rotationPeriod + ks.opts.gracePeriod)) {
+			continue
+		}
+
+		if err := ks.opts.store.Delete(ctx, kid); err != nil {
+			return err
+		}
+		delete(ks.keys, kid)
+	}
+
+	ks.current = ks.newest()
+	if ks.current == nil || now.Sub(ks.current.CreatedAt) >= ks.opts.rotationPeriod {
+		return ks.rotate(ctx)
+	}
+
+	return nil
+}
+
+// Rotate generates a new signing key immediately. The previous keys are kept until their grace period ends.
+func (ks *KeySet) Rotate(ctx context.Context) error {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	return ks.rotate(ctx)
+}
+
+// Run refreshes the key set every interval until ctx is done.
+func (ks *KeySet) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := ks.Refresh(ctx); err != nil {
+				log.Errorw(err, "Failed to refresh signing keys")
+			}
+		}
+	}
+}
+
+// Current returns the key currently used to sign tokens.
+func (ks *KeySet) Current() *SigningKey {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	return ks.current
+}
+
+// Keyfunc can be passed to WithKeyfunc or jwt.Parse to verify the tokens signed by the key set.
+func (ks *KeySet) Keyfunc(token *jwt.Token) (any, error) {
+	kid, ok := token.Header["kid"].(string)
+	if !ok {
+		return nil, ErrTokenInvalid
+	}
+
+	key, err := ks.get(kid)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.Method.Alg() != key.Algorithm {
+		return nil, ErrUnSupportSigningMethod
+	}
+
+	return key.PrivateKey.Public(), nil
+}
+
+// JWKS returns the public keys of the key set, which are served to the verifiers.
+func (ks *KeySet) JWKS() (JSONWebKeySet, error) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	jwks := JSONWebKeySet{Keys: make([]JSONWebKey, 0, len(ks.keys))}
+	for _, key := range ks.sorted() {
+		jwk, err := key.JSONWebKey()
+		if err != nil {
+			return jwks, err
+		}
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+
+	return jwks, nil
+}
+
+// get returns the key with the given kid. The store is read again if the key is
+// unknown, because it may have been rotated by another replica.
+func (ks *KeySet) get(kid string) (*SigningKey, error) {
+	ks.mu.RLock()
+	key, ok := ks.keys[kid]
+	ks.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	if time.Since(ks.reloaded) >= minReloadInterval {
+		if err := ks.load(context.Background()); err != nil {
+			return nil, err
+		}
+	}
+
+	if key, ok := ks.keys[kid]; ok {
+		return key, nil
+	}
+
+	return nil, ErrTokenInvalid
+}
+
+func (ks *KeySet) load(ctx context.Context) error {
+	keys, err := ks.opts.store.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	ks.keys = make(map[string]*SigningKey, len(keys))
+	for _, key := range keys {
+		ks.keys[key.ID] = key
+	}
+	ks.reloaded = time.Now()
+
+	return nil
+}
+
+func (ks *KeySet) rotate(ctx context.Context) error {
+	key, err := GenerateSigningKey(ks.alg)
+	if err != nil {
+		return err
+	}
+
+	if err := ks.opts.store.Create(ctx, key); err != nil {
+		return err
+	}
+
+	ks.keys[key.ID] = key
+	ks.current = key
+	return nil
+}
+
+// newest returns the newest key of the configured algorithm.
+func (ks *KeySet) newest() *SigningKey {
+	var newest *SigningKey
+	for _, key := range ks.keys {
+		if key.Algorithm != ks.alg {
+			continue
+		}
+		if newest == nil || key.CreatedAt.After(newest.CreatedAt) {
+			newest = key
+		}
+	}
+
+	return newest
+}
+
+// sorted returns the keys from the newest to the oldest.
+func (ks *KeySet) sorted() []*SigningKey {
+	keys := make([]*SigningKey, 0, len(ks.keys))
+	for _, key := range ks.keys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.After(keys[j].CreatedAt)
+	})
+
+	return keys
+}
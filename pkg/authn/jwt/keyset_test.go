@@ -0,0 +1,104 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package jwt
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeySetSignAndVerify(t *testing.T) {
+	for _, alg := range []string{RS256, ES256, EdDSA} {
+		t.Run(alg, func(t *testing.T) {
+			ks, err := NewKeySet(alg)
+			assert.Nil(t, err)
+
+			ctx := context.Background()
+			a := New(nil, WithKeySet(ks))
+			token, err := a.Sign(ctx, "user-1")
+			assert.Nil(t, err)
+
+			claims, err := a.ParseClaims(ctx, token.GetToken())
+			assert.Nil(t, err)
+			assert.Equal(t, "user-1", claims.Subject)
+
+			// Verify offline with the published key set, as the other services do.
+			jwks, err := ks.JWKS()
+			assert.Nil(t, err)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(jwks)
+			}))
+			defer srv.Close()
+
+			verifier := New(nil, WithKeyfunc(NewRemoteKeySet(nil, srv.URL).Keyfunc), WithSigningMethod(ks.Current().SigningMethod()))
+			claims, err = verifier.ParseClaims(ctx, token.GetToken())
+			assert.Nil(t, err)
+			assert.Equal(t, "user-1", claims.Subject)
+		})
+	}
+}
+
+func TestKeySetRotation(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryKeyStore()
+	ks, err := NewKeySet(ES256, WithKeyStore(store), WithRotationPeriod(time.Hour), WithGracePeriod(time.Hour))
+	assert.Nil(t, err)
+
+	a := New(nil, WithKeySet(ks))
+	old := ks.Current()
+	token, _ := a.Sign(ctx, "user-1")
+
+	tests := []struct {
+		name    string
+		age     time.Duration
+		rotated bool
+		valid   bool
+	}{
+		{"within rotation period", 30 * time.Minute, false, true},
+		{"within grace period", 90 * time.Minute, true, true},
+		{"after grace period", 150 * time.Minute, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Age the first key, and drop the keys rotated by the previous cases.
+			keys, _ := store.List(ctx)
+			for _, key := range keys {
+				if key.ID != old.ID {
+					_ = store.Delete(ctx, key.ID)
+				}
+			}
+			old.CreatedAt = time.Now().Add(-tt.age)
+			_ = store.Create(ctx, old)
+
+			assert.Nil(t, ks.Refresh(ctx))
+			assert.Equal(t, tt.rotated, ks.Current().ID != old.ID)
+
+			_, err := a.ParseClaims(ctx, token.GetToken())
+			assert.Equal(t, tt.valid, err == nil)
+		})
+	}
+}
+
+func TestSigningKeyPEM(t *testing.T) {
+	for _, alg := range []string{RS256, ES256, EdDSA} {
+		key, err := GenerateSigningKey(alg)
+		assert.Nil(t, err)
+
+		data, err := MarshalPrivateKey(key.PrivateKey)
+		assert.Nil(t, err)
+
+		priv, err := ParsePrivateKey(data)
+		assert.Nil(t, err)
+		assert.Equal(t, key.PrivateKey.Public(), priv.Public())
+	}
+}
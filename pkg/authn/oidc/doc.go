@@ -0,0 +1,9 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+// Package oidc implements an OpenID Connect relying party which logs users in
+// with the authorization code flow and PKCE.
+package oidc // import "github.com/superproj/onex/pkg/authn/oidc"
@@ -0,0 +1,265 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"golang.org/x/oauth2"
+
+	jwtauthn "github.com/superproj/onex/pkg/authn/jwt"
+)
+
+// ScopeOpenID is the mandatory scope of all OpenID Connect requests.
+const ScopeOpenID = "openid"
+
+var (
+	// ErrMissingIDToken is returned when the token response does not contain an id_token.
+	ErrMissingIDToken = errors.New("oidc: id_token is missing from the token response")
+	// ErrNonceMismatch is returned when the nonce of the ID token does not match the one sent in the authorization request.
+	ErrNonceMismatch = errors.New("oidc: nonce does not match")
+)
+
+// Config defines the client registration at the OpenID provider.
+type Config struct {
+	// IssuerURL is the issuer identifier of the provider, which is used to discover its endpoints.
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	// Scopes are requested in addition to `openid`.
+	Scopes []string
+	// HTTPClient is used to call the provider. http.DefaultClient is used if it is nil.
+	HTTPClient *http.Client
+}
+
+// IDToken holds the claims of a verified ID token.
+type IDToken struct {
+	jwt.RegisteredClaims
+	Nonce             string `json:"nonce,omitempty"`
+	Email             string `json:"email,omitempty"`
+	EmailVerified     bool   `json:"email_verified,omitempty"`
+	Name              string `json:"name,omitempty"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+
+	// Claims contains all the claims of the ID token, including the ones above.
+	Claims map[string]any `json:"-"`
+}
+
+// discovery holds the fields of the provider metadata used by the relying party.
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider is an OpenID Connect relying party of a single provider.
+// The provider metadata is discovered on first use and cached afterwards.
+type Provider struct {
+	cfg Config
+
+	mu       sync.Mutex
+	metadata *discovery
+	keys     *jwtauthn.RemoteKeySet
+}
+
+// New returns a new Provider with the given config.
+func New(cfg Config) *Provider {
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+
+	return &Provider{cfg: cfg}
+}
+
+// GenerateVerifier returns a random PKCE code verifier as defined in RFC 7636.
+func GenerateVerifier() string {
+	return randomString(32)
+}
+
+// GenerateNonce returns a random value to be used as the state or the nonce of an authorization request.
+func GenerateNonce() string {
+	return randomString(16)
+}
+
+// S256Challenge returns the S256 PKCE code challenge of the verifier.
+func S256Challenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// AuthCodeURL returns the URL of the provider's consent page, which the user should be redirected to.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	cfg, err := p.oauth2Config(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return cfg.AuthCodeURL(state,
+		oauth2.SetAuthURLParam("nonce", nonce),
+		oauth2.SetAuthURLParam("code_challenge", S256Challenge(verifier)),
+		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	), nil
+}
+
+// Exchange exchanges the authorization code for tokens, and returns the verified ID token.
+func (p *Provider) Exchange(ctx context.Context, code, nonce, verifier string) (*IDToken, error) {
+	cfg, err := p.oauth2Config(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.cfg.HTTPClient)
+	token, err := cfg.Exchange(ctx, code, oauth2.SetAuthURLParam("code_verifier", verifier))
+	if err != nil {
+		return nil, err
+	}
+
+	raw, ok := token.Extra("id_token").(string)
+	if !ok || raw == "" {
+		return nil, ErrMissingIDToken
+	}
+
+	idToken, err := p.Verify(ctx, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if idToken.Nonce != nonce {
+		return nil, ErrNonceMismatch
+	}
+
+	return idToken, nil
+}
+
+// Verify verifies the signature, issuer, audience and expiration of an ID token.
+func (p *Provider) Verify(ctx context.Context, raw string) (*IDToken, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	idToken := &IDToken{}
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}))
+	if _, err := parser.ParseWithClaims(raw, idToken, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.keys.Get(ctx, kid)
+	}); err != nil {
+		return nil, fmt.Errorf("oidc: failed to verify id_token: %w", err)
+	}
+
+	if idToken.Issuer != md.Issuer {
+		return nil, fmt.Errorf("oidc: id_token issued by %q, expected %q", idToken.Issuer, md.Issuer)
+	}
+
+	if !idToken.VerifyAudience(p.cfg.ClientID, true) {
+		return nil, fmt.Errorf("oidc: id_token is not issued to client %q", p.cfg.ClientID)
+	}
+
+	if idToken.ExpiresAt == nil {
+		return nil, errors.New("oidc: id_token has no expiration")
+	}
+
+	// Keep all the claims so that the caller can read custom ones.
+	payload, err := jwt.DecodeSegment(strings.Split(raw, ".")[1])
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(payload, &idToken.Claims); err != nil {
+		return nil, err
+	}
+
+	return idToken, nil
+}
+
+// oauth2Config returns the OAuth 2.0 config of the provider.
+func (p *Provider) oauth2Config(ctx context.Context) (*oauth2.Config, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		RedirectURL:  p.cfg.RedirectURL,
+		Scopes:       append([]string{ScopeOpenID}, p.cfg.Scopes...),
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  md.AuthorizationEndpoint,
+			TokenURL: md.TokenEndpoint,
+		},
+	}, nil
+}
+
+// discover fetches the provider metadata from the well-known location.
+func (p *Provider) discover(ctx context.Context) (*discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+
+	url := strings.TrimSuffix(p.cfg.IssuerURL, "/") + "/.well-known/openid-configuration"
+	var md discovery
+	if err := getJSON(ctx, p.cfg.HTTPClient, url, &md); err != nil {
+		return nil, fmt.Errorf("oidc: failed to discover provider: %w", err)
+	}
+
+	// The issuer must be identical to the URL used to discover the metadata, see OpenID Connect Discovery 1.0 section 4.3.
+	if strings.TrimSuffix(md.Issuer, "/") != strings.TrimSuffix(p.cfg.IssuerURL, "/") {
+		return nil, fmt.Errorf("oidc: issuer %q does not match the configured %q", md.Issuer, p.cfg.IssuerURL)
+	}
+
+	p.metadata = &md
+	p.keys = jwtauthn.NewRemoteKeySet(p.cfg.HTTPClient, md.JWKSURI)
+	return p.metadata, nil
+}
+
+// getJSON sends a GET request and decodes the JSON response into v.
+func getJSON(ctx context.Context, client *http.Client, url string, v any) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s from %s", resp.Status, url)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func randomString(n int) string {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,80 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package oidc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/superproj/onex/pkg/authn/oidc"
+	"github.com/superproj/onex/pkg/authn/oidc/oidctest"
+)
+
+func TestProvider_Exchange(t *testing.T) {
+	idp := oidctest.NewServer("onex", "secret")
+	defer idp.Close()
+
+	tests := []struct {
+		name         string
+		clientSecret string
+		nonce        string
+		verifier     func(verifier string) string
+		wantErr      bool
+	}{
+		{name: "valid"},
+		{name: "wrong client secret", clientSecret: "wrong", wantErr: true},
+		{name: "wrong nonce", nonce: "wrong", wantErr: true},
+		{name: "wrong verifier", verifier: func(string) string { return oidc.GenerateVerifier() }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret := idp.ClientSecret
+			if tt.clientSecret != "" {
+				secret = tt.clientSecret
+			}
+			p := oidc.New(oidc.Config{
+				IssuerURL:    idp.URL,
+				ClientID:     idp.ClientID,
+				ClientSecret: secret,
+				RedirectURL:  "http://localhost/callback",
+				Scopes:       []string{"email", "profile"},
+			})
+
+			ctx := context.Background()
+			state, nonce, verifier := oidc.GenerateNonce(), oidc.GenerateNonce(), oidc.GenerateVerifier()
+			authURL, err := p.AuthCodeURL(ctx, state, nonce, verifier)
+			assert.Nil(t, err)
+
+			code, gotState, err := idp.Authorize(authURL)
+			assert.Nil(t, err)
+			assert.Equal(t, state, gotState)
+
+			if tt.nonce != "" {
+				nonce = tt.nonce
+			}
+			if tt.verifier != nil {
+				verifier = tt.verifier(verifier)
+			}
+
+			idToken, err := p.Exchange(ctx, code, nonce, verifier)
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "user-1", idToken.Subject)
+			assert.Equal(t, idp.URL, idToken.Issuer)
+			assert.Equal(t, "user1", idToken.PreferredUsername)
+			assert.Equal(t, "user1@example.com", idToken.Email)
+			assert.True(t, idToken.EmailVerified)
+			assert.Equal(t, "user1", idToken.Claims["preferred_username"])
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Digits is the number of digits of a generated passcode.
+	Digits = 6
+	// Period is the number of seconds a passcode is valid for.
+	Period = 30
+	// Skew is the number of periods before and after the current one which are also accepted,
+	// to tolerate clock drift between the server and the authenticator.
+	Skew = 1
+
+	// secretSize is the size in bytes of a generated secret, 160 bits as recommended by RFC 4226.
+	secretSize = 20
+)
+
+// encoding is the base32 encoding used by authenticator apps, without padding.
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a new random base32 encoded secret.
+func GenerateSecret() (string, error) {
+	b := make([]byte, secretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return encoding.EncodeToString(b), nil
+}
+
+// Generate returns the passcode of the given secret at time t.
+func Generate(secret string, t time.Time) (string, error) {
+	key, err := decode(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return hotp(key, uint64(t.Unix()/Period)), nil
+}
+
+// Validate reports whether the passcode is valid for the given secret at time t.
+func Validate(passcode string, secret string, t time.Time) bool {
+	_, ok := ValidateCounter(passcode, secret, t)
+	return ok
+}
+
+// ValidateCounter is like Validate, and also returns the time step counter the passcode
+// was generated for, so that the caller can refuse a passcode which was already used.
+func ValidateCounter(passcode string, secret string, t time.Time) (int64, bool) {
+	if len(passcode) != Digits {
+		return 0, false
+	}
+
+	key, err := decode(secret)
+	if err != nil {
+		return 0, false
+	}
+
+	counter := t.Unix() / Period
+	for i := int64(-Skew); i <= Skew; i++ {
+		if subtle.ConstantTimeCompare([]byte(hotp(key, uint64(counter+i))), []byte(passcode)) == 1 {
+			return counter + i, true
+		}
+	}
+
+	return 0, false
+}
+
+// URL returns the otpauth:// key uri used to provision authenticator apps, usually rendered as a QR code.
+// See https://github.com/google/google-authenticator/wiki/Key-Uri-Format.
+func URL(issuer string, account string, secret string) string {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(Digits))
+	v.Set("period", fmt.Sprint(Period))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: v.Encode(),
+	}
+	return u.String()
+}
+
+// decode decodes a base32 secret, ignoring case, spaces and padding.
+func decode(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	return encoding.DecodeString(strings.TrimRight(secret, "="))
+}
+
+// hotp computes the HOTP value of the counter as defined in RFC 4226.
+func hotp(key []byte, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation.
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", Digits, code%1000000)
+}
@@ -0,0 +1,81 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package totp
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rfcSecret is the SHA1 seed used by the test vectors in RFC 6238 Appendix B.
+var rfcSecret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestGenerate(t *testing.T) {
+	// The expected values are the last 6 digits of the 8 digits RFC 6238 test vectors.
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, tt := range tests {
+		got, err := Generate(rfcSecret, time.Unix(tt.unix, 0))
+		assert.Nil(t, err)
+		assert.Equal(t, tt.want, got)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	code, _ := Generate(rfcSecret, now)
+
+	tests := []struct {
+		name     string
+		passcode string
+		at       time.Time
+		want     bool
+	}{
+		{"current period", code, now, true},
+		{"previous period", code, now.Add(Period * time.Second), true},
+		{"next period", code, now.Add(-Period * time.Second), true},
+		{"out of skew", code, now.Add(2 * Period * time.Second), false},
+		{"wrong code", "000000", now, false},
+		{"wrong length", "12345", now, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Validate(tt.passcode, rfcSecret, tt.at))
+		})
+	}
+}
+
+func TestValidateCounter(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	code, _ := Generate(rfcSecret, now)
+
+	counter, ok := ValidateCounter(code, rfcSecret, now.Add(Period*time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, now.Unix()/Period, counter)
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	assert.Nil(t, err)
+	assert.Len(t, secret, 32)
+
+	code, err := Generate(secret, time.Now())
+	assert.Nil(t, err)
+	assert.True(t, Validate(code, secret, time.Now()))
+}
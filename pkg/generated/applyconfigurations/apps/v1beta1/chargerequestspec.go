@@ -10,8 +10,12 @@ package v1beta1
 // ChargeRequestSpecApplyConfiguration represents an declarative configuration of the ChargeRequestSpec type for use
 // with apply.
 type ChargeRequestSpecApplyConfiguration struct {
-	From     *string `json:"from,omitempty"`
-	Password *string `json:"password,omitempty"`
+	From          *string `json:"from,omitempty"`
+	Password      *string `json:"password,omitempty"`
+	Salt          *string `json:"salt,omitempty"`
+	ChainName     *string `json:"chainName,omitempty"`
+	Amount        *int64  `json:"amount,omitempty"`
+	Confirmations *int32  `json:"confirmations,omitempty"`
 }
 
 // ChargeRequestSpecApplyConfiguration constructs an declarative configuration of the ChargeRequestSpec type for use with
@@ -35,3 +39,35 @@ func (b *ChargeRequestSpecApplyConfiguration) WithPassword(value string) *Charge
 	b.Password = &value
 	return b
 }
+
+// WithSalt sets the Salt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Salt field is set to the value of the last call.
+func (b *ChargeRequestSpecApplyConfiguration) WithSalt(value string) *ChargeRequestSpecApplyConfiguration {
+	b.Salt = &value
+	return b
+}
+
+// WithChainName sets the ChainName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ChainName field is set to the value of the last call.
+func (b *ChargeRequestSpecApplyConfiguration) WithChainName(value string) *ChargeRequestSpecApplyConfiguration {
+	b.ChainName = &value
+	return b
+}
+
+// WithAmount sets the Amount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Amount field is set to the value of the last call.
+func (b *ChargeRequestSpecApplyConfiguration) WithAmount(value int64) *ChargeRequestSpecApplyConfiguration {
+	b.Amount = &value
+	return b
+}
+
+// WithConfirmations sets the Confirmations field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Confirmations field is set to the value of the last call.
+func (b *ChargeRequestSpecApplyConfiguration) WithConfirmations(value int32) *ChargeRequestSpecApplyConfiguration {
+	b.Confirmations = &value
+	return b
+}
@@ -14,7 +14,11 @@ import (
 // ChargeRequestStatusApplyConfiguration represents an declarative configuration of the ChargeRequestStatus type for use
 // with apply.
 type ChargeRequestStatusApplyConfiguration struct {
-	Conditions *v1beta1.Conditions `json:"conditions,omitempty"`
+	Conditions      *v1beta1.Conditions `json:"conditions,omitempty"`
+	TransactionID   *string             `json:"transactionID,omitempty"`
+	SubmittedHeight *int64              `json:"submittedHeight,omitempty"`
+	BlockHeight     *int64              `json:"blockHeight,omitempty"`
+	Confirmations   *int32              `json:"confirmations,omitempty"`
 }
 
 // ChargeRequestStatusApplyConfiguration constructs an declarative configuration of the ChargeRequestStatus type for use with
@@ -30,3 +34,35 @@ func (b *ChargeRequestStatusApplyConfiguration) WithConditions(value v1beta1.Con
 	b.Conditions = &value
 	return b
 }
+
+// WithTransactionID sets the TransactionID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TransactionID field is set to the value of the last call.
+func (b *ChargeRequestStatusApplyConfiguration) WithTransactionID(value string) *ChargeRequestStatusApplyConfiguration {
+	b.TransactionID = &value
+	return b
+}
+
+// WithSubmittedHeight sets the SubmittedHeight field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SubmittedHeight field is set to the value of the last call.
+func (b *ChargeRequestStatusApplyConfiguration) WithSubmittedHeight(value int64) *ChargeRequestStatusApplyConfiguration {
+	b.SubmittedHeight = &value
+	return b
+}
+
+// WithBlockHeight sets the BlockHeight field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BlockHeight field is set to the value of the last call.
+func (b *ChargeRequestStatusApplyConfiguration) WithBlockHeight(value int64) *ChargeRequestStatusApplyConfiguration {
+	b.BlockHeight = &value
+	return b
+}
+
+// WithConfirmations sets the Confirmations field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Confirmations field is set to the value of the last call.
+func (b *ChargeRequestStatusApplyConfiguration) WithConfirmations(value int32) *ChargeRequestStatusApplyConfiguration {
+	b.Confirmations = &value
+	return b
+}
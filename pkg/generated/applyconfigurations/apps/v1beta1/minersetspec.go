@@ -21,6 +21,10 @@ type MinerSetSpecApplyConfiguration struct {
 	DeletePolicy            *string                              `json:"deletePolicy,omitempty"`
 	MinReadySeconds         *int32                               `json:"minReadySeconds,omitempty"`
 	ProgressDeadlineSeconds *int32                               `json:"progressDeadlineSeconds,omitempty"`
+	Strategy                *MinerSetStrategyApplyConfiguration  `json:"strategy,omitempty"`
+	RevisionHistoryLimit    *int32                               `json:"revisionHistoryLimit,omitempty"`
+	Paused                  *bool                                `json:"paused,omitempty"`
+	RollbackTo              *MinerSetRollbackApplyConfiguration  `json:"rollbackTo,omitempty"`
 }
 
 // MinerSetSpecApplyConfiguration constructs an declarative configuration of the MinerSetSpec type for use with
@@ -84,3 +88,35 @@ func (b *MinerSetSpecApplyConfiguration) WithProgressDeadlineSeconds(value int32
 	b.ProgressDeadlineSeconds = &value
 	return b
 }
+
+// WithStrategy sets the Strategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Strategy field is set to the value of the last call.
+func (b *MinerSetSpecApplyConfiguration) WithStrategy(value *MinerSetStrategyApplyConfiguration) *MinerSetSpecApplyConfiguration {
+	b.Strategy = value
+	return b
+}
+
+// WithRevisionHistoryLimit sets the RevisionHistoryLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RevisionHistoryLimit field is set to the value of the last call.
+func (b *MinerSetSpecApplyConfiguration) WithRevisionHistoryLimit(value int32) *MinerSetSpecApplyConfiguration {
+	b.RevisionHistoryLimit = &value
+	return b
+}
+
+// WithPaused sets the Paused field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Paused field is set to the value of the last call.
+func (b *MinerSetSpecApplyConfiguration) WithPaused(value bool) *MinerSetSpecApplyConfiguration {
+	b.Paused = &value
+	return b
+}
+
+// WithRollbackTo sets the RollbackTo field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RollbackTo field is set to the value of the last call.
+func (b *MinerSetSpecApplyConfiguration) WithRollbackTo(value *MinerSetRollbackApplyConfiguration) *MinerSetSpecApplyConfiguration {
+	b.RollbackTo = value
+	return b
+}
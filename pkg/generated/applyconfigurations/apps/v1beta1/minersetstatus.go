@@ -15,14 +15,18 @@ import (
 // MinerSetStatusApplyConfiguration represents an declarative configuration of the MinerSetStatus type for use
 // with apply.
 type MinerSetStatusApplyConfiguration struct {
-	Replicas             *int32                      `json:"replicas,omitempty"`
-	FullyLabeledReplicas *int32                      `json:"fullyLabeledReplicas,omitempty"`
-	ReadyReplicas        *int32                      `json:"readyReplicas,omitempty"`
-	AvailableReplicas    *int32                      `json:"availableReplicas,omitempty"`
-	ObservedGeneration   *int64                      `json:"observedGeneration,omitempty"`
-	FailureReason        *errors.MinerSetStatusError `json:"failureReason,omitempty"`
-	FailureMessage       *string                     `json:"failureMessage,omitempty"`
-	Conditions           *v1beta1.Conditions         `json:"conditions,omitempty"`
+	Replicas             *int32                               `json:"replicas,omitempty"`
+	FullyLabeledReplicas *int32                               `json:"fullyLabeledReplicas,omitempty"`
+	ReadyReplicas        *int32                               `json:"readyReplicas,omitempty"`
+	AvailableReplicas    *int32                               `json:"availableReplicas,omitempty"`
+	ObservedGeneration   *int64                               `json:"observedGeneration,omitempty"`
+	FailureReason        *errors.MinerSetStatusError          `json:"failureReason,omitempty"`
+	FailureMessage       *string                              `json:"failureMessage,omitempty"`
+	Conditions           *v1beta1.Conditions                  `json:"conditions,omitempty"`
+	UpdatedReplicas      *int32                               `json:"updatedReplicas,omitempty"`
+	Revision             *int64                               `json:"revision,omitempty"`
+	TemplateHash         *string                              `json:"templateHash,omitempty"`
+	History              []MinerSetRevisionApplyConfiguration `json:"history,omitempty"`
 }
 
 // MinerSetStatusApplyConfiguration constructs an declarative configuration of the MinerSetStatus type for use with
@@ -94,3 +98,40 @@ func (b *MinerSetStatusApplyConfiguration) WithConditions(value v1beta1.Conditio
 	b.Conditions = &value
 	return b
 }
+
+// WithUpdatedReplicas sets the UpdatedReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpdatedReplicas field is set to the value of the last call.
+func (b *MinerSetStatusApplyConfiguration) WithUpdatedReplicas(value int32) *MinerSetStatusApplyConfiguration {
+	b.UpdatedReplicas = &value
+	return b
+}
+
+// WithRevision sets the Revision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Revision field is set to the value of the last call.
+func (b *MinerSetStatusApplyConfiguration) WithRevision(value int64) *MinerSetStatusApplyConfiguration {
+	b.Revision = &value
+	return b
+}
+
+// WithTemplateHash sets the TemplateHash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TemplateHash field is set to the value of the last call.
+func (b *MinerSetStatusApplyConfiguration) WithTemplateHash(value string) *MinerSetStatusApplyConfiguration {
+	b.TemplateHash = &value
+	return b
+}
+
+// WithHistory adds the given value to the History field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the History field.
+func (b *MinerSetStatusApplyConfiguration) WithHistory(values ...*MinerSetRevisionApplyConfiguration) *MinerSetStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithHistory")
+		}
+		b.History = append(b.History, *values[i])
+	}
+	return b
+}
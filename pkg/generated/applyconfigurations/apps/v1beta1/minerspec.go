@@ -16,11 +16,12 @@ import (
 // with apply.
 type MinerSpecApplyConfiguration struct {
 	*ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	DisplayName                   *string           `json:"displayName,omitempty"`
-	MinerType                     *string           `json:"minerType,omitempty"`
-	ChainName                     *string           `json:"chainName,omitempty"`
-	RestartPolicy                 *v1.RestartPolicy `json:"restartPolicy,omitempty"`
-	PodDeletionTimeout            *metav1.Duration  `json:"podDeletionTimeout,omitempty"`
+	DisplayName                   *string                              `json:"displayName,omitempty"`
+	MinerType                     *string                              `json:"minerType,omitempty"`
+	ChainName                     *string                              `json:"chainName,omitempty"`
+	RestartPolicy                 *v1.RestartPolicy                    `json:"restartPolicy,omitempty"`
+	PodDeletionTimeout            *metav1.Duration                     `json:"podDeletionTimeout,omitempty"`
+	PodOverrides                  *MinerPodOverridesApplyConfiguration `json:"podOverrides,omitempty"`
 }
 
 // MinerSpecApplyConfiguration constructs an declarative configuration of the MinerSpec type for use with
@@ -104,3 +105,11 @@ func (b *MinerSpecApplyConfiguration) WithPodDeletionTimeout(value metav1.Durati
 	b.PodDeletionTimeout = &value
 	return b
 }
+
+// WithPodOverrides sets the PodOverrides field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodOverrides field is set to the value of the last call.
+func (b *MinerSpecApplyConfiguration) WithPodOverrides(value *MinerPodOverridesApplyConfiguration) *MinerSpecApplyConfiguration {
+	b.PodOverrides = value
+	return b
+}
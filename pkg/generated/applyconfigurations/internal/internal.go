@@ -58,6 +58,16 @@ var schemaYAML = typed.YAMLObject(`types:
     elementType:
       namedType: __untyped_deduced_
     elementRelationship: separable
+- name: com.github.superproj.onex.pkg.apis.apps.v1beta1.MinerClass
+  scalar: untyped
+  list:
+    elementType:
+      namedType: __untyped_atomic_
+    elementRelationship: atomic
+  map:
+    elementType:
+      namedType: __untyped_deduced_
+    elementRelationship: separable
 - name: com.github.superproj.onex.pkg.apis.apps.v1beta1.MinerSet
   scalar: untyped
   list:
@@ -68,6 +78,16 @@ var schemaYAML = typed.YAMLObject(`types:
     elementType:
       namedType: __untyped_deduced_
     elementRelationship: separable
+- name: com.github.superproj.onex.pkg.apis.apps.v1beta1.MinerSetAutoscaler
+  scalar: untyped
+  list:
+    elementType:
+      namedType: __untyped_atomic_
+    elementRelationship: atomic
+  map:
+    elementType:
+      namedType: __untyped_deduced_
+    elementRelationship: separable
 - name: io.k8s.api.autoscaling.v1.CrossVersionObjectReference
   map:
     fields:
@@ -71,6 +71,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		// Group=apps.onex.io, Version=v1beta1
 	case v1beta1.SchemeGroupVersion.WithKind("Chain"):
 		return &appsv1beta1.ChainApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ChainBlockIntervalMetricSource"):
+		return &appsv1beta1.ChainBlockIntervalMetricSourceApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ChainSpec"):
 		return &appsv1beta1.ChainSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ChainStatus"):
@@ -89,12 +91,40 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &appsv1beta1.MinerApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("MinerAddress"):
 		return &appsv1beta1.MinerAddressApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MinerClass"):
+		return &appsv1beta1.MinerClassApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MinerClassPricing"):
+		return &appsv1beta1.MinerClassPricingApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MinerClassSpec"):
+		return &appsv1beta1.MinerClassSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MinerPodOverrides"):
+		return &appsv1beta1.MinerPodOverridesApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("MinerSet"):
 		return &appsv1beta1.MinerSetApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MinerSetAutoscaler"):
+		return &appsv1beta1.MinerSetAutoscalerApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MinerSetAutoscalerBehavior"):
+		return &appsv1beta1.MinerSetAutoscalerBehaviorApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MinerSetAutoscalerSpec"):
+		return &appsv1beta1.MinerSetAutoscalerSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MinerSetAutoscalerStatus"):
+		return &appsv1beta1.MinerSetAutoscalerStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MinerSetMetricSpec"):
+		return &appsv1beta1.MinerSetMetricSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MinerSetMetricStatus"):
+		return &appsv1beta1.MinerSetMetricStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MinerSetRevision"):
+		return &appsv1beta1.MinerSetRevisionApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MinerSetRollback"):
+		return &appsv1beta1.MinerSetRollbackApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MinerSetScalingRules"):
+		return &appsv1beta1.MinerSetScalingRulesApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("MinerSetSpec"):
 		return &appsv1beta1.MinerSetSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("MinerSetStatus"):
 		return &appsv1beta1.MinerSetStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MinerSetStrategy"):
+		return &appsv1beta1.MinerSetStrategyApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("MinerSpec"):
 		return &appsv1beta1.MinerSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("MinerStatus"):
@@ -103,6 +133,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &appsv1beta1.MinerTemplateSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ObjectMeta"):
 		return &appsv1beta1.ObjectMetaApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PendingChargeRequestsMetricSource"):
+		return &appsv1beta1.PendingChargeRequestsMetricSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PodCPUMetricSource"):
+		return &appsv1beta1.PodCPUMetricSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RollingUpdateMinerSet"):
+		return &appsv1beta1.RollingUpdateMinerSetApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ScheduleMetricSource"):
+		return &appsv1beta1.ScheduleMetricSourceApplyConfiguration{}
 
 		// Group=autoscaling, Version=v1
 	case autoscalingv1.SchemeGroupVersion.WithKind("CrossVersionObjectReference"):
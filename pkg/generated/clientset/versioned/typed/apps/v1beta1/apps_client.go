@@ -20,7 +20,9 @@ type AppsV1beta1Interface interface {
 	ChainsGetter
 	ChargeRequestsGetter
 	MinersGetter
+	MinerClassesGetter
 	MinerSetsGetter
+	MinerSetAutoscalersGetter
 }
 
 // AppsV1beta1Client is used to interact with features provided by the apps.onex.io group.
@@ -40,10 +42,18 @@ func (c *AppsV1beta1Client) Miners(namespace string) MinerInterface {
 	return newMiners(c, namespace)
 }
 
+func (c *AppsV1beta1Client) MinerClasses() MinerClassInterface {
+	return newMinerClasses(c)
+}
+
 func (c *AppsV1beta1Client) MinerSets(namespace string) MinerSetInterface {
 	return newMinerSets(c, namespace)
 }
 
+func (c *AppsV1beta1Client) MinerSetAutoscalers(namespace string) MinerSetAutoscalerInterface {
+	return newMinerSetAutoscalers(c, namespace)
+}
+
 // NewForConfig creates a new AppsV1beta1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
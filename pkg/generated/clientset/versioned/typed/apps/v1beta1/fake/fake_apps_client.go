@@ -29,10 +29,18 @@ func (c *FakeAppsV1beta1) Miners(namespace string) v1beta1.MinerInterface {
 	return &FakeMiners{c, namespace}
 }
 
+func (c *FakeAppsV1beta1) MinerClasses() v1beta1.MinerClassInterface {
+	return &FakeMinerClasses{c}
+}
+
 func (c *FakeAppsV1beta1) MinerSets(namespace string) v1beta1.MinerSetInterface {
 	return &FakeMinerSets{c, namespace}
 }
 
+func (c *FakeAppsV1beta1) MinerSetAutoscalers(namespace string) v1beta1.MinerSetAutoscalerInterface {
+	return &FakeMinerSetAutoscalers{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeAppsV1beta1) RESTClient() rest.Interface {
@@ -13,4 +13,8 @@ type ChargeRequestExpansion interface{}
 
 type MinerExpansion interface{}
 
+type MinerClassExpansion interface{}
+
 type MinerSetExpansion interface{}
+
+type MinerSetAutoscalerExpansion interface{}
@@ -19,8 +19,12 @@ type Interface interface {
 	ChargeRequests() ChargeRequestInformer
 	// Miners returns a MinerInformer.
 	Miners() MinerInformer
+	// MinerClasses returns a MinerClassInformer.
+	MinerClasses() MinerClassInformer
 	// MinerSets returns a MinerSetInformer.
 	MinerSets() MinerSetInformer
+	// MinerSetAutoscalers returns a MinerSetAutoscalerInformer.
+	MinerSetAutoscalers() MinerSetAutoscalerInformer
 }
 
 type version struct {
@@ -49,7 +53,17 @@ func (v *version) Miners() MinerInformer {
 	return &minerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// MinerClasses returns a MinerClassInformer.
+func (v *version) MinerClasses() MinerClassInformer {
+	return &minerClassInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // MinerSets returns a MinerSetInformer.
 func (v *version) MinerSets() MinerSetInformer {
 	return &minerSetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// MinerSetAutoscalers returns a MinerSetAutoscalerInformer.
+func (v *version) MinerSetAutoscalers() MinerSetAutoscalerInformer {
+	return &minerSetAutoscalerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -56,8 +56,12 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Apps().V1beta1().ChargeRequests().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("miners"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Apps().V1beta1().Miners().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("minerclasses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Apps().V1beta1().MinerClasses().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("minersets"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Apps().V1beta1().MinerSets().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("minersetautoscalers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Apps().V1beta1().MinerSetAutoscalers().Informer()}, nil
 
 		// Group=coordination.k8s.io, Version=v1
 	case coordinationv1.SchemeGroupVersion.WithResource("leases"):
@@ -31,6 +31,10 @@ type MinerListerExpansion interface{}
 // MinerNamespaceLister.
 type MinerNamespaceListerExpansion interface{}
 
+// MinerClassListerExpansion allows custom methods to be added to
+// MinerClassLister.
+type MinerClassListerExpansion interface{}
+
 // MinerSetListerExpansion allows custom methods to be added to
 // MinerSetLister.
 type MinerSetListerExpansion interface{}
@@ -38,3 +42,11 @@ type MinerSetListerExpansion interface{}
 // MinerSetNamespaceListerExpansion allows custom methods to be added to
 // MinerSetNamespaceLister.
 type MinerSetNamespaceListerExpansion interface{}
+
+// MinerSetAutoscalerListerExpansion allows custom methods to be added to
+// MinerSetAutoscalerLister.
+type MinerSetAutoscalerListerExpansion interface{}
+
+// MinerSetAutoscalerNamespaceListerExpansion allows custom methods to be added to
+// MinerSetAutoscalerNamespaceLister.
+type MinerSetAutoscalerNamespaceListerExpansion interface{}
@@ -23,6 +23,7 @@ import (
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
 		"github.com/superproj/onex/pkg/apis/apps/v1beta1.Chain":                                      schema_pkg_apis_apps_v1beta1_Chain(ref),
+		"github.com/superproj/onex/pkg/apis/apps/v1beta1.ChainBlockIntervalMetricSource":             schema_pkg_apis_apps_v1beta1_ChainBlockIntervalMetricSource(ref),
 		"github.com/superproj/onex/pkg/apis/apps/v1beta1.ChainList":                                  schema_pkg_apis_apps_v1beta1_ChainList(ref),
 		"github.com/superproj/onex/pkg/apis/apps/v1beta1.ChainSpec":                                  schema_pkg_apis_apps_v1beta1_ChainSpec(ref),
 		"github.com/superproj/onex/pkg/apis/apps/v1beta1.ChainStatus":                                schema_pkg_apis_apps_v1beta1_ChainStatus(ref),
@@ -34,16 +35,36 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/superproj/onex/pkg/apis/apps/v1beta1.LocalObjectReference":                       schema_pkg_apis_apps_v1beta1_LocalObjectReference(ref),
 		"github.com/superproj/onex/pkg/apis/apps/v1beta1.Miner":                                      schema_pkg_apis_apps_v1beta1_Miner(ref),
 		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerAddress":                               schema_pkg_apis_apps_v1beta1_MinerAddress(ref),
+		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerClass":                                 schema_pkg_apis_apps_v1beta1_MinerClass(ref),
+		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerClassList":                             schema_pkg_apis_apps_v1beta1_MinerClassList(ref),
+		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerClassPricing":                          schema_pkg_apis_apps_v1beta1_MinerClassPricing(ref),
+		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerClassSpec":                             schema_pkg_apis_apps_v1beta1_MinerClassSpec(ref),
 		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerList":                                  schema_pkg_apis_apps_v1beta1_MinerList(ref),
+		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerPodOverrides":                          schema_pkg_apis_apps_v1beta1_MinerPodOverrides(ref),
 		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSet":                                   schema_pkg_apis_apps_v1beta1_MinerSet(ref),
+		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetAutoscaler":                         schema_pkg_apis_apps_v1beta1_MinerSetAutoscaler(ref),
+		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetAutoscalerBehavior":                 schema_pkg_apis_apps_v1beta1_MinerSetAutoscalerBehavior(ref),
+		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetAutoscalerList":                     schema_pkg_apis_apps_v1beta1_MinerSetAutoscalerList(ref),
+		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetAutoscalerSpec":                     schema_pkg_apis_apps_v1beta1_MinerSetAutoscalerSpec(ref),
+		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetAutoscalerStatus":                   schema_pkg_apis_apps_v1beta1_MinerSetAutoscalerStatus(ref),
 		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetList":                               schema_pkg_apis_apps_v1beta1_MinerSetList(ref),
+		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetMetricSpec":                         schema_pkg_apis_apps_v1beta1_MinerSetMetricSpec(ref),
+		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetMetricStatus":                       schema_pkg_apis_apps_v1beta1_MinerSetMetricStatus(ref),
+		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetRevision":                           schema_pkg_apis_apps_v1beta1_MinerSetRevision(ref),
+		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetRollback":                           schema_pkg_apis_apps_v1beta1_MinerSetRollback(ref),
+		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetScalingRules":                       schema_pkg_apis_apps_v1beta1_MinerSetScalingRules(ref),
 		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetSpec":                               schema_pkg_apis_apps_v1beta1_MinerSetSpec(ref),
 		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetStatus":                             schema_pkg_apis_apps_v1beta1_MinerSetStatus(ref),
+		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetStrategy":                           schema_pkg_apis_apps_v1beta1_MinerSetStrategy(ref),
 		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSpec":                                  schema_pkg_apis_apps_v1beta1_MinerSpec(ref),
 		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerStatus":                                schema_pkg_apis_apps_v1beta1_MinerStatus(ref),
 		"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerTemplateSpec":                          schema_pkg_apis_apps_v1beta1_MinerTemplateSpec(ref),
 		"github.com/superproj/onex/pkg/apis/apps/v1beta1.ObjectMeta":                                 schema_pkg_apis_apps_v1beta1_ObjectMeta(ref),
+		"github.com/superproj/onex/pkg/apis/apps/v1beta1.PendingChargeRequestsMetricSource":          schema_pkg_apis_apps_v1beta1_PendingChargeRequestsMetricSource(ref),
+		"github.com/superproj/onex/pkg/apis/apps/v1beta1.PodCPUMetricSource":                         schema_pkg_apis_apps_v1beta1_PodCPUMetricSource(ref),
 		"github.com/superproj/onex/pkg/apis/apps/v1beta1.PodInfo":                                    schema_pkg_apis_apps_v1beta1_PodInfo(ref),
+		"github.com/superproj/onex/pkg/apis/apps/v1beta1.RollingUpdateMinerSet":                      schema_pkg_apis_apps_v1beta1_RollingUpdateMinerSet(ref),
+		"github.com/superproj/onex/pkg/apis/apps/v1beta1.ScheduleMetricSource":                       schema_pkg_apis_apps_v1beta1_ScheduleMetricSource(ref),
 		"k8s.io/api/autoscaling/v1.ContainerResourceMetricSource":                                    schema_k8sio_api_autoscaling_v1_ContainerResourceMetricSource(ref),
 		"k8s.io/api/autoscaling/v1.ContainerResourceMetricStatus":                                    schema_k8sio_api_autoscaling_v1_ContainerResourceMetricStatus(ref),
 		"k8s.io/api/autoscaling/v1.CrossVersionObjectReference":                                      schema_k8sio_api_autoscaling_v1_CrossVersionObjectReference(ref),
@@ -454,6 +475,28 @@ func schema_pkg_apis_apps_v1beta1_Chain(ref common.ReferenceCallback) common.Ope
 	}
 }
 
+func schema_pkg_apis_apps_v1beta1_ChainBlockIntervalMetricSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ChainBlockIntervalMetricSource scales the MinerSet to keep the average block interval of the chain at the target. The miners are scaled up when the blocks are mined slower than the target, and down when they are mined faster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"targetSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetSeconds is the target value of the average time between two blocks.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"targetSeconds"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_apps_v1beta1_ChainList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -709,17 +752,51 @@ func schema_pkg_apis_apps_v1beta1_ChargeRequestSpec(ref common.ReferenceCallback
 				Properties: map[string]spec.Schema{
 					"from": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "From is the address of the account the charge is paid from.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"password": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Password is the password the signing key of the From account is derived from. It is sealed by the apiserver before being stored, and cleared once the charge is submitted to the chain.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"salt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Salt is the hex encoded salt, of at least 16 bytes, the signing key of the From account is derived from together with the password. It is chosen at random when the account is created.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"chainName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChainName is the name of the chain the charge is settled on. The charge is paid to the bootstrap account of the chain.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"amount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Amount is the number of coins charged.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"confirmations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Confirmations is the number of blocks, including the one containing the charge, required before the charge is considered settled. Defaults to 3.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
+				Required: []string{"salt", "chainName", "amount"},
 			},
 		},
 	}
@@ -745,6 +822,34 @@ func schema_pkg_apis_apps_v1beta1_ChargeRequestStatus(ref common.ReferenceCallba
 							},
 						},
 					},
+					"transactionID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TransactionID is the identifier of the transaction submitted for the charge.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"submittedHeight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubmittedHeight is the height of the chain when the transaction was submitted.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"blockHeight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BlockHeight is the index of the block containing the transaction.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"confirmations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Confirmations is the number of blocks, including the one containing the transaction, mined since the transaction was included in the chain.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
@@ -913,11 +1018,11 @@ func schema_pkg_apis_apps_v1beta1_MinerAddress(ref common.ReferenceCallback) com
 	}
 }
 
-func schema_pkg_apis_apps_v1beta1_MinerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apps_v1beta1_MinerClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MinerList is a list of Miner objects.",
+				Description: "MinerClass describes a machine configuration of the miners. The `minerType` of the miners and the chains refers to the name of a MinerClass, which is resolved by the miner controller when the miner pod is created.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -936,39 +1041,31 @@ func schema_pkg_apis_apps_v1beta1_MinerList(ref common.ReferenceCallback) common
 					},
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard list metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata",
+							Description: "Standard object's metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata",
 							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"items": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is a list of schema objects.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.Miner"),
-									},
-								},
-							},
+							Description: "Specification of the miners of the class. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerClassSpec"),
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/superproj/onex/pkg/apis/apps/v1beta1.Miner", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerClassSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_apps_v1beta1_MinerSet(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apps_v1beta1_MinerClassList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MinerSet ensures that a specified number of miners replicas are running at any given time.",
+				Description: "MinerClassList is a list of MinerClass objects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -987,154 +1084,944 @@ func schema_pkg_apis_apps_v1beta1_MinerSet(ref common.ReferenceCallback) common.
 					},
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If the Labels of a MinerSet are empty, they are defaulted to be the same as the Miner(s) that the MinerSet manages. Standard object's metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata",
+							Description: "Standard list metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata",
 							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"spec": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the specification of the desired behavior of the MinerSet. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetSpec"),
+							Description: "Items is a list of schema objects.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerClass"),
+									},
+								},
+							},
 						},
 					},
-					"status": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerClass", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_apps_v1beta1_MinerClassPricing(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MinerClassPricing defines the price of the miners of a MinerClass.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hourlyPrice": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status is the most recently observed status of the MinerSet. This data may be out of date by some window of time. Populated by the system. Read-only. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetStatus"),
+							Description: "HourlyPrice is the number of tokens charged for running a miner of the class for one hour.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetSpec", "github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_apps_v1beta1_MinerSetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apps_v1beta1_MinerClassSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MinerSetList contains a list of MinerSet.",
+				Description: "MinerClassSpec defines the machine configuration of the miners of a MinerClass.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "The display name of the miner class.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Resources are the compute resources of the miner container. The cpu and memory limits are required.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
 						},
 					},
-					"metadata": {
+					"miningDifficulty": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard list metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "MiningDifficulty is the minimum number of leading zero bits of the block hashes mined by the miners of the class.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"items": {
+					"nodeSelector": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-map-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "List of MinerSets.",
+							Description: "NodeSelector is a selector which must be true for the miner pods to fit on a node. The node selector of the pod overrides of a miner can only add keys to it. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tolerations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, the tolerations of the miner pods. The tolerations of the pod overrides of a miner are appended.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSet"),
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
 									},
 								},
 							},
 						},
 					},
+					"affinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, the scheduling constraints of the miner pods. The affinity of the pod overrides of a miner is merged into it, and can only narrow the required node affinity.",
+							Ref:         ref("k8s.io/api/core/v1.Affinity"),
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image overrides the blockchain node image of the chain.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pricing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pricing is the price of the miners of the class.",
+							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerClassPricing"),
+						},
+					},
 				},
-				Required: []string{"items"},
+				Required: []string{"resources"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSet", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerClassPricing", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
 	}
 }
 
-func schema_pkg_apis_apps_v1beta1_MinerSetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apps_v1beta1_MinerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MinerSetSpec defines the desired state of MinerSet.",
+				Description: "MinerList is a list of Miner objects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"replicas": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Replicas is the number of desired replicas. This is a pointer to distinguish between explicit zero and unspecified. Defaults to 1. More info: https://kubernetes.io/docs/concepts/workloads/controllers/replicationcontroller/#what-is-a-replicationcontroller",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"selector": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Selector is a label query over miners that should match the replica count. Label keys and values that must match in order to be controlled by this MinerSet. It must match the miner template's labels. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
-						},
-					},
-					"template": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Template is the object that describes the miner that will be created if insufficient replicas are detected.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerTemplateSpec"),
-						},
-					},
-					"displayName": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The display name of the minerset.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"deletePolicy": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeletePolicy defines the policy used to identify miners to delete when downscaling. Defaults to \"Random\". Valid values are \"Random, \"Newest\", \"Oldest\"",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"minReadySeconds": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Minimum number of seconds for which a newly created miner should be ready without any of its component crashing, for it to be considered available. Defaults to 0 (miner will be considered available as soon as it is ready)",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Standard list metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"progressDeadlineSeconds": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The maximum time in seconds for a minerset to make progress before it is considered to be failed. The deployment controller will continue to process failed deployments and a condition with a ProgressDeadlineExceeded reason will be surfaced in the deployment status. Note that progress will not be estimated during the time a deployment is paused. Defaults to 600s.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Items is a list of schema objects.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.Miner"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"selector"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerTemplateSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/superproj/onex/pkg/apis/apps/v1beta1.Miner", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_apps_v1beta1_MinerSetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apps_v1beta1_MinerPodOverrides(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MinerSetStatus represents the current status of a MinerSet.",
+				Description: "MinerPodOverrides is the restricted set of Pod fields which can be customized for a miner. The containers, ports and resources of the Pod are always generated by the miner controller.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeSelector": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-map-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector is a selector which must be true for the Pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tolerations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, the Pod's tolerations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+					"affinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, the Pod's scheduling constraints.",
+							Ref:         ref("k8s.io/api/core/v1.Affinity"),
+						},
+					},
+					"topologySpreadConstraints": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"topologyKey",
+									"whenUnsatisfiable",
+								},
+								"x-kubernetes-list-type":       "map",
+								"x-kubernetes-patch-merge-key": "topologyKey",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologySpreadConstraints describes how the miner Pods ought to spread across topology domains, e.g. nodes or zones. The label selectors of the constraints match the labels set through the ObjectMeta of the miner.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.TopologySpreadConstraint"),
+									},
+								},
+							},
+						},
+					},
+					"priorityClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, indicates the Pod's priority. The \"system-\" prefixed priority classes are reserved for the system and cannot be used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"env": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type":       "map",
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Env is a list of extra environment variables to set in the miner container. Only literal values and references to the fields and resources of the Pod are allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.TopologySpreadConstraint"},
+	}
+}
+
+func schema_pkg_apis_apps_v1beta1_MinerSet(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MinerSet ensures that a specified number of miners replicas are running at any given time.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If the Labels of a MinerSet are empty, they are defaulted to be the same as the Miner(s) that the MinerSet manages. Standard object's metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the specification of the desired behavior of the MinerSet. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the most recently observed status of the MinerSet. This data may be out of date by some window of time. Populated by the system. Read-only. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetSpec", "github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_apps_v1beta1_MinerSetAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MinerSetAutoscaler automatically scales the replicas of a MinerSet between the minimum and the maximum, based on the metrics specified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the behaviour of the autoscaler. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetAutoscalerSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the current information about the autoscaler.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetAutoscalerStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetAutoscalerSpec", "github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetAutoscalerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_apps_v1beta1_MinerSetAutoscalerBehavior(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MinerSetAutoscalerBehavior configures the scaling behavior of the target in both up and down directions.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"scaleUp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleUp is the scaling policy for scaling up. If not set, the MinerSet is scaled up immediately.",
+							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetScalingRules"),
+						},
+					},
+					"scaleDown": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleDown is the scaling policy for scaling down. If not set, the replica counts recommended in the last 300 seconds are considered.",
+							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetScalingRules"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetScalingRules"},
+	}
+}
+
+func schema_pkg_apis_apps_v1beta1_MinerSetAutoscalerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MinerSetAutoscalerList is a list of MinerSetAutoscaler objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of MinerSetAutoscalers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetAutoscaler"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetAutoscaler", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_apps_v1beta1_MinerSetAutoscalerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MinerSetAutoscalerSpec describes the desired functionality of the MinerSetAutoscaler.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"scaleTargetRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleTargetRef points to the MinerSet to scale, in the namespace of the autoscaler.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.LocalObjectReference"),
+						},
+					},
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinReplicas is the lower limit for the number of replicas to which the autoscaler can scale down. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxReplicas is the upper limit for the number of replicas to which the autoscaler can scale up. It cannot be less than minReplicas.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"metrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metrics contains the specifications used to calculate the desired replica count. The desired replica count is calculated for each metric, and the largest one is used. If no metric gives a replica count, e.g. all the schedules are inactive, the MinerSet is scaled to minReplicas.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetMetricSpec"),
+									},
+								},
+							},
+						},
+					},
+					"behavior": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Behavior configures the scaling behavior of the target in both up and down directions. If not set, the default behaviors are used.",
+							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetAutoscalerBehavior"),
+						},
+					},
+				},
+				Required: []string{"scaleTargetRef", "maxReplicas"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/superproj/onex/pkg/apis/apps/v1beta1.LocalObjectReference", "github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetAutoscalerBehavior", "github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetMetricSpec"},
+	}
+}
+
+func schema_pkg_apis_apps_v1beta1_MinerSetAutoscalerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MinerSetAutoscalerStatus describes the current status of a MinerSetAutoscaler.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed by this autoscaler.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastScaleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastScaleTime is the last time the autoscaler scaled the MinerSet.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"currentReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentReplicas is the current number of replicas of the MinerSet, as last seen by the autoscaler.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"desiredReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DesiredReplicas is the desired number of replicas of the MinerSet, as last calculated by the autoscaler.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"currentMetrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentMetrics is the last read state of the metrics used by this autoscaler.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetMetricStatus"),
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions defines current service state of the autoscaler.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"desiredReplicas"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/superproj/onex/pkg/apis/apps/v1beta1.Condition", "github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetMetricStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_apps_v1beta1_MinerSetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MinerSetList contains a list of MinerSet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of MinerSets.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSet"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSet", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_apps_v1beta1_MinerSetMetricSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MinerSetMetricSpec specifies how to scale based on a single metric. Only the field matching the type should be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of metric source. It should be one of \"PodCPU\", \"ChainBlockInterval\", \"PendingChargeRequests\" or \"Schedule\", each mapping to a matching field in the object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"podCPU": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodCPU refers to the CPU usage of the miner pods.",
+							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.PodCPUMetricSource"),
+						},
+					},
+					"chainBlockInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChainBlockInterval refers to the average block interval of the chain.",
+							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.ChainBlockIntervalMetricSource"),
+						},
+					},
+					"pendingChargeRequests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingChargeRequests refers to the ChargeRequests not settled yet.",
+							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.PendingChargeRequestsMetricSource"),
+						},
+					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule refers to a recurring time window.",
+							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.ScheduleMetricSource"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/superproj/onex/pkg/apis/apps/v1beta1.ChainBlockIntervalMetricSource", "github.com/superproj/onex/pkg/apis/apps/v1beta1.PendingChargeRequestsMetricSource", "github.com/superproj/onex/pkg/apis/apps/v1beta1.PodCPUMetricSource", "github.com/superproj/onex/pkg/apis/apps/v1beta1.ScheduleMetricSource"},
+	}
+}
+
+func schema_pkg_apis_apps_v1beta1_MinerSetMetricStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MinerSetMetricStatus describes the last read state of a single metric.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of metric source.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"current": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Current is the current value of the metric: the average utilization in percent for PodCPU, the average block interval in seconds for ChainBlockInterval, the number of pending requests for PendingChargeRequests, and 1 if the time window is active else 0 for Schedule.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"desiredReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DesiredReplicas is the replica count recommended by the metric. It is not set if the metric does not recommend any replica count, e.g. an inactive schedule.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_apps_v1beta1_MinerSetRevision(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MinerSetRevision is a revision of the miner template of a MinerSet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the sequence number of the revision.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"templateHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateHash is the hash of the miner spec of the template, it is set on the miners created from the revision with the `apps.onex.io/minerset-template-hash` label.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the miner spec of the template of the revision.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSpec"),
+						},
+					},
+					"creationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreationTimestamp is the time the revision was rolled out.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"revision", "templateHash"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_apps_v1beta1_MinerSetRollback(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MinerSetRollback describes the revision a MinerSet is rolled back to.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The revision to rollback to. If set to 0, rollback to the last revision.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_apps_v1beta1_MinerSetScalingRules(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MinerSetScalingRules configures the scaling behavior for one direction.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"stabilizationWindowSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StabilizationWindowSeconds is the number of seconds for which past recommendations should be considered while scaling up or scaling down, to prevent flapping: the MinerSet is scaled down to the highest, or up to the lowest, replica count recommended during the window. It must be between 0 and 3600 (one hour).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_apps_v1beta1_MinerSetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MinerSetSpec defines the desired state of MinerSet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the number of desired replicas. This is a pointer to distinguish between explicit zero and unspecified. Defaults to 1. More info: https://kubernetes.io/docs/concepts/workloads/controllers/replicationcontroller/#what-is-a-replicationcontroller",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector is a label query over miners that should match the replica count. Label keys and values that must match in order to be controlled by this MinerSet. It must match the miner template's labels. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template is the object that describes the miner that will be created if insufficient replicas are detected.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerTemplateSpec"),
+						},
+					},
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The display name of the minerset.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deletePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletePolicy defines the policy used to identify miners to delete when downscaling. Defaults to \"Random\". Valid values are \"Random, \"Newest\", \"Oldest\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"minReadySeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Minimum number of seconds for which a newly created miner should be ready without any of its component crashing, for it to be considered available. Defaults to 0 (miner will be considered available as soon as it is ready)",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"progressDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum time in seconds for a minerset to make progress before it is considered to be failed. The deployment controller will continue to process failed deployments and a condition with a ProgressDeadlineExceeded reason will be surfaced in the deployment status. Note that progress will not be estimated during the time a deployment is paused. Defaults to 600s.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"strategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The minerset strategy to use to replace existing miners with new ones when the spec of the miner template changes.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetStrategy"),
+						},
+					},
+					"revisionHistoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of old revisions to retain in the status to allow rollback. This is a pointer to distinguish between explicit zero and not specified. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"paused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicates that the rollout of the miner template is paused. Miners are still created and deleted to match the replicas, from the last rolled out template.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"rollbackTo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The revision to rollback to. The controller copies the miner spec of the revision into the template and clears this field.",
+							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetRollback"),
+						},
+					},
+				},
+				Required: []string{"selector"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetRollback", "github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetStrategy", "github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerTemplateSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_apps_v1beta1_MinerSetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MinerSetStatus represents the current status of a MinerSet.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"replicas": {
@@ -1207,12 +2094,75 @@ func schema_pkg_apis_apps_v1beta1_MinerSetStatus(ref common.ReferenceCallback) c
 							},
 						},
 					},
+					"updatedReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of miners created from the current revision of the miner template.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the current revision of the miner template.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"templateHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateHash is the hash of the current revision of the miner template.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"history": {
+						SchemaProps: spec.SchemaProps{
+							Description: "History is the current and the old revisions of the miner template, from the oldest to the newest. The old revisions are limited by RevisionHistoryLimit.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetRevision"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"replicas"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/superproj/onex/pkg/apis/apps/v1beta1.Condition"},
+			"github.com/superproj/onex/pkg/apis/apps/v1beta1.Condition", "github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerSetRevision"},
+	}
+}
+
+func schema_pkg_apis_apps_v1beta1_MinerSetStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MinerSetStrategy describes how to replace existing miners with new ones.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of minerset strategy. Can be \"Recreate\" or \"RollingUpdate\". Default is RollingUpdate.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rollingUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rolling update config params. Present only if MinerSetStrategyType = RollingUpdate.",
+							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.RollingUpdateMinerSet"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/superproj/onex/pkg/apis/apps/v1beta1.RollingUpdateMinerSet"},
 	}
 }
 
@@ -1264,11 +2214,17 @@ func schema_pkg_apis_apps_v1beta1_MinerSpec(ref common.ReferenceCallback) common
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"podOverrides": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodOverrides are merged into the Pod created for the miner. Use ObjectMeta to set the labels and annotations of the Pod.",
+							Ref:         ref("github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerPodOverrides"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/superproj/onex/pkg/apis/apps/v1beta1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/superproj/onex/pkg/apis/apps/v1beta1.MinerPodOverrides", "github.com/superproj/onex/pkg/apis/apps/v1beta1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -1435,6 +2391,50 @@ func schema_pkg_apis_apps_v1beta1_ObjectMeta(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_pkg_apis_apps_v1beta1_PendingChargeRequestsMetricSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PendingChargeRequestsMetricSource scales the MinerSet to have a replica for every targetPerReplica pending ChargeRequests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"targetPerReplica": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetPerReplica is the number of pending ChargeRequests a replica can handle.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"targetPerReplica"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_apps_v1beta1_PodCPUMetricSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PodCPUMetricSource scales the MinerSet to keep the average CPU utilization of its miner pods at the target.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"targetAverageUtilization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetAverageUtilization is the target value of the average CPU utilization of the miner pods, as a percentage of their CPU requests, or limits if the requests are not set.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"targetAverageUtilization"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_apps_v1beta1_PodInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1465,6 +2465,71 @@ func schema_pkg_apis_apps_v1beta1_PodInfo(ref common.ReferenceCallback) common.O
 	}
 }
 
+func schema_pkg_apis_apps_v1beta1_RollingUpdateMinerSet(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RollingUpdateMinerSet is the spec to control the desired behavior of rolling update.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum number of miners that can be unavailable during the update. Value can be an absolute number (ex: 5) or a percentage of desired miners (ex: 10%). Absolute number is calculated from percentage by rounding down. This can not be 0 if MaxSurge is 0. Defaults to 25%.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum number of miners that can be scheduled above the desired number of miners during the update. Value can be an absolute number (ex: 5) or a percentage of desired miners (ex: 10%). This can not be 0 if MaxUnavailable is 0. Absolute number is calculated from percentage by rounding up. Defaults to 25%.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_pkg_apis_apps_v1beta1_ScheduleMetricSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScheduleMetricSource requests a fixed number of replicas for a duration every time the schedule fires.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is the start of the time window, in Cron format, e.g. \"0 8 * * 1-5\". The time zone can be set with a \"CRON_TZ=\" prefix, it defaults to the time zone of the controller.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the length of the time window.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the number of replicas during the time window.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"schedule", "duration", "replicas"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_k8sio_api_autoscaling_v1_ContainerResourceMetricSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7,6 +7,8 @@
 package idempotent
 
 import (
+	"time"
+
 	"github.com/redis/go-redis/v9"
 )
 
@@ -14,6 +16,11 @@ type Options struct {
 	redis  redis.UniversalClient
 	prefix string
 	expire int
+	// lockTimeout is how long a request holds its key before a retry may take over,
+	// in case the instance processing it crashed.
+	lockTimeout time.Duration
+	// wait is how long a duplicate request waits for the original one to complete.
+	wait time.Duration
 }
 
 func WithRedis(rd redis.UniversalClient) func(*Options) {
@@ -46,6 +53,29 @@ func WithExpire(min int) func(*Options) {
 	}
 }
 
+// WithLockTimeout sets how long a request holds its key while being processed.
+func WithLockTimeout(timeout time.Duration) func(*Options) {
+	return func(options *Options) {
+		if timeout <= 0 {
+			return
+		}
+
+		getOptionsOrSetDefault(options).lockTimeout = timeout
+	}
+}
+
+// WithWait sets how long a duplicate request waits for the original one to complete.
+// A duplicate request is rejected with ErrInProgress immediately if wait is 0.
+func WithWait(wait time.Duration) func(*Options) {
+	return func(options *Options) {
+		if wait < 0 {
+			return
+		}
+
+		getOptionsOrSetDefault(options).wait = wait
+	}
+}
+
 // getOptionsOrSetDefault returns the provided options if they are not nil,
 // otherwise it returns a default set of options.
 func getOptionsOrSetDefault(options *Options) *Options {
@@ -54,7 +84,9 @@ func getOptionsOrSetDefault(options *Options) *Options {
 	}
 
 	return &Options{
-		prefix: "idempotent",
-		expire: 60,
+		prefix:      "idempotent",
+		expire:      60,
+		lockTimeout: time.Minute,
+		wait:        10 * time.Second,
 	}
 }
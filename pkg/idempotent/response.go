@@ -0,0 +1,174 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package idempotent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/superproj/onex/pkg/log"
+)
+
+// pollInterval is how often a duplicate request checks whether the original one has completed.
+const pollInterval = 100 * time.Millisecond
+
+var (
+	// ErrInProgress is returned when a request with the same key is still being processed.
+	ErrInProgress = errors.New("idempotent: a request with the same key is in progress")
+	// ErrMismatch is returned when the key has been used by a request with different parameters.
+	ErrMismatch = errors.New("idempotent: the key has been used by a different request")
+	// ErrLockLost is returned by Complete and Release when the key is no longer held by the
+	// request, e.g. it was taken over by a retry after the lock timeout.
+	ErrLockLost = errors.New("idempotent: the key is no longer held by the request")
+)
+
+// acquireScript returns the record of the key, or stores the processing record if there is none.
+var acquireScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if current then
+    return current
+end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+return false
+`)
+
+// completeScript replaces the processing record of the key with the final record, only if
+// the key is still held with the token.
+var completeScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if not current or cjson.decode(current).token ~= ARGV[1] then
+    return 0
+end
+redis.call('SET', KEYS[1], ARGV[2], 'PX', ARGV[3])
+return 1
+`)
+
+// releaseScript deletes the processing record of the key, only if the key is still held with the token.
+var releaseScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if not current or cjson.decode(current).token ~= ARGV[1] then
+    return 0
+end
+redis.call('DEL', KEYS[1])
+return 1
+`)
+
+// Response is the final response of a request, which is replayed to the retries of the request.
+type Response struct {
+	Status int    `json:"status"`
+	Body   []byte `json:"body"`
+}
+
+// record is stored in redis for each idempotency key.
+type record struct {
+	// Fingerprint identifies the parameters of the request which first used the key.
+	Fingerprint string `json:"fingerprint"`
+	// Token identifies the request holding the key, it is empty once the response is stored.
+	Token string `json:"token,omitempty"`
+	// Response is nil while the request is being processed.
+	Response *Response `json:"response,omitempty"`
+}
+
+// Acquire claims the key for the request identified by fingerprint.
+// It returns a nil response and the token of the claim if the caller should process the
+// request, and then call Complete or Release with the token.
+// If the key has been used by a completed request, its response is returned to be replayed.
+// A duplicate of a request in progress waits for it, and gets ErrInProgress if it is not
+// completed in time. ErrMismatch is returned if the key was used by a different request.
+func (i *Idempotent) Acquire(ctx context.Context, key string, fingerprint string) (*Response, string, error) {
+	if i.ops.redis == nil {
+		log.C(ctx).Warnw("please enable redis, otherwise the idempotent is invalid")
+		return nil, "", nil
+	}
+
+	token := uuid.NewString()
+	processing, err := json.Marshal(&record{Fingerprint: fingerprint, Token: token})
+	if err != nil {
+		return nil, "", err
+	}
+
+	deadline := time.Now().Add(i.ops.wait)
+	for {
+		res, err := acquireScript.Run(ctx, i.ops.redis, []string{i.responseKey(key)}, processing, i.ops.lockTimeout.Milliseconds()).Text()
+		if errors.Is(err, redis.Nil) {
+			return nil, token, nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		var rec record
+		if err := json.Unmarshal([]byte(res), &rec); err != nil {
+			return nil, "", err
+		}
+		if rec.Fingerprint != fingerprint {
+			return nil, "", ErrMismatch
+		}
+		if rec.Response != nil {
+			return rec.Response, "", nil
+		}
+
+		if !time.Now().Before(deadline) {
+			return nil, "", ErrInProgress
+		}
+		select {
+		case <-ctx.Done():
+			return nil, "", ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// Complete stores the final response of the request which acquired the key with token, so that
+// it is replayed to the retries. ErrLockLost is returned if the key is no longer held with token.
+func (i *Idempotent) Complete(ctx context.Context, key string, token string, fingerprint string, resp *Response) error {
+	if i.ops.redis == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(&record{Fingerprint: fingerprint, Response: resp})
+	if err != nil {
+		return err
+	}
+
+	expire := time.Duration(i.ops.expire) * time.Minute
+	return i.swap(ctx, completeScript, key, token, data, expire.Milliseconds())
+}
+
+// Release gives up the key acquired with token without storing a response, so that the request
+// can be retried, e.g. after a transient failure. ErrLockLost is returned if the key is no longer
+// held with token, in which case it is left to its current holder.
+func (i *Idempotent) Release(ctx context.Context, key string, token string) error {
+	if i.ops.redis == nil {
+		return nil
+	}
+
+	return i.swap(ctx, releaseScript, key, token)
+}
+
+// swap runs a script which updates the key only if it is still held with token.
+func (i *Idempotent) swap(ctx context.Context, script *redis.Script, key string, token string, args ...any) error {
+	ok, err := script.Run(ctx, i.ops.redis, []string{i.responseKey(key)}, append([]any{token}, args...)...).Int()
+	if err != nil {
+		return err
+	}
+	if ok == 0 {
+		return ErrLockLost
+	}
+
+	return nil
+}
+
+func (i *Idempotent) responseKey(key string) string {
+	return fmt.Sprintf("%s_response_%s", i.ops.prefix, key)
+}
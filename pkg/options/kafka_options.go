@@ -337,11 +337,22 @@ func (o *KafkaOptions) Dialer() (*kafka.Dialer, error) {
 }
 
 func (o *KafkaOptions) Writer() (*kafka.Writer, error) {
-	dialer, err := o.Dialer()
+	config, err := o.WriterConfig()
 	if err != nil {
 		return nil, err
 	}
 
+	kafkaWriter := kafka.NewWriter(config)
+	return kafkaWriter, nil
+}
+
+// WriterConfig returns the config of the kafka writers, e.g. for the kafka sinks of `pkg/streams`.
+func (o *KafkaOptions) WriterConfig() (kafka.WriterConfig, error) {
+	dialer, err := o.Dialer()
+	if err != nil {
+		return kafka.WriterConfig{}, err
+	}
+
 	// Kafka writer connection config
 	config := kafka.WriterConfig{
 		Brokers:      o.Brokers,
@@ -364,6 +375,5 @@ func (o *KafkaOptions) Writer() (*kafka.Writer, error) {
 		config.CompressionCodec = snappy.NewCompressionCodec()
 	}
 
-	kafkaWriter := kafka.NewWriter(config)
-	return kafkaWriter, nil
+	return config, nil
 }
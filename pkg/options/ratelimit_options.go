@@ -0,0 +1,71 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package options
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+)
+
+var _ IOptions = (*RateLimitOptions)(nil)
+
+// RateLimitOptions defines options for the rate limiting of the requests of each caller.
+type RateLimitOptions struct {
+	Enabled bool `json:"enabled" mapstructure:"enabled"`
+	// Rate is the number of the requests per second allowed for a caller on an operation.
+	Rate float64 `json:"rate" mapstructure:"rate"`
+	// Burst is the number of the requests allowed at once.
+	Burst int `json:"burst" mapstructure:"burst"`
+	// Routes overrides the limits of some operations.
+	Routes []RateLimitRoute `json:"routes" mapstructure:"routes"`
+}
+
+// RateLimitRoute is the limit of an operation, e.g. `/gateway.v1.Gateway/CreateMiner`.
+type RateLimitRoute struct {
+	Operation string  `json:"operation" mapstructure:"operation"`
+	Rate      float64 `json:"rate" mapstructure:"rate"`
+	Burst     int     `json:"burst" mapstructure:"burst"`
+}
+
+// NewRateLimitOptions create a `zero` value instance.
+func NewRateLimitOptions() *RateLimitOptions {
+	return &RateLimitOptions{
+		Enabled: true,
+		Rate:    20,
+		Burst:   50,
+	}
+}
+
+// Validate verifies flags passed to RateLimitOptions.
+func (o *RateLimitOptions) Validate() []error {
+	errs := []error{}
+
+	if !o.Enabled {
+		return errs
+	}
+
+	if o.Rate <= 0 || o.Burst <= 0 {
+		errs = append(errs, fmt.Errorf("--ratelimit.rate and --ratelimit.burst must be greater than 0"))
+	}
+
+	for _, r := range o.Routes {
+		if r.Operation == "" || r.Rate <= 0 || r.Burst <= 0 {
+			errs = append(errs, fmt.Errorf("rate limit route %q must have an operation, and a rate and burst greater than 0", r.Operation))
+		}
+	}
+
+	return errs
+}
+
+// AddFlags adds flags related to rate limiting for a specific server to the specified FlagSet.
+// The limits of the routes can only be set in the config file.
+func (o *RateLimitOptions) AddFlags(fs *pflag.FlagSet, prefixes ...string) {
+	fs.BoolVar(&o.Enabled, "ratelimit.enabled", o.Enabled, "Limit the rate of the requests of each caller.")
+	fs.Float64Var(&o.Rate, "ratelimit.rate", o.Rate, "Number of the requests per second allowed for a caller on an operation.")
+	fs.IntVar(&o.Burst, "ratelimit.burst", o.Burst, "Number of the requests allowed at once for a caller on an operation.")
+}
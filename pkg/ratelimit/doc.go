@@ -0,0 +1,9 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+// Package ratelimit implements a token bucket rate limiter backed by redis, which is
+// shared by all the instances of a service.
+package ratelimit // import "github.com/superproj/onex/pkg/ratelimit"
@@ -0,0 +1,114 @@
+// Copyright 2022 Lingfei Kong <colin404@foxmail.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/superproj/onex.
+//
+
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// redis lua script(refill the bucket => take a token => save the bucket).
+// The time of redis is used, so that the clocks of the instances don't matter.
+const lua string = `
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) + tonumber(t[2]) / 1000000
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1]) or burst
+local ts = tonumber(bucket[2]) or now
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate)
+
+local allowed = 0
+local retry = 0
+if tokens >= 1 then
+    tokens = tokens - 1
+    allowed = 1
+else
+    retry = (1 - tokens) / rate
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst / rate * 1000) + 1000)
+return {allowed, tostring(tokens), tostring(retry), tostring((burst - tokens) / rate)}
+`
+
+// ErrInvalidLimit is returned when the rate or the burst of a limit is not positive.
+var ErrInvalidLimit = errors.New("rate and burst of the limit must be positive")
+
+// Limit is the rate of a bucket. The bucket holds at most Burst tokens, and is refilled
+// with Rate tokens per second. Each request takes a token.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Result is the state of the bucket after a request.
+type Result struct {
+	// Allowed is true if the request took a token.
+	Allowed bool
+	// Limit is the capacity of the bucket.
+	Limit int
+	// Remaining is the number of the requests which are allowed right now.
+	Remaining int
+	// RetryAfter is how long to wait for a token, it is 0 if the request is allowed.
+	RetryAfter time.Duration
+	// ResetAfter is how long it takes to refill the bucket.
+	ResetAfter time.Duration
+}
+
+type RateLimiter struct {
+	ops Options
+}
+
+func New(options ...func(*Options)) *RateLimiter {
+	ops := getOptionsOrSetDefault(nil)
+	for _, f := range options {
+		f(ops)
+	}
+	return &RateLimiter{ops: *ops}
+}
+
+// Allow takes a token from the bucket of the key for a request.
+func (l *RateLimiter) Allow(ctx context.Context, key string, limit Limit) (*Result, error) {
+	if limit.Rate <= 0 || limit.Burst <= 0 {
+		return nil, ErrInvalidLimit
+	}
+
+	res, err := l.ops.redis.Eval(ctx, lua, []string{fmt.Sprintf("%s_%s", l.ops.prefix, key)}, limit.Rate, limit.Burst).Slice()
+	if err != nil {
+		return nil, err
+	}
+	if len(res) != 4 {
+		return nil, fmt.Errorf("unexpected rate limit script result: %v", res)
+	}
+
+	allowed, _ := res[0].(int64)
+	tokens := parseFloat(res[1])
+	return &Result{
+		Allowed:    allowed == 1,
+		Limit:      limit.Burst,
+		Remaining:  int(math.Floor(tokens)),
+		RetryAfter: seconds(parseFloat(res[2])),
+		ResetAfter: seconds(parseFloat(res[3])),
+	}, nil
+}
+
+func parseFloat(v any) float64 {
+	s, _ := v.(string)
+	f, _ := strconv.ParseFloat(s, 64)
+	return f
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}